@@ -27,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -279,3 +280,52 @@ var Password action.GTSAction = func(ctx context.Context) error {
 
 	return nil
 }
+
+// RecoveryCodes generates new recovery codes for target account, replacing any it had before,
+// and prints them, so that they can be passed on to the account's owner.
+var RecoveryCodes action.GTSAction = func(ctx context.Context) error {
+	if !config.GetOIDCEnabled() {
+		return errors.New("recovery codes are only used when oidc is enabled")
+	}
+
+	dbConn, err := bundb.NewBunDBService(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	username := config.GetAdminAccountUsername()
+	if username == "" {
+		return errors.New("no username set")
+	}
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	a, err := dbConn.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	u, err := dbConn.GetUserByAccountID(ctx, a.ID)
+	if err != nil {
+		return err
+	}
+
+	codes, hashes, err := oauth.NewRecoveryCodes()
+	if err != nil {
+		return err
+	}
+
+	updatingColumns := []string{"recovery_codes", "updated_at"}
+	u.RecoveryCodes = hashes
+	u.UpdatedAt = time.Now()
+	if _, err := dbConn.UpdateUser(ctx, u, updatingColumns...); err != nil {
+		return err
+	}
+
+	for _, code := range codes {
+		fmt.Println(code)
+	}
+
+	return dbConn.Stop(ctx)
+}
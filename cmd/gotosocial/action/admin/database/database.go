@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Maintain runs database maintenance immediately, the same as a scheduled
+// maintenance run would, and reports how much space was reclaimed.
+var Maintain action.GTSAction = func(ctx context.Context) error {
+	dbConn, err := bundb.NewBunDBService(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	begin := time.Now()
+	result, err := dbConn.Maintain(ctx, config.GetDbMaintenanceVacuum(), config.GetDbMaintenanceReindex())
+	if err != nil {
+		return fmt.Errorf("error running database maintenance: %s", err)
+	}
+
+	log.Infof("database maintenance finished in %s: vacuumed %t, reindexed %t, size before %d bytes, size after %d bytes, reclaimed %d bytes", time.Since(begin), result.Vacuumed, result.Reindexed, result.SizeBefore, result.SizeAfter, result.Reclaimed())
+
+	return dbConn.Stop(ctx)
+}
+
+// AuditIDs checks the ids of tables that are paged through by id against the times
+// their rows were created, and reports rows whose ids are skewed, eg., by clock jumps.
+var AuditIDs action.GTSAction = func(ctx context.Context) error {
+	return auditIDs(ctx, false)
+}
+
+// ResequenceIDs gives new ids to the skewed rows reported by AuditIDs, where it's
+// safe to do so. The server must be stopped while this runs.
+var ResequenceIDs action.GTSAction = func(ctx context.Context) error {
+	return auditIDs(ctx, true)
+}
+
+func auditIDs(ctx context.Context, resequence bool) error {
+	dbConn, err := bundb.NewBunDBService(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	tolerance := time.Duration(config.GetAdminIDsTolerance()) * time.Second
+
+	var audits []*db.IDAudit
+	if resequence {
+		audits, err = dbConn.ResequenceIDs(ctx, tolerance)
+	} else {
+		audits, err = dbConn.AuditIDs(ctx, tolerance)
+	}
+	if err != nil {
+		return fmt.Errorf("error auditing ids: %s", err)
+	}
+
+	for _, a := range audits {
+		log.Infof("%s: checked %d, invalid %d, skewed %d (in the future %d), resequenceable %d, resequenced %d", a.Table, a.Checked, a.Invalid, a.Skewed, a.Future, a.Resequenceable, a.Resequenced)
+	}
+
+	return dbConn.Stop(ctx)
+}
@@ -0,0 +1,138 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Rename rewrites all local URIs in the database from the given old host to the
+// currently configured host, then sends an Update for every local account so
+// that remote instances have a chance to pick up the new actor URIs.
+var Rename action.GTSAction = func(ctx context.Context) error {
+	if !config.GetAdminDomainRiskOK() {
+		return fmt.Errorf("renaming the instance host cannot be undone and remote instances may not follow the change; read the documentation, back up your database, then rerun with --%s", config.AdminDomainRiskOKFlag())
+	}
+
+	oldHost := config.GetAdminDomainOldHost()
+	if oldHost == "" {
+		return errors.New("no old host set")
+	}
+
+	dbConn, err := bundb.NewBunDBService(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	if err := dbConn.RenameHost(ctx, oldHost); err != nil {
+		return fmt.Errorf("error renaming host: %s", err)
+	}
+
+	if err := federateAccountUpdates(ctx, dbConn); err != nil {
+		// the rename itself has been done by this point,
+		// so don't pretend the whole thing failed
+		log.Errorf("error sending account updates: %s", err)
+	}
+
+	return dbConn.Stop(ctx)
+}
+
+// federateAccountUpdates sends an Update of each local account to its followers.
+func federateAccountUpdates(ctx context.Context, dbConn db.DB) error {
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return fmt.Errorf("error creating storage backend: %w", err)
+	}
+
+	mediaManager, err := media.NewManager(dbConn, storage)
+	if err != nil {
+		return fmt.Errorf("error creating media manager: %s", err)
+	}
+	defer func() {
+		if err := mediaManager.Stop(); err != nil {
+			log.Errorf("error stopping media manager: %s", err)
+		}
+	}()
+
+	// nothing is received while this command runs, so the
+	// federator worker pool is never started or used
+	fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+	federatingDB := federatingdb.New(dbConn, fedWorker)
+	typeConverter := typeutils.NewConverter(dbConn)
+	client := httpclient.New(httpclient.Config{})
+	transportController := transport.NewController(dbConn, federatingDB, &federation.Clock{}, client)
+	federator := federation.NewFederator(dbConn, federatingDB, transportController, typeConverter, mediaManager)
+
+	accounts := []*gtsmodel.Account{}
+	if err := dbConn.GetWhere(ctx, []db.Where{{Key: "domain", Value: nil}}, &accounts); err != nil {
+		return fmt.Errorf("error selecting local accounts: %s", err)
+	}
+
+	for _, account := range accounts {
+		if !account.SuspendedAt.IsZero() {
+			continue
+		}
+
+		person, err := typeConverter.AccountToAS(ctx, account)
+		if err != nil {
+			log.Errorf("error converting account %s to person: %s", account.Username, err)
+			continue
+		}
+
+		update, err := typeConverter.WrapPersonInUpdate(person, account)
+		if err != nil {
+			log.Errorf("error wrapping person %s in update: %s", account.Username, err)
+			continue
+		}
+
+		outboxIRI, err := url.Parse(account.OutboxURI)
+		if err != nil {
+			log.Errorf("error parsing outbox uri %s: %s", account.OutboxURI, err)
+			continue
+		}
+
+		if _, err := federator.FederatingActor().Send(ctx, outboxIRI, update); err != nil {
+			log.Errorf("error sending update for account %s: %s", account.Username, err)
+			continue
+		}
+
+		log.Infof("sent update for account %s", account.Username)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emoji
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/emojipack"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
+)
+
+// Import imports the emojis of an emoji pack archive as local emojis.
+var Import action.GTSAction = func(ctx context.Context) error {
+	path := config.GetAdminTransPath()
+	if path == "" {
+		return errors.New("no path set")
+	}
+
+	dbConn, err := bundb.NewBunDBService(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return fmt.Errorf("error creating storage backend: %w", err)
+	}
+
+	mediaManager, err := media.NewManager(dbConn, storage)
+	if err != nil {
+		return fmt.Errorf("error creating media manager: %s", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %s", path, err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("error reading %s: %s", path, err)
+	}
+
+	emojis, err := emojipack.NewImporter(dbConn, mediaManager).Import(ctx, f, fi.Size(), config.GetAdminEmojiCategory())
+	if err != nil {
+		return err
+	}
+	log.Infof("imported %d emojis from %s", len(emojis), path)
+
+	if err := mediaManager.Stop(); err != nil {
+		return err
+	}
+
+	return dbConn.Stop(ctx)
+}
+
+// Add adds the images in a directory as local emojis, with shortcodes derived from their file names.
+var Add action.GTSAction = func(ctx context.Context) error {
+	dir := config.GetAdminEmojiDir()
+	if dir == "" {
+		return errors.New("no dir set")
+	}
+
+	onConflict, err := emojipack.ParseOnConflict(config.GetAdminEmojiOnConflict())
+	if err != nil {
+		return err
+	}
+
+	dryRun := config.GetAdminEmojiDryRun()
+
+	dbConn, err := bundb.NewBunDBService(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return fmt.Errorf("error creating storage backend: %w", err)
+	}
+
+	mediaManager, err := media.NewManager(dbConn, storage)
+	if err != nil {
+		return fmt.Errorf("error creating media manager: %s", err)
+	}
+
+	results, err := emojipack.NewImporter(dbConn, mediaManager).ImportDir(ctx, dir, config.GetAdminEmojiCategory(), onConflict, dryRun)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[emojipack.DirAction]int, 3)
+	for _, r := range results {
+		counts[r.Action]++
+		if r.Action == emojipack.DirActionSkip {
+			log.Infof("skip %s: %s", r.File, r.Reason)
+			continue
+		}
+		log.Infof("%s %s as :%s:", r.Action, r.File, r.Shortcode)
+	}
+
+	summary := fmt.Sprintf("%d added, %d replaced, %d skipped", counts[emojipack.DirActionAdd], counts[emojipack.DirActionReplace], counts[emojipack.DirActionSkip])
+	if dryRun {
+		log.Infof("dry run, nothing was changed: %s", summary)
+	} else {
+		log.Infof("added emojis from %s: %s", dir, summary)
+	}
+
+	if err := mediaManager.Stop(); err != nil {
+		return err
+	}
+
+	return dbConn.Stop(ctx)
+}
+
+// Export exports local emojis into an emoji pack archive.
+var Export action.GTSAction = func(ctx context.Context) error {
+	path := config.GetAdminTransPath()
+	if path == "" {
+		return errors.New("no path set")
+	}
+
+	dbConn, err := bundb.NewBunDBService(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return fmt.Errorf("error creating storage backend: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %s", path, err)
+	}
+	defer f.Close()
+
+	count, err := emojipack.NewExporter(dbConn, storage).Export(ctx, f, config.GetAdminEmojiCategory())
+	if err != nil {
+		return err
+	}
+	log.Infof("exported %d emojis to %s", count, path)
+
+	return dbConn.Stop(ctx)
+}
@@ -0,0 +1,492 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federation
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	gtsfederation "github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+const (
+	// checkTimeout is how long each step of the check may take.
+	checkTimeout = 20 * time.Second
+	// maxClockSkew is how far the remote clock may be from ours before it's
+	// reported; most implementations only accept signatures dated within
+	// a few minutes to a few hours of their own time.
+	maxClockSkew = 30 * time.Second
+	// certExpiryWarning is how soon before a certificate expires it's reported.
+	certExpiryWarning = 14 * 24 * time.Hour
+)
+
+// statusCodeRegexp picks the status code out of the errors returned by the transport.
+var statusCodeRegexp = regexp.MustCompile(`failed \((\d{3})\)`)
+
+// Check runs a series of federation checks against the given domain, and prints a diagnosis of
+// anything which looks wrong: DNS resolution, the TLS certificate, webfinger, fetching an actor
+// with and without a signature, and delivering a harmless signed activity to its inbox.
+//
+// The actor looked up is username@domain; if username is empty, domain@domain is used, which is
+// where GoToSocial, Mastodon and several others serve their instance actor.
+func Check(domain string, username string) action.GTSAction {
+	return func(ctx context.Context) error {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain == "" {
+			return errors.New("no domain given")
+		}
+		if username == "" {
+			username = domain
+		}
+
+		dbConn, err := bundb.NewBunDBService(ctx)
+		if err != nil {
+			return fmt.Errorf("error creating dbservice: %s", err)
+		}
+		defer func() {
+			_ = dbConn.Stop(ctx)
+		}()
+
+		instanceAccount, err := dbConn.GetInstanceAccount(ctx, "")
+		if err != nil {
+			return fmt.Errorf("error getting instance account: %s", err)
+		}
+
+		// nothing is received while this command runs, so the
+		// federator worker pool is never started or used
+		fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+		federatingDB := federatingdb.New(dbConn, fedWorker)
+		transportController := transport.NewController(dbConn, federatingDB, &gtsfederation.Clock{}, httpclient.New(httpclient.Config{}))
+		tp, err := transportController.NewTransport(instanceAccount.PublicKeyURI, instanceAccount.PrivateKey)
+		if err != nil {
+			return fmt.Errorf("error creating transport: %s", err)
+		}
+
+		c := &checker{
+			domain: domain,
+			client: &http.Client{Timeout: checkTimeout},
+		}
+
+		c.checkDNS(ctx)
+		c.checkTLS()
+		c.checkOwnKey(ctx, instanceAccount)
+		if actorIRI := c.checkWebfinger(ctx, tp, username); actorIRI != nil {
+			inbox := c.checkActor(ctx, actorIRI)
+			if signedInbox := c.checkSignedFetch(ctx, tp, actorIRI); inbox == nil {
+				inbox = signedInbox
+			}
+			c.checkDelivery(ctx, tp, instanceAccount, actorIRI, inbox)
+		}
+
+		c.printDiagnosis()
+		return nil
+	}
+}
+
+// checker keeps track of what's been found out about a remote domain.
+type checker struct {
+	domain    string
+	client    *http.Client
+	diagnosis []string
+}
+
+// ok prints that the given step passed.
+func (c *checker) ok(step string, format string, a ...interface{}) {
+	fmt.Printf("%-13s ok    %s\n", step+":", fmt.Sprintf(format, a...))
+}
+
+// warn prints that the given step passed, but found something worth knowing about.
+func (c *checker) warn(step string, diagnosis string, format string, a ...interface{}) {
+	fmt.Printf("%-13s warn  %s\n", step+":", fmt.Sprintf(format, a...))
+	c.diagnosis = append(c.diagnosis, diagnosis)
+}
+
+// fail prints that the given step failed, and remembers the likely cause.
+func (c *checker) fail(step string, diagnosis string, format string, a ...interface{}) {
+	fmt.Printf("%-13s FAIL  %s\n", step+":", fmt.Sprintf(format, a...))
+	c.diagnosis = append(c.diagnosis, diagnosis)
+}
+
+// skip prints that the given step couldn't be run because of an earlier failure.
+func (c *checker) skip(step string, reason string) {
+	fmt.Printf("%-13s skip  %s\n", step+":", reason)
+}
+
+func (c *checker) printDiagnosis() {
+	fmt.Println()
+	if len(c.diagnosis) == 0 {
+		fmt.Printf("everything looks fine for federating with %s\n", c.domain)
+		return
+	}
+
+	fmt.Println("diagnosis:")
+	for _, d := range c.diagnosis {
+		fmt.Printf("  - %s\n", d)
+	}
+}
+
+// host returns the domain without any port.
+func (c *checker) host() string {
+	if host, _, err := net.SplitHostPort(c.domain); err == nil {
+		return host
+	}
+	return c.domain
+}
+
+func (c *checker) checkDNS(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupHost(ctx, c.host())
+	if err != nil {
+		c.fail("dns", fmt.Sprintf("DNS: %s doesn't resolve from here; check the name is right, and that its DNS records exist", c.host()), "%s", err)
+		return
+	}
+
+	c.ok("dns", "%s resolves to %s", c.host(), strings.Join(addrs, ", "))
+}
+
+func (c *checker) checkTLS() {
+	addr := c.domain
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, "443")
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: checkTimeout}, "tcp", addr, &tls.Config{
+		ServerName: c.host(),
+		MinVersion: tls.VersionTLS12,
+	})
+	if err != nil {
+		var (
+			invalidErr   x509.CertificateInvalidError
+			hostnameErr  x509.HostnameError
+			authorityErr x509.UnknownAuthorityError
+		)
+		switch {
+		case errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired:
+			c.fail("tls", "TLS: the certificate has expired; it needs renewing", "%s", err)
+		case errors.As(err, &hostnameErr):
+			c.fail("tls", fmt.Sprintf("TLS: the certificate isn't valid for %s; check the reverse proxy serves the right certificate for this name", c.host()), "%s", err)
+		case errors.As(err, &authorityErr):
+			c.fail("tls", "TLS: the certificate isn't signed by an authority we trust; self-signed certificates don't work for federation, and the full chain must be served", "%s", err)
+		default:
+			c.fail("tls", fmt.Sprintf("TLS: couldn't make a https connection to %s; check it's listening on that port, and not blocked by a firewall", addr), "%s", err)
+		}
+		return
+	}
+	defer conn.Close()
+
+	cert := conn.ConnectionState().PeerCertificates[0]
+	if until := time.Until(cert.NotAfter); until < certExpiryWarning {
+		c.warn("tls", fmt.Sprintf("TLS: the certificate expires in %s; make sure it's being renewed", until.Round(time.Hour)), "certificate valid until %s", cert.NotAfter.Format(time.RFC3339))
+		return
+	}
+
+	c.ok("tls", "certificate issued by %s, valid until %s", cert.Issuer.CommonName, cert.NotAfter.Format(time.RFC3339))
+}
+
+// checkOwnKey checks that our own instance actor's key can be fetched from its public address, since
+// remote servers fetch it to check our signatures. It'll fail if this instance isn't running.
+func (c *checker) checkOwnKey(ctx context.Context, instanceAccount *gtsmodel.Account) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, instanceAccount.PublicKeyURI, nil)
+	if err != nil {
+		c.fail("own key", fmt.Sprintf("our own key id %s isn't a valid url; check the host setting", instanceAccount.PublicKeyURI), "%s", err)
+		return
+	}
+	req.Header.Set("Accept", "application/activity+json")
+
+	rsp, err := c.client.Do(req)
+	if err == nil {
+		rsp.Body.Close()
+		if rsp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("GET request to %s failed (%d): %s", instanceAccount.PublicKeyURI, rsp.StatusCode, rsp.Status)
+		}
+	}
+	if err != nil {
+		c.fail("own key", fmt.Sprintf("our own key at %s can't be fetched from here; remote servers need it to check our signatures, so check this instance is running and reachable at its host", instanceAccount.PublicKeyURI), "%s", err)
+		return
+	}
+
+	c.ok("own key", "%s is reachable", instanceAccount.PublicKeyURI)
+}
+
+// checkWebfinger looks up the given username on the remote domain, and returns the IRI of its actor.
+func (c *checker) checkWebfinger(ctx context.Context, tp transport.Transport, username string) *url.URL {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	b, err := tp.Finger(ctx, username, c.domain)
+	if err != nil {
+		c.fail("webfinger", fmt.Sprintf("webfinger: looking up %s@%s didn't work; check /.well-known/webfinger is passed through by the reverse proxy, or give the username of an account which exists", username, c.domain), "%s", err)
+		return nil
+	}
+
+	resp := &apimodel.WellKnownResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		c.fail("webfinger", "webfinger: the response isn't valid json", "%s", err)
+		return nil
+	}
+
+	for _, l := range resp.Links {
+		if l.Rel != "self" || (l.Type != "application/activity+json" && !strings.HasPrefix(l.Type, "application/ld+json")) {
+			continue
+		}
+
+		actorIRI, err := url.Parse(l.Href)
+		if err != nil {
+			c.fail("webfinger", "webfinger: the actor link isn't a valid url", "%s", err)
+			return nil
+		}
+
+		c.ok("webfinger", "%s@%s is %s", username, c.domain, actorIRI)
+		return actorIRI
+	}
+
+	c.fail("webfinger", "webfinger: the response has no activitypub actor link", "no self link in response for %s@%s", username, c.domain)
+	return nil
+}
+
+// checkActor fetches the actor without a signature, compares the remote clock with ours, and returns the actor's inbox, if it could be fetched.
+func (c *checker) checkActor(ctx context.Context, actorIRI *url.URL) *url.URL {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, actorIRI.String(), nil)
+	if err != nil {
+		c.fail("actor", "actor: the actor link isn't a valid url", "%s", err)
+		return nil
+	}
+	req.Header.Set("Accept", "application/activity+json")
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		c.fail("actor", fmt.Sprintf("actor: couldn't connect to %s", actorIRI.Host), "%s", err)
+		return nil
+	}
+	defer rsp.Body.Close()
+	received := time.Now()
+
+	c.checkClock(rsp, received)
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		// not a problem in itself, plenty of servers require signed fetches
+		c.ok("actor", "unsigned fetch refused (%d), so %s requires signed fetches", rsp.StatusCode, c.domain)
+		return nil
+	default:
+		c.fail("actor", fmt.Sprintf("actor: %s answered %s for its own actor", c.domain, rsp.Status), "GET request to %s failed (%d): %s", actorIRI, rsp.StatusCode, rsp.Status)
+		return nil
+	}
+
+	inbox, err := decodeInbox(json.NewDecoder(rsp.Body).Decode)
+	if err != nil {
+		c.fail("actor", "actor: the actor couldn't be understood as activitypub", "%s", err)
+		return nil
+	}
+
+	c.ok("actor", "fetched %s, inbox is %s", actorIRI, inbox)
+	return inbox
+}
+
+// checkClock compares the date of the given response with the time it was received.
+func (c *checker) checkClock(rsp *http.Response, received time.Time) {
+	date, err := http.ParseTime(rsp.Header.Get("Date"))
+	if err != nil {
+		c.warn("clock", "clock: the remote doesn't send a valid Date header, so its clock couldn't be compared with ours", "%s", err)
+		return
+	}
+
+	// dates only have second precision
+	skew := date.Sub(received.Truncate(time.Second))
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxClockSkew {
+		c.warn("clock", fmt.Sprintf("clock: the remote clock is %s off from ours; signatures are only accepted within a window around their date, so make sure both sides sync their clocks with ntp", skew), "remote date is %s, ours is %s", date.Format(time.RFC3339), received.UTC().Format(time.RFC3339))
+		return
+	}
+
+	c.ok("clock", "remote clock is within %s of ours", maxClockSkew)
+}
+
+// checkSignedFetch fetches the actor with a signature from our instance actor, and returns the actor's inbox, if it could be fetched.
+func (c *checker) checkSignedFetch(ctx context.Context, tp transport.Transport, actorIRI *url.URL) *url.URL {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	b, err := tp.Dereference(ctx, actorIRI)
+	if err != nil {
+		c.fail("signed fetch", signatureDiagnosis("signed fetch", err), "%s", err)
+		return nil
+	}
+
+	inbox, err := decodeInbox(func(v interface{}) error { return json.Unmarshal(b, v) })
+	if err != nil {
+		c.fail("signed fetch", "signed fetch: the actor couldn't be understood as activitypub", "%s", err)
+		return nil
+	}
+
+	c.ok("signed fetch", "fetched %s with a signature", actorIRI)
+	return inbox
+}
+
+// checkDelivery posts a signed Delete of a status which never existed to the given inbox. It's
+// an activity every implementation accepts, and ignores once it finds it knows nothing about it.
+func (c *checker) checkDelivery(ctx context.Context, tp transport.Transport, instanceAccount *gtsmodel.Account, actorIRI *url.URL, inbox *url.URL) {
+	if inbox == nil {
+		c.skip("delivery", "the actor's inbox couldn't be found")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	b, err := dummyDelete(instanceAccount, actorIRI)
+	if err != nil {
+		c.fail("delivery", "delivery: couldn't build the test activity", "%s", err)
+		return
+	}
+
+	if err := tp.Deliver(ctx, b, inbox); err != nil {
+		c.fail("delivery", signatureDiagnosis("delivery", err), "%s", err)
+		return
+	}
+
+	c.ok("delivery", "delivered a test activity to %s", inbox)
+}
+
+// signatureDiagnosis explains the likely cause of an error from a signed request.
+func signatureDiagnosis(step string, err error) string {
+	var code int
+	if m := statusCodeRegexp.FindStringSubmatch(err.Error()); m != nil {
+		code, _ = strconv.Atoi(m[1])
+	}
+
+	switch code {
+	case http.StatusUnauthorized:
+		return step + ": our signature was refused; usually the remote couldn't fetch our key, or our clocks differ too much"
+	case http.StatusForbidden:
+		return step + ": the request was forbidden; this instance, or its instance actor, may be blocked there"
+	case http.StatusNotFound, http.StatusGone:
+		return step + ": the remote doesn't know the actor or inbox any more"
+	case 0:
+		return step + ": the request didn't get an answer; check the remote is up, and reachable from here"
+	default:
+		return fmt.Sprintf("%s: the remote answered with an unexpected %d", step, code)
+	}
+}
+
+// decodeInbox decodes an actor with the given decode function, and returns its shared inbox if it has one, or its own inbox otherwise.
+func decodeInbox(decode func(v interface{}) error) (*url.URL, error) {
+	actor := struct {
+		Inbox     string `json:"inbox"`
+		Endpoints struct {
+			SharedInbox string `json:"sharedInbox"`
+		} `json:"endpoints"`
+	}{}
+
+	if err := decode(&actor); err != nil {
+		return nil, err
+	}
+
+	inbox := actor.Endpoints.SharedInbox
+	if inbox == "" {
+		inbox = actor.Inbox
+	}
+	if inbox == "" {
+		return nil, errors.New("actor has no inbox")
+	}
+
+	return url.Parse(inbox)
+}
+
+// dummyDelete returns the json of a Delete, from the given account to the given actor, of a status which never existed.
+func dummyDelete(account *gtsmodel.Account, to *url.URL) ([]byte, error) {
+	checkID, err := id.NewULID()
+	if err != nil {
+		return nil, err
+	}
+
+	actorIRI, err := url.Parse(account.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteIRI, err := url.Parse(account.URI + "#federation-check/" + checkID)
+	if err != nil {
+		return nil, err
+	}
+
+	objectIRI, err := url.Parse(uris.GenerateURIsForAccount(account.Username).StatusesURI + "/" + checkID)
+	if err != nil {
+		return nil, err
+	}
+
+	del := streams.NewActivityStreamsDelete()
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(deleteIRI)
+	del.SetJSONLDId(idProp)
+
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(actorIRI)
+	del.SetActivityStreamsActor(actorProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(objectIRI)
+	del.SetActivityStreamsObject(objectProp)
+
+	toProp := streams.NewActivityStreamsToProperty()
+	toProp.AppendIRI(to)
+	del.SetActivityStreamsTo(toProp)
+
+	m, err := streams.Serialize(del)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(m)
+}
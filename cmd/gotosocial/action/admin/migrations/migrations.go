@@ -0,0 +1,135 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Status prints every known database migration, and whether it has been applied yet.
+var Status action.GTSAction = func(ctx context.Context) error {
+	migrator, err := bundb.NewMigrator(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating migrator: %s", err)
+	}
+	defer migrator.Close()
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting migration status: %s", err)
+	}
+
+	pending := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MIGRATION\tSTATUS\tMIGRATED AT")
+	for _, s := range statuses {
+		if !s.Applied {
+			pending++
+			fmt.Fprintf(w, "%s\tpending\t\n", s.Name)
+			continue
+		}
+		fmt.Fprintf(w, "%s\tapplied\t%s\n", s.Name, util.FormatISO8601(s.MigratedAt))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d migrations, %d pending\n", len(statuses), pending)
+	return nil
+}
+
+// Up runs all pending database migrations.
+var Up action.GTSAction = func(ctx context.Context) error {
+	migrator, err := bundb.NewMigrator(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating migrator: %s", err)
+	}
+	defer migrator.Close()
+
+	names, err := migrator.Up(ctx)
+	if err != nil {
+		if len(names) != 0 {
+			return fmt.Errorf("error running migration %s: %s", names[len(names)-1], err)
+		}
+		return fmt.Errorf("error running migrations: %s", err)
+	}
+
+	if len(names) == 0 {
+		log.Info("there are no pending migrations to run")
+		return nil
+	}
+
+	for _, name := range names {
+		log.Infof("applied migration %s", name)
+	}
+
+	return nil
+}
+
+// Down rolls back the most recently applied database migration.
+var Down action.GTSAction = func(ctx context.Context) error {
+	migrator, err := bundb.NewMigrator(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating migrator: %s", err)
+	}
+	defer migrator.Close()
+
+	name, err := migrator.Down(ctx)
+	if err != nil {
+		if name != "" {
+			return fmt.Errorf("error rolling back migration %s: %s", name, err)
+		}
+		return fmt.Errorf("error rolling back migration: %s", err)
+	}
+
+	if name == "" {
+		log.Info("there are no applied migrations to roll back")
+		return nil
+	}
+
+	log.Infof("rolled back migration %s; unless %s is set, it will be run again the next time the server starts", name, config.DbSkipMigrationsFlag())
+	return nil
+}
+
+// MarkApplied marks one pending database migration as applied, without running it.
+var MarkApplied action.GTSAction = func(ctx context.Context) error {
+	name := config.GetAdminMigrationName()
+
+	migrator, err := bundb.NewMigrator(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating migrator: %s", err)
+	}
+	defer migrator.Close()
+
+	if err := migrator.MarkApplied(ctx, name); err != nil {
+		return fmt.Errorf("error marking migration %s as applied: %s", name, err)
+	}
+
+	log.Infof("marked migration %s as applied", name)
+	return nil
+}
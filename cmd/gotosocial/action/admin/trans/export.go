@@ -43,7 +43,7 @@ var Export action.GTSAction = func(ctx context.Context) error {
 		return errors.New("no path set")
 	}
 
-	if err := exporter.ExportMinimal(ctx, path); err != nil {
+	if err := exporter.ExportFull(ctx, path); err != nil {
 		return err
 	}
 
@@ -32,15 +32,21 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/app"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/auth"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/blocks"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/bookmarks"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/clientsettings"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/domainblocks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/emoji"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/favourites"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/fileserver"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/filter"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/followrequest"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/instance"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/list"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/mailgateway"
 	mediaModule "github.com/superseriousbusiness/gotosocial/internal/api/client/media"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/notification"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/polls"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/search"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/status"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/streaming"
@@ -173,7 +179,13 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	securityModule := security.New(dbService, oauthServer)
 	streamingModule := streaming.New(processor)
 	favouritesModule := favourites.New(processor)
+	bookmarksModule := bookmarks.New(processor)
 	blocksModule := blocks.New(processor)
+	domainBlocksModule := domainblocks.New(processor)
+	featuredTagsModule := featuredtags.New(processor)
+	clientSettingsModule := clientsettings.New(processor)
+	pollsModule := polls.New(processor)
+	mailGatewayModule := mailgateway.New(processor)
 	userClientModule := userClient.New(processor)
 
 	apis := []api.ClientModule{
@@ -204,7 +216,13 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		listsModule,
 		streamingModule,
 		favouritesModule,
+		bookmarksModule,
 		blocksModule,
+		domainBlocksModule,
+		featuredTagsModule,
+		clientSettingsModule,
+		pollsModule,
+		mailGatewayModule,
 		userClientModule,
 	}
 
@@ -214,7 +232,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		}
 	}
 
-	gts, err := gotosocial.NewServer(dbService, router, federator, mediaManager)
+	gts, err := gotosocial.NewServer(dbService, router, federator, mediaManager, processor)
 	if err != nil {
 		return fmt.Errorf("error creating gotosocial service: %s", err)
 	}
@@ -21,6 +21,11 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/account"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/database"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/domain"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/emoji"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/federation"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/migrations"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/trans"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
@@ -120,6 +125,19 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountRecoveryCodesCmd := &cobra.Command{
+		Use:   "recovery-codes",
+		Short: "generate new recovery codes for the given local account, for signing in when the oidc provider is unavailable",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.RecoveryCodes)
+		},
+	}
+	config.AddAdminAccount(adminAccountRecoveryCodesCmd)
+	adminAccountCmd.AddCommand(adminAccountRecoveryCodesCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -152,5 +170,223 @@ func adminCommands() *cobra.Command {
 	config.AddAdminTrans(adminImportCmd)
 	adminCmd.AddCommand(adminImportCmd)
 
+	/*
+	   ADMIN EMOJI COMMANDS
+	*/
+
+	adminEmojiCmd := &cobra.Command{
+		Use:   "emoji",
+		Short: "admin commands related to local (this instance) custom emojis",
+	}
+
+	adminEmojiImportCmd := &cobra.Command{
+		Use:   "import",
+		Short: "import the emojis of a pleroma/akkoma-style emoji pack archive at the given path",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), emoji.Import)
+		},
+	}
+	config.AddAdminEmoji(adminEmojiImportCmd)
+	adminEmojiCmd.AddCommand(adminEmojiImportCmd)
+
+	adminEmojiExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "export local emojis to a pleroma/akkoma-style emoji pack archive at the given path",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), emoji.Export)
+		},
+	}
+	config.AddAdminEmoji(adminEmojiExportCmd)
+	adminEmojiCmd.AddCommand(adminEmojiExportCmd)
+
+	adminEmojiAddCmd := &cobra.Command{
+		Use:   "add",
+		Short: "add the png, gif and svg images in the given directory as emojis, with shortcodes derived from their file names",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), emoji.Add)
+		},
+	}
+	config.AddAdminEmojiAdd(adminEmojiAddCmd)
+	adminEmojiCmd.AddCommand(adminEmojiAddCmd)
+
+	adminCmd.AddCommand(adminEmojiCmd)
+
+	/*
+	   ADMIN DOMAIN COMMANDS
+	*/
+
+	adminDomainCmd := &cobra.Command{
+		Use:   "domain",
+		Short: "admin commands related to the domain this instance is served from",
+	}
+
+	adminDomainRenameCmd := &cobra.Command{
+		Use:   "rename",
+		Short: "rewrite local uris from the given old host to the configured host, and notify remote instances; read the docs first, this cannot be undone",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), domain.Rename)
+		},
+	}
+	config.AddAdminDomainRename(adminDomainRenameCmd)
+	adminDomainCmd.AddCommand(adminDomainRenameCmd)
+
+	adminCmd.AddCommand(adminDomainCmd)
+
+	/*
+	   ADMIN FEDERATION COMMANDS
+	*/
+
+	adminFederationCmd := &cobra.Command{
+		Use:   "federation",
+		Short: "admin commands related to federating with other instances",
+	}
+
+	adminFederationCheckCmd := &cobra.Command{
+		Use:   "check <domain> [username]",
+		Short: "check federation with the given domain works, by looking up, fetching and delivering to one of its accounts (by default its instance actor), and print a diagnosis of anything that doesn't",
+		Args:  cobra.RangeArgs(1, 2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var username string
+			if len(args) > 1 {
+				username = args[1]
+			}
+			return run(cmd.Context(), federation.Check(args[0], username))
+		},
+	}
+	adminFederationCmd.AddCommand(adminFederationCheckCmd)
+
+	adminCmd.AddCommand(adminFederationCmd)
+
+	/*
+	   ADMIN DATABASE COMMANDS
+	*/
+
+	adminDatabaseCmd := &cobra.Command{
+		Use:   "database",
+		Short: "admin commands related to the database",
+	}
+
+	adminDatabaseMaintainCmd := &cobra.Command{
+		Use:   "maintain",
+		Short: "run database maintenance now, analyzing tables and (for sqlite, if db-maintenance-vacuum is set) vacuuming to reclaim space; stop the server first when vacuuming sqlite",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), database.Maintain)
+		},
+	}
+	adminDatabaseCmd.AddCommand(adminDatabaseMaintainCmd)
+
+	adminDatabaseIDsCmd := &cobra.Command{
+		Use:   "ids",
+		Short: "admin commands for checking and fixing the ordering of database ids, eg., after the system clock jumped",
+	}
+
+	adminDatabaseIDsAuditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "report rows whose ids don't match the time they were created, which can make them appear out of order when paging through timelines and notifications",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), database.AuditIDs)
+		},
+	}
+	config.AddAdminIDs(adminDatabaseIDsAuditCmd)
+	adminDatabaseIDsCmd.AddCommand(adminDatabaseIDsAuditCmd)
+
+	adminDatabaseIDsResequenceCmd := &cobra.Command{
+		Use:   "resequence",
+		Short: "give new ids to the rows reported by audit, where this is safe; stop the server first and back up your database",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), database.ResequenceIDs)
+		},
+	}
+	config.AddAdminIDs(adminDatabaseIDsResequenceCmd)
+	adminDatabaseIDsCmd.AddCommand(adminDatabaseIDsResequenceCmd)
+
+	adminDatabaseCmd.AddCommand(adminDatabaseIDsCmd)
+
+	adminCmd.AddCommand(adminDatabaseCmd)
+
+	/*
+	   ADMIN MIGRATIONS COMMANDS
+	*/
+
+	adminMigrationsCmd := &cobra.Command{
+		Use:   "migrations",
+		Short: "admin commands for inspecting and running database migrations separately from server start",
+	}
+
+	adminMigrationsStatusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "list all database migrations, and whether each has been applied yet",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), migrations.Status)
+		},
+	}
+	adminMigrationsCmd.AddCommand(adminMigrationsStatusCmd)
+
+	adminMigrationsUpCmd := &cobra.Command{
+		Use:   "up",
+		Short: "run all pending database migrations",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), migrations.Up)
+		},
+	}
+	adminMigrationsCmd.AddCommand(adminMigrationsUpCmd)
+
+	adminMigrationsDownCmd := &cobra.Command{
+		Use:   "down",
+		Short: "roll back the most recently applied database migration; most migrations don't undo their changes, so this mostly just marks the migration as pending again",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), migrations.Down)
+		},
+	}
+	adminMigrationsCmd.AddCommand(adminMigrationsDownCmd)
+
+	adminMigrationsMarkAppliedCmd := &cobra.Command{
+		Use:   "mark-applied",
+		Short: "mark a pending database migration as applied without running it, for when its changes have already been made by hand",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), migrations.MarkApplied)
+		},
+	}
+	config.AddAdminMigrationsMarkApplied(adminMigrationsMarkAppliedCmd)
+	adminMigrationsCmd.AddCommand(adminMigrationsMarkAppliedCmd)
+
+	adminCmd.AddCommand(adminMigrationsCmd)
+
 	return adminCmd
 }
@@ -49,6 +49,7 @@
 //	      write:blocks: grants write access to blocks
 //	      write:follows: grants write access to follows
 //	      write:media: grants write access to media
+//	      write:mutes: grants write access to mutes
 //	      write:statuses: grants write access to statuses
 //	      write:user: grants write access to user-level info
 //	      admin: grants admin access to everything
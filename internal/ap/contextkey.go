@@ -34,4 +34,7 @@ const (
 	ContextRequestingPublicKeyVerifier ContextKey = "requestingPublicKeyVerifier"
 	// ContextRequestingPublicKeySignature can be used to set and retrieve the value of the signature header of an incoming federation request.
 	ContextRequestingPublicKeySignature ContextKey = "requestingPublicKeySignature"
+	// ContextDeliveringStatusID can be used to set and retrieve the ID of a local status which an outgoing activity
+	// delivers, so that the outcome of delivering it can be recorded for the author.
+	ContextDeliveringStatusID ContextKey = "deliveringStatusID"
 )
@@ -710,6 +710,107 @@ func ExtractSensitive(withSensitive WithSensitive) bool {
 	return false
 }
 
+// ExtractPollOptions extracts the titles and vote counts of the options of a poll,
+// and whether the poll allows multiple choices. Single choice polls list their
+// options under oneOf, and multiple choice polls list them under anyOf.
+func ExtractPollOptions(i Pollable) (titles []string, votes []int, multiple bool, err error) {
+	options := []PollOptionable{}
+
+	if oneOfProp := i.GetActivityStreamsOneOf(); oneOfProp != nil {
+		for iter := oneOfProp.Begin(); iter != oneOfProp.End(); iter = iter.Next() {
+			if iter.IsActivityStreamsNote() {
+				options = append(options, iter.GetActivityStreamsNote())
+			}
+		}
+	}
+
+	if len(options) == 0 {
+		if anyOfProp := i.GetActivityStreamsAnyOf(); anyOfProp != nil {
+			for iter := anyOfProp.Begin(); iter != anyOfProp.End(); iter = iter.Next() {
+				if iter.IsActivityStreamsNote() {
+					options = append(options, iter.GetActivityStreamsNote())
+				}
+			}
+		}
+		multiple = true
+	}
+
+	if len(options) < 2 {
+		return nil, nil, false, fmt.Errorf("poll had %d options, at least 2 are needed", len(options))
+	}
+
+	for _, option := range options {
+		title, err := ExtractName(option)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("error extracting poll option title: %s", err)
+		}
+		titles = append(titles, title)
+		votes = append(votes, extractTotalReplies(option))
+	}
+
+	return titles, votes, multiple, nil
+}
+
+// extractTotalReplies returns the totalItems of the replies collection of
+// the given interface, which is how poll options carry their vote counts.
+// If there's no such collection, 0 is returned.
+func extractTotalReplies(i WithReplies) int {
+	repliesProp := i.GetActivityStreamsReplies()
+	if repliesProp == nil || !repliesProp.IsActivityStreamsCollection() {
+		return 0
+	}
+
+	totalItemsProp := repliesProp.GetActivityStreamsCollection().GetActivityStreamsTotalItems()
+	if totalItemsProp == nil || !totalItemsProp.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+
+	return totalItemsProp.Get()
+}
+
+// ExtractPollEndTime extracts when a poll ends or ended. This is its endTime if
+// that's set, or else the time it was closed at. If neither is set, the poll
+// doesn't end, and the zero time is returned.
+func ExtractPollEndTime(i Pollable) time.Time {
+	if endTimeProp := i.GetActivityStreamsEndTime(); endTimeProp != nil && endTimeProp.IsXMLSchemaDateTime() {
+		return endTimeProp.Get()
+	}
+
+	closedAt, _ := ExtractPollClosed(i)
+	return closedAt
+}
+
+// ExtractPollClosed extracts whether a poll has been closed, and if so, when. Some
+// implementations only set closed to true, in which case the zero time is returned.
+func ExtractPollClosed(i WithClosed) (time.Time, bool) {
+	closedProp := i.GetActivityStreamsClosed()
+	if closedProp == nil {
+		return time.Time{}, false
+	}
+
+	for iter := closedProp.Begin(); iter != closedProp.End(); iter = iter.Next() {
+		switch {
+		case iter.IsXMLSchemaDateTime():
+			return iter.GetXMLSchemaDateTime(), true
+		case iter.IsXMLSchemaBoolean() && iter.GetXMLSchemaBoolean():
+			return time.Time{}, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// ExtractVotersCount extracts the number of accounts that have voted in a poll.
+// If the property isn't set, 0 is returned.
+func ExtractVotersCount(i WithVotersCount) int {
+	votersCountProp := i.GetTootVotersCount()
+	if votersCountProp == nil || !votersCountProp.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+
+	return votersCountProp.Get()
+}
+
 // ExtractSharedInbox extracts the sharedInbox URI properly from an Actor.
 // Returns nil if this property is not set.
 func ExtractSharedInbox(withEndpoints WithEndpoints) *url.URL {
@@ -0,0 +1,123 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ap_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type ExtractPollTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ExtractPollTestSuite) pollable(rawJSON string) ap.Pollable {
+	var jsonAsMap map[string]interface{}
+	err := json.Unmarshal([]byte(rawJSON), &jsonAsMap)
+	suite.NoError(err)
+
+	t, err := streams.ToType(context.Background(), jsonAsMap)
+	suite.NoError(err)
+
+	pollable, ok := t.(ap.Pollable)
+	suite.True(ok)
+	return pollable
+}
+
+func (suite *ExtractPollTestSuite) TestExtractPollOneOf() {
+	pollable := suite.pollable(`{
+	"@context": ["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#", "votersCount": "toot:votersCount"}],
+	"id": "https://example.org/users/someone/statuses/109435290283592000",
+	"type": "Question",
+	"attributedTo": "https://example.org/users/someone",
+	"content": "which is better?",
+	"endTime": "2022-12-01T12:00:00Z",
+	"votersCount": 5,
+	"oneOf": [
+		{"type": "Note", "name": "cats", "replies": {"type": "Collection", "totalItems": 3}},
+		{"type": "Note", "name": "dogs", "replies": {"type": "Collection", "totalItems": 2}}
+	]
+}`)
+
+	titles, votes, multiple, err := ap.ExtractPollOptions(pollable)
+	suite.NoError(err)
+	suite.Equal([]string{"cats", "dogs"}, titles)
+	suite.Equal([]int{3, 2}, votes)
+	suite.False(multiple)
+
+	suite.Equal(time.Date(2022, 12, 1, 12, 0, 0, 0, time.UTC), ap.ExtractPollEndTime(pollable).UTC())
+	suite.Equal(5, ap.ExtractVotersCount(pollable))
+
+	_, closed := ap.ExtractPollClosed(pollable)
+	suite.False(closed)
+}
+
+func (suite *ExtractPollTestSuite) TestExtractPollAnyOfClosed() {
+	pollable := suite.pollable(`{
+	"@context": "https://www.w3.org/ns/activitystreams",
+	"id": "https://example.org/users/someone/statuses/109435290283592001",
+	"type": "Question",
+	"attributedTo": "https://example.org/users/someone",
+	"content": "pick any",
+	"closed": "2022-12-02T08:30:00Z",
+	"anyOf": [
+		{"type": "Note", "name": "tea"},
+		{"type": "Note", "name": "coffee"},
+		{"type": "Note", "name": "water"}
+	]
+}`)
+
+	titles, votes, multiple, err := ap.ExtractPollOptions(pollable)
+	suite.NoError(err)
+	suite.Equal([]string{"tea", "coffee", "water"}, titles)
+	suite.Equal([]int{0, 0, 0}, votes)
+	suite.True(multiple)
+
+	closedAt, closed := ap.ExtractPollClosed(pollable)
+	suite.True(closed)
+	suite.Equal(time.Date(2022, 12, 2, 8, 30, 0, 0, time.UTC), closedAt.UTC())
+
+	// no endTime, so the closed time is used instead
+	suite.Equal(closedAt, ap.ExtractPollEndTime(pollable))
+}
+
+func (suite *ExtractPollTestSuite) TestExtractPollTooFewOptions() {
+	pollable := suite.pollable(`{
+	"@context": "https://www.w3.org/ns/activitystreams",
+	"id": "https://example.org/users/someone/statuses/109435290283592002",
+	"type": "Question",
+	"attributedTo": "https://example.org/users/someone",
+	"oneOf": [
+		{"type": "Note", "name": "the only choice"}
+	]
+}`)
+
+	_, _, _, err := ap.ExtractPollOptions(pollable)
+	suite.EqualError(err, "poll had 1 options, at least 2 are needed")
+}
+
+func TestExtractPollTestSuite(t *testing.T) {
+	suite.Run(t, &ExtractPollTestSuite{})
+}
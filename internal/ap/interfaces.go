@@ -45,8 +45,9 @@ type Accountable interface {
 }
 
 // Statusable represents the minimum activitypub interface for representing a 'status'.
-// This interface is fulfilled by: Article, Document, Image, Video, Note, Page, Event, Place, Mention, Profile
+// This interface is fulfilled by: Article, Document, Image, Video, Note, Page, Event, Place, Mention, Profile, Question
 type Statusable interface {
+	vocab.Type
 	WithJSONLDId
 	WithTypeName
 
@@ -65,6 +66,26 @@ type Statusable interface {
 	WithReplies
 }
 
+// Pollable represents the minimum activitypub interface for representing a 'poll'.
+// This interface is fulfilled by: Question
+type Pollable interface {
+	Statusable
+
+	WithOneOf
+	WithAnyOf
+	WithEndTime
+	WithClosed
+	WithVotersCount
+}
+
+// PollOptionable represents the minimum activitypub interface for representing a single option of a 'poll'.
+// This interface is fulfilled by: Note
+type PollOptionable interface {
+	WithTypeName
+	WithName
+	WithReplies
+}
+
 // Attachmentable represents the minimum activitypub interface for representing a 'mediaAttachment'.
 // This interface is fulfilled by: Audio, Document, Image, Video
 type Attachmentable interface {
@@ -286,6 +307,31 @@ type WithReplies interface {
 	GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty
 }
 
+// WithOneOf represents an activity with ActivityStreamsOneOfProperty
+type WithOneOf interface {
+	GetActivityStreamsOneOf() vocab.ActivityStreamsOneOfProperty
+}
+
+// WithAnyOf represents an activity with ActivityStreamsAnyOfProperty
+type WithAnyOf interface {
+	GetActivityStreamsAnyOf() vocab.ActivityStreamsAnyOfProperty
+}
+
+// WithEndTime represents an activity with ActivityStreamsEndTimeProperty
+type WithEndTime interface {
+	GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty
+}
+
+// WithClosed represents an activity with ActivityStreamsClosedProperty
+type WithClosed interface {
+	GetActivityStreamsClosed() vocab.ActivityStreamsClosedProperty
+}
+
+// WithVotersCount represents an activity with TootVotersCountProperty
+type WithVotersCount interface {
+	GetTootVotersCount() vocab.TootVotersCountProperty
+}
+
 // WithMediaType represents an activity with ActivityStreamsMediaTypeProperty
 type WithMediaType interface {
 	GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
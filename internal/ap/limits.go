@@ -0,0 +1,86 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ap
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// CheckJSONLimits streams through the given json document without building
+// it in memory, and returns an error if objects and arrays are nested deeper
+// than maxDepth, or if any array has more than maxArrayLength entries.
+//
+// This lets incoming activities crafted to use up memory or cpu while being
+// parsed be rejected before they're parsed for real. A maxDepth or
+// maxArrayLength of 0 or less means no limit.
+func CheckJSONLimits(body []byte, maxDepth int, maxArrayLength int) error {
+	if maxDepth <= 0 && maxArrayLength <= 0 {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+
+	// lengths has one entry per open object or array;
+	// for arrays it counts the entries seen so far,
+	// for objects it's always -1
+	lengths := []int{}
+
+	for {
+		t, err := dec.Token()
+		if errors.Is(err, io.EOF) && len(lengths) == 0 {
+			return nil
+		}
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return fmt.Errorf("CheckJSONLimits: error parsing json: %w", err)
+		}
+
+		delim, isDelim := t.(json.Delim)
+		if isDelim && (delim == '}' || delim == ']') {
+			lengths = lengths[:len(lengths)-1]
+			continue
+		}
+
+		// every other token is a value in an array, a key or value in an
+		// object, or a top level value; only array entries are counted
+		if l := len(lengths); l > 0 && lengths[l-1] >= 0 {
+			lengths[l-1]++
+			if maxArrayLength > 0 && lengths[l-1] > maxArrayLength {
+				return fmt.Errorf("CheckJSONLimits: array has more than %d entries", maxArrayLength)
+			}
+		}
+
+		if isDelim {
+			if maxDepth > 0 && len(lengths) >= maxDepth {
+				return fmt.Errorf("CheckJSONLimits: objects and arrays are nested more than %d deep", maxDepth)
+			}
+			if delim == '[' {
+				lengths = append(lengths, 0)
+			} else {
+				lengths = append(lengths, -1)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ap_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type LimitsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LimitsTestSuite) TestCheckJSONLimitsOK() {
+	body := `{"@context":"https://www.w3.org/ns/activitystreams","type":"Create","to":["a","b","c"],"object":{"type":"Note","tag":[{"type":"Mention"},{"type":"Hashtag"}]}}`
+	suite.NoError(ap.CheckJSONLimits([]byte(body), 4, 3))
+}
+
+func (suite *LimitsTestSuite) TestCheckJSONLimitsTooDeep() {
+	body := `{"object":{"tag":[{"type":"Mention"}]}}`
+	suite.NoError(ap.CheckJSONLimits([]byte(body), 4, 0))
+	suite.EqualError(ap.CheckJSONLimits([]byte(body), 3, 0), "CheckJSONLimits: objects and arrays are nested more than 3 deep")
+}
+
+func (suite *LimitsTestSuite) TestCheckJSONLimitsDeeplyNestedArrays() {
+	body := strings.Repeat("[", 10000) + strings.Repeat("]", 10000)
+	suite.EqualError(ap.CheckJSONLimits([]byte(body), 32, 0), "CheckJSONLimits: objects and arrays are nested more than 32 deep")
+}
+
+func (suite *LimitsTestSuite) TestCheckJSONLimitsArrayTooLong() {
+	body := `{"to":["a","b",{"c":["d","e","f","g"]}],"cc":["a","b","c","d"]}`
+	suite.NoError(ap.CheckJSONLimits([]byte(body), 0, 4))
+	suite.EqualError(ap.CheckJSONLimits([]byte(body), 0, 3), "CheckJSONLimits: array has more than 3 entries")
+}
+
+func (suite *LimitsTestSuite) TestCheckJSONLimitsObjectKeysNotCounted() {
+	body := `{"a":1,"b":2,"c":3,"d":4,"e":5}`
+	suite.NoError(ap.CheckJSONLimits([]byte(body), 1, 1))
+}
+
+func (suite *LimitsTestSuite) TestCheckJSONLimitsNoLimits() {
+	body := strings.Repeat("[", 100) + strings.Repeat("]", 100)
+	suite.NoError(ap.CheckJSONLimits([]byte(body), 0, 0))
+}
+
+func (suite *LimitsTestSuite) TestCheckJSONLimitsInvalid() {
+	suite.Error(ap.CheckJSONLimits([]byte(`{"type":`), 32, 1000))
+}
+
+func TestLimitsTestSuite(t *testing.T) {
+	suite.Run(t, &LimitsTestSuite{})
+}
@@ -46,6 +46,10 @@ const (
 	OnlyMediaKey = "only_media"
 	// OnlyPublicKey is for specifying that only statuses with visibility public should be returned in a list of returned statuses by account.
 	OnlyPublicKey = "only_public"
+	// DaysKey is for specifying how many days of account stats should be returned.
+	DaysKey = "days"
+	// SearchQueryKey is for specifying the start of the username or display name of accounts to search for.
+	SearchQueryKey = "q"
 
 	// IDKey is the key to use for retrieving account ID in requests
 	IDKey = "id"
@@ -63,6 +67,8 @@ const (
 	GetFollowersPath = BasePathWithID + "/followers"
 	// GetFollowingPath is for showing account's that an account follows.
 	GetFollowingPath = BasePathWithID + "/following"
+	// GetFeaturedTagsPath is for showing the hashtags featured by an account
+	GetFeaturedTagsPath = BasePathWithID + "/featured_tags"
 	// GetRelationshipsPath is for showing an account's relationship with other accounts
 	GetRelationshipsPath = BasePath + "/relationships"
 	// FollowPath is for POSTing new follows to, and updating existing follows
@@ -75,6 +81,10 @@ const (
 	UnblockPath = BasePathWithID + "/unblock"
 	// DeleteAccountPath is for deleting one's account via the API
 	DeleteAccountPath = BasePath + "/delete"
+	// StatsPath is for showing one's own posting and follower stats
+	StatsPath = BasePath + "/stats"
+	// SearchPath is for autocompleting accounts to mention
+	SearchPath = BasePath + "/search"
 )
 
 // Module implements the ClientAPIModule interface for account-related actions
@@ -110,9 +120,18 @@ func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodGet, GetFollowersPath, m.AccountFollowersGETHandler)
 	r.AttachHandler(http.MethodGet, GetFollowingPath, m.AccountFollowingGETHandler)
 
+	// get account's featured hashtags
+	r.AttachHandler(http.MethodGet, GetFeaturedTagsPath, m.AccountFeaturedTagsGETHandler)
+
 	// get relationship with account
 	r.AttachHandler(http.MethodGet, GetRelationshipsPath, m.AccountRelationshipsGETHandler)
 
+	// get own stats
+	r.AttachHandler(http.MethodGet, StatsPath, m.AccountStatsGETHandler)
+
+	// search accounts to mention
+	r.AttachHandler(http.MethodGet, SearchPath, m.AccountSearchGETHandler)
+
 	// follow or unfollow account
 	r.AttachHandler(http.MethodPost, FollowPath, m.AccountFollowPOSTHandler)
 	r.AttachHandler(http.MethodPost, UnfollowPath, m.AccountUnfollowPOSTHandler)
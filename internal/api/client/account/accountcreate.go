@@ -68,6 +68,10 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: >-
+//				The given link could not be fetched, or didn't link back
+//				to the new account with rel="me".
 //		'500':
 //			description: internal server error
 func (m *Module) AccountCreatePOSTHandler(c *gin.Context) {
@@ -130,6 +134,10 @@ func validateCreateAccount(form *model.AccountCreateRequest) error {
 		return err
 	}
 
+	if err := validate.SignUpEmailDomain(form.Email, config.GetAccountsSignupEmailDomains()); err != nil {
+		return err
+	}
+
 	if err := validate.NewPassword(form.Password); err != nil {
 		return err
 	}
@@ -146,5 +154,9 @@ func validateCreateAccount(form *model.AccountCreateRequest) error {
 		return err
 	}
 
+	if err := validate.SignUpLink(form.Link, config.GetAccountsSignupLinkDomains()); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -99,11 +99,60 @@ import (
 //		description: Default language to use for authored statuses (ISO 6391).
 //		type: string
 //	-
+//		name: source[locale]
+//		in: formData
+//		description: Language to show web pages and error messages in (ISO 6391). Empty to let the browser or instance decide.
+//		type: string
+//	-
 //		name: source[status_format]
 //		in: formData
 //		description: Default format to use for authored statuses (plain or markdown).
 //		type: string
 //	-
+//		name: source[followers_only_boostable]
+//		in: formData
+//		description: Allow followers to boost authored followers-only statuses to their own followers.
+//		type: boolean
+//	-
+//		name: source[mention_policy]
+//		in: formData
+//		description: >-
+//			Which accounts may mention you: `everyone`, `following` (only accounts you follow), or `nobody`.
+//			Mentions from other accounts are dropped, and won't create notifications.
+//		type: string
+//	-
+//		name: source[web_hide_boosts]
+//		in: formData
+//		description: Hide your boosts on your public web profile.
+//		type: boolean
+//	-
+//		name: source[web_hide_replies]
+//		in: formData
+//		description: Hide your replies on your public web profile.
+//		type: boolean
+//	-
+//		name: source[web_pinned_first]
+//		in: formData
+//		description: Show your pinned statuses above your other statuses on your public web profile.
+//		type: boolean
+//	-
+//		name: source[web_media_tab]
+//		in: formData
+//		description: Show a media-only gallery tab on your public web profile.
+//		type: boolean
+//	-
+//		name: source[hide_mirrors]
+//		in: formData
+//		description: Hide statuses from bot accounts, and accounts flagged by an admin as mirrors of external feeds, in the public timelines.
+//		type: boolean
+//	-
+//		name: source[notifications_retention_days]
+//		in: formData
+//		description: >-
+//			Delete read notifications after this many days.
+//			0 keeps them forever, and -1 goes back to using the instance default.
+//		type: integer
+//	-
 //		name: custom_css
 //		in: formData
 //		description: >-
@@ -194,6 +243,66 @@ func parseUpdateAccountForm(c *gin.Context) (*model.UpdateCredentialsRequest, er
 		form.Source.StatusFormat = &statusFormat
 	}
 
+	if followersOnlyBoostable, ok := sourceMap["followers_only_boostable"]; ok {
+		followersOnlyBoostableBool, err := strconv.ParseBool(followersOnlyBoostable)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing form source[followers_only_boostable]: %s", err)
+		}
+		form.Source.FollowersOnlyBoostable = &followersOnlyBoostableBool
+	}
+
+	if mentionPolicy, ok := sourceMap["mention_policy"]; ok {
+		form.Source.MentionPolicy = &mentionPolicy
+	}
+
+	if webHideBoosts, ok := sourceMap["web_hide_boosts"]; ok {
+		webHideBoostsBool, err := strconv.ParseBool(webHideBoosts)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing form source[web_hide_boosts]: %s", err)
+		}
+		form.Source.WebHideBoosts = &webHideBoostsBool
+	}
+
+	if webHideReplies, ok := sourceMap["web_hide_replies"]; ok {
+		webHideRepliesBool, err := strconv.ParseBool(webHideReplies)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing form source[web_hide_replies]: %s", err)
+		}
+		form.Source.WebHideReplies = &webHideRepliesBool
+	}
+
+	if webPinnedFirst, ok := sourceMap["web_pinned_first"]; ok {
+		webPinnedFirstBool, err := strconv.ParseBool(webPinnedFirst)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing form source[web_pinned_first]: %s", err)
+		}
+		form.Source.WebPinnedFirst = &webPinnedFirstBool
+	}
+
+	if webMediaTab, ok := sourceMap["web_media_tab"]; ok {
+		webMediaTabBool, err := strconv.ParseBool(webMediaTab)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing form source[web_media_tab]: %s", err)
+		}
+		form.Source.WebMediaTab = &webMediaTabBool
+	}
+
+	if hideMirrors, ok := sourceMap["hide_mirrors"]; ok {
+		hideMirrorsBool, err := strconv.ParseBool(hideMirrors)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing form source[hide_mirrors]: %s", err)
+		}
+		form.Source.HideMirrors = &hideMirrorsBool
+	}
+
+	if notificationsRetentionDays, ok := sourceMap["notifications_retention_days"]; ok {
+		notificationsRetentionDaysInt, err := strconv.Atoi(notificationsRetentionDays)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing form source[notifications_retention_days]: %s", err)
+		}
+		form.Source.NotificationsRetentionDays = &notificationsRetentionDaysInt
+	}
+
 	if form == nil ||
 		(form.Discoverable == nil &&
 			form.Bot == nil &&
@@ -206,6 +315,14 @@ func parseUpdateAccountForm(c *gin.Context) (*model.UpdateCredentialsRequest, er
 			form.Source.Sensitive == nil &&
 			form.Source.Language == nil &&
 			form.Source.StatusFormat == nil &&
+			form.Source.FollowersOnlyBoostable == nil &&
+			form.Source.MentionPolicy == nil &&
+			form.Source.WebHideBoosts == nil &&
+			form.Source.WebHideReplies == nil &&
+			form.Source.WebPinnedFirst == nil &&
+			form.Source.WebMediaTab == nil &&
+			form.Source.HideMirrors == nil &&
+			form.Source.NotificationsRetentionDays == nil &&
 			form.FieldsAttributes == nil &&
 			form.CustomCSS == nil &&
 			form.EnableRSS == nil) {
@@ -0,0 +1,99 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/account"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type AccountFeaturedTagsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountFeaturedTagsTestSuite) getFeaturedTags(targetAccountID string) ([]*apimodel.FeaturedTag, int) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, fmt.Sprintf("/api/v1/accounts/%s/featured_tags", targetAccountID), "")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   account.IDKey,
+			Value: targetAccountID,
+		},
+	}
+
+	suite.accountModule.AccountFeaturedTagsGETHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	featuredTags := []*apimodel.FeaturedTag{}
+	suite.NoError(json.Unmarshal(b, &featuredTags))
+	return featuredTags, recorder.Code
+}
+
+func (suite *AccountFeaturedTagsTestSuite) TestGetFeaturedTags() {
+	targetAccount := suite.testAccounts["admin_account"]
+
+	// nothing featured yet
+	featuredTags, code := suite.getFeaturedTags(targetAccount.ID)
+	suite.Equal(http.StatusOK, code)
+	suite.Empty(featuredTags)
+
+	_, errWithCode := suite.processor.AccountFeaturedTagCreate(context.Background(), &oauth.Auth{Account: targetAccount}, &apimodel.FeaturedTagCreateRequest{Name: "welcome"})
+	suite.NoError(errWithCode)
+
+	featuredTags, code = suite.getFeaturedTags(targetAccount.ID)
+	suite.Equal(http.StatusOK, code)
+	if suite.Len(featuredTags, 1) {
+		suite.Equal("welcome", featuredTags[0].Name)
+		suite.Equal("http://localhost:8080/@admin/tagged/welcome", featuredTags[0].URL)
+		suite.Equal(1, featuredTags[0].StatusesCount)
+		suite.NotEmpty(featuredTags[0].LastStatusAt)
+	}
+}
+
+func (suite *AccountFeaturedTagsTestSuite) TestGetFeaturedTagsRemoteAccount() {
+	featuredTags, code := suite.getFeaturedTags(suite.testAccounts["remote_account_1"].ID)
+	suite.Equal(http.StatusOK, code)
+	suite.Empty(featuredTags)
+}
+
+func (suite *AccountFeaturedTagsTestSuite) TestGetFeaturedTagsUnknownAccount() {
+	_, code := suite.getFeaturedTags("01GKC2XBSKPQ3Q0WNXF3TE3SYV")
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func TestAccountFeaturedTagsTestSuite(t *testing.T) {
+	suite.Run(t, &AccountFeaturedTagsTestSuite{})
+}
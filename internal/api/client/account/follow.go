@@ -33,6 +33,9 @@ import (
 //
 // Follow account with id.
 //
+// If the account is already followed, or a follow request is already pending,
+// the reblogs, replies and notify options given will be updated instead.
+//
 // The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
 // The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
 //
@@ -59,6 +62,12 @@ import (
 //		description: Show reblogs from this account.
 //		in: formData
 //	-
+//		name: replies
+//		type: boolean
+//		default: true
+//		description: Show replies from this account to other accounts.
+//		in: formData
+//	-
 //		default: false
 //		description: Notify when this account posts.
 //		in: formData
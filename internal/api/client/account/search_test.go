@@ -0,0 +1,83 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/account"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SearchTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *SearchTestSuite) search(query string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodGet, "http://localhost:8080"+account.SearchPath+"?"+query, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.accountModule.AccountSearchGETHandler(ctx)
+	return recorder
+}
+
+func (suite *SearchTestSuite) TestSearchMention() {
+	recorder := suite.search(url.Values{account.SearchQueryKey: {"@foss_satan@fossbros-anonymous.io"}}.Encode())
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	accounts := []*apimodel.Account{}
+	suite.NoError(json.Unmarshal(b, &accounts))
+	if suite.Len(accounts, 1) {
+		suite.Equal(suite.testAccounts["remote_account_1"].ID, accounts[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchNoQuery() {
+	recorder := suite.search("")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *SearchTestSuite) TestSearchLimitTooHigh() {
+	recorder := suite.search(url.Values{account.SearchQueryKey: {"a"}, account.LimitKey: {"41"}}.Encode())
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
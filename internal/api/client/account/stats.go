@@ -0,0 +1,116 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+const (
+	statsDefaultDays = 365
+	statsMaxDays     = 3660
+)
+
+// AccountStatsGETHandler swagger:operation GET /api/v1/accounts/stats accountStats
+//
+// See posting and follower statistics for your account.
+//
+// Statistics are given per day and per week (starting on Monday) in UTC, along with your most used hashtags.
+// Follower counts reflect accounts which still follow you, so they don't include followers since lost.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: days
+//		type: integer
+//		description: >-
+//			Number of days of statistics to return, up to and including today.
+//			Days before the account was created are not included.
+//		default: 365
+//		maximum: 3660
+//		minimum: 1
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:accounts
+//
+//	responses:
+//		'200':
+//			name: account stats
+//			description: Statistics for the requesting account.
+//			schema:
+//				"$ref": "#/definitions/accountStats"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) AccountStatsGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	days := statsDefaultDays
+	if daysString := c.Query(DaysKey); daysString != "" {
+		i, err := strconv.ParseInt(daysString, 10, 64)
+		if err != nil {
+			err := fmt.Errorf("error parsing %s: %s", DaysKey, err)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		if i < 1 || i > statsMaxDays {
+			err := fmt.Errorf("%s must be between 1 and %d", DaysKey, statsMaxDays)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		days = int(i)
+	}
+
+	stats, errWithCode := m.processor.AccountStatsGet(c.Request.Context(), authed, days)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
@@ -54,7 +54,7 @@ import (
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken (`disable`, `silence`, or `suspend`).
+//		description: Type of action to be taken (`disable`, `silence`, `suspend`, `mirror`, or `unmirror`). `mirror` flags the account as a mirror of an external feed, so that it can be filtered from public timelines; `unmirror` removes the flag.
 //		type: string
 //		required: true
 //	-
@@ -0,0 +1,76 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type AccountInteractionsTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountInteractionsTestSuite) getInteractions(accountID string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	path := strings.ReplaceAll(admin.AccountsInteractionsPath, ":"+admin.IDKey, accountID)
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "")
+	ctx.AddParam(admin.IDKey, accountID)
+	suite.adminModule.AccountInteractionsGETHandler(ctx)
+	return recorder
+}
+
+func (suite *AccountInteractionsTestSuite) TestAccountInteractionsGet() {
+	remoteAccount := suite.testAccounts["remote_account_1"]
+
+	recorder := suite.getInteractions(remoteAccount.ID)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiInteractions := &apimodel.AdminAccountInteractions{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(apiInteractions))
+	suite.Equal(1, apiInteractions.LocalBlocks)
+	suite.Zero(apiInteractions.LocalFollowing)
+	suite.Zero(apiInteractions.MentionsCount)
+	if suite.Len(apiInteractions.Interactions, 1) {
+		suite.Equal("1happyturtle", apiInteractions.Interactions[0].Account.Username)
+		suite.True(apiInteractions.Interactions[0].BlockedBy)
+		suite.Empty(apiInteractions.Interactions[0].LastMentionedAt)
+	}
+}
+
+func (suite *AccountInteractionsTestSuite) TestAccountInteractionsGetLocalAccount() {
+	recorder := suite.getInteractions(suite.testAccounts["local_account_1"].ID)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *AccountInteractionsTestSuite) TestAccountInteractionsGetNotFound() {
+	recorder := suite.getInteractions("01GKKVJ3X3G4FM3WAGQ6D5Z9WZ")
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestAccountInteractionsTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountInteractionsTestSuite))
+}
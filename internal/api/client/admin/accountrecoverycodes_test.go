@@ -0,0 +1,89 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type AccountRecoveryCodesTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountRecoveryCodesTestSuite) postRecoveryCodes(accountID string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	path := strings.ReplaceAll(admin.AccountsRecoveryCodesPath, ":"+admin.IDKey, accountID)
+	ctx := suite.newContext(recorder, http.MethodPost, nil, path, "")
+	ctx.AddParam(admin.IDKey, accountID)
+	suite.adminModule.AccountRecoveryCodesPOSTHandler(ctx)
+	return recorder
+}
+
+func (suite *AccountRecoveryCodesTestSuite) TestAccountRecoveryCodesCreate() {
+	config.SetOIDCEnabled(true)
+	localAccount := suite.testAccounts["local_account_1"]
+
+	recorder := suite.postRecoveryCodes(localAccount.ID)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiCodes := &apimodel.AdminRecoveryCodes{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(apiCodes))
+	suite.Len(apiCodes.Codes, oauth.RecoveryCodesCount)
+
+	// only hashes of the codes are stored
+	user, err := suite.db.GetUserByAccountID(context.Background(), localAccount.ID)
+	suite.NoError(err)
+	suite.Len(user.RecoveryCodes, oauth.RecoveryCodesCount)
+	suite.NotContains(user.RecoveryCodes, apiCodes.Codes[0])
+	suite.Equal(0, oauth.MatchRecoveryCode(user.RecoveryCodes, apiCodes.Codes[0]))
+
+	// generating codes again replaces the old ones
+	recorder = suite.postRecoveryCodes(localAccount.ID)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	user, err = suite.db.GetUserByAccountID(context.Background(), localAccount.ID)
+	suite.NoError(err)
+	suite.Equal(-1, oauth.MatchRecoveryCode(user.RecoveryCodes, apiCodes.Codes[0]))
+}
+
+func (suite *AccountRecoveryCodesTestSuite) TestAccountRecoveryCodesCreateRemoteAccount() {
+	config.SetOIDCEnabled(true)
+	recorder := suite.postRecoveryCodes(suite.testAccounts["remote_account_1"].ID)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *AccountRecoveryCodesTestSuite) TestAccountRecoveryCodesCreateNoOIDC() {
+	recorder := suite.postRecoveryCodes(suite.testAccounts["local_account_1"].ID)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func TestAccountRecoveryCodesTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountRecoveryCodesTestSuite))
+}
@@ -33,19 +33,61 @@ const (
 	EmojiPath = BasePath + "/custom_emojis"
 	// EmojiPathWithID is used for interacting with a single emoji.
 	EmojiPathWithID = EmojiPath + "/:" + IDKey
+	// EmojiCopyPath is used for copying a remote emoji to a local emoji.
+	EmojiCopyPath = EmojiPathWithID + "/copy"
 	// EmojiCategoriesPath is used for interacting with emoji categories.
 	EmojiCategoriesPath = EmojiPath + "/categories"
+	// EmojiCategoriesPathWithID is used for interacting with a single emoji category.
+	EmojiCategoriesPathWithID = EmojiCategoriesPath + "/:" + IDKey
+	// EmojiUsagePath is used for viewing how much emojis are used.
+	EmojiUsagePath = EmojiPath + "/usage"
+	// EmojiPacksPath is used for importing/exporting emoji packs.
+	EmojiPacksPath = EmojiPath + "/packs"
+	// EmojiRegenerateStaticsPath is used for regenerating missing or broken emoji statics.
+	EmojiRegenerateStaticsPath = EmojiPath + "/regenerate_statics"
+	// EmojiDomainActionPath is used for acting on all emojis from one remote domain.
+	EmojiDomainActionPath = EmojiPath + "/domains/:" + DomainKey + "/action"
 	// DomainBlocksPath is used for posting domain blocks.
 	DomainBlocksPath = BasePath + "/domain_blocks"
 	// DomainBlocksPathWithID is used for interacting with a single domain block.
 	DomainBlocksPathWithID = DomainBlocksPath + "/:" + IDKey
+	// DomainNotesPath is used for listing private admin notes on domains.
+	DomainNotesPath = BasePath + "/domain_notes"
+	// DomainNotesPathWithDomain is used for interacting with the note on a single domain.
+	DomainNotesPathWithDomain = DomainNotesPath + "/:" + DomainKey
+	// DomainEmojiPoliciesPath is used for listing emoji policies for domains.
+	DomainEmojiPoliciesPath = BasePath + "/domain_emoji_policies"
+	// DomainEmojiPoliciesPathWithDomain is used for interacting with the emoji policy for a single domain.
+	DomainEmojiPoliciesPathWithDomain = DomainEmojiPoliciesPath + "/:" + DomainKey
+	// FederationErrorsPath is used for listing domains with recent federation errors.
+	FederationErrorsPath = BasePath + "/federation_errors"
+	// FederationErrorsPathWithDomain is used for viewing the recent federation errors for a single domain.
+	FederationErrorsPathWithDomain = FederationErrorsPath + "/:" + DomainKey
+	// DomainStoragePath is used for viewing how much is stored from each remote domain.
+	DomainStoragePath = BasePath + "/domain_storage"
+	// UserAgentRejectionsPath is used for viewing the number of requests rejected by blocked user agent rules.
+	UserAgentRejectionsPath = BasePath + "/user_agent_rejections"
+	// DBPoolStatsPath is used for viewing statistics for the database connection pools.
+	DBPoolStatsPath = BasePath + "/db_pool_stats"
+	// CacheStatsPath is used for viewing statistics for the caches kept in front of the database.
+	CacheStatsPath = BasePath + "/cache_stats"
+	// LegalDocumentsPath is used for publishing and listing versions of the terms of service and privacy policy.
+	LegalDocumentsPath = BasePath + "/legal_documents"
+	// LegalDocumentsPathWithID is used for viewing a single version of a legal document.
+	LegalDocumentsPathWithID = LegalDocumentsPath + "/:" + IDKey
+	// DBMaintenancePath is used for running database maintenance on demand.
+	DBMaintenancePath = BasePath + "/db_maintenance"
 	// AccountsPath is used for listing + acting on accounts.
 	AccountsPath = BasePath + "/accounts"
 	// AccountsPathWithID is used for interacting with a single account.
 	AccountsPathWithID = AccountsPath + "/:" + IDKey
 	// AccountsActionPath is used for taking action on a single account.
 	AccountsActionPath = AccountsPathWithID + "/action"
-	MediaCleanupPath   = BasePath + "/media_cleanup"
+	// AccountsInteractionsPath is used for viewing a remote account's interactions with local accounts.
+	AccountsInteractionsPath = AccountsPathWithID + "/interactions"
+	// AccountsRecoveryCodesPath is used for generating recovery codes for a local account.
+	AccountsRecoveryCodesPath = AccountsPathWithID + "/recovery_codes"
+	MediaCleanupPath          = BasePath + "/media_cleanup"
 
 	// ExportQueryKey is for requesting a public export of some data.
 	ExportQueryKey = "export"
@@ -53,6 +95,8 @@ const (
 	ImportQueryKey = "import"
 	// IDKey specifies the ID of a single item being interacted with.
 	IDKey = "id"
+	// DomainKey specifies the domain of a single item being interacted with.
+	DomainKey = "domain"
 	// FilterKey is for applying filters to admin views of accounts, emojis, etc.
 	FilterQueryKey = "filter"
 	// MaxShortcodeDomainKey is the url query for returning emoji results lower (alphabetically)
@@ -61,8 +105,16 @@ const (
 	// MaxShortcodeDomainKey is the url query for returning emoji results higher (alphabetically)
 	// than the given `[shortcode]@[domain]` parameter.
 	MinShortcodeDomainKey = "min_shortcode_domain"
+	// DomainQueryKey is for restricting results to one domain.
+	DomainQueryKey = "domain"
+	// OrderQueryKey is for choosing the sort order of results.
+	OrderQueryKey = "order"
 	// LimitKey is for specifying maximum number of results to return.
 	LimitKey = "limit"
+	// CategoryQueryKey is for restricting results to one emoji category.
+	CategoryQueryKey = "category"
+	// KindQueryKey is for restricting results to one kind of legal document.
+	KindQueryKey = "kind"
 )
 
 // Module implements the ClientAPIModule interface for admin-related actions (reports, emojis, etc)
@@ -83,12 +135,41 @@ func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodGet, EmojiPath, m.EmojisGETHandler)
 	r.AttachHandler(http.MethodDelete, EmojiPathWithID, m.EmojiDELETEHandler)
 	r.AttachHandler(http.MethodGet, EmojiPathWithID, m.EmojiGETHandler)
+	r.AttachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
+	r.AttachHandler(http.MethodPost, EmojiCopyPath, m.EmojiCopyPOSTHandler)
 	r.AttachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
 	r.AttachHandler(http.MethodGet, DomainBlocksPath, m.DomainBlocksGETHandler)
 	r.AttachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
 	r.AttachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
+	r.AttachHandler(http.MethodGet, DomainNotesPath, m.DomainNotesGETHandler)
+	r.AttachHandler(http.MethodGet, DomainNotesPathWithDomain, m.DomainNoteGETHandler)
+	r.AttachHandler(http.MethodPut, DomainNotesPathWithDomain, m.DomainNotePUTHandler)
+	r.AttachHandler(http.MethodDelete, DomainNotesPathWithDomain, m.DomainNoteDELETEHandler)
+	r.AttachHandler(http.MethodGet, DomainEmojiPoliciesPath, m.DomainEmojiPoliciesGETHandler)
+	r.AttachHandler(http.MethodGet, DomainEmojiPoliciesPathWithDomain, m.DomainEmojiPolicyGETHandler)
+	r.AttachHandler(http.MethodPut, DomainEmojiPoliciesPathWithDomain, m.DomainEmojiPolicyPUTHandler)
+	r.AttachHandler(http.MethodDelete, DomainEmojiPoliciesPathWithDomain, m.DomainEmojiPolicyDELETEHandler)
+	r.AttachHandler(http.MethodGet, FederationErrorsPath, m.FederationErrorDomainsGETHandler)
+	r.AttachHandler(http.MethodGet, FederationErrorsPathWithDomain, m.FederationErrorsGETHandler)
+	r.AttachHandler(http.MethodGet, DomainStoragePath, m.DomainStorageGETHandler)
+	r.AttachHandler(http.MethodGet, UserAgentRejectionsPath, m.UserAgentRejectionsGETHandler)
+	r.AttachHandler(http.MethodGet, DBPoolStatsPath, m.DBPoolStatsGETHandler)
+	r.AttachHandler(http.MethodGet, CacheStatsPath, m.CacheStatsGETHandler)
+	r.AttachHandler(http.MethodPost, DBMaintenancePath, m.DBMaintenancePOSTHandler)
+	r.AttachHandler(http.MethodPost, LegalDocumentsPath, m.LegalDocumentPOSTHandler)
+	r.AttachHandler(http.MethodGet, LegalDocumentsPath, m.LegalDocumentsGETHandler)
+	r.AttachHandler(http.MethodGet, LegalDocumentsPathWithID, m.LegalDocumentGETHandler)
 	r.AttachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
+	r.AttachHandler(http.MethodGet, AccountsInteractionsPath, m.AccountInteractionsGETHandler)
+	r.AttachHandler(http.MethodPost, AccountsRecoveryCodesPath, m.AccountRecoveryCodesPOSTHandler)
 	r.AttachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
+	r.AttachHandler(http.MethodGet, EmojiUsagePath, m.EmojiUsageGETHandler)
 	r.AttachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
+	r.AttachHandler(http.MethodPatch, EmojiCategoriesPathWithID, m.EmojiCategoryPATCHHandler)
+	r.AttachHandler(http.MethodDelete, EmojiCategoriesPathWithID, m.EmojiCategoryDELETEHandler)
+	r.AttachHandler(http.MethodPost, EmojiPacksPath, m.EmojiPackPOSTHandler)
+	r.AttachHandler(http.MethodGet, EmojiPacksPath, m.EmojiPackGETHandler)
+	r.AttachHandler(http.MethodPost, EmojiRegenerateStaticsPath, m.EmojiStaticsRegeneratePOSTHandler)
+	r.AttachHandler(http.MethodPost, EmojiDomainActionPath, m.EmojiDomainActionPOSTHandler)
 	return nil
 }
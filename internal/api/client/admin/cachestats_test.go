@@ -0,0 +1,72 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type CacheStatsTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *CacheStatsTestSuite) TestCacheStatsGet() {
+	// look up a status twice, so that the second lookup is a cache hit
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+	for i := 0; i < 2; i++ {
+		_, err := suite.db.GetStatusByID(context.Background(), testStatus.ID)
+		suite.NoError(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.CacheStatsPath, "")
+	suite.adminModule.CacheStatsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiCaches := []*apimodel.AdminCacheStats{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiCaches))
+
+	names := make([]string, 0, len(apiCaches))
+	var statuses *apimodel.AdminCacheStats
+	for _, c := range apiCaches {
+		names = append(names, c.Name)
+		if c.Name == "statuses" {
+			statuses = c
+		}
+	}
+	suite.Equal([]string{"accounts", "domain_blocks", "emoji_categories", "emojis", "mentions", "notifications", "statuses", "users"}, names)
+
+	suite.NotNil(statuses)
+	suite.Positive(statuses.Size)
+	suite.Positive(statuses.Hits)
+	suite.Greater(statuses.HitRatio, 0.0)
+	suite.LessOrEqual(statuses.HitRatio, 1.0)
+}
+
+func TestCacheStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheStatsTestSuite))
+}
@@ -0,0 +1,66 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type DBMaintenanceTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DBMaintenanceTestSuite) maintain() *apimodel.AdminDBMaintenanceResult {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.DBMaintenancePath, "")
+	suite.adminModule.DBMaintenancePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := &apimodel.AdminDBMaintenanceResult{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(result))
+	return result
+}
+
+func (suite *DBMaintenanceTestSuite) TestDBMaintenancePost() {
+	result := suite.maintain()
+	suite.Positive(result.SizeBefore)
+	suite.False(result.Vacuumed)
+	suite.False(result.Reindexed)
+}
+
+func (suite *DBMaintenanceTestSuite) TestDBMaintenancePostVacuum() {
+	config.SetDbMaintenanceVacuum(true)
+
+	result := suite.maintain()
+	suite.Positive(result.SizeAfter)
+	suite.True(result.Vacuumed)
+	suite.False(result.Reindexed)
+}
+
+func TestDBMaintenanceTestSuite(t *testing.T) {
+	suite.Run(t, new(DBMaintenanceTestSuite))
+}
@@ -0,0 +1,52 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type DBPoolStatsTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DBPoolStatsTestSuite) TestDBPoolStatsGet() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DBPoolStatsPath, "")
+	suite.adminModule.DBPoolStatsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiPools := []*apimodel.AdminDBPoolStats{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiPools))
+	suite.Len(apiPools, 1)
+	suite.Equal("primary", apiPools[0].Name)
+	suite.Positive(apiPools[0].OpenConnections)
+	suite.Equal(apiPools[0].OpenConnections, apiPools[0].InUse+apiPools[0].Idle)
+}
+
+func TestDBPoolStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(DBPoolStatsTestSuite))
+}
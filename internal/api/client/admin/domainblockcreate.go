@@ -41,6 +41,8 @@ import (
 //
 // The format of the json file should be something like: `[{"domain":"example.org"},{"domain":"whatever.com","public_comment":"they smell"}]`
 //
+// Imported domain blocks with an `expires_at` time keep their expiry. Those which have already expired are skipped.
+//
 //	---
 //	tags:
 //	- admin
@@ -99,6 +101,14 @@ import (
 //			is a useful way of internally keeping track of why a certain domain ended up blocked.
 //			Used only if `import` is not `true`.
 //		type: string
+//	-
+//		name: expires_in
+//		in: formData
+//		description: >-
+//			Number of seconds after which the domain block will automatically be lifted,
+//			as though it had been deleted. Leave unset or set to 0 for a block that doesn't expire.
+//			Used only if `import` is not `true`.
+//		type: integer
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -195,6 +205,10 @@ func validateCreateDomainBlock(form *model.DomainBlockCreateRequest, imp bool) e
 		if form.Domain == "" {
 			return errors.New("empty domain provided")
 		}
+
+		if form.ExpiresIn < 0 {
+			return errors.New("expires_in must not be negative")
+		}
 	}
 
 	return nil
@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DomainEmojiPolicyTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainEmojiPolicyTestSuite) putPolicy(domain string, policy string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"policy": policy,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, requestBody.Bytes(), admin.DomainEmojiPoliciesPathWithDomain, w.FormDataContentType())
+	ctx.AddParam(admin.DomainKey, domain)
+
+	suite.adminModule.DomainEmojiPolicyPUTHandler(ctx)
+	return recorder
+}
+
+func (suite *DomainEmojiPolicyTestSuite) getPolicy(domain string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DomainEmojiPoliciesPathWithDomain, "")
+	ctx.AddParam(admin.DomainKey, domain)
+
+	suite.adminModule.DomainEmojiPolicyGETHandler(ctx)
+	return recorder
+}
+
+func (suite *DomainEmojiPolicyTestSuite) TestDomainEmojiPolicyPutGetDelete() {
+	recorder := suite.putPolicy("Fossbros-Anonymous.io", "reject")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.putPolicy("fossbros-anonymous.io", "hide")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.getPolicy("fossbros-anonymous.io")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiPolicy := &apimodel.DomainEmojiPolicy{}
+	suite.NoError(json.Unmarshal(b, apiPolicy))
+	suite.Equal("fossbros-anonymous.io", apiPolicy.Domain)
+	suite.Equal("hide", apiPolicy.Policy)
+	suite.Equal(suite.testAccounts["admin_account"].ID, apiPolicy.UpdatedBy)
+
+	// there should only be the one policy
+	recorder = httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DomainEmojiPoliciesPath, "")
+	suite.adminModule.DomainEmojiPoliciesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiPolicies := []*apimodel.DomainEmojiPolicy{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiPolicies))
+	suite.Len(apiPolicies, 1)
+
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, admin.DomainEmojiPoliciesPathWithDomain, "")
+	ctx.AddParam(admin.DomainKey, "fossbros-anonymous.io")
+	suite.adminModule.DomainEmojiPolicyDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.getPolicy("fossbros-anonymous.io")
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *DomainEmojiPolicyTestSuite) TestDomainEmojiPolicyPutUnknownPolicy() {
+	recorder := suite.putPolicy("fossbros-anonymous.io", "delete")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: emoji policy \"delete\" is not supported; use one of store, hide, reject","code":400}`, string(b))
+}
+
+func (suite *DomainEmojiPolicyTestSuite) TestDomainEmojiPolicyPutInvalidDomain() {
+	recorder := suite.putPolicy("not a domain", "reject")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestDomainEmojiPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainEmojiPolicyTestSuite))
+}
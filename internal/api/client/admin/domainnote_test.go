@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DomainNoteTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainNoteTestSuite) putNote(domain string, text string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"text": text,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, requestBody.Bytes(), admin.DomainNotesPathWithDomain, w.FormDataContentType())
+	ctx.AddParam(admin.DomainKey, domain)
+
+	suite.adminModule.DomainNotePUTHandler(ctx)
+	return recorder
+}
+
+func (suite *DomainNoteTestSuite) getNote(domain string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DomainNotesPathWithDomain, "")
+	ctx.AddParam(admin.DomainKey, domain)
+
+	suite.adminModule.DomainNoteGETHandler(ctx)
+	return recorder
+}
+
+func (suite *DomainNoteTestSuite) TestDomainNotePutGetDelete() {
+	recorder := suite.putNote("Fossbros-Anonymous.io", "limited 2023-06 due to spam wave, re-evaluate")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.putNote("fossbros-anonymous.io", "spam wave is over")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.getNote("fossbros-anonymous.io")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiNote := &apimodel.DomainNote{}
+	suite.NoError(json.Unmarshal(b, apiNote))
+	suite.Equal("fossbros-anonymous.io", apiNote.Domain)
+	suite.Equal("spam wave is over", apiNote.Text)
+	suite.Equal(suite.testAccounts["admin_account"].ID, apiNote.UpdatedBy)
+
+	// there should only be the one note
+	recorder = httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DomainNotesPath, "")
+	suite.adminModule.DomainNotesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiNotes := []*apimodel.DomainNote{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiNotes))
+	suite.Len(apiNotes, 1)
+
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, admin.DomainNotesPathWithDomain, "")
+	ctx.AddParam(admin.DomainKey, "fossbros-anonymous.io")
+	suite.adminModule.DomainNoteDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.getNote("fossbros-anonymous.io")
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *DomainNoteTestSuite) TestDomainNotePutEmpty() {
+	recorder := suite.putNote("fossbros-anonymous.io", "")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: note text was empty; to remove a note, use DELETE instead","code":400}`, string(b))
+}
+
+func (suite *DomainNoteTestSuite) TestDomainNotePutInvalidDomain() {
+	recorder := suite.putNote("not a domain", "whatever")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestDomainNoteTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainNoteTestSuite))
+}
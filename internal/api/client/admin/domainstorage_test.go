@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type DomainStorageTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainStorageTestSuite) TestDomainStorageGet() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DomainStoragePath, "")
+	suite.adminModule.DomainStorageGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiStorages := []*apimodel.AdminDomainStorage{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiStorages))
+	suite.NotEmpty(apiStorages)
+	suite.Equal("fossbros-anonymous.io", apiStorages[0].Domain)
+	suite.Equal(2, apiStorages[0].AttachmentsCount)
+	suite.Equal(apiStorages[0].AttachmentsBytes+apiStorages[0].EmojisBytes, apiStorages[0].TotalBytes)
+}
+
+func (suite *DomainStorageTestSuite) TestDomainStorageGetCSV() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DomainStoragePath, "")
+	ctx.Request.Header.Set("accept", "text/csv")
+	suite.adminModule.DomainStorageGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("text/csv", recorder.Header().Get("Content-Type"))
+	suite.Equal(`attachment; filename="domain_storage.csv"`, recorder.Header().Get("Content-Disposition"))
+
+	records, err := csv.NewReader(recorder.Body).ReadAll()
+	suite.NoError(err)
+	suite.Greater(len(records), 1)
+	suite.Equal([]string{
+		"domain",
+		"accounts_count",
+		"statuses_count",
+		"attachments_count",
+		"attachments_bytes",
+		"emojis_count",
+		"emojis_bytes",
+		"total_bytes",
+	}, records[0])
+	suite.Equal([]string{"fossbros-anonymous.io", "1", "1", "2", "79410", "1", "21697", "101107"}, records[1])
+}
+
+func TestDomainStorageTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainStorageTestSuite))
+}
@@ -0,0 +1,110 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DomainStorageGETHandler swagger:operation GET /api/v1/admin/domain_storage domainStorageGet
+//
+// View how many accounts, statuses, media attachments and custom emojis are stored from each remote domain,
+// and how much storage the attachments and emojis take up, most storage first.
+//
+// Useful for deciding which domains to prune or block when running short of capacity.
+// Attachments only count towards storage while they're cached; pruned remote media is not counted.
+//
+// Send `Accept: text/csv` to download the same data as a CSV file, with a header row.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: Storage used by each remote domain.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminDomainStorage"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DomainStorageGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		api.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	format, err := api.NegotiateAccept(c, api.AppJSON, api.TextCSV)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if format == string(api.TextCSV) {
+		content, errWithCode := m.processor.AdminDomainStorageExport(c.Request.Context(), authed)
+		if errWithCode != nil {
+			api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+			return
+		}
+
+		extraHeaders := map[string]string{
+			"Content-Disposition": `attachment; filename="domain_storage.csv"`,
+		}
+		c.DataFromReader(http.StatusOK, content.ContentLength, content.ContentType, content.Content, extraHeaders)
+		return
+	}
+
+	storages, errWithCode := m.processor.AdminDomainStorageGet(c.Request.Context(), authed)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, storages)
+}
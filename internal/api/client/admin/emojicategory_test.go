@@ -0,0 +1,174 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiCategoryTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCategoryTestSuite) updateCategory(id string, name string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"name": name,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPatch, requestBody.Bytes(), admin.EmojiCategoriesPathWithID, w.FormDataContentType())
+	ctx.AddParam(admin.IDKey, id)
+
+	suite.adminModule.EmojiCategoryPATCHHandler(ctx)
+	return recorder
+}
+
+func (suite *EmojiCategoryTestSuite) deleteCategory(id string, query string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, admin.EmojiCategoriesPathWithID+query, "")
+	ctx.AddParam(admin.IDKey, id)
+
+	suite.adminModule.EmojiCategoryDELETEHandler(ctx)
+	return recorder
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryUpdate() {
+	testCategory := suite.testEmojiCategories["reactions"]
+
+	recorder := suite.updateCategory(testCategory.ID, "reacts")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"id":"01GGQ8V4993XK67B2JB396YFB7","name":"reacts"}`, string(b))
+
+	dbCategory, err := suite.db.GetEmojiCategory(context.Background(), testCategory.ID)
+	suite.NoError(err)
+	suite.Equal("reacts", dbCategory.Name)
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryUpdateNameTaken() {
+	testCategory := suite.testEmojiCategories["reactions"]
+
+	// names are compared case-insensitively
+	recorder := suite.updateCategory(testCategory.ID, "Cute Stuff")
+	suite.Equal(http.StatusConflict, recorder.Code)
+
+	dbCategory, err := suite.db.GetEmojiCategory(context.Background(), testCategory.ID)
+	suite.NoError(err)
+	suite.Equal("reactions", dbCategory.Name)
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryUpdateNotFound() {
+	recorder := suite.updateCategory("01GF8VRXX1R00X7XH8973Z29R1", "reacts")
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryDelete() {
+	testCategory := suite.testEmojiCategories["reactions"]
+	testEmoji := suite.testEmojis["rainbow"]
+
+	recorder := suite.deleteCategory(testCategory.ID, "")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"id":"01GGQ8V4993XK67B2JB396YFB7","name":"reactions"}`, string(b))
+
+	_, err = suite.db.GetEmojiCategory(context.Background(), testCategory.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// emoji should be kept, but uncategorized
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.Empty(dbEmoji.CategoryID)
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryDeleteReassign() {
+	testCategory := suite.testEmojiCategories["reactions"]
+	testEmoji := suite.testEmojis["rainbow"]
+
+	recorder := suite.deleteCategory(testCategory.ID, "?reassign_to=cute+stuff")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	_, err := suite.db.GetEmojiCategory(context.Background(), testCategory.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// emoji should have moved to the other category
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.Equal(suite.testEmojiCategories["cute stuff"].ID, dbEmoji.CategoryID)
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryDeleteReassignToSelf() {
+	testCategory := suite.testEmojiCategories["reactions"]
+
+	recorder := suite.deleteCategory(testCategory.ID, "?reassign_to=reactions")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: cannot reassign emojis to the category being deleted","code":400}`, string(b))
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryDeleteCascade() {
+	testCategory := suite.testEmojiCategories["reactions"]
+	testEmoji := suite.testEmojis["rainbow"]
+
+	recorder := suite.deleteCategory(testCategory.ID, "?cascade=true")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	_, err := suite.db.GetEmojiCategory(context.Background(), testCategory.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// emoji should be gone too
+	_, err = suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *EmojiCategoryTestSuite) TestEmojiCategoryDeleteCascadeAndReassign() {
+	testCategory := suite.testEmojiCategories["reactions"]
+
+	recorder := suite.deleteCategory(testCategory.ID, "?cascade=true&reassign_to=cute+stuff")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: cascade and reassign_to cannot be used together","code":400}`, string(b))
+
+	// category should still be there
+	_, err = suite.db.GetEmojiCategory(context.Background(), testCategory.ID)
+	suite.NoError(err)
+}
+
+func TestEmojiCategoryTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCategoryTestSuite{})
+}
@@ -0,0 +1,154 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// EmojiCopyPOSTHandler swagger:operation POST /api/v1/admin/custom_emojis/{id}/copy emojiCopy
+//
+// Copy a remote emoji to a new local emoji.
+//
+// The image of the remote emoji is reprocessed, and a new local emoji is created from it,
+// with the given shortcode and category. The remote emoji itself is left untouched.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the remote emoji.
+//		in: path
+//		required: true
+//	-
+//		name: shortcode
+//		in: formData
+//		description: >-
+//			The code to use for the new local emoji. This will be used for searching/listing,
+//			and emoji codes in statuses will use this code. Defaults to the shortcode of the
+//			remote emoji. Must be unique for this instance.
+//		type: string
+//		pattern: \w{2,30}
+//		required: false
+//	-
+//		name: category
+//		in: formData
+//		description: >-
+//			Category in which to place the new emoji. 64 characters maximum.
+//			If left blank, emoji will be uncategorized. If a category with the
+//			given name doesn't exist yet, it will be created.
+//		type: string
+//		required: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: The newly-created local emoji.
+//			schema:
+//				"$ref": "#/definitions/emoji"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: conflict -- shortcode for this emoji is already in use
+//		'500':
+//			description: internal server error
+func (m *Module) EmojiCopyPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		api.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	emojiID := c.Param(IDKey)
+	if emojiID == "" {
+		err := errors.New("no emoji id specified")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &model.EmojiCopyRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if err := validateCopyEmoji(form); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	apiEmoji, errWithCode := m.processor.AdminEmojiCopy(c.Request.Context(), authed, emojiID, form)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiEmoji)
+}
+
+func validateCopyEmoji(form *model.EmojiCopyRequest) error {
+	if form.Shortcode != "" {
+		if err := validate.EmojiShortcode(form.Shortcode); err != nil {
+			return err
+		}
+	}
+
+	return validate.EmojiCategory(form.CategoryName)
+}
@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiCopyTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCopyTestSuite) copyEmoji(id string, fields map[string]string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", fields)
+	if err != nil {
+		panic(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody.Bytes(), admin.EmojiCopyPath, w.FormDataContentType())
+	ctx.AddParam(admin.IDKey, id)
+
+	suite.adminModule.EmojiCopyPOSTHandler(ctx)
+	return recorder
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopy() {
+	remoteEmoji := suite.testEmojis["yell"]
+
+	recorder := suite.copyEmoji(remoteEmoji.ID, map[string]string{
+		"shortcode": "yell_copy",
+		"category":  "stolen", // this category doesn't exist yet
+	})
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiEmoji := &apimodel.Emoji{}
+	suite.NoError(json.Unmarshal(b, apiEmoji))
+	suite.Equal("yell_copy", apiEmoji.Shortcode)
+	suite.Equal("stolen", apiEmoji.Category)
+
+	// the new emoji should be local
+	dbEmoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "yell_copy", "")
+	suite.NoError(err)
+	suite.Empty(dbEmoji.Domain)
+	suite.Empty(dbEmoji.ImageRemoteURL)
+	suite.NotEqual(remoteEmoji.ImagePath, dbEmoji.ImagePath)
+	suite.Equal(remoteEmoji.ImageFileSize, dbEmoji.ImageFileSize)
+
+	// and the remote emoji should be untouched
+	dbRemoteEmoji, err := suite.db.GetEmojiByID(context.Background(), remoteEmoji.ID)
+	suite.NoError(err)
+	suite.Equal(remoteEmoji.Domain, dbRemoteEmoji.Domain)
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopyDefaultShortcode() {
+	recorder := suite.copyEmoji(suite.testEmojis["yell"].ID, map[string]string{})
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	dbEmoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "yell", "")
+	suite.NoError(err)
+	suite.Empty(dbEmoji.CategoryID)
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopyShortcodeInUse() {
+	recorder := suite.copyEmoji(suite.testEmojis["yell"].ID, map[string]string{
+		"shortcode": "rainbow",
+	})
+	suite.Equal(http.StatusConflict, recorder.Code)
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopyLocal() {
+	recorder := suite.copyEmoji(suite.testEmojis["rainbow"].ID, map[string]string{
+		"shortcode": "rainbow_copy",
+	})
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: emoji 01F8MH9H8E4VG3KDYJR9EGPXCQ is already a local emoji","code":400}`, string(b))
+}
+
+func (suite *EmojiCopyTestSuite) TestEmojiCopyNotFound() {
+	recorder := suite.copyEmoji("01GF8VRXX1R00X7XH8973Z29R1", map[string]string{})
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestEmojiCopyTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCopyTestSuite{})
+}
@@ -60,7 +60,7 @@ import (
 //		name: image
 //		in: formData
 //		description: >-
-//			A png or gif image of the emoji. Animated pngs work too!
+//			A png, gif, or svg image of the emoji. Animated pngs work too!
 //			To ensure compatibility with other fedi implementations, emoji size limit is 50kb by default.
 //		type: file
 //		required: true
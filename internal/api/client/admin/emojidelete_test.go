@@ -72,7 +72,7 @@ func (suite *EmojiDeleteTestSuite) TestEmojiDelete2() {
 	suite.NoError(err)
 	suite.NotNil(b)
 
-	suite.Equal(`{"error":"Bad Request: EmojiDelete: emoji with id 01GD5KP5CQEE1R3X43Y1EHS2CW was not a local emoji, will not delete"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: EmojiDelete: emoji with id 01GD5KP5CQEE1R3X43Y1EHS2CW was not a local emoji, will not delete","code":400}`, string(b))
 
 	// emoji should still be in the db
 	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
@@ -93,7 +93,7 @@ func (suite *EmojiDeleteTestSuite) TestEmojiDeleteNotFound() {
 	b, err := io.ReadAll(recorder.Body)
 	suite.NoError(err)
 	suite.NotNil(b)
-	suite.Equal(`{"error":"Not Found"}`, string(b))
+	suite.Equal(`{"error":"Not Found","code":404}`, string(b))
 }
 
 func TestEmojiDeleteTestSuite(t *testing.T) {
@@ -0,0 +1,115 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiDomainActionTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiDomainActionTestSuite) emojiDomainAction(domain string, actionType string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"type": actionType,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody.Bytes(), admin.EmojiDomainActionPath, w.FormDataContentType())
+	ctx.AddParam(admin.DomainKey, domain)
+
+	suite.adminModule.EmojiDomainActionPOSTHandler(ctx)
+	return recorder
+}
+
+func (suite *EmojiDomainActionTestSuite) TestEmojiDomainActionDisableEnable() {
+	testEmoji := suite.testEmojis["yell"]
+
+	recorder := suite.emojiDomainAction("Fossbros-Anonymous.io", "disable")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	action := &apimodel.AdminEmojiDomainAction{}
+	if err := json.Unmarshal(b, action); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("fossbros-anonymous.io", action.Domain)
+	suite.Equal("disable", action.Type)
+	suite.Equal(1, action.Count)
+
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.True(*dbEmoji.Disabled)
+
+	// disabling again shouldn't change anything
+	recorder = suite.emojiDomainAction("fossbros-anonymous.io", "disable")
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`{"domain":"fossbros-anonymous.io","type":"disable","count":0}`, recorder.Body.String())
+
+	recorder = suite.emojiDomainAction("fossbros-anonymous.io", "enable")
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`{"domain":"fossbros-anonymous.io","type":"enable","count":1}`, recorder.Body.String())
+
+	dbEmoji, err = suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.False(*dbEmoji.Disabled)
+
+	// local emojis should be untouched throughout
+	dbEmoji, err = suite.db.GetEmojiByID(context.Background(), suite.testEmojis["rainbow"].ID)
+	suite.NoError(err)
+	suite.False(*dbEmoji.Disabled)
+}
+
+func (suite *EmojiDomainActionTestSuite) TestEmojiDomainActionRefreshNoEmojis() {
+	recorder := suite.emojiDomainAction("example.org", "refresh")
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`{"domain":"example.org","type":"refresh","count":0}`, recorder.Body.String())
+}
+
+func (suite *EmojiDomainActionTestSuite) TestEmojiDomainActionLocalDomain() {
+	recorder := suite.emojiDomainAction("localhost:8080", "disable")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Equal(`{"error":"Bad Request: EmojiDomainAction: domain must be a remote domain","code":400}`, recorder.Body.String())
+}
+
+func (suite *EmojiDomainActionTestSuite) TestEmojiDomainActionBadType() {
+	recorder := suite.emojiDomainAction("fossbros-anonymous.io", "delete")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Equal(`{"error":"Bad Request: EmojiDomainAction: emoji domain action type delete is not supported","code":400}`, recorder.Body.String())
+}
+
+func TestEmojiDomainActionTestSuite(t *testing.T) {
+	suite.Run(t, new(EmojiDomainActionTestSuite))
+}
@@ -81,7 +81,7 @@ func (suite *EmojiGetTestSuite) TestEmojiGetNotFound() {
 	b, err := io.ReadAll(recorder.Body)
 	suite.NoError(err)
 	suite.NotNil(b)
-	suite.Equal(`{"error":"Not Found"}`, string(b))
+	suite.Equal(`{"error":"Not Found","code":404}`, string(b))
 }
 
 func TestEmojiGetTestSuite(t *testing.T) {
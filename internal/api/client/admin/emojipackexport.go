@@ -0,0 +1,95 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// EmojiPackGETHandler swagger:operation GET /api/v1/admin/custom_emojis/packs emojiPackExport
+//
+// Export local custom emojis as a Pleroma/Akkoma-style emoji pack.
+//
+// The pack is a zip archive containing a `pack.json` manifest, and the image of each exported emoji.
+// Only enabled emojis are exported.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/zip
+//
+//	parameters:
+//	-
+//		name: category
+//		in: query
+//		description: Only export emojis in the category with this name.
+//		type: string
+//		required: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: Zip archive of the emoji pack.
+//			schema:
+//				type: file
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'500':
+//			description: internal server error
+func (m *Module) EmojiPackGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		api.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	content, errWithCode := m.processor.AdminEmojiPackExport(c.Request.Context(), authed, c.Query(CategoryQueryKey))
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	extraHeaders := map[string]string{
+		"Content-Disposition": `attachment; filename="emojis.zip"`,
+	}
+	c.DataFromReader(http.StatusOK, content.ContentLength, content.ContentType, content.Content, extraHeaders)
+}
@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiStaticsRegenerateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiStaticsRegenerateTestSuite) TestEmojiStaticsRegenerate() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	// remove the static from storage so there's something to regenerate
+	if err := suite.storage.Delete(context.Background(), testEmoji.ImageStaticPath); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.EmojiRegenerateStaticsPath, "")
+
+	suite.adminModule.EmojiStaticsRegeneratePOSTHandler(ctx)
+
+	// regeneration happens asynchronously, so we should just be told it's accepted
+	suite.Equal(http.StatusAccepted, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"message":"accepted"}`, string(b))
+
+	// the static should be back in storage soon
+	if !testrig.WaitFor(func() bool {
+		static, _ := suite.storage.Get(context.Background(), testEmoji.ImageStaticPath)
+		return len(static) == testEmoji.ImageStaticFileSize
+	}) {
+		suite.FailNow("timed out waiting for emoji static to be regenerated")
+	}
+}
+
+func TestEmojiStaticsRegenerateTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiStaticsRegenerateTestSuite{})
+}
@@ -0,0 +1,107 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiUpdateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiUpdateTestSuite) updateEmoji(id string, fields map[string]string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", fields)
+	if err != nil {
+		panic(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPatch, requestBody.Bytes(), admin.EmojiPathWithID, w.FormDataContentType())
+	ctx.AddParam(admin.IDKey, id)
+
+	suite.adminModule.EmojiPATCHHandler(ctx)
+	return recorder
+}
+
+func (suite *EmojiUpdateTestSuite) TestEmojiUpdateAttribution() {
+	testEmoji := suite.testEmojis["rainbow"]
+
+	recorder := suite.updateEmoji(testEmoji.ID, map[string]string{
+		"author":     "Volpeon",
+		"license":    "CC BY-NC-SA 4.0",
+		"source_url": "https://volpeon.ink/emojis/neocat/",
+	})
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	adminEmoji := &apimodel.AdminEmoji{}
+	if err := json.Unmarshal(b, adminEmoji); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("Volpeon", adminEmoji.Author)
+	suite.Equal("CC BY-NC-SA 4.0", adminEmoji.License)
+	suite.Equal("https://volpeon.ink/emojis/neocat/", adminEmoji.SourceURL)
+
+	// clearing one field should leave the others alone
+	recorder = suite.updateEmoji(testEmoji.ID, map[string]string{
+		"license": "",
+	})
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.Equal("Volpeon", dbEmoji.Author)
+	suite.Empty(dbEmoji.License)
+	suite.Equal("https://volpeon.ink/emojis/neocat/", dbEmoji.SourceURL)
+}
+
+func (suite *EmojiUpdateTestSuite) TestEmojiUpdateBadSourceURL() {
+	recorder := suite.updateEmoji(suite.testEmojis["rainbow"].ID, map[string]string{
+		"source_url": "javascript:alert(1)",
+	})
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: emoji source url javascript:alert(1) was not an http or https url","code":400}`, string(b))
+}
+
+func (suite *EmojiUpdateTestSuite) TestEmojiUpdateNotFound() {
+	recorder := suite.updateEmoji("01GF8VRXX1R00X7XH8973Z29R1", map[string]string{
+		"author": "someone",
+	})
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestEmojiUpdateTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiUpdateTestSuite{})
+}
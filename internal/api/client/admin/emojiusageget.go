@@ -0,0 +1,157 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// EmojiUsageGETHandler swagger:operation GET /api/v1/admin/custom_emojis/usage emojiUsageGet
+//
+// View how many statuses and accounts use each emoji known by this instance.
+//
+// Useful for finding unused local emojis to prune, or popular remote emojis worth copying locally.
+// Counts are of statuses and accounts currently stored on this instance.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: domain
+//		type: string
+//		description: >-
+//			Show only emojis from the given domain. Use `local` for local emojis only,
+//			or `all` (the default) for emojis from all domains, including local.
+//		default: all
+//		in: query
+//	-
+//		name: order
+//		type: string
+//		description: >-
+//			Sort by total use count, either `desc` (most used first) or `asc` (least used first).
+//		enum:
+//			- desc
+//			- asc
+//		default: desc
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of emojis to return. Less than 1 means unlimited (all emojis).
+//		default: 50
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: An array of emoji usage counts.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminEmojiUsage"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) EmojiUsageGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		api.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	limit := 50
+	limitString := c.Query(LimitKey)
+	if limitString != "" {
+		i, err := strconv.ParseInt(limitString, 10, 64)
+		if err != nil {
+			err := fmt.Errorf("error parsing %s: %s", LimitKey, err)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		limit = int(i)
+	}
+	if limit < 0 {
+		limit = 0
+	}
+
+	var ascending bool
+	switch order := strings.ToLower(c.Query(OrderQueryKey)); order {
+	case "", "desc":
+		ascending = false
+	case "asc":
+		ascending = true
+	default:
+		err := fmt.Errorf("%s %s not recognized; accepted values are 'desc', 'asc'", OrderQueryKey, order)
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	domain := strings.ToLower(c.Query(DomainQueryKey))
+	if domain == "" || domain == "*" {
+		// default is to show all domains
+		domain = db.EmojiAllDomains
+	} else if domain == "local" || domain == config.GetHost() || domain == config.GetAccountDomain() {
+		// pass empty string for local domain
+		domain = ""
+	}
+
+	usage, errWithCode := m.processor.AdminEmojiUsageGet(c.Request.Context(), authed, domain, ascending, limit)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
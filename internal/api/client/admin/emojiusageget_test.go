@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type EmojiUsageGetTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiUsageGetTestSuite) getUsage(query string) []*apimodel.AdminEmojiUsage {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.EmojiUsagePath+query, "")
+
+	suite.adminModule.EmojiUsageGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	usage := []*apimodel.AdminEmojiUsage{}
+	if err := json.Unmarshal(b, &usage); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return usage
+}
+
+func (suite *EmojiUsageGetTestSuite) TestEmojiUsageGet() {
+	usage := suite.getUsage("")
+	suite.Len(usage, 2)
+
+	suite.Equal("rainbow", usage[0].Emoji.Shortcode)
+	suite.Equal(1, usage[0].StatusesCount)
+	suite.Equal(0, usage[0].AccountsCount)
+
+	suite.Equal("yell", usage[1].Emoji.Shortcode)
+	suite.Equal("fossbros-anonymous.io", usage[1].Emoji.Domain)
+	suite.Equal(0, usage[1].StatusesCount)
+	suite.Equal(0, usage[1].AccountsCount)
+}
+
+func (suite *EmojiUsageGetTestSuite) TestEmojiUsageGetLocalAscending() {
+	usage := suite.getUsage("?domain=local&order=asc")
+	suite.Len(usage, 1)
+	suite.Equal("rainbow", usage[0].Emoji.Shortcode)
+}
+
+func (suite *EmojiUsageGetTestSuite) TestEmojiUsageGetBadOrder() {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.EmojiUsagePath+"?order=sideways", "")
+
+	suite.adminModule.EmojiUsageGETHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: order sideways not recognized; accepted values are 'desc', 'asc'","code":400}`, string(b))
+}
+
+func TestEmojiUsageGetTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiUsageGetTestSuite{})
+}
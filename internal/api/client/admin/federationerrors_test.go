@@ -0,0 +1,95 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+)
+
+type FederationErrorsTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *FederationErrorsTestSuite) TestFederationErrorsGet() {
+	fedErrors := suite.federator.TransportController().FederationErrors()
+	fedErrors.Record(transport.FederationError{
+		Domain:     "fossbros-anonymous.io",
+		Kind:       transport.FederationErrorHTTPStatus,
+		URL:        "https://fossbros-anonymous.io/inbox",
+		StatusCode: http.StatusBadGateway,
+		Message:    `http response "502 Bad Gateway"`,
+	})
+	fedErrors.Record(transport.FederationError{
+		Domain:     "fossbros-anonymous.io",
+		Inbound:    true,
+		Kind:       transport.FederationErrorSignature,
+		StatusCode: http.StatusUnauthorized,
+		Message:    "authentication not passed",
+	})
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.FederationErrorsPath, "")
+	suite.adminModule.FederationErrorDomainsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiDomains := []*apimodel.AdminFederationErrorDomain{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiDomains))
+	suite.Len(apiDomains, 1)
+	suite.Equal("fossbros-anonymous.io", apiDomains[0].Domain)
+	suite.Equal(2, apiDomains[0].Count)
+	suite.Equal("inbound", apiDomains[0].LatestError.Direction)
+
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.FederationErrorsPathWithDomain, "")
+	ctx.AddParam(admin.DomainKey, "Fossbros-Anonymous.io")
+	suite.adminModule.FederationErrorsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiErrs := []*apimodel.AdminFederationError{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiErrs))
+	suite.Len(apiErrs, 2)
+	suite.Equal("inbound", apiErrs[0].Direction)
+	suite.Equal("signature", apiErrs[0].Kind)
+	suite.Empty(apiErrs[0].URL)
+	suite.Equal("outbound", apiErrs[1].Direction)
+	suite.Equal("http_status", apiErrs[1].Kind)
+	suite.Equal(http.StatusBadGateway, apiErrs[1].StatusCode)
+	suite.Equal("https://fossbros-anonymous.io/inbox", apiErrs[1].URL)
+}
+
+func (suite *FederationErrorsTestSuite) TestFederationErrorsGetNone() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.FederationErrorsPathWithDomain, "")
+	ctx.AddParam(admin.DomainKey, "example.org")
+	suite.adminModule.FederationErrorsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("[]", recorder.Body.String())
+}
+
+func TestFederationErrorsTestSuite(t *testing.T) {
+	suite.Run(t, new(FederationErrorsTestSuite))
+}
@@ -0,0 +1,107 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type LegalDocumentTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *LegalDocumentTestSuite) postDocument(kind string, text string, changelog string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"kind":               kind,
+		"text":               text,
+		"changelog":          changelog,
+		"require_acceptance": "true",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody.Bytes(), admin.LegalDocumentsPath, w.FormDataContentType())
+
+	suite.adminModule.LegalDocumentPOSTHandler(ctx)
+	return recorder
+}
+
+func (suite *LegalDocumentTestSuite) TestLegalDocumentPostGet() {
+	recorder := suite.postDocument("terms_of_service", "Be nice to each other.", "")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.postDocument("terms_of_service", "Be **very** nice to each other.", "Clarified how nice you have to be.")
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiDocument := &apimodel.LegalDocument{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(apiDocument))
+	suite.Equal("terms_of_service", apiDocument.Kind)
+	suite.Equal(2, apiDocument.Version)
+	suite.Contains(apiDocument.Content, "<strong>very</strong>")
+	suite.Equal("Clarified how nice you have to be.", apiDocument.Changelog)
+	suite.True(apiDocument.RequireAcceptance)
+
+	// fetch it again by id
+	recorder = httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.LegalDocumentsPathWithID, "")
+	ctx.AddParam(admin.IDKey, apiDocument.ID)
+	suite.adminModule.LegalDocumentGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	fetched := &apimodel.LegalDocument{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(fetched))
+	suite.Equal(apiDocument, fetched)
+
+	// both versions should be listed, newest first
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.LegalDocumentsPath+"?kind=terms_of_service", "")
+	suite.adminModule.LegalDocumentsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiDocuments := []*apimodel.LegalDocument{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiDocuments))
+	if suite.Len(apiDocuments, 2) {
+		suite.Equal(2, apiDocuments[0].Version)
+		suite.Equal(1, apiDocuments[1].Version)
+	}
+}
+
+func (suite *LegalDocumentTestSuite) TestLegalDocumentPostBadKind() {
+	recorder := suite.postDocument("code_of_conduct", "Be nice to each other.", "")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *LegalDocumentTestSuite) TestLegalDocumentPostEmpty() {
+	recorder := suite.postDocument("privacy_policy", "", "")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestLegalDocumentTestSuite(t *testing.T) {
+	suite.Run(t, new(LegalDocumentTestSuite))
+}
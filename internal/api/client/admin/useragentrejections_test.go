@@ -0,0 +1,62 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/useragent"
+)
+
+type UserAgentRejectionsTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *UserAgentRejectionsTestSuite) TestUserAgentRejectionsGet() {
+	gptBot := useragent.Rule{Kind: useragent.KindAIScraper, Pattern: "GPTBot"}
+	curl := useragent.Rule{Kind: useragent.KindPattern, Pattern: "^curl/"}
+	useragent.RecordRejection(gptBot)
+	useragent.RecordRejection(gptBot)
+	useragent.RecordRejection(curl)
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.UserAgentRejectionsPath, "")
+	suite.adminModule.UserAgentRejectionsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiRejections := []*apimodel.AdminUserAgentRejection{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiRejections))
+
+	counts := make(map[string]int)
+	for _, r := range apiRejections {
+		counts[r.Kind+" "+r.Pattern] = r.Count
+	}
+	suite.GreaterOrEqual(counts["ai_scraper GPTBot"], 2)
+	suite.GreaterOrEqual(counts["pattern ^curl/"], 1)
+}
+
+func TestUserAgentRejectionsTestSuite(t *testing.T) {
+	suite.Run(t, new(UserAgentRejectionsTestSuite))
+}
@@ -20,6 +20,7 @@ package auth
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -27,6 +28,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 	"github.com/superseriousbusiness/gotosocial/internal/router"
+	limiter "github.com/ulule/limiter/v3"
+	memory "github.com/ulule/limiter/v3/drivers/store/memory"
 )
 
 /* #nosec G101 */
@@ -34,6 +37,9 @@ const (
 	// AuthSignInPath is the API path for users to sign in through
 	AuthSignInPath = "/auth/sign_in"
 
+	// AuthRecoveryPath is the API path for users to sign in with a recovery code when the OIDC provider is unavailable
+	AuthRecoveryPath = "/auth/recovery"
+
 	// CheckYourEmailPath users land here after registering a new account, instructs them to confirm thier email
 	CheckYourEmailPath = "/check_your_email"
 
@@ -64,6 +70,12 @@ const (
 	sessionScope         = "scope"
 	sessionInternalState = "internal_state"
 	sessionClientState   = "client_state"
+
+	recoveryLimitPeriod   = 5 * time.Minute
+	recoveryLimitAttempts = 10
+
+	formRemember    = "remember"
+	formAcceptLegal = "accept_legal"
 )
 
 // Module implements the ClientAPIModule interface for
@@ -71,6 +83,11 @@ type Module struct {
 	db        db.DB
 	idp       oidc.IDP
 	processor processing.Processor
+
+	// recoveryLimiter limits how often one client can try a
+	// recovery code, since each attempt may cost several bcrypt
+	// comparisons and the endpoint is unauthenticated
+	recoveryLimiter *limiter.Limiter
 }
 
 // New returns a new auth module
@@ -79,6 +96,10 @@ func New(db db.DB, idp oidc.IDP, processor processing.Processor) api.ClientModul
 		db:        db,
 		idp:       idp,
 		processor: processor,
+		recoveryLimiter: limiter.New(memory.NewStore(), limiter.Rate{
+			Period: recoveryLimitPeriod,
+			Limit:  recoveryLimitAttempts,
+		}),
 	}
 }
 
@@ -87,6 +108,9 @@ func (m *Module) Route(s router.Router) error {
 	s.AttachHandler(http.MethodGet, AuthSignInPath, m.SignInGETHandler)
 	s.AttachHandler(http.MethodPost, AuthSignInPath, m.SignInPOSTHandler)
 
+	s.AttachHandler(http.MethodGet, AuthRecoveryPath, m.RecoveryGETHandler)
+	s.AttachHandler(http.MethodPost, AuthRecoveryPath, m.RecoveryPOSTHandler)
+
 	s.AttachHandler(http.MethodPost, OauthTokenPath, m.TokenPOSTHandler)
 
 	s.AttachHandler(http.MethodGet, OauthAuthorizePath, m.AuthorizeGETHandler)
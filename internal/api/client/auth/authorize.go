@@ -33,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -140,6 +141,29 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 		return
 	}
 
+	// new versions of the terms of service or privacy policy may need
+	// accepting, in which case the authorize page always has to be shown
+	legal, errWithCode := m.processor.UserLegalDocumentsToAccept(c.Request.Context(), user)
+	if errWithCode != nil {
+		m.clearSession(s)
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	// if the user previously chose to remember this application, and they
+	// already consented to the requested scope, we don't need to ask again
+	remembered, err := m.consentRemembered(c.Request.Context(), user.ID, app.ClientID, scope)
+	if err != nil {
+		m.clearSession(s)
+		api.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGet)
+		return
+	}
+
+	if remembered && len(legal) == 0 {
+		m.AuthorizePOSTHandler(c)
+		return
+	}
+
 	instance, errWithCode := m.processor.InstanceGet(c.Request.Context(), config.GetHost())
 	if errWithCode != nil {
 		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
@@ -150,18 +174,28 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 	// about the app that's trying to authorize, and the scope of the request.
 	// They can then approve it if it looks OK to them, which will POST to the AuthorizePOSTHandler
 	c.HTML(http.StatusOK, "authorize.tmpl", gin.H{
-		"appname":    app.Name,
-		"appwebsite": app.Website,
-		"redirect":   redirect,
-		"scope":      scope,
-		"user":       acct.Username,
-		"instance":   instance,
+		"appname":      app.Name,
+		"appwebsite":   app.Website,
+		"redirect":     redirect,
+		"scope":        scope,
+		"scopes":       oauth.ScopeDescriptions(scope),
+		"rememberable": consentRememberable(scope),
+		"legal":        legal,
+		"user":         acct.Username,
+		"instance":     instance,
+		"lang":         i18n.Negotiate(c, user.Locale),
 	})
 }
 
 // AuthorizePOSTHandler should be served as POST at https://example.org/oauth/authorize
 // At this point we assume that the user has A) logged in and B) accepted that the app should act for them,
 // so we should proceed with the authentication flow and generate an oauth token for them if we can.
+//
+// If the user ticked the box to remember this application, their consent will be stored so that
+// future authorizations for the same (or a narrower) scope can skip the authorize page.
+//
+// If new versions of the terms of service or privacy policy need accepting, the user must
+// have ticked the box to accept them, otherwise they're sent back to the authorize page.
 func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 	s := sessions.Default(c)
 
@@ -242,6 +276,35 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	legal, errWithCode := m.processor.UserLegalDocumentsToAccept(c.Request.Context(), user)
+	if errWithCode != nil {
+		m.clearSession(s)
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	if len(legal) != 0 {
+		if c.PostForm(formAcceptLegal) != "true" {
+			// back to the authorize page, which asks them to accept
+			c.Redirect(http.StatusSeeOther, OauthAuthorizePath)
+			return
+		}
+
+		if errWithCode := m.processor.UserLegalDocumentsAccept(c.Request.Context(), user); errWithCode != nil {
+			m.clearSession(s)
+			api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+			return
+		}
+	}
+
+	if c.PostForm(formRemember) == "true" && consentRememberable(scope) {
+		if err := m.rememberConsent(c.Request.Context(), userID, clientID, scope); err != nil {
+			m.clearSession(s)
+			api.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGet)
+			return
+		}
+	}
+
 	if redirectURI != oauth.OOBURI {
 		// we're done with the session now, so just clear it out
 		m.clearSession(s)
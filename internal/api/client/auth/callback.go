@@ -31,6 +31,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -160,6 +161,11 @@ func (m *Module) parseUserFromClaims(ctx context.Context, claims *oidc.Claims, i
 	// we don't have a confirmed or unconfirmed user with the claimed email address
 	// however, because we trust the OIDC provider, we should now create a user + account with the provided claims
 
+	// check if the email address is on an accepted domain, if we're only accepting some
+	if err := validate.SignUpEmailDomain(claims.Email, config.GetAccountsSignupEmailDomains()); err != nil {
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
 	// check if the email address is available for use; if it's not there's nothing we can so
 	emailAvailable, err := m.db.IsEmailAvailable(ctx, claims.Email)
 	if err != nil {
@@ -0,0 +1,98 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// consentRememberable returns true if the user is allowed to skip
+// the authorize screen for the given scope in future, ie., if the
+// scope doesn't include any scopes that always require consent.
+func consentRememberable(scope string) bool {
+	return !oauth.ScopesIntersect(scope, config.GetAccountsForceConsentScopes())
+}
+
+// consentRemembered returns true if the given user has previously chosen to remember
+// the given client, and their remembered consent covers the requested scope.
+func (m *Module) consentRemembered(ctx context.Context, userID string, clientID string, scope string) (bool, error) {
+	if !consentRememberable(scope) {
+		return false, nil
+	}
+
+	consent := &gtsmodel.ApplicationConsent{}
+	if err := m.db.GetWhere(ctx, []db.Where{
+		{Key: "user_id", Value: userID},
+		{Key: "client_id", Value: clientID},
+	}, consent); err != nil {
+		if err == db.ErrNoEntries {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return oauth.ScopesCovered(consent.Scope, scope), nil
+}
+
+// rememberConsent stores the user's consent for the given client to act with
+// the given scope, adding to any consent they've previously given to that client.
+func (m *Module) rememberConsent(ctx context.Context, userID string, clientID string, scope string) error {
+	consent := &gtsmodel.ApplicationConsent{}
+	if err := m.db.GetWhere(ctx, []db.Where{
+		{Key: "user_id", Value: userID},
+		{Key: "client_id", Value: clientID},
+	}, consent); err != nil {
+		if err != db.ErrNoEntries {
+			return err
+		}
+
+		// no consent stored yet, so make a new one
+		consentID, err := id.NewULID()
+		if err != nil {
+			return err
+		}
+
+		return m.db.Put(ctx, &gtsmodel.ApplicationConsent{
+			ID:       consentID,
+			UserID:   userID,
+			ClientID: clientID,
+			Scope:    strings.Join(strings.Fields(scope), " "),
+		})
+	}
+
+	// add any newly consented scopes to the existing ones
+	scopes := strings.Fields(consent.Scope)
+	for _, s := range strings.Fields(scope) {
+		if !oauth.ScopesCovered(strings.Join(scopes, " "), s) {
+			scopes = append(scopes, s)
+		}
+	}
+
+	consent.Scope = strings.Join(scopes, " ")
+	consent.UpdatedAt = time.Now()
+	return m.db.UpdateByID(ctx, consent, consent.ID, "scope", "updated_at")
+}
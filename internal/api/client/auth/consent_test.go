@@ -0,0 +1,119 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package auth_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/auth"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type AuthConsentTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *AuthConsentTestSuite) authorizeContext(scope string) (*gin.Context, func() (int, string, string)) {
+	ctx, recorder := suite.newContext(http.MethodGet, auth.OauthAuthorizePath, nil, "")
+
+	testSession := sessions.Default(ctx)
+	testSession.Set(sessionUserID, suite.testUsers["local_account_1"].ID)
+	testSession.Set(sessionClientID, suite.testApplications["application_1"].ClientID)
+	testSession.Set("redirect_uri", suite.testApplications["application_1"].RedirectURI)
+	testSession.Set("response_type", "code")
+	testSession.Set("scope", scope)
+	if err := testSession.Save(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return ctx, func() (int, string, string) {
+		b, err := ioutil.ReadAll(recorder.Body)
+		suite.NoError(err)
+		return recorder.Code, recorder.Header().Get("Location"), string(b)
+	}
+}
+
+func (suite *AuthConsentTestSuite) putConsent(scope string) {
+	consentID, err := id.NewULID()
+	suite.NoError(err)
+
+	suite.NoError(suite.db.Put(context.Background(), &gtsmodel.ApplicationConsent{
+		ID:       consentID,
+		UserID:   suite.testUsers["local_account_1"].ID,
+		ClientID: suite.testApplications["application_1"].ClientID,
+		Scope:    scope,
+	}))
+}
+
+func (suite *AuthConsentTestSuite) TestAuthorizeNoConsent() {
+	ctx, result := suite.authorizeContext("read write")
+	suite.authModule.AuthorizeGETHandler(ctx)
+
+	code, _, body := result()
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, "read all of your account data")
+	suite.Contains(body, "modify all of your account data")
+	suite.Contains(body, "Remember this application")
+}
+
+func (suite *AuthConsentTestSuite) TestAuthorizeRememberedConsent() {
+	suite.putConsent("read write")
+
+	ctx, result := suite.authorizeContext("read:statuses write")
+	suite.authModule.AuthorizeGETHandler(ctx)
+
+	// we should be sent straight back to the application with a code
+	code, location, _ := result()
+	suite.Equal(http.StatusFound, code)
+	suite.Contains(location, "code=")
+}
+
+func (suite *AuthConsentTestSuite) TestAuthorizeRememberedConsentTooNarrow() {
+	suite.putConsent("read")
+
+	ctx, result := suite.authorizeContext("read write")
+	suite.authModule.AuthorizeGETHandler(ctx)
+
+	code, _, _ := result()
+	suite.Equal(http.StatusOK, code)
+}
+
+func (suite *AuthConsentTestSuite) TestAuthorizeRememberedConsentForcedScope() {
+	suite.putConsent("read admin")
+
+	ctx, result := suite.authorizeContext("read admin:read")
+	suite.authModule.AuthorizeGETHandler(ctx)
+
+	// admin scopes always need consent, and can't be remembered
+	code, _, body := result()
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, "read sensitive data of all users on the instance")
+	suite.NotContains(body, "Remember this application")
+}
+
+func TestAuthConsentTestSuite(t *testing.T) {
+	suite.Run(t, &AuthConsentTestSuite{})
+}
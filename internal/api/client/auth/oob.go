@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -104,6 +105,7 @@ func (m *Module) OobHandler(c *gin.Context) {
 
 	c.HTML(http.StatusOK, "oob.tmpl", gin.H{
 		"instance": instance,
+		"lang":     i18n.Negotiate(c, user.Locale),
 		"user":     acct.Username,
 		"oobToken": oobToken,
 		"scope":    scope,
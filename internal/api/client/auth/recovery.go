@@ -0,0 +1,164 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/api/security"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// recoveryLogin wraps a form-submitted email address and recovery code.
+type recoveryLogin struct {
+	Email string `form:"username"`
+	Code  string `form:"code"`
+}
+
+// RecoveryGETHandler should be served at https://example.org/auth/recovery.
+// When an idp provider is set, this presents a page where users can sign in with one of
+// the recovery codes an admin generated for them, for when the idp provider is unavailable.
+// The form will then POST to the recovery page, which will be handled by RecoveryPOSTHandler.
+func (m *Module) RecoveryGETHandler(c *gin.Context) {
+	if _, err := api.NegotiateAccept(c, api.HTMLAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if m.idp == nil {
+		err := errors.New("recovery codes are not used on this instance, sign in with your password instead")
+		api.ErrorHandler(c, gtserror.NewErrorNotFound(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGet(c.Request.Context(), config.GetHost())
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.HTML(http.StatusOK, "recovery.tmpl", gin.H{
+		"instance": instance,
+		"lang":     i18n.Negotiate(c, ""),
+	})
+}
+
+// RecoveryPOSTHandler should be served at https://example.org/auth/recovery.
+// It signs the user in if the recovery code they gave is one of theirs, and then
+// redirects to the auth handler served at /auth, just like SignInPOSTHandler.
+// Each recovery code can only be used once.
+func (m *Module) RecoveryPOSTHandler(c *gin.Context) {
+	s := sessions.Default(c)
+
+	if m.idp == nil {
+		err := errors.New("recovery codes are not used on this instance, sign in with your password instead")
+		api.ErrorHandler(c, gtserror.NewErrorNotFound(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	limit, err := m.recoveryLimiter.Get(c.Request.Context(), security.RateLimitKey(c))
+	if err != nil {
+		err := fmt.Errorf("error checking recovery code rate limit: %s", err)
+		api.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGet)
+		return
+	}
+	if limit.Reached {
+		err := errors.New("too many recovery code attempts, please wait a few minutes and try again")
+		api.ErrorHandler(c, gtserror.NewErrorTooManyRequests(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &recoveryLogin{}
+	if err := c.ShouldBind(form); err != nil {
+		m.clearSession(s)
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGet)
+		return
+	}
+
+	userid, errWithCode := m.ValidateRecoveryCode(c.Request.Context(), form.Email, form.Code)
+	if errWithCode != nil {
+		// don't clear session here, so the user can just press back and try again
+		// if they accidentally mistyped their code or something
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	s.Set(sessionUserID, userid)
+	if err := s.Save(); err != nil {
+		err := fmt.Errorf("error saving user id onto session: %s", err)
+		api.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGet)
+		return
+	}
+
+	c.Redirect(http.StatusFound, OauthAuthorizePath)
+}
+
+// ValidateRecoveryCode takes an email address and a recovery code. If the code matches one
+// of the unused recovery codes of the user with that email address, it's used up, and the
+// userid (a ulid) of that user is returned, so that it can be used in further Oauth flows.
+func (m *Module) ValidateRecoveryCode(ctx context.Context, email string, code string) (string, gtserror.WithCode) {
+	if email == "" || code == "" {
+		err := errors.New("email or recovery code was not provided")
+		return incorrectRecoveryCode(err)
+	}
+
+	user, err := m.db.GetUserByEmailAddress(ctx, email)
+	if err != nil {
+		err := fmt.Errorf("user %s was not retrievable from db during recovery code sign in attempt: %s", email, err)
+		return incorrectRecoveryCode(err)
+	}
+
+	i := oauth.MatchRecoveryCode(user.RecoveryCodes, code)
+	if i == -1 {
+		err := fmt.Errorf("recovery code didn't match for user %s during sign in attempt", user.Email)
+		return incorrectRecoveryCode(err)
+	}
+
+	// use the code up in one conditional update, so that
+	// concurrent requests can't both sign in with it
+	if err := m.db.UseRecoveryCode(ctx, user, user.RecoveryCodes[i]); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("recovery code of user %s was already used", user.Email)
+			return incorrectRecoveryCode(err)
+		}
+		err := fmt.Errorf("db error using up recovery code of user %s: %s", user.Email, err)
+		return "", gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice)
+	}
+
+	log.Infof("user %s signed in with a recovery code, %d recovery codes left", user.ID, len(user.RecoveryCodes)-1)
+	return user.ID, nil
+}
+
+// incorrectRecoveryCode wraps the given error in a gtserror.WithCode, and returns
+// only a generic 'safe' error message to the user, to not give any info away.
+func incorrectRecoveryCode(err error) (string, gtserror.WithCode) {
+	safeErr := fmt.Errorf("recovery code/email combination was incorrect")
+	return "", gtserror.NewErrorUnauthorized(err, safeErr.Error(), oauth.HelpfulAdvice)
+}
@@ -0,0 +1,124 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/auth"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/oidc"
+)
+
+// unavailableIDP stands in for an oidc provider that can't be reached.
+type unavailableIDP struct{}
+
+func (unavailableIDP) HandleCallback(ctx context.Context, code string) (*oidc.Claims, gtserror.WithCode) {
+	return nil, gtserror.NewErrorInternalError(context.DeadlineExceeded)
+}
+
+func (unavailableIDP) AuthCodeURL(state string) string {
+	return "https://idp.example.org/auth?state=" + state
+}
+
+type AuthRecoveryTestSuite struct {
+	AuthStandardTestSuite
+	codes []string
+}
+
+func (suite *AuthRecoveryTestSuite) SetupTest() {
+	suite.AuthStandardTestSuite.SetupTest()
+	suite.authModule = auth.New(suite.db, unavailableIDP{}, suite.processor).(*auth.Module)
+
+	codes, hashes, err := oauth.NewRecoveryCodes()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.codes = codes
+
+	user := suite.testUsers["local_account_1"]
+	user.RecoveryCodes = hashes
+	if _, err := suite.db.UpdateUser(context.Background(), user, "recovery_codes"); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *AuthRecoveryTestSuite) postRecovery(email string, code string) (int, string, interface{}) {
+	form := url.Values{"username": {email}, "code": {code}}
+	ctx, recorder := suite.newContext(http.MethodPost, auth.AuthRecoveryPath, []byte(form.Encode()), "application/x-www-form-urlencoded")
+
+	suite.authModule.RecoveryPOSTHandler(ctx)
+	return recorder.Code, recorder.Header().Get("Location"), sessions.Default(ctx).Get(sessionUserID)
+}
+
+func (suite *AuthRecoveryTestSuite) TestRecoverySignIn() {
+	email := suite.testUsers["local_account_1"].Email
+
+	code, location, userID := suite.postRecovery(email, suite.codes[2])
+	suite.Equal(http.StatusFound, code)
+	suite.Equal(auth.OauthAuthorizePath, location)
+	suite.Equal(suite.testUsers["local_account_1"].ID, userID)
+
+	user, err := suite.db.GetUserByEmailAddress(context.Background(), email)
+	suite.NoError(err)
+	suite.Len(user.RecoveryCodes, oauth.RecoveryCodesCount-1)
+
+	// each code only works once
+	code, _, userID = suite.postRecovery(email, suite.codes[2])
+	suite.Equal(http.StatusUnauthorized, code)
+	suite.Nil(userID)
+
+	// but the others still work
+	code, _, _ = suite.postRecovery(email, suite.codes[3])
+	suite.Equal(http.StatusFound, code)
+}
+
+func (suite *AuthRecoveryTestSuite) TestRecoverySignInWrongUser() {
+	code, _, userID := suite.postRecovery(suite.testUsers["admin_account"].Email, suite.codes[0])
+	suite.Equal(http.StatusUnauthorized, code)
+	suite.Nil(userID)
+}
+
+func (suite *AuthRecoveryTestSuite) TestRecoveryPageWithoutIDP() {
+	suite.authModule = auth.New(suite.db, nil, suite.processor).(*auth.Module)
+
+	ctx, recorder := suite.newContext(http.MethodGet, auth.AuthRecoveryPath, nil, "")
+	suite.authModule.RecoveryGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+
+	code, _, _ := suite.postRecovery(suite.testUsers["local_account_1"].Email, suite.codes[0])
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *AuthRecoveryTestSuite) TestRecoveryPage() {
+	ctx, recorder := suite.newContext(http.MethodGet, auth.AuthRecoveryPath, nil, "")
+	suite.authModule.RecoveryGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Contains(recorder.Body.String(), `action="/auth/recovery"`)
+}
+
+func TestAuthRecoveryTestSuite(t *testing.T) {
+	suite.Run(t, &AuthRecoveryTestSuite{})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -59,6 +60,7 @@ func (m *Module) SignInGETHandler(c *gin.Context) {
 		// no idp provider, use our own funky little sign in page
 		c.HTML(http.StatusOK, "sign-in.tmpl", gin.H{
 			"instance": instance,
+			"lang":     i18n.Negotiate(c, ""),
 		})
 		return
 	}
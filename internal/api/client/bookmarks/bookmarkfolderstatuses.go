@@ -0,0 +1,189 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bookmarks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// BookmarkFolderStatusesPOSTHandler swagger:operation POST /api/v1/bookmarks/folders/{id}/statuses bookmarkFolderStatusesAdd
+//
+// File bookmarks in a bookmark folder.
+//
+// Only statuses bookmarked by the requesting account can be filed. A bookmark can only be filed in one folder
+// at a time, so bookmarks already filed in another folder are moved into this one.
+//
+// The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
+// The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
+//
+//	---
+//	tags:
+//	- bookmarks
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the bookmark folder.
+//		in: path
+//		required: true
+//	-
+//		name: status_ids[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of the bookmarked statuses to file in the bookmark folder.
+//		in: formData
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:bookmarks
+//
+//	responses:
+//		'200':
+//			description: The bookmarks were filed in the bookmark folder. An empty object is returned.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: one of the statuses is not bookmarked by the requesting account
+//		'500':
+//			description: internal server error
+func (m *Module) BookmarkFolderStatusesPOSTHandler(c *gin.Context) {
+	m.bookmarkFolderStatusesChange(c, m.processor.BookmarkFolderStatusesAdd)
+}
+
+// BookmarkFolderStatusesDELETEHandler swagger:operation DELETE /api/v1/bookmarks/folders/{id}/statuses bookmarkFolderStatusesRemove
+//
+// Take bookmarks out of a bookmark folder.
+//
+// The bookmarks themselves are kept, but are no longer filed in any folder.
+//
+// The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
+// The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
+//
+//	---
+//	tags:
+//	- bookmarks
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the bookmark folder.
+//		in: path
+//		required: true
+//	-
+//		name: status_ids[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of the bookmarked statuses to take out of the bookmark folder.
+//		in: formData
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:bookmarks
+//
+//	responses:
+//		'200':
+//			description: The bookmarks were taken out of the bookmark folder. An empty object is returned.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) BookmarkFolderStatusesDELETEHandler(c *gin.Context) {
+	m.bookmarkFolderStatusesChange(c, m.processor.BookmarkFolderStatusesRemove)
+}
+
+func (m *Module) bookmarkFolderStatusesChange(c *gin.Context, change func(context.Context, *oauth.Auth, string, []string) gtserror.WithCode) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	folderID := c.Param(IDKey)
+	if folderID == "" {
+		err := errors.New("no bookmark folder id specified")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &apimodel.BookmarkFolderStatusesChangeRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if len(form.StatusIDs) == 0 {
+		err := errors.New("no status ids specified")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if errWithCode := change(c.Request.Context(), authed, folderID, form.StatusIDs); errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,76 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bookmarks
+
+import (
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/router"
+)
+
+const (
+	// IDKey is the key to use for retrieving bookmark folder IDs in context
+	IDKey = "id"
+	// BasePath is the base URI path for serving bookmarks
+	BasePath = "/api/v1/bookmarks"
+	// FoldersPath is the path for viewing and creating bookmark folders
+	FoldersPath = BasePath + "/folders"
+	// FolderPathWithID is the path for viewing, renaming and deleting a single bookmark folder
+	FolderPathWithID = FoldersPath + "/:" + IDKey
+	// FolderStatusesPath is the path for filing bookmarks in a bookmark folder and taking them out of it
+	FolderStatusesPath = FolderPathWithID + "/statuses"
+
+	// FolderIDKey is the url query for only returning bookmarks filed in the given bookmark folder
+	FolderIDKey = "folder_id"
+
+	// MaxIDKey is the url query for setting a max bookmark ID to return
+	MaxIDKey = "max_id"
+	// MinIDKey is the url query for returning results immediately newer than the given bookmark ID
+	MinIDKey = "min_id"
+	// LimitKey is for specifying maximum number of results to return.
+	LimitKey = "limit"
+)
+
+// Module implements the ClientAPIModule interface for everything relating to viewing bookmarks
+type Module struct {
+	processor processing.Processor
+}
+
+// New returns a new bookmarks module
+func New(processor processing.Processor) api.ClientModule {
+	return &Module{
+		processor: processor,
+	}
+}
+
+// Route attaches all routes from this module to the given router
+func (m *Module) Route(r router.Router) error {
+	r.AttachHandler(http.MethodGet, BasePath, m.BookmarksGETHandler)
+
+	r.AttachHandler(http.MethodGet, FoldersPath, m.BookmarkFoldersGETHandler)
+	r.AttachHandler(http.MethodPost, FoldersPath, m.BookmarkFolderPOSTHandler)
+	r.AttachHandler(http.MethodGet, FolderPathWithID, m.BookmarkFolderGETHandler)
+	r.AttachHandler(http.MethodPut, FolderPathWithID, m.BookmarkFolderPUTHandler)
+	r.AttachHandler(http.MethodDelete, FolderPathWithID, m.BookmarkFolderDELETEHandler)
+	r.AttachHandler(http.MethodPost, FolderStatusesPath, m.BookmarkFolderStatusesPOSTHandler)
+	r.AttachHandler(http.MethodDelete, FolderStatusesPath, m.BookmarkFolderStatusesDELETEHandler)
+	return nil
+}
@@ -0,0 +1,289 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bookmarks_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/bookmarks"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type BookmarksTestSuite struct {
+	suite.Suite
+	db           db.DB
+	storage      storage.Driver
+	mediaManager media.Manager
+	federator    federation.Federator
+	processor    processing.Processor
+	emailSender  email.Sender
+
+	// standard suite models
+	testTokens       map[string]*gtsmodel.Token
+	testApplications map[string]*gtsmodel.Application
+	testUsers        map[string]*gtsmodel.User
+	testAccounts     map[string]*gtsmodel.Account
+	testStatuses     map[string]*gtsmodel.Status
+	testFolders      map[string]*gtsmodel.BookmarkFolder
+
+	// module being tested
+	bookmarksModule *bookmarks.Module
+}
+
+func (suite *BookmarksTestSuite) SetupSuite() {
+	suite.testTokens = testrig.NewTestTokens()
+	suite.testApplications = testrig.NewTestApplications()
+	suite.testUsers = testrig.NewTestUsers()
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
+	suite.testFolders = testrig.NewTestBookmarkFolders()
+}
+
+func (suite *BookmarksTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+	clientWorker := concurrency.NewWorkerPool[messages.FromClientAPI](-1, -1)
+
+	suite.db = testrig.NewTestDB()
+	suite.storage = testrig.NewInMemoryStorage()
+	suite.mediaManager = testrig.NewTestMediaManager(suite.db, suite.storage)
+	suite.federator = testrig.NewTestFederator(suite.db, testrig.NewTestTransportController(testrig.NewMockHTTPClient(nil, "../../../../testrig/media"), suite.db, fedWorker), suite.storage, suite.mediaManager, fedWorker)
+	suite.emailSender = testrig.NewEmailSender("../../../../web/template/", nil)
+	suite.processor = testrig.NewTestProcessor(suite.db, suite.storage, suite.federator, suite.emailSender, suite.mediaManager, clientWorker, fedWorker)
+	suite.bookmarksModule = bookmarks.New(suite.processor).(*bookmarks.Module)
+	testrig.StandardDBSetup(suite.db, nil)
+	testrig.StandardStorageSetup(suite.storage, "../../../../testrig/media")
+
+	suite.NoError(suite.processor.Start())
+}
+
+func (suite *BookmarksTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StandardStorageTeardown(suite.storage)
+}
+
+func (suite *BookmarksTestSuite) newContext(recorder *httptest.ResponseRecorder, requestMethod string, requestPath string, requestBody string) *gin.Context {
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Request = httptest.NewRequest(requestMethod, "http://localhost:8080"+requestPath, strings.NewReader(requestBody))
+	if requestBody != "" {
+		ctx.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	ctx.Request.Header.Set("accept", "application/json")
+	return ctx
+}
+
+func (suite *BookmarksTestSuite) getBookmarks(query string) ([]*apimodel.Status, string) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, fmt.Sprintf("%s?%s", bookmarks.BasePath, query), "")
+
+	suite.bookmarksModule.BookmarksGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiStatuses := []*apimodel.Status{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&apiStatuses))
+	return apiStatuses, recorder.Header().Get("Link")
+}
+
+func (suite *BookmarksTestSuite) TestGetBookmarks() {
+	authed := &oauth.Auth{
+		Application: suite.testApplications["application_1"],
+		User:        suite.testUsers["local_account_1"],
+		Account:     suite.testAccounts["local_account_1"],
+	}
+
+	// bookmark statuses in a different order to the one they were created in
+	statusKeys := []string{"admin_account_status_1", "local_account_2_status_1", "local_account_1_status_1"}
+	for _, key := range statusKeys {
+		apiStatus, errWithCode := suite.processor.StatusBookmark(context.Background(), authed, suite.testStatuses[key].ID)
+		suite.NoError(errWithCode)
+		suite.True(apiStatus.Bookmarked)
+
+		// bookmark IDs are only ordered between milliseconds
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	// most recently bookmarked comes first
+	apiStatuses, link := suite.getBookmarks("limit=2")
+	suite.Len(apiStatuses, 2)
+	suite.Equal(suite.testStatuses["local_account_1_status_1"].ID, apiStatuses[0].ID)
+	suite.Equal(suite.testStatuses["local_account_2_status_1"].ID, apiStatuses[1].ID)
+	suite.True(apiStatuses[0].Bookmarked)
+	suite.Contains(link, `rel="next"`)
+
+	// the next page starts after the bookmark of the last status returned
+	bookmark := &gtsmodel.StatusBookmark{}
+	suite.NoError(suite.db.GetWhere(context.Background(), []db.Where{
+		{Key: "account_id", Value: authed.Account.ID},
+		{Key: "status_id", Value: suite.testStatuses["local_account_2_status_1"].ID},
+	}, bookmark))
+	suite.Contains(link, "max_id="+bookmark.ID)
+
+	apiStatuses, _ = suite.getBookmarks("limit=2&max_id=" + bookmark.ID)
+	suite.Len(apiStatuses, 1)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].ID, apiStatuses[0].ID)
+
+	// unbookmarked statuses disappear
+	apiStatus, errWithCode := suite.processor.StatusUnbookmark(context.Background(), authed, suite.testStatuses["local_account_1_status_1"].ID)
+	suite.NoError(errWithCode)
+	suite.False(apiStatus.Bookmarked)
+
+	apiStatuses, _ = suite.getBookmarks("")
+	suite.Len(apiStatuses, 2)
+}
+
+func (suite *BookmarksTestSuite) TestGetBookmarksEmpty() {
+	apiStatuses, link := suite.getBookmarks("")
+	suite.Empty(apiStatuses)
+	suite.Empty(link)
+}
+
+func (suite *BookmarksTestSuite) fileBookmark(method string, folderID string, statusID string) int {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, method, bookmarks.FoldersPath+"/"+folderID+"/statuses", "status_ids[]="+statusID)
+	ctx.Params = gin.Params{gin.Param{Key: bookmarks.IDKey, Value: folderID}}
+	if method == http.MethodPost {
+		suite.bookmarksModule.BookmarkFolderStatusesPOSTHandler(ctx)
+	} else {
+		suite.bookmarksModule.BookmarkFolderStatusesDELETEHandler(ctx)
+	}
+	return recorder.Code
+}
+
+func (suite *BookmarksTestSuite) TestCreateBookmarkFolder() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bookmarks.FoldersPath, "title=%20knitting%20")
+	suite.bookmarksModule.BookmarkFolderPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	folder := &apimodel.BookmarkFolder{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(folder))
+	suite.NotEmpty(folder.ID)
+	suite.Equal("knitting", folder.Title)
+
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, bookmarks.FoldersPath, "")
+	suite.bookmarksModule.BookmarkFoldersGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	folders := []*apimodel.BookmarkFolder{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(&folders))
+	if suite.Len(folders, 2) {
+		suite.Equal(suite.testFolders["local_account_1_bookmark_folder_1"].ID, folders[0].ID)
+		suite.Equal(folder.ID, folders[1].ID)
+	}
+
+	// folders need a title
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodPost, bookmarks.FoldersPath, "title=")
+	suite.bookmarksModule.BookmarkFolderPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *BookmarksTestSuite) TestGetBookmarksInFolder() {
+	authed := &oauth.Auth{
+		Application: suite.testApplications["application_1"],
+		User:        suite.testUsers["local_account_1"],
+		Account:     suite.testAccounts["local_account_1"],
+	}
+	folderID := suite.testFolders["local_account_1_bookmark_folder_1"].ID
+	filedStatusID := suite.testStatuses["admin_account_status_1"].ID
+
+	for _, key := range []string{"admin_account_status_1", "local_account_2_status_1"} {
+		_, errWithCode := suite.processor.StatusBookmark(context.Background(), authed, suite.testStatuses[key].ID)
+		suite.NoError(errWithCode)
+	}
+
+	// only bookmarked statuses can be filed
+	suite.Equal(http.StatusUnprocessableEntity, suite.fileBookmark(http.MethodPost, folderID, suite.testStatuses["local_account_1_status_1"].ID))
+	suite.Equal(http.StatusOK, suite.fileBookmark(http.MethodPost, folderID, filedStatusID))
+
+	apiStatuses, _ := suite.getBookmarks("folder_id=" + folderID)
+	if suite.Len(apiStatuses, 1) {
+		suite.Equal(filedStatusID, apiStatuses[0].ID)
+	}
+
+	// filed bookmarks are still listed without a folder
+	apiStatuses, _ = suite.getBookmarks("")
+	suite.Len(apiStatuses, 2)
+
+	suite.Equal(http.StatusOK, suite.fileBookmark(http.MethodDelete, folderID, filedStatusID))
+	apiStatuses, _ = suite.getBookmarks("folder_id=" + folderID)
+	suite.Empty(apiStatuses)
+	apiStatuses, _ = suite.getBookmarks("")
+	suite.Len(apiStatuses, 2)
+}
+
+func (suite *BookmarksTestSuite) TestDeleteBookmarkFolder() {
+	authed := &oauth.Auth{
+		Application: suite.testApplications["application_1"],
+		User:        suite.testUsers["local_account_1"],
+		Account:     suite.testAccounts["local_account_1"],
+	}
+	folderID := suite.testFolders["local_account_1_bookmark_folder_1"].ID
+	statusID := suite.testStatuses["admin_account_status_1"].ID
+
+	_, errWithCode := suite.processor.StatusBookmark(context.Background(), authed, statusID)
+	suite.NoError(errWithCode)
+	suite.Equal(http.StatusOK, suite.fileBookmark(http.MethodPost, folderID, statusID))
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodDelete, bookmarks.FoldersPath+"/"+folderID, "")
+	ctx.Params = gin.Params{gin.Param{Key: bookmarks.IDKey, Value: folderID}}
+	suite.bookmarksModule.BookmarkFolderDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// the bookmark is kept, but the folder is gone
+	apiStatuses, _ := suite.getBookmarks("")
+	suite.Len(apiStatuses, 1)
+
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, bookmarks.BasePath+"?folder_id="+folderID, "")
+	suite.bookmarksModule.BookmarksGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestBookmarksTestSuite(t *testing.T) {
+	suite.Run(t, new(BookmarksTestSuite))
+}
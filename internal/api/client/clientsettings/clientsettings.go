@@ -0,0 +1,57 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package clientsettings
+
+import (
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/router"
+)
+
+const (
+	// KeyKey is the url param for the key of a client setting
+	KeyKey = "key"
+	// BasePath is the base URI path for serving client settings
+	BasePath = "/api/v1/client_settings"
+	// BasePathWithKey is the base path with the key param in it
+	BasePathWithKey = BasePath + "/:" + KeyKey
+)
+
+// Module implements the ClientAPIModule interface for storing and syncing client settings
+type Module struct {
+	processor processing.Processor
+}
+
+// New returns a new client settings module
+func New(processor processing.Processor) api.ClientModule {
+	return &Module{
+		processor: processor,
+	}
+}
+
+// Route attaches all routes from this module to the given router
+func (m *Module) Route(r router.Router) error {
+	r.AttachHandler(http.MethodGet, BasePath, m.ClientSettingsGETHandler)
+	r.AttachHandler(http.MethodGet, BasePathWithKey, m.ClientSettingGETHandler)
+	r.AttachHandler(http.MethodPut, BasePathWithKey, m.ClientSettingPUTHandler)
+	r.AttachHandler(http.MethodDelete, BasePathWithKey, m.ClientSettingDELETEHandler)
+	return nil
+}
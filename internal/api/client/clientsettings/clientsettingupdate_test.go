@@ -0,0 +1,174 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package clientsettings_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/clientsettings"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type ClientSettingUpdateTestSuite struct {
+	ClientSettingsStandardTestSuite
+}
+
+func (suite *ClientSettingUpdateTestSuite) putSetting(application *gtsmodel.Application, key string, value string) (int, *apimodel.ClientSetting) {
+	recorder := httptest.NewRecorder()
+	body := []byte("value=" + url.QueryEscape(value))
+	ctx := suite.newContext(recorder, http.MethodPut, body, "api/v1/client_settings/"+key, "application/x-www-form-urlencoded")
+	ctx.Set(oauth.SessionAuthorizedApplication, application)
+	ctx.Params = gin.Params{gin.Param{Key: clientsettings.KeyKey, Value: key}}
+	suite.clientSettingsModule.ClientSettingPUTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return recorder.Code, nil
+	}
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	setting := &apimodel.ClientSetting{}
+	suite.NoError(json.Unmarshal(b, setting))
+	return recorder.Code, setting
+}
+
+func (suite *ClientSettingUpdateTestSuite) getSettings(application *gtsmodel.Application) []*apimodel.ClientSetting {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/client_settings", "")
+	ctx.Set(oauth.SessionAuthorizedApplication, application)
+	suite.clientSettingsModule.ClientSettingsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	settings := []*apimodel.ClientSetting{}
+	suite.NoError(json.Unmarshal(b, &settings))
+	return settings
+}
+
+func (suite *ClientSettingUpdateTestSuite) TestPutGetDeleteSetting() {
+	application := suite.testApplications["application_1"]
+
+	code, setting := suite.putSetting(application, "pinned_columns", `["home","local"]`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("pinned_columns", setting.Key)
+	suite.Equal(`["home","local"]`, setting.Value)
+	suite.NotEmpty(setting.UpdatedAt)
+
+	// storing the same key again should replace the value
+	code, setting = suite.putSetting(application, "pinned_columns", `["home","notifications"]`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`["home","notifications"]`, setting.Value)
+
+	code, _ = suite.putSetting(application, "theme", "dark")
+	suite.Equal(http.StatusOK, code)
+
+	settings := suite.getSettings(application)
+	if suite.Len(settings, 2) {
+		suite.Equal("pinned_columns", settings[0].Key)
+		suite.Equal(`["home","notifications"]`, settings[0].Value)
+		suite.Equal("theme", settings[1].Key)
+		suite.Equal("dark", settings[1].Value)
+	}
+
+	// get a single setting
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/client_settings/theme", "")
+	ctx.Params = gin.Params{gin.Param{Key: clientsettings.KeyKey, Value: "theme"}}
+	suite.clientSettingsModule.ClientSettingGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+	suite.Contains(string(b), `"value":"dark"`)
+
+	// delete it
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, "api/v1/client_settings/theme", "")
+	ctx.Params = gin.Params{gin.Param{Key: clientsettings.KeyKey, Value: "theme"}}
+	suite.clientSettingsModule.ClientSettingDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Len(suite.getSettings(application), 1)
+
+	// it should be gone now
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, "api/v1/client_settings/theme", "")
+	ctx.Params = gin.Params{gin.Param{Key: clientsettings.KeyKey, Value: "theme"}}
+	suite.clientSettingsModule.ClientSettingGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *ClientSettingUpdateTestSuite) TestSettingsNamespacedPerApplication() {
+	code, _ := suite.putSetting(suite.testApplications["application_1"], "theme", "dark")
+	suite.Equal(http.StatusOK, code)
+
+	code, _ = suite.putSetting(suite.testApplications["application_2"], "theme", "light")
+	suite.Equal(http.StatusOK, code)
+
+	settings := suite.getSettings(suite.testApplications["application_1"])
+	if suite.Len(settings, 1) {
+		suite.Equal("dark", settings[0].Value)
+	}
+
+	settings = suite.getSettings(suite.testApplications["application_2"])
+	if suite.Len(settings, 1) {
+		suite.Equal("light", settings[0].Value)
+	}
+}
+
+func (suite *ClientSettingUpdateTestSuite) TestPutSettingTooLarge() {
+	application := suite.testApplications["application_1"]
+	config.SetAccountsClientSettingsMaxSize(32)
+
+	code, _ := suite.putSetting(application, "theme", strings.Repeat("a", 20))
+	suite.Equal(http.StatusOK, code)
+
+	// replacing the existing value shouldn't count it twice
+	code, _ = suite.putSetting(application, "theme", strings.Repeat("b", 27))
+	suite.Equal(http.StatusOK, code)
+
+	code, _ = suite.putSetting(application, "font", "serif")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+	suite.Len(suite.getSettings(application), 1)
+}
+
+func (suite *ClientSettingUpdateTestSuite) TestPutSettingBadKey() {
+	code, _ := suite.putSetting(suite.testApplications["application_1"], "bad!key", "whatever")
+	suite.Equal(http.StatusBadRequest, code)
+
+	code, _ = suite.putSetting(suite.testApplications["application_1"], strings.Repeat("a", 65), "whatever")
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func TestClientSettingUpdateTestSuite(t *testing.T) {
+	suite.Run(t, &ClientSettingUpdateTestSuite{})
+}
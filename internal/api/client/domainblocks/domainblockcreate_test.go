@@ -0,0 +1,115 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package domainblocks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type DomainBlockCreateTestSuite struct {
+	DomainBlocksStandardTestSuite
+}
+
+func (suite *DomainBlockCreateTestSuite) blockDomain(domain string) int {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte("domain="+domain), "api/v1/domain_blocks", "application/x-www-form-urlencoded")
+	suite.domainBlocksModule.DomainBlockPOSTHandler(ctx)
+	return recorder.Code
+}
+
+func (suite *DomainBlockCreateTestSuite) getDomainBlocks() []string {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/domain_blocks", "")
+	suite.domainBlocksModule.DomainBlocksGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	domains := []string{}
+	suite.NoError(json.Unmarshal(b, &domains))
+	return domains
+}
+
+func (suite *DomainBlockCreateTestSuite) TestBlockDomain() {
+	remoteAccount := suite.testAccounts["remote_account_1"]
+	remoteStatus := suite.testStatuses["remote_account_1_status_1"]
+	authed := &oauth.Auth{Account: suite.testAccounts["local_account_1"]}
+
+	// status should be visible before the block
+	_, errWithCode := suite.processor.StatusGet(context.Background(), authed, remoteStatus.ID)
+	suite.Nil(errWithCode)
+
+	suite.Equal(http.StatusOK, suite.blockDomain("FOSSBROS-anonymous.io"))
+	suite.Equal([]string{"fossbros-anonymous.io"}, suite.getDomainBlocks())
+
+	// blocking again should be a no-op
+	suite.Equal(http.StatusOK, suite.blockDomain("fossbros-anonymous.io"))
+	suite.Len(suite.getDomainBlocks(), 1)
+
+	// relationship should show the domain block
+	relationship, err := suite.db.GetRelationship(context.Background(), authed.Account.ID, remoteAccount.ID)
+	suite.NoError(err)
+	suite.True(relationship.DomainBlocking)
+
+	// status should now be hidden from the requesting account
+	_, errWithCode = suite.processor.StatusGet(context.Background(), authed, remoteStatus.ID)
+	suite.NotNil(errWithCode)
+
+	// follow attempts should be refused
+	_, errWithCode = suite.processor.AccountFollowCreate(context.Background(), authed, &apimodel.AccountFollowRequest{ID: remoteAccount.ID})
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// now unblock, and everything should go back to normal
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, "api/v1/domain_blocks?domain=fossbros-anonymous.io", "")
+	suite.domainBlocksModule.DomainBlockDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Empty(suite.getDomainBlocks())
+
+	_, errWithCode = suite.processor.StatusGet(context.Background(), authed, remoteStatus.ID)
+	suite.Nil(errWithCode)
+}
+
+func (suite *DomainBlockCreateTestSuite) TestBlockOwnDomain() {
+	suite.Equal(http.StatusBadRequest, suite.blockDomain("localhost:8080"))
+	suite.Equal(http.StatusBadRequest, suite.blockDomain(""))
+	suite.Empty(suite.getDomainBlocks())
+}
+
+func (suite *DomainBlockCreateTestSuite) TestBlockDomainBadForm() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte("domain=%zz"), "api/v1/domain_blocks", "application/x-www-form-urlencoded")
+	suite.domainBlocksModule.DomainBlockPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestDomainBlockCreateTestSuite(t *testing.T) {
+	suite.Run(t, &DomainBlockCreateTestSuite{})
+}
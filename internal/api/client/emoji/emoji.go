@@ -29,6 +29,29 @@ import (
 const (
 	// BasePath is the base path for serving the emoji API
 	BasePath = "/api/v1/custom_emojis"
+	// SearchPath is the path for searching emojis by shortcode
+	SearchPath = BasePath + "/search"
+
+	// ShortcodeKey is the query key for filtering emojis by shortcode substring
+	ShortcodeKey = "shortcode"
+	// CategoryKey is the query key for filtering emojis by category name
+	CategoryKey = "category"
+	// LimitKey is the query key for limiting the number of emojis returned
+	LimitKey = "limit"
+	// OrderKey is the query key for choosing how returned emojis are ordered
+	OrderKey = "order"
+	// QueryKey is the query key for the shortcode prefix or substring to search for
+	QueryKey = "q"
+
+	// OrderAlphabetical orders emojis alphabetically by shortcode
+	OrderAlphabetical = "alphabetical"
+	// OrderFrequentlyUsed orders the emojis most used by the requesting account first, then the rest alphabetically
+	OrderFrequentlyUsed = "frequently_used"
+
+	// defaultSearchLimit is the number of emojis returned by a search when no limit is given
+	defaultSearchLimit = 20
+	// maxSearchLimit is the greatest number of emojis a search will return
+	maxSearchLimit = 80
 )
 
 // Module implements the ClientAPIModule interface for everything related to emoji
@@ -46,5 +69,6 @@ func New(processor processing.Processor) api.ClientModule {
 // Route attaches all routes from this module to the given router
 func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodGet, BasePath, m.EmojisGETHandler)
+	r.AttachHandler(http.MethodGet, SearchPath, m.EmojisSearchGETHandler)
 	return nil
 }
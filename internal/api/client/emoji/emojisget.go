@@ -1,7 +1,9 @@
 package emoji
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
@@ -20,17 +22,46 @@ import (
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: shortcode
+//		type: string
+//		description: Show only emojis whose shortcode contains the given string (case-insensitive).
+//		in: query
+//	-
+//		name: category
+//		type: string
+//		description: Show only emojis in the category with the given name.
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of emojis to return. Less than 1, or not set, means unlimited (all matching emojis).
+//		in: query
+//	-
+//		name: order
+//		type: string
+//		description: |-
+//			How to order the returned emojis. One of `alphabetical` or `frequently_used`.
+//			With `frequently_used`, the emojis the requesting account has used most in its statuses are returned first,
+//			followed by the remaining emojis alphabetically by shortcode. This requires a user token; for app tokens
+//			the emojis are ordered alphabetically.
+//		default: alphabetical
+//		in: query
+//
 //	security:
 //	- OAuth2 Bearer:
 //		- read:custom_emojis
 //
 //	responses:
 //		'200':
-//			description: Array of custom emojis.
+//			description: Array of custom emojis, arranged according to the order parameter.
 //			schema:
 //				type: array
 //				items:
 //					"$ref": "#/definitions/emoji"
+//		'400':
+//			description: bad request
 //		'401':
 //			description: unauthorized
 //		'406':
@@ -38,7 +69,8 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) EmojisGETHandler(c *gin.Context) {
-	if _, err := oauth.Authed(c, true, true, true, true); err != nil {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
 		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
 		return
 	}
@@ -48,7 +80,29 @@ func (m *Module) EmojisGETHandler(c *gin.Context) {
 		return
 	}
 
-	emojis, errWithCode := m.processor.CustomEmojisGet(c)
+	limit := 0
+	if limitString := c.Query(LimitKey); limitString != "" {
+		i, err := strconv.Atoi(limitString)
+		if err != nil {
+			err := fmt.Errorf("error parsing %s: %s", LimitKey, err)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		limit = i
+	}
+
+	frequentlyUsed := false
+	switch order := c.Query(OrderKey); order {
+	case "", OrderAlphabetical:
+	case OrderFrequentlyUsed:
+		frequentlyUsed = true
+	default:
+		err := fmt.Errorf("%s must be one of %s or %s, got %s", OrderKey, OrderAlphabetical, OrderFrequentlyUsed, order)
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	emojis, errWithCode := m.processor.CustomEmojisGet(c.Request.Context(), authed, c.Query(ShortcodeKey), c.Query(CategoryKey), limit, frequentlyUsed)
 	if errWithCode != nil {
 		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
 		return
@@ -29,6 +29,10 @@ import (
 const (
 	// BasePath is the base URI path for serving favourites
 	BasePath = "/api/v1/favourites"
+	// ExportPath is for exporting all favourites as a CSV file
+	ExportPath = BasePath + "/export"
+	// ImportPath is for re-favouriting statuses listed in a CSV file
+	ImportPath = BasePath + "/import"
 
 	// MaxIDKey is the url query for setting a max status ID to return
 	MaxIDKey = "max_id"
@@ -57,5 +61,7 @@ func New(processor processing.Processor) api.ClientModule {
 // Route attaches all routes from this module to the given router
 func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodGet, BasePath, m.FavouritesGETHandler)
+	r.AttachHandler(http.MethodGet, ExportPath, m.FavouritesExportGETHandler)
+	r.AttachHandler(http.MethodPost, ImportPath, m.FavouritesImportPOSTHandler)
 	return nil
 }
@@ -0,0 +1,76 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package favourites
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// FavouritesExportGETHandler swagger:operation GET /api/v1/favourites/export favouritesExport
+//
+// Export all statuses favourited by the requesting account, as a CSV file.
+//
+// The file contains the ActivityPub URI of one status per line, oldest favourite first, and can be
+// imported again on this or another instance using the favourites import endpoint.
+//
+//	---
+//	tags:
+//	- favourites
+//
+//	produces:
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:favourites
+//
+//	responses:
+//		'200':
+//			description: CSV file of favourited status URIs.
+//			schema:
+//				type: file
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'500':
+//			description: internal server error
+func (m *Module) FavouritesExportGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	content, errWithCode := m.processor.FavouritesExport(c.Request.Context(), authed)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	extraHeaders := map[string]string{
+		"Content-Disposition": `attachment; filename="favourites.csv"`,
+	}
+	c.DataFromReader(http.StatusOK, content.ContentLength, content.ContentType, content.Content, extraHeaders)
+}
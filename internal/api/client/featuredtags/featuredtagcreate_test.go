@@ -0,0 +1,126 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package featuredtags_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type FeaturedTagCreateTestSuite struct {
+	FeaturedTagsStandardTestSuite
+}
+
+func (suite *FeaturedTagCreateTestSuite) featureTag(name string) (*apimodel.FeaturedTag, int) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte("name="+name), "api/v1/featured_tags", "application/x-www-form-urlencoded")
+	suite.featuredTagsModule.FeaturedTagPOSTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	featuredTag := &apimodel.FeaturedTag{}
+	suite.NoError(json.Unmarshal(b, featuredTag))
+	return featuredTag, recorder.Code
+}
+
+func (suite *FeaturedTagCreateTestSuite) getFeaturedTags() []*apimodel.FeaturedTag {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/featured_tags", "")
+	suite.featuredTagsModule.FeaturedTagsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	featuredTags := []*apimodel.FeaturedTag{}
+	suite.NoError(json.Unmarshal(b, &featuredTags))
+	return featuredTags
+}
+
+func (suite *FeaturedTagCreateTestSuite) TestFeatureTag() {
+	featuredTag, code := suite.featureTag("%23welcome")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("welcome", featuredTag.Name)
+	suite.Equal("http://localhost:8080/@the_mighty_zork/tagged/welcome", featuredTag.URL)
+	suite.Zero(featuredTag.StatusesCount)
+	suite.Empty(featuredTag.LastStatusAt)
+
+	// a brand new tag can be featured too
+	_, code = suite.featureTag("gotosocial")
+	suite.Equal(http.StatusOK, code)
+
+	featuredTags := suite.getFeaturedTags()
+	if suite.Len(featuredTags, 2) {
+		suite.Equal("welcome", featuredTags[0].Name)
+		suite.Equal("gotosocial", featuredTags[1].Name)
+	}
+
+	// featuring the same tag twice is a conflict
+	_, code = suite.featureTag("welcome")
+	suite.Equal(http.StatusConflict, code)
+
+	// unfeature the first tag
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, "api/v1/featured_tags/"+featuredTag.ID, "")
+	ctx.Params = gin.Params{gin.Param{Key: featuredtags.IDKey, Value: featuredTag.ID}}
+	suite.featuredTagsModule.FeaturedTagDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	featuredTags = suite.getFeaturedTags()
+	if suite.Len(featuredTags, 1) {
+		suite.Equal("gotosocial", featuredTags[0].Name)
+	}
+}
+
+func (suite *FeaturedTagCreateTestSuite) TestFeatureInvalidTag() {
+	_, code := suite.featureTag("")
+	suite.Equal(http.StatusBadRequest, code)
+
+	_, code = suite.featureTag("not+a+tag")
+	suite.Equal(http.StatusBadRequest, code)
+
+	suite.Empty(suite.getFeaturedTags())
+}
+
+func (suite *FeaturedTagCreateTestSuite) TestFeatureTooManyTags() {
+	for _, name := range []string{"one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten"} {
+		_, code := suite.featureTag(name)
+		suite.Equal(http.StatusOK, code)
+	}
+
+	_, code := suite.featureTag("eleven")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func TestFeaturedTagCreateTestSuite(t *testing.T) {
+	suite.Run(t, &FeaturedTagCreateTestSuite{})
+}
@@ -21,11 +21,14 @@ package fileserver
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -42,6 +45,14 @@ func (m *FileServer) ServeFile(c *gin.Context) {
 		return
 	}
 
+	if config.GetMediaHotlinkProtection() {
+		if referer := c.Request.Referer(); !hotlinkAllowed(referer) {
+			err := fmt.Errorf("media requested from disallowed referer %s", referer)
+			api.ErrorHandler(c, gtserror.NewErrorNotFound(err), m.processor.InstanceGet)
+			return
+		}
+	}
+
 	// We use request params to check what to pull out of the database/storage so check everything. A request URL should be formatted as follows:
 	// "https://example.org/fileserver/[ACCOUNT_ID]/[MEDIA_TYPE]/[MEDIA_SIZE]/[FILE_NAME]"
 	// "FILE_NAME" consists of two parts, the attachment's database id, a period, and the file extension.
@@ -93,6 +104,12 @@ func (m *FileServer) ServeFile(c *gin.Context) {
 		}
 	}()
 
+	// let admins decide whether browsers should load
+	// our media into pages served from other origins
+	if corp := config.GetMediaCrossOriginResourcePolicy(); corp != "" {
+		c.Header("Cross-Origin-Resource-Policy", corp)
+	}
+
 	if content.URL != nil {
 		c.Redirect(http.StatusFound, content.URL.String())
 		return
@@ -113,6 +130,13 @@ func (m *FileServer) ServeFile(c *gin.Context) {
 	// that clients don't keep fetching files over + over again
 	c.Header("Cache-Control", "max-age=604800")
 
+	// svgs are sanitized when they're stored, but in case anything slipped
+	// through, make sure browsers won't run scripts or fetch anything else
+	// if they're opened directly
+	if content.ContentType == string(api.ImageSVG) {
+		c.Header("Content-Security-Policy", "default-src 'none'; img-src data:; style-src 'unsafe-inline'")
+	}
+
 	if c.Request.Method == http.MethodHead {
 		c.Header("Content-Type", format)
 		c.Header("Content-Length", strconv.FormatInt(content.ContentLength, 10))
@@ -122,3 +146,39 @@ func (m *FileServer) ServeFile(c *gin.Context) {
 
 	c.DataFromReader(http.StatusOK, content.ContentLength, format, content.Content, nil)
 }
+
+// hotlinkAllowed returns true if media may be served to a request with the
+// given Referer header value. Requests without a Referer come from apps, other
+// servers, or someone opening the file directly, so they're always allowed;
+// otherwise the referring page must be on this instance, or on one of the
+// configured allowed domains (or a subdomain of one).
+func hotlinkAllowed(referer string) bool {
+	if referer == "" {
+		return true
+	}
+
+	refererURL, err := url.Parse(referer)
+	if err != nil {
+		return false
+	}
+
+	refererHost := strings.ToLower(refererURL.Hostname())
+	if refererHost == "" {
+		return false
+	}
+
+	allowed := append([]string{config.GetHost(), config.GetAccountDomain()}, config.GetMediaHotlinkAllowedDomains()...)
+	for _, domain := range allowed {
+		// host config values may include a port, the referer hostname won't
+		domain = strings.ToLower(strings.Split(domain, ":")[0])
+		if domain == "" {
+			continue
+		}
+
+		if refererHost == domain || strings.HasSuffix(refererHost, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/fileserver"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
@@ -212,6 +213,91 @@ func (suite *ServeFileTestSuite) TestServeSmallFileSuccessful() {
 	suite.Equal(b, fileInStorage)
 }
 
+func (suite *ServeFileTestSuite) TestServeFileHotlinkRefused() {
+	config.SetMediaHotlinkProtection(true)
+	defer config.SetMediaHotlinkProtection(false)
+
+	targetAttachment := suite.testAttachments["admin_account_status_1_attachment_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, targetAttachment.Thumbnail.URL, nil)
+	ctx.Request.Header.Set("accept", "*/*")
+	ctx.Request.Header.Set("referer", "https://bandwidth-thief.example.net/some/page")
+
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   fileserver.AccountIDKey,
+			Value: targetAttachment.AccountID,
+		},
+		gin.Param{
+			Key:   fileserver.MediaTypeKey,
+			Value: string(media.TypeAttachment),
+		},
+		gin.Param{
+			Key:   fileserver.MediaSizeKey,
+			Value: string(media.SizeSmall),
+		},
+		gin.Param{
+			Key:   fileserver.FileNameKey,
+			Value: fmt.Sprintf("%s.jpeg", targetAttachment.ID),
+		},
+	}
+
+	suite.fileServer.ServeFile(ctx)
+	suite.EqualValues(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *ServeFileTestSuite) TestServeFileHotlinkAllowed() {
+	config.SetMediaHotlinkProtection(true)
+	config.SetMediaHotlinkAllowedDomains([]string{"friends.example.net"})
+	config.SetMediaCrossOriginResourcePolicy("same-site")
+	defer func() {
+		config.SetMediaHotlinkProtection(false)
+		config.SetMediaHotlinkAllowedDomains([]string{})
+		config.SetMediaCrossOriginResourcePolicy("")
+	}()
+
+	targetAttachment := suite.testAttachments["admin_account_status_1_attachment_1"]
+
+	for _, referer := range []string{
+		"",
+		"http://localhost:8080/@admin",
+		"https://blog.friends.example.net/posts/1",
+	} {
+		recorder := httptest.NewRecorder()
+		ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+		ctx.Request = httptest.NewRequest(http.MethodGet, targetAttachment.Thumbnail.URL, nil)
+		ctx.Request.Header.Set("accept", "*/*")
+		if referer != "" {
+			ctx.Request.Header.Set("referer", referer)
+		}
+
+		ctx.Params = gin.Params{
+			gin.Param{
+				Key:   fileserver.AccountIDKey,
+				Value: targetAttachment.AccountID,
+			},
+			gin.Param{
+				Key:   fileserver.MediaTypeKey,
+				Value: string(media.TypeAttachment),
+			},
+			gin.Param{
+				Key:   fileserver.MediaSizeKey,
+				Value: string(media.SizeSmall),
+			},
+			gin.Param{
+				Key:   fileserver.FileNameKey,
+				Value: fmt.Sprintf("%s.jpeg", targetAttachment.ID),
+			},
+		}
+
+		suite.fileServer.ServeFile(ctx)
+		suite.EqualValues(http.StatusOK, recorder.Code, referer)
+		suite.Equal("same-site", recorder.Header().Get("Cross-Origin-Resource-Policy"))
+	}
+}
+
 func TestServeFileTestSuite(t *testing.T) {
 	suite.Run(t, new(ServeFileTestSuite))
 }
@@ -27,8 +27,22 @@ import (
 )
 
 const (
+	// IDKey is the key to use for retrieving filter, filter keyword and filter status IDs in context
+	IDKey = "id"
 	// BasePath is the base path for serving the filter API
 	BasePath = "/api/v1/filters"
+	// BasePathV2 is the base path for serving the v2 filter API
+	BasePathV2 = "/api/v2/filters"
+	// BasePathV2WithID is the v2 base path with the ID key in it
+	BasePathV2WithID = BasePathV2 + "/:" + IDKey
+	// KeywordsPath is the path for viewing and adding the keywords of a filter
+	KeywordsPath = BasePathV2WithID + "/keywords"
+	// KeywordPathWithID is the path for viewing, changing and removing a single filter keyword
+	KeywordPathWithID = BasePathV2 + "/keywords/:" + IDKey
+	// StatusesPath is the path for viewing and adding the statuses of a filter
+	StatusesPath = BasePathV2WithID + "/statuses"
+	// StatusPathWithID is the path for viewing and removing a single filter status
+	StatusPathWithID = BasePathV2 + "/statuses/:" + IDKey
 )
 
 // Module implements the ClientAPIModule interface for every related to filters
@@ -46,5 +60,22 @@ func New(processor processing.Processor) api.ClientModule {
 // Route attaches all routes from this module to the given router
 func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodGet, BasePath, m.FiltersGETHandler)
+
+	r.AttachHandler(http.MethodGet, BasePathV2, m.FiltersV2GETHandler)
+	r.AttachHandler(http.MethodPost, BasePathV2, m.FilterV2POSTHandler)
+	r.AttachHandler(http.MethodGet, BasePathV2WithID, m.FilterV2GETHandler)
+	r.AttachHandler(http.MethodPut, BasePathV2WithID, m.FilterV2PUTHandler)
+	r.AttachHandler(http.MethodDelete, BasePathV2WithID, m.FilterV2DELETEHandler)
+
+	r.AttachHandler(http.MethodGet, KeywordsPath, m.FilterKeywordsGETHandler)
+	r.AttachHandler(http.MethodPost, KeywordsPath, m.FilterKeywordPOSTHandler)
+	r.AttachHandler(http.MethodGet, KeywordPathWithID, m.FilterKeywordGETHandler)
+	r.AttachHandler(http.MethodPut, KeywordPathWithID, m.FilterKeywordPUTHandler)
+	r.AttachHandler(http.MethodDelete, KeywordPathWithID, m.FilterKeywordDELETEHandler)
+
+	r.AttachHandler(http.MethodGet, StatusesPath, m.FilterStatusesGETHandler)
+	r.AttachHandler(http.MethodPost, StatusesPath, m.FilterStatusPOSTHandler)
+	r.AttachHandler(http.MethodGet, StatusPathWithID, m.FilterStatusGETHandler)
+	r.AttachHandler(http.MethodDelete, StatusPathWithID, m.FilterStatusDELETEHandler)
 	return nil
 }
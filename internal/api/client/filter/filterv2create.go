@@ -0,0 +1,134 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// FilterV2POSTHandler swagger:operation POST /api/v2/filters filterV2Create
+//
+// Create a filter.
+//
+// Keywords can be added to the new filter at the same time by giving `keywords_attributes`
+// (an array of objects with `keyword` and `whole_word`) in a JSON or XML body.
+//
+// The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
+// The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
+//
+//	---
+//	tags:
+//	- filters
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: title
+//		type: string
+//		description: The name of the filter.
+//		in: formData
+//		required: true
+//	-
+//		name: context[]
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- home
+//				- notifications
+//				- public
+//				- thread
+//				- account
+//		description: Where the filter should be applied. At least one context is required.
+//		in: formData
+//		required: true
+//	-
+//		name: filter_action
+//		type: string
+//		enum:
+//			- warn
+//			- hide
+//		description: >-
+//			What to do with statuses matching the filter.
+//			`warn` returns them with a filter result so they can be shown behind a warning,
+//			`hide` leaves them out entirely.
+//			Defaults to `warn`.
+//		in: formData
+//	-
+//		name: expires_in
+//		type: integer
+//		description: Number of seconds from now that the filter should expire. Leave out for a filter that never expires.
+//		in: formData
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:filters
+//
+//	responses:
+//		'200':
+//			description: The newly created filter.
+//			schema:
+//				"$ref": "#/definitions/filterV2"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) FilterV2POSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &apimodel.FilterCreateUpdateRequestV2{}
+	if err := c.ShouldBind(form); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	filter, errWithCode := m.processor.FilterV2Create(c.Request.Context(), authed, form)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, filter)
+}
@@ -0,0 +1,197 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/filter"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type FilterCreateTestSuite struct {
+	FilterStandardTestSuite
+}
+
+func (suite *FilterCreateTestSuite) createFilter(body string, contentType string) (*apimodel.FilterV2, int) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), "api/v2/filters", contentType)
+	suite.filterModule.FilterV2POSTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	f := &apimodel.FilterV2{}
+	suite.NoError(json.Unmarshal(b, f))
+	return f, recorder.Code
+}
+
+func (suite *FilterCreateTestSuite) updateFilter(filterID string, body string) (*apimodel.FilterV2, int) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, []byte(body), "api/v2/filters/"+filterID, "application/json")
+	ctx.Params = gin.Params{gin.Param{Key: filter.IDKey, Value: filterID}}
+	suite.filterModule.FilterV2PUTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	f := &apimodel.FilterV2{}
+	suite.NoError(json.Unmarshal(b, f))
+	return f, recorder.Code
+}
+
+func (suite *FilterCreateTestSuite) getFilters() []*apimodel.FilterV2 {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v2/filters", "")
+	suite.filterModule.FiltersV2GETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	filters := []*apimodel.FilterV2{}
+	suite.NoError(json.Unmarshal(b, &filters))
+	return filters
+}
+
+func (suite *FilterCreateTestSuite) addFilterStatus(filterID string, statusID string) int {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte("status_id="+statusID), "api/v2/filters/"+filterID+"/statuses", "application/x-www-form-urlencoded")
+	ctx.Params = gin.Params{gin.Param{Key: filter.IDKey, Value: filterID}}
+	suite.filterModule.FilterStatusPOSTHandler(ctx)
+	return recorder.Code
+}
+
+func (suite *FilterCreateTestSuite) TestCreateFilter() {
+	f, code := suite.createFilter("title=spoilers&context[]=home&context[]=thread&filter_action=hide&expires_in=3600", "application/x-www-form-urlencoded")
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(f.ID)
+	suite.Equal("spoilers", f.Title)
+	suite.Equal([]string{"home", "thread"}, f.Context)
+	suite.Equal("hide", f.FilterAction)
+	suite.NotNil(f.ExpiresAt)
+	suite.Empty(f.Keywords)
+	suite.Empty(f.Statuses)
+
+	filters := suite.getFilters()
+	if suite.Len(filters, 2) {
+		suite.Equal(suite.testFilters["local_account_1_filter_1"].ID, filters[0].ID)
+		suite.Equal(f.ID, filters[1].ID)
+	}
+
+	// delete the new filter again
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, "api/v2/filters/"+f.ID, "")
+	ctx.Params = gin.Params{gin.Param{Key: filter.IDKey, Value: f.ID}}
+	suite.filterModule.FilterV2DELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	suite.Len(suite.getFilters(), 1)
+}
+
+func (suite *FilterCreateTestSuite) TestCreateFilterWithKeywords() {
+	f, code := suite.createFilter(`{"title":"birds","context":["public"],"keywords_attributes":[{"keyword":"bird"},{"keyword":"Pigeons","whole_word":true}]}`, "application/json")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("warn", f.FilterAction)
+	suite.Nil(f.ExpiresAt)
+	if suite.Len(f.Keywords, 2) {
+		suite.Equal("bird", f.Keywords[0].Keyword)
+		suite.False(f.Keywords[0].WholeWord)
+		suite.Equal("Pigeons", f.Keywords[1].Keyword)
+		suite.True(f.Keywords[1].WholeWord)
+	}
+}
+
+func (suite *FilterCreateTestSuite) TestCreateFilterInvalid() {
+	for _, body := range []string{
+		"context[]=home",
+		"title=&context[]=home",
+		"title=spoilers",
+		"title=spoilers&context[]=everywhere",
+		"title=spoilers&context[]=home&filter_action=mute",
+		"title=spoilers&context[]=home&expires_in=-5",
+	} {
+		_, code := suite.createFilter(body, "application/x-www-form-urlencoded")
+		suite.Equal(http.StatusBadRequest, code, body)
+	}
+
+	suite.Len(suite.getFilters(), 1)
+}
+
+func (suite *FilterCreateTestSuite) TestUpdateFilterKeywords() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+	keywordID := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"].ID
+
+	f, code := suite.updateFilter(testFilter.ID, `{"keywords_attributes":[{"id":"`+keywordID+`","_destroy":true},{"keyword":"eris"}]}`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(testFilter.Title, f.Title)
+	if suite.Len(f.Keywords, 1) {
+		suite.NotEqual(keywordID, f.Keywords[0].ID)
+		suite.Equal("eris", f.Keywords[0].Keyword)
+	}
+
+	// a keyword that isn't part of the filter can't be changed, and nothing else changes either
+	_, code = suite.updateFilter(testFilter.ID, `{"title":"discord","keywords_attributes":[{"id":"01GKZAEQ8P0B7WD1Y9TGDXSWXS","keyword":"chaos"}]}`)
+	suite.Equal(http.StatusUnprocessableEntity, code)
+
+	filters := suite.getFilters()
+	if suite.Len(filters, 1) {
+		suite.Equal(testFilter.Title, filters[0].Title)
+	}
+}
+
+func (suite *FilterCreateTestSuite) TestAddFilterStatus() {
+	filterID := suite.testFilters["local_account_1_filter_1"].ID
+	statusID := suite.testStatuses["admin_account_status_1"].ID
+
+	suite.Equal(http.StatusOK, suite.addFilterStatus(filterID, statusID))
+	suite.Equal(http.StatusUnprocessableEntity, suite.addFilterStatus(filterID, statusID))
+	suite.Equal(http.StatusNotFound, suite.addFilterStatus("01GKZAEQ8P0B7WD1Y9TGDXSWXS", statusID))
+
+	filters := suite.getFilters()
+	if suite.Len(filters, 1) && suite.Len(filters[0].Statuses, 1) {
+		suite.Equal(statusID, filters[0].Statuses[0].StatusID)
+	}
+}
+
+func (suite *FilterCreateTestSuite) TestGetUnknownFilterKeyword() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v2/filters/keywords/01GKZAEQ8P0B7WD1Y9TGDXSWXS", "")
+	ctx.Params = gin.Params{gin.Param{Key: filter.IDKey, Value: "01GKZAEQ8P0B7WD1Y9TGDXSWXS"}}
+	suite.filterModule.FilterKeywordGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestFilterCreateTestSuite(t *testing.T) {
+	suite.Run(t, &FilterCreateTestSuite{})
+}
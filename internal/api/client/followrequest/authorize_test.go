@@ -79,7 +79,7 @@ func (suite *AuthorizeTestSuite) TestAuthorize() {
 	b, err := ioutil.ReadAll(result.Body)
 	assert.NoError(suite.T(), err)
 
-	suite.Equal(`{"id":"01FHMQX3GAABWSM0S2VZEC2SWC","following":false,"showing_reblogs":false,"notifying":false,"followed_by":true,"blocking":false,"blocked_by":false,"muting":false,"muting_notifications":false,"requested":false,"domain_blocking":false,"endorsed":false,"note":""}`, string(b))
+	suite.Equal(`{"id":"01FHMQX3GAABWSM0S2VZEC2SWC","following":false,"showing_reblogs":false,"showing_replies":false,"notifying":false,"followed_by":true,"blocking":false,"blocked_by":false,"muting":false,"muting_notifications":false,"requested":false,"domain_blocking":false,"endorsed":false,"note":""}`, string(b))
 }
 
 func (suite *AuthorizeTestSuite) TestAuthorizeNoFR() {
@@ -107,7 +107,7 @@ func (suite *AuthorizeTestSuite) TestAuthorizeNoFR() {
 	b, err := ioutil.ReadAll(result.Body)
 	assert.NoError(suite.T(), err)
 
-	suite.Equal(`{"error":"Not Found"}`, string(b))
+	suite.Equal(`{"error":"Not Found","code":404}`, string(b))
 }
 
 func TestAuthorizeTestSuite(t *testing.T) {
@@ -31,8 +31,16 @@ const (
 	InstanceInformationPath = "api/v1/instance"
 	// InstancePeersPath is for serving instance peers requests.
 	InstancePeersPath = InstanceInformationPath + "/peers"
+	// InstanceTermsOfServicePath is for serving the terms of service of this instance
+	InstanceTermsOfServicePath = InstanceInformationPath + "/terms_of_service"
+	// InstancePrivacyPolicyPath is for serving the privacy policy of this instance
+	InstancePrivacyPolicyPath = InstanceInformationPath + "/privacy_policy"
+	// InstanceInformationPathV2 is for serving v2 instance info requests
+	InstanceInformationPathV2 = "api/v2/instance"
 	// PeersFilterKey is used to provide filters to /api/v1/instance/peers
 	PeersFilterKey = "filter"
+	// VersionKey is used to request an earlier version of a legal document, like the terms of service
+	VersionKey = "version"
 )
 
 // Module implements the ClientModule interface
@@ -52,5 +60,8 @@ func (m *Module) Route(s router.Router) error {
 	s.AttachHandler(http.MethodGet, InstanceInformationPath, m.InstanceInformationGETHandler)
 	s.AttachHandler(http.MethodPatch, InstanceInformationPath, m.InstanceUpdatePATCHHandler)
 	s.AttachHandler(http.MethodGet, InstancePeersPath, m.InstancePeersGETHandler)
+	s.AttachHandler(http.MethodGet, InstanceTermsOfServicePath, m.InstanceTermsOfServiceGETHandler)
+	s.AttachHandler(http.MethodGet, InstancePrivacyPolicyPath, m.InstancePrivacyPolicyGETHandler)
+	s.AttachHandler(http.MethodGet, InstanceInformationPathV2, m.InstanceInformationGETHandlerV2)
 	return nil
 }
@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package instance_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/instance"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type InstanceGetV2TestSuite struct {
+	InstanceStandardTestSuite
+}
+
+func (suite *InstanceGetV2TestSuite) TestInstanceGetV2() {
+	recorder := httptest.NewRecorder()
+	baseURI := fmt.Sprintf("%s://%s", config.GetProtocol(), config.GetHost())
+	requestURI := fmt.Sprintf("%s/%s", baseURI, instance.InstanceInformationPathV2)
+	ctx := suite.newContext(recorder, http.MethodGet, requestURI, nil, "", false)
+
+	suite.instanceModule.InstanceInformationGETHandlerV2(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	apiInstance := &apimodel.InstanceV2{}
+	if err := json.Unmarshal(b, apiInstance); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("localhost:8080", apiInstance.Domain)
+	suite.Equal("localhost:8080", apiInstance.AccountDomain)
+	suite.Equal("GoToSocial Testrig Instance", apiInstance.Title)
+	suite.Equal("http://localhost:8080/assets/logo.png", apiInstance.Thumbnail.URL)
+	suite.True(apiInstance.Registrations.Enabled)
+	suite.True(apiInstance.Registrations.ApprovalRequired)
+	suite.Nil(apiInstance.Registrations.Message)
+	suite.Equal("admin@example.org", apiInstance.Contact.Email)
+	suite.Equal("admin", apiInstance.Contact.Account.Username)
+	suite.Empty(apiInstance.Contact.DeputyEmails)
+	suite.Empty(apiInstance.Contact.DeputyAccounts)
+
+	// deputy contacts should always be serialized as lists
+	suite.Contains(string(b), `"deputy_emails":[]`)
+	suite.Contains(string(b), `"deputy_accounts":[]`)
+}
+
+func TestInstanceGetV2TestSuite(t *testing.T) {
+	suite.Run(t, &InstanceGetV2TestSuite{})
+}
@@ -0,0 +1,126 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// InstanceTermsOfServiceGETHandler swagger:operation GET /api/v1/instance/terms_of_service instanceTermsOfServiceGet
+//
+// View the terms of service of this instance.
+//
+//	---
+//	tags:
+//	- instance
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: version
+//		type: integer
+//		description: Show this version of the terms of service instead of the latest one.
+//		in: query
+//
+//	responses:
+//		'200':
+//			description: The terms of service.
+//			schema:
+//				"$ref": "#/definitions/legalDocument"
+//		'400':
+//			description: bad request
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal error
+func (m *Module) InstanceTermsOfServiceGETHandler(c *gin.Context) {
+	m.legalDocumentGET(c, gtsmodel.LegalDocumentTermsOfService)
+}
+
+// InstancePrivacyPolicyGETHandler swagger:operation GET /api/v1/instance/privacy_policy instancePrivacyPolicyGet
+//
+// View the privacy policy of this instance.
+//
+//	---
+//	tags:
+//	- instance
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: version
+//		type: integer
+//		description: Show this version of the privacy policy instead of the latest one.
+//		in: query
+//
+//	responses:
+//		'200':
+//			description: The privacy policy.
+//			schema:
+//				"$ref": "#/definitions/legalDocument"
+//		'400':
+//			description: bad request
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal error
+func (m *Module) InstancePrivacyPolicyGETHandler(c *gin.Context) {
+	m.legalDocumentGET(c, gtsmodel.LegalDocumentPrivacyPolicy)
+}
+
+func (m *Module) legalDocumentGET(c *gin.Context, kind gtsmodel.LegalDocumentKind) {
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	version := 0
+	if v := c.Query(VersionKey); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 1 {
+			err := fmt.Errorf("%s must be a positive integer, provided value was %s", VersionKey, v)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		version = i
+	}
+
+	document, errWithCode := m.processor.InstanceLegalDocumentGet(c.Request.Context(), kind, version)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, document)
+}
@@ -37,6 +37,8 @@ import (
 //
 // This requires admin permissions on the instance.
 //
+// Changes are stored in the database, and take effect immediately without a restart.
+//
 //	---
 //	tags:
 //	- instance
@@ -70,23 +72,46 @@ import (
 //		type: string
 //		allowEmptyValue: true
 //	-
+//		name: contact_deputy_usernames[]
+//		in: formData
+//		description: >-
+//			Usernames of deputy contact accounts, in addition to the primary contact account.
+//			Each must be the username of an instance admin or moderator.
+//			Submit a single empty value to clear the list.
+//		type: array
+//		items:
+//			type: string
+//		collectionFormat: multi
+//		allowEmptyValue: true
+//	-
+//		name: contact_deputy_emails[]
+//		in: formData
+//		description: >-
+//			Email addresses to use as deputy instance contacts, in addition to the primary contact email.
+//			Submit a single empty value to clear the list.
+//		type: array
+//		items:
+//			type: string
+//		collectionFormat: multi
+//		allowEmptyValue: true
+//	-
 //		name: short_description
 //		in: formData
-//		description: Short description of the instance.
+//		description: Short description of the instance. Markdown or HTML formatting accepted.
 //		type: string
 //		maximum: 500
 //		allowEmptyValue: true
 //	-
 //		name: description
 //		in: formData
-//		description: Longer description of the instance.
+//		description: Longer description of the instance. Markdown or HTML formatting accepted.
 //		type: string
 //		maximum: 5000
 //		allowEmptyValue: true
 //	-
 //		name: terms
 //		in: formData
-//		description: Terms and conditions of the instance.
+//		description: Terms and conditions of the instance. Markdown or HTML formatting accepted.
 //		type: string
 //		maximum: 5000
 //		allowEmptyValue: true
@@ -169,6 +194,8 @@ func validateInstanceUpdate(form *model.InstanceSettingsUpdateRequest) error {
 	if form.Title == nil &&
 		form.ContactUsername == nil &&
 		form.ContactEmail == nil &&
+		form.ContactDeputyUsernames == nil &&
+		form.ContactDeputyEmails == nil &&
 		form.ShortDescription == nil &&
 		form.Description == nil &&
 		form.Terms == nil &&
@@ -27,8 +27,23 @@ import (
 )
 
 const (
+	// IDKey is the key to use for retrieving list IDs in context
+	IDKey = "id"
 	// BasePath is the base path for serving the lists API
 	BasePath = "/api/v1/lists"
+	// BasePathWithID is the base path with the ID key in it
+	BasePathWithID = BasePath + "/:" + IDKey
+	// AccountsPath is the path for viewing and changing the accounts in a list
+	AccountsPath = BasePathWithID + "/accounts"
+
+	// MaxIDKey is the url query for setting a max ID to return
+	MaxIDKey = "max_id"
+	// SinceIDKey is the url query for returning results newer than the given ID
+	SinceIDKey = "since_id"
+	// MinIDKey is the url query for returning results immediately newer than the given ID
+	MinIDKey = "min_id"
+	// LimitKey is for specifying maximum number of results to return.
+	LimitKey = "limit"
 )
 
 // Module implements the ClientAPIModule interface for everything related to lists
@@ -46,5 +61,12 @@ func New(processor processing.Processor) api.ClientModule {
 // Route attaches all routes from this module to the given router
 func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodGet, BasePath, m.ListsGETHandler)
+	r.AttachHandler(http.MethodPost, BasePath, m.ListPOSTHandler)
+	r.AttachHandler(http.MethodGet, BasePathWithID, m.ListGETHandler)
+	r.AttachHandler(http.MethodPut, BasePathWithID, m.ListPUTHandler)
+	r.AttachHandler(http.MethodDelete, BasePathWithID, m.ListDELETEHandler)
+	r.AttachHandler(http.MethodGet, AccountsPath, m.ListAccountsGETHandler)
+	r.AttachHandler(http.MethodPost, AccountsPath, m.ListAccountsPOSTHandler)
+	r.AttachHandler(http.MethodDelete, AccountsPath, m.ListAccountsDELETEHandler)
 	return nil
 }
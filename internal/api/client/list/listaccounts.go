@@ -0,0 +1,300 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// ListAccountsGETHandler swagger:operation GET /api/v1/lists/{id}/accounts listAccounts
+//
+// Page through the accounts in a list.
+//
+// The returned Link header can be used to generate the previous and next queries when paging up or down.
+//
+//	---
+//	tags:
+//	- lists
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the list.
+//		in: path
+//		required: true
+//	-
+//		name: max_id
+//		type: string
+//		description: Return only list entries *OLDER* than the given max ID.
+//		in: query
+//	-
+//		name: since_id
+//		type: string
+//		description: Return only list entries *NEWER* than the given since ID.
+//		in: query
+//	-
+//		name: min_id
+//		type: string
+//		description: Return only list entries immediately *NEWER* than the given min ID.
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of accounts to return. If 0, all accounts in the list are returned.
+//		default: 40
+//		minimum: 0
+//		maximum: 80
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:lists
+//
+//	responses:
+//		'200':
+//			description: Array of accounts in the list.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/account"
+//			headers:
+//				Link:
+//					type: string
+//					description: Links to the next and previous queries.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ListAccountsGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	listID := c.Param(IDKey)
+	if listID == "" {
+		err := errors.New("no list id specified")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	limit := 40
+	if limitString := c.Query(LimitKey); limitString != "" {
+		i, err := strconv.Atoi(limitString)
+		if err != nil || i < 0 || i > 80 {
+			err := fmt.Errorf("%s must be a number between 0 and 80", LimitKey)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		limit = i
+	}
+
+	resp, errWithCode := m.processor.ListAccountsGet(c.Request.Context(), authed, listID, c.Query(MaxIDKey), c.Query(SinceIDKey), c.Query(MinIDKey), limit)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	if resp.LinkHeader != "" {
+		c.Header("Link", resp.LinkHeader)
+	}
+	c.JSON(http.StatusOK, resp.Items)
+}
+
+// ListAccountsPOSTHandler swagger:operation POST /api/v1/lists/{id}/accounts listAccountsAdd
+//
+// Add accounts to a list.
+//
+// Only accounts followed by the requesting account can be added to its lists.
+//
+// The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
+// The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
+//
+//	---
+//	tags:
+//	- lists
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the list.
+//		in: path
+//		required: true
+//	-
+//		name: account_ids[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of the accounts to add to the list.
+//		in: formData
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:lists
+//
+//	responses:
+//		'200':
+//			description: The accounts were added to the list. An empty object is returned.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: one of the accounts is not followed by the requesting account
+//		'500':
+//			description: internal server error
+func (m *Module) ListAccountsPOSTHandler(c *gin.Context) {
+	m.listAccountsChange(c, m.processor.ListAccountsAdd)
+}
+
+// ListAccountsDELETEHandler swagger:operation DELETE /api/v1/lists/{id}/accounts listAccountsRemove
+//
+// Remove accounts from a list.
+//
+// The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
+// The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
+//
+//	---
+//	tags:
+//	- lists
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the list.
+//		in: path
+//		required: true
+//	-
+//		name: account_ids[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of the accounts to remove from the list.
+//		in: formData
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:lists
+//
+//	responses:
+//		'200':
+//			description: The accounts were removed from the list. An empty object is returned.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ListAccountsDELETEHandler(c *gin.Context) {
+	m.listAccountsChange(c, m.processor.ListAccountsRemove)
+}
+
+// listAccountsChange contains the logic shared by the handlers for adding accounts to and removing accounts from a list.
+func (m *Module) listAccountsChange(c *gin.Context, change func(context.Context, *oauth.Auth, string, []string) gtserror.WithCode) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	listID := c.Param(IDKey)
+	if listID == "" {
+		err := errors.New("no list id specified")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &apimodel.ListAccountsChangeRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if len(form.AccountIDs) == 0 {
+		err := errors.New("no account ids specified")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if errWithCode := change(c.Request.Context(), authed, listID, form.AccountIDs); errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,162 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package list_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/list"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type ListCreateTestSuite struct {
+	ListStandardTestSuite
+}
+
+func (suite *ListCreateTestSuite) createList(body string) (*apimodel.List, int) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), "api/v1/lists", "application/x-www-form-urlencoded")
+	suite.listModule.ListPOSTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	l := &apimodel.List{}
+	suite.NoError(json.Unmarshal(b, l))
+	return l, recorder.Code
+}
+
+func (suite *ListCreateTestSuite) getLists() []*apimodel.List {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/lists", "")
+	suite.listModule.ListsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	lists := []*apimodel.List{}
+	suite.NoError(json.Unmarshal(b, &lists))
+	return lists
+}
+
+func (suite *ListCreateTestSuite) changeAccounts(method string, listID string, accountID string) int {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, method, []byte("account_ids[]="+accountID), "api/v1/lists/"+listID+"/accounts", "application/x-www-form-urlencoded")
+	ctx.Params = gin.Params{gin.Param{Key: list.IDKey, Value: listID}}
+	if method == http.MethodPost {
+		suite.listModule.ListAccountsPOSTHandler(ctx)
+	} else {
+		suite.listModule.ListAccountsDELETEHandler(ctx)
+	}
+	return recorder.Code
+}
+
+func (suite *ListCreateTestSuite) getListAccounts(listID string) []*apimodel.Account {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/lists/"+listID+"/accounts", "")
+	ctx.Params = gin.Params{gin.Param{Key: list.IDKey, Value: listID}}
+	suite.listModule.ListAccountsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	accounts := []*apimodel.Account{}
+	suite.NoError(json.Unmarshal(b, &accounts))
+	return accounts
+}
+
+func (suite *ListCreateTestSuite) TestCreateList() {
+	l, code := suite.createList("title=friends")
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(l.ID)
+	suite.Equal("friends", l.Title)
+	suite.Equal("list", l.RepliesPolicy)
+
+	lists := suite.getLists()
+	if suite.Len(lists, 2) {
+		suite.Equal(suite.testLists["local_account_1_list_1"].ID, lists[0].ID)
+		suite.Equal(l.ID, lists[1].ID)
+	}
+
+	// delete the new list again
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, "api/v1/lists/"+l.ID, "")
+	ctx.Params = gin.Params{gin.Param{Key: list.IDKey, Value: l.ID}}
+	suite.listModule.ListDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	suite.Len(suite.getLists(), 1)
+}
+
+func (suite *ListCreateTestSuite) TestCreateListInvalid() {
+	_, code := suite.createList("title=")
+	suite.Equal(http.StatusBadRequest, code)
+
+	_, code = suite.createList("title=friends&replies_policy=everyone")
+	suite.Equal(http.StatusBadRequest, code)
+
+	suite.Len(suite.getLists(), 1)
+}
+
+func (suite *ListCreateTestSuite) TestChangeListAccounts() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+
+	accounts := suite.getListAccounts(listID)
+	if suite.Len(accounts, 1) {
+		suite.Equal(suite.testAccounts["local_account_2"].ID, accounts[0].ID)
+	}
+
+	// followed accounts can be added
+	suite.Equal(http.StatusOK, suite.changeAccounts(http.MethodPost, listID, suite.testAccounts["admin_account"].ID))
+	suite.Len(suite.getListAccounts(listID), 2)
+
+	// accounts which aren't followed can't
+	suite.Equal(http.StatusUnprocessableEntity, suite.changeAccounts(http.MethodPost, listID, suite.testAccounts["remote_account_1"].ID))
+	suite.Len(suite.getListAccounts(listID), 2)
+
+	suite.Equal(http.StatusOK, suite.changeAccounts(http.MethodDelete, listID, suite.testAccounts["local_account_2"].ID))
+	accounts = suite.getListAccounts(listID)
+	if suite.Len(accounts, 1) {
+		suite.Equal(suite.testAccounts["admin_account"].ID, accounts[0].ID)
+	}
+}
+
+func (suite *ListCreateTestSuite) TestGetUnknownList() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/lists/01GKZ5E6JZ4Q8YB1BQ8WTH8G2W", "")
+	ctx.Params = gin.Params{gin.Param{Key: list.IDKey, Value: "01GKZ5E6JZ4Q8YB1BQ8WTH8G2W"}}
+	suite.listModule.ListGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestListCreateTestSuite(t *testing.T) {
+	suite.Run(t, &ListCreateTestSuite{})
+}
@@ -0,0 +1,144 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package mailgateway
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// maxMessageSize is the largest email, in bytes, that the mail gateway accepts.
+// Attachments aren't posted, but they still count towards the size of a message.
+const maxMessageSize = 10 * 1024 * 1024
+
+// InboundPOSTHandler swagger:operation POST /api/v1/mail_gateway/inbound mailGatewayInbound
+//
+// Post an email as a status.
+//
+// This is called by a mail service, not by users or their clients. The mail service must send the secret
+// configured as mail-gateway-secret as a bearer token, and the body of the request must be the raw email,
+// or a multipart form with the raw email in an 'email' field.
+//
+// The email must be sent to the secret post by email address of a user, from that user's confirmed email
+// address. The mail service must also vouch for the sender, with an Authentication-Results header using the
+// authserv-id configured as mail-gateway-authserv-id, showing that the email passed DMARC, DKIM or SPF for the
+// domain it was sent from. The subject and plain text body of the email are posted as a status, with the
+// user's default settings; attachments are ignored.
+//
+// If the same email, identified by its Message-ID, is received more than once, the status that was posted
+// for it the first time is returned instead of posting it again.
+//
+//	---
+//	tags:
+//	- mail gateway
+//
+//	consumes:
+//	- message/rfc822
+//	- multipart/form-data
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: email
+//		in: formData
+//		description: The raw email, if sent as a multipart form.
+//		type: string
+//
+//	responses:
+//		'200':
+//			description: The status that was posted.
+//			schema:
+//				"$ref": "#/definitions/status"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: email was not sent from the user's confirmed address, wasn't authenticated by the mail service, or the user may not post
+//		'404':
+//			description: mail gateway not enabled, or no user has the address the email was sent to
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: unprocessable
+//		'500':
+//			description: internal error
+func (m *Module) InboundPOSTHandler(c *gin.Context) {
+	if !config.GetMailGatewayEnabled() {
+		err := errors.New("mail gateway is not enabled on this instance")
+		api.ErrorHandler(c, gtserror.NewErrorNotFound(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if !validSecret(c.GetHeader("Authorization")) {
+		err := errors.New("mail gateway secret was missing or incorrect")
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxMessageSize)
+
+	var message io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		email, ok := c.GetPostForm("email")
+		if !ok {
+			err := errors.New("multipart form has no email field")
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		message = strings.NewReader(email)
+	}
+
+	apiStatus, errWithCode := m.processor.MailGatewayPost(c.Request.Context(), message)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiStatus)
+}
+
+// validSecret returns whether the given Authorization header has the mail gateway secret as its bearer token.
+func validSecret(authorization string) bool {
+	secret := config.GetMailGatewaySecret()
+	if secret == "" {
+		return false
+	}
+
+	token := strings.TrimPrefix(authorization, "Bearer ")
+	if token == authorization {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
@@ -0,0 +1,160 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package mailgateway_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/mailgateway"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type InboundTestSuite struct {
+	MailGatewayStandardTestSuite
+}
+
+// enablePostByMail enables posting by email for local_account_1, and returns their secret address.
+func (suite *InboundTestSuite) enablePostByMail() string {
+	postByMail, errWithCode := suite.processor.UserPostByMailEnable(context.Background(), &oauth.Auth{
+		User:    suite.testUsers["local_account_1"],
+		Account: suite.testAccounts["local_account_1"],
+	})
+	suite.NoError(errWithCode)
+	return postByMail.Address
+}
+
+// message returns an email from the given address, which mx.post.example.org says passed dkim for the given domain.
+func (suite *InboundTestSuite) message(from string, to string, messageID string, dkimDomain string) string {
+	return strings.Join([]string{
+		"Authentication-Results: mx.post.example.org; dkim=pass header.d=" + dkimDomain + "; spf=none",
+		"From: Zork <" + from + ">",
+		"To: " + to,
+		"Subject: hello from my email",
+		"Message-ID: <" + messageID + ">",
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		"this was posted by email!",
+		"",
+		"-- ",
+		"zork",
+		"",
+	}, "\r\n")
+}
+
+func (suite *InboundTestSuite) inbound(body []byte, contentType string, authorization string, expectedCode int) *apimodel.Status {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", mailgateway.InboundPath), bytes.NewReader(body))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", contentType)
+	if authorization != "" {
+		ctx.Request.Header.Set("authorization", authorization)
+	}
+	suite.mailGatewayModule.InboundPOSTHandler(ctx)
+
+	suite.Equal(expectedCode, recorder.Code)
+	if expectedCode != http.StatusOK {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiStatus := &apimodel.Status{}
+	suite.NoError(json.Unmarshal(b, apiStatus))
+	return apiStatus
+}
+
+func (suite *InboundTestSuite) TestInbound() {
+	address := suite.enablePostByMail()
+	message := suite.message("zork@example.org", address, "first@mail.example.org", "example.org")
+
+	apiStatus := suite.inbound([]byte(message), "message/rfc822", "Bearer some-secret", http.StatusOK)
+	suite.Equal("<p>hello from my email<br/><br/>this was posted by email!</p>", apiStatus.Content)
+	suite.Equal("Post by email", apiStatus.Application.Name)
+	suite.Equal(suite.testAccounts["local_account_1"].ID, apiStatus.Account.ID)
+
+	// the same message delivered again shouldn't be posted twice
+	again := suite.inbound([]byte(message), "message/rfc822", "Bearer some-secret", http.StatusOK)
+	suite.Equal(apiStatus.ID, again.ID)
+}
+
+func (suite *InboundTestSuite) TestInboundMultipartForm() {
+	address := suite.enablePostByMail()
+	message := suite.message("zork@example.org", address, "form@mail.example.org", "example.org")
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	suite.NoError(writer.WriteField("email", message))
+	suite.NoError(writer.Close())
+
+	apiStatus := suite.inbound(body.Bytes(), writer.FormDataContentType(), "Bearer some-secret", http.StatusOK)
+	suite.Equal("<p>hello from my email<br/><br/>this was posted by email!</p>", apiStatus.Content)
+}
+
+func (suite *InboundTestSuite) TestInboundWrongFrom() {
+	address := suite.enablePostByMail()
+	message := suite.message("someone.else@example.org", address, "wrongfrom@mail.example.org", "example.org")
+
+	suite.inbound([]byte(message), "message/rfc822", "Bearer some-secret", http.StatusForbidden)
+}
+
+func (suite *InboundTestSuite) TestInboundNotAuthenticated() {
+	address := suite.enablePostByMail()
+
+	// the right from address isn't enough if the mail service didn't authenticate it
+	message := suite.message("zork@example.org", address, "forged@mail.example.org", "evil.example.com")
+	suite.inbound([]byte(message), "message/rfc822", "Bearer some-secret", http.StatusForbidden)
+
+	// and results from anyone but the configured mail service don't count
+	message = strings.Replace(suite.message("zork@example.org", address, "forged@mail.example.org", "example.org"), "mx.post.example.org", "mx.evil.example.com", 1)
+	suite.inbound([]byte(message), "message/rfc822", "Bearer some-secret", http.StatusForbidden)
+}
+
+func (suite *InboundTestSuite) TestInboundUnknownAddress() {
+	suite.enablePostByMail()
+	message := suite.message("zork@example.org", "00000000000000000000000000000000@post.example.org", "unknown@mail.example.org", "example.org")
+
+	suite.inbound([]byte(message), "message/rfc822", "Bearer some-secret", http.StatusNotFound)
+}
+
+func (suite *InboundTestSuite) TestInboundWrongSecret() {
+	address := suite.enablePostByMail()
+	message := suite.message("zork@example.org", address, "wrongsecret@mail.example.org", "example.org")
+
+	suite.inbound([]byte(message), "message/rfc822", "Bearer not-the-secret", http.StatusUnauthorized)
+	suite.inbound([]byte(message), "message/rfc822", "", http.StatusUnauthorized)
+}
+
+func TestInboundTestSuite(t *testing.T) {
+	suite.Run(t, &InboundTestSuite{})
+}
@@ -38,6 +38,8 @@ const (
 
 	// ExcludeTypes is an array specifying notification types to exclude
 	ExcludeTypesKey = "exclude_types[]"
+	// TypesKey is an array specifying notification types to clear
+	TypesKey = "types[]"
 	// MaxIDKey is the url query for setting a max notification ID to return
 	MaxIDKey = "max_id"
 	// LimitKey is for specifying maximum number of notifications to return.
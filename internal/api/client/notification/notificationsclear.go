@@ -27,10 +27,12 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
-// NotificationsClearPOSTHandler swagger:operation POST /api/v1/notifications clearNotifications
+// NotificationsClearPOSTHandler swagger:operation POST /api/v1/notifications/clear clearNotifications
 //
 // Clear/delete all notifications for currently authorized user.
 //
+// If one or more types are given, only notifications of those types will be cleared.
+//
 // Will return an empty object `{}` to indicate success.
 //
 //	---
@@ -40,6 +42,16 @@ import (
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: types
+//		type: array
+//		items:
+//			type: string
+//			description: Array of types of notifications to clear (follow, favourite, reblog, mention, poll, follow_request, status)
+//		in: query
+//		required: false
+//
 //	security:
 //	- OAuth2 Bearer:
 //		- read:notifications
@@ -70,7 +82,12 @@ func (m *Module) NotificationsClearPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	errWithCode := m.processor.NotificationsClear(c.Request.Context(), authed)
+	types := c.QueryArray(TypesKey)
+	if formTypes, ok := c.GetPostFormArray(TypesKey); ok {
+		types = append(types, formTypes...)
+	}
+
+	errWithCode := m.processor.NotificationsClear(c.Request.Context(), authed, types)
 	if errWithCode != nil {
 		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
 		return
@@ -0,0 +1,161 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package polls_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/polls"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type PollVoteTestSuite struct {
+	PollsStandardTestSuite
+}
+
+func (suite *PollVoteTestSuite) vote(accountKey string, pollID string, choices ...string) (int, *apimodel.Poll) {
+	form := make([]string, 0, len(choices))
+	for _, c := range choices {
+		form = append(form, "choices[]="+c)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, accountKey, http.MethodPost, []byte(strings.Join(form, "&")), "api/v1/polls/"+pollID+"/votes", "application/x-www-form-urlencoded")
+	ctx.Params = gin.Params{gin.Param{Key: polls.IDKey, Value: pollID}}
+	suite.pollsModule.PollVotePOSTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return recorder.Code, nil
+	}
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	apiPoll := &apimodel.Poll{}
+	suite.NoError(json.Unmarshal(b, apiPoll))
+	return recorder.Code, apiPoll
+}
+
+func (suite *PollVoteTestSuite) get(accountKey string, pollID string) *apimodel.Poll {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, accountKey, http.MethodGet, nil, "api/v1/polls/"+pollID, "")
+	ctx.Params = gin.Params{gin.Param{Key: polls.IDKey, Value: pollID}}
+	suite.pollsModule.PollGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	suite.NoError(err)
+
+	apiPoll := &apimodel.Poll{}
+	suite.NoError(json.Unmarshal(b, apiPoll))
+	return apiPoll
+}
+
+func (suite *PollVoteTestSuite) TestVoteAndGet() {
+	pollID := suite.createPoll(false, false).Poll.ID
+
+	code, apiPoll := suite.vote("local_account_2", pollID, "1")
+	suite.Equal(http.StatusOK, code)
+	suite.True(apiPoll.Voted)
+	suite.Equal([]int{1}, apiPoll.OwnVotes)
+	suite.Equal(1, apiPoll.VotesCount)
+	suite.Equal(1, *apiPoll.VotersCount)
+	suite.Equal(0, *apiPoll.Options[0].VotesCount)
+	suite.Equal(1, *apiPoll.Options[1].VotesCount)
+
+	// someone who hasn't voted yet sees the count but no vote of their own
+	apiPoll = suite.get("admin_account", pollID)
+	suite.False(apiPoll.Voted)
+	suite.Empty(apiPoll.OwnVotes)
+	suite.Equal(1, *apiPoll.Options[1].VotesCount)
+
+	// the author counts as having voted already
+	apiPoll = suite.get("local_account_1", pollID)
+	suite.True(apiPoll.Voted)
+}
+
+func (suite *PollVoteTestSuite) TestVoteMultiple() {
+	pollID := suite.createPoll(true, false).Poll.ID
+
+	code, apiPoll := suite.vote("local_account_2", pollID, "0", "2")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal([]int{0, 2}, apiPoll.OwnVotes)
+
+	code, apiPoll = suite.vote("admin_account", pollID, "0")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(3, apiPoll.VotesCount)
+	suite.Equal(2, *apiPoll.VotersCount)
+	suite.Equal(2, *apiPoll.Options[0].VotesCount)
+}
+
+func (suite *PollVoteTestSuite) TestVoteTwice() {
+	pollID := suite.createPoll(false, false).Poll.ID
+
+	code, _ := suite.vote("local_account_2", pollID, "0")
+	suite.Equal(http.StatusOK, code)
+
+	code, _ = suite.vote("local_account_2", pollID, "1")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func (suite *PollVoteTestSuite) TestVoteOwnPoll() {
+	pollID := suite.createPoll(false, false).Poll.ID
+
+	code, _ := suite.vote("local_account_1", pollID, "0")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func (suite *PollVoteTestSuite) TestVoteBadChoices() {
+	pollID := suite.createPoll(false, false).Poll.ID
+
+	// only one choice allowed
+	code, _ := suite.vote("local_account_2", pollID, "0", "1")
+	suite.Equal(http.StatusBadRequest, code)
+
+	// out of range
+	code, _ = suite.vote("local_account_2", pollID, "3")
+	suite.Equal(http.StatusBadRequest, code)
+
+	// nothing chosen
+	code, _ = suite.vote("local_account_2", pollID)
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func (suite *PollVoteTestSuite) TestVoteHiddenTotals() {
+	pollID := suite.createPoll(false, true).Poll.ID
+
+	code, apiPoll := suite.vote("local_account_2", pollID, "0")
+	suite.Equal(http.StatusOK, code)
+	suite.Nil(apiPoll.Options[0].VotesCount)
+
+	// the author can still see the counts
+	apiPoll = suite.get("local_account_1", pollID)
+	suite.Equal(1, *apiPoll.Options[0].VotesCount)
+}
+
+func TestPollVoteTestSuite(t *testing.T) {
+	suite.Run(t, new(PollVoteTestSuite))
+}
@@ -37,6 +37,13 @@ import (
 //
 // If statuses are in the result, they will be returned in descending chronological order (newest first), with sequential IDs (bigger = newer).
 //
+// Searching with an app token that isn't tied to a user is allowed, but then remote accounts and statuses
+// won't be resolved, only accounts that have opted into discovery will be returned, and only public statuses
+// will be returned.
+//
+// Users can also search the text and content warnings of statuses they have posted or been mentioned in,
+// by giving words to search for as the query. These results can be paged using `max_id`, `min_id`, and `offset`.
+//
 //	---
 //	tags:
 //	- search
@@ -64,7 +71,7 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) SearchGETHandler(c *gin.Context) {
-	authed, err := oauth.Authed(c, true, true, true, true)
+	authed, err := oauth.Authed(c, true, true, false, false)
 	if err != nil {
 		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
 		return
@@ -106,7 +113,7 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		}
 	}
 
-	limit := 2
+	limit := 20
 	limitString := c.Query(LimitKey)
 	if limitString != "" {
 		i, err := strconv.ParseInt(limitString, 10, 64)
@@ -34,6 +34,8 @@ const (
 	IDKey = "id"
 	// BasePath is the base path for serving the status API
 	BasePath = "/api/v1/statuses"
+	// ThreadPath is for creating a thread of multiple statuses in one go
+	ThreadPath = BasePath + "/thread"
 	// BasePathWithID is just the base path with the ID key in it.
 	// Use this anywhere you need to know the ID of the status being queried.
 	BasePathWithID = BasePath + "/:" + IDKey
@@ -69,6 +71,12 @@ const (
 	PinPath = BasePathWithID + "/pin"
 	// UnpinPath is for undoing a pin and returning a status to the ever-swirling drain of time and entropy
 	UnpinPath = BasePathWithID + "/unpin"
+
+	// ViewsPath is for the author of a status to see how many times it's been viewed
+	ViewsPath = BasePathWithID + "/views"
+
+	// DeliveriesPath is for the author of a status to see how it was delivered to other servers
+	DeliveriesPath = BasePathWithID + "/deliveries"
 )
 
 // Module implements the ClientAPIModule interface for every related to posting/deleting/interacting with statuses
@@ -86,6 +94,7 @@ func New(processor processing.Processor) api.ClientModule {
 // Route attaches all routes from this module to the given router
 func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodPost, BasePath, m.StatusCreatePOSTHandler)
+	r.AttachHandler(http.MethodPost, ThreadPath, m.StatusThreadCreatePOSTHandler)
 	r.AttachHandler(http.MethodDelete, BasePathWithID, m.StatusDELETEHandler)
 
 	r.AttachHandler(http.MethodPost, FavouritePath, m.StatusFavePOSTHandler)
@@ -96,8 +105,17 @@ func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodPost, UnreblogPath, m.StatusUnboostPOSTHandler)
 	r.AttachHandler(http.MethodGet, RebloggedPath, m.StatusBoostedByGETHandler)
 
+	r.AttachHandler(http.MethodPost, MutePath, m.StatusMutePOSTHandler)
+	r.AttachHandler(http.MethodPost, UnmutePath, m.StatusUnmutePOSTHandler)
+
+	r.AttachHandler(http.MethodPost, BookmarkPath, m.StatusBookmarkPOSTHandler)
+	r.AttachHandler(http.MethodPost, UnbookmarkPath, m.StatusUnbookmarkPOSTHandler)
+
 	r.AttachHandler(http.MethodGet, ContextPath, m.StatusContextGETHandler)
 
+	r.AttachHandler(http.MethodGet, ViewsPath, m.StatusViewCountGETHandler)
+	r.AttachHandler(http.MethodGet, DeliveriesPath, m.StatusDeliveriesGETHandler)
+
 	r.AttachHandler(http.MethodGet, BasePathWithID, m.muxHandler)
 	return nil
 }
@@ -0,0 +1,105 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/status"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusBookmarkTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusBookmarkTestSuite) postBookmark(path string, handler gin.HandlerFunc, targetStatus *gtsmodel.Status, expectedCode int) *model.Status {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", strings.Replace(path, ":id", targetStatus.ID, 1)), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   status.IDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	handler(ctx)
+
+	suite.Equal(expectedCode, recorder.Code)
+	if expectedCode != http.StatusOK {
+		return nil
+	}
+
+	apiStatus := &model.Status{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(apiStatus))
+	return apiStatus
+}
+
+func (suite *StatusBookmarkTestSuite) TestBookmarkUnbookmark() {
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	bookmarkedStatus := suite.postBookmark(status.BookmarkPath, suite.statusModule.StatusBookmarkPOSTHandler, targetStatus, http.StatusOK)
+	suite.Equal(targetStatus.ID, bookmarkedStatus.ID)
+	suite.True(bookmarkedStatus.Bookmarked)
+
+	// bookmarking again should be a no-op
+	bookmarkedStatus = suite.postBookmark(status.BookmarkPath, suite.statusModule.StatusBookmarkPOSTHandler, targetStatus, http.StatusOK)
+	suite.True(bookmarkedStatus.Bookmarked)
+
+	bookmarks := []*gtsmodel.StatusBookmark{}
+	suite.NoError(suite.db.GetAll(context.Background(), &bookmarks))
+	suite.Len(bookmarks, 1)
+	suite.Equal(requestingAccount.ID, bookmarks[0].AccountID)
+	suite.Equal(targetStatus.AccountID, bookmarks[0].TargetAccountID)
+
+	unbookmarkedStatus := suite.postBookmark(status.UnbookmarkPath, suite.statusModule.StatusUnbookmarkPOSTHandler, targetStatus, http.StatusOK)
+	suite.Equal(targetStatus.ID, unbookmarkedStatus.ID)
+	suite.False(unbookmarkedStatus.Bookmarked)
+
+	bookmarked, err := suite.db.IsStatusBookmarkedBy(context.Background(), targetStatus, requestingAccount.ID)
+	suite.NoError(err)
+	suite.False(bookmarked)
+}
+
+func (suite *StatusBookmarkTestSuite) TestBookmarkNonexistentStatus() {
+	targetStatus := &gtsmodel.Status{ID: "01GKBQ2ZDB9M1WPSHV5N2SRYZ0"}
+	suite.postBookmark(status.BookmarkPath, suite.statusModule.StatusBookmarkPOSTHandler, targetStatus, http.StatusNotFound)
+}
+
+func TestStatusBookmarkTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusBookmarkTestSuite))
+}
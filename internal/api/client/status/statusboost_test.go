@@ -203,7 +203,7 @@ func (suite *StatusBoostTestSuite) TestPostUnboostable() {
 	defer result.Body.Close()
 	b, err := ioutil.ReadAll(result.Body)
 	suite.NoError(err)
-	suite.Equal(`{"error":"Forbidden"}`, string(b))
+	suite.Equal(`{"error":"Forbidden","code":403}`, string(b))
 }
 
 // try to boost a status that's not visible to the user
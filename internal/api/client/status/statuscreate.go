@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
@@ -90,6 +91,15 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if form.Poll == nil {
+		poll, err := parsePollForm(c)
+		if err != nil {
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		form.Poll = poll
+	}
+
 	if err := validateCreateStatus(form); err != nil {
 		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
 		return
@@ -137,6 +147,12 @@ func validateCreateStatus(form *model.AdvancedStatusCreateForm) error {
 		if form.Poll.Options == nil {
 			return errors.New("poll with no options")
 		}
+		if len(form.Poll.Options) < 2 {
+			return errors.New("poll must have at least 2 options")
+		}
+		if form.Poll.ExpiresIn <= 0 {
+			return errors.New("poll expires_in must be a positive number of seconds")
+		}
 		if len(form.Poll.Options) > maxPollOptions {
 			return fmt.Errorf("too many poll options provided, %d provided but limit is %d", len(form.Poll.Options), maxPollOptions)
 		}
@@ -161,3 +177,41 @@ func validateCreateStatus(form *model.AdvancedStatusCreateForm) error {
 
 	return nil
 }
+
+// parsePollForm picks up a poll given as form fields, eg., 'poll[options][]=...',
+// since gin doesn't bind these into the nested poll request by itself.
+// If no poll options were given, it returns nil.
+func parsePollForm(c *gin.Context) (*model.PollRequest, error) {
+	options := c.PostFormArray("poll[options][]")
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	poll := &model.PollRequest{Options: options}
+
+	if expiresIn := c.PostForm("poll[expires_in]"); expiresIn != "" {
+		i, err := strconv.Atoi(expiresIn)
+		if err != nil {
+			return nil, fmt.Errorf("poll[expires_in] %s could not be parsed as a number", expiresIn)
+		}
+		poll.ExpiresIn = i
+	}
+
+	if multiple := c.PostForm("poll[multiple]"); multiple != "" {
+		b, err := strconv.ParseBool(multiple)
+		if err != nil {
+			return nil, fmt.Errorf("poll[multiple] %s could not be parsed as a boolean", multiple)
+		}
+		poll.Multiple = b
+	}
+
+	if hideTotals := c.PostForm("poll[hide_totals]"); hideTotals != "" {
+		b, err := strconv.ParseBool(hideTotals)
+		if err != nil {
+			return nil, fmt.Errorf("poll[hide_totals] %s could not be parsed as a boolean", hideTotals)
+		}
+		poll.HideTotals = b
+	}
+
+	return poll, nil
+}
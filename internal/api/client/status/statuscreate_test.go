@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -292,7 +293,7 @@ func (suite *StatusCreateTestSuite) TestReplyToNonexistentStatus() {
 	defer result.Body.Close()
 	b, err := ioutil.ReadAll(result.Body)
 	suite.NoError(err)
-	suite.Equal(`{"error":"Bad Request: status with id 3759e7ef-8ee1-4c0c-86f6-8b70b9ad3d50 not replyable because it doesn't exist"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: status with id 3759e7ef-8ee1-4c0c-86f6-8b70b9ad3d50 not replyable because it doesn't exist","code":400}`, string(b))
 }
 
 // Post a reply to the status of a local user that allows replies.
@@ -393,6 +394,73 @@ func (suite *StatusCreateTestSuite) TestAttachNewMediaSuccess() {
 	suite.Equal(statusResponse.ID, gtsAttachment.StatusID)
 }
 
+func (suite *StatusCreateTestSuite) postPollForm(form url.Values) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", status.BasePath), strings.NewReader(form.Encode())) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+	return recorder
+}
+
+func (suite *StatusCreateTestSuite) TestPostNewStatusWithPoll() {
+	recorder := suite.postPollForm(url.Values{
+		"status":            {"tea or coffee?"},
+		"poll[options][]":   {"tea", "coffee"},
+		"poll[expires_in]":  {"3600"},
+		"poll[multiple]":    {"true"},
+		"poll[hide_totals]": {"false"},
+	})
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply := &model.Status{}
+	suite.NoError(json.Unmarshal(b, statusReply))
+
+	if suite.NotNil(statusReply.Poll) {
+		suite.NotEmpty(statusReply.Poll.ID)
+		suite.NotEmpty(statusReply.Poll.ExpiresAt)
+		suite.False(statusReply.Poll.Expired)
+		suite.True(statusReply.Poll.Multiple)
+		suite.Equal(0, statusReply.Poll.VotesCount)
+		suite.Len(statusReply.Poll.Options, 2)
+		suite.Equal("tea", statusReply.Poll.Options[0].Title)
+		suite.Equal("coffee", statusReply.Poll.Options[1].Title)
+		suite.Equal(0, *statusReply.Poll.Options[0].VotesCount)
+	}
+
+	dbStatus, err := suite.db.GetStatusByID(context.Background(), statusReply.ID)
+	suite.NoError(err)
+	suite.Equal(statusReply.Poll.ID, dbStatus.PollID)
+	suite.Equal("Question", dbStatus.ActivityStreamsType)
+}
+
+func (suite *StatusCreateTestSuite) TestPostNewStatusWithBadPoll() {
+	// only one option
+	recorder := suite.postPollForm(url.Values{
+		"status":           {"tea?"},
+		"poll[options][]":  {"tea"},
+		"poll[expires_in]": {"3600"},
+	})
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+
+	// no expiry
+	recorder = suite.postPollForm(url.Values{
+		"status":          {"tea or coffee?"},
+		"poll[options][]": {"tea", "coffee"},
+	})
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
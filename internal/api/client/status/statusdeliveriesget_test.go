@@ -0,0 +1,128 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/status"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusDeliveriesGetTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusDeliveriesGetTestSuite) getDeliveries(accountKey string, statusID string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:8080%s", strings.Replace(status.DeliveriesPath, ":id", statusID, 1)), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   status.IDKey,
+			Value: statusID,
+		},
+	}
+
+	suite.statusModule.StatusDeliveriesGETHandler(ctx)
+	return recorder
+}
+
+func (suite *StatusDeliveriesGetTestSuite) TestGetDeliveries() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	now := time.Now()
+	suite.NoError(suite.db.PutStatusDeliveries(context.Background(), []*gtsmodel.StatusDelivery{
+		{
+			ID:        "01GKQ2Y2B0DTS6M6AQ7ZQ8WZ8E",
+			CreatedAt: now,
+			UpdatedAt: now,
+			StatusID:  targetStatus.ID,
+			Domain:    "fossbros-anonymous.io",
+			Delivered: 3,
+		},
+		{
+			ID:        "01GKQ2YBKR2VHT0QYW1DGD4S5J",
+			CreatedAt: now,
+			UpdatedAt: now,
+			StatusID:  targetStatus.ID,
+			Domain:    "example.org",
+			Delivered: 1,
+			Failed:    2,
+			Error:     "POST request to https://example.org/inbox failed (503): 503 Service Unavailable",
+		},
+	}))
+
+	recorder := suite.getDeliveries("local_account_1", targetStatus.ID)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	deliveries := &model.StatusDeliveries{}
+	suite.NoError(json.Unmarshal(b, deliveries))
+	suite.Equal(4, deliveries.Delivered)
+	suite.Equal(2, deliveries.Failed)
+	suite.Equal([]string{"example.org"}, deliveries.FailedDomains)
+	suite.Len(deliveries.Domains, 2)
+	suite.Equal("example.org", deliveries.Domains[0].Domain)
+	suite.Equal("POST request to https://example.org/inbox failed (503): 503 Service Unavailable", deliveries.Domains[0].Error)
+	suite.Equal("fossbros-anonymous.io", deliveries.Domains[1].Domain)
+	suite.Empty(deliveries.Domains[1].Error)
+}
+
+func (suite *StatusDeliveriesGetTestSuite) TestGetDeliveriesNeverDelivered() {
+	targetStatus := suite.testStatuses["local_account_1_status_2"]
+
+	recorder := suite.getDeliveries("local_account_1", targetStatus.ID)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"delivered":0,"failed":0,"failed_domains":[],"domains":[]}`, string(b))
+}
+
+func (suite *StatusDeliveriesGetTestSuite) TestGetDeliveriesNotAuthor() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	recorder := suite.getDeliveries("local_account_2", targetStatus.ID)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestStatusDeliveriesGetTestSuite(t *testing.T) {
+	suite.Run(t, &StatusDeliveriesGetTestSuite{})
+}
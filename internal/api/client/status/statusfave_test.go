@@ -123,7 +123,7 @@ func (suite *StatusFaveTestSuite) TestPostUnfaveable() {
 	defer result.Body.Close()
 	b, err := ioutil.ReadAll(result.Body)
 	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), `{"error":"Forbidden"}`, string(b))
+	assert.Equal(suite.T(), `{"error":"Forbidden","code":403}`, string(b))
 }
 
 func TestStatusFaveTestSuite(t *testing.T) {
@@ -0,0 +1,115 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/status"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusMuteTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusMuteTestSuite) postMute(path string, handler gin.HandlerFunc, targetStatus *gtsmodel.Status) *model.Status {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", strings.Replace(path, ":id", targetStatus.ID, 1)), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   status.IDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	handler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	apiStatus := &model.Status{}
+	if err := json.Unmarshal(b, apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiStatus
+}
+
+func (suite *StatusMuteTestSuite) TestMuteUnmuteThread() {
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_3"] // a reply to local_account_1_status_1
+	otherReply := suite.testStatuses["local_account_2_status_5"] // another reply to local_account_1_status_1
+
+	mutedStatus := suite.postMute(status.MutePath, suite.statusModule.StatusMutePOSTHandler, targetStatus)
+	suite.Equal(targetStatus.ID, mutedStatus.ID)
+	suite.True(mutedStatus.Muted)
+
+	// the mute should apply to the whole thread, not just the targeted status
+	muted, err := suite.db.IsThreadMutedBy(context.Background(), otherReply, requestingAccount.ID)
+	suite.NoError(err)
+	suite.True(muted)
+
+	// muting again should be a no-op
+	mutedStatus = suite.postMute(status.MutePath, suite.statusModule.StatusMutePOSTHandler, targetStatus)
+	suite.True(mutedStatus.Muted)
+
+	// unmuting via a different status in the thread should unmute the whole thread
+	unmutedStatus := suite.postMute(status.UnmutePath, suite.statusModule.StatusUnmutePOSTHandler, otherReply)
+	suite.Equal(otherReply.ID, unmutedStatus.ID)
+	suite.False(unmutedStatus.Muted)
+
+	muted, err = suite.db.IsThreadMutedBy(context.Background(), targetStatus, requestingAccount.ID)
+	suite.NoError(err)
+	suite.False(muted)
+}
+
+func TestStatusMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusMuteTestSuite))
+}
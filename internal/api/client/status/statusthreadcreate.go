@@ -0,0 +1,146 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// maxThreadStatuses is the maximum number of statuses that can be posted in one thread create request.
+const maxThreadStatuses = 20
+
+// StatusThreadCreatePOSTHandler swagger:operation POST /api/v1/statuses/thread statusThreadCreate
+//
+// Create a new thread of statuses.
+//
+// Each status in the thread will be posted as a reply to the status before it. The first status
+// may optionally be a reply to an existing status. Either every status in the thread is posted,
+// or none of them are.
+//
+// The parameters should be given in the body of the request, as JSON, with the content-type set to 'application/json',
+// or as XML, with the content-type set to 'application/xml'.
+//
+//	---
+//	tags:
+//	- statuses
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: statuses
+//		in: body
+//		description: Statuses to post, in thread order.
+//		required: true
+//		schema:
+//			"$ref": "#/definitions/statusThreadCreateForm"
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:statuses
+//
+//	responses:
+//		'200':
+//			description: "The newly created statuses, in thread order."
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/status"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) StatusThreadCreatePOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &model.StatusThreadCreateForm{}
+	if err := c.ShouldBind(form); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if err := validateCreateThread(form); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	apiStatuses, errWithCode := m.processor.StatusThreadCreate(c.Request.Context(), authed, form)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiStatuses)
+}
+
+func validateCreateThread(form *model.StatusThreadCreateForm) error {
+	if len(form.Statuses) == 0 {
+		return errors.New("no statuses provided")
+	}
+
+	if len(form.Statuses) > maxThreadStatuses {
+		return fmt.Errorf("too many statuses in thread, %d provided but limit is %d", len(form.Statuses), maxThreadStatuses)
+	}
+
+	for i, status := range form.Statuses {
+		if status == nil {
+			return fmt.Errorf("status %d in thread is empty", i)
+		}
+
+		if i > 0 && status.InReplyToID != "" {
+			return fmt.Errorf("status %d in thread has in_reply_to_id set; only the first status in a thread may reply to another status", i)
+		}
+
+		if err := validateCreateStatus(status); err != nil {
+			return fmt.Errorf("status %d in thread is invalid: %w", i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/package status_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/status"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusThreadCreateTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusThreadCreateTestSuite) threadRequest(body string) *httptest.ResponseRecorder {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", status.ThreadPath), strings.NewReader(body))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/json")
+	suite.statusModule.StatusThreadCreatePOSTHandler(ctx)
+
+	return recorder
+}
+
+func (suite *StatusThreadCreateTestSuite) TestPostThread() {
+	recorder := suite.threadRequest(`{"statuses":[
+		{"status":"first post in my thread! 1/3","visibility":"public"},
+		{"status":"second post in my thread! 2/3","visibility":"public"},
+		{"status":"third post in my thread! 3/3","visibility":"unlisted"}
+	]}`)
+
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	apiStatuses := []*model.Status{}
+	err = json.Unmarshal(b, &apiStatuses)
+	suite.NoError(err)
+	suite.Len(apiStatuses, 3)
+
+	// first status should not be a reply
+	suite.Nil(apiStatuses[0].InReplyToID)
+	suite.Equal("<p>first post in my thread! 1/3</p>", apiStatuses[0].Content)
+
+	// every other status should reply to the one before it
+	for i := 1; i < len(apiStatuses); i++ {
+		suite.NotNil(apiStatuses[i].InReplyToID)
+		suite.Equal(apiStatuses[i-1].ID, *apiStatuses[i].InReplyToID)
+		suite.Equal(apiStatuses[i-1].Account.ID, *apiStatuses[i].InReplyToAccountID)
+		suite.Less(apiStatuses[i-1].ID, apiStatuses[i].ID)
+	}
+	suite.Equal(model.VisibilityUnlisted, apiStatuses[2].Visibility)
+
+	// all statuses should now be in the db
+	for _, s := range apiStatuses {
+		dbStatus, err := suite.db.GetStatusByID(context.Background(), s.ID)
+		suite.NoError(err)
+		suite.NotNil(dbStatus)
+	}
+}
+
+func (suite *StatusThreadCreateTestSuite) TestPostThreadLaterReplyToID() {
+	recorder := suite.threadRequest(fmt.Sprintf(`{"statuses":[
+		{"status":"first post in my thread! 1/2"},
+		{"status":"second post in my thread! 2/2","in_reply_to_id":"%s"}
+	]}`, suite.testStatuses["local_account_2_status_1"].ID))
+
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: status 1 in thread has in_reply_to_id set; only the first status in a thread may reply to another status","code":400}`, string(b))
+}
+
+func (suite *StatusThreadCreateTestSuite) TestPostThreadMentionsTags() {
+	recorder := suite.threadRequest(`{"statuses":[
+		{"status":"hey @admin, look at my #threadtag 1/2"},
+		{"status":"second post in my thread! 2/2"}
+	]}`)
+
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	apiStatuses := []*model.Status{}
+	err = json.Unmarshal(b, &apiStatuses)
+	suite.NoError(err)
+	suite.Len(apiStatuses, 2)
+
+	// the mention and the new tag should have been stored with the status
+	mentions := []*gtsmodel.Mention{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "status_id", Value: apiStatuses[0].ID}}, &mentions)
+	suite.NoError(err)
+	suite.Len(mentions, 1)
+	suite.Equal(suite.testAccounts["admin_account"].ID, mentions[0].TargetAccountID)
+
+	tag := &gtsmodel.Tag{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "name", Value: "threadtag"}}, tag)
+	suite.NoError(err)
+}
+
+func (suite *StatusThreadCreateTestSuite) TestPostThreadLaterReplyToIDNothingLeftBehind() {
+	adminMentions := func() []*gtsmodel.Mention {
+		mentions := []*gtsmodel.Mention{}
+		err := suite.db.GetWhere(context.Background(), []db.Where{{Key: "target_account_id", Value: suite.testAccounts["admin_account"].ID}}, &mentions)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			suite.FailNow(err.Error())
+		}
+		return mentions
+	}
+	before := len(adminMentions())
+
+	recorder := suite.threadRequest(fmt.Sprintf(`{"statuses":[
+		{"status":"hey @admin, look at my #threadtag 1/2"},
+		{"status":"second post in my thread! 2/2","in_reply_to_id":"%s"}
+	]}`, suite.testStatuses["local_account_2_status_1"].ID))
+
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+
+	// nothing from the first status should have been stored
+	suite.Len(adminMentions(), before)
+
+	tag := &gtsmodel.Tag{}
+	err := suite.db.GetWhere(context.Background(), []db.Where{{Key: "name", Value: "threadtag"}}, tag)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *StatusThreadCreateTestSuite) TestPostThreadEmpty() {
+	recorder := suite.threadRequest(`{"statuses":[]}`)
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+}
+
+func TestStatusThreadCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusThreadCreateTestSuite))
+}
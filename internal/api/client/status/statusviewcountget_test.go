@@ -0,0 +1,103 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/status"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusViewCountGetTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusViewCountGetTestSuite) getViewCount(accountKey string, statusID string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:8080%s", strings.Replace(status.ViewsPath, ":id", statusID, 1)), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   status.IDKey,
+			Value: statusID,
+		},
+	}
+
+	suite.statusModule.StatusViewCountGETHandler(ctx)
+	return recorder
+}
+
+func (suite *StatusViewCountGetTestSuite) TestGetViewCount() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	suite.NoError(suite.db.IncrementStatusViewCount(context.Background(), targetStatus.ID, false))
+	suite.NoError(suite.db.IncrementStatusViewCount(context.Background(), targetStatus.ID, false))
+	suite.NoError(suite.db.IncrementStatusViewCount(context.Background(), targetStatus.ID, true))
+
+	recorder := suite.getViewCount("local_account_1", targetStatus.ID)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	viewCount := &model.StatusViewCount{}
+	suite.NoError(json.Unmarshal(b, viewCount))
+	suite.Equal(2, viewCount.WebViews)
+	suite.Equal(1, viewCount.Fetches)
+}
+
+func (suite *StatusViewCountGetTestSuite) TestGetViewCountNeverViewed() {
+	targetStatus := suite.testStatuses["local_account_1_status_2"]
+
+	recorder := suite.getViewCount("local_account_1", targetStatus.ID)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"web_views":0,"fetches":0}`, string(b))
+}
+
+func (suite *StatusViewCountGetTestSuite) TestGetViewCountNotAuthor() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// someone else can see the status, but not how often it's been viewed
+	recorder := suite.getViewCount("local_account_2", targetStatus.ID)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestStatusViewCountGetTestSuite(t *testing.T) {
+	suite.Run(t, &StatusViewCountGetTestSuite{})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -65,10 +66,15 @@ var wsUpgrader = websocket.Upgrader{
 //			`public:local`: receive updates for the local timeline.
 //			`hashtag`: receive updates for a given hashtag.
 //			`hashtag:local`: receive local updates for a given hashtag.
-//			`list`: receive updates for a certain list of accounts.
+//			`list`: receive updates for one of the account's lists, given by the `list` parameter.
 //			`direct`: receive updates for direct messages.
 //		in: query
 //		required: true
+//	-
+//		name: list
+//		type: string
+//		description: ID of the list to receive updates for, when `stream` is `list`.
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -129,6 +135,17 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 		return
 	}
 
+	// list streams are opened for one particular list
+	if streamType == stream.TimelineList {
+		listID := c.Query(ListQueryKey)
+		if listID == "" {
+			err := fmt.Errorf("no list id provided under query key %s", ListQueryKey)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		streamType = stream.ListTimeline(listID)
+	}
+
 	accessToken := c.Query(AccessTokenQueryKey)
 	if accessToken == "" {
 		err := fmt.Errorf("no access token provided under query key %s", AccessTokenQueryKey)
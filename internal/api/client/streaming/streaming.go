@@ -33,6 +33,9 @@ const (
 	// StreamQueryKey is the query key for the type of stream being requested
 	StreamQueryKey = "stream"
 
+	// ListQueryKey is the query key for the ID of the list to stream, when the stream type is list
+	ListQueryKey = "list"
+
 	// AccessTokenQueryKey is the query key for an oauth access token that should be passed in streaming requests.
 	AccessTokenQueryKey = "access_token"
 )
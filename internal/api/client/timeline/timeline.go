@@ -31,8 +31,12 @@ const (
 	BasePath = "/api/v1/timelines"
 	// HomeTimeline is the path for the home timeline
 	HomeTimeline = BasePath + "/home"
+	// ListTimeline is the path for the timeline of a list
+	ListTimeline = BasePath + "/list/:" + IDKey
 	// PublicTimeline is the path for the public (and public local) timeline
 	PublicTimeline = BasePath + "/public"
+	// IDKey is the key to use for retrieving list IDs in context
+	IDKey = "id"
 	// MaxIDKey is the url query for setting a max status ID to return
 	MaxIDKey = "max_id"
 	// SinceIDKey is the url query for returning results newer than the given ID
@@ -60,6 +64,7 @@ func New(processor processing.Processor) api.ClientModule {
 // Route attaches all routes from this module to the given router
 func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodGet, HomeTimeline, m.HomeTimelineGETHandler)
+	r.AttachHandler(http.MethodGet, ListTimeline, m.ListTimelineGETHandler)
 	r.AttachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
 	return nil
 }
@@ -0,0 +1,155 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// PostByMailGETHandler swagger:operation GET /api/v1/user/post_by_mail userPostByMailGet
+//
+// View settings for posting statuses by email.
+//
+// If posting by email is enabled, the response includes the secret address that emails can be sent to,
+// from the user's confirmed email address, to post them as statuses.
+//
+//	---
+//	tags:
+//	- user
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:accounts
+//
+//	responses:
+//		'200':
+//			description: Post by email settings.
+//			schema:
+//				"$ref": "#/definitions/postByMail"
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: posting by email is not enabled on this instance
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal error
+func (m *Module) PostByMailGETHandler(c *gin.Context) {
+	m.postByMailHandler(c, m.processor.UserPostByMailGet)
+}
+
+// PostByMailPOSTHandler swagger:operation POST /api/v1/user/post_by_mail userPostByMailEnable
+//
+// Enable posting statuses by email, or get a new secret address to post to.
+//
+// Any previous secret address stops working straight away.
+// The user must have confirmed their email address.
+//
+//	---
+//	tags:
+//	- user
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: Post by email settings, including the new address.
+//			schema:
+//				"$ref": "#/definitions/postByMail"
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: posting by email is not enabled on this instance
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: user's email address is not confirmed
+//		'500':
+//			description: internal error
+func (m *Module) PostByMailPOSTHandler(c *gin.Context) {
+	m.postByMailHandler(c, m.processor.UserPostByMailEnable)
+}
+
+// PostByMailDELETEHandler swagger:operation DELETE /api/v1/user/post_by_mail userPostByMailDisable
+//
+// Disable posting statuses by email.
+//
+//	---
+//	tags:
+//	- user
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: Post by email settings.
+//			schema:
+//				"$ref": "#/definitions/postByMail"
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: posting by email is not enabled on this instance
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal error
+func (m *Module) PostByMailDELETEHandler(c *gin.Context) {
+	m.postByMailHandler(c, m.processor.UserPostByMailDisable)
+}
+
+func (m *Module) postByMailHandler(c *gin.Context, process func(ctx context.Context, authed *oauth.Auth) (*model.PostByMail, gtserror.WithCode)) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := api.NegotiateAccept(c, api.JSONAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	postByMail, errWithCode := process(c.Request.Context(), authed)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, postByMail)
+}
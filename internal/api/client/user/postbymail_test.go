@@ -0,0 +1,140 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PostByMailTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *PostByMailTestSuite) SetupTest() {
+	suite.UserStandardTestSuite.SetupTest()
+	config.SetMailGatewayEnabled(true)
+	config.SetMailGatewayDomain("post.example.org")
+	config.SetMailGatewaySecret("some-secret")
+}
+
+func (suite *PostByMailTestSuite) postByMail(method string, handler func(*gin.Context), testUser *gtsmodel.User, expectedCode int) *apimodel.PostByMail {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(method, fmt.Sprintf("http://localhost:8080%s", user.PostByMailPath), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	handler(ctx)
+
+	suite.Equal(expectedCode, recorder.Code)
+	if expectedCode != http.StatusOK {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	postByMail := &apimodel.PostByMail{}
+	suite.NoError(json.Unmarshal(b, postByMail))
+	return postByMail
+}
+
+func (suite *PostByMailTestSuite) TestPostByMailEnableGetDisable() {
+	testUser := suite.testUsers["local_account_1"]
+
+	postByMail := suite.postByMail(http.MethodGet, suite.userModule.PostByMailGETHandler, testUser, http.StatusOK)
+	suite.False(postByMail.Enabled)
+	suite.Empty(postByMail.Address)
+
+	postByMail = suite.postByMail(http.MethodPost, suite.userModule.PostByMailPOSTHandler, testUser, http.StatusOK)
+	suite.True(postByMail.Enabled)
+	suite.Regexp(regexp.MustCompile(`^[0-9a-f]{32}@post\.example\.org$`), postByMail.Address)
+	firstAddress := postByMail.Address
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), testUser.ID)
+	suite.NoError(err)
+	suite.Equal(firstAddress, dbUser.PostByMailToken+"@post.example.org")
+	suite.NotEmpty(dbUser.PostByMailApplicationID)
+	applicationID := dbUser.PostByMailApplicationID
+
+	application := &gtsmodel.Application{}
+	suite.NoError(suite.db.GetByID(context.Background(), applicationID, application))
+	suite.Equal("Post by email", application.Name)
+
+	postByMail = suite.postByMail(http.MethodGet, suite.userModule.PostByMailGETHandler, testUser, http.StatusOK)
+	suite.True(postByMail.Enabled)
+	suite.Equal(firstAddress, postByMail.Address)
+
+	// enabling again should give a new address, with the same application
+	postByMail = suite.postByMail(http.MethodPost, suite.userModule.PostByMailPOSTHandler, testUser, http.StatusOK)
+	suite.True(postByMail.Enabled)
+	suite.NotEqual(firstAddress, postByMail.Address)
+
+	dbUser, err = suite.db.GetUserByID(context.Background(), testUser.ID)
+	suite.NoError(err)
+	suite.Equal(applicationID, dbUser.PostByMailApplicationID)
+
+	postByMail = suite.postByMail(http.MethodDelete, suite.userModule.PostByMailDELETEHandler, testUser, http.StatusOK)
+	suite.False(postByMail.Enabled)
+	suite.Empty(postByMail.Address)
+
+	dbUser, err = suite.db.GetUserByID(context.Background(), testUser.ID)
+	suite.NoError(err)
+	suite.Empty(dbUser.PostByMailToken)
+	suite.Equal(applicationID, dbUser.PostByMailApplicationID)
+}
+
+func (suite *PostByMailTestSuite) TestPostByMailEnableUnconfirmedEmail() {
+	testUser := suite.testUsers["local_account_1"]
+	testUser.ConfirmedAt = time.Time{}
+
+	suite.postByMail(http.MethodPost, suite.userModule.PostByMailPOSTHandler, testUser, http.StatusUnprocessableEntity)
+}
+
+func (suite *PostByMailTestSuite) TestPostByMailGatewayDisabled() {
+	config.SetMailGatewayEnabled(false)
+	testUser := suite.testUsers["local_account_1"]
+
+	suite.postByMail(http.MethodGet, suite.userModule.PostByMailGETHandler, testUser, http.StatusNotFound)
+	suite.postByMail(http.MethodPost, suite.userModule.PostByMailPOSTHandler, testUser, http.StatusNotFound)
+	suite.postByMail(http.MethodDelete, suite.userModule.PostByMailDELETEHandler, testUser, http.StatusNotFound)
+}
+
+func TestPostByMailTestSuite(t *testing.T) {
+	suite.Run(t, &PostByMailTestSuite{})
+}
@@ -31,6 +31,10 @@ const (
 	BasePath = "/api/v1/user"
 	// PasswordChangePath is the path for POSTing a password change request.
 	PasswordChangePath = BasePath + "/password_change"
+	// PostByMailPath is the path for viewing, enabling, and disabling posting statuses by email.
+	PostByMailPath = BasePath + "/post_by_mail"
+	// UsernameChangePath is the path for POSTing a username change request.
+	UsernameChangePath = BasePath + "/username_change"
 )
 
 // Module implements the ClientAPIModule interface
@@ -48,5 +52,9 @@ func New(processor processing.Processor) api.ClientModule {
 // Route attaches all routes from this module to the given router
 func (m *Module) Route(r router.Router) error {
 	r.AttachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
+	r.AttachHandler(http.MethodGet, PostByMailPath, m.PostByMailGETHandler)
+	r.AttachHandler(http.MethodPost, PostByMailPath, m.PostByMailPOSTHandler)
+	r.AttachHandler(http.MethodDelete, PostByMailPath, m.PostByMailDELETEHandler)
+	r.AttachHandler(http.MethodPost, UsernameChangePath, m.UsernameChangePOSTHandler)
 	return nil
 }
@@ -0,0 +1,119 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type UsernameChangeTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *UsernameChangeTestSuite) changeUsername(password string, newUsername string) (int, []byte) {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", user.UsernameChangePath), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"password":     {password},
+		"new_username": {newUsername},
+	}
+	suite.userModule.UsernameChangePOSTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	return recorder.Code, b
+}
+
+func (suite *UsernameChangeTestSuite) TestUsernameChangePOST() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	code, b := suite.changeUsername("password", "Zork_The_Renamed")
+	suite.Equal(http.StatusOK, code)
+
+	apiAccount := &apimodel.Account{}
+	err := json.Unmarshal(b, apiAccount)
+	suite.NoError(err)
+	suite.Equal(testAccount.ID, apiAccount.ID)
+	suite.Equal("zork_the_renamed", apiAccount.Username)
+	suite.Equal("zork_the_renamed", apiAccount.Acct)
+	suite.Equal("http://localhost:8080/@zork_the_renamed", apiAccount.URL)
+
+	// the activitypub uri stays the same
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.Equal(testAccount.URI, dbAccount.URI)
+
+	// and the account can still be found by its old username
+	aliased, err := suite.db.GetAccountByUsernameDomain(context.Background(), testAccount.Username, "")
+	suite.NoError(err)
+	suite.Equal(testAccount.ID, aliased.ID)
+}
+
+func (suite *UsernameChangeTestSuite) TestUsernameChangeWrongPassword() {
+	code, b := suite.changeUsername("not the password", "zork_the_renamed")
+	suite.Equal(http.StatusUnauthorized, code)
+	suite.Equal(`{"error":"Unauthorized: password was incorrect","code":401}`, string(b))
+}
+
+func (suite *UsernameChangeTestSuite) TestUsernameChangeInUse() {
+	code, b := suite.changeUsername("password", suite.testAccounts["local_account_2"].Username)
+	suite.Equal(http.StatusConflict, code)
+	suite.Equal(`{"error":"Conflict: username 1happyturtle in use","code":409}`, string(b))
+}
+
+func (suite *UsernameChangeTestSuite) TestUsernameChangeInvalid() {
+	code, b := suite.changeUsername("password", "zork the renamed")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: given username zork the renamed was invalid: must contain only lowercase letters, numbers, and underscores, max 64 characters","code":400}`, string(b))
+}
+
+func (suite *UsernameChangeTestSuite) TestUsernameChangeMissingNewUsername() {
+	code, b := suite.changeUsername("password", "")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: username change request missing field new_username","code":400}`, string(b))
+}
+
+func TestUsernameChangeTestSuite(t *testing.T) {
+	suite.Run(t, &UsernameChangeTestSuite{})
+}
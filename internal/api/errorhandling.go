@@ -20,6 +20,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"codeberg.org/gruf/go-kv"
@@ -27,10 +28,41 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
-// TODO: add more templated html pages here for different error types
+// errorTemplateCodes are the status codes which get their own
+// html error page template, named like '[code].tmpl', which admins
+// can override. Other codes are served using 'error.tmpl'.
+var errorTemplateCodes = map[int]bool{
+	http.StatusForbidden:           true,
+	http.StatusNotFound:            true,
+	http.StatusInternalServerError: true,
+}
+
+// errorTemplate returns the name of the html template
+// to use for serving an error with the given code.
+func errorTemplate(code int) string {
+	if errorTemplateCodes[code] {
+		return fmt.Sprintf("%d.tmpl", code)
+	}
+	return "error.tmpl"
+}
+
+// errorLanguage returns the language to serve an error in, taking
+// into account the locale of the authorized user, if there is one.
+func errorLanguage(c *gin.Context) string {
+	var userLocale string
+	if i, ok := c.Get(oauth.SessionAuthorizedUser); ok {
+		if user, ok := i.(*gtsmodel.User); ok && user != nil {
+			userLocale = user.Locale
+		}
+	}
+	return i18n.Negotiate(c, userLocale)
+}
 
 // NotFoundHandler serves a 404 html page through the provided gin context,
 // if accept is 'text/html', or just returns a json error if 'accept' is empty
@@ -42,6 +74,9 @@ import (
 //
 // If an error is returned by InstanceGet, the function will panic.
 func NotFoundHandler(c *gin.Context, instanceGet func(ctx context.Context, domain string) (*apimodel.Instance, gtserror.WithCode), accept string) {
+	lang := errorLanguage(c)
+	errText := i18n.Translate(lang, http.StatusText(http.StatusNotFound))
+
 	switch accept {
 	case string(TextHTML):
 		host := config.GetHost()
@@ -50,11 +85,17 @@ func NotFoundHandler(c *gin.Context, instanceGet func(ctx context.Context, domai
 			panic(err)
 		}
 
-		c.HTML(http.StatusNotFound, "404.tmpl", gin.H{
+		c.HTML(http.StatusNotFound, errorTemplate(http.StatusNotFound), gin.H{
 			"instance": instance,
+			"lang":     lang,
+			"code":     http.StatusNotFound,
+			"error":    errText,
 		})
 	default:
-		c.JSON(http.StatusNotFound, gin.H{"error": http.StatusText(http.StatusNotFound)})
+		c.JSON(http.StatusNotFound, apimodel.Error{
+			Error: errText,
+			Code:  http.StatusNotFound,
+		})
 	}
 }
 
@@ -62,6 +103,9 @@ func NotFoundHandler(c *gin.Context, instanceGet func(ctx context.Context, domai
 // be used for serving either generic error pages with some rendered help text,
 // or just some error json if the caller prefers (or has no preference).
 func genericErrorHandler(c *gin.Context, instanceGet func(ctx context.Context, domain string) (*apimodel.Instance, gtserror.WithCode), accept string, errWithCode gtserror.WithCode) {
+	lang := errorLanguage(c)
+	errText := i18n.Translate(lang, errWithCode.Safe())
+
 	switch accept {
 	case string(TextHTML):
 		host := config.GetHost()
@@ -70,13 +114,17 @@ func genericErrorHandler(c *gin.Context, instanceGet func(ctx context.Context, d
 			panic(err)
 		}
 
-		c.HTML(errWithCode.Code(), "error.tmpl", gin.H{
+		c.HTML(errWithCode.Code(), errorTemplate(errWithCode.Code()), gin.H{
 			"instance": instance,
+			"lang":     lang,
 			"code":     errWithCode.Code(),
-			"error":    errWithCode.Safe(),
+			"error":    errText,
 		})
 	default:
-		c.JSON(errWithCode.Code(), gin.H{"error": errWithCode.Safe()})
+		c.JSON(errWithCode.Code(), apimodel.Error{
+			Error: errText,
+			Code:  errWithCode.Code(),
+		})
 	}
 }
 
@@ -33,4 +33,6 @@ const (
 	TextXML           MIME = `text/xml`
 	TextHTML          MIME = `text/html`
 	TextCSS           MIME = `text/css`
+	TextCSV           MIME = `text/csv`
+	ImageSVG          MIME = `image/svg+xml`
 )
@@ -106,6 +106,11 @@ type Account struct {
 type AccountCreateRequest struct {
 	// Text that will be reviewed by moderators if registrations require manual approval.
 	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// A link to a web page which links back to the new account's profile with rel="me".
+	// Required if this instance only accepts signups vouched for by pages on certain domains.
+	// swagger:parameters
+	// example: https://staff.example.org/someone
+	Link string `form:"link" json:"link" xml:"link"`
 	// The desired username for the account.
 	// swagger:parameters
 	// pattern: [a-z0-9_]{2,64}
@@ -175,8 +180,26 @@ type UpdateSource struct {
 	Sensitive *bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
 	// Default language to use for authored statuses. (ISO 6391)
 	Language *string `form:"language" json:"language" xml:"language"`
+	// Language to show web pages and error messages in, eg., de. Empty to let the browser or instance decide.
+	Locale *string `form:"locale" json:"locale" xml:"locale"`
 	// Default format for authored statuses (plain or markdown).
 	StatusFormat *string `form:"status_format" json:"status_format" xml:"status_format"`
+	// Allow followers to boost authored followers-only statuses to their own followers.
+	FollowersOnlyBoostable *bool `form:"followers_only_boostable" json:"followers_only_boostable" xml:"followers_only_boostable"`
+	// Which accounts may mention this account: everyone, following (only accounts this account follows), or nobody.
+	MentionPolicy *string `form:"mention_policy" json:"mention_policy" xml:"mention_policy"`
+	// Hide boosts on the public web profile.
+	WebHideBoosts *bool `form:"web_hide_boosts" json:"web_hide_boosts" xml:"web_hide_boosts"`
+	// Hide replies on the public web profile.
+	WebHideReplies *bool `form:"web_hide_replies" json:"web_hide_replies" xml:"web_hide_replies"`
+	// Show pinned statuses above other statuses on the public web profile.
+	WebPinnedFirst *bool `form:"web_pinned_first" json:"web_pinned_first" xml:"web_pinned_first"`
+	// Show a media-only gallery tab on the public web profile.
+	WebMediaTab *bool `form:"web_media_tab" json:"web_media_tab" xml:"web_media_tab"`
+	// Hide statuses from bot and mirror accounts in the public timelines.
+	HideMirrors *bool `form:"hide_mirrors" json:"hide_mirrors" xml:"hide_mirrors"`
+	// Delete read notifications after this many days; 0 keeps them forever, and -1 uses the instance default.
+	NotificationsRetentionDays *int `form:"notifications_retention_days" json:"notifications_retention_days" xml:"notifications_retention_days"`
 }
 
 // UpdateField is to be used specifically in an UpdateCredentialsRequest.
@@ -198,6 +221,8 @@ type AccountFollowRequest struct {
 	ID string `form:"-" json:"-" xml:"-"`
 	// Show reblogs from this account.
 	Reblogs *bool `form:"reblogs" json:"reblogs" xml:"reblogs"`
+	// Show replies from this account to other accounts.
+	Replies *bool `form:"replies" json:"replies" xml:"replies"`
 	// Notify when this account posts.
 	Notify *bool `form:"notify" json:"notify" xml:"notify"`
 }
@@ -225,3 +250,19 @@ const (
 	AccountRoleAdmin     AccountRole = "admin"     // Instance admin
 	AccountRoleUnknown   AccountRole = ""          // We don't know / remote account
 )
+
+// WebLayout models the layout options an account has chosen for its public web profile.
+//
+// swagger:ignore
+type WebLayout struct {
+	// Hide boosts on the web profile.
+	HideBoosts bool
+	// Hide replies on the web profile.
+	HideReplies bool
+	// Show pinned statuses above other statuses on the web profile.
+	PinnedFirst bool
+	// Show a media-only gallery tab on the web profile.
+	MediaTab bool
+	// Names of hashtags featured on the web profile, each shown as its own tab.
+	FeaturedTags []string
+}
@@ -0,0 +1,69 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// AccountStats models statistics about the activity of the requesting account over a period of time,
+// suitable for drawing activity heatmaps or "year in review" summaries.
+//
+// swagger:model accountStats
+type AccountStats struct {
+	// First day covered by these stats (ISO 8601 Date, UTC).
+	// This is the later of the requested start and the day the account was created.
+	// example: 2022-01-01
+	Since string `json:"since"`
+	// Stats for each day, oldest first. The last entry is for today so far.
+	Days []AccountStatsPeriod `json:"days"`
+	// Stats for each week (starting on Monday), oldest first. The first and last weeks may be partial.
+	Weeks []AccountStatsPeriod `json:"weeks"`
+	// Hashtags most used by the account during the covered days, most used first.
+	Tags []AccountStatsTag `json:"tags"`
+}
+
+// AccountStatsPeriod models the activity of an account over one day or week.
+//
+// swagger:model accountStatsPeriod
+type AccountStatsPeriod struct {
+	// First day of the period (ISO 8601 Date, UTC).
+	// example: 2022-11-28
+	Date string `json:"date"`
+	// Number of statuses posted during the period, not counting boosts.
+	// example: 12
+	Statuses int `json:"statuses"`
+	// Number of boosts made during the period.
+	// example: 3
+	Boosts int `json:"boosts"`
+	// Number of accounts which started following during the period, and haven't unfollowed since.
+	// example: 2
+	NewFollowers int `json:"new_followers"`
+	// Number of followers at the end of the period. Followers who later unfollowed may not be counted.
+	// example: 120
+	Followers int `json:"followers"`
+}
+
+// AccountStatsTag models how often an account used a hashtag.
+//
+// swagger:model accountStatsTag
+type AccountStatsTag struct {
+	// Name of the hashtag, without the #.
+	// example: caturday
+	Name string `json:"name"`
+	// Number of statuses the hashtag was used in.
+	// example: 7
+	Count int `json:"count"`
+}
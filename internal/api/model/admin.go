@@ -107,13 +107,282 @@ type AdminEmoji struct {
 	// The ActivityPub URI of the emoji.
 	// example: https://example.org/emojis/016T5Q3SQKBT337DAKVSKNXXW1
 	URI string `json:"uri"`
+	// Name of the artist who created the emoji, if known.
+	// example: Volpeon
+	Author string `json:"author,omitempty"`
+	// License under which the emoji may be used, if known.
+	// example: CC BY-NC-SA 4.0
+	License string `json:"license,omitempty"`
+	// Where the emoji was originally published, if known.
+	// example: https://volpeon.ink/emojis/neocat/
+	SourceURL string `json:"source_url,omitempty"`
+}
+
+// AdminEmojiUsage models how much one custom emoji is used.
+//
+// swagger:model adminEmojiUsage
+type AdminEmojiUsage struct {
+	// The emoji.
+	Emoji *AdminEmoji `json:"emoji"`
+	// Number of statuses that use this emoji.
+	// example: 42
+	StatusesCount int `json:"statuses_count"`
+	// Number of accounts that use this emoji in their display name or bio.
+	// example: 3
+	AccountsCount int `json:"accounts_count"`
+}
+
+// AdminEmojiDomainAction models the result of an action taken on all emojis from one remote domain.
+//
+// swagger:model adminEmojiDomainAction
+type AdminEmojiDomainAction struct {
+	// Domain whose emojis were acted on.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Type of the action. One of disable, enable, refresh.
+	// example: disable
+	Type string `json:"type"`
+	// Number of emojis acted on. For disable and enable, emojis which were already
+	// disabled or enabled aren't counted. For refresh, this is the number of emojis
+	// which will be refreshed in the background.
+	// example: 12
+	Count int `json:"count"`
+}
+
+// AdminFederationError models one recent failed federation request made to or received from a remote domain.
+//
+// swagger:model adminFederationError
+type AdminFederationError struct {
+	// Time at which the request failed (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The remote domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Whether the request was made by the remote domain to us (inbound), or by us to the remote domain (outbound).
+	// example: outbound
+	Direction string `json:"direction"`
+	// The way in which the request failed. One of signature, tls, http_status, network.
+	// example: http_status
+	Kind string `json:"kind"`
+	// URL of the request. Only set for outbound requests.
+	// example: https://example.org/users/someone/inbox
+	URL string `json:"url,omitempty"`
+	// HTTP status code of the response, if there was one.
+	// example: 502
+	StatusCode int `json:"status_code,omitempty"`
+	// Description of the error.
+	// example: http response "502 Bad Gateway"
+	Message string `json:"message"`
+}
+
+// AdminFederationErrorDomain summarizes the recent failed federation requests to or from one remote domain.
+//
+// swagger:model adminFederationErrorDomain
+type AdminFederationErrorDomain struct {
+	// The remote domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of failed requests recorded for this domain since the instance was started.
+	// example: 42
+	Count int `json:"count"`
+	// The most recent failed request.
+	LatestError *AdminFederationError `json:"latest_error"`
+}
+
+// AdminDomainStorage models how much is stored on this instance from one remote domain.
+//
+// swagger:model adminDomainStorage
+type AdminDomainStorage struct {
+	// The remote domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of accounts from this domain.
+	// example: 120
+	AccountsCount int `json:"accounts_count"`
+	// Number of statuses posted by accounts from this domain.
+	// example: 5000
+	StatusesCount int `json:"statuses_count"`
+	// Number of media attachments, avatars and headers of accounts from this domain.
+	// example: 800
+	AttachmentsCount int `json:"attachments_count"`
+	// Bytes of storage taken up by the media attachments, avatars and headers of accounts from this domain which are currently cached.
+	// example: 104857600
+	AttachmentsBytes int64 `json:"attachments_bytes"`
+	// Number of custom emojis from this domain.
+	// example: 50
+	EmojisCount int `json:"emojis_count"`
+	// Bytes of storage taken up by custom emojis from this domain.
+	// example: 1048576
+	EmojisBytes int64 `json:"emojis_bytes"`
+	// Bytes of storage taken up by media attachments and custom emojis from this domain together.
+	// example: 105906176
+	TotalBytes int64 `json:"total_bytes"`
+}
+
+// AdminDBPoolStats models the statistics for one pool of connections to the database.
+//
+// swagger:model adminDBPoolStats
+type AdminDBPoolStats struct {
+	// Name of the pool; primary, or the address of a read replica.
+	// example: primary
+	Name string `json:"name"`
+	// Maximum number of open connections allowed in the pool.
+	// example: 32
+	MaxOpenConnections int `json:"max_open_connections"`
+	// Number of open connections, both in use and idle.
+	// example: 8
+	OpenConnections int `json:"open_connections"`
+	// Number of connections in use.
+	// example: 6
+	InUse int `json:"in_use"`
+	// Number of idle connections.
+	// example: 2
+	Idle int `json:"idle"`
+	// Number of times a query had to wait for a connection, since the instance was started.
+	// example: 120
+	WaitCount int64 `json:"wait_count"`
+	// Total time spent waiting for connections in milliseconds, since the instance was started.
+	// example: 3500
+	WaitDurationMS int64 `json:"wait_duration_ms"`
+	// Number of connections closed because there were too many idle connections.
+	// example: 10
+	MaxIdleClosed int64 `json:"max_idle_closed"`
+	// Number of connections closed because they were idle for too long.
+	// example: 0
+	MaxIdleTimeClosed int64 `json:"max_idle_time_closed"`
+	// Number of connections closed because they reached their maximum lifetime.
+	// example: 4
+	MaxLifetimeClosed int64 `json:"max_lifetime_closed"`
+}
+
+// AdminCacheStats models the statistics for one cache kept in front of the database.
+//
+// swagger:model adminCacheStats
+type AdminCacheStats struct {
+	// Name of the cache.
+	// example: statuses
+	Name string `json:"name"`
+	// Number of items currently in the cache.
+	// example: 1200
+	Size int `json:"size"`
+	// Number of lookups which found an item in the cache, since the instance was started.
+	// example: 95000
+	Hits uint64 `json:"hits"`
+	// Number of lookups which found nothing in the cache, since the instance was started.
+	// example: 5000
+	Misses uint64 `json:"misses"`
+	// Number of items removed from the cache because they expired, since the instance was started.
+	// example: 3800
+	Evictions uint64 `json:"evictions"`
+	// Fraction of lookups which found an item in the cache; 0 if there have been no lookups.
+	// example: 0.95
+	HitRatio float64 `json:"hit_ratio"`
+}
+
+// AdminDBMaintenanceResult models the outcome of a database maintenance run.
+//
+// swagger:model adminDBMaintenanceResult
+type AdminDBMaintenanceResult struct {
+	// Size of the database in bytes before maintenance.
+	// example: 104857600
+	SizeBefore int64 `json:"size_before"`
+	// Size of the database in bytes after maintenance.
+	// example: 83886080
+	SizeAfter int64 `json:"size_after"`
+	// Number of bytes reclaimed by maintenance, or 0 if the database grew.
+	// example: 20971520
+	Reclaimed int64 `json:"reclaimed"`
+	// Whether the database was vacuumed (sqlite only).
+	// example: true
+	Vacuumed bool `json:"vacuumed"`
+	// Whether the database indexes were rebuilt (postgres only).
+	// example: false
+	Reindexed bool `json:"reindexed"`
+	// How long maintenance took to run, in milliseconds.
+	// example: 4200
+	DurationMS int64 `json:"duration_ms"`
+}
+
+// AdminUserAgentRejection models the number of requests rejected because of one blocked user agent rule.
+//
+// swagger:model adminUserAgentRejection
+type AdminUserAgentRejection struct {
+	// The kind of rule. One of pattern (configured with advanced-blocked-user-agents) or ai_scraper.
+	// example: ai_scraper
+	Kind string `json:"kind"`
+	// The configured pattern, or the user agent token of the AI scraper.
+	// example: GPTBot
+	Pattern string `json:"pattern"`
+	// Number of requests rejected by this rule since the instance was started.
+	// example: 42
+	Count int `json:"count"`
+}
+
+// AdminAccountInteraction models the interactions between a remote account and one local account.
+//
+// swagger:model adminAccountInteraction
+type AdminAccountInteraction struct {
+	// The local account.
+	Account *Account `json:"account"`
+	// The remote account follows the local account.
+	// example: true
+	Following bool `json:"following"`
+	// The remote account has requested to follow the local account.
+	// example: false
+	Requested bool `json:"requested"`
+	// The local account follows the remote account.
+	// example: false
+	FollowedBy bool `json:"followed_by"`
+	// The local account blocks the remote account.
+	// example: false
+	BlockedBy bool `json:"blocked_by"`
+	// Number of statuses in which the remote account has mentioned the local account.
+	// example: 3
+	MentionsCount int `json:"mentions_count"`
+	// Time at which the remote account last mentioned the local account (ISO 8601 Datetime), if ever.
+	// example: 2021-07-30T09:20:25+00:00
+	LastMentionedAt string `json:"last_mentioned_at,omitempty"`
+}
+
+// AdminAccountInteractions summarizes the interactions between a remote account and local accounts.
+//
+// swagger:model adminAccountInteractions
+type AdminAccountInteractions struct {
+	// Number of local accounts followed by the remote account.
+	// example: 5
+	LocalFollowing int `json:"local_following"`
+	// Number of local accounts following the remote account.
+	// example: 2
+	LocalFollowers int `json:"local_followers"`
+	// Number of pending follow requests from the remote account to local accounts.
+	// example: 1
+	PendingFollowRequests int `json:"pending_follow_requests"`
+	// Number of local accounts blocking the remote account.
+	// example: 4
+	LocalBlocks int `json:"local_blocks"`
+	// Total number of mentions of local accounts by the remote account.
+	// example: 12
+	MentionsCount int `json:"mentions_count"`
+	// Interactions with each local account, most mentioned first.
+	Interactions []*AdminAccountInteraction `json:"interactions"`
+}
+
+// AdminRecoveryCodes are freshly generated recovery codes for a local account,
+// which can each be used once to sign in when the OIDC provider is unavailable.
+//
+// swagger:model adminRecoveryCodes
+type AdminRecoveryCodes struct {
+	// The recovery codes. These are only shown this once.
+	// example: ["abcde-fghjk","mnpqr-stuvw"]
+	Codes []string `json:"codes"`
 }
 
 // AdminAccountActionRequest models the admin view of an account's details.
 //
 // swagger:ignore
 type AdminAccountActionRequest struct {
-	// Type of the account action. One of disable, silence, suspend.
+	// Type of the account action. One of disable, silence, suspend, mirror, unmirror.
 	Type string `form:"type" json:"type" xml:"type"`
 	// Text describing why an action was taken.
 	Text string `form:"text" json:"text" xml:"text"`
@@ -75,6 +75,10 @@ type Attachment struct {
 	// The location of a scaled-down preview of the attachment.
 	// example: https://example.org/fileserver/some_id/attachments/some_id/small/attachment.jpeg
 	PreviewURL string `json:"preview_url"`
+	// The location of a medium-sized version of the attachment, suitable for display on large screens.
+	// Only set for images large enough to need one; otherwise, use url.
+	// example: https://example.org/fileserver/some_id/attachments/some_id/medium/attachment.jpeg
+	MediumURL string `json:"medium_url,omitempty"`
 	// The location of the full-size original attachment on the remote server.
 	// Only defined for instances other than our own.
 	// example: https://some-other-server.org/attachments/original/ahhhhh.jpeg
@@ -130,6 +134,9 @@ type MediaMeta struct {
 	Original MediaDimensions `json:"original"`
 	// Dimensions of the thumbnail/small version of the media.
 	Small MediaDimensions `json:"small,omitempty"`
+	// Dimensions of the medium-sized version of the media.
+	// Only set if medium_url is set.
+	Medium *MediaDimensions `json:"medium,omitempty"`
 	// Focus data for the media.
 	Focus MediaFocus `json:"focus,omitempty"`
 }
@@ -24,3 +24,18 @@ type BlocksResponse struct {
 	Accounts   []*Account
 	LinkHeader string
 }
+
+// DomainBlocksResponse wraps a slice of domains blocked by an account, ready to be serialized,
+// along with the Link header for the previous and next queries, to be returned to the client.
+type DomainBlocksResponse struct {
+	Domains    []string
+	LinkHeader string
+}
+
+// AccountDomainBlockRequest models a request to block or unblock a domain for the requesting account.
+//
+// swagger:ignore
+type AccountDomainBlockRequest struct {
+	// Domain to block or unblock.
+	Domain string `form:"domain" json:"domain" xml:"domain"`
+}
@@ -0,0 +1,47 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// BookmarkFolder represents a folder that the authenticated user can file their bookmarks in.
+//
+// swagger:model bookmarkFolder
+type BookmarkFolder struct {
+	// The internal database ID of the bookmark folder.
+	// example: 01GKZB7QGN3MV7Y4W9H6C1Q2XE
+	ID string `json:"id"`
+	// The user-defined title of the bookmark folder.
+	// example: recipes
+	Title string `json:"title"`
+}
+
+// BookmarkFolderCreateUpdateRequest models a request to create or rename a bookmark folder.
+//
+// swagger:ignore
+type BookmarkFolderCreateUpdateRequest struct {
+	// Title of the bookmark folder.
+	Title string `form:"title" json:"title" xml:"title"`
+}
+
+// BookmarkFolderStatusesChangeRequest models a request to file bookmarks in a bookmark folder or take them out of it.
+//
+// swagger:ignore
+type BookmarkFolderStatusesChangeRequest struct {
+	// IDs of the bookmarked statuses to file or take out.
+	StatusIDs []string `form:"status_ids[]" json:"status_ids" xml:"status_ids"`
+}
@@ -0,0 +1,42 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// ClientSetting represents one key-value pair stored on the server by an application, on behalf of the requesting account.
+//
+// swagger:model clientSetting
+type ClientSetting struct {
+	// Key of this setting. Unique per account and application.
+	// example: pinned_columns
+	Key string `json:"key"`
+	// Value of this setting, exactly as it was stored by the application.
+	// example: ["home","notifications","local"]
+	Value string `json:"value"`
+	// When this setting was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ClientSettingUpdateRequest models a request to store a client setting.
+//
+// swagger:ignore
+type ClientSettingUpdateRequest struct {
+	// Value to store for this setting.
+	Value string `form:"value" json:"value" xml:"value"`
+}
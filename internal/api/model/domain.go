@@ -27,6 +27,10 @@ type Domain struct {
 	// The hostname of the domain.
 	// example: example.org
 	Domain string `form:"domain" json:"domain" validate:"required"`
+	// Hex-encoded SHA256 digest of the domain name. Only present on blocked domains when
+	// they're served publicly, so that obfuscated domains can still be checked.
+	// example: bfabc37432958b063360d3ad6461c9c4735ae7f8edd46592a5e0f01452b2e4b5
+	Digest string `json:"digest,omitempty"`
 	// Time at which this domain was suspended. Key will not be present on open domains.
 	// example: 2021-07-30T09:20:25+00:00
 	SuspendedAt string `json:"suspended_at,omitempty"`
@@ -63,6 +67,10 @@ type DomainBlock struct {
 	// Time at which this block was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at,omitempty"`
+	// Time at which this block will automatically be lifted (ISO 8601 Datetime).
+	// Key will not be present on blocks that don't expire.
+	// example: 2021-08-30T09:20:25+00:00
+	ExpiresAt string `json:"expires_at,omitempty"`
 }
 
 // DomainBlockCreateRequest is the form submitted as a POST to /api/v1/admin/domain_blocks to create a new block.
@@ -79,4 +87,58 @@ type DomainBlockCreateRequest struct {
 	PrivateComment string `form:"private_comment" json:"private_comment" xml:"private_comment"`
 	// public comment on the reason for the domain block
 	PublicComment string `form:"public_comment" json:"public_comment" xml:"public_comment"`
+	// number of seconds after which the domain block should automatically be lifted; 0 means never
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// DomainNote represents a private note attached to a remote domain by an instance admin.
+//
+// swagger:model domainNote
+type DomainNote struct {
+	// The hostname of the domain this note is about.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Text of the note, visible to our instance admins only.
+	// example: limited 2023-06 due to spam wave, re-evaluate
+	Text string `json:"text"`
+	// ID of the admin account that last edited this note.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	UpdatedBy string `json:"updated_by"`
+	// Time at which this note was last edited (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// DomainEmojiPolicy represents what happens to custom emojis federated in from a remote domain.
+//
+// swagger:model domainEmojiPolicy
+type DomainEmojiPolicy struct {
+	// The hostname of the domain this policy applies to.
+	// example: example.org
+	Domain string `json:"domain"`
+	// What happens to emojis from the domain. One of store, hide, reject.
+	// example: hide
+	Policy string `json:"policy"`
+	// ID of the admin account that last set this policy.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	UpdatedBy string `json:"updated_by"`
+	// Time at which this policy was last set (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// DomainEmojiPolicyRequest is the form submitted as a PUT to /api/v1/admin/domain_emoji_policies/{domain} to set the emoji policy for a domain.
+//
+// swagger:ignore
+type DomainEmojiPolicyRequest struct {
+	// What to do with emojis from the domain.
+	Policy string `form:"policy" json:"policy" xml:"policy"`
+}
+
+// DomainNoteRequest is the form submitted as a PUT to /api/v1/admin/domain_notes/{domain} to set the note on a domain.
+//
+// swagger:ignore
+type DomainNoteRequest struct {
+	// Text of the note.
+	Text string `form:"text" json:"text" xml:"text"`
 }
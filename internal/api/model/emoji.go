@@ -48,9 +48,50 @@ type EmojiCreateRequest struct {
 	// Desired shortcode for the emoji, without surrounding colons. This must be unique for the domain.
 	// example: blobcat_uwu
 	Shortcode string `form:"shortcode" validation:"required"`
-	// Image file to use for the emoji. Must be png or gif and no larger than 50kb.
+	// Image file to use for the emoji. Must be png, gif, or svg and no larger than 50kb.
 	Image *multipart.FileHeader `form:"image" validation:"required"`
 	// Category in which to place the new emoji. Will be uncategorized by default.
 	// CategoryName length should not exceed 64 characters.
 	CategoryName string `form:"category"`
 }
+
+// EmojiUpdateRequest represents a request to update the attribution of a custom emoji, made through the admin API.
+// Fields that aren't set are left unchanged; fields set to an empty string are cleared.
+//
+// swagger:ignore
+type EmojiUpdateRequest struct {
+	// Name of the artist who created the emoji.
+	Author *string `form:"author" json:"author" xml:"author"`
+	// License under which the emoji may be used.
+	License *string `form:"license" json:"license" xml:"license"`
+	// Where the emoji was originally published.
+	SourceURL *string `form:"source_url" json:"source_url" xml:"source_url"`
+}
+
+// EmojiCopyRequest represents a request to copy a remote emoji to a local emoji, made through the admin API.
+//
+// swagger:ignore
+type EmojiCopyRequest struct {
+	// Desired shortcode for the local emoji, without surrounding colons. Defaults to the shortcode of the remote emoji.
+	Shortcode string `form:"shortcode"`
+	// Category in which to place the local emoji. Will be uncategorized by default.
+	CategoryName string `form:"category"`
+}
+
+// EmojiDomainActionRequest represents a request to act on all emojis from one remote domain, made through the admin API.
+//
+// swagger:ignore
+type EmojiDomainActionRequest struct {
+	// Type of the action. One of disable, enable, refresh.
+	Type string `form:"type" json:"type" xml:"type"`
+}
+
+// EmojiPackImportRequest represents a request to import a Pleroma/Akkoma-style emoji pack made through the admin API.
+//
+// swagger:ignore
+type EmojiPackImportRequest struct {
+	// Zip archive containing a pack.json manifest and the emoji images it lists.
+	Pack *multipart.FileHeader `form:"pack" validation:"required"`
+	// Category in which to place the imported emojis. Will be uncategorized by default.
+	CategoryName string `form:"category"`
+}
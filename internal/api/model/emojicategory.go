@@ -27,3 +27,22 @@ type EmojiCategory struct {
 	// The name of the custom emoji category.
 	Name string `json:"name"`
 }
+
+// EmojiCategoryUpdateRequest represents a request to rename an emoji category, made through the admin API.
+//
+// swagger:ignore
+type EmojiCategoryUpdateRequest struct {
+	// New name for the category. Must not already be used by another category.
+	Name string `form:"name" json:"name" xml:"name"`
+}
+
+// EmojiCategoryDeleteRequest represents a request to delete an emoji category, made through the admin API.
+//
+// swagger:ignore
+type EmojiCategoryDeleteRequest struct {
+	// Name of a category to move the emojis of the deleted category into.
+	// If a category with this name doesn't exist yet, it will be created.
+	ReassignTo string `form:"reassign_to" json:"reassign_to" xml:"reassign_to"`
+	// Delete the local emojis in the category along with the category.
+	Cascade bool `form:"cascade" json:"cascade" xml:"cascade"`
+}
@@ -0,0 +1,43 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+import "mime/multipart"
+
+// FavouritesImportRequest is the form submitted as a POST to /api/v1/favourites/import to re-favourite statuses.
+//
+// swagger:ignore
+type FavouritesImportRequest struct {
+	// CSV file of status URIs or URLs to favourite, one per line.
+	Data *multipart.FileHeader `form:"data" validation:"required"`
+	// Dereference statuses that aren't known to this instance yet from their origin instance.
+	Resolve bool `form:"resolve"`
+}
+
+// FavouritesImportResult is returned after importing favourites from a CSV file.
+//
+// swagger:model favouritesImportResult
+type FavouritesImportResult struct {
+	// Number of statuses that were favourited.
+	// example: 12
+	Imported int `json:"imported"`
+	// Number of lines that were skipped, because their status couldn't be found, isn't visible, or can't be favourited.
+	// example: 2
+	Skipped int `json:"skipped"`
+}
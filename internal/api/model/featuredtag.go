@@ -19,6 +19,8 @@
 package model
 
 // FeaturedTag represents a hashtag that is featured on a profile.
+//
+// swagger:model featuredTag
 type FeaturedTag struct {
 	// The internal ID of the featured tag in the database.
 	ID string `json:"id"`
@@ -31,3 +33,11 @@ type FeaturedTag struct {
 	// The timestamp of the last authored status containing this hashtag. (ISO 8601 Datetime)
 	LastStatusAt string `json:"last_status_at"`
 }
+
+// FeaturedTagCreateRequest models a request to feature a hashtag on the requesting account's profile.
+//
+// swagger:ignore
+type FeaturedTagCreateRequest struct {
+	// The hashtag to feature, with or without the leading '#'.
+	Name string `form:"name" json:"name" xml:"name"`
+}
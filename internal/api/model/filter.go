@@ -44,3 +44,125 @@ type Filter struct {
 	// Should matching entities in home and notifications be dropped by the server?
 	Irreversible bool `json:"irreversible"`
 }
+
+// FilterV2 represents a user-defined filter for determining which statuses should not be shown to the user.
+// Statuses matching a filter with filter_action warn are returned with a filter result in their filtered
+// field, so that clients can show them behind a warning. Statuses matching a filter with filter_action hide
+// are left out by the server.
+//
+// swagger:model filterV2
+type FilterV2 struct {
+	// The ID of the filter in the database.
+	// example: 01GKZA3J0BMGF7JN6TMDX6T3V0
+	ID string `json:"id"`
+	// The name given by the user to the filter.
+	// example: fnords
+	Title string `json:"title"`
+	// The contexts in which the filter should be applied.
+	//	home = home timeline and lists
+	//	notifications = notifications timeline
+	//	public = public timelines
+	//	thread = expanded thread of a detailed status
+	//	account = statuses of an account
+	Context []string `json:"context"`
+	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire.
+	// example: 2022-12-19T09:40:37.000Z
+	ExpiresAt *string `json:"expires_at"`
+	// The action to be taken when a status matches this filter.
+	//	warn = show a warning that identifies the matching filter by title
+	//	hide = do not show this status if it is received
+	// example: warn
+	FilterAction string `json:"filter_action"`
+	// The keywords grouped under this filter.
+	Keywords []FilterKeyword `json:"keywords"`
+	// The statuses grouped under this filter.
+	Statuses []FilterStatus `json:"statuses"`
+}
+
+// FilterKeyword represents a keyword that, if matched, should cause the filter action to be taken.
+//
+// swagger:model filterKeyword
+type FilterKeyword struct {
+	// The ID of the filter keyword in the database.
+	// example: 01GKZA4G3XKQ9Y7HNBYM0F0H5S
+	ID string `json:"id"`
+	// The phrase to be matched against, case-insensitively.
+	// example: fnord
+	Keyword string `json:"keyword"`
+	// Should the filter consider word boundaries?
+	WholeWord bool `json:"whole_word"`
+}
+
+// FilterStatus represents a status that, if matched, should cause the filter action to be taken.
+//
+// swagger:model filterStatus
+type FilterStatus struct {
+	// The ID of the filter status in the database.
+	// example: 01GKZA8WVFJ2HZ7Q2V1A1C60RA
+	ID string `json:"id"`
+	// The ID of the filtered status.
+	// example: 01F8MH75CBF9JFX4ZAD54N0W0R
+	StatusID string `json:"status_id"`
+}
+
+// FilterResult represents a filter whose keywords or statuses matched a given status.
+//
+// swagger:model filterResult
+type FilterResult struct {
+	// The filter that was matched.
+	Filter FilterV2 `json:"filter"`
+	// The keywords within the filter that were matched.
+	KeywordMatches []string `json:"keyword_matches"`
+	// The IDs of statuses within the filter that were matched.
+	StatusMatches []string `json:"status_matches"`
+}
+
+// FilterCreateUpdateRequestV2 models a request to create or update a filter.
+// Fields which aren't given in an update are left unchanged.
+//
+// swagger:ignore
+type FilterCreateUpdateRequestV2 struct {
+	// The name of the filter.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// Where the filter should be applied: home, notifications, public, thread and/or account.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// The action to be taken when a status matches the filter: warn or hide. Defaults to warn.
+	FilterAction *string `form:"filter_action" json:"filter_action" xml:"filter_action"`
+	// Number of seconds from now that the filter should expire. 0 means the filter never expires.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Keywords to add to, change in or remove from the filter. Only accepted in JSON and XML bodies.
+	KeywordsAttributes []FilterKeywordAttributes `form:"-" json:"keywords_attributes" xml:"keywords_attributes"`
+}
+
+// FilterKeywordAttributes models a keyword in a request to create or update a filter.
+//
+// swagger:ignore
+type FilterKeywordAttributes struct {
+	// ID of an existing keyword of the filter to change or remove. Leave out to add a keyword.
+	ID string `json:"id" xml:"id"`
+	// The keyword to be added or changed.
+	Keyword *string `json:"keyword" xml:"keyword"`
+	// Whether the keyword should consider word boundaries.
+	WholeWord *bool `json:"whole_word" xml:"whole_word"`
+	// Remove the existing keyword with the given ID from the filter.
+	Destroy bool `json:"_destroy" xml:"_destroy"`
+}
+
+// FilterKeywordCreateUpdateRequest models a request to add a keyword to a filter or update one.
+// Fields which aren't given in an update are left unchanged.
+//
+// swagger:ignore
+type FilterKeywordCreateUpdateRequest struct {
+	// The keyword to be matched against.
+	Keyword *string `form:"keyword" json:"keyword" xml:"keyword"`
+	// Whether the keyword should consider word boundaries.
+	WholeWord *bool `form:"whole_word" json:"whole_word" xml:"whole_word"`
+}
+
+// FilterStatusCreateRequest models a request to add a status to a filter.
+//
+// swagger:ignore
+type FilterStatusCreateRequest struct {
+	// The ID of the status to be filtered.
+	StatusID string `form:"status_id" json:"status_id" xml:"status_id"`
+}
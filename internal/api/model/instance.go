@@ -41,12 +41,28 @@ type Instance struct {
 	//
 	// This should be displayed on the 'about' page for an instance.
 	Description string `json:"description"`
+	// Raw markdown source of the instance description.
+	//
+	// Only set for this instance, so that admins can edit it.
+	DescriptionText string `json:"description_text,omitempty"`
 	// A shorter description of the instance.
 	//
 	// Should be HTML formatted, but might be plaintext.
 	//
 	// This should be displayed on the instance splash/landing page.
 	ShortDescription string `json:"short_description"`
+	// Raw markdown source of the instance short description.
+	//
+	// Only set for this instance, so that admins can edit it.
+	ShortDescriptionText string `json:"short_description_text,omitempty"`
+	// Terms and conditions for accounts on this instance.
+	//
+	// Should be HTML formatted.
+	Terms string `json:"terms,omitempty"`
+	// Raw markdown source of the instance terms and conditions.
+	//
+	// Only set for this instance, so that admins can edit it.
+	TermsText string `json:"terms_text,omitempty"`
 	// An email address that may be used for inquiries.
 	// example: admin@example.org
 	Email string `json:"email"`
@@ -85,6 +101,11 @@ type Instance struct {
 	ThumbnailDescription string `json:"thumbnail_description,omitempty"`
 	// Contact account for the instance.
 	ContactAccount *Account `json:"contact_account,omitempty"`
+	// Further contact accounts for the instance, alongside the main contact account.
+	ContactDeputyAccounts []*Account `json:"contact_deputy_accounts,omitempty"`
+	// Further email addresses that may be used for inquiries, alongside the main email address.
+	// example: ["moderator@example.org"]
+	ContactDeputyEmails []string `json:"contact_deputy_emails,omitempty"`
 	// Maximum allowed length of a post on this instance, in characters.
 	//
 	// This is provided for compatibility with Tusky and other apps.
@@ -191,6 +212,28 @@ type InstanceConfigurationAccounts struct {
 	//
 	// example: false
 	AllowCustomCSS bool `json:"allow_custom_css"`
+	// Max allowed characters in the display name of an account.
+	//
+	// example: 100
+	MaxDisplayNameChars int `json:"max_display_name_chars"`
+	// Max allowed characters in the note/bio of an account.
+	//
+	// example: 5000
+	MaxNoteChars int `json:"max_note_chars"`
+	// Max number of profile fields (name/value pairs) an account may set.
+	//
+	// example: 4
+	MaxProfileFields int `json:"max_profile_fields"`
+	// Domains that new account signups must provide a verified rel="me" link to a page on.
+	// Omitted if no link is required.
+	//
+	// example: ["staff.example.org"]
+	SignupLinkDomains []string `json:"signup_link_domains,omitempty"`
+	// Email domains that new account signups must use an address on.
+	// Omitted if any email domain is accepted.
+	//
+	// example: ["example.org"]
+	SignupEmailDomains []string `json:"signup_email_domains,omitempty"`
 }
 
 // InstanceConfigurationEmojis models instance emoji config parameters.
@@ -220,11 +263,15 @@ type InstanceSettingsUpdateRequest struct {
 	ContactUsername *string `form:"contact_username" json:"contact_username" xml:"contact_username"`
 	// Email for reaching the instance administrator(s).
 	ContactEmail *string `form:"contact_email" json:"contact_email" xml:"contact_email"`
-	// Short description of the instance, max 500 chars. HTML formatting accepted.
+	// Usernames of further contact accounts, alongside the main contact account. Each must be the username of an existing admin or moderator.
+	ContactDeputyUsernames *[]string `form:"contact_deputy_usernames[]" json:"contact_deputy_usernames" xml:"contact_deputy_usernames"`
+	// Further emails for reaching the instance administrator(s), alongside the main contact email.
+	ContactDeputyEmails *[]string `form:"contact_deputy_emails[]" json:"contact_deputy_emails" xml:"contact_deputy_emails"`
+	// Short description of the instance, max 500 chars. Markdown or HTML formatting accepted.
 	ShortDescription *string `form:"short_description" json:"short_description" xml:"short_description"`
-	// Longer description of the instance, max 5,000 chars. HTML formatting accepted.
+	// Longer description of the instance, max 5,000 chars. Markdown or HTML formatting accepted.
 	Description *string `form:"description" json:"description" xml:"description"`
-	// Terms and conditions of the instance, max 5,000 chars. HTML formatting accepted.
+	// Terms and conditions of the instance, max 5,000 chars. Markdown or HTML formatting accepted.
 	Terms *string `form:"terms" json:"terms" xml:"terms"`
 	// Image to use as the instance thumbnail.
 	Avatar *multipart.FileHeader `form:"thumbnail" json:"thumbnail" xml:"thumbnail"`
@@ -233,3 +280,89 @@ type InstanceSettingsUpdateRequest struct {
 	// Image to use as the instance header.
 	Header *multipart.FileHeader `form:"header" json:"header" xml:"header"`
 }
+
+// InstanceV2 models information about this instance, as served at /api/v2/instance.
+//
+// swagger:model instanceV2
+type InstanceV2 struct {
+	// The domain of the instance.
+	// example: gts.example.org
+	Domain string `json:"domain"`
+	// The domain of accounts on this instance.
+	// This will not necessarily be the same as
+	// simply the domain of the instance.
+	// example: example.org
+	AccountDomain string `json:"account_domain"`
+	// The title of the instance.
+	// example: GoToSocial Example Instance
+	Title string `json:"title"`
+	// The version of GoToSocial installed on the instance.
+	//
+	// This will contain at least a semantic version number.
+	//
+	// It may also contain, after a space, the short git commit ID of the running software.
+	//
+	// example: 0.1.1 cb85f65
+	Version string `json:"version"`
+	// URL of the source code of the software running on this instance.
+	// example: https://github.com/superseriousbusiness/gotosocial
+	SourceURL string `json:"source_url"`
+	// A short description of the instance.
+	//
+	// Should be HTML formatted, but might be plaintext.
+	Description string `json:"description"`
+	// The instance thumbnail.
+	Thumbnail InstanceV2Thumbnail `json:"thumbnail"`
+	// Primary languages of the instance.
+	// example: ["en"]
+	Languages []string `json:"languages"`
+	// Configuration object containing values about status limits etc.
+	Configuration *InstanceConfiguration `json:"configuration"`
+	// Information about registering for this instance.
+	Registrations InstanceV2Registrations `json:"registrations"`
+	// Ways to contact the admins of this instance.
+	Contact InstanceV2Contact `json:"contact"`
+}
+
+// InstanceV2Thumbnail models the thumbnail of an instance.
+//
+// swagger:model instanceV2Thumbnail
+type InstanceV2Thumbnail struct {
+	// URL of the instance avatar/banner image.
+	// example: https://example.org/files/instance/thumbnail.jpeg
+	URL string `json:"url"`
+	// MIME type of the instance thumbnail.
+	// example: image/png
+	Type string `json:"type,omitempty"`
+	// Description of the instance thumbnail.
+	// example: picture of a cute lil' friendly sloth
+	Description string `json:"description,omitempty"`
+}
+
+// InstanceV2Registrations models information about registering for an instance.
+//
+// swagger:model instanceV2Registrations
+type InstanceV2Registrations struct {
+	// New account registrations are enabled on this instance.
+	Enabled bool `json:"enabled"`
+	// New account registrations require admin approval.
+	ApprovalRequired bool `json:"approval_required"`
+	// A custom message to be shown when registrations are closed. Always null for GoToSocial.
+	Message *string `json:"message"`
+}
+
+// InstanceV2Contact models ways to contact the admins of an instance.
+//
+// swagger:model instanceV2Contact
+type InstanceV2Contact struct {
+	// An email address that may be used for inquiries.
+	// example: admin@example.org
+	Email string `json:"email"`
+	// Main contact account for the instance.
+	Account *Account `json:"account"`
+	// Further email addresses that may be used for inquiries, alongside the main email address.
+	// example: ["moderator@example.org"]
+	DeputyEmails []string `json:"deputy_emails"`
+	// Further contact accounts for the instance, alongside the main contact account.
+	DeputyAccounts []*Account `json:"deputy_accounts"`
+}
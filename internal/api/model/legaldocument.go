@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// LegalDocument represents one version of a document which users of this instance agree to,
+// like its terms of service or privacy policy.
+//
+// swagger:model legalDocument
+type LegalDocument struct {
+	// The id of this version of the document.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	ID string `json:"id"`
+	// Which document this is a version of. One of terms_of_service, privacy_policy.
+	// example: terms_of_service
+	Kind string `json:"kind"`
+	// Version of the document, counting up from 1.
+	// example: 2
+	Version int `json:"version"`
+	// HTML content of the document.
+	// example: <p>Be nice to each other.</p>
+	Content string `json:"content"`
+	// Raw markdown source of the document.
+	// example: Be nice to each other.
+	Text string `json:"text"`
+	// Summary of what changed since the previous version.
+	// example: Clarified the rules about bots.
+	Changelog string `json:"changelog"`
+	// Users had to accept this version before they could sign in again.
+	RequireAcceptance bool `json:"require_acceptance"`
+	// Time at which this version was published (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	PublishedAt string `json:"published_at"`
+}
+
+// LegalDocumentCreateRequest is the form submitted as a POST to /api/v1/admin/legal_documents to publish a new version of a document.
+//
+// swagger:ignore
+type LegalDocumentCreateRequest struct {
+	// Which document to publish a new version of.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Markdown text of the new version.
+	Text string `form:"text" json:"text" xml:"text"`
+	// Summary of what changed since the previous version.
+	Changelog string `form:"changelog" json:"changelog" xml:"changelog"`
+	// Users must accept the new version before they can sign in again.
+	RequireAcceptance bool `form:"require_acceptance" json:"require_acceptance" xml:"require_acceptance"`
+}
@@ -19,13 +19,47 @@
 package model
 
 // List represents a list of some users that the authenticated user follows.
+//
+// swagger:model list
 type List struct {
 	// The internal database ID of the list.
+	// example: 01GKYX0D1J0QPJFDN2T8YXMH1F
 	ID string `json:"id"`
 	// The user-defined title of the list.
+	// example: friends
 	Title string `json:"title"`
-	// followed = Show replies to any followed user
+	// Which replies should be shown in the list timeline.
+	//	followed = Show replies to any followed user
 	//	list = Show replies to members of the list
 	//	none = Show replies to no one
+	// example: list
 	RepliesPolicy string `json:"replies_policy"`
 }
+
+// ListCreateRequest models a request to create a list.
+//
+// swagger:ignore
+type ListCreateRequest struct {
+	// Title of the list.
+	Title string `form:"title" json:"title" xml:"title"`
+	// Which replies should be shown in the list timeline: followed, list or none. Defaults to list.
+	RepliesPolicy string `form:"replies_policy" json:"replies_policy" xml:"replies_policy"`
+}
+
+// ListUpdateRequest models a request to update a list. Fields which aren't given are left unchanged.
+//
+// swagger:ignore
+type ListUpdateRequest struct {
+	// Title of the list.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// Which replies should be shown in the list timeline: followed, list or none.
+	RepliesPolicy *string `form:"replies_policy" json:"replies_policy" xml:"replies_policy"`
+}
+
+// ListAccountsChangeRequest models a request to add accounts to or remove accounts from a list.
+//
+// swagger:ignore
+type ListAccountsChangeRequest struct {
+	// IDs of the accounts to add or remove. Accounts can only be added if they're followed.
+	AccountIDs []string `form:"account_ids[]" json:"account_ids" xml:"account_ids"`
+}
@@ -33,8 +33,8 @@ type Poll struct {
 	Multiple bool `json:"multiple"`
 	// How many votes have been received.
 	VotesCount int `json:"votes_count"`
-	// How many unique accounts have voted on a multiple-choice poll. Null if multiple is false.
-	VotersCount int `json:"voters_count,omitempty"`
+	// How many unique accounts have voted.
+	VotersCount *int `json:"voters_count"`
 	// When called with a user token, has the authorized user voted?
 	Voted bool `json:"voted,omitempty"`
 	// When called with a user token, which options has the authorized user chosen? Contains an array of index values for options.
@@ -53,7 +53,7 @@ type PollOptions struct {
 	Title string `json:"title"`
 	// The number of received votes for this option.
 	// Number, or null if results are not published yet.
-	VotesCount int `json:"votes_count,omitempty"`
+	VotesCount *int `json:"votes_count"`
 }
 
 // PollRequest models a request to create a poll.
@@ -72,3 +72,11 @@ type PollRequest struct {
 	// Hide vote counts until the poll ends.
 	HideTotals bool `form:"hide_totals" json:"hide_totals" xml:"hide_totals"`
 }
+
+// PollVoteRequest models a request to vote in a poll.
+//
+// swagger:ignore
+type PollVoteRequest struct {
+	// Indexes of the chosen options.
+	Choices []int `form:"choices[]" json:"choices" xml:"choices"`
+}
@@ -29,6 +29,8 @@ type Relationship struct {
 	Following bool `json:"following"`
 	// You are seeing reblogs/boosts from this account in your home timeline.
 	ShowingReblogs bool `json:"showing_reblogs"`
+	// You are seeing replies from this account to other accounts in your home timeline.
+	ShowingReplies bool `json:"showing_replies"`
 	// You are seeing notifications when this account posts.
 	Notifying bool `json:"notifying"`
 	// This account follows you.
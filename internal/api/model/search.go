@@ -58,6 +58,9 @@ type SearchQuery struct {
 	//
 	// For a status, this can be in the format: `https://some.instance.com/@someaccount/SOME_ID_OF_A_STATUS`
 	//
+	// If nothing is found by looking the query up as an account or status, and type is `statuses` or not set, the
+	// statuses the searching account has posted or been mentioned in are searched for ones containing all the words in the query.
+	//
 	// required: true
 	// in: query
 	Query string `json:"q"`
@@ -31,8 +31,28 @@ type Source struct {
 	Sensitive bool `json:"sensitive,omitempty"`
 	// The default posting language for new statuses.
 	Language string `json:"language,omitempty"`
+	// The language to show web pages and error messages in. If empty, the
+	// language asked for by the browser, or the instance language, is used.
+	Locale string `json:"locale"`
 	// The default posting format for new statuses.
 	StatusFormat string `json:"status_format"`
+	// Whether followers may boost new followers-only statuses to their own followers.
+	FollowersOnlyBoostable bool `json:"followers_only_boostable,omitempty"`
+	// Which accounts may mention this account: everyone, following (only accounts this account follows), or nobody.
+	MentionPolicy string `json:"mention_policy"`
+	// Hide boosts on this account's public web profile.
+	WebHideBoosts bool `json:"web_hide_boosts"`
+	// Hide replies on this account's public web profile.
+	WebHideReplies bool `json:"web_hide_replies"`
+	// Show pinned statuses above other statuses on this account's public web profile.
+	WebPinnedFirst bool `json:"web_pinned_first"`
+	// Show a media-only gallery tab on this account's public web profile.
+	WebMediaTab bool `json:"web_media_tab"`
+	// Hide statuses from bot and mirror accounts in the public timelines.
+	HideMirrors bool `json:"hide_mirrors"`
+	// Read notifications are deleted after this many days; 0 keeps them forever.
+	// If null, the instance default is used.
+	NotificationsRetentionDays *int `json:"notifications_retention_days"`
 	// Profile bio.
 	Note string `json:"note"`
 	// Metadata about the account.
@@ -98,6 +98,9 @@ type Status struct {
 	// so the user may redraft from the source text without the client having to reverse-engineer
 	// the original text from the HTML content.
 	Text string `json:"text,omitempty"`
+	// Filters of the requesting account that the status matched, if any.
+	// Clients should show the status behind a warning naming these filters.
+	Filtered []FilterResult `json:"filtered,omitempty"`
 }
 
 /*
@@ -137,6 +140,59 @@ type StatusReblogged struct {
 	*Status
 }
 
+// StatusViewCount represents how many times a status has been viewed.
+// Only the author of a status can see its view counts.
+//
+// swagger:model statusViewCount
+type StatusViewCount struct {
+	// Number of times the web page of the status has been viewed.
+	// example: 42
+	WebViews int `json:"web_views"`
+	// Number of times the status has been fetched over ActivityPub by other servers.
+	// example: 7
+	Fetches int `json:"fetches"`
+}
+
+// StatusDeliveries summarizes how a status was delivered to the inboxes of other servers,
+// to help its author work out why someone elsewhere didn't see it. Only the author of a
+// status can see its deliveries.
+//
+// swagger:model statusDeliveries
+type StatusDeliveries struct {
+	// Total number of inboxes on other servers which accepted the status.
+	// example: 120
+	Delivered int `json:"delivered"`
+	// Total number of inboxes on other servers which the status couldn't be delivered to.
+	// example: 3
+	Failed int `json:"failed"`
+	// Domains of the servers which the status couldn't be delivered to, in alphabetical order.
+	// example: ["example.org"]
+	FailedDomains []string `json:"failed_domains"`
+	// Outcome of delivering the status to each server, in alphabetical order of domain.
+	Domains []StatusDeliveryDomain `json:"domains"`
+}
+
+// StatusDeliveryDomain is the outcome of delivering a status to the inboxes on one server.
+//
+// swagger:model statusDeliveryDomain
+type StatusDeliveryDomain struct {
+	// Domain of the server.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of inboxes on the server which accepted the status.
+	// example: 2
+	Delivered int `json:"delivered"`
+	// Number of inboxes on the server which the status couldn't be delivered to.
+	// example: 1
+	Failed int `json:"failed"`
+	// Error from the last failed delivery to the server, if any.
+	// example: POST request to https://example.org/inbox failed (503): 503 Service Unavailable
+	Error string `json:"error,omitempty"`
+	// When the status was delivered to the server (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	DeliveredAt string `json:"delivered_at"`
+}
+
 // StatusCreateRequest models status creation parameters.
 //
 // swagger:model statusCreateRequest
@@ -211,6 +267,15 @@ type AdvancedStatusCreateForm struct {
 	AdvancedVisibilityFlagsForm
 }
 
+// StatusThreadCreateForm models a request to post multiple statuses as one thread,
+// where each status is a reply to the one before it.
+//
+// swagger:model statusThreadCreateForm
+type StatusThreadCreateForm struct {
+	// Statuses to post, in thread order. Only the first status may have in_reply_to_id set.
+	Statuses []*AdvancedStatusCreateForm `form:"statuses" json:"statuses" xml:"statuses"`
+}
+
 // AdvancedVisibilityFlagsForm allows a few more advanced flags to be set on new statuses, in addition
 // to the standard mastodon-compatible ones.
 //
@@ -35,3 +35,32 @@ type PasswordChangeRequest struct {
 	// required: true
 	NewPassword string `form:"new_password" json:"new_password" xml:"new_password" validation:"required"`
 }
+
+// PostByMail models the settings for posting statuses by email, for the authorized user.
+//
+// swagger:model postByMail
+type PostByMail struct {
+	// Posting statuses by email is enabled for this user.
+	Enabled bool `json:"enabled"`
+	// Secret address that emails can be sent to, from the user's confirmed email address, to post them as statuses.
+	// Only set if posting by email is enabled.
+	// example: 5f1e0d3c2b4a69788796a5b4c3d2e1f0@post.example.org
+	Address string `json:"address,omitempty"`
+}
+
+// UsernameChangeRequest models user username change parameters.
+//
+// swagger:parameters userUsernameChange
+type UsernameChangeRequest struct {
+	// User's current password.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+	// Desired new username.
+	// The old username stays reserved for the account, and it can still be found by it.
+	//
+	// in: formData
+	// required: true
+	NewUsername string `form:"new_username" json:"new_username" xml:"new_username" validation:"required"`
+}
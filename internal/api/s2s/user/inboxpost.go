@@ -19,14 +19,24 @@
 package user
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror" //nolint:typecheck
 )
 
+// inboxRetryAfter is how long, in seconds, we ask remote
+// servers to wait when the inbox queue is full.
+const inboxRetryAfter = "60"
+
 // InboxPOSTHandler deals with incoming POST requests to an actor's inbox.
 // Eg., POST to https://example.org/users/whatever/inbox.
 func (m *Module) InboxPOSTHandler(c *gin.Context) {
@@ -38,8 +48,16 @@ func (m *Module) InboxPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if errWithCode := checkInboxBody(c.Request); errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
 	if posted, err := m.processor.InboxPost(transferContext(c), c.Writer, c.Request); err != nil {
 		if withCode, ok := err.(gtserror.WithCode); ok {
+			if withCode.Code() == http.StatusTooManyRequests {
+				c.Header("Retry-After", inboxRetryAfter)
+			}
 			api.ErrorHandler(c, withCode, m.processor.InstanceGet)
 		} else {
 			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
@@ -49,3 +67,41 @@ func (m *Module) InboxPOSTHandler(c *gin.Context) {
 		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
 	}
 }
+
+// checkInboxBody reads the body of the given inbox request, and checks it against
+// the configured size and json limits before anything else is done with it, so that
+// payloads crafted to use up memory or cpu are rejected as cheaply as possible.
+//
+// If the body is fine, it's put back on the request to be read again.
+func checkInboxBody(r *http.Request) gtserror.WithCode {
+	maxBodySize := int64(config.GetAdvancedInboxMaxBodySize())
+
+	if maxBodySize > 0 && r.ContentLength > maxBodySize {
+		err := fmt.Errorf("request body of %d bytes was larger than %d bytes", r.ContentLength, maxBodySize)
+		return gtserror.NewErrorRequestEntityTooLarge(err, err.Error())
+	}
+
+	reader := io.Reader(r.Body)
+	if maxBodySize > 0 {
+		// read one byte past the limit so we can tell if it was exceeded
+		reader = io.LimitReader(r.Body, maxBodySize+1)
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		err := fmt.Errorf("error reading request body: %w", err)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if maxBodySize > 0 && int64(len(body)) > maxBodySize {
+		err := fmt.Errorf("request body was larger than %d bytes", maxBodySize)
+		return gtserror.NewErrorRequestEntityTooLarge(err, err.Error())
+	}
+
+	if err := ap.CheckJSONLimits(body, config.GetAdvancedInboxMaxJSONDepth(), config.GetAdvancedInboxMaxJSONArrayLength()); err != nil {
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/api/s2s/user"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -495,6 +497,66 @@ func (suite *InboxPostTestSuite) TestPostDelete() {
 	suite.Equal(dbAccount.ID, dbAccount.SuspensionOrigin)
 }
 
+func (suite *InboxPostTestSuite) postRaw(body string, chunked bool) (int, string) {
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodPost, receivingAccount.InboxURI, strings.NewReader(body))
+	if chunked {
+		ctx.Request.ContentLength = -1
+	}
+	ctx.Request.Header.Set("Content-Type", "application/activity+json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   user.UsernameKey,
+			Value: receivingAccount.Username,
+		},
+	}
+
+	suite.userModule.InboxPOSTHandler(ctx)
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	return result.StatusCode, string(b)
+}
+
+func (suite *InboxPostTestSuite) TestPostTooLarge() {
+	config.SetAdvancedInboxMaxBodySize(64)
+	defer config.SetAdvancedInboxMaxBodySize(1048576)
+
+	code, body := suite.postRaw(`{"type":"Create","object":"`+strings.Repeat("a", 64)+`"}`, false)
+	suite.Equal(http.StatusRequestEntityTooLarge, code)
+	suite.Equal(`{"error":"Request Entity Too Large: request body of 93 bytes was larger than 64 bytes","code":413}`, body)
+}
+
+func (suite *InboxPostTestSuite) TestPostTooLargeChunked() {
+	config.SetAdvancedInboxMaxBodySize(64)
+	defer config.SetAdvancedInboxMaxBodySize(1048576)
+
+	// no content length, so the body has to be read to find out it's too big
+	code, body := suite.postRaw(`{"type":"Create","object":"`+strings.Repeat("a", 64)+`"}`, true)
+	suite.Equal(http.StatusRequestEntityTooLarge, code)
+	suite.Equal(`{"error":"Request Entity Too Large: request body was larger than 64 bytes","code":413}`, body)
+}
+
+func (suite *InboxPostTestSuite) TestPostTooDeep() {
+	code, body := suite.postRaw(`{"type":"Create","object":`+strings.Repeat("[", 100)+strings.Repeat("]", 100)+`}`, false)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: CheckJSONLimits: objects and arrays are nested more than 32 deep","code":400}`, body)
+}
+
+func (suite *InboxPostTestSuite) TestPostArrayTooLong() {
+	config.SetAdvancedInboxMaxJSONArrayLength(3)
+	defer config.SetAdvancedInboxMaxJSONArrayLength(1000)
+
+	code, body := suite.postRaw(`{"type":"Create","to":["a","b","c","d"]}`, false)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: CheckJSONLimits: array has more than 3 entries","code":400}`, body)
+}
+
 func TestInboxPostTestSuite(t *testing.T) {
 	suite.Run(t, &InboxPostTestSuite{})
 }
@@ -0,0 +1,255 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/api/s2s/user"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type InboxQueueTestSuite struct {
+	UserStandardTestSuite
+}
+
+// newQueueingProcessor returns a new processor with the inbox queue turned on.
+// It isn't started, so anything queued stays queued until Start is called.
+func (suite *InboxQueueTestSuite) newQueueingProcessor(size int, shedSize int) processing.Processor {
+	config.SetAdvancedInboxQueueSize(size)
+	config.SetAdvancedInboxQueueShedSize(shedSize)
+
+	clientWorker := concurrency.NewWorkerPool[messages.FromClientAPI](-1, -1)
+	fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+
+	tc := testrig.NewTestTransportController(testrig.NewMockHTTPClient(nil, "../../../../testrig/media"), suite.db, fedWorker)
+	federator := testrig.NewTestFederator(suite.db, tc, suite.storage, suite.mediaManager, fedWorker)
+	return testrig.NewTestProcessor(suite.db, suite.storage, federator, suite.emailSender, suite.mediaManager, clientWorker, fedWorker)
+}
+
+// post signs the given activity with privateKey under the sender's key id,
+// and posts it to the receiver's inbox using the given processor.
+func (suite *InboxQueueTestSuite) post(processor processing.Processor, activity pub.Activity, sender *gtsmodel.Account, privateKey *rsa.PrivateKey, receiver *gtsmodel.Account) *http.Response {
+	targetURI := testrig.URLMustParse(receiver.InboxURI)
+	signature, digestHeader, dateHeader := testrig.GetSignatureForActivity(activity, sender.PublicKeyURI, privateKey, targetURI)
+
+	bodyI, err := streams.Serialize(activity)
+	suite.NoError(err)
+
+	bodyJson, err := json.Marshal(bodyI)
+	suite.NoError(err)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodPost, targetURI.String(), bytes.NewReader(bodyJson))
+	ctx.Request.Header.Set("Signature", signature)
+	ctx.Request.Header.Set("Date", dateHeader)
+	ctx.Request.Header.Set("Digest", digestHeader)
+	ctx.Request.Header.Set("Content-Type", "application/activity+json")
+
+	suite.securityModule.SignatureCheck(ctx)
+
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   user.UsernameKey,
+			Value: receiver.Username,
+		},
+	}
+
+	user.New(processor).(*user.Module).InboxPOSTHandler(ctx)
+
+	return recorder.Result()
+}
+
+func (suite *InboxQueueTestSuite) newBlock(blockingAccount *gtsmodel.Account, blockedAccount *gtsmodel.Account, blockURI string) pub.Activity {
+	block := streams.NewActivityStreamsBlock()
+
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(testrig.URLMustParse(blockingAccount.URI))
+	block.SetActivityStreamsActor(actorProp)
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(testrig.URLMustParse(blockURI))
+	block.SetJSONLDId(idProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(testrig.URLMustParse(blockedAccount.URI))
+	block.SetActivityStreamsObject(objectProp)
+
+	toProp := streams.NewActivityStreamsToProperty()
+	toProp.AppendIRI(testrig.URLMustParse(blockedAccount.URI))
+	block.SetActivityStreamsTo(toProp)
+
+	return block
+}
+
+func (suite *InboxQueueTestSuite) newLike(likingAccount *gtsmodel.Account, likedStatus *gtsmodel.Status, likeURI string) pub.Activity {
+	like := streams.NewActivityStreamsLike()
+
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(testrig.URLMustParse(likingAccount.URI))
+	like.SetActivityStreamsActor(actorProp)
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(testrig.URLMustParse(likeURI))
+	like.SetJSONLDId(idProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(testrig.URLMustParse(likedStatus.URI))
+	like.SetActivityStreamsObject(objectProp)
+
+	return like
+}
+
+func (suite *InboxQueueTestSuite) queuedIDs() []string {
+	ids, err := suite.db.GetInboxItemIDs(context.Background())
+	suite.NoError(err)
+	return ids
+}
+
+func (suite *InboxQueueTestSuite) TestEnqueueAndProcess() {
+	sender := suite.testAccounts["remote_account_1"]
+	receiver := suite.testAccounts["local_account_1"]
+	processor := suite.newQueueingProcessor(10, 0)
+
+	block := suite.newBlock(sender, receiver, "http://fossbros-anonymous.io/users/foss_satan/blocks/01GKZ5CDQ4W4ZKQ3BR4BRF5BZ7")
+	result := suite.post(processor, block, sender, sender.PrivateKey, receiver)
+	defer result.Body.Close()
+	suite.Equal(http.StatusAccepted, result.StatusCode)
+	suite.Len(suite.queuedIDs(), 1)
+
+	// once started, the queued block should be processed and removed from the queue
+	suite.NoError(processor.Start())
+	defer func() {
+		suite.NoError(processor.Stop())
+	}()
+
+	suite.Eventually(func() bool {
+		dbBlock, err := suite.db.GetBlock(context.Background(), sender.ID, receiver.ID)
+		return err == nil && dbBlock != nil && len(suite.queuedIDs()) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func (suite *InboxQueueTestSuite) TestEnqueueBadSignature() {
+	sender := suite.testAccounts["remote_account_1"]
+	receiver := suite.testAccounts["local_account_1"]
+	processor := suite.newQueueingProcessor(10, 0)
+
+	// sign with a key that doesn't belong to the sender
+	wrongKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+
+	block := suite.newBlock(sender, receiver, "http://fossbros-anonymous.io/users/foss_satan/blocks/01GKZ5DAV6T3GQ1R2KMTB2C7QF")
+	result := suite.post(processor, block, sender, wrongKey, receiver)
+	defer result.Body.Close()
+
+	// the sender should be told, and nothing should be queued
+	suite.Equal(http.StatusUnauthorized, result.StatusCode)
+	suite.Empty(suite.queuedIDs())
+}
+
+func (suite *InboxQueueTestSuite) TestEnqueueFull() {
+	sender := suite.testAccounts["remote_account_1"]
+	receiver := suite.testAccounts["local_account_1"]
+	processor := suite.newQueueingProcessor(1, 0)
+
+	block := suite.newBlock(sender, receiver, "http://fossbros-anonymous.io/users/foss_satan/blocks/01GKZ5E3J6J1QZ6V1SZ2DN4X9K")
+	result := suite.post(processor, block, sender, sender.PrivateKey, receiver)
+	defer result.Body.Close()
+	suite.Equal(http.StatusAccepted, result.StatusCode)
+
+	// the queue is full now, so the next activity should be refused
+	like := suite.newLike(sender, suite.testStatuses["local_account_1_status_1"], "http://fossbros-anonymous.io/users/foss_satan/likes/01GKZ5EQ8YF6W7J6FJ0T1T1WQ4")
+	result = suite.post(processor, like, sender, sender.PrivateKey, receiver)
+	defer result.Body.Close()
+	suite.Equal(http.StatusTooManyRequests, result.StatusCode)
+	suite.NotEmpty(result.Header.Get("Retry-After"))
+	suite.Len(suite.queuedIDs(), 1)
+}
+
+func (suite *InboxQueueTestSuite) TestEnqueueShed() {
+	sender := suite.testAccounts["remote_account_1"]
+	receiver := suite.testAccounts["local_account_1"]
+	processor := suite.newQueueingProcessor(10, 1)
+
+	block := suite.newBlock(sender, receiver, "http://fossbros-anonymous.io/users/foss_satan/blocks/01GKZ5FBNR1K5H0C0NQ7B6JMMZ")
+	result := suite.post(processor, block, sender, sender.PrivateKey, receiver)
+	defer result.Body.Close()
+	suite.Equal(http.StatusAccepted, result.StatusCode)
+
+	// past the shed size, a like should be accepted but dropped
+	like := suite.newLike(sender, suite.testStatuses["local_account_1_status_1"], "http://fossbros-anonymous.io/users/foss_satan/likes/01GKZ5FTGXRVQ0AB9M6DZ1A0N8")
+	result = suite.post(processor, like, sender, sender.PrivateKey, receiver)
+	defer result.Body.Close()
+	suite.Equal(http.StatusAccepted, result.StatusCode)
+	suite.Len(suite.queuedIDs(), 1)
+
+	// but a block should still be queued
+	block = suite.newBlock(sender, suite.testAccounts["local_account_2"], "http://fossbros-anonymous.io/users/foss_satan/blocks/01GKZ5G9S2X1CY1VQ4K5FWW4YE")
+	result = suite.post(processor, block, sender, sender.PrivateKey, suite.testAccounts["local_account_2"])
+	defer result.Body.Close()
+	suite.Equal(http.StatusAccepted, result.StatusCode)
+	suite.Len(suite.queuedIDs(), 2)
+}
+
+func (suite *InboxQueueTestSuite) TestRequeueOnRestart() {
+	sender := suite.testAccounts["remote_account_1"]
+	receiver := suite.testAccounts["local_account_1"]
+
+	// queue an activity, then stop without ever processing it
+	processor := suite.newQueueingProcessor(10, 0)
+	block := suite.newBlock(sender, receiver, "http://fossbros-anonymous.io/users/foss_satan/blocks/01GKZ5GTQ1J6Y4P0YBWQ8F3DCY")
+	result := suite.post(processor, block, sender, sender.PrivateKey, receiver)
+	defer result.Body.Close()
+	suite.Equal(http.StatusAccepted, result.StatusCode)
+	suite.Len(suite.queuedIDs(), 1)
+
+	// a new processor should pick up the leftover activity when it starts
+	restarted := suite.newQueueingProcessor(10, 0)
+	suite.NoError(restarted.Start())
+	defer func() {
+		suite.NoError(restarted.Stop())
+	}()
+
+	suite.Eventually(func() bool {
+		dbBlock, err := suite.db.GetBlock(context.Background(), sender.ID, receiver.ID)
+		return err == nil && dbBlock != nil && len(suite.queuedIDs()) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestInboxQueueTestSuite(t *testing.T) {
+	suite.Run(t, &InboxQueueTestSuite{})
+}
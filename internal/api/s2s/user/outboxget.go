@@ -35,6 +35,11 @@ import (
 //
 // Get the public outbox collection for an actor.
 //
+// Only public statuses which are federated, and which are visible to the requester, will be included.
+// Replies and boosts are not included.
+//
+// If the instance has been configured not to expose outboxes, then 404 will always be returned.
+//
 // Note that the response will be a Collection with a page as `first`, as shown below, if `page` is `false`.
 //
 // If `page` is `true`, then the response will be a single `CollectionPage` without the wrapping `Collection`.
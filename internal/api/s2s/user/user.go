@@ -57,6 +57,8 @@ const (
 	UsersFollowersPath = UsersBasePathWithUsername + "/" + uris.FollowersPath
 	// UsersFollowingPath is for serving GET request's to a user's following list, with the given username key.
 	UsersFollowingPath = UsersBasePathWithUsername + "/" + uris.FollowingPath
+	// UsersFeaturedTagsPath is for serving GET requests to a user's featured hashtags, with the given username key.
+	UsersFeaturedTagsPath = UsersBasePathWithUsername + "/" + uris.CollectionsPath + "/" + uris.FeaturedTagsPath
 	// UsersStatusPath is for serving GET requests to a particular status by a user, with the given username key and status ID
 	UsersStatusPath = UsersBasePathWithUsername + "/" + uris.StatusesPath + "/:" + StatusIDKey
 	// UsersStatusRepliesPath is for serving the replies collection of a status.
@@ -81,6 +83,7 @@ func (m *Module) Route(s router.Router) error {
 	s.AttachHandler(http.MethodPost, UsersInboxPath, m.InboxPOSTHandler)
 	s.AttachHandler(http.MethodGet, UsersFollowersPath, m.FollowersGETHandler)
 	s.AttachHandler(http.MethodGet, UsersFollowingPath, m.FollowingGETHandler)
+	s.AttachHandler(http.MethodGet, UsersFeaturedTagsPath, m.FeaturedTagsGETHandler)
 	s.AttachHandler(http.MethodGet, UsersStatusPath, m.StatusGETHandler)
 	s.AttachHandler(http.MethodGet, UsersPublicKeyPath, m.PublicKeyGETHandler)
 	s.AttachHandler(http.MethodGet, UsersStatusRepliesPath, m.StatusRepliesGETHandler)
@@ -20,6 +20,7 @@ package webfinger
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -65,14 +67,16 @@ func (m *Module) WebfingerGETRequest(c *gin.Context) {
 	resourceQuery, set := c.GetQuery("resource")
 	if !set || resourceQuery == "" {
 		l.Debug("aborting request because no resource was set in query")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "no 'resource' in request query"})
+		err := errors.New("no 'resource' in request query")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
 		return
 	}
 
 	requestedUsername, requestedHost, err := util.ExtractWebfingerParts(resourceQuery)
 	if err != nil {
 		l.Debugf("bad webfinger request with resource query %s: %s", resourceQuery, err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("bad webfinger request with resource query %s", resourceQuery)})
+		err := fmt.Errorf("bad webfinger request with resource query %s", resourceQuery)
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
 		return
 	}
 
@@ -81,7 +85,8 @@ func (m *Module) WebfingerGETRequest(c *gin.Context) {
 
 	if requestedHost != host && requestedHost != accountDomain {
 		l.Debugf("aborting request because requestedHost %s does not belong to this instance", requestedHost)
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("requested host %s does not belong to this instance", requestedHost)})
+		err := fmt.Errorf("requested host %s does not belong to this instance", requestedHost)
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
 		return
 	}
 
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	limiter "github.com/ulule/limiter/v3"
 	mgin "github.com/ulule/limiter/v3/drivers/middleware/gin"
 	memory "github.com/ulule/limiter/v3/drivers/store/memory"
@@ -36,10 +37,39 @@ type RateLimitOptions struct {
 
 func (m *Module) LimitReachedHandler(c *gin.Context) {
 	code := http.StatusTooManyRequests
-	c.AbortWithStatusJSON(code, gin.H{"error": "rate limit reached"})
+	c.AbortWithStatusJSON(code, apimodel.Error{Error: "rate limit reached", Code: code})
 }
 
-// returns a gin middleware that will automatically rate limit caller (by IP address)
+// ipv6Mask is the prefix length used to bucket IPv6 clients.
+// A single client is typically assigned a whole /64, so limiting
+// by individual address would let them trivially dodge the limit.
+var ipv6Mask = net.CIDRMask(64, 128)
+
+// RateLimitKey returns the key used to rate limit the caller of c.
+//
+// IPv4 addresses are used as-is, while IPv6 addresses are masked to
+// their /64 prefix. The limiter's own WithIPv6Mask option is not
+// used here, since it only applies to keys it derives itself and
+// not to the key handed to it by the gin middleware.
+func RateLimitKey(c *gin.Context) string {
+	clientIP := c.ClientIP()
+
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		// can't parse, just
+		// use the raw value
+		return clientIP
+	}
+
+	if ip.To4() == nil {
+		ip = ip.Mask(ipv6Mask)
+	}
+
+	return ip.String()
+}
+
+// returns a gin middleware that will automatically rate limit caller (by IP address,
+// or by /64 prefix for IPv6 addresses)
 // and enrich the response header with the following headers:
 // - `x-ratelimit-limit` maximum number of requests allowed per time period (fixed)
 // - `x-ratelimit-remaining` number of remaining requests that can still be performed
@@ -53,17 +83,14 @@ func (m *Module) RateLimit(rateOptions RateLimitOptions) func(c *gin.Context) {
 
 	store := memory.NewStore()
 
-	limiterInstance := limiter.New(
-		store,
-		rate,
-		// apply /64 mask to IPv6 addresses
-		limiter.WithIPv6Mask(net.CIDRMask(64, 128)),
-	)
+	limiterInstance := limiter.New(store, rate)
 
 	middleware := mgin.NewMiddleware(
 		limiterInstance,
 		// use custom rate limit reached error
 		mgin.WithLimitReachedHandler(m.LimitReachedHandler),
+		// bucket IPv6 clients by /64 prefix
+		mgin.WithKeyGetter(RateLimitKey),
 	)
 
 	return middleware
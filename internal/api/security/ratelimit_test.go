@@ -0,0 +1,84 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package security_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/security"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RateLimitTestSuite) newEngine() *gin.Engine {
+	module := &security.Module{}
+
+	engine := gin.New()
+	engine.Use(module.RateLimit(security.RateLimitOptions{
+		Period: time.Minute,
+		Limit:  1,
+	}))
+	engine.GET("/", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return engine
+}
+
+func (suite *RateLimitTestSuite) request(engine *gin.Engine, remoteAddr string) int {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, req)
+
+	return recorder.Code
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitIPv6SamePrefix() {
+	engine := suite.newEngine()
+
+	// first request uses up the limit for the whole /64
+	suite.Equal(http.StatusOK, suite.request(engine, "[2001:db8:1:2::1]:1234"))
+	suite.Equal(http.StatusTooManyRequests, suite.request(engine, "[2001:db8:1:2:ffff::99]:1234"))
+
+	// a different /64 has its own bucket
+	suite.Equal(http.StatusOK, suite.request(engine, "[2001:db8:1:3::1]:1234"))
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitIPv4() {
+	engine := suite.newEngine()
+
+	suite.Equal(http.StatusOK, suite.request(engine, "192.0.2.1:1234"))
+	suite.Equal(http.StatusTooManyRequests, suite.request(engine, "192.0.2.1:1234"))
+
+	// neighbouring IPv4 addresses are not bucketed together
+	suite.Equal(http.StatusOK, suite.request(engine, "192.0.2.2:1234"))
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
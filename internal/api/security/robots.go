@@ -20,8 +20,10 @@ package security
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/useragent"
 )
 
 const robotsString = `User-agent: *
@@ -53,5 +55,22 @@ Disallow: /settings/
 // More granular robots meta tags are then applied for web pages
 // depending on user preferences (see internal/web).
 func (m *Module) RobotsGETHandler(c *gin.Context) {
-	c.String(http.StatusOK, robotsString)
+	c.String(http.StatusOK, m.robots)
+}
+
+// robots returns the robots.txt to serve. If blockAIScrapers
+// is true, known AI scrapers are asked not to crawl anything.
+func robots(blockAIScrapers bool) string {
+	if !blockAIScrapers {
+		return robotsString
+	}
+
+	var b strings.Builder
+	b.WriteString("# AI scrapers\n")
+	for _, token := range useragent.AIScrapers {
+		b.WriteString("User-agent: " + token + "\n")
+	}
+	b.WriteString("Disallow: /\n\n")
+	b.WriteString(robotsString)
+	return b.String()
 }
@@ -19,6 +19,7 @@
 package security
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -27,14 +28,17 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/router"
+	"github.com/superseriousbusiness/gotosocial/internal/useragent"
 )
 
 const robotsPath = "/robots.txt"
 
 // Module implements the ClientAPIModule interface for security middleware
 type Module struct {
-	db     db.DB
-	server oauth.Server
+	db        db.DB
+	server    oauth.Server
+	blocklist *useragent.Blocklist
+	robots    string
 }
 
 // New returns a new security module
@@ -47,6 +51,13 @@ func New(db db.DB, server oauth.Server) api.ClientModule {
 
 // Route attaches security middleware to the given router
 func (m *Module) Route(s router.Router) error {
+	blocklist, err := useragent.NewBlocklist(config.GetAdvancedBlockedUserAgents(), config.GetAdvancedBlockAIScrapers())
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %s", config.AdvancedBlockedUserAgentsFlag(), err)
+	}
+	m.blocklist = blocklist
+	m.robots = robots(config.GetAdvancedBlockAIScrapers())
+
 	// only enable rate limit middleware if configured
 	// advanced-rate-limit-requests is greater than 0
 	if rateLimitRequests := config.GetAdvancedRateLimitRequests(); rateLimitRequests > 0 {
@@ -22,14 +22,39 @@ import (
 	"errors"
 	"net/http"
 
+	"codeberg.org/gruf/go-kv"
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/useragent"
 )
 
-// UserAgentBlock aborts requests with empty user agent strings.
+// UserAgentBlock aborts requests with empty user agent strings,
+// and requests with user agents that match the configured blocklist.
 func (m *Module) UserAgentBlock(c *gin.Context) {
-	if ua := c.Request.UserAgent(); ua == "" {
+	ua := c.Request.UserAgent()
+	if ua == "" {
 		code := http.StatusTeapot
 		err := errors.New(http.StatusText(code) + ": no user-agent sent with request")
-		c.AbortWithStatusJSON(code, gin.H{"error": err.Error()})
+		c.AbortWithStatusJSON(code, apimodel.Error{Error: err.Error(), Code: code})
+		return
+	}
+
+	// always let robots.txt through, so that
+	// well-behaved crawlers can see they're not welcome
+	if m.blocklist == nil || m.blocklist.Empty() || c.Request.URL.Path == robotsPath {
+		return
+	}
+
+	if rule, blocked := m.blocklist.Match(ua); blocked {
+		useragent.RecordRejection(rule)
+		log.WithFields(kv.Fields{
+			{"userAgent", ua},
+			{"rule", rule.Pattern},
+		}...).Debug("rejected request from blocked user agent")
+
+		code := http.StatusForbidden
+		err := errors.New(http.StatusText(code) + ": user-agent is blocked")
+		c.AbortWithStatusJSON(code, apimodel.Error{Error: err.Error(), Code: code})
 	}
 }
@@ -22,18 +22,19 @@ import (
 	"time"
 
 	"codeberg.org/gruf/go-cache/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 // AccountCache is a cache wrapper to provide URL and URI lookups for gtsmodel.Account
 type AccountCache struct {
-	cache cache.LookupCache[string, string, *gtsmodel.Account]
+	cache *countedLookup[string, string, *gtsmodel.Account]
 }
 
 // NewAccountCache returns a new instantiated AccountCache object
 func NewAccountCache() *AccountCache {
 	c := &AccountCache{}
-	c.cache = cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.Account]{
+	c.cache = newCountedLookup(cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.Account]{
 		RegisterLookups: func(lm *cache.LookupMap[string, string]) {
 			lm.RegisterLookup("uri")
 			lm.RegisterLookup("url")
@@ -62,12 +63,18 @@ func NewAccountCache() *AccountCache {
 			lm.Delete("pubkeyid", acc.PublicKeyURI)
 			lm.Delete("usernamedomain", usernameDomainKey(acc.Username, acc.Domain))
 		},
-	})
-	c.cache.SetTTL(time.Minute*5, false)
+	}))
+	c.cache.SetTTL(config.GetCacheAccountTTL(), false)
+	c.cache.SetMaxSize(config.GetCacheAccountMaxSize())
 	c.cache.Start(time.Second * 10)
 	return c
 }
 
+// Stats returns the current hit, miss and eviction counts and size of the cache
+func (c *AccountCache) Stats() Stats {
+	return c.cache.Stats()
+}
+
 // GetByID attempts to fetch a account from the cache by its ID, you will receive a copy for thread-safety
 func (c *AccountCache) GetByID(id string) (*gtsmodel.Account, bool) {
 	return c.cache.Get(id)
@@ -111,54 +118,64 @@ func (c *AccountCache) Invalidate(id string) {
 // this should be a relatively cheap process
 func copyAccount(account *gtsmodel.Account) *gtsmodel.Account {
 	return &gtsmodel.Account{
-		ID:                      account.ID,
-		Username:                account.Username,
-		Domain:                  account.Domain,
-		AvatarMediaAttachmentID: account.AvatarMediaAttachmentID,
-		AvatarMediaAttachment:   nil,
-		AvatarRemoteURL:         account.AvatarRemoteURL,
-		HeaderMediaAttachmentID: account.HeaderMediaAttachmentID,
-		HeaderMediaAttachment:   nil,
-		HeaderRemoteURL:         account.HeaderRemoteURL,
-		DisplayName:             account.DisplayName,
-		EmojiIDs:                account.EmojiIDs,
-		Emojis:                  nil,
-		Fields:                  account.Fields,
-		Note:                    account.Note,
-		NoteRaw:                 account.NoteRaw,
-		Memorial:                copyBoolPtr(account.Memorial),
-		MovedToAccountID:        account.MovedToAccountID,
-		Bot:                     copyBoolPtr(account.Bot),
-		CreatedAt:               account.CreatedAt,
-		UpdatedAt:               account.UpdatedAt,
-		Reason:                  account.Reason,
-		Locked:                  copyBoolPtr(account.Locked),
-		Discoverable:            copyBoolPtr(account.Discoverable),
-		Privacy:                 account.Privacy,
-		Sensitive:               copyBoolPtr(account.Sensitive),
-		Language:                account.Language,
-		StatusFormat:            account.StatusFormat,
-		CustomCSS:               account.CustomCSS,
-		URI:                     account.URI,
-		URL:                     account.URL,
-		LastWebfingeredAt:       account.LastWebfingeredAt,
-		InboxURI:                account.InboxURI,
-		SharedInboxURI:          account.SharedInboxURI,
-		OutboxURI:               account.OutboxURI,
-		FollowingURI:            account.FollowingURI,
-		FollowersURI:            account.FollowersURI,
-		FeaturedCollectionURI:   account.FeaturedCollectionURI,
-		ActorType:               account.ActorType,
-		AlsoKnownAs:             account.AlsoKnownAs,
-		PrivateKey:              account.PrivateKey,
-		PublicKey:               account.PublicKey,
-		PublicKeyURI:            account.PublicKeyURI,
-		SensitizedAt:            account.SensitizedAt,
-		SilencedAt:              account.SilencedAt,
-		SuspendedAt:             account.SuspendedAt,
-		HideCollections:         copyBoolPtr(account.HideCollections),
-		SuspensionOrigin:        account.SuspensionOrigin,
-		EnableRSS:               copyBoolPtr(account.EnableRSS),
+		ID:                         account.ID,
+		Username:                   account.Username,
+		Domain:                     account.Domain,
+		AvatarMediaAttachmentID:    account.AvatarMediaAttachmentID,
+		AvatarMediaAttachment:      nil,
+		AvatarRemoteURL:            account.AvatarRemoteURL,
+		HeaderMediaAttachmentID:    account.HeaderMediaAttachmentID,
+		HeaderMediaAttachment:      nil,
+		HeaderRemoteURL:            account.HeaderRemoteURL,
+		DisplayName:                account.DisplayName,
+		EmojiIDs:                   account.EmojiIDs,
+		Emojis:                     nil,
+		Fields:                     account.Fields,
+		Note:                       account.Note,
+		NoteRaw:                    account.NoteRaw,
+		Memorial:                   copyBoolPtr(account.Memorial),
+		MovedToAccountID:           account.MovedToAccountID,
+		Bot:                        copyBoolPtr(account.Bot),
+		CreatedAt:                  account.CreatedAt,
+		UpdatedAt:                  account.UpdatedAt,
+		Reason:                     account.Reason,
+		Locked:                     copyBoolPtr(account.Locked),
+		Discoverable:               copyBoolPtr(account.Discoverable),
+		Privacy:                    account.Privacy,
+		Sensitive:                  copyBoolPtr(account.Sensitive),
+		Language:                   account.Language,
+		StatusFormat:               account.StatusFormat,
+		CustomCSS:                  account.CustomCSS,
+		URI:                        account.URI,
+		URL:                        account.URL,
+		LastWebfingeredAt:          account.LastWebfingeredAt,
+		InboxURI:                   account.InboxURI,
+		SharedInboxURI:             account.SharedInboxURI,
+		OutboxURI:                  account.OutboxURI,
+		FollowingURI:               account.FollowingURI,
+		FollowersURI:               account.FollowersURI,
+		FeaturedCollectionURI:      account.FeaturedCollectionURI,
+		ActorType:                  account.ActorType,
+		AlsoKnownAs:                account.AlsoKnownAs,
+		PrivateKey:                 account.PrivateKey,
+		PublicKey:                  account.PublicKey,
+		PublicKeyURI:               account.PublicKeyURI,
+		SensitizedAt:               account.SensitizedAt,
+		SilencedAt:                 account.SilencedAt,
+		SuspendedAt:                account.SuspendedAt,
+		HideCollections:            copyBoolPtr(account.HideCollections),
+		SuspensionOrigin:           account.SuspensionOrigin,
+		DeletedAt:                  account.DeletedAt,
+		EnableRSS:                  copyBoolPtr(account.EnableRSS),
+		FollowersOnlyBoostable:     copyBoolPtr(account.FollowersOnlyBoostable),
+		MentionPolicy:              account.MentionPolicy,
+		WebHideBoosts:              copyBoolPtr(account.WebHideBoosts),
+		WebHideReplies:             copyBoolPtr(account.WebHideReplies),
+		WebPinnedFirst:             copyBoolPtr(account.WebPinnedFirst),
+		WebMediaTab:                copyBoolPtr(account.WebMediaTab),
+		Mirror:                     copyBoolPtr(account.Mirror),
+		HideMirrors:                copyBoolPtr(account.HideMirrors),
+		NotificationsRetentionDays: copyIntPtr(account.NotificationsRetentionDays),
 	}
 }
 
@@ -35,6 +35,7 @@ type AccountCacheTestSuite struct {
 }
 
 func (suite *AccountCacheTestSuite) SetupSuite() {
+	testrig.InitTestConfig()
 	suite.data = testrig.NewTestAccounts()
 }
 
@@ -0,0 +1,79 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// Bus carries cache invalidations between GoToSocial processes which share a database,
+// so that a change made through one process isn't hidden from the others by their
+// cached copies of whatever changed. Caches are identified by name, eg., "statuses".
+type Bus interface {
+	// Publish tells other processes to invalidate the given key from the named cache.
+	Publish(ctx context.Context, name string, key string)
+
+	// Subscribe registers invalidate to be called with each key
+	// that another process publishes for the named cache.
+	Subscribe(name string, invalidate func(key string))
+}
+
+// NewLocalBus returns a Bus for a process which doesn't share its database with any
+// others; keys are never published anywhere, so subscribers are never called.
+func NewLocalBus() Bus {
+	return localBus{}
+}
+
+type localBus struct{}
+
+func (localBus) Publish(ctx context.Context, name string, key string) {}
+
+func (localBus) Subscribe(name string, invalidate func(key string)) {}
+
+// Subscribers keeps track of the invalidate functions subscribed to each cache,
+// for Bus implementations to call when they receive a key from another process.
+type Subscribers struct {
+	mutex sync.RWMutex
+	subs  map[string][]func(key string)
+}
+
+// Subscribe registers invalidate to be called with keys for the named cache.
+func (s *Subscribers) Subscribe(name string, invalidate func(key string)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.subs == nil {
+		s.subs = make(map[string][]func(key string))
+	}
+	s.subs[name] = append(s.subs[name], invalidate)
+}
+
+// Invalidate calls every function subscribed to the named cache with the given key.
+// It returns false if nothing is subscribed to the named cache.
+func (s *Subscribers) Invalidate(name string, key string) bool {
+	s.mutex.RLock()
+	subs := s.subs[name]
+	s.mutex.RUnlock()
+
+	for _, invalidate := range subs {
+		invalidate(key)
+	}
+	return len(subs) != 0
+}
@@ -0,0 +1,57 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SubscribersTestSuite struct {
+	suite.Suite
+}
+
+func (suite *SubscribersTestSuite) SetupSuite() {
+	testrig.InitTestConfig()
+}
+
+func (suite *SubscribersTestSuite) TestInvalidate() {
+	statusCache := cache.NewStatusCache()
+	testStatus := testrig.NewTestStatuses()["local_account_1_status_1"]
+	statusCache.Put(testStatus)
+
+	subs := &cache.Subscribers{}
+	subs.Subscribe("statuses", statusCache.Invalidate)
+
+	// keys for other caches go nowhere
+	suite.False(subs.Invalidate("accounts", testStatus.ID))
+	_, ok := statusCache.GetByID(testStatus.ID)
+	suite.True(ok)
+
+	suite.True(subs.Invalidate("statuses", testStatus.ID))
+	_, ok = statusCache.GetByID(testStatus.ID)
+	suite.False(ok)
+}
+
+func TestSubscribersTestSuite(t *testing.T) {
+	suite.Run(t, new(SubscribersTestSuite))
+}
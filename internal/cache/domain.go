@@ -22,18 +22,19 @@ import (
 	"time"
 
 	"codeberg.org/gruf/go-cache/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 // DomainCache is a cache wrapper to provide URL and URI lookups for gtsmodel.Status
 type DomainBlockCache struct {
-	cache cache.LookupCache[string, string, *gtsmodel.DomainBlock]
+	cache *countedLookup[string, string, *gtsmodel.DomainBlock]
 }
 
 // NewStatusCache returns a new instantiated statusCache object
 func NewDomainBlockCache() *DomainBlockCache {
 	c := &DomainBlockCache{}
-	c.cache = cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.DomainBlock]{
+	c.cache = newCountedLookup(cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.DomainBlock]{
 		RegisterLookups: func(lm *cache.LookupMap[string, string]) {
 			lm.RegisterLookup("id")
 		},
@@ -51,12 +52,18 @@ func NewDomainBlockCache() *DomainBlockCache {
 				lm.Delete("id", block.ID)
 			}
 		},
-	})
-	c.cache.SetTTL(time.Minute*5, false)
+	}))
+	c.cache.SetTTL(config.GetCacheDomainBlockTTL(), false)
+	c.cache.SetMaxSize(config.GetCacheDomainBlockMaxSize())
 	c.cache.Start(time.Second * 10)
 	return c
 }
 
+// Stats returns the current hit, miss and eviction counts and size of the cache
+func (c *DomainBlockCache) Stats() Stats {
+	return c.cache.Stats()
+}
+
 // GetByID attempts to fetch a status from the cache by its ID, you will receive a copy for thread-safety
 func (c *DomainBlockCache) GetByID(id string) (*gtsmodel.DomainBlock, bool) {
 	return c.cache.GetBy("id", id)
@@ -19,21 +19,33 @@
 package cache
 
 import (
+	"sync"
 	"time"
 
 	"codeberg.org/gruf/go-cache/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 // EmojiCache is a cache wrapper to provide ID and URI lookups for gtsmodel.Emoji
 type EmojiCache struct {
-	cache cache.LookupCache[string, string, *gtsmodel.Emoji]
+	cache  *countedLookup[string, string, *gtsmodel.Emoji]
+	misses *missCache
+
+	// categories indexes the IDs of cached emojis by category ID;
+	// cached emojis may have their Category embedded, so they all
+	// need invalidating when that category is renamed or deleted
+	categories      map[string]map[string]struct{}
+	categoriesMutex sync.Mutex
 }
 
 // NewEmojiCache returns a new instantiated EmojiCache object
 func NewEmojiCache() *EmojiCache {
-	c := &EmojiCache{}
-	c.cache = cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.Emoji]{
+	c := &EmojiCache{
+		misses:     newMissCache(),
+		categories: make(map[string]map[string]struct{}),
+	}
+	c.cache = newCountedLookup(cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.Emoji]{
 		RegisterLookups: func(lm *cache.LookupMap[string, string]) {
 			lm.RegisterLookup("uri")
 			lm.RegisterLookup("shortcodedomain")
@@ -41,30 +53,42 @@ func NewEmojiCache() *EmojiCache {
 		},
 
 		AddLookups: func(lm *cache.LookupMap[string, string], emoji *gtsmodel.Emoji) {
-			lm.Set("shortcodedomain", shortcodeDomainKey(emoji.Shortcode, emoji.Domain), emoji.ID)
+			lm.Set("shortcodedomain", ShortcodeDomainKey(emoji.Shortcode, emoji.Domain), emoji.ID)
 			if uri := emoji.URI; uri != "" {
 				lm.Set("uri", uri, emoji.ID)
 			}
 			if imageStaticURL := emoji.ImageStaticURL; imageStaticURL != "" {
 				lm.Set("imagestaticurl", imageStaticURL, emoji.ID)
 			}
+			if categoryID := emoji.CategoryID; categoryID != "" {
+				c.indexCategory(categoryID, emoji.ID)
+			}
 		},
 
 		DeleteLookups: func(lm *cache.LookupMap[string, string], emoji *gtsmodel.Emoji) {
-			lm.Delete("shortcodedomain", shortcodeDomainKey(emoji.Shortcode, emoji.Domain))
+			lm.Delete("shortcodedomain", ShortcodeDomainKey(emoji.Shortcode, emoji.Domain))
 			if uri := emoji.URI; uri != "" {
 				lm.Delete("uri", uri)
 			}
 			if imageStaticURL := emoji.ImageStaticURL; imageStaticURL != "" {
 				lm.Delete("imagestaticurl", imageStaticURL)
 			}
+			if categoryID := emoji.CategoryID; categoryID != "" {
+				c.unindexCategory(categoryID, emoji.ID)
+			}
 		},
-	})
-	c.cache.SetTTL(time.Minute*5, false)
+	}))
+	c.cache.SetTTL(config.GetCacheEmojiTTL(), false)
+	c.cache.SetMaxSize(config.GetCacheEmojiMaxSize())
 	c.cache.Start(time.Second * 10)
 	return c
 }
 
+// Stats returns the current hit, miss and eviction counts and size of the cache
+func (c *EmojiCache) Stats() Stats {
+	return c.cache.Stats()
+}
+
 // GetByID attempts to fetch an emoji from the cache by its ID, you will receive a copy for thread-safety
 func (c *EmojiCache) GetByID(id string) (*gtsmodel.Emoji, bool) {
 	return c.cache.Get(id)
@@ -76,7 +100,7 @@ func (c *EmojiCache) GetByURI(uri string) (*gtsmodel.Emoji, bool) {
 }
 
 func (c *EmojiCache) GetByShortcodeDomain(shortcode string, domain string) (*gtsmodel.Emoji, bool) {
-	return c.cache.GetBy("shortcodedomain", shortcodeDomainKey(shortcode, domain))
+	return c.cache.GetBy("shortcodedomain", ShortcodeDomainKey(shortcode, domain))
 }
 
 func (c *EmojiCache) GetByImageStaticURL(imageStaticURL string) (*gtsmodel.Emoji, bool) {
@@ -89,12 +113,79 @@ func (c *EmojiCache) Put(emoji *gtsmodel.Emoji) {
 		panic("invalid emoji")
 	}
 	c.cache.Set(emoji.ID, copyEmoji(emoji))
+	c.InvalidateMisses(emoji)
 }
 
 func (c *EmojiCache) Invalidate(emojiID string) {
 	c.cache.Invalidate(emojiID)
 }
 
+// InvalidateCategory invalidates every cached emoji in the category with the given ID,
+// so that none of them keep serving an out of date copy of the category.
+func (c *EmojiCache) InvalidateCategory(categoryID string) {
+	c.categoriesMutex.Lock()
+	emojiIDs := make([]string, 0, len(c.categories[categoryID]))
+	for emojiID := range c.categories[categoryID] {
+		emojiIDs = append(emojiIDs, emojiID)
+	}
+	c.categoriesMutex.Unlock()
+
+	// invalidating unindexes each emoji
+	// again, so do it with the mutex unlocked
+	for _, emojiID := range emojiIDs {
+		c.cache.Invalidate(emojiID)
+	}
+}
+
+// IsMiss returns whether a recent lookup of an emoji by the given lookup ("id", "uri",
+// "shortcodedomain" or "imagestaticurl") and key found nothing in the database.
+func (c *EmojiCache) IsMiss(lookup string, key string) bool {
+	return c.misses.has(lookup, key)
+}
+
+// PutMiss records that a lookup of an emoji by the given lookup and key found nothing in the database.
+func (c *EmojiCache) PutMiss(lookup string, key string) {
+	c.misses.put(lookup, key)
+}
+
+// InvalidateMisses removes any recorded misses which the given emoji would now satisfy.
+func (c *EmojiCache) InvalidateMisses(emoji *gtsmodel.Emoji) {
+	c.misses.invalidate("id", emoji.ID)
+	c.misses.invalidate("shortcodedomain", ShortcodeDomainKey(emoji.Shortcode, emoji.Domain))
+	if uri := emoji.URI; uri != "" {
+		c.misses.invalidate("uri", uri)
+	}
+	if imageStaticURL := emoji.ImageStaticURL; imageStaticURL != "" {
+		c.misses.invalidate("imagestaticurl", imageStaticURL)
+	}
+}
+
+func (c *EmojiCache) indexCategory(categoryID string, emojiID string) {
+	c.categoriesMutex.Lock()
+	defer c.categoriesMutex.Unlock()
+
+	emojiIDs, ok := c.categories[categoryID]
+	if !ok {
+		emojiIDs = make(map[string]struct{})
+		c.categories[categoryID] = emojiIDs
+	}
+	emojiIDs[emojiID] = struct{}{}
+}
+
+func (c *EmojiCache) unindexCategory(categoryID string, emojiID string) {
+	c.categoriesMutex.Lock()
+	defer c.categoriesMutex.Unlock()
+
+	emojiIDs, ok := c.categories[categoryID]
+	if !ok {
+		return
+	}
+	delete(emojiIDs, emojiID)
+	if len(emojiIDs) == 0 {
+		delete(c.categories, categoryID)
+	}
+}
+
 // copyEmoji performs a surface-level copy of emoji, only keeping attached IDs intact, not the objects.
 // due to all the data being copied being 99% primitive types or strings (which are immutable and passed by ptr)
 // this should be a relatively cheap process
@@ -123,7 +214,8 @@ func copyEmoji(emoji *gtsmodel.Emoji) *gtsmodel.Emoji {
 	}
 }
 
-func shortcodeDomainKey(shortcode string, domain string) string {
+// ShortcodeDomainKey returns the key used to look up an emoji by its shortcode and domain.
+func ShortcodeDomainKey(shortcode string, domain string) string {
 	if domain != "" {
 		return shortcode + "@" + domain
 	}
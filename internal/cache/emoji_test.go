@@ -0,0 +1,76 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiCacheTestSuite struct {
+	suite.Suite
+	data  map[string]*gtsmodel.Emoji
+	cache *cache.EmojiCache
+}
+
+func (suite *EmojiCacheTestSuite) SetupSuite() {
+	testrig.InitTestConfig()
+	suite.data = testrig.NewTestEmojis()
+}
+
+func (suite *EmojiCacheTestSuite) SetupTest() {
+	suite.cache = cache.NewEmojiCache()
+}
+
+func (suite *EmojiCacheTestSuite) TearDownTest() {
+	suite.cache = nil
+}
+
+func (suite *EmojiCacheTestSuite) TestInvalidateCategory() {
+	for _, emoji := range suite.data {
+		suite.cache.Put(emoji)
+	}
+
+	categorized := suite.data["rainbow"]
+	uncategorized := suite.data["yell"]
+
+	suite.cache.InvalidateCategory(categorized.CategoryID)
+
+	// only the emoji in the category should have been dropped
+	_, ok := suite.cache.GetByID(categorized.ID)
+	suite.False(ok)
+	_, ok = suite.cache.GetByShortcodeDomain(categorized.Shortcode, categorized.Domain)
+	suite.False(ok)
+	_, ok = suite.cache.GetByID(uncategorized.ID)
+	suite.True(ok)
+
+	// re-caching after invalidation should index the emoji again
+	suite.cache.Put(categorized)
+	suite.cache.InvalidateCategory(categorized.CategoryID)
+	_, ok = suite.cache.GetByID(categorized.ID)
+	suite.False(ok)
+}
+
+func TestEmojiCache(t *testing.T) {
+	suite.Run(t, &EmojiCacheTestSuite{})
+}
@@ -23,18 +23,20 @@ import (
 	"time"
 
 	"codeberg.org/gruf/go-cache/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 // EmojiCategoryCache is a cache wrapper to provide ID lookups for gtsmodel.EmojiCategory
 type EmojiCategoryCache struct {
-	cache cache.LookupCache[string, string, *gtsmodel.EmojiCategory]
+	cache  *countedLookup[string, string, *gtsmodel.EmojiCategory]
+	misses *missCache
 }
 
 // NewEmojiCategoryCache returns a new instantiated EmojiCategoryCache object
 func NewEmojiCategoryCache() *EmojiCategoryCache {
-	c := &EmojiCategoryCache{}
-	c.cache = cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.EmojiCategory]{
+	c := &EmojiCategoryCache{misses: newMissCache()}
+	c.cache = newCountedLookup(cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.EmojiCategory]{
 		RegisterLookups: func(lm *cache.LookupMap[string, string]) {
 			lm.RegisterLookup("name")
 		},
@@ -46,12 +48,18 @@ func NewEmojiCategoryCache() *EmojiCategoryCache {
 		DeleteLookups: func(lm *cache.LookupMap[string, string], emojiCategory *gtsmodel.EmojiCategory) {
 			lm.Delete("name", strings.ToLower(emojiCategory.Name))
 		},
-	})
-	c.cache.SetTTL(time.Minute*5, false)
+	}))
+	c.cache.SetTTL(config.GetCacheEmojiCategoryTTL(), false)
+	c.cache.SetMaxSize(config.GetCacheEmojiCategoryMaxSize())
 	c.cache.Start(time.Second * 10)
 	return c
 }
 
+// Stats returns the current hit, miss and eviction counts and size of the cache
+func (c *EmojiCategoryCache) Stats() Stats {
+	return c.cache.Stats()
+}
+
 // GetByID attempts to fetch an emojiCategory from the cache by its ID, you will receive a copy for thread-safety
 func (c *EmojiCategoryCache) GetByID(id string) (*gtsmodel.EmojiCategory, bool) {
 	return c.cache.Get(id)
@@ -68,12 +76,38 @@ func (c *EmojiCategoryCache) Put(emoji *gtsmodel.EmojiCategory) {
 		panic("invalid emoji")
 	}
 	c.cache.Set(emoji.ID, copyEmojiCategory(emoji))
+	c.InvalidateMisses(emoji)
 }
 
 func (c *EmojiCategoryCache) Invalidate(emojiID string) {
 	c.cache.Invalidate(emojiID)
 }
 
+// IsMiss returns whether a recent lookup of an emojiCategory by the given
+// lookup ("id" or "name") and key found nothing in the database.
+func (c *EmojiCategoryCache) IsMiss(lookup string, key string) bool {
+	return c.misses.has(lookup, missCategoryKey(lookup, key))
+}
+
+// PutMiss records that a lookup of an emojiCategory by the given lookup and key found nothing in the database.
+func (c *EmojiCategoryCache) PutMiss(lookup string, key string) {
+	c.misses.put(lookup, missCategoryKey(lookup, key))
+}
+
+// InvalidateMisses removes any recorded misses which the given emojiCategory would now satisfy.
+func (c *EmojiCategoryCache) InvalidateMisses(emojiCategory *gtsmodel.EmojiCategory) {
+	c.misses.invalidate("id", emojiCategory.ID)
+	c.misses.invalidate("name", strings.ToLower(emojiCategory.Name))
+}
+
+// missCategoryKey normalizes names, since they're looked up case-insensitively.
+func missCategoryKey(lookup string, key string) string {
+	if lookup == "name" {
+		return strings.ToLower(key)
+	}
+	return key
+}
+
 func copyEmojiCategory(emojiCategory *gtsmodel.EmojiCategory) *gtsmodel.EmojiCategory {
 	return &gtsmodel.EmojiCategory{
 		ID:        emojiCategory.ID,
@@ -0,0 +1,79 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache
+
+import (
+	"sync"
+
+	"codeberg.org/gruf/go-cache/v2"
+)
+
+// limit keeps a cache within a maximum number of items, by invalidating (roughly)
+// the items which were added to it longest ago once it grows beyond that.
+type limit[K comparable, V any] struct {
+	max int
+
+	mu sync.Mutex
+	// queue holds keys in the order they were added to the cache,
+	// some of which may since have expired or been invalidated.
+	queue []K
+}
+
+// newLimit returns a limit of max items, or nil if max is 0 or less,
+// meaning the cache may grow without limit.
+func newLimit[K comparable, V any](max int) *limit[K, V] {
+	if max <= 0 {
+		return nil
+	}
+	return &limit[K, V]{max: max}
+}
+
+// added records that key was newly added to c, then invalidates the oldest
+// items in c until it's back within the limit, returning how many were invalidated.
+func (l *limit[K, V]) added(c cache.Cache[K, V], key K) (invalidated uint64) {
+	if l == nil {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.queue = append(l.queue, key)
+	for len(l.queue) > 0 && c.Size() > l.max {
+		oldest := l.queue[0]
+		l.queue = l.queue[1:]
+		if c.Invalidate(oldest) {
+			invalidated++
+		}
+	}
+
+	// Items which expire never come through here, so drop their keys
+	// once they make up most of the queue, to stop it growing forever.
+	if len(l.queue) > 2*l.max {
+		queue := make([]K, 0, l.max)
+		for _, k := range l.queue {
+			if c.Has(k) {
+				queue = append(queue, k)
+			}
+		}
+		l.queue = queue
+	}
+
+	return invalidated
+}
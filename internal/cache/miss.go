@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache
+
+import (
+	"time"
+
+	"codeberg.org/gruf/go-cache/v2"
+)
+
+// missTTL is how long a lookup that found nothing is remembered for. This is kept
+// short, since a miss can go stale when the thing looked up is created elsewhere.
+const missTTL = time.Second * 30
+
+// missCache records lookups which recently found nothing in the database, so
+// that repeated lookups for things which don't exist can skip the database.
+// Keys are namespaced by the name of the lookup, eg., "uri" or "shortcodedomain".
+type missCache struct {
+	cache cache.Cache[string, struct{}]
+}
+
+// newMissCache returns a new instantiated missCache object
+func newMissCache() *missCache {
+	c := &missCache{cache: cache.New[string, struct{}]()}
+	c.cache.SetTTL(missTTL, false)
+	c.cache.Start(time.Second * 10)
+	return c
+}
+
+// put records a miss for the given lookup and key.
+func (c *missCache) put(lookup string, key string) {
+	c.cache.Set(missKey(lookup, key), struct{}{})
+}
+
+// has returns whether a miss is recorded for the given lookup and key.
+func (c *missCache) has(lookup string, key string) bool {
+	return c.cache.Has(missKey(lookup, key))
+}
+
+// invalidate removes any miss recorded for the given lookup and key.
+func (c *missCache) invalidate(lookup string, key string) {
+	c.cache.Invalidate(missKey(lookup, key))
+}
+
+func missKey(lookup string, key string) string {
+	return lookup + ":" + key
+}
@@ -0,0 +1,97 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// requestCacheKey is the context key that a request cache is stored under.
+type requestCacheKey struct{}
+
+// requestCache remembers the results of lookups made while handling one request, so
+// that assembling eg., a page of a timeline looks up each account, emoji, or attachment
+// only once, no matter how many statuses on the page refer to it.
+//
+// Unlike the other caches, a request cache hands out the same pointer every time, without
+// copying, so it should only be used while assembling responses, not while changing things.
+type requestCache struct {
+	mu      sync.Mutex
+	entries map[string]map[string]any
+}
+
+// WithRequestCache returns a copy of ctx carrying a new, empty request cache, which
+// lookups made with the returned context will share. If ctx already carries a request
+// cache, ctx itself is returned, so that nested calls share the outermost cache.
+func WithRequestCache(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(requestCacheKey{}).(*requestCache); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, requestCacheKey{}, &requestCache{
+		entries: make(map[string]map[string]any),
+	})
+}
+
+// RequestCached returns the result of the lookup of the given kind and key remembered in
+// the request cache of ctx. If there is none yet, load is called, and what it returns is
+// remembered, unless it returned an error. Without a request cache in ctx, this just calls load.
+func RequestCached[V any](ctx context.Context, kind string, key string, load func() (V, error)) (V, error) {
+	c, ok := ctx.Value(requestCacheKey{}).(*requestCache)
+	if !ok {
+		return load()
+	}
+
+	c.mu.Lock()
+	v, ok := c.entries[kind][key].(V)
+	c.mu.Unlock()
+	if ok {
+		return v, nil
+	}
+
+	// load outside of the lock, since
+	// loading may itself do lookups
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+
+	c.mu.Lock()
+	if c.entries[kind] == nil {
+		c.entries[kind] = make(map[string]any)
+	}
+	c.entries[kind][key] = v
+	c.mu.Unlock()
+
+	return v, nil
+}
+
+// RequestInvalidate forgets all lookups of the given kind remembered in the request cache of ctx,
+// if it carries one. This should be called whenever something of that kind is changed, in
+// case the change is made while a request cache is in use.
+func RequestInvalidate(ctx context.Context, kind string) {
+	c, ok := ctx.Value(requestCacheKey{}).(*requestCache)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	delete(c.entries, kind)
+	c.mu.Unlock()
+}
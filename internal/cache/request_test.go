@@ -0,0 +1,117 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+)
+
+type RequestCacheTestSuite struct {
+	suite.Suite
+}
+
+// counter returns a load function which returns how many times it has been called.
+func counter() func() (int, error) {
+	calls := 0
+	return func() (int, error) {
+		calls++
+		return calls, nil
+	}
+}
+
+func (suite *RequestCacheTestSuite) TestRequestCached() {
+	ctx := cache.WithRequestCache(context.Background())
+	load := counter()
+
+	v, err := cache.RequestCached(ctx, "account", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.NoError(err)
+	suite.Equal(1, v)
+
+	// remembered for the same kind and key
+	v, err = cache.RequestCached(ctx, "account", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.NoError(err)
+	suite.Equal(1, v)
+
+	// but not for others
+	v, err = cache.RequestCached(ctx, "account", "01F8MH17FWEB39HZJ76B6VXSKF", load)
+	suite.NoError(err)
+	suite.Equal(2, v)
+
+	v, err = cache.RequestCached(ctx, "emoji", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.NoError(err)
+	suite.Equal(3, v)
+
+	// nested request caches are the same cache
+	v, err = cache.RequestCached(cache.WithRequestCache(ctx), "emoji", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.NoError(err)
+	suite.Equal(3, v)
+
+	// forgotten after invalidating the kind
+	cache.RequestInvalidate(ctx, "account")
+	v, err = cache.RequestCached(ctx, "account", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.NoError(err)
+	suite.Equal(4, v)
+
+	v, err = cache.RequestCached(ctx, "emoji", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.NoError(err)
+	suite.Equal(3, v)
+}
+
+func (suite *RequestCacheTestSuite) TestRequestCachedWithoutCache() {
+	ctx := context.Background()
+	load := counter()
+
+	for i := 1; i <= 3; i++ {
+		v, err := cache.RequestCached(ctx, "account", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+		suite.NoError(err)
+		suite.Equal(i, v)
+	}
+
+	// doesn't panic
+	cache.RequestInvalidate(ctx, "account")
+}
+
+func (suite *RequestCacheTestSuite) TestRequestCachedError() {
+	ctx := cache.WithRequestCache(context.Background())
+	calls := 0
+	load := func() (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, errors.New("database is having a bad day")
+		}
+		return calls, nil
+	}
+
+	_, err := cache.RequestCached(ctx, "account", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.Error(err)
+
+	// errors aren't remembered
+	v, err := cache.RequestCached(ctx, "account", "01F8MH1H7YV1Z7D2C8K2730QBF", load)
+	suite.NoError(err)
+	suite.Equal(2, v)
+}
+
+func TestRequestCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestCacheTestSuite))
+}
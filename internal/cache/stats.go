@@ -0,0 +1,182 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache
+
+import (
+	"sync/atomic"
+
+	"codeberg.org/gruf/go-cache/v2"
+)
+
+// Stats are the statistics for one cache, counted since it was created.
+type Stats struct {
+	// Size is the number of items currently in the cache.
+	Size int
+	// Hits is the number of lookups which found an item in the cache.
+	Hits uint64
+	// Misses is the number of lookups which found nothing in the cache.
+	Misses uint64
+	// Evictions is the number of items removed from the cache because they expired, or because it was full.
+	Evictions uint64
+}
+
+// counters counts hits, misses and evictions for a cache.
+type counters struct {
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// record counts a lookup, as a hit when ok is true, otherwise as a miss.
+func (c *counters) record(ok bool) {
+	if ok {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+}
+
+// evictionHook returns the given hook wrapped to also count evictions.
+func evictionHook[K comparable, V any](c *counters, hook cache.Hook[K, V]) cache.Hook[K, V] {
+	return func(key K, value V) {
+		c.evictions.Add(1)
+		if hook != nil {
+			hook(key, value)
+		}
+	}
+}
+
+func (c *counters) stats(size int) Stats {
+	return Stats{
+		Size:      size,
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+	}
+}
+
+// Counted wraps a cache, counting hits, misses and evictions so that they can be viewed with Stats.
+// It can also limit the cache to a maximum number of items, which go-cache can't do by itself.
+type Counted[K comparable, V any] struct {
+	cache.Cache[K, V]
+	counters counters
+	limit    *limit[K, V]
+}
+
+// NewCounted returns the given cache wrapped in a new Counted.
+func NewCounted[K comparable, V any](c cache.Cache[K, V]) *Counted[K, V] {
+	counted := &Counted[K, V]{Cache: c}
+	counted.SetEvictionCallback(nil)
+	return counted
+}
+
+// SetEvictionCallback sets the eviction callback to the provided hook, which may be nil.
+func (c *Counted[K, V]) SetEvictionCallback(hook cache.Hook[K, V]) {
+	c.Cache.SetEvictionCallback(evictionHook(&c.counters, hook))
+}
+
+// SetMaxSize limits the cache to max items, or removes the limit if max is 0.
+// It must be called before the cache is used.
+func (c *Counted[K, V]) SetMaxSize(max int) {
+	c.limit = newLimit[K, V](max)
+}
+
+// Get fetches the value with key from the cache, counting a hit or a miss.
+func (c *Counted[K, V]) Get(key K) (V, bool) {
+	value, ok := c.Cache.Get(key)
+	c.counters.record(ok)
+	return value, ok
+}
+
+// Put places value in the cache under key if it's not already there, returning whether it was placed.
+func (c *Counted[K, V]) Put(key K, value V) bool {
+	if !c.Cache.Put(key, value) {
+		return false
+	}
+	c.counters.evictions.Add(c.limit.added(c.Cache, key))
+	return true
+}
+
+// Set places value in the cache under key, replacing any existing value.
+func (c *Counted[K, V]) Set(key K, value V) {
+	existed := c.Cache.Has(key)
+	c.Cache.Set(key, value)
+	if !existed {
+		c.counters.evictions.Add(c.limit.added(c.Cache, key))
+	}
+}
+
+// Stats returns the current statistics for the cache.
+func (c *Counted[K, V]) Stats() Stats {
+	return c.counters.stats(c.Size())
+}
+
+// countedLookup is the equivalent of Counted for a cache.LookupCache,
+// used by the cache wrappers in this package.
+type countedLookup[OK, AK comparable, V any] struct {
+	cache.LookupCache[OK, AK, V]
+	counters counters
+	limit    *limit[OK, V]
+}
+
+func newCountedLookup[OK, AK comparable, V any](c cache.LookupCache[OK, AK, V]) *countedLookup[OK, AK, V] {
+	counted := &countedLookup[OK, AK, V]{LookupCache: c}
+	counted.SetEvictionCallback(nil)
+	return counted
+}
+
+func (c *countedLookup[OK, AK, V]) SetEvictionCallback(hook cache.Hook[OK, V]) {
+	c.LookupCache.SetEvictionCallback(evictionHook(&c.counters, hook))
+}
+
+func (c *countedLookup[OK, AK, V]) SetMaxSize(max int) {
+	c.limit = newLimit[OK, V](max)
+}
+
+func (c *countedLookup[OK, AK, V]) Put(key OK, value V) bool {
+	if !c.LookupCache.Put(key, value) {
+		return false
+	}
+	c.counters.evictions.Add(c.limit.added(c.LookupCache, key))
+	return true
+}
+
+func (c *countedLookup[OK, AK, V]) Set(key OK, value V) {
+	existed := c.LookupCache.Has(key)
+	c.LookupCache.Set(key, value)
+	if !existed {
+		c.counters.evictions.Add(c.limit.added(c.LookupCache, key))
+	}
+}
+
+func (c *countedLookup[OK, AK, V]) Get(key OK) (V, bool) {
+	value, ok := c.LookupCache.Get(key)
+	c.counters.record(ok)
+	return value, ok
+}
+
+func (c *countedLookup[OK, AK, V]) GetBy(lookup string, key AK) (V, bool) {
+	value, ok := c.LookupCache.GetBy(lookup, key)
+	c.counters.record(ok)
+	return value, ok
+}
+
+func (c *countedLookup[OK, AK, V]) Stats() Stats {
+	return c.counters.stats(c.Size())
+}
@@ -0,0 +1,112 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	grufcache "codeberg.org/gruf/go-cache/v2"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *StatsTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+}
+
+func (suite *StatsTestSuite) TestStatusCacheStats() {
+	statusCache := cache.NewStatusCache()
+	testStatus := testrig.NewTestStatuses()["local_account_1_status_1"]
+	statusCache.Put(testStatus)
+
+	_, ok := statusCache.GetByID(testStatus.ID)
+	suite.True(ok)
+	_, ok = statusCache.GetByURI(testStatus.URI)
+	suite.True(ok)
+	_, ok = statusCache.GetByURI("https://example.org/users/nobody/statuses/01GKZ7WQ5F8M1Y0Z3S0Q1XH9ZT")
+	suite.False(ok)
+
+	suite.Equal(cache.Stats{Size: 1, Hits: 2, Misses: 1}, statusCache.Stats())
+}
+
+func (suite *StatsTestSuite) TestCountedEvictions() {
+	c := cache.NewCounted(grufcache.New[string, string]())
+	c.SetTTL(time.Millisecond, false)
+	c.Start(time.Millisecond * 10)
+	defer c.Stop()
+
+	evicted := make(chan string, 1)
+	c.SetEvictionCallback(func(key string, value string) {
+		evicted <- key
+	})
+
+	c.Set("some_key", "some_value")
+
+	select {
+	case key := <-evicted:
+		suite.Equal("some_key", key)
+	case <-time.After(time.Second * 5):
+		suite.FailNow("timed out waiting for eviction")
+	}
+
+	_, ok := c.Get("some_key")
+	suite.False(ok)
+	suite.Equal(cache.Stats{Size: 0, Misses: 1, Evictions: 1}, c.Stats())
+}
+
+func (suite *StatsTestSuite) TestCountedMaxSize() {
+	c := cache.NewCounted(grufcache.New[string, string]())
+	c.SetMaxSize(2)
+
+	c.Set("key_1", "value_1")
+	c.Set("key_2", "value_2")
+	c.Set("key_1", "value_1_again")
+	c.Put("key_3", "value_3")
+
+	// key_1 was added first, so it went when key_3 was added
+	suite.False(c.Has("key_1"))
+	suite.True(c.Has("key_2"))
+	suite.True(c.Has("key_3"))
+	suite.Equal(cache.Stats{Size: 2, Evictions: 1}, c.Stats())
+}
+
+func (suite *StatsTestSuite) TestStatusCacheMaxSize() {
+	config.SetCacheStatusMaxSize(1)
+	statusCache := cache.NewStatusCache()
+	testStatuses := testrig.NewTestStatuses()
+	statusCache.Put(testStatuses["local_account_1_status_1"])
+	statusCache.Put(testStatuses["local_account_1_status_2"])
+
+	_, ok := statusCache.GetByURI(testStatuses["local_account_1_status_1"].URI)
+	suite.False(ok)
+	_, ok = statusCache.GetByURI(testStatuses["local_account_1_status_2"].URI)
+	suite.True(ok)
+	suite.Equal(cache.Stats{Size: 1, Hits: 1, Misses: 1, Evictions: 1}, statusCache.Stats())
+}
+
+func TestStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatsTestSuite))
+}
@@ -19,21 +19,31 @@
 package cache
 
 import (
+	"sync"
 	"time"
 
 	"codeberg.org/gruf/go-cache/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 // StatusCache is a cache wrapper to provide URL and URI lookups for gtsmodel.Status
 type StatusCache struct {
-	cache cache.LookupCache[string, string, *gtsmodel.Status]
+	cache *countedLookup[string, string, *gtsmodel.Status]
+
+	// accounts indexes the IDs of cached statuses by the IDs of the
+	// accounts that posted or were boosted by them, so that they can
+	// all be invalidated at once when an account's statuses are deleted
+	accounts      map[string]map[string]struct{}
+	accountsMutex sync.Mutex
 }
 
 // NewStatusCache returns a new instantiated statusCache object
 func NewStatusCache() *StatusCache {
-	c := &StatusCache{}
-	c.cache = cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.Status]{
+	c := &StatusCache{
+		accounts: make(map[string]map[string]struct{}),
+	}
+	c.cache = newCountedLookup(cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.Status]{
 		RegisterLookups: func(lm *cache.LookupMap[string, string]) {
 			lm.RegisterLookup("uri")
 			lm.RegisterLookup("url")
@@ -46,6 +56,10 @@ func NewStatusCache() *StatusCache {
 			if url := status.URL; url != "" {
 				lm.Set("url", url, status.ID)
 			}
+			c.indexAccount(status.AccountID, status.ID)
+			if boostOfAccountID := status.BoostOfAccountID; boostOfAccountID != "" {
+				c.indexAccount(boostOfAccountID, status.ID)
+			}
 		},
 
 		DeleteLookups: func(lm *cache.LookupMap[string, string], status *gtsmodel.Status) {
@@ -55,13 +69,23 @@ func NewStatusCache() *StatusCache {
 			if url := status.URL; url != "" {
 				lm.Delete("url", url)
 			}
+			c.unindexAccount(status.AccountID, status.ID)
+			if boostOfAccountID := status.BoostOfAccountID; boostOfAccountID != "" {
+				c.unindexAccount(boostOfAccountID, status.ID)
+			}
 		},
-	})
-	c.cache.SetTTL(time.Minute*5, false)
+	}))
+	c.cache.SetTTL(config.GetCacheStatusTTL(), false)
+	c.cache.SetMaxSize(config.GetCacheStatusMaxSize())
 	c.cache.Start(time.Second * 10)
 	return c
 }
 
+// Stats returns the current hit, miss and eviction counts and size of the cache
+func (c *StatusCache) Stats() Stats {
+	return c.cache.Stats()
+}
+
 // GetByID attempts to fetch a status from the cache by its ID, you will receive a copy for thread-safety
 func (c *StatusCache) GetByID(id string) (*gtsmodel.Status, bool) {
 	return c.cache.Get(id)
@@ -90,6 +114,49 @@ func (c *StatusCache) Invalidate(statusID string) {
 	c.cache.Invalidate(statusID)
 }
 
+// InvalidateAccount invalidates every cached status posted by the account with the
+// given ID, and every cached boost of its statuses, eg., when they've all been deleted.
+func (c *StatusCache) InvalidateAccount(accountID string) {
+	c.accountsMutex.Lock()
+	statusIDs := make([]string, 0, len(c.accounts[accountID]))
+	for statusID := range c.accounts[accountID] {
+		statusIDs = append(statusIDs, statusID)
+	}
+	c.accountsMutex.Unlock()
+
+	// invalidating unindexes each status
+	// again, so do it with the mutex unlocked
+	for _, statusID := range statusIDs {
+		c.cache.Invalidate(statusID)
+	}
+}
+
+func (c *StatusCache) indexAccount(accountID string, statusID string) {
+	c.accountsMutex.Lock()
+	defer c.accountsMutex.Unlock()
+
+	statusIDs, ok := c.accounts[accountID]
+	if !ok {
+		statusIDs = make(map[string]struct{})
+		c.accounts[accountID] = statusIDs
+	}
+	statusIDs[statusID] = struct{}{}
+}
+
+func (c *StatusCache) unindexAccount(accountID string, statusID string) {
+	c.accountsMutex.Lock()
+	defer c.accountsMutex.Unlock()
+
+	statusIDs, ok := c.accounts[accountID]
+	if !ok {
+		return
+	}
+	delete(statusIDs, statusID)
+	if len(statusIDs) == 0 {
+		delete(c.accounts, accountID)
+	}
+}
+
 // copyStatus performs a surface-level copy of status, only keeping attached IDs intact, not the objects.
 // due to all the data being copied being 99% primitive types or strings (which are immutable and passed by ptr)
 // this should be a relatively cheap process
@@ -107,6 +174,8 @@ func copyStatus(status *gtsmodel.Status) *gtsmodel.Status {
 		Mentions:                 nil,
 		EmojiIDs:                 status.EmojiIDs,
 		Emojis:                   nil,
+		PollID:                   status.PollID,
+		Poll:                     nil,
 		Local:                    copyBoolPtr(status.Local),
 		CreatedAt:                status.CreatedAt,
 		UpdatedAt:                status.UpdatedAt,
@@ -35,6 +35,7 @@ type StatusCacheTestSuite struct {
 }
 
 func (suite *StatusCacheTestSuite) SetupSuite() {
+	testrig.InitTestConfig()
 	suite.data = testrig.NewTestStatuses()
 }
 
@@ -99,6 +100,34 @@ func (suite *StatusCacheTestSuite) TestBoolPointerCopying() {
 	suite.False(*cachedStatus.Pinned)
 }
 
+func (suite *StatusCacheTestSuite) TestInvalidateAccount() {
+	for _, status := range suite.data {
+		suite.cache.Put(status)
+	}
+
+	posted := suite.data["local_account_1_status_1"]
+	boost := suite.data["admin_account_status_4"]
+	other := suite.data["local_account_2_status_1"]
+
+	suite.cache.InvalidateAccount(posted.AccountID)
+
+	// statuses by the account and boosts of them should have been dropped
+	_, ok := suite.cache.GetByID(posted.ID)
+	suite.False(ok)
+	_, ok = suite.cache.GetByURI(posted.URI)
+	suite.False(ok)
+	_, ok = suite.cache.GetByID(boost.ID)
+	suite.False(ok)
+	_, ok = suite.cache.GetByID(other.ID)
+	suite.True(ok)
+
+	// re-caching after invalidation should index the status again
+	suite.cache.Put(posted)
+	suite.cache.InvalidateAccount(posted.AccountID)
+	_, ok = suite.cache.GetByID(posted.ID)
+	suite.False(ok)
+}
+
 func TestStatusCache(t *testing.T) {
 	suite.Run(t, &StatusCacheTestSuite{})
 }
@@ -22,23 +22,25 @@ import (
 	"time"
 
 	"codeberg.org/gruf/go-cache/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 // UserCache is a cache wrapper to provide lookups for gtsmodel.User
 type UserCache struct {
-	cache cache.LookupCache[string, string, *gtsmodel.User]
+	cache *countedLookup[string, string, *gtsmodel.User]
 }
 
 // NewUserCache returns a new instantiated UserCache object
 func NewUserCache() *UserCache {
 	c := &UserCache{}
-	c.cache = cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.User]{
+	c.cache = newCountedLookup(cache.NewLookup(cache.LookupCfg[string, string, *gtsmodel.User]{
 		RegisterLookups: func(lm *cache.LookupMap[string, string]) {
 			lm.RegisterLookup("accountid")
 			lm.RegisterLookup("email")
 			lm.RegisterLookup("unconfirmedemail")
 			lm.RegisterLookup("confirmationtoken")
+			lm.RegisterLookup("postbymailtoken")
 		},
 
 		AddLookups: func(lm *cache.LookupMap[string, string], user *gtsmodel.User) {
@@ -52,6 +54,9 @@ func NewUserCache() *UserCache {
 			if confirmationToken := user.ConfirmationToken; confirmationToken != "" {
 				lm.Set("confirmationtoken", confirmationToken, user.ID)
 			}
+			if postByMailToken := user.PostByMailToken; postByMailToken != "" {
+				lm.Set("postbymailtoken", postByMailToken, user.ID)
+			}
 		},
 
 		DeleteLookups: func(lm *cache.LookupMap[string, string], user *gtsmodel.User) {
@@ -65,13 +70,22 @@ func NewUserCache() *UserCache {
 			if confirmationToken := user.ConfirmationToken; confirmationToken != "" {
 				lm.Delete("confirmationtoken", confirmationToken)
 			}
+			if postByMailToken := user.PostByMailToken; postByMailToken != "" {
+				lm.Delete("postbymailtoken", postByMailToken)
+			}
 		},
-	})
-	c.cache.SetTTL(time.Minute*5, false)
+	}))
+	c.cache.SetTTL(config.GetCacheUserTTL(), false)
+	c.cache.SetMaxSize(config.GetCacheUserMaxSize())
 	c.cache.Start(time.Second * 10)
 	return c
 }
 
+// Stats returns the current hit, miss and eviction counts and size of the cache
+func (c *UserCache) Stats() Stats {
+	return c.cache.Stats()
+}
+
 // GetByID attempts to fetch a user from the cache by its ID, you will receive a copy for thread-safety
 func (c *UserCache) GetByID(id string) (*gtsmodel.User, bool) {
 	return c.cache.Get(id)
@@ -92,6 +106,11 @@ func (c *UserCache) GetByConfirmationToken(token string) (*gtsmodel.User, bool)
 	return c.cache.GetBy("confirmationtoken", token)
 }
 
+// GetByPostByMailToken attempts to fetch a user from the cache by its post by mail token, you will receive a copy for thread-safety
+func (c *UserCache) GetByPostByMailToken(token string) (*gtsmodel.User, bool) {
+	return c.cache.GetBy("postbymailtoken", token)
+}
+
 // Put places a user in the cache, ensuring that the object place is a copy for thread-safety
 func (c *UserCache) Put(user *gtsmodel.User) {
 	if user == nil || user.ID == "" {
@@ -107,35 +126,37 @@ func (c *UserCache) Invalidate(userID string) {
 
 func copyUser(user *gtsmodel.User) *gtsmodel.User {
 	return &gtsmodel.User{
-		ID:                     user.ID,
-		CreatedAt:              user.CreatedAt,
-		UpdatedAt:              user.UpdatedAt,
-		Email:                  user.Email,
-		AccountID:              user.AccountID,
-		Account:                nil,
-		EncryptedPassword:      user.EncryptedPassword,
-		SignUpIP:               user.SignUpIP,
-		CurrentSignInAt:        user.CurrentSignInAt,
-		CurrentSignInIP:        user.CurrentSignInIP,
-		LastSignInAt:           user.LastSignInAt,
-		LastSignInIP:           user.LastSignInIP,
-		SignInCount:            user.SignInCount,
-		InviteID:               user.InviteID,
-		ChosenLanguages:        user.ChosenLanguages,
-		FilteredLanguages:      user.FilteredLanguages,
-		Locale:                 user.Locale,
-		CreatedByApplicationID: user.CreatedByApplicationID,
-		CreatedByApplication:   nil,
-		LastEmailedAt:          user.LastEmailedAt,
-		ConfirmationToken:      user.ConfirmationToken,
-		ConfirmationSentAt:     user.ConfirmationSentAt,
-		ConfirmedAt:            user.ConfirmedAt,
-		UnconfirmedEmail:       user.UnconfirmedEmail,
-		Moderator:              copyBoolPtr(user.Moderator),
-		Admin:                  copyBoolPtr(user.Admin),
-		Disabled:               copyBoolPtr(user.Disabled),
-		Approved:               copyBoolPtr(user.Approved),
-		ResetPasswordToken:     user.ResetPasswordToken,
-		ResetPasswordSentAt:    user.ResetPasswordSentAt,
+		ID:                      user.ID,
+		CreatedAt:               user.CreatedAt,
+		UpdatedAt:               user.UpdatedAt,
+		Email:                   user.Email,
+		AccountID:               user.AccountID,
+		Account:                 nil,
+		EncryptedPassword:       user.EncryptedPassword,
+		SignUpIP:                user.SignUpIP,
+		CurrentSignInAt:         user.CurrentSignInAt,
+		CurrentSignInIP:         user.CurrentSignInIP,
+		LastSignInAt:            user.LastSignInAt,
+		LastSignInIP:            user.LastSignInIP,
+		SignInCount:             user.SignInCount,
+		InviteID:                user.InviteID,
+		ChosenLanguages:         user.ChosenLanguages,
+		FilteredLanguages:       user.FilteredLanguages,
+		Locale:                  user.Locale,
+		CreatedByApplicationID:  user.CreatedByApplicationID,
+		CreatedByApplication:    nil,
+		LastEmailedAt:           user.LastEmailedAt,
+		ConfirmationToken:       user.ConfirmationToken,
+		ConfirmationSentAt:      user.ConfirmationSentAt,
+		ConfirmedAt:             user.ConfirmedAt,
+		UnconfirmedEmail:        user.UnconfirmedEmail,
+		Moderator:               copyBoolPtr(user.Moderator),
+		Admin:                   copyBoolPtr(user.Admin),
+		Disabled:                copyBoolPtr(user.Disabled),
+		Approved:                copyBoolPtr(user.Approved),
+		ResetPasswordToken:      user.ResetPasswordToken,
+		ResetPasswordSentAt:     user.ResetPasswordSentAt,
+		PostByMailToken:         user.PostByMailToken,
+		PostByMailApplicationID: user.PostByMailApplicationID,
 	}
 }
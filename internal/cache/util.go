@@ -29,3 +29,15 @@ func copyBoolPtr(in *bool) *bool {
 	*b = *in
 	return b
 }
+
+// copyIntPtr returns an int pointer with the same value as the pointer passed into it.
+//
+// Useful when copying things from the cache to a caller.
+func copyIntPtr(in *int) *int {
+	if in == nil {
+		return nil
+	}
+	i := new(int)
+	*i = *in
+	return i
+}
@@ -18,7 +18,11 @@
 
 package config
 
-import "github.com/coreos/go-oidc/v3/oidc"
+import (
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
 
 // Defaults contains a populated Configuration with reasonable defaults. Note that
 // if you use this, you will still need to set Host, and, if desired, ConfigPath.
@@ -44,25 +48,76 @@ var Defaults = Configuration{
 	DbTLSMode:   "disable",
 	DbTLSCACert: "",
 
-	WebTemplateBaseDir: "./web/template/",
-	WebAssetBaseDir:    "./web/assets/",
+	DbReplicaAddresses:     []string{},
+	DbReplicaMaxLagSeconds: 5,
+
+	DbMaintenanceSchedule: "",
+	DbMaintenanceVacuum:   true,
+	DbMaintenanceReindex:  false,
+
+	DbSqliteBusyTimeoutSeconds: 10,
+
+	DbSlowQueryThresholdMilliseconds: 1000,
+
+	DbQueryTimeoutSeconds: 60,
+
+	DbCacheInvalidation: "",
+
+	DbSkipMigrations: false,
+
+	CacheAccountMaxSize:       2000,
+	CacheAccountTTL:           time.Minute * 5,
+	CacheDomainBlockMaxSize:   1000,
+	CacheDomainBlockTTL:       time.Minute * 5,
+	CacheEmojiMaxSize:         2000,
+	CacheEmojiTTL:             time.Minute * 5,
+	CacheEmojiCategoryMaxSize: 100,
+	CacheEmojiCategoryTTL:     time.Minute * 5,
+	CacheMentionMaxSize:       5000,
+	CacheMentionTTL:           time.Minute * 5,
+	CacheNotificationMaxSize:  5000,
+	CacheNotificationTTL:      time.Minute * 5,
+	CacheStatusMaxSize:        5000,
+	CacheStatusTTL:            time.Minute * 5,
+	CacheUserMaxSize:          500,
+	CacheUserTTL:              time.Minute * 5,
+
+	WebTemplateBaseDir:  "./web/template/",
+	WebAssetBaseDir:     "./web/assets/",
+	WebErrorTemplateDir: "",
+	WebI18nDir:          "./web/i18n/",
 
 	InstanceExposePeers:            false,
 	InstanceExposeSuspended:        false,
+	InstanceExposeOutboxes:         true,
 	InstanceDeliverToSharedInboxes: true,
-
-	AccountsRegistrationOpen: true,
-	AccountsApprovalRequired: true,
-	AccountsReasonRequired:   true,
-	AccountsAllowCustomCSS:   false,
-
-	MediaImageMaxSize:        10485760, // 10mb
-	MediaVideoMaxSize:        41943040, // 40mb
-	MediaDescriptionMinChars: 0,
-	MediaDescriptionMaxChars: 500,
-	MediaRemoteCacheDays:     30,
-	MediaEmojiLocalMaxSize:   51200,  // 50kb
-	MediaEmojiRemoteMaxSize:  102400, // 100kb
+	InstanceStatusViewCounts:       false,
+	InstanceLanguage:               "en",
+
+	AccountsRegistrationOpen:           true,
+	AccountsApprovalRequired:           true,
+	AccountsReasonRequired:             true,
+	AccountsAllowCustomCSS:             false,
+	AccountsDisplayNameMaxChars:        100,
+	AccountsNoteMaxChars:               5000,
+	AccountsMaxProfileFields:           4,
+	AccountsForceConsentScopes:         []string{"admin"},
+	AccountsClientSettingsMaxSize:      65536,
+	AccountsSignupLinkDomains:          []string{},
+	AccountsSignupEmailDomains:         []string{},
+	AccountsNotificationsRetentionDays: 0,
+	AccountsFollowRequestExpiryDays:    0,
+
+	MediaImageMaxSize:              10485760, // 10mb
+	MediaVideoMaxSize:              41943040, // 40mb
+	MediaDescriptionMinChars:       0,
+	MediaDescriptionMaxChars:       500,
+	MediaRemoteCacheDays:           30,
+	MediaEmojiLocalMaxSize:         51200,  // 50kb
+	MediaEmojiRemoteMaxSize:        102400, // 100kb
+	MediaHotlinkProtection:         false,
+	MediaHotlinkAllowedDomains:     []string{},
+	MediaCrossOriginResourcePolicy: "",
 
 	StorageBackend:       "local",
 	StorageLocalBasePath: "/gotosocial/storage",
@@ -74,6 +129,7 @@ var Defaults = Configuration{
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesThreadMuteBoosts:   false,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
@@ -94,10 +150,26 @@ var Defaults = Configuration{
 	SMTPPassword: "",
 	SMTPFrom:     "GoToSocial",
 
+	MailGatewayEnabled:    false,
+	MailGatewayDomain:     "",
+	MailGatewaySecret:     "",
+	MailGatewayAuthservID: "",
+
 	SyslogEnabled:  false,
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
-	AdvancedCookiesSamesite:   "lax",
-	AdvancedRateLimitRequests: 1000, // per 5 minutes
+	AdvancedCookiesSamesite:             "lax",
+	AdvancedRateLimitRequests:           1000, // per 5 minutes
+	AdvancedRemoteHostRequestsPerMinute: 120,
+	AdvancedInboxQueueSize:              10000,
+	AdvancedInboxQueueShedSize:          5000,
+	AdvancedInboxMaxBodySize:            1048576, // 1mb
+	AdvancedInboxMaxJSONDepth:           32,
+	AdvancedInboxMaxJSONArrayLength:     1000,
+	AdvancedThreadMaxDepth:              100,
+	AdvancedThreadMaxReplies:            100,
+	AdvancedThreadReplyAncestors:        5,
+	AdvancedBlockedUserAgents:           []string{},
+	AdvancedBlockAIScrapers:             false,
 }
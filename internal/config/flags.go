@@ -51,6 +51,32 @@ func (s *ConfigState) AddGlobalFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(DbDatabaseFlag(), cfg.DbDatabase, fieldtag("DbDatabase", "usage"))
 		cmd.PersistentFlags().String(DbTLSModeFlag(), cfg.DbTLSMode, fieldtag("DbTLSMode", "usage"))
 		cmd.PersistentFlags().String(DbTLSCACertFlag(), cfg.DbTLSCACert, fieldtag("DbTLSCACert", "usage"))
+		cmd.PersistentFlags().StringSlice(DbReplicaAddressesFlag(), cfg.DbReplicaAddresses, fieldtag("DbReplicaAddresses", "usage"))
+		cmd.PersistentFlags().Int(DbReplicaMaxLagSecondsFlag(), cfg.DbReplicaMaxLagSeconds, fieldtag("DbReplicaMaxLagSeconds", "usage"))
+		cmd.PersistentFlags().String(DbMaintenanceScheduleFlag(), cfg.DbMaintenanceSchedule, fieldtag("DbMaintenanceSchedule", "usage"))
+		cmd.PersistentFlags().Bool(DbMaintenanceVacuumFlag(), cfg.DbMaintenanceVacuum, fieldtag("DbMaintenanceVacuum", "usage"))
+		cmd.PersistentFlags().Bool(DbMaintenanceReindexFlag(), cfg.DbMaintenanceReindex, fieldtag("DbMaintenanceReindex", "usage"))
+		cmd.PersistentFlags().Int(DbSqliteBusyTimeoutSecondsFlag(), cfg.DbSqliteBusyTimeoutSeconds, fieldtag("DbSqliteBusyTimeoutSeconds", "usage"))
+		cmd.PersistentFlags().Int(DbSlowQueryThresholdMillisecondsFlag(), cfg.DbSlowQueryThresholdMilliseconds, fieldtag("DbSlowQueryThresholdMilliseconds", "usage"))
+		cmd.PersistentFlags().Int(DbQueryTimeoutSecondsFlag(), cfg.DbQueryTimeoutSeconds, fieldtag("DbQueryTimeoutSeconds", "usage"))
+		cmd.PersistentFlags().String(DbCacheInvalidationFlag(), cfg.DbCacheInvalidation, fieldtag("DbCacheInvalidation", "usage"))
+		cmd.PersistentFlags().Bool(DbSkipMigrationsFlag(), cfg.DbSkipMigrations, fieldtag("DbSkipMigrations", "usage"))
+		cmd.PersistentFlags().Int(CacheAccountMaxSizeFlag(), cfg.CacheAccountMaxSize, fieldtag("CacheAccountMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheAccountTTLFlag(), cfg.CacheAccountTTL, fieldtag("CacheAccountTTL", "usage"))
+		cmd.PersistentFlags().Int(CacheDomainBlockMaxSizeFlag(), cfg.CacheDomainBlockMaxSize, fieldtag("CacheDomainBlockMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheDomainBlockTTLFlag(), cfg.CacheDomainBlockTTL, fieldtag("CacheDomainBlockTTL", "usage"))
+		cmd.PersistentFlags().Int(CacheEmojiMaxSizeFlag(), cfg.CacheEmojiMaxSize, fieldtag("CacheEmojiMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheEmojiTTLFlag(), cfg.CacheEmojiTTL, fieldtag("CacheEmojiTTL", "usage"))
+		cmd.PersistentFlags().Int(CacheEmojiCategoryMaxSizeFlag(), cfg.CacheEmojiCategoryMaxSize, fieldtag("CacheEmojiCategoryMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheEmojiCategoryTTLFlag(), cfg.CacheEmojiCategoryTTL, fieldtag("CacheEmojiCategoryTTL", "usage"))
+		cmd.PersistentFlags().Int(CacheMentionMaxSizeFlag(), cfg.CacheMentionMaxSize, fieldtag("CacheMentionMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheMentionTTLFlag(), cfg.CacheMentionTTL, fieldtag("CacheMentionTTL", "usage"))
+		cmd.PersistentFlags().Int(CacheNotificationMaxSizeFlag(), cfg.CacheNotificationMaxSize, fieldtag("CacheNotificationMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheNotificationTTLFlag(), cfg.CacheNotificationTTL, fieldtag("CacheNotificationTTL", "usage"))
+		cmd.PersistentFlags().Int(CacheStatusMaxSizeFlag(), cfg.CacheStatusMaxSize, fieldtag("CacheStatusMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheStatusTTLFlag(), cfg.CacheStatusTTL, fieldtag("CacheStatusTTL", "usage"))
+		cmd.PersistentFlags().Int(CacheUserMaxSizeFlag(), cfg.CacheUserMaxSize, fieldtag("CacheUserMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(CacheUserTTLFlag(), cfg.CacheUserTTL, fieldtag("CacheUserTTL", "usage"))
 	})
 }
 
@@ -70,17 +96,31 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		// Template
 		cmd.Flags().String(WebTemplateBaseDirFlag(), cfg.WebTemplateBaseDir, fieldtag("WebTemplateBaseDir", "usage"))
 		cmd.Flags().String(WebAssetBaseDirFlag(), cfg.WebAssetBaseDir, fieldtag("WebAssetBaseDir", "usage"))
+		cmd.Flags().String(WebErrorTemplateDirFlag(), cfg.WebErrorTemplateDir, fieldtag("WebErrorTemplateDir", "usage"))
+		cmd.Flags().String(WebI18nDirFlag(), cfg.WebI18nDir, fieldtag("WebI18nDir", "usage"))
 
 		// Instance
 		cmd.Flags().Bool(InstanceExposePeersFlag(), cfg.InstanceExposePeers, fieldtag("InstanceExposePeers", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
+		cmd.Flags().Bool(InstanceExposeOutboxesFlag(), cfg.InstanceExposeOutboxes, fieldtag("InstanceExposeOutboxes", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
+		cmd.Flags().Bool(InstanceStatusViewCountsFlag(), cfg.InstanceStatusViewCounts, fieldtag("InstanceStatusViewCounts", "usage"))
+		cmd.Flags().String(InstanceLanguageFlag(), cfg.InstanceLanguage, fieldtag("InstanceLanguage", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsApprovalRequiredFlag(), cfg.AccountsApprovalRequired, fieldtag("AccountsApprovalRequired", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Int(AccountsDisplayNameMaxCharsFlag(), cfg.AccountsDisplayNameMaxChars, fieldtag("AccountsDisplayNameMaxChars", "usage"))
+		cmd.Flags().Int(AccountsNoteMaxCharsFlag(), cfg.AccountsNoteMaxChars, fieldtag("AccountsNoteMaxChars", "usage"))
+		cmd.Flags().Int(AccountsMaxProfileFieldsFlag(), cfg.AccountsMaxProfileFields, fieldtag("AccountsMaxProfileFields", "usage"))
+		cmd.Flags().StringSlice(AccountsForceConsentScopesFlag(), cfg.AccountsForceConsentScopes, fieldtag("AccountsForceConsentScopes", "usage"))
+		cmd.Flags().Int(AccountsClientSettingsMaxSizeFlag(), cfg.AccountsClientSettingsMaxSize, fieldtag("AccountsClientSettingsMaxSize", "usage"))
+		cmd.Flags().StringSlice(AccountsSignupLinkDomainsFlag(), cfg.AccountsSignupLinkDomains, fieldtag("AccountsSignupLinkDomains", "usage"))
+		cmd.Flags().StringSlice(AccountsSignupEmailDomainsFlag(), cfg.AccountsSignupEmailDomains, fieldtag("AccountsSignupEmailDomains", "usage"))
+		cmd.Flags().Int(AccountsNotificationsRetentionDaysFlag(), cfg.AccountsNotificationsRetentionDays, fieldtag("AccountsNotificationsRetentionDays", "usage"))
+		cmd.Flags().Int(AccountsFollowRequestExpiryDaysFlag(), cfg.AccountsFollowRequestExpiryDays, fieldtag("AccountsFollowRequestExpiryDays", "usage"))
 
 		// Media
 		cmd.Flags().Uint64(MediaImageMaxSizeFlag(), uint64(cfg.MediaImageMaxSize), fieldtag("MediaImageMaxSize", "usage"))
@@ -90,6 +130,9 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Int(MediaRemoteCacheDaysFlag(), cfg.MediaRemoteCacheDays, fieldtag("MediaRemoteCacheDays", "usage"))
 		cmd.Flags().Uint64(MediaEmojiLocalMaxSizeFlag(), uint64(cfg.MediaEmojiLocalMaxSize), fieldtag("MediaEmojiLocalMaxSize", "usage"))
 		cmd.Flags().Uint64(MediaEmojiRemoteMaxSizeFlag(), uint64(cfg.MediaEmojiRemoteMaxSize), fieldtag("MediaEmojiRemoteMaxSize", "usage"))
+		cmd.Flags().Bool(MediaHotlinkProtectionFlag(), cfg.MediaHotlinkProtection, fieldtag("MediaHotlinkProtection", "usage"))
+		cmd.Flags().StringSlice(MediaHotlinkAllowedDomainsFlag(), cfg.MediaHotlinkAllowedDomains, fieldtag("MediaHotlinkAllowedDomains", "usage"))
+		cmd.Flags().String(MediaCrossOriginResourcePolicyFlag(), cfg.MediaCrossOriginResourcePolicy, fieldtag("MediaCrossOriginResourcePolicy", "usage"))
 
 		// Storage
 		cmd.Flags().String(StorageBackendFlag(), cfg.StorageBackend, fieldtag("StorageBackend", "usage"))
@@ -101,6 +144,7 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Int(StatusesPollMaxOptionsFlag(), cfg.StatusesPollMaxOptions, fieldtag("StatusesPollMaxOptions", "usage"))
 		cmd.Flags().Int(StatusesPollOptionMaxCharsFlag(), cfg.StatusesPollOptionMaxChars, fieldtag("StatusesPollOptionMaxChars", "usage"))
 		cmd.Flags().Int(StatusesMediaMaxFilesFlag(), cfg.StatusesMediaMaxFiles, fieldtag("StatusesMediaMaxFiles", "usage"))
+		cmd.Flags().Bool(StatusesThreadMuteBoostsFlag(), cfg.StatusesThreadMuteBoosts, fieldtag("StatusesThreadMuteBoosts", "usage"))
 
 		// LetsEncrypt
 		cmd.Flags().Bool(LetsEncryptEnabledFlag(), cfg.LetsEncryptEnabled, fieldtag("LetsEncryptEnabled", "usage"))
@@ -124,6 +168,12 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SMTPPasswordFlag(), cfg.SMTPPassword, fieldtag("SMTPPassword", "usage"))
 		cmd.Flags().String(SMTPFromFlag(), cfg.SMTPFrom, fieldtag("SMTPFrom", "usage"))
 
+		// Mail gateway
+		cmd.Flags().Bool(MailGatewayEnabledFlag(), cfg.MailGatewayEnabled, fieldtag("MailGatewayEnabled", "usage"))
+		cmd.Flags().String(MailGatewayDomainFlag(), cfg.MailGatewayDomain, fieldtag("MailGatewayDomain", "usage"))
+		cmd.Flags().String(MailGatewaySecretFlag(), cfg.MailGatewaySecret, fieldtag("MailGatewaySecret", "usage"))
+		cmd.Flags().String(MailGatewayAuthservIDFlag(), cfg.MailGatewayAuthservID, fieldtag("MailGatewayAuthservID", "usage"))
+
 		// Syslog
 		cmd.Flags().Bool(SyslogEnabledFlag(), cfg.SyslogEnabled, fieldtag("SyslogEnabled", "usage"))
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
@@ -132,6 +182,17 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		// Advanced flags
 		cmd.Flags().String(AdvancedCookiesSamesiteFlag(), cfg.AdvancedCookiesSamesite, fieldtag("AdvancedCookiesSamesite", "usage"))
 		cmd.Flags().Int(AdvancedRateLimitRequestsFlag(), cfg.AdvancedRateLimitRequests, fieldtag("AdvancedRateLimitRequests", "usage"))
+		cmd.Flags().Int(AdvancedRemoteHostRequestsPerMinuteFlag(), cfg.AdvancedRemoteHostRequestsPerMinute, fieldtag("AdvancedRemoteHostRequestsPerMinute", "usage"))
+		cmd.Flags().Int(AdvancedInboxQueueSizeFlag(), cfg.AdvancedInboxQueueSize, fieldtag("AdvancedInboxQueueSize", "usage"))
+		cmd.Flags().Int(AdvancedInboxQueueShedSizeFlag(), cfg.AdvancedInboxQueueShedSize, fieldtag("AdvancedInboxQueueShedSize", "usage"))
+		cmd.Flags().Uint64(AdvancedInboxMaxBodySizeFlag(), uint64(cfg.AdvancedInboxMaxBodySize), fieldtag("AdvancedInboxMaxBodySize", "usage"))
+		cmd.Flags().Int(AdvancedInboxMaxJSONDepthFlag(), cfg.AdvancedInboxMaxJSONDepth, fieldtag("AdvancedInboxMaxJSONDepth", "usage"))
+		cmd.Flags().Int(AdvancedInboxMaxJSONArrayLengthFlag(), cfg.AdvancedInboxMaxJSONArrayLength, fieldtag("AdvancedInboxMaxJSONArrayLength", "usage"))
+		cmd.Flags().Int(AdvancedThreadMaxDepthFlag(), cfg.AdvancedThreadMaxDepth, fieldtag("AdvancedThreadMaxDepth", "usage"))
+		cmd.Flags().Int(AdvancedThreadMaxRepliesFlag(), cfg.AdvancedThreadMaxReplies, fieldtag("AdvancedThreadMaxReplies", "usage"))
+		cmd.Flags().Int(AdvancedThreadReplyAncestorsFlag(), cfg.AdvancedThreadReplyAncestors, fieldtag("AdvancedThreadReplyAncestors", "usage"))
+		cmd.Flags().StringSlice(AdvancedBlockedUserAgentsFlag(), cfg.AdvancedBlockedUserAgents, fieldtag("AdvancedBlockedUserAgents", "usage"))
+		cmd.Flags().Bool(AdvancedBlockAIScrapersFlag(), cfg.AdvancedBlockAIScrapers, fieldtag("AdvancedBlockAIScrapers", "usage"))
 	})
 }
 
@@ -178,3 +239,65 @@ func AddAdminTrans(cmd *cobra.Command) {
 		panic(err)
 	}
 }
+
+// AddAdminEmoji attaches flags pertaining to emoji pack import/export commands.
+func AddAdminEmoji(cmd *cobra.Command) {
+	AddAdminTrans(cmd)
+
+	name := AdminEmojiCategoryFlag()
+	usage := fieldtag("AdminEmojiCategory", "usage")
+	cmd.Flags().String(name, "", usage)
+}
+
+// AddAdminEmojiAdd attaches flags pertaining to the emoji directory add command.
+func AddAdminEmojiAdd(cmd *cobra.Command) {
+	name := AdminEmojiDirFlag()
+	usage := fieldtag("AdminEmojiDir", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+
+	name = AdminEmojiCategoryFlag()
+	usage = fieldtag("AdminEmojiCategory", "usage")
+	cmd.Flags().String(name, "", usage)
+
+	name = AdminEmojiOnConflictFlag()
+	usage = fieldtag("AdminEmojiOnConflict", "usage")
+	cmd.Flags().String(name, "skip", usage)
+
+	name = AdminEmojiDryRunFlag()
+	usage = fieldtag("AdminEmojiDryRun", "usage")
+	cmd.Flags().Bool(name, false, usage)
+}
+
+// AddAdminDomainRename attaches flags pertaining to the domain rename command.
+func AddAdminDomainRename(cmd *cobra.Command) {
+	name := AdminDomainOldHostFlag()
+	usage := fieldtag("AdminDomainOldHost", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+
+	name = AdminDomainRiskOKFlag()
+	usage = fieldtag("AdminDomainRiskOK", "usage")
+	cmd.Flags().Bool(name, false, usage)
+}
+
+// AddAdminIDs attaches flags pertaining to the id audit and resequence commands.
+func AddAdminIDs(cmd *cobra.Command) {
+	name := AdminIDsToleranceFlag()
+	usage := fieldtag("AdminIDsTolerance", "usage")
+	cmd.Flags().Int(name, 60, usage)
+}
+
+// AddAdminMigrationsMarkApplied attaches flags pertaining to the migrations mark-applied command.
+func AddAdminMigrationsMarkApplied(cmd *cobra.Command) {
+	name := AdminMigrationNameFlag()
+	usage := fieldtag("AdminMigrationName", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+}
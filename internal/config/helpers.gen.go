@@ -18,7 +18,11 @@
 */
 package config
 
-import "codeberg.org/gruf/go-bytesize"
+import (
+	"time"
+
+	"codeberg.org/gruf/go-bytesize"
+)
 
 // GetLogLevel safely fetches the Configuration value for state's 'LogLevel' field
 func (st *ConfigState) GetLogLevel() (v string) {
@@ -520,1377 +524,3058 @@ func GetDbTLSCACert() string { return global.GetDbTLSCACert() }
 // SetDbTLSCACert safely sets the value for global configuration 'DbTLSCACert' field
 func SetDbTLSCACert(v string) { global.SetDbTLSCACert(v) }
 
-// GetWebTemplateBaseDir safely fetches the Configuration value for state's 'WebTemplateBaseDir' field
-func (st *ConfigState) GetWebTemplateBaseDir() (v string) {
+// GetDbReplicaAddresses safely fetches the Configuration value for state's 'DbReplicaAddresses' field
+func (st *ConfigState) GetDbReplicaAddresses() (v []string) {
 	st.mutex.Lock()
-	v = st.config.WebTemplateBaseDir
+	v = st.config.DbReplicaAddresses
 	st.mutex.Unlock()
 	return
 }
 
-// SetWebTemplateBaseDir safely sets the Configuration value for state's 'WebTemplateBaseDir' field
-func (st *ConfigState) SetWebTemplateBaseDir(v string) {
+// SetDbReplicaAddresses safely sets the Configuration value for state's 'DbReplicaAddresses' field
+func (st *ConfigState) SetDbReplicaAddresses(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.WebTemplateBaseDir = v
+	st.config.DbReplicaAddresses = v
 	st.reloadToViper()
 }
 
-// WebTemplateBaseDirFlag returns the flag name for the 'WebTemplateBaseDir' field
-func WebTemplateBaseDirFlag() string { return "web-template-base-dir" }
+// DbReplicaAddressesFlag returns the flag name for the 'DbReplicaAddresses' field
+func DbReplicaAddressesFlag() string { return "db-replica-addresses" }
 
-// GetWebTemplateBaseDir safely fetches the value for global configuration 'WebTemplateBaseDir' field
-func GetWebTemplateBaseDir() string { return global.GetWebTemplateBaseDir() }
+// GetDbReplicaAddresses safely fetches the value for global configuration 'DbReplicaAddresses' field
+func GetDbReplicaAddresses() []string { return global.GetDbReplicaAddresses() }
 
-// SetWebTemplateBaseDir safely sets the value for global configuration 'WebTemplateBaseDir' field
-func SetWebTemplateBaseDir(v string) { global.SetWebTemplateBaseDir(v) }
+// SetDbReplicaAddresses safely sets the value for global configuration 'DbReplicaAddresses' field
+func SetDbReplicaAddresses(v []string) { global.SetDbReplicaAddresses(v) }
 
-// GetWebAssetBaseDir safely fetches the Configuration value for state's 'WebAssetBaseDir' field
-func (st *ConfigState) GetWebAssetBaseDir() (v string) {
+// GetDbReplicaMaxLagSeconds safely fetches the Configuration value for state's 'DbReplicaMaxLagSeconds' field
+func (st *ConfigState) GetDbReplicaMaxLagSeconds() (v int) {
 	st.mutex.Lock()
-	v = st.config.WebAssetBaseDir
+	v = st.config.DbReplicaMaxLagSeconds
 	st.mutex.Unlock()
 	return
 }
 
-// SetWebAssetBaseDir safely sets the Configuration value for state's 'WebAssetBaseDir' field
-func (st *ConfigState) SetWebAssetBaseDir(v string) {
+// SetDbReplicaMaxLagSeconds safely sets the Configuration value for state's 'DbReplicaMaxLagSeconds' field
+func (st *ConfigState) SetDbReplicaMaxLagSeconds(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.WebAssetBaseDir = v
+	st.config.DbReplicaMaxLagSeconds = v
 	st.reloadToViper()
 }
 
-// WebAssetBaseDirFlag returns the flag name for the 'WebAssetBaseDir' field
-func WebAssetBaseDirFlag() string { return "web-asset-base-dir" }
+// DbReplicaMaxLagSecondsFlag returns the flag name for the 'DbReplicaMaxLagSeconds' field
+func DbReplicaMaxLagSecondsFlag() string { return "db-replica-max-lag-seconds" }
 
-// GetWebAssetBaseDir safely fetches the value for global configuration 'WebAssetBaseDir' field
-func GetWebAssetBaseDir() string { return global.GetWebAssetBaseDir() }
+// GetDbReplicaMaxLagSeconds safely fetches the value for global configuration 'DbReplicaMaxLagSeconds' field
+func GetDbReplicaMaxLagSeconds() int { return global.GetDbReplicaMaxLagSeconds() }
 
-// SetWebAssetBaseDir safely sets the value for global configuration 'WebAssetBaseDir' field
-func SetWebAssetBaseDir(v string) { global.SetWebAssetBaseDir(v) }
+// SetDbReplicaMaxLagSeconds safely sets the value for global configuration 'DbReplicaMaxLagSeconds' field
+func SetDbReplicaMaxLagSeconds(v int) { global.SetDbReplicaMaxLagSeconds(v) }
 
-// GetInstanceExposePeers safely fetches the Configuration value for state's 'InstanceExposePeers' field
-func (st *ConfigState) GetInstanceExposePeers() (v bool) {
+// GetDbMaintenanceSchedule safely fetches the Configuration value for state's 'DbMaintenanceSchedule' field
+func (st *ConfigState) GetDbMaintenanceSchedule() (v string) {
 	st.mutex.Lock()
-	v = st.config.InstanceExposePeers
+	v = st.config.DbMaintenanceSchedule
 	st.mutex.Unlock()
 	return
 }
 
-// SetInstanceExposePeers safely sets the Configuration value for state's 'InstanceExposePeers' field
-func (st *ConfigState) SetInstanceExposePeers(v bool) {
+// SetDbMaintenanceSchedule safely sets the Configuration value for state's 'DbMaintenanceSchedule' field
+func (st *ConfigState) SetDbMaintenanceSchedule(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.InstanceExposePeers = v
+	st.config.DbMaintenanceSchedule = v
 	st.reloadToViper()
 }
 
-// InstanceExposePeersFlag returns the flag name for the 'InstanceExposePeers' field
-func InstanceExposePeersFlag() string { return "instance-expose-peers" }
+// DbMaintenanceScheduleFlag returns the flag name for the 'DbMaintenanceSchedule' field
+func DbMaintenanceScheduleFlag() string { return "db-maintenance-schedule" }
 
-// GetInstanceExposePeers safely fetches the value for global configuration 'InstanceExposePeers' field
-func GetInstanceExposePeers() bool { return global.GetInstanceExposePeers() }
+// GetDbMaintenanceSchedule safely fetches the value for global configuration 'DbMaintenanceSchedule' field
+func GetDbMaintenanceSchedule() string { return global.GetDbMaintenanceSchedule() }
 
-// SetInstanceExposePeers safely sets the value for global configuration 'InstanceExposePeers' field
-func SetInstanceExposePeers(v bool) { global.SetInstanceExposePeers(v) }
+// SetDbMaintenanceSchedule safely sets the value for global configuration 'DbMaintenanceSchedule' field
+func SetDbMaintenanceSchedule(v string) { global.SetDbMaintenanceSchedule(v) }
 
-// GetInstanceExposeSuspended safely fetches the Configuration value for state's 'InstanceExposeSuspended' field
-func (st *ConfigState) GetInstanceExposeSuspended() (v bool) {
+// GetDbMaintenanceVacuum safely fetches the Configuration value for state's 'DbMaintenanceVacuum' field
+func (st *ConfigState) GetDbMaintenanceVacuum() (v bool) {
 	st.mutex.Lock()
-	v = st.config.InstanceExposeSuspended
+	v = st.config.DbMaintenanceVacuum
 	st.mutex.Unlock()
 	return
 }
 
-// SetInstanceExposeSuspended safely sets the Configuration value for state's 'InstanceExposeSuspended' field
-func (st *ConfigState) SetInstanceExposeSuspended(v bool) {
+// SetDbMaintenanceVacuum safely sets the Configuration value for state's 'DbMaintenanceVacuum' field
+func (st *ConfigState) SetDbMaintenanceVacuum(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.InstanceExposeSuspended = v
+	st.config.DbMaintenanceVacuum = v
 	st.reloadToViper()
 }
 
-// InstanceExposeSuspendedFlag returns the flag name for the 'InstanceExposeSuspended' field
-func InstanceExposeSuspendedFlag() string { return "instance-expose-suspended" }
+// DbMaintenanceVacuumFlag returns the flag name for the 'DbMaintenanceVacuum' field
+func DbMaintenanceVacuumFlag() string { return "db-maintenance-vacuum" }
 
-// GetInstanceExposeSuspended safely fetches the value for global configuration 'InstanceExposeSuspended' field
-func GetInstanceExposeSuspended() bool { return global.GetInstanceExposeSuspended() }
+// GetDbMaintenanceVacuum safely fetches the value for global configuration 'DbMaintenanceVacuum' field
+func GetDbMaintenanceVacuum() bool { return global.GetDbMaintenanceVacuum() }
 
-// SetInstanceExposeSuspended safely sets the value for global configuration 'InstanceExposeSuspended' field
-func SetInstanceExposeSuspended(v bool) { global.SetInstanceExposeSuspended(v) }
+// SetDbMaintenanceVacuum safely sets the value for global configuration 'DbMaintenanceVacuum' field
+func SetDbMaintenanceVacuum(v bool) { global.SetDbMaintenanceVacuum(v) }
 
-// GetInstanceExposePublicTimeline safely fetches the Configuration value for state's 'InstanceExposePublicTimeline' field
-func (st *ConfigState) GetInstanceExposePublicTimeline() (v bool) {
+// GetDbMaintenanceReindex safely fetches the Configuration value for state's 'DbMaintenanceReindex' field
+func (st *ConfigState) GetDbMaintenanceReindex() (v bool) {
 	st.mutex.Lock()
-	v = st.config.InstanceExposePublicTimeline
+	v = st.config.DbMaintenanceReindex
 	st.mutex.Unlock()
 	return
 }
 
-// SetInstanceExposePublicTimeline safely sets the Configuration value for state's 'InstanceExposePublicTimeline' field
-func (st *ConfigState) SetInstanceExposePublicTimeline(v bool) {
+// SetDbMaintenanceReindex safely sets the Configuration value for state's 'DbMaintenanceReindex' field
+func (st *ConfigState) SetDbMaintenanceReindex(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.InstanceExposePublicTimeline = v
+	st.config.DbMaintenanceReindex = v
 	st.reloadToViper()
 }
 
-// InstanceExposePublicTimelineFlag returns the flag name for the 'InstanceExposePublicTimeline' field
-func InstanceExposePublicTimelineFlag() string { return "instance-expose-public-timeline" }
+// DbMaintenanceReindexFlag returns the flag name for the 'DbMaintenanceReindex' field
+func DbMaintenanceReindexFlag() string { return "db-maintenance-reindex" }
 
-// GetInstanceExposePublicTimeline safely fetches the value for global configuration 'InstanceExposePublicTimeline' field
-func GetInstanceExposePublicTimeline() bool { return global.GetInstanceExposePublicTimeline() }
+// GetDbMaintenanceReindex safely fetches the value for global configuration 'DbMaintenanceReindex' field
+func GetDbMaintenanceReindex() bool { return global.GetDbMaintenanceReindex() }
 
-// SetInstanceExposePublicTimeline safely sets the value for global configuration 'InstanceExposePublicTimeline' field
-func SetInstanceExposePublicTimeline(v bool) { global.SetInstanceExposePublicTimeline(v) }
+// SetDbMaintenanceReindex safely sets the value for global configuration 'DbMaintenanceReindex' field
+func SetDbMaintenanceReindex(v bool) { global.SetDbMaintenanceReindex(v) }
 
-// GetInstanceDeliverToSharedInboxes safely fetches the Configuration value for state's 'InstanceDeliverToSharedInboxes' field
-func (st *ConfigState) GetInstanceDeliverToSharedInboxes() (v bool) {
+// GetDbSqliteBusyTimeoutSeconds safely fetches the Configuration value for state's 'DbSqliteBusyTimeoutSeconds' field
+func (st *ConfigState) GetDbSqliteBusyTimeoutSeconds() (v int) {
 	st.mutex.Lock()
-	v = st.config.InstanceDeliverToSharedInboxes
+	v = st.config.DbSqliteBusyTimeoutSeconds
 	st.mutex.Unlock()
 	return
 }
 
-// SetInstanceDeliverToSharedInboxes safely sets the Configuration value for state's 'InstanceDeliverToSharedInboxes' field
-func (st *ConfigState) SetInstanceDeliverToSharedInboxes(v bool) {
+// SetDbSqliteBusyTimeoutSeconds safely sets the Configuration value for state's 'DbSqliteBusyTimeoutSeconds' field
+func (st *ConfigState) SetDbSqliteBusyTimeoutSeconds(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.InstanceDeliverToSharedInboxes = v
+	st.config.DbSqliteBusyTimeoutSeconds = v
 	st.reloadToViper()
 }
 
-// InstanceDeliverToSharedInboxesFlag returns the flag name for the 'InstanceDeliverToSharedInboxes' field
-func InstanceDeliverToSharedInboxesFlag() string { return "instance-deliver-to-shared-inboxes" }
+// DbSqliteBusyTimeoutSecondsFlag returns the flag name for the 'DbSqliteBusyTimeoutSeconds' field
+func DbSqliteBusyTimeoutSecondsFlag() string { return "db-sqlite-busy-timeout-seconds" }
 
-// GetInstanceDeliverToSharedInboxes safely fetches the value for global configuration 'InstanceDeliverToSharedInboxes' field
-func GetInstanceDeliverToSharedInboxes() bool { return global.GetInstanceDeliverToSharedInboxes() }
+// GetDbSqliteBusyTimeoutSeconds safely fetches the value for global configuration 'DbSqliteBusyTimeoutSeconds' field
+func GetDbSqliteBusyTimeoutSeconds() int { return global.GetDbSqliteBusyTimeoutSeconds() }
 
-// SetInstanceDeliverToSharedInboxes safely sets the value for global configuration 'InstanceDeliverToSharedInboxes' field
-func SetInstanceDeliverToSharedInboxes(v bool) { global.SetInstanceDeliverToSharedInboxes(v) }
+// SetDbSqliteBusyTimeoutSeconds safely sets the value for global configuration 'DbSqliteBusyTimeoutSeconds' field
+func SetDbSqliteBusyTimeoutSeconds(v int) { global.SetDbSqliteBusyTimeoutSeconds(v) }
 
-// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
+// GetDbSlowQueryThresholdMilliseconds safely fetches the Configuration value for state's 'DbSlowQueryThresholdMilliseconds' field
+func (st *ConfigState) GetDbSlowQueryThresholdMilliseconds() (v int) {
 	st.mutex.Lock()
-	v = st.config.AccountsRegistrationOpen
+	v = st.config.DbSlowQueryThresholdMilliseconds
 	st.mutex.Unlock()
 	return
 }
 
-// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
+// SetDbSlowQueryThresholdMilliseconds safely sets the Configuration value for state's 'DbSlowQueryThresholdMilliseconds' field
+func (st *ConfigState) SetDbSlowQueryThresholdMilliseconds(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsRegistrationOpen = v
+	st.config.DbSlowQueryThresholdMilliseconds = v
 	st.reloadToViper()
 }
 
-// AccountsRegistrationOpenFlag returns the flag name for the 'AccountsRegistrationOpen' field
-func AccountsRegistrationOpenFlag() string { return "accounts-registration-open" }
+// DbSlowQueryThresholdMillisecondsFlag returns the flag name for the 'DbSlowQueryThresholdMilliseconds' field
+func DbSlowQueryThresholdMillisecondsFlag() string { return "db-slow-query-threshold-milliseconds" }
 
-// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
-func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
+// GetDbSlowQueryThresholdMilliseconds safely fetches the value for global configuration 'DbSlowQueryThresholdMilliseconds' field
+func GetDbSlowQueryThresholdMilliseconds() int { return global.GetDbSlowQueryThresholdMilliseconds() }
 
-// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
-func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
+// SetDbSlowQueryThresholdMilliseconds safely sets the value for global configuration 'DbSlowQueryThresholdMilliseconds' field
+func SetDbSlowQueryThresholdMilliseconds(v int) { global.SetDbSlowQueryThresholdMilliseconds(v) }
 
-// GetAccountsApprovalRequired safely fetches the Configuration value for state's 'AccountsApprovalRequired' field
-func (st *ConfigState) GetAccountsApprovalRequired() (v bool) {
+// GetDbQueryTimeoutSeconds safely fetches the Configuration value for state's 'DbQueryTimeoutSeconds' field
+func (st *ConfigState) GetDbQueryTimeoutSeconds() (v int) {
 	st.mutex.Lock()
-	v = st.config.AccountsApprovalRequired
+	v = st.config.DbQueryTimeoutSeconds
 	st.mutex.Unlock()
 	return
 }
 
-// SetAccountsApprovalRequired safely sets the Configuration value for state's 'AccountsApprovalRequired' field
-func (st *ConfigState) SetAccountsApprovalRequired(v bool) {
+// SetDbQueryTimeoutSeconds safely sets the Configuration value for state's 'DbQueryTimeoutSeconds' field
+func (st *ConfigState) SetDbQueryTimeoutSeconds(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsApprovalRequired = v
+	st.config.DbQueryTimeoutSeconds = v
 	st.reloadToViper()
 }
 
-// AccountsApprovalRequiredFlag returns the flag name for the 'AccountsApprovalRequired' field
-func AccountsApprovalRequiredFlag() string { return "accounts-approval-required" }
+// DbQueryTimeoutSecondsFlag returns the flag name for the 'DbQueryTimeoutSeconds' field
+func DbQueryTimeoutSecondsFlag() string { return "db-query-timeout-seconds" }
 
-// GetAccountsApprovalRequired safely fetches the value for global configuration 'AccountsApprovalRequired' field
-func GetAccountsApprovalRequired() bool { return global.GetAccountsApprovalRequired() }
+// GetDbQueryTimeoutSeconds safely fetches the value for global configuration 'DbQueryTimeoutSeconds' field
+func GetDbQueryTimeoutSeconds() int { return global.GetDbQueryTimeoutSeconds() }
 
-// SetAccountsApprovalRequired safely sets the value for global configuration 'AccountsApprovalRequired' field
-func SetAccountsApprovalRequired(v bool) { global.SetAccountsApprovalRequired(v) }
+// SetDbQueryTimeoutSeconds safely sets the value for global configuration 'DbQueryTimeoutSeconds' field
+func SetDbQueryTimeoutSeconds(v int) { global.SetDbQueryTimeoutSeconds(v) }
 
-// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
+// GetDbCacheInvalidation safely fetches the Configuration value for state's 'DbCacheInvalidation' field
+func (st *ConfigState) GetDbCacheInvalidation() (v string) {
 	st.mutex.Lock()
-	v = st.config.AccountsReasonRequired
+	v = st.config.DbCacheInvalidation
 	st.mutex.Unlock()
 	return
 }
 
-// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) SetAccountsReasonRequired(v bool) {
+// SetDbCacheInvalidation safely sets the Configuration value for state's 'DbCacheInvalidation' field
+func (st *ConfigState) SetDbCacheInvalidation(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsReasonRequired = v
+	st.config.DbCacheInvalidation = v
 	st.reloadToViper()
 }
 
-// AccountsReasonRequiredFlag returns the flag name for the 'AccountsReasonRequired' field
-func AccountsReasonRequiredFlag() string { return "accounts-reason-required" }
+// DbCacheInvalidationFlag returns the flag name for the 'DbCacheInvalidation' field
+func DbCacheInvalidationFlag() string { return "db-cache-invalidation" }
 
-// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
-func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
+// GetDbCacheInvalidation safely fetches the value for global configuration 'DbCacheInvalidation' field
+func GetDbCacheInvalidation() string { return global.GetDbCacheInvalidation() }
 
-// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
-func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
+// SetDbCacheInvalidation safely sets the value for global configuration 'DbCacheInvalidation' field
+func SetDbCacheInvalidation(v string) { global.SetDbCacheInvalidation(v) }
 
-// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
+// GetDbSkipMigrations safely fetches the Configuration value for state's 'DbSkipMigrations' field
+func (st *ConfigState) GetDbSkipMigrations() (v bool) {
 	st.mutex.Lock()
-	v = st.config.AccountsAllowCustomCSS
+	v = st.config.DbSkipMigrations
 	st.mutex.Unlock()
 	return
 }
 
-// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
+// SetDbSkipMigrations safely sets the Configuration value for state's 'DbSkipMigrations' field
+func (st *ConfigState) SetDbSkipMigrations(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsAllowCustomCSS = v
+	st.config.DbSkipMigrations = v
 	st.reloadToViper()
 }
 
-// AccountsAllowCustomCSSFlag returns the flag name for the 'AccountsAllowCustomCSS' field
-func AccountsAllowCustomCSSFlag() string { return "accounts-allow-custom-css" }
+// DbSkipMigrationsFlag returns the flag name for the 'DbSkipMigrations' field
+func DbSkipMigrationsFlag() string { return "db-skip-migrations" }
 
-// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
-func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
+// GetDbSkipMigrations safely fetches the value for global configuration 'DbSkipMigrations' field
+func GetDbSkipMigrations() bool { return global.GetDbSkipMigrations() }
 
-// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
-func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
+// SetDbSkipMigrations safely sets the value for global configuration 'DbSkipMigrations' field
+func SetDbSkipMigrations(v bool) { global.SetDbSkipMigrations(v) }
 
-// GetMediaImageMaxSize safely fetches the Configuration value for state's 'MediaImageMaxSize' field
-func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
+// GetCacheAccountMaxSize safely fetches the Configuration value for state's 'CacheAccountMaxSize' field
+func (st *ConfigState) GetCacheAccountMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.MediaImageMaxSize
+	v = st.config.CacheAccountMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetMediaImageMaxSize safely sets the Configuration value for state's 'MediaImageMaxSize' field
-func (st *ConfigState) SetMediaImageMaxSize(v bytesize.Size) {
+// SetCacheAccountMaxSize safely sets the Configuration value for state's 'CacheAccountMaxSize' field
+func (st *ConfigState) SetCacheAccountMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaImageMaxSize = v
+	st.config.CacheAccountMaxSize = v
 	st.reloadToViper()
 }
 
-// MediaImageMaxSizeFlag returns the flag name for the 'MediaImageMaxSize' field
-func MediaImageMaxSizeFlag() string { return "media-image-max-size" }
+// CacheAccountMaxSizeFlag returns the flag name for the 'CacheAccountMaxSize' field
+func CacheAccountMaxSizeFlag() string { return "cache-account-max-size" }
 
-// GetMediaImageMaxSize safely fetches the value for global configuration 'MediaImageMaxSize' field
-func GetMediaImageMaxSize() bytesize.Size { return global.GetMediaImageMaxSize() }
+// GetCacheAccountMaxSize safely fetches the value for global configuration 'CacheAccountMaxSize' field
+func GetCacheAccountMaxSize() int { return global.GetCacheAccountMaxSize() }
 
-// SetMediaImageMaxSize safely sets the value for global configuration 'MediaImageMaxSize' field
-func SetMediaImageMaxSize(v bytesize.Size) { global.SetMediaImageMaxSize(v) }
+// SetCacheAccountMaxSize safely sets the value for global configuration 'CacheAccountMaxSize' field
+func SetCacheAccountMaxSize(v int) { global.SetCacheAccountMaxSize(v) }
 
-// GetMediaVideoMaxSize safely fetches the Configuration value for state's 'MediaVideoMaxSize' field
-func (st *ConfigState) GetMediaVideoMaxSize() (v bytesize.Size) {
+// GetCacheAccountTTL safely fetches the Configuration value for state's 'CacheAccountTTL' field
+func (st *ConfigState) GetCacheAccountTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.MediaVideoMaxSize
+	v = st.config.CacheAccountTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetMediaVideoMaxSize safely sets the Configuration value for state's 'MediaVideoMaxSize' field
-func (st *ConfigState) SetMediaVideoMaxSize(v bytesize.Size) {
+// SetCacheAccountTTL safely sets the Configuration value for state's 'CacheAccountTTL' field
+func (st *ConfigState) SetCacheAccountTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaVideoMaxSize = v
+	st.config.CacheAccountTTL = v
 	st.reloadToViper()
 }
 
-// MediaVideoMaxSizeFlag returns the flag name for the 'MediaVideoMaxSize' field
-func MediaVideoMaxSizeFlag() string { return "media-video-max-size" }
+// CacheAccountTTLFlag returns the flag name for the 'CacheAccountTTL' field
+func CacheAccountTTLFlag() string { return "cache-account-ttl" }
 
-// GetMediaVideoMaxSize safely fetches the value for global configuration 'MediaVideoMaxSize' field
-func GetMediaVideoMaxSize() bytesize.Size { return global.GetMediaVideoMaxSize() }
+// GetCacheAccountTTL safely fetches the value for global configuration 'CacheAccountTTL' field
+func GetCacheAccountTTL() time.Duration { return global.GetCacheAccountTTL() }
 
-// SetMediaVideoMaxSize safely sets the value for global configuration 'MediaVideoMaxSize' field
-func SetMediaVideoMaxSize(v bytesize.Size) { global.SetMediaVideoMaxSize(v) }
+// SetCacheAccountTTL safely sets the value for global configuration 'CacheAccountTTL' field
+func SetCacheAccountTTL(v time.Duration) { global.SetCacheAccountTTL(v) }
 
-// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
-func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+// GetCacheDomainBlockMaxSize safely fetches the Configuration value for state's 'CacheDomainBlockMaxSize' field
+func (st *ConfigState) GetCacheDomainBlockMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.MediaDescriptionMinChars
+	v = st.config.CacheDomainBlockMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'MediaDescriptionMinChars' field
-func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+// SetCacheDomainBlockMaxSize safely sets the Configuration value for state's 'CacheDomainBlockMaxSize' field
+func (st *ConfigState) SetCacheDomainBlockMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaDescriptionMinChars = v
+	st.config.CacheDomainBlockMaxSize = v
 	st.reloadToViper()
 }
 
-// MediaDescriptionMinCharsFlag returns the flag name for the 'MediaDescriptionMinChars' field
-func MediaDescriptionMinCharsFlag() string { return "media-description-min-chars" }
+// CacheDomainBlockMaxSizeFlag returns the flag name for the 'CacheDomainBlockMaxSize' field
+func CacheDomainBlockMaxSizeFlag() string { return "cache-domain-block-max-size" }
 
-// GetMediaDescriptionMinChars safely fetches the value for global configuration 'MediaDescriptionMinChars' field
-func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+// GetCacheDomainBlockMaxSize safely fetches the value for global configuration 'CacheDomainBlockMaxSize' field
+func GetCacheDomainBlockMaxSize() int { return global.GetCacheDomainBlockMaxSize() }
 
-// SetMediaDescriptionMinChars safely sets the value for global configuration 'MediaDescriptionMinChars' field
-func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+// SetCacheDomainBlockMaxSize safely sets the value for global configuration 'CacheDomainBlockMaxSize' field
+func SetCacheDomainBlockMaxSize(v int) { global.SetCacheDomainBlockMaxSize(v) }
 
-// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'MediaDescriptionMaxChars' field
-func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+// GetCacheDomainBlockTTL safely fetches the Configuration value for state's 'CacheDomainBlockTTL' field
+func (st *ConfigState) GetCacheDomainBlockTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.MediaDescriptionMaxChars
+	v = st.config.CacheDomainBlockTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'MediaDescriptionMaxChars' field
-func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+// SetCacheDomainBlockTTL safely sets the Configuration value for state's 'CacheDomainBlockTTL' field
+func (st *ConfigState) SetCacheDomainBlockTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaDescriptionMaxChars = v
+	st.config.CacheDomainBlockTTL = v
 	st.reloadToViper()
 }
 
-// MediaDescriptionMaxCharsFlag returns the flag name for the 'MediaDescriptionMaxChars' field
-func MediaDescriptionMaxCharsFlag() string { return "media-description-max-chars" }
+// CacheDomainBlockTTLFlag returns the flag name for the 'CacheDomainBlockTTL' field
+func CacheDomainBlockTTLFlag() string { return "cache-domain-block-ttl" }
 
-// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'MediaDescriptionMaxChars' field
-func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+// GetCacheDomainBlockTTL safely fetches the value for global configuration 'CacheDomainBlockTTL' field
+func GetCacheDomainBlockTTL() time.Duration { return global.GetCacheDomainBlockTTL() }
 
-// SetMediaDescriptionMaxChars safely sets the value for global configuration 'MediaDescriptionMaxChars' field
-func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+// SetCacheDomainBlockTTL safely sets the value for global configuration 'CacheDomainBlockTTL' field
+func SetCacheDomainBlockTTL(v time.Duration) { global.SetCacheDomainBlockTTL(v) }
 
-// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'MediaRemoteCacheDays' field
-func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+// GetCacheEmojiMaxSize safely fetches the Configuration value for state's 'CacheEmojiMaxSize' field
+func (st *ConfigState) GetCacheEmojiMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.MediaRemoteCacheDays
+	v = st.config.CacheEmojiMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'MediaRemoteCacheDays' field
-func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
+// SetCacheEmojiMaxSize safely sets the Configuration value for state's 'CacheEmojiMaxSize' field
+func (st *ConfigState) SetCacheEmojiMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaRemoteCacheDays = v
+	st.config.CacheEmojiMaxSize = v
 	st.reloadToViper()
 }
 
-// MediaRemoteCacheDaysFlag returns the flag name for the 'MediaRemoteCacheDays' field
-func MediaRemoteCacheDaysFlag() string { return "media-remote-cache-days" }
+// CacheEmojiMaxSizeFlag returns the flag name for the 'CacheEmojiMaxSize' field
+func CacheEmojiMaxSizeFlag() string { return "cache-emoji-max-size" }
 
-// GetMediaRemoteCacheDays safely fetches the value for global configuration 'MediaRemoteCacheDays' field
-func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+// GetCacheEmojiMaxSize safely fetches the value for global configuration 'CacheEmojiMaxSize' field
+func GetCacheEmojiMaxSize() int { return global.GetCacheEmojiMaxSize() }
 
-// SetMediaRemoteCacheDays safely sets the value for global configuration 'MediaRemoteCacheDays' field
-func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+// SetCacheEmojiMaxSize safely sets the value for global configuration 'CacheEmojiMaxSize' field
+func SetCacheEmojiMaxSize(v int) { global.SetCacheEmojiMaxSize(v) }
 
-// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'MediaEmojiLocalMaxSize' field
-func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+// GetCacheEmojiTTL safely fetches the Configuration value for state's 'CacheEmojiTTL' field
+func (st *ConfigState) GetCacheEmojiTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.MediaEmojiLocalMaxSize
+	v = st.config.CacheEmojiTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'MediaEmojiLocalMaxSize' field
-func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+// SetCacheEmojiTTL safely sets the Configuration value for state's 'CacheEmojiTTL' field
+func (st *ConfigState) SetCacheEmojiTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaEmojiLocalMaxSize = v
+	st.config.CacheEmojiTTL = v
 	st.reloadToViper()
 }
 
-// MediaEmojiLocalMaxSizeFlag returns the flag name for the 'MediaEmojiLocalMaxSize' field
-func MediaEmojiLocalMaxSizeFlag() string { return "media-emoji-local-max-size" }
+// CacheEmojiTTLFlag returns the flag name for the 'CacheEmojiTTL' field
+func CacheEmojiTTLFlag() string { return "cache-emoji-ttl" }
 
-// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'MediaEmojiLocalMaxSize' field
-func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+// GetCacheEmojiTTL safely fetches the value for global configuration 'CacheEmojiTTL' field
+func GetCacheEmojiTTL() time.Duration { return global.GetCacheEmojiTTL() }
 
-// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'MediaEmojiLocalMaxSize' field
-func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+// SetCacheEmojiTTL safely sets the value for global configuration 'CacheEmojiTTL' field
+func SetCacheEmojiTTL(v time.Duration) { global.SetCacheEmojiTTL(v) }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
-func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+// GetCacheEmojiCategoryMaxSize safely fetches the Configuration value for state's 'CacheEmojiCategoryMaxSize' field
+func (st *ConfigState) GetCacheEmojiCategoryMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.MediaEmojiRemoteMaxSize
+	v = st.config.CacheEmojiCategoryMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
-func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+// SetCacheEmojiCategoryMaxSize safely sets the Configuration value for state's 'CacheEmojiCategoryMaxSize' field
+func (st *ConfigState) SetCacheEmojiCategoryMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaEmojiRemoteMaxSize = v
+	st.config.CacheEmojiCategoryMaxSize = v
 	st.reloadToViper()
 }
 
-// MediaEmojiRemoteMaxSizeFlag returns the flag name for the 'MediaEmojiRemoteMaxSize' field
-func MediaEmojiRemoteMaxSizeFlag() string { return "media-emoji-remote-max-size" }
+// CacheEmojiCategoryMaxSizeFlag returns the flag name for the 'CacheEmojiCategoryMaxSize' field
+func CacheEmojiCategoryMaxSizeFlag() string { return "cache-emoji-category-max-size" }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'MediaEmojiRemoteMaxSize' field
-func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+// GetCacheEmojiCategoryMaxSize safely fetches the value for global configuration 'CacheEmojiCategoryMaxSize' field
+func GetCacheEmojiCategoryMaxSize() int { return global.GetCacheEmojiCategoryMaxSize() }
 
-// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'MediaEmojiRemoteMaxSize' field
-func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+// SetCacheEmojiCategoryMaxSize safely sets the value for global configuration 'CacheEmojiCategoryMaxSize' field
+func SetCacheEmojiCategoryMaxSize(v int) { global.SetCacheEmojiCategoryMaxSize(v) }
 
-// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) GetStorageBackend() (v string) {
+// GetCacheEmojiCategoryTTL safely fetches the Configuration value for state's 'CacheEmojiCategoryTTL' field
+func (st *ConfigState) GetCacheEmojiCategoryTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.StorageBackend
+	v = st.config.CacheEmojiCategoryTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) SetStorageBackend(v string) {
+// SetCacheEmojiCategoryTTL safely sets the Configuration value for state's 'CacheEmojiCategoryTTL' field
+func (st *ConfigState) SetCacheEmojiCategoryTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageBackend = v
+	st.config.CacheEmojiCategoryTTL = v
 	st.reloadToViper()
 }
 
-// StorageBackendFlag returns the flag name for the 'StorageBackend' field
-func StorageBackendFlag() string { return "storage-backend" }
+// CacheEmojiCategoryTTLFlag returns the flag name for the 'CacheEmojiCategoryTTL' field
+func CacheEmojiCategoryTTLFlag() string { return "cache-emoji-category-ttl" }
 
-// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
-func GetStorageBackend() string { return global.GetStorageBackend() }
+// GetCacheEmojiCategoryTTL safely fetches the value for global configuration 'CacheEmojiCategoryTTL' field
+func GetCacheEmojiCategoryTTL() time.Duration { return global.GetCacheEmojiCategoryTTL() }
 
-// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
-func SetStorageBackend(v string) { global.SetStorageBackend(v) }
+// SetCacheEmojiCategoryTTL safely sets the value for global configuration 'CacheEmojiCategoryTTL' field
+func SetCacheEmojiCategoryTTL(v time.Duration) { global.SetCacheEmojiCategoryTTL(v) }
 
-// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) GetStorageLocalBasePath() (v string) {
+// GetCacheMentionMaxSize safely fetches the Configuration value for state's 'CacheMentionMaxSize' field
+func (st *ConfigState) GetCacheMentionMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.StorageLocalBasePath
+	v = st.config.CacheMentionMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) SetStorageLocalBasePath(v string) {
+// SetCacheMentionMaxSize safely sets the Configuration value for state's 'CacheMentionMaxSize' field
+func (st *ConfigState) SetCacheMentionMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageLocalBasePath = v
+	st.config.CacheMentionMaxSize = v
 	st.reloadToViper()
 }
 
-// StorageLocalBasePathFlag returns the flag name for the 'StorageLocalBasePath' field
-func StorageLocalBasePathFlag() string { return "storage-local-base-path" }
+// CacheMentionMaxSizeFlag returns the flag name for the 'CacheMentionMaxSize' field
+func CacheMentionMaxSizeFlag() string { return "cache-mention-max-size" }
 
-// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
-func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
+// GetCacheMentionMaxSize safely fetches the value for global configuration 'CacheMentionMaxSize' field
+func GetCacheMentionMaxSize() int { return global.GetCacheMentionMaxSize() }
 
-// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
-func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
+// SetCacheMentionMaxSize safely sets the value for global configuration 'CacheMentionMaxSize' field
+func SetCacheMentionMaxSize(v int) { global.SetCacheMentionMaxSize(v) }
 
-// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) GetStorageS3Endpoint() (v string) {
+// GetCacheMentionTTL safely fetches the Configuration value for state's 'CacheMentionTTL' field
+func (st *ConfigState) GetCacheMentionTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.StorageS3Endpoint
+	v = st.config.CacheMentionTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) SetStorageS3Endpoint(v string) {
+// SetCacheMentionTTL safely sets the Configuration value for state's 'CacheMentionTTL' field
+func (st *ConfigState) SetCacheMentionTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3Endpoint = v
+	st.config.CacheMentionTTL = v
 	st.reloadToViper()
 }
 
-// StorageS3EndpointFlag returns the flag name for the 'StorageS3Endpoint' field
-func StorageS3EndpointFlag() string { return "storage-s3-endpoint" }
+// CacheMentionTTLFlag returns the flag name for the 'CacheMentionTTL' field
+func CacheMentionTTLFlag() string { return "cache-mention-ttl" }
 
-// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
-func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
+// GetCacheMentionTTL safely fetches the value for global configuration 'CacheMentionTTL' field
+func GetCacheMentionTTL() time.Duration { return global.GetCacheMentionTTL() }
 
-// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
-func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
+// SetCacheMentionTTL safely sets the value for global configuration 'CacheMentionTTL' field
+func SetCacheMentionTTL(v time.Duration) { global.SetCacheMentionTTL(v) }
 
-// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) GetStorageS3AccessKey() (v string) {
+// GetCacheNotificationMaxSize safely fetches the Configuration value for state's 'CacheNotificationMaxSize' field
+func (st *ConfigState) GetCacheNotificationMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.StorageS3AccessKey
+	v = st.config.CacheNotificationMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) SetStorageS3AccessKey(v string) {
+// SetCacheNotificationMaxSize safely sets the Configuration value for state's 'CacheNotificationMaxSize' field
+func (st *ConfigState) SetCacheNotificationMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3AccessKey = v
+	st.config.CacheNotificationMaxSize = v
 	st.reloadToViper()
 }
 
-// StorageS3AccessKeyFlag returns the flag name for the 'StorageS3AccessKey' field
-func StorageS3AccessKeyFlag() string { return "storage-s3-access-key" }
+// CacheNotificationMaxSizeFlag returns the flag name for the 'CacheNotificationMaxSize' field
+func CacheNotificationMaxSizeFlag() string { return "cache-notification-max-size" }
 
-// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
-func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
+// GetCacheNotificationMaxSize safely fetches the value for global configuration 'CacheNotificationMaxSize' field
+func GetCacheNotificationMaxSize() int { return global.GetCacheNotificationMaxSize() }
 
-// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
-func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
+// SetCacheNotificationMaxSize safely sets the value for global configuration 'CacheNotificationMaxSize' field
+func SetCacheNotificationMaxSize(v int) { global.SetCacheNotificationMaxSize(v) }
 
-// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) GetStorageS3SecretKey() (v string) {
+// GetCacheNotificationTTL safely fetches the Configuration value for state's 'CacheNotificationTTL' field
+func (st *ConfigState) GetCacheNotificationTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.StorageS3SecretKey
+	v = st.config.CacheNotificationTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) SetStorageS3SecretKey(v string) {
+// SetCacheNotificationTTL safely sets the Configuration value for state's 'CacheNotificationTTL' field
+func (st *ConfigState) SetCacheNotificationTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3SecretKey = v
+	st.config.CacheNotificationTTL = v
 	st.reloadToViper()
 }
 
-// StorageS3SecretKeyFlag returns the flag name for the 'StorageS3SecretKey' field
-func StorageS3SecretKeyFlag() string { return "storage-s3-secret-key" }
+// CacheNotificationTTLFlag returns the flag name for the 'CacheNotificationTTL' field
+func CacheNotificationTTLFlag() string { return "cache-notification-ttl" }
 
-// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
-func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
+// GetCacheNotificationTTL safely fetches the value for global configuration 'CacheNotificationTTL' field
+func GetCacheNotificationTTL() time.Duration { return global.GetCacheNotificationTTL() }
 
-// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
-func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
+// SetCacheNotificationTTL safely sets the value for global configuration 'CacheNotificationTTL' field
+func SetCacheNotificationTTL(v time.Duration) { global.SetCacheNotificationTTL(v) }
 
-// GetStorageS3UseSSL safely fetches the Configuration value for state's 'StorageS3UseSSL' field
-func (st *ConfigState) GetStorageS3UseSSL() (v bool) {
+// GetCacheStatusMaxSize safely fetches the Configuration value for state's 'CacheStatusMaxSize' field
+func (st *ConfigState) GetCacheStatusMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.StorageS3UseSSL
+	v = st.config.CacheStatusMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageS3UseSSL safely sets the Configuration value for state's 'StorageS3UseSSL' field
-func (st *ConfigState) SetStorageS3UseSSL(v bool) {
+// SetCacheStatusMaxSize safely sets the Configuration value for state's 'CacheStatusMaxSize' field
+func (st *ConfigState) SetCacheStatusMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3UseSSL = v
+	st.config.CacheStatusMaxSize = v
 	st.reloadToViper()
 }
 
-// StorageS3UseSSLFlag returns the flag name for the 'StorageS3UseSSL' field
-func StorageS3UseSSLFlag() string { return "storage-s3-use-ssl" }
+// CacheStatusMaxSizeFlag returns the flag name for the 'CacheStatusMaxSize' field
+func CacheStatusMaxSizeFlag() string { return "cache-status-max-size" }
 
-// GetStorageS3UseSSL safely fetches the value for global configuration 'StorageS3UseSSL' field
-func GetStorageS3UseSSL() bool { return global.GetStorageS3UseSSL() }
+// GetCacheStatusMaxSize safely fetches the value for global configuration 'CacheStatusMaxSize' field
+func GetCacheStatusMaxSize() int { return global.GetCacheStatusMaxSize() }
 
-// SetStorageS3UseSSL safely sets the value for global configuration 'StorageS3UseSSL' field
-func SetStorageS3UseSSL(v bool) { global.SetStorageS3UseSSL(v) }
+// SetCacheStatusMaxSize safely sets the value for global configuration 'CacheStatusMaxSize' field
+func SetCacheStatusMaxSize(v int) { global.SetCacheStatusMaxSize(v) }
 
-// GetStorageS3BucketName safely fetches the Configuration value for state's 'StorageS3BucketName' field
-func (st *ConfigState) GetStorageS3BucketName() (v string) {
+// GetCacheStatusTTL safely fetches the Configuration value for state's 'CacheStatusTTL' field
+func (st *ConfigState) GetCacheStatusTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.StorageS3BucketName
+	v = st.config.CacheStatusTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageS3BucketName safely sets the Configuration value for state's 'StorageS3BucketName' field
-func (st *ConfigState) SetStorageS3BucketName(v string) {
+// SetCacheStatusTTL safely sets the Configuration value for state's 'CacheStatusTTL' field
+func (st *ConfigState) SetCacheStatusTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3BucketName = v
+	st.config.CacheStatusTTL = v
 	st.reloadToViper()
 }
 
-// StorageS3BucketNameFlag returns the flag name for the 'StorageS3BucketName' field
-func StorageS3BucketNameFlag() string { return "storage-s3-bucket" }
+// CacheStatusTTLFlag returns the flag name for the 'CacheStatusTTL' field
+func CacheStatusTTLFlag() string { return "cache-status-ttl" }
 
-// GetStorageS3BucketName safely fetches the value for global configuration 'StorageS3BucketName' field
-func GetStorageS3BucketName() string { return global.GetStorageS3BucketName() }
+// GetCacheStatusTTL safely fetches the value for global configuration 'CacheStatusTTL' field
+func GetCacheStatusTTL() time.Duration { return global.GetCacheStatusTTL() }
 
-// SetStorageS3BucketName safely sets the value for global configuration 'StorageS3BucketName' field
-func SetStorageS3BucketName(v string) { global.SetStorageS3BucketName(v) }
+// SetCacheStatusTTL safely sets the value for global configuration 'CacheStatusTTL' field
+func SetCacheStatusTTL(v time.Duration) { global.SetCacheStatusTTL(v) }
 
-// GetStorageS3Proxy safely fetches the Configuration value for state's 'StorageS3Proxy' field
-func (st *ConfigState) GetStorageS3Proxy() (v bool) {
+// GetCacheUserMaxSize safely fetches the Configuration value for state's 'CacheUserMaxSize' field
+func (st *ConfigState) GetCacheUserMaxSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.StorageS3Proxy
+	v = st.config.CacheUserMaxSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetStorageS3Proxy safely sets the Configuration value for state's 'StorageS3Proxy' field
-func (st *ConfigState) SetStorageS3Proxy(v bool) {
+// SetCacheUserMaxSize safely sets the Configuration value for state's 'CacheUserMaxSize' field
+func (st *ConfigState) SetCacheUserMaxSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3Proxy = v
+	st.config.CacheUserMaxSize = v
 	st.reloadToViper()
 }
 
-// StorageS3ProxyFlag returns the flag name for the 'StorageS3Proxy' field
-func StorageS3ProxyFlag() string { return "storage-s3-proxy" }
+// CacheUserMaxSizeFlag returns the flag name for the 'CacheUserMaxSize' field
+func CacheUserMaxSizeFlag() string { return "cache-user-max-size" }
 
-// GetStorageS3Proxy safely fetches the value for global configuration 'StorageS3Proxy' field
-func GetStorageS3Proxy() bool { return global.GetStorageS3Proxy() }
+// GetCacheUserMaxSize safely fetches the value for global configuration 'CacheUserMaxSize' field
+func GetCacheUserMaxSize() int { return global.GetCacheUserMaxSize() }
 
-// SetStorageS3Proxy safely sets the value for global configuration 'StorageS3Proxy' field
-func SetStorageS3Proxy(v bool) { global.SetStorageS3Proxy(v) }
+// SetCacheUserMaxSize safely sets the value for global configuration 'CacheUserMaxSize' field
+func SetCacheUserMaxSize(v int) { global.SetCacheUserMaxSize(v) }
 
-// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) GetStatusesMaxChars() (v int) {
+// GetCacheUserTTL safely fetches the Configuration value for state's 'CacheUserTTL' field
+func (st *ConfigState) GetCacheUserTTL() (v time.Duration) {
 	st.mutex.Lock()
-	v = st.config.StatusesMaxChars
+	v = st.config.CacheUserTTL
 	st.mutex.Unlock()
 	return
 }
 
-// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) SetStatusesMaxChars(v int) {
+// SetCacheUserTTL safely sets the Configuration value for state's 'CacheUserTTL' field
+func (st *ConfigState) SetCacheUserTTL(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMaxChars = v
+	st.config.CacheUserTTL = v
 	st.reloadToViper()
 }
 
-// StatusesMaxCharsFlag returns the flag name for the 'StatusesMaxChars' field
-func StatusesMaxCharsFlag() string { return "statuses-max-chars" }
+// CacheUserTTLFlag returns the flag name for the 'CacheUserTTL' field
+func CacheUserTTLFlag() string { return "cache-user-ttl" }
 
-// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
-func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
+// GetCacheUserTTL safely fetches the value for global configuration 'CacheUserTTL' field
+func GetCacheUserTTL() time.Duration { return global.GetCacheUserTTL() }
 
-// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
-func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
+// SetCacheUserTTL safely sets the value for global configuration 'CacheUserTTL' field
+func SetCacheUserTTL(v time.Duration) { global.SetCacheUserTTL(v) }
 
-// GetStatusesCWMaxChars safely fetches the Configuration value for state's 'StatusesCWMaxChars' field
-func (st *ConfigState) GetStatusesCWMaxChars() (v int) {
+// GetWebTemplateBaseDir safely fetches the Configuration value for state's 'WebTemplateBaseDir' field
+func (st *ConfigState) GetWebTemplateBaseDir() (v string) {
 	st.mutex.Lock()
-	v = st.config.StatusesCWMaxChars
+	v = st.config.WebTemplateBaseDir
 	st.mutex.Unlock()
 	return
 }
 
-// SetStatusesCWMaxChars safely sets the Configuration value for state's 'StatusesCWMaxChars' field
-func (st *ConfigState) SetStatusesCWMaxChars(v int) {
+// SetWebTemplateBaseDir safely sets the Configuration value for state's 'WebTemplateBaseDir' field
+func (st *ConfigState) SetWebTemplateBaseDir(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesCWMaxChars = v
+	st.config.WebTemplateBaseDir = v
 	st.reloadToViper()
 }
 
-// StatusesCWMaxCharsFlag returns the flag name for the 'StatusesCWMaxChars' field
-func StatusesCWMaxCharsFlag() string { return "statuses-cw-max-chars" }
+// WebTemplateBaseDirFlag returns the flag name for the 'WebTemplateBaseDir' field
+func WebTemplateBaseDirFlag() string { return "web-template-base-dir" }
 
-// GetStatusesCWMaxChars safely fetches the value for global configuration 'StatusesCWMaxChars' field
-func GetStatusesCWMaxChars() int { return global.GetStatusesCWMaxChars() }
+// GetWebTemplateBaseDir safely fetches the value for global configuration 'WebTemplateBaseDir' field
+func GetWebTemplateBaseDir() string { return global.GetWebTemplateBaseDir() }
 
-// SetStatusesCWMaxChars safely sets the value for global configuration 'StatusesCWMaxChars' field
-func SetStatusesCWMaxChars(v int) { global.SetStatusesCWMaxChars(v) }
+// SetWebTemplateBaseDir safely sets the value for global configuration 'WebTemplateBaseDir' field
+func SetWebTemplateBaseDir(v string) { global.SetWebTemplateBaseDir(v) }
 
-// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
+// GetWebAssetBaseDir safely fetches the Configuration value for state's 'WebAssetBaseDir' field
+func (st *ConfigState) GetWebAssetBaseDir() (v string) {
 	st.mutex.Lock()
-	v = st.config.StatusesPollMaxOptions
+	v = st.config.WebAssetBaseDir
 	st.mutex.Unlock()
 	return
 }
 
-// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
+// SetWebAssetBaseDir safely sets the Configuration value for state's 'WebAssetBaseDir' field
+func (st *ConfigState) SetWebAssetBaseDir(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollMaxOptions = v
+	st.config.WebAssetBaseDir = v
 	st.reloadToViper()
 }
 
-// StatusesPollMaxOptionsFlag returns the flag name for the 'StatusesPollMaxOptions' field
-func StatusesPollMaxOptionsFlag() string { return "statuses-poll-max-options" }
+// WebAssetBaseDirFlag returns the flag name for the 'WebAssetBaseDir' field
+func WebAssetBaseDirFlag() string { return "web-asset-base-dir" }
 
-// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
-func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
+// GetWebAssetBaseDir safely fetches the value for global configuration 'WebAssetBaseDir' field
+func GetWebAssetBaseDir() string { return global.GetWebAssetBaseDir() }
 
-// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
-func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
+// SetWebAssetBaseDir safely sets the value for global configuration 'WebAssetBaseDir' field
+func SetWebAssetBaseDir(v string) { global.SetWebAssetBaseDir(v) }
 
-// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
+// GetWebErrorTemplateDir safely fetches the Configuration value for state's 'WebErrorTemplateDir' field
+func (st *ConfigState) GetWebErrorTemplateDir() (v string) {
 	st.mutex.Lock()
-	v = st.config.StatusesPollOptionMaxChars
+	v = st.config.WebErrorTemplateDir
 	st.mutex.Unlock()
 	return
 }
 
-// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
+// SetWebErrorTemplateDir safely sets the Configuration value for state's 'WebErrorTemplateDir' field
+func (st *ConfigState) SetWebErrorTemplateDir(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollOptionMaxChars = v
+	st.config.WebErrorTemplateDir = v
 	st.reloadToViper()
 }
 
-// StatusesPollOptionMaxCharsFlag returns the flag name for the 'StatusesPollOptionMaxChars' field
-func StatusesPollOptionMaxCharsFlag() string { return "statuses-poll-option-max-chars" }
+// WebErrorTemplateDirFlag returns the flag name for the 'WebErrorTemplateDir' field
+func WebErrorTemplateDirFlag() string { return "web-error-template-dir" }
 
-// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
-func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
+// GetWebErrorTemplateDir safely fetches the value for global configuration 'WebErrorTemplateDir' field
+func GetWebErrorTemplateDir() string { return global.GetWebErrorTemplateDir() }
 
-// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
-func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
+// SetWebErrorTemplateDir safely sets the value for global configuration 'WebErrorTemplateDir' field
+func SetWebErrorTemplateDir(v string) { global.SetWebErrorTemplateDir(v) }
 
-// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
+// GetWebI18nDir safely fetches the Configuration value for state's 'WebI18nDir' field
+func (st *ConfigState) GetWebI18nDir() (v string) {
 	st.mutex.Lock()
-	v = st.config.StatusesMediaMaxFiles
+	v = st.config.WebI18nDir
 	st.mutex.Unlock()
 	return
 }
 
-// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
+// SetWebI18nDir safely sets the Configuration value for state's 'WebI18nDir' field
+func (st *ConfigState) SetWebI18nDir(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMediaMaxFiles = v
+	st.config.WebI18nDir = v
 	st.reloadToViper()
 }
 
-// StatusesMediaMaxFilesFlag returns the flag name for the 'StatusesMediaMaxFiles' field
-func StatusesMediaMaxFilesFlag() string { return "statuses-media-max-files" }
+// WebI18nDirFlag returns the flag name for the 'WebI18nDir' field
+func WebI18nDirFlag() string { return "web-i18n-dir" }
 
-// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
-func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
+// GetWebI18nDir safely fetches the value for global configuration 'WebI18nDir' field
+func GetWebI18nDir() string { return global.GetWebI18nDir() }
 
-// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
-func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
+// SetWebI18nDir safely sets the value for global configuration 'WebI18nDir' field
+func SetWebI18nDir(v string) { global.SetWebI18nDir(v) }
 
-// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+// GetInstanceExposePeers safely fetches the Configuration value for state's 'InstanceExposePeers' field
+func (st *ConfigState) GetInstanceExposePeers() (v bool) {
 	st.mutex.Lock()
-	v = st.config.LetsEncryptEnabled
+	v = st.config.InstanceExposePeers
 	st.mutex.Unlock()
 	return
 }
 
-// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+// SetInstanceExposePeers safely sets the Configuration value for state's 'InstanceExposePeers' field
+func (st *ConfigState) SetInstanceExposePeers(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEnabled = v
+	st.config.InstanceExposePeers = v
 	st.reloadToViper()
 }
 
-// LetsEncryptEnabledFlag returns the flag name for the 'LetsEncryptEnabled' field
-func LetsEncryptEnabledFlag() string { return "letsencrypt-enabled" }
+// InstanceExposePeersFlag returns the flag name for the 'InstanceExposePeers' field
+func InstanceExposePeersFlag() string { return "instance-expose-peers" }
 
-// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
-func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+// GetInstanceExposePeers safely fetches the value for global configuration 'InstanceExposePeers' field
+func GetInstanceExposePeers() bool { return global.GetInstanceExposePeers() }
 
-// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
-func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+// SetInstanceExposePeers safely sets the value for global configuration 'InstanceExposePeers' field
+func SetInstanceExposePeers(v bool) { global.SetInstanceExposePeers(v) }
 
-// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) GetLetsEncryptPort() (v int) {
+// GetInstanceExposeSuspended safely fetches the Configuration value for state's 'InstanceExposeSuspended' field
+func (st *ConfigState) GetInstanceExposeSuspended() (v bool) {
 	st.mutex.Lock()
-	v = st.config.LetsEncryptPort
+	v = st.config.InstanceExposeSuspended
 	st.mutex.Unlock()
 	return
 }
 
-// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) SetLetsEncryptPort(v int) {
+// SetInstanceExposeSuspended safely sets the Configuration value for state's 'InstanceExposeSuspended' field
+func (st *ConfigState) SetInstanceExposeSuspended(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptPort = v
+	st.config.InstanceExposeSuspended = v
 	st.reloadToViper()
 }
 
-// LetsEncryptPortFlag returns the flag name for the 'LetsEncryptPort' field
-func LetsEncryptPortFlag() string { return "letsencrypt-port" }
+// InstanceExposeSuspendedFlag returns the flag name for the 'InstanceExposeSuspended' field
+func InstanceExposeSuspendedFlag() string { return "instance-expose-suspended" }
 
-// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
-func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+// GetInstanceExposeSuspended safely fetches the value for global configuration 'InstanceExposeSuspended' field
+func GetInstanceExposeSuspended() bool { return global.GetInstanceExposeSuspended() }
 
-// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
-func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+// SetInstanceExposeSuspended safely sets the value for global configuration 'InstanceExposeSuspended' field
+func SetInstanceExposeSuspended(v bool) { global.SetInstanceExposeSuspended(v) }
 
-// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+// GetInstanceExposeOutboxes safely fetches the Configuration value for state's 'InstanceExposeOutboxes' field
+func (st *ConfigState) GetInstanceExposeOutboxes() (v bool) {
 	st.mutex.Lock()
-	v = st.config.LetsEncryptCertDir
+	v = st.config.InstanceExposeOutboxes
 	st.mutex.Unlock()
 	return
 }
 
-// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+// SetInstanceExposeOutboxes safely sets the Configuration value for state's 'InstanceExposeOutboxes' field
+func (st *ConfigState) SetInstanceExposeOutboxes(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptCertDir = v
+	st.config.InstanceExposeOutboxes = v
 	st.reloadToViper()
 }
 
-// LetsEncryptCertDirFlag returns the flag name for the 'LetsEncryptCertDir' field
-func LetsEncryptCertDirFlag() string { return "letsencrypt-cert-dir" }
+// InstanceExposeOutboxesFlag returns the flag name for the 'InstanceExposeOutboxes' field
+func InstanceExposeOutboxesFlag() string { return "instance-expose-outboxes" }
 
-// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
-func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+// GetInstanceExposeOutboxes safely fetches the value for global configuration 'InstanceExposeOutboxes' field
+func GetInstanceExposeOutboxes() bool { return global.GetInstanceExposeOutboxes() }
 
-// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
-func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+// SetInstanceExposeOutboxes safely sets the value for global configuration 'InstanceExposeOutboxes' field
+func SetInstanceExposeOutboxes(v bool) { global.SetInstanceExposeOutboxes(v) }
 
-// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+// GetInstanceExposePublicTimeline safely fetches the Configuration value for state's 'InstanceExposePublicTimeline' field
+func (st *ConfigState) GetInstanceExposePublicTimeline() (v bool) {
 	st.mutex.Lock()
-	v = st.config.LetsEncryptEmailAddress
+	v = st.config.InstanceExposePublicTimeline
 	st.mutex.Unlock()
 	return
 }
 
-// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+// SetInstanceExposePublicTimeline safely sets the Configuration value for state's 'InstanceExposePublicTimeline' field
+func (st *ConfigState) SetInstanceExposePublicTimeline(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEmailAddress = v
+	st.config.InstanceExposePublicTimeline = v
 	st.reloadToViper()
 }
 
-// LetsEncryptEmailAddressFlag returns the flag name for the 'LetsEncryptEmailAddress' field
-func LetsEncryptEmailAddressFlag() string { return "letsencrypt-email-address" }
+// InstanceExposePublicTimelineFlag returns the flag name for the 'InstanceExposePublicTimeline' field
+func InstanceExposePublicTimelineFlag() string { return "instance-expose-public-timeline" }
 
-// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
-func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+// GetInstanceExposePublicTimeline safely fetches the value for global configuration 'InstanceExposePublicTimeline' field
+func GetInstanceExposePublicTimeline() bool { return global.GetInstanceExposePublicTimeline() }
 
-// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
-func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+// SetInstanceExposePublicTimeline safely sets the value for global configuration 'InstanceExposePublicTimeline' field
+func SetInstanceExposePublicTimeline(v bool) { global.SetInstanceExposePublicTimeline(v) }
 
-// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
-func (st *ConfigState) GetOIDCEnabled() (v bool) {
+// GetInstanceDeliverToSharedInboxes safely fetches the Configuration value for state's 'InstanceDeliverToSharedInboxes' field
+func (st *ConfigState) GetInstanceDeliverToSharedInboxes() (v bool) {
 	st.mutex.Lock()
-	v = st.config.OIDCEnabled
+	v = st.config.InstanceDeliverToSharedInboxes
 	st.mutex.Unlock()
 	return
 }
 
-// SetOIDCEnabled safely sets the Configuration value for state's 'OIDCEnabled' field
-func (st *ConfigState) SetOIDCEnabled(v bool) {
+// SetInstanceDeliverToSharedInboxes safely sets the Configuration value for state's 'InstanceDeliverToSharedInboxes' field
+func (st *ConfigState) SetInstanceDeliverToSharedInboxes(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCEnabled = v
+	st.config.InstanceDeliverToSharedInboxes = v
 	st.reloadToViper()
 }
 
-// OIDCEnabledFlag returns the flag name for the 'OIDCEnabled' field
-func OIDCEnabledFlag() string { return "oidc-enabled" }
+// InstanceDeliverToSharedInboxesFlag returns the flag name for the 'InstanceDeliverToSharedInboxes' field
+func InstanceDeliverToSharedInboxesFlag() string { return "instance-deliver-to-shared-inboxes" }
 
-// GetOIDCEnabled safely fetches the value for global configuration 'OIDCEnabled' field
-func GetOIDCEnabled() bool { return global.GetOIDCEnabled() }
+// GetInstanceDeliverToSharedInboxes safely fetches the value for global configuration 'InstanceDeliverToSharedInboxes' field
+func GetInstanceDeliverToSharedInboxes() bool { return global.GetInstanceDeliverToSharedInboxes() }
 
-// SetOIDCEnabled safely sets the value for global configuration 'OIDCEnabled' field
-func SetOIDCEnabled(v bool) { global.SetOIDCEnabled(v) }
+// SetInstanceDeliverToSharedInboxes safely sets the value for global configuration 'InstanceDeliverToSharedInboxes' field
+func SetInstanceDeliverToSharedInboxes(v bool) { global.SetInstanceDeliverToSharedInboxes(v) }
 
-// GetOIDCIdpName safely fetches the Configuration value for state's 'OIDCIdpName' field
-func (st *ConfigState) GetOIDCIdpName() (v string) {
+// GetInstanceStatusViewCounts safely fetches the Configuration value for state's 'InstanceStatusViewCounts' field
+func (st *ConfigState) GetInstanceStatusViewCounts() (v bool) {
 	st.mutex.Lock()
-	v = st.config.OIDCIdpName
+	v = st.config.InstanceStatusViewCounts
 	st.mutex.Unlock()
 	return
 }
 
-// SetOIDCIdpName safely sets the Configuration value for state's 'OIDCIdpName' field
-func (st *ConfigState) SetOIDCIdpName(v string) {
+// SetInstanceStatusViewCounts safely sets the Configuration value for state's 'InstanceStatusViewCounts' field
+func (st *ConfigState) SetInstanceStatusViewCounts(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCIdpName = v
+	st.config.InstanceStatusViewCounts = v
 	st.reloadToViper()
 }
 
-// OIDCIdpNameFlag returns the flag name for the 'OIDCIdpName' field
-func OIDCIdpNameFlag() string { return "oidc-idp-name" }
+// InstanceStatusViewCountsFlag returns the flag name for the 'InstanceStatusViewCounts' field
+func InstanceStatusViewCountsFlag() string { return "instance-status-view-counts" }
 
-// GetOIDCIdpName safely fetches the value for global configuration 'OIDCIdpName' field
-func GetOIDCIdpName() string { return global.GetOIDCIdpName() }
+// GetInstanceStatusViewCounts safely fetches the value for global configuration 'InstanceStatusViewCounts' field
+func GetInstanceStatusViewCounts() bool { return global.GetInstanceStatusViewCounts() }
+
+// SetInstanceStatusViewCounts safely sets the value for global configuration 'InstanceStatusViewCounts' field
+func SetInstanceStatusViewCounts(v bool) { global.SetInstanceStatusViewCounts(v) }
+
+// GetInstanceLanguage safely fetches the Configuration value for state's 'InstanceLanguage' field
+func (st *ConfigState) GetInstanceLanguage() (v string) {
+	st.mutex.Lock()
+	v = st.config.InstanceLanguage
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceLanguage safely sets the Configuration value for state's 'InstanceLanguage' field
+func (st *ConfigState) SetInstanceLanguage(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceLanguage = v
+	st.reloadToViper()
+}
+
+// InstanceLanguageFlag returns the flag name for the 'InstanceLanguage' field
+func InstanceLanguageFlag() string { return "instance-language" }
+
+// GetInstanceLanguage safely fetches the value for global configuration 'InstanceLanguage' field
+func GetInstanceLanguage() string { return global.GetInstanceLanguage() }
+
+// SetInstanceLanguage safely sets the value for global configuration 'InstanceLanguage' field
+func SetInstanceLanguage(v string) { global.SetInstanceLanguage(v) }
+
+// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
+	st.mutex.Lock()
+	v = st.config.AccountsRegistrationOpen
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsRegistrationOpen = v
+	st.reloadToViper()
+}
+
+// AccountsRegistrationOpenFlag returns the flag name for the 'AccountsRegistrationOpen' field
+func AccountsRegistrationOpenFlag() string { return "accounts-registration-open" }
+
+// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
+func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
+
+// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
+func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
+
+// GetAccountsApprovalRequired safely fetches the Configuration value for state's 'AccountsApprovalRequired' field
+func (st *ConfigState) GetAccountsApprovalRequired() (v bool) {
+	st.mutex.Lock()
+	v = st.config.AccountsApprovalRequired
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsApprovalRequired safely sets the Configuration value for state's 'AccountsApprovalRequired' field
+func (st *ConfigState) SetAccountsApprovalRequired(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsApprovalRequired = v
+	st.reloadToViper()
+}
+
+// AccountsApprovalRequiredFlag returns the flag name for the 'AccountsApprovalRequired' field
+func AccountsApprovalRequiredFlag() string { return "accounts-approval-required" }
+
+// GetAccountsApprovalRequired safely fetches the value for global configuration 'AccountsApprovalRequired' field
+func GetAccountsApprovalRequired() bool { return global.GetAccountsApprovalRequired() }
+
+// SetAccountsApprovalRequired safely sets the value for global configuration 'AccountsApprovalRequired' field
+func SetAccountsApprovalRequired(v bool) { global.SetAccountsApprovalRequired(v) }
+
+// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
+	st.mutex.Lock()
+	v = st.config.AccountsReasonRequired
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) SetAccountsReasonRequired(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsReasonRequired = v
+	st.reloadToViper()
+}
+
+// AccountsReasonRequiredFlag returns the flag name for the 'AccountsReasonRequired' field
+func AccountsReasonRequiredFlag() string { return "accounts-reason-required" }
+
+// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
+func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
+
+// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
+func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
+
+// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
+	st.mutex.Lock()
+	v = st.config.AccountsAllowCustomCSS
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsAllowCustomCSS = v
+	st.reloadToViper()
+}
+
+// AccountsAllowCustomCSSFlag returns the flag name for the 'AccountsAllowCustomCSS' field
+func AccountsAllowCustomCSSFlag() string { return "accounts-allow-custom-css" }
+
+// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
+func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
+
+// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
+func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
+
+// GetAccountsDisplayNameMaxChars safely fetches the Configuration value for state's 'AccountsDisplayNameMaxChars' field
+func (st *ConfigState) GetAccountsDisplayNameMaxChars() (v int) {
+	st.mutex.Lock()
+	v = st.config.AccountsDisplayNameMaxChars
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsDisplayNameMaxChars safely sets the Configuration value for state's 'AccountsDisplayNameMaxChars' field
+func (st *ConfigState) SetAccountsDisplayNameMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsDisplayNameMaxChars = v
+	st.reloadToViper()
+}
+
+// AccountsDisplayNameMaxCharsFlag returns the flag name for the 'AccountsDisplayNameMaxChars' field
+func AccountsDisplayNameMaxCharsFlag() string { return "accounts-display-name-max-chars" }
+
+// GetAccountsDisplayNameMaxChars safely fetches the value for global configuration 'AccountsDisplayNameMaxChars' field
+func GetAccountsDisplayNameMaxChars() int { return global.GetAccountsDisplayNameMaxChars() }
+
+// SetAccountsDisplayNameMaxChars safely sets the value for global configuration 'AccountsDisplayNameMaxChars' field
+func SetAccountsDisplayNameMaxChars(v int) { global.SetAccountsDisplayNameMaxChars(v) }
+
+// GetAccountsNoteMaxChars safely fetches the Configuration value for state's 'AccountsNoteMaxChars' field
+func (st *ConfigState) GetAccountsNoteMaxChars() (v int) {
+	st.mutex.Lock()
+	v = st.config.AccountsNoteMaxChars
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsNoteMaxChars safely sets the Configuration value for state's 'AccountsNoteMaxChars' field
+func (st *ConfigState) SetAccountsNoteMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsNoteMaxChars = v
+	st.reloadToViper()
+}
+
+// AccountsNoteMaxCharsFlag returns the flag name for the 'AccountsNoteMaxChars' field
+func AccountsNoteMaxCharsFlag() string { return "accounts-note-max-chars" }
+
+// GetAccountsNoteMaxChars safely fetches the value for global configuration 'AccountsNoteMaxChars' field
+func GetAccountsNoteMaxChars() int { return global.GetAccountsNoteMaxChars() }
+
+// SetAccountsNoteMaxChars safely sets the value for global configuration 'AccountsNoteMaxChars' field
+func SetAccountsNoteMaxChars(v int) { global.SetAccountsNoteMaxChars(v) }
+
+// GetAccountsMaxProfileFields safely fetches the Configuration value for state's 'AccountsMaxProfileFields' field
+func (st *ConfigState) GetAccountsMaxProfileFields() (v int) {
+	st.mutex.Lock()
+	v = st.config.AccountsMaxProfileFields
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsMaxProfileFields safely sets the Configuration value for state's 'AccountsMaxProfileFields' field
+func (st *ConfigState) SetAccountsMaxProfileFields(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsMaxProfileFields = v
+	st.reloadToViper()
+}
+
+// AccountsMaxProfileFieldsFlag returns the flag name for the 'AccountsMaxProfileFields' field
+func AccountsMaxProfileFieldsFlag() string { return "accounts-max-profile-fields" }
+
+// GetAccountsMaxProfileFields safely fetches the value for global configuration 'AccountsMaxProfileFields' field
+func GetAccountsMaxProfileFields() int { return global.GetAccountsMaxProfileFields() }
+
+// SetAccountsMaxProfileFields safely sets the value for global configuration 'AccountsMaxProfileFields' field
+func SetAccountsMaxProfileFields(v int) { global.SetAccountsMaxProfileFields(v) }
+
+// GetAccountsForceConsentScopes safely fetches the Configuration value for state's 'AccountsForceConsentScopes' field
+func (st *ConfigState) GetAccountsForceConsentScopes() (v []string) {
+	st.mutex.Lock()
+	v = st.config.AccountsForceConsentScopes
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsForceConsentScopes safely sets the Configuration value for state's 'AccountsForceConsentScopes' field
+func (st *ConfigState) SetAccountsForceConsentScopes(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsForceConsentScopes = v
+	st.reloadToViper()
+}
+
+// AccountsForceConsentScopesFlag returns the flag name for the 'AccountsForceConsentScopes' field
+func AccountsForceConsentScopesFlag() string { return "accounts-force-consent-scopes" }
+
+// GetAccountsForceConsentScopes safely fetches the value for global configuration 'AccountsForceConsentScopes' field
+func GetAccountsForceConsentScopes() []string { return global.GetAccountsForceConsentScopes() }
+
+// SetAccountsForceConsentScopes safely sets the value for global configuration 'AccountsForceConsentScopes' field
+func SetAccountsForceConsentScopes(v []string) { global.SetAccountsForceConsentScopes(v) }
+
+// GetAccountsClientSettingsMaxSize safely fetches the Configuration value for state's 'AccountsClientSettingsMaxSize' field
+func (st *ConfigState) GetAccountsClientSettingsMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.AccountsClientSettingsMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsClientSettingsMaxSize safely sets the Configuration value for state's 'AccountsClientSettingsMaxSize' field
+func (st *ConfigState) SetAccountsClientSettingsMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsClientSettingsMaxSize = v
+	st.reloadToViper()
+}
+
+// AccountsClientSettingsMaxSizeFlag returns the flag name for the 'AccountsClientSettingsMaxSize' field
+func AccountsClientSettingsMaxSizeFlag() string { return "accounts-client-settings-max-size" }
+
+// GetAccountsClientSettingsMaxSize safely fetches the value for global configuration 'AccountsClientSettingsMaxSize' field
+func GetAccountsClientSettingsMaxSize() int { return global.GetAccountsClientSettingsMaxSize() }
+
+// SetAccountsClientSettingsMaxSize safely sets the value for global configuration 'AccountsClientSettingsMaxSize' field
+func SetAccountsClientSettingsMaxSize(v int) { global.SetAccountsClientSettingsMaxSize(v) }
+
+// GetAccountsSignupLinkDomains safely fetches the Configuration value for state's 'AccountsSignupLinkDomains' field
+func (st *ConfigState) GetAccountsSignupLinkDomains() (v []string) {
+	st.mutex.Lock()
+	v = st.config.AccountsSignupLinkDomains
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsSignupLinkDomains safely sets the Configuration value for state's 'AccountsSignupLinkDomains' field
+func (st *ConfigState) SetAccountsSignupLinkDomains(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsSignupLinkDomains = v
+	st.reloadToViper()
+}
+
+// AccountsSignupLinkDomainsFlag returns the flag name for the 'AccountsSignupLinkDomains' field
+func AccountsSignupLinkDomainsFlag() string { return "accounts-signup-link-domains" }
+
+// GetAccountsSignupLinkDomains safely fetches the value for global configuration 'AccountsSignupLinkDomains' field
+func GetAccountsSignupLinkDomains() []string { return global.GetAccountsSignupLinkDomains() }
+
+// SetAccountsSignupLinkDomains safely sets the value for global configuration 'AccountsSignupLinkDomains' field
+func SetAccountsSignupLinkDomains(v []string) { global.SetAccountsSignupLinkDomains(v) }
+
+// GetAccountsSignupEmailDomains safely fetches the Configuration value for state's 'AccountsSignupEmailDomains' field
+func (st *ConfigState) GetAccountsSignupEmailDomains() (v []string) {
+	st.mutex.Lock()
+	v = st.config.AccountsSignupEmailDomains
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsSignupEmailDomains safely sets the Configuration value for state's 'AccountsSignupEmailDomains' field
+func (st *ConfigState) SetAccountsSignupEmailDomains(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsSignupEmailDomains = v
+	st.reloadToViper()
+}
+
+// AccountsSignupEmailDomainsFlag returns the flag name for the 'AccountsSignupEmailDomains' field
+func AccountsSignupEmailDomainsFlag() string { return "accounts-signup-email-domains" }
+
+// GetAccountsSignupEmailDomains safely fetches the value for global configuration 'AccountsSignupEmailDomains' field
+func GetAccountsSignupEmailDomains() []string { return global.GetAccountsSignupEmailDomains() }
+
+// SetAccountsSignupEmailDomains safely sets the value for global configuration 'AccountsSignupEmailDomains' field
+func SetAccountsSignupEmailDomains(v []string) { global.SetAccountsSignupEmailDomains(v) }
+
+// GetAccountsNotificationsRetentionDays safely fetches the Configuration value for state's 'AccountsNotificationsRetentionDays' field
+func (st *ConfigState) GetAccountsNotificationsRetentionDays() (v int) {
+	st.mutex.Lock()
+	v = st.config.AccountsNotificationsRetentionDays
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsNotificationsRetentionDays safely sets the Configuration value for state's 'AccountsNotificationsRetentionDays' field
+func (st *ConfigState) SetAccountsNotificationsRetentionDays(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsNotificationsRetentionDays = v
+	st.reloadToViper()
+}
+
+// AccountsNotificationsRetentionDaysFlag returns the flag name for the 'AccountsNotificationsRetentionDays' field
+func AccountsNotificationsRetentionDaysFlag() string { return "accounts-notifications-retention-days" }
+
+// GetAccountsNotificationsRetentionDays safely fetches the value for global configuration 'AccountsNotificationsRetentionDays' field
+func GetAccountsNotificationsRetentionDays() int {
+	return global.GetAccountsNotificationsRetentionDays()
+}
+
+// SetAccountsNotificationsRetentionDays safely sets the value for global configuration 'AccountsNotificationsRetentionDays' field
+func SetAccountsNotificationsRetentionDays(v int) { global.SetAccountsNotificationsRetentionDays(v) }
+
+// GetAccountsFollowRequestExpiryDays safely fetches the Configuration value for state's 'AccountsFollowRequestExpiryDays' field
+func (st *ConfigState) GetAccountsFollowRequestExpiryDays() (v int) {
+	st.mutex.Lock()
+	v = st.config.AccountsFollowRequestExpiryDays
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsFollowRequestExpiryDays safely sets the Configuration value for state's 'AccountsFollowRequestExpiryDays' field
+func (st *ConfigState) SetAccountsFollowRequestExpiryDays(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsFollowRequestExpiryDays = v
+	st.reloadToViper()
+}
+
+// AccountsFollowRequestExpiryDaysFlag returns the flag name for the 'AccountsFollowRequestExpiryDays' field
+func AccountsFollowRequestExpiryDaysFlag() string { return "accounts-follow-request-expiry-days" }
+
+// GetAccountsFollowRequestExpiryDays safely fetches the value for global configuration 'AccountsFollowRequestExpiryDays' field
+func GetAccountsFollowRequestExpiryDays() int { return global.GetAccountsFollowRequestExpiryDays() }
+
+// SetAccountsFollowRequestExpiryDays safely sets the value for global configuration 'AccountsFollowRequestExpiryDays' field
+func SetAccountsFollowRequestExpiryDays(v int) { global.SetAccountsFollowRequestExpiryDays(v) }
+
+// GetMediaImageMaxSize safely fetches the Configuration value for state's 'MediaImageMaxSize' field
+func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
+	st.mutex.Lock()
+	v = st.config.MediaImageMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaImageMaxSize safely sets the Configuration value for state's 'MediaImageMaxSize' field
+func (st *ConfigState) SetMediaImageMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaImageMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaImageMaxSizeFlag returns the flag name for the 'MediaImageMaxSize' field
+func MediaImageMaxSizeFlag() string { return "media-image-max-size" }
+
+// GetMediaImageMaxSize safely fetches the value for global configuration 'MediaImageMaxSize' field
+func GetMediaImageMaxSize() bytesize.Size { return global.GetMediaImageMaxSize() }
+
+// SetMediaImageMaxSize safely sets the value for global configuration 'MediaImageMaxSize' field
+func SetMediaImageMaxSize(v bytesize.Size) { global.SetMediaImageMaxSize(v) }
+
+// GetMediaVideoMaxSize safely fetches the Configuration value for state's 'MediaVideoMaxSize' field
+func (st *ConfigState) GetMediaVideoMaxSize() (v bytesize.Size) {
+	st.mutex.Lock()
+	v = st.config.MediaVideoMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaVideoMaxSize safely sets the Configuration value for state's 'MediaVideoMaxSize' field
+func (st *ConfigState) SetMediaVideoMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaVideoMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaVideoMaxSizeFlag returns the flag name for the 'MediaVideoMaxSize' field
+func MediaVideoMaxSizeFlag() string { return "media-video-max-size" }
+
+// GetMediaVideoMaxSize safely fetches the value for global configuration 'MediaVideoMaxSize' field
+func GetMediaVideoMaxSize() bytesize.Size { return global.GetMediaVideoMaxSize() }
+
+// SetMediaVideoMaxSize safely sets the value for global configuration 'MediaVideoMaxSize' field
+func SetMediaVideoMaxSize(v bytesize.Size) { global.SetMediaVideoMaxSize(v) }
+
+// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
+func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+	st.mutex.Lock()
+	v = st.config.MediaDescriptionMinChars
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'MediaDescriptionMinChars' field
+func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaDescriptionMinChars = v
+	st.reloadToViper()
+}
+
+// MediaDescriptionMinCharsFlag returns the flag name for the 'MediaDescriptionMinChars' field
+func MediaDescriptionMinCharsFlag() string { return "media-description-min-chars" }
+
+// GetMediaDescriptionMinChars safely fetches the value for global configuration 'MediaDescriptionMinChars' field
+func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+
+// SetMediaDescriptionMinChars safely sets the value for global configuration 'MediaDescriptionMinChars' field
+func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+
+// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'MediaDescriptionMaxChars' field
+func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+	st.mutex.Lock()
+	v = st.config.MediaDescriptionMaxChars
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'MediaDescriptionMaxChars' field
+func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaDescriptionMaxChars = v
+	st.reloadToViper()
+}
+
+// MediaDescriptionMaxCharsFlag returns the flag name for the 'MediaDescriptionMaxChars' field
+func MediaDescriptionMaxCharsFlag() string { return "media-description-max-chars" }
+
+// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'MediaDescriptionMaxChars' field
+func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+
+// SetMediaDescriptionMaxChars safely sets the value for global configuration 'MediaDescriptionMaxChars' field
+func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+
+// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'MediaRemoteCacheDays' field
+func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+	st.mutex.Lock()
+	v = st.config.MediaRemoteCacheDays
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'MediaRemoteCacheDays' field
+func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaRemoteCacheDays = v
+	st.reloadToViper()
+}
+
+// MediaRemoteCacheDaysFlag returns the flag name for the 'MediaRemoteCacheDays' field
+func MediaRemoteCacheDaysFlag() string { return "media-remote-cache-days" }
+
+// GetMediaRemoteCacheDays safely fetches the value for global configuration 'MediaRemoteCacheDays' field
+func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+
+// SetMediaRemoteCacheDays safely sets the value for global configuration 'MediaRemoteCacheDays' field
+func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+
+// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'MediaEmojiLocalMaxSize' field
+func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+	st.mutex.Lock()
+	v = st.config.MediaEmojiLocalMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'MediaEmojiLocalMaxSize' field
+func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaEmojiLocalMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaEmojiLocalMaxSizeFlag returns the flag name for the 'MediaEmojiLocalMaxSize' field
+func MediaEmojiLocalMaxSizeFlag() string { return "media-emoji-local-max-size" }
+
+// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'MediaEmojiLocalMaxSize' field
+func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+
+// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'MediaEmojiLocalMaxSize' field
+func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+
+// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
+func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+	st.mutex.Lock()
+	v = st.config.MediaEmojiRemoteMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
+func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaEmojiRemoteMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaEmojiRemoteMaxSizeFlag returns the flag name for the 'MediaEmojiRemoteMaxSize' field
+func MediaEmojiRemoteMaxSizeFlag() string { return "media-emoji-remote-max-size" }
+
+// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'MediaEmojiRemoteMaxSize' field
+func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+
+// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'MediaEmojiRemoteMaxSize' field
+func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+
+// GetMediaHotlinkProtection safely fetches the Configuration value for state's 'MediaHotlinkProtection' field
+func (st *ConfigState) GetMediaHotlinkProtection() (v bool) {
+	st.mutex.Lock()
+	v = st.config.MediaHotlinkProtection
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaHotlinkProtection safely sets the Configuration value for state's 'MediaHotlinkProtection' field
+func (st *ConfigState) SetMediaHotlinkProtection(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaHotlinkProtection = v
+	st.reloadToViper()
+}
+
+// MediaHotlinkProtectionFlag returns the flag name for the 'MediaHotlinkProtection' field
+func MediaHotlinkProtectionFlag() string { return "media-hotlink-protection" }
+
+// GetMediaHotlinkProtection safely fetches the value for global configuration 'MediaHotlinkProtection' field
+func GetMediaHotlinkProtection() bool { return global.GetMediaHotlinkProtection() }
+
+// SetMediaHotlinkProtection safely sets the value for global configuration 'MediaHotlinkProtection' field
+func SetMediaHotlinkProtection(v bool) { global.SetMediaHotlinkProtection(v) }
+
+// GetMediaHotlinkAllowedDomains safely fetches the Configuration value for state's 'MediaHotlinkAllowedDomains' field
+func (st *ConfigState) GetMediaHotlinkAllowedDomains() (v []string) {
+	st.mutex.Lock()
+	v = st.config.MediaHotlinkAllowedDomains
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaHotlinkAllowedDomains safely sets the Configuration value for state's 'MediaHotlinkAllowedDomains' field
+func (st *ConfigState) SetMediaHotlinkAllowedDomains(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaHotlinkAllowedDomains = v
+	st.reloadToViper()
+}
+
+// MediaHotlinkAllowedDomainsFlag returns the flag name for the 'MediaHotlinkAllowedDomains' field
+func MediaHotlinkAllowedDomainsFlag() string { return "media-hotlink-allowed-domains" }
+
+// GetMediaHotlinkAllowedDomains safely fetches the value for global configuration 'MediaHotlinkAllowedDomains' field
+func GetMediaHotlinkAllowedDomains() []string { return global.GetMediaHotlinkAllowedDomains() }
+
+// SetMediaHotlinkAllowedDomains safely sets the value for global configuration 'MediaHotlinkAllowedDomains' field
+func SetMediaHotlinkAllowedDomains(v []string) { global.SetMediaHotlinkAllowedDomains(v) }
+
+// GetMediaCrossOriginResourcePolicy safely fetches the Configuration value for state's 'MediaCrossOriginResourcePolicy' field
+func (st *ConfigState) GetMediaCrossOriginResourcePolicy() (v string) {
+	st.mutex.Lock()
+	v = st.config.MediaCrossOriginResourcePolicy
+	st.mutex.Unlock()
+	return
+}
+
+// SetMediaCrossOriginResourcePolicy safely sets the Configuration value for state's 'MediaCrossOriginResourcePolicy' field
+func (st *ConfigState) SetMediaCrossOriginResourcePolicy(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaCrossOriginResourcePolicy = v
+	st.reloadToViper()
+}
+
+// MediaCrossOriginResourcePolicyFlag returns the flag name for the 'MediaCrossOriginResourcePolicy' field
+func MediaCrossOriginResourcePolicyFlag() string { return "media-cross-origin-resource-policy" }
+
+// GetMediaCrossOriginResourcePolicy safely fetches the value for global configuration 'MediaCrossOriginResourcePolicy' field
+func GetMediaCrossOriginResourcePolicy() string { return global.GetMediaCrossOriginResourcePolicy() }
+
+// SetMediaCrossOriginResourcePolicy safely sets the value for global configuration 'MediaCrossOriginResourcePolicy' field
+func SetMediaCrossOriginResourcePolicy(v string) { global.SetMediaCrossOriginResourcePolicy(v) }
+
+// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) GetStorageBackend() (v string) {
+	st.mutex.Lock()
+	v = st.config.StorageBackend
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) SetStorageBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageBackend = v
+	st.reloadToViper()
+}
+
+// StorageBackendFlag returns the flag name for the 'StorageBackend' field
+func StorageBackendFlag() string { return "storage-backend" }
+
+// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
+func GetStorageBackend() string { return global.GetStorageBackend() }
+
+// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
+func SetStorageBackend(v string) { global.SetStorageBackend(v) }
+
+// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) GetStorageLocalBasePath() (v string) {
+	st.mutex.Lock()
+	v = st.config.StorageLocalBasePath
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) SetStorageLocalBasePath(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageLocalBasePath = v
+	st.reloadToViper()
+}
+
+// StorageLocalBasePathFlag returns the flag name for the 'StorageLocalBasePath' field
+func StorageLocalBasePathFlag() string { return "storage-local-base-path" }
+
+// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
+func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
+
+// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
+func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
+
+// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) GetStorageS3Endpoint() (v string) {
+	st.mutex.Lock()
+	v = st.config.StorageS3Endpoint
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) SetStorageS3Endpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3Endpoint = v
+	st.reloadToViper()
+}
+
+// StorageS3EndpointFlag returns the flag name for the 'StorageS3Endpoint' field
+func StorageS3EndpointFlag() string { return "storage-s3-endpoint" }
+
+// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
+func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
+
+// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
+func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
+
+// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) GetStorageS3AccessKey() (v string) {
+	st.mutex.Lock()
+	v = st.config.StorageS3AccessKey
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) SetStorageS3AccessKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3AccessKey = v
+	st.reloadToViper()
+}
+
+// StorageS3AccessKeyFlag returns the flag name for the 'StorageS3AccessKey' field
+func StorageS3AccessKeyFlag() string { return "storage-s3-access-key" }
+
+// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
+func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
+
+// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
+func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
+
+// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) GetStorageS3SecretKey() (v string) {
+	st.mutex.Lock()
+	v = st.config.StorageS3SecretKey
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) SetStorageS3SecretKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3SecretKey = v
+	st.reloadToViper()
+}
+
+// StorageS3SecretKeyFlag returns the flag name for the 'StorageS3SecretKey' field
+func StorageS3SecretKeyFlag() string { return "storage-s3-secret-key" }
+
+// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
+func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
+
+// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
+func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
+
+// GetStorageS3UseSSL safely fetches the Configuration value for state's 'StorageS3UseSSL' field
+func (st *ConfigState) GetStorageS3UseSSL() (v bool) {
+	st.mutex.Lock()
+	v = st.config.StorageS3UseSSL
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageS3UseSSL safely sets the Configuration value for state's 'StorageS3UseSSL' field
+func (st *ConfigState) SetStorageS3UseSSL(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3UseSSL = v
+	st.reloadToViper()
+}
+
+// StorageS3UseSSLFlag returns the flag name for the 'StorageS3UseSSL' field
+func StorageS3UseSSLFlag() string { return "storage-s3-use-ssl" }
+
+// GetStorageS3UseSSL safely fetches the value for global configuration 'StorageS3UseSSL' field
+func GetStorageS3UseSSL() bool { return global.GetStorageS3UseSSL() }
+
+// SetStorageS3UseSSL safely sets the value for global configuration 'StorageS3UseSSL' field
+func SetStorageS3UseSSL(v bool) { global.SetStorageS3UseSSL(v) }
+
+// GetStorageS3BucketName safely fetches the Configuration value for state's 'StorageS3BucketName' field
+func (st *ConfigState) GetStorageS3BucketName() (v string) {
+	st.mutex.Lock()
+	v = st.config.StorageS3BucketName
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageS3BucketName safely sets the Configuration value for state's 'StorageS3BucketName' field
+func (st *ConfigState) SetStorageS3BucketName(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3BucketName = v
+	st.reloadToViper()
+}
+
+// StorageS3BucketNameFlag returns the flag name for the 'StorageS3BucketName' field
+func StorageS3BucketNameFlag() string { return "storage-s3-bucket" }
+
+// GetStorageS3BucketName safely fetches the value for global configuration 'StorageS3BucketName' field
+func GetStorageS3BucketName() string { return global.GetStorageS3BucketName() }
+
+// SetStorageS3BucketName safely sets the value for global configuration 'StorageS3BucketName' field
+func SetStorageS3BucketName(v string) { global.SetStorageS3BucketName(v) }
+
+// GetStorageS3Proxy safely fetches the Configuration value for state's 'StorageS3Proxy' field
+func (st *ConfigState) GetStorageS3Proxy() (v bool) {
+	st.mutex.Lock()
+	v = st.config.StorageS3Proxy
+	st.mutex.Unlock()
+	return
+}
+
+// SetStorageS3Proxy safely sets the Configuration value for state's 'StorageS3Proxy' field
+func (st *ConfigState) SetStorageS3Proxy(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3Proxy = v
+	st.reloadToViper()
+}
+
+// StorageS3ProxyFlag returns the flag name for the 'StorageS3Proxy' field
+func StorageS3ProxyFlag() string { return "storage-s3-proxy" }
+
+// GetStorageS3Proxy safely fetches the value for global configuration 'StorageS3Proxy' field
+func GetStorageS3Proxy() bool { return global.GetStorageS3Proxy() }
+
+// SetStorageS3Proxy safely sets the value for global configuration 'StorageS3Proxy' field
+func SetStorageS3Proxy(v bool) { global.SetStorageS3Proxy(v) }
+
+// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) GetStatusesMaxChars() (v int) {
+	st.mutex.Lock()
+	v = st.config.StatusesMaxChars
+	st.mutex.Unlock()
+	return
+}
+
+// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) SetStatusesMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesMaxChars = v
+	st.reloadToViper()
+}
+
+// StatusesMaxCharsFlag returns the flag name for the 'StatusesMaxChars' field
+func StatusesMaxCharsFlag() string { return "statuses-max-chars" }
+
+// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
+func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
+
+// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
+func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
+
+// GetStatusesCWMaxChars safely fetches the Configuration value for state's 'StatusesCWMaxChars' field
+func (st *ConfigState) GetStatusesCWMaxChars() (v int) {
+	st.mutex.Lock()
+	v = st.config.StatusesCWMaxChars
+	st.mutex.Unlock()
+	return
+}
+
+// SetStatusesCWMaxChars safely sets the Configuration value for state's 'StatusesCWMaxChars' field
+func (st *ConfigState) SetStatusesCWMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesCWMaxChars = v
+	st.reloadToViper()
+}
+
+// StatusesCWMaxCharsFlag returns the flag name for the 'StatusesCWMaxChars' field
+func StatusesCWMaxCharsFlag() string { return "statuses-cw-max-chars" }
+
+// GetStatusesCWMaxChars safely fetches the value for global configuration 'StatusesCWMaxChars' field
+func GetStatusesCWMaxChars() int { return global.GetStatusesCWMaxChars() }
+
+// SetStatusesCWMaxChars safely sets the value for global configuration 'StatusesCWMaxChars' field
+func SetStatusesCWMaxChars(v int) { global.SetStatusesCWMaxChars(v) }
+
+// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
+	st.mutex.Lock()
+	v = st.config.StatusesPollMaxOptions
+	st.mutex.Unlock()
+	return
+}
+
+// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollMaxOptions = v
+	st.reloadToViper()
+}
+
+// StatusesPollMaxOptionsFlag returns the flag name for the 'StatusesPollMaxOptions' field
+func StatusesPollMaxOptionsFlag() string { return "statuses-poll-max-options" }
+
+// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
+func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
+
+// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
+func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
+
+// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
+	st.mutex.Lock()
+	v = st.config.StatusesPollOptionMaxChars
+	st.mutex.Unlock()
+	return
+}
+
+// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollOptionMaxChars = v
+	st.reloadToViper()
+}
+
+// StatusesPollOptionMaxCharsFlag returns the flag name for the 'StatusesPollOptionMaxChars' field
+func StatusesPollOptionMaxCharsFlag() string { return "statuses-poll-option-max-chars" }
+
+// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
+func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
+
+// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
+func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
+
+// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
+	st.mutex.Lock()
+	v = st.config.StatusesMediaMaxFiles
+	st.mutex.Unlock()
+	return
+}
+
+// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesMediaMaxFiles = v
+	st.reloadToViper()
+}
+
+// StatusesMediaMaxFilesFlag returns the flag name for the 'StatusesMediaMaxFiles' field
+func StatusesMediaMaxFilesFlag() string { return "statuses-media-max-files" }
+
+// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
+func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
+
+// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
+func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
+
+// GetStatusesThreadMuteBoosts safely fetches the Configuration value for state's 'StatusesThreadMuteBoosts' field
+func (st *ConfigState) GetStatusesThreadMuteBoosts() (v bool) {
+	st.mutex.Lock()
+	v = st.config.StatusesThreadMuteBoosts
+	st.mutex.Unlock()
+	return
+}
+
+// SetStatusesThreadMuteBoosts safely sets the Configuration value for state's 'StatusesThreadMuteBoosts' field
+func (st *ConfigState) SetStatusesThreadMuteBoosts(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesThreadMuteBoosts = v
+	st.reloadToViper()
+}
+
+// StatusesThreadMuteBoostsFlag returns the flag name for the 'StatusesThreadMuteBoosts' field
+func StatusesThreadMuteBoostsFlag() string { return "statuses-thread-mute-boosts" }
+
+// GetStatusesThreadMuteBoosts safely fetches the value for global configuration 'StatusesThreadMuteBoosts' field
+func GetStatusesThreadMuteBoosts() bool { return global.GetStatusesThreadMuteBoosts() }
+
+// SetStatusesThreadMuteBoosts safely sets the value for global configuration 'StatusesThreadMuteBoosts' field
+func SetStatusesThreadMuteBoosts(v bool) { global.SetStatusesThreadMuteBoosts(v) }
+
+// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.LetsEncryptEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEnabled = v
+	st.reloadToViper()
+}
+
+// LetsEncryptEnabledFlag returns the flag name for the 'LetsEncryptEnabled' field
+func LetsEncryptEnabledFlag() string { return "letsencrypt-enabled" }
+
+// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
+func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+
+// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
+func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+
+// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) GetLetsEncryptPort() (v int) {
+	st.mutex.Lock()
+	v = st.config.LetsEncryptPort
+	st.mutex.Unlock()
+	return
+}
+
+// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) SetLetsEncryptPort(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptPort = v
+	st.reloadToViper()
+}
+
+// LetsEncryptPortFlag returns the flag name for the 'LetsEncryptPort' field
+func LetsEncryptPortFlag() string { return "letsencrypt-port" }
+
+// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
+func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+
+// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
+func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+
+// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+	st.mutex.Lock()
+	v = st.config.LetsEncryptCertDir
+	st.mutex.Unlock()
+	return
+}
+
+// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptCertDir = v
+	st.reloadToViper()
+}
+
+// LetsEncryptCertDirFlag returns the flag name for the 'LetsEncryptCertDir' field
+func LetsEncryptCertDirFlag() string { return "letsencrypt-cert-dir" }
+
+// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
+func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+
+// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
+func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+
+// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+	st.mutex.Lock()
+	v = st.config.LetsEncryptEmailAddress
+	st.mutex.Unlock()
+	return
+}
+
+// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEmailAddress = v
+	st.reloadToViper()
+}
+
+// LetsEncryptEmailAddressFlag returns the flag name for the 'LetsEncryptEmailAddress' field
+func LetsEncryptEmailAddressFlag() string { return "letsencrypt-email-address" }
+
+// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
+func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+
+// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
+func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+
+// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
+func (st *ConfigState) GetOIDCEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.OIDCEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetOIDCEnabled safely sets the Configuration value for state's 'OIDCEnabled' field
+func (st *ConfigState) SetOIDCEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCEnabled = v
+	st.reloadToViper()
+}
+
+// OIDCEnabledFlag returns the flag name for the 'OIDCEnabled' field
+func OIDCEnabledFlag() string { return "oidc-enabled" }
+
+// GetOIDCEnabled safely fetches the value for global configuration 'OIDCEnabled' field
+func GetOIDCEnabled() bool { return global.GetOIDCEnabled() }
+
+// SetOIDCEnabled safely sets the value for global configuration 'OIDCEnabled' field
+func SetOIDCEnabled(v bool) { global.SetOIDCEnabled(v) }
+
+// GetOIDCIdpName safely fetches the Configuration value for state's 'OIDCIdpName' field
+func (st *ConfigState) GetOIDCIdpName() (v string) {
+	st.mutex.Lock()
+	v = st.config.OIDCIdpName
+	st.mutex.Unlock()
+	return
+}
+
+// SetOIDCIdpName safely sets the Configuration value for state's 'OIDCIdpName' field
+func (st *ConfigState) SetOIDCIdpName(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCIdpName = v
+	st.reloadToViper()
+}
+
+// OIDCIdpNameFlag returns the flag name for the 'OIDCIdpName' field
+func OIDCIdpNameFlag() string { return "oidc-idp-name" }
+
+// GetOIDCIdpName safely fetches the value for global configuration 'OIDCIdpName' field
+func GetOIDCIdpName() string { return global.GetOIDCIdpName() }
+
+// SetOIDCIdpName safely sets the value for global configuration 'OIDCIdpName' field
+func SetOIDCIdpName(v string) { global.SetOIDCIdpName(v) }
+
+// GetOIDCSkipVerification safely fetches the Configuration value for state's 'OIDCSkipVerification' field
+func (st *ConfigState) GetOIDCSkipVerification() (v bool) {
+	st.mutex.Lock()
+	v = st.config.OIDCSkipVerification
+	st.mutex.Unlock()
+	return
+}
+
+// SetOIDCSkipVerification safely sets the Configuration value for state's 'OIDCSkipVerification' field
+func (st *ConfigState) SetOIDCSkipVerification(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCSkipVerification = v
+	st.reloadToViper()
+}
+
+// OIDCSkipVerificationFlag returns the flag name for the 'OIDCSkipVerification' field
+func OIDCSkipVerificationFlag() string { return "oidc-skip-verification" }
+
+// GetOIDCSkipVerification safely fetches the value for global configuration 'OIDCSkipVerification' field
+func GetOIDCSkipVerification() bool { return global.GetOIDCSkipVerification() }
+
+// SetOIDCSkipVerification safely sets the value for global configuration 'OIDCSkipVerification' field
+func SetOIDCSkipVerification(v bool) { global.SetOIDCSkipVerification(v) }
+
+// GetOIDCIssuer safely fetches the Configuration value for state's 'OIDCIssuer' field
+func (st *ConfigState) GetOIDCIssuer() (v string) {
+	st.mutex.Lock()
+	v = st.config.OIDCIssuer
+	st.mutex.Unlock()
+	return
+}
+
+// SetOIDCIssuer safely sets the Configuration value for state's 'OIDCIssuer' field
+func (st *ConfigState) SetOIDCIssuer(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCIssuer = v
+	st.reloadToViper()
+}
+
+// OIDCIssuerFlag returns the flag name for the 'OIDCIssuer' field
+func OIDCIssuerFlag() string { return "oidc-issuer" }
+
+// GetOIDCIssuer safely fetches the value for global configuration 'OIDCIssuer' field
+func GetOIDCIssuer() string { return global.GetOIDCIssuer() }
+
+// SetOIDCIssuer safely sets the value for global configuration 'OIDCIssuer' field
+func SetOIDCIssuer(v string) { global.SetOIDCIssuer(v) }
+
+// GetOIDCClientID safely fetches the Configuration value for state's 'OIDCClientID' field
+func (st *ConfigState) GetOIDCClientID() (v string) {
+	st.mutex.Lock()
+	v = st.config.OIDCClientID
+	st.mutex.Unlock()
+	return
+}
+
+// SetOIDCClientID safely sets the Configuration value for state's 'OIDCClientID' field
+func (st *ConfigState) SetOIDCClientID(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCClientID = v
+	st.reloadToViper()
+}
+
+// OIDCClientIDFlag returns the flag name for the 'OIDCClientID' field
+func OIDCClientIDFlag() string { return "oidc-client-id" }
+
+// GetOIDCClientID safely fetches the value for global configuration 'OIDCClientID' field
+func GetOIDCClientID() string { return global.GetOIDCClientID() }
+
+// SetOIDCClientID safely sets the value for global configuration 'OIDCClientID' field
+func SetOIDCClientID(v string) { global.SetOIDCClientID(v) }
+
+// GetOIDCClientSecret safely fetches the Configuration value for state's 'OIDCClientSecret' field
+func (st *ConfigState) GetOIDCClientSecret() (v string) {
+	st.mutex.Lock()
+	v = st.config.OIDCClientSecret
+	st.mutex.Unlock()
+	return
+}
+
+// SetOIDCClientSecret safely sets the Configuration value for state's 'OIDCClientSecret' field
+func (st *ConfigState) SetOIDCClientSecret(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCClientSecret = v
+	st.reloadToViper()
+}
+
+// OIDCClientSecretFlag returns the flag name for the 'OIDCClientSecret' field
+func OIDCClientSecretFlag() string { return "oidc-client-secret" }
+
+// GetOIDCClientSecret safely fetches the value for global configuration 'OIDCClientSecret' field
+func GetOIDCClientSecret() string { return global.GetOIDCClientSecret() }
+
+// SetOIDCClientSecret safely sets the value for global configuration 'OIDCClientSecret' field
+func SetOIDCClientSecret(v string) { global.SetOIDCClientSecret(v) }
+
+// GetOIDCScopes safely fetches the Configuration value for state's 'OIDCScopes' field
+func (st *ConfigState) GetOIDCScopes() (v []string) {
+	st.mutex.Lock()
+	v = st.config.OIDCScopes
+	st.mutex.Unlock()
+	return
+}
+
+// SetOIDCScopes safely sets the Configuration value for state's 'OIDCScopes' field
+func (st *ConfigState) SetOIDCScopes(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCScopes = v
+	st.reloadToViper()
+}
+
+// OIDCScopesFlag returns the flag name for the 'OIDCScopes' field
+func OIDCScopesFlag() string { return "oidc-scopes" }
+
+// GetOIDCScopes safely fetches the value for global configuration 'OIDCScopes' field
+func GetOIDCScopes() []string { return global.GetOIDCScopes() }
+
+// SetOIDCScopes safely sets the value for global configuration 'OIDCScopes' field
+func SetOIDCScopes(v []string) { global.SetOIDCScopes(v) }
+
+// GetSMTPHost safely fetches the Configuration value for state's 'SMTPHost' field
+func (st *ConfigState) GetSMTPHost() (v string) {
+	st.mutex.Lock()
+	v = st.config.SMTPHost
+	st.mutex.Unlock()
+	return
+}
+
+// SetSMTPHost safely sets the Configuration value for state's 'SMTPHost' field
+func (st *ConfigState) SetSMTPHost(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPHost = v
+	st.reloadToViper()
+}
+
+// SMTPHostFlag returns the flag name for the 'SMTPHost' field
+func SMTPHostFlag() string { return "smtp-host" }
+
+// GetSMTPHost safely fetches the value for global configuration 'SMTPHost' field
+func GetSMTPHost() string { return global.GetSMTPHost() }
+
+// SetSMTPHost safely sets the value for global configuration 'SMTPHost' field
+func SetSMTPHost(v string) { global.SetSMTPHost(v) }
+
+// GetSMTPPort safely fetches the Configuration value for state's 'SMTPPort' field
+func (st *ConfigState) GetSMTPPort() (v int) {
+	st.mutex.Lock()
+	v = st.config.SMTPPort
+	st.mutex.Unlock()
+	return
+}
+
+// SetSMTPPort safely sets the Configuration value for state's 'SMTPPort' field
+func (st *ConfigState) SetSMTPPort(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPPort = v
+	st.reloadToViper()
+}
+
+// SMTPPortFlag returns the flag name for the 'SMTPPort' field
+func SMTPPortFlag() string { return "smtp-port" }
+
+// GetSMTPPort safely fetches the value for global configuration 'SMTPPort' field
+func GetSMTPPort() int { return global.GetSMTPPort() }
+
+// SetSMTPPort safely sets the value for global configuration 'SMTPPort' field
+func SetSMTPPort(v int) { global.SetSMTPPort(v) }
+
+// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) GetSMTPUsername() (v string) {
+	st.mutex.Lock()
+	v = st.config.SMTPUsername
+	st.mutex.Unlock()
+	return
+}
+
+// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) SetSMTPUsername(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPUsername = v
+	st.reloadToViper()
+}
+
+// SMTPUsernameFlag returns the flag name for the 'SMTPUsername' field
+func SMTPUsernameFlag() string { return "smtp-username" }
+
+// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
+func GetSMTPUsername() string { return global.GetSMTPUsername() }
+
+// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
+func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
+
+// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) GetSMTPPassword() (v string) {
+	st.mutex.Lock()
+	v = st.config.SMTPPassword
+	st.mutex.Unlock()
+	return
+}
+
+// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) SetSMTPPassword(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPPassword = v
+	st.reloadToViper()
+}
+
+// SMTPPasswordFlag returns the flag name for the 'SMTPPassword' field
+func SMTPPasswordFlag() string { return "smtp-password" }
+
+// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
+func GetSMTPPassword() string { return global.GetSMTPPassword() }
+
+// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
+func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
+
+// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) GetSMTPFrom() (v string) {
+	st.mutex.Lock()
+	v = st.config.SMTPFrom
+	st.mutex.Unlock()
+	return
+}
+
+// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) SetSMTPFrom(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPFrom = v
+	st.reloadToViper()
+}
+
+// SMTPFromFlag returns the flag name for the 'SMTPFrom' field
+func SMTPFromFlag() string { return "smtp-from" }
+
+// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
+func GetSMTPFrom() string { return global.GetSMTPFrom() }
+
+// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
+func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
+
+// GetMailGatewayEnabled safely fetches the Configuration value for state's 'MailGatewayEnabled' field
+func (st *ConfigState) GetMailGatewayEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.MailGatewayEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetMailGatewayEnabled safely sets the Configuration value for state's 'MailGatewayEnabled' field
+func (st *ConfigState) SetMailGatewayEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MailGatewayEnabled = v
+	st.reloadToViper()
+}
+
+// MailGatewayEnabledFlag returns the flag name for the 'MailGatewayEnabled' field
+func MailGatewayEnabledFlag() string { return "mail-gateway-enabled" }
+
+// GetMailGatewayEnabled safely fetches the value for global configuration 'MailGatewayEnabled' field
+func GetMailGatewayEnabled() bool { return global.GetMailGatewayEnabled() }
+
+// SetMailGatewayEnabled safely sets the value for global configuration 'MailGatewayEnabled' field
+func SetMailGatewayEnabled(v bool) { global.SetMailGatewayEnabled(v) }
+
+// GetMailGatewayDomain safely fetches the Configuration value for state's 'MailGatewayDomain' field
+func (st *ConfigState) GetMailGatewayDomain() (v string) {
+	st.mutex.Lock()
+	v = st.config.MailGatewayDomain
+	st.mutex.Unlock()
+	return
+}
+
+// SetMailGatewayDomain safely sets the Configuration value for state's 'MailGatewayDomain' field
+func (st *ConfigState) SetMailGatewayDomain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MailGatewayDomain = v
+	st.reloadToViper()
+}
+
+// MailGatewayDomainFlag returns the flag name for the 'MailGatewayDomain' field
+func MailGatewayDomainFlag() string { return "mail-gateway-domain" }
+
+// GetMailGatewayDomain safely fetches the value for global configuration 'MailGatewayDomain' field
+func GetMailGatewayDomain() string { return global.GetMailGatewayDomain() }
+
+// SetMailGatewayDomain safely sets the value for global configuration 'MailGatewayDomain' field
+func SetMailGatewayDomain(v string) { global.SetMailGatewayDomain(v) }
+
+// GetMailGatewaySecret safely fetches the Configuration value for state's 'MailGatewaySecret' field
+func (st *ConfigState) GetMailGatewaySecret() (v string) {
+	st.mutex.Lock()
+	v = st.config.MailGatewaySecret
+	st.mutex.Unlock()
+	return
+}
+
+// SetMailGatewaySecret safely sets the Configuration value for state's 'MailGatewaySecret' field
+func (st *ConfigState) SetMailGatewaySecret(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MailGatewaySecret = v
+	st.reloadToViper()
+}
+
+// MailGatewaySecretFlag returns the flag name for the 'MailGatewaySecret' field
+func MailGatewaySecretFlag() string { return "mail-gateway-secret" }
+
+// GetMailGatewaySecret safely fetches the value for global configuration 'MailGatewaySecret' field
+func GetMailGatewaySecret() string { return global.GetMailGatewaySecret() }
+
+// SetMailGatewaySecret safely sets the value for global configuration 'MailGatewaySecret' field
+func SetMailGatewaySecret(v string) { global.SetMailGatewaySecret(v) }
+
+// GetMailGatewayAuthservID safely fetches the Configuration value for state's 'MailGatewayAuthservID' field
+func (st *ConfigState) GetMailGatewayAuthservID() (v string) {
+	st.mutex.Lock()
+	v = st.config.MailGatewayAuthservID
+	st.mutex.Unlock()
+	return
+}
+
+// SetMailGatewayAuthservID safely sets the Configuration value for state's 'MailGatewayAuthservID' field
+func (st *ConfigState) SetMailGatewayAuthservID(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MailGatewayAuthservID = v
+	st.reloadToViper()
+}
+
+// MailGatewayAuthservIDFlag returns the flag name for the 'MailGatewayAuthservID' field
+func MailGatewayAuthservIDFlag() string { return "mail-gateway-authserv-id" }
+
+// GetMailGatewayAuthservID safely fetches the value for global configuration 'MailGatewayAuthservID' field
+func GetMailGatewayAuthservID() string { return global.GetMailGatewayAuthservID() }
+
+// SetMailGatewayAuthservID safely sets the value for global configuration 'MailGatewayAuthservID' field
+func SetMailGatewayAuthservID(v string) { global.SetMailGatewayAuthservID(v) }
+
+// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) GetSyslogEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.SyslogEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) SetSyslogEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SyslogEnabled = v
+	st.reloadToViper()
+}
+
+// SyslogEnabledFlag returns the flag name for the 'SyslogEnabled' field
+func SyslogEnabledFlag() string { return "syslog-enabled" }
+
+// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
+func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
+
+// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
+func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
+
+// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) GetSyslogProtocol() (v string) {
+	st.mutex.Lock()
+	v = st.config.SyslogProtocol
+	st.mutex.Unlock()
+	return
+}
+
+// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) SetSyslogProtocol(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SyslogProtocol = v
+	st.reloadToViper()
+}
+
+// SyslogProtocolFlag returns the flag name for the 'SyslogProtocol' field
+func SyslogProtocolFlag() string { return "syslog-protocol" }
+
+// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
+func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
+
+// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
+func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
+
+// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) GetSyslogAddress() (v string) {
+	st.mutex.Lock()
+	v = st.config.SyslogAddress
+	st.mutex.Unlock()
+	return
+}
+
+// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) SetSyslogAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SyslogAddress = v
+	st.reloadToViper()
+}
+
+// SyslogAddressFlag returns the flag name for the 'SyslogAddress' field
+func SyslogAddressFlag() string { return "syslog-address" }
+
+// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
+func GetSyslogAddress() string { return global.GetSyslogAddress() }
+
+// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
+func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
+
+// GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
+func (st *ConfigState) GetAdminAccountUsername() (v string) {
+	st.mutex.Lock()
+	v = st.config.AdminAccountUsername
+	st.mutex.Unlock()
+	return
+}
+
+// SetAdminAccountUsername safely sets the Configuration value for state's 'AdminAccountUsername' field
+func (st *ConfigState) SetAdminAccountUsername(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountUsername = v
+	st.reloadToViper()
+}
+
+// AdminAccountUsernameFlag returns the flag name for the 'AdminAccountUsername' field
+func AdminAccountUsernameFlag() string { return "username" }
+
+// GetAdminAccountUsername safely fetches the value for global configuration 'AdminAccountUsername' field
+func GetAdminAccountUsername() string { return global.GetAdminAccountUsername() }
+
+// SetAdminAccountUsername safely sets the value for global configuration 'AdminAccountUsername' field
+func SetAdminAccountUsername(v string) { global.SetAdminAccountUsername(v) }
+
+// GetAdminAccountEmail safely fetches the Configuration value for state's 'AdminAccountEmail' field
+func (st *ConfigState) GetAdminAccountEmail() (v string) {
+	st.mutex.Lock()
+	v = st.config.AdminAccountEmail
+	st.mutex.Unlock()
+	return
+}
+
+// SetAdminAccountEmail safely sets the Configuration value for state's 'AdminAccountEmail' field
+func (st *ConfigState) SetAdminAccountEmail(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountEmail = v
+	st.reloadToViper()
+}
+
+// AdminAccountEmailFlag returns the flag name for the 'AdminAccountEmail' field
+func AdminAccountEmailFlag() string { return "email" }
+
+// GetAdminAccountEmail safely fetches the value for global configuration 'AdminAccountEmail' field
+func GetAdminAccountEmail() string { return global.GetAdminAccountEmail() }
+
+// SetAdminAccountEmail safely sets the value for global configuration 'AdminAccountEmail' field
+func SetAdminAccountEmail(v string) { global.SetAdminAccountEmail(v) }
+
+// GetAdminAccountPassword safely fetches the Configuration value for state's 'AdminAccountPassword' field
+func (st *ConfigState) GetAdminAccountPassword() (v string) {
+	st.mutex.Lock()
+	v = st.config.AdminAccountPassword
+	st.mutex.Unlock()
+	return
+}
+
+// SetAdminAccountPassword safely sets the Configuration value for state's 'AdminAccountPassword' field
+func (st *ConfigState) SetAdminAccountPassword(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountPassword = v
+	st.reloadToViper()
+}
+
+// AdminAccountPasswordFlag returns the flag name for the 'AdminAccountPassword' field
+func AdminAccountPasswordFlag() string { return "password" }
+
+// GetAdminAccountPassword safely fetches the value for global configuration 'AdminAccountPassword' field
+func GetAdminAccountPassword() string { return global.GetAdminAccountPassword() }
+
+// SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
+func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
+
+// GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
+func (st *ConfigState) GetAdminTransPath() (v string) {
+	st.mutex.Lock()
+	v = st.config.AdminTransPath
+	st.mutex.Unlock()
+	return
+}
+
+// SetAdminTransPath safely sets the Configuration value for state's 'AdminTransPath' field
+func (st *ConfigState) SetAdminTransPath(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminTransPath = v
+	st.reloadToViper()
+}
+
+// AdminTransPathFlag returns the flag name for the 'AdminTransPath' field
+func AdminTransPathFlag() string { return "path" }
+
+// GetAdminTransPath safely fetches the value for global configuration 'AdminTransPath' field
+func GetAdminTransPath() string { return global.GetAdminTransPath() }
+
+// SetAdminTransPath safely sets the value for global configuration 'AdminTransPath' field
+func SetAdminTransPath(v string) { global.SetAdminTransPath(v) }
+
+// GetAdminEmojiCategory safely fetches the Configuration value for state's 'AdminEmojiCategory' field
+func (st *ConfigState) GetAdminEmojiCategory() (v string) {
+	st.mutex.Lock()
+	v = st.config.AdminEmojiCategory
+	st.mutex.Unlock()
+	return
+}
+
+// SetAdminEmojiCategory safely sets the Configuration value for state's 'AdminEmojiCategory' field
+func (st *ConfigState) SetAdminEmojiCategory(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminEmojiCategory = v
+	st.reloadToViper()
+}
+
+// AdminEmojiCategoryFlag returns the flag name for the 'AdminEmojiCategory' field
+func AdminEmojiCategoryFlag() string { return "category" }
+
+// GetAdminEmojiCategory safely fetches the value for global configuration 'AdminEmojiCategory' field
+func GetAdminEmojiCategory() string { return global.GetAdminEmojiCategory() }
+
+// SetAdminEmojiCategory safely sets the value for global configuration 'AdminEmojiCategory' field
+func SetAdminEmojiCategory(v string) { global.SetAdminEmojiCategory(v) }
+
+// GetAdminEmojiDir safely fetches the Configuration value for state's 'AdminEmojiDir' field
+func (st *ConfigState) GetAdminEmojiDir() (v string) {
+	st.mutex.Lock()
+	v = st.config.AdminEmojiDir
+	st.mutex.Unlock()
+	return
+}
+
+// SetAdminEmojiDir safely sets the Configuration value for state's 'AdminEmojiDir' field
+func (st *ConfigState) SetAdminEmojiDir(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminEmojiDir = v
+	st.reloadToViper()
+}
+
+// AdminEmojiDirFlag returns the flag name for the 'AdminEmojiDir' field
+func AdminEmojiDirFlag() string { return "dir" }
+
+// GetAdminEmojiDir safely fetches the value for global configuration 'AdminEmojiDir' field
+func GetAdminEmojiDir() string { return global.GetAdminEmojiDir() }
 
-// SetOIDCIdpName safely sets the value for global configuration 'OIDCIdpName' field
-func SetOIDCIdpName(v string) { global.SetOIDCIdpName(v) }
+// SetAdminEmojiDir safely sets the value for global configuration 'AdminEmojiDir' field
+func SetAdminEmojiDir(v string) { global.SetAdminEmojiDir(v) }
 
-// GetOIDCSkipVerification safely fetches the Configuration value for state's 'OIDCSkipVerification' field
-func (st *ConfigState) GetOIDCSkipVerification() (v bool) {
+// GetAdminEmojiOnConflict safely fetches the Configuration value for state's 'AdminEmojiOnConflict' field
+func (st *ConfigState) GetAdminEmojiOnConflict() (v string) {
 	st.mutex.Lock()
-	v = st.config.OIDCSkipVerification
+	v = st.config.AdminEmojiOnConflict
 	st.mutex.Unlock()
 	return
 }
 
-// SetOIDCSkipVerification safely sets the Configuration value for state's 'OIDCSkipVerification' field
-func (st *ConfigState) SetOIDCSkipVerification(v bool) {
+// SetAdminEmojiOnConflict safely sets the Configuration value for state's 'AdminEmojiOnConflict' field
+func (st *ConfigState) SetAdminEmojiOnConflict(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCSkipVerification = v
+	st.config.AdminEmojiOnConflict = v
 	st.reloadToViper()
 }
 
-// OIDCSkipVerificationFlag returns the flag name for the 'OIDCSkipVerification' field
-func OIDCSkipVerificationFlag() string { return "oidc-skip-verification" }
+// AdminEmojiOnConflictFlag returns the flag name for the 'AdminEmojiOnConflict' field
+func AdminEmojiOnConflictFlag() string { return "on-conflict" }
 
-// GetOIDCSkipVerification safely fetches the value for global configuration 'OIDCSkipVerification' field
-func GetOIDCSkipVerification() bool { return global.GetOIDCSkipVerification() }
+// GetAdminEmojiOnConflict safely fetches the value for global configuration 'AdminEmojiOnConflict' field
+func GetAdminEmojiOnConflict() string { return global.GetAdminEmojiOnConflict() }
 
-// SetOIDCSkipVerification safely sets the value for global configuration 'OIDCSkipVerification' field
-func SetOIDCSkipVerification(v bool) { global.SetOIDCSkipVerification(v) }
+// SetAdminEmojiOnConflict safely sets the value for global configuration 'AdminEmojiOnConflict' field
+func SetAdminEmojiOnConflict(v string) { global.SetAdminEmojiOnConflict(v) }
 
-// GetOIDCIssuer safely fetches the Configuration value for state's 'OIDCIssuer' field
-func (st *ConfigState) GetOIDCIssuer() (v string) {
+// GetAdminEmojiDryRun safely fetches the Configuration value for state's 'AdminEmojiDryRun' field
+func (st *ConfigState) GetAdminEmojiDryRun() (v bool) {
 	st.mutex.Lock()
-	v = st.config.OIDCIssuer
+	v = st.config.AdminEmojiDryRun
 	st.mutex.Unlock()
 	return
 }
 
-// SetOIDCIssuer safely sets the Configuration value for state's 'OIDCIssuer' field
-func (st *ConfigState) SetOIDCIssuer(v string) {
+// SetAdminEmojiDryRun safely sets the Configuration value for state's 'AdminEmojiDryRun' field
+func (st *ConfigState) SetAdminEmojiDryRun(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCIssuer = v
+	st.config.AdminEmojiDryRun = v
 	st.reloadToViper()
 }
 
-// OIDCIssuerFlag returns the flag name for the 'OIDCIssuer' field
-func OIDCIssuerFlag() string { return "oidc-issuer" }
+// AdminEmojiDryRunFlag returns the flag name for the 'AdminEmojiDryRun' field
+func AdminEmojiDryRunFlag() string { return "dry-run" }
 
-// GetOIDCIssuer safely fetches the value for global configuration 'OIDCIssuer' field
-func GetOIDCIssuer() string { return global.GetOIDCIssuer() }
+// GetAdminEmojiDryRun safely fetches the value for global configuration 'AdminEmojiDryRun' field
+func GetAdminEmojiDryRun() bool { return global.GetAdminEmojiDryRun() }
 
-// SetOIDCIssuer safely sets the value for global configuration 'OIDCIssuer' field
-func SetOIDCIssuer(v string) { global.SetOIDCIssuer(v) }
+// SetAdminEmojiDryRun safely sets the value for global configuration 'AdminEmojiDryRun' field
+func SetAdminEmojiDryRun(v bool) { global.SetAdminEmojiDryRun(v) }
 
-// GetOIDCClientID safely fetches the Configuration value for state's 'OIDCClientID' field
-func (st *ConfigState) GetOIDCClientID() (v string) {
+// GetAdminDomainOldHost safely fetches the Configuration value for state's 'AdminDomainOldHost' field
+func (st *ConfigState) GetAdminDomainOldHost() (v string) {
 	st.mutex.Lock()
-	v = st.config.OIDCClientID
+	v = st.config.AdminDomainOldHost
 	st.mutex.Unlock()
 	return
 }
 
-// SetOIDCClientID safely sets the Configuration value for state's 'OIDCClientID' field
-func (st *ConfigState) SetOIDCClientID(v string) {
+// SetAdminDomainOldHost safely sets the Configuration value for state's 'AdminDomainOldHost' field
+func (st *ConfigState) SetAdminDomainOldHost(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCClientID = v
+	st.config.AdminDomainOldHost = v
 	st.reloadToViper()
 }
 
-// OIDCClientIDFlag returns the flag name for the 'OIDCClientID' field
-func OIDCClientIDFlag() string { return "oidc-client-id" }
+// AdminDomainOldHostFlag returns the flag name for the 'AdminDomainOldHost' field
+func AdminDomainOldHostFlag() string { return "old-host" }
 
-// GetOIDCClientID safely fetches the value for global configuration 'OIDCClientID' field
-func GetOIDCClientID() string { return global.GetOIDCClientID() }
+// GetAdminDomainOldHost safely fetches the value for global configuration 'AdminDomainOldHost' field
+func GetAdminDomainOldHost() string { return global.GetAdminDomainOldHost() }
 
-// SetOIDCClientID safely sets the value for global configuration 'OIDCClientID' field
-func SetOIDCClientID(v string) { global.SetOIDCClientID(v) }
+// SetAdminDomainOldHost safely sets the value for global configuration 'AdminDomainOldHost' field
+func SetAdminDomainOldHost(v string) { global.SetAdminDomainOldHost(v) }
 
-// GetOIDCClientSecret safely fetches the Configuration value for state's 'OIDCClientSecret' field
-func (st *ConfigState) GetOIDCClientSecret() (v string) {
+// GetAdminDomainRiskOK safely fetches the Configuration value for state's 'AdminDomainRiskOK' field
+func (st *ConfigState) GetAdminDomainRiskOK() (v bool) {
 	st.mutex.Lock()
-	v = st.config.OIDCClientSecret
+	v = st.config.AdminDomainRiskOK
 	st.mutex.Unlock()
 	return
 }
 
-// SetOIDCClientSecret safely sets the Configuration value for state's 'OIDCClientSecret' field
-func (st *ConfigState) SetOIDCClientSecret(v string) {
+// SetAdminDomainRiskOK safely sets the Configuration value for state's 'AdminDomainRiskOK' field
+func (st *ConfigState) SetAdminDomainRiskOK(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCClientSecret = v
+	st.config.AdminDomainRiskOK = v
 	st.reloadToViper()
 }
 
-// OIDCClientSecretFlag returns the flag name for the 'OIDCClientSecret' field
-func OIDCClientSecretFlag() string { return "oidc-client-secret" }
+// AdminDomainRiskOKFlag returns the flag name for the 'AdminDomainRiskOK' field
+func AdminDomainRiskOKFlag() string { return "i-understand-the-risks" }
 
-// GetOIDCClientSecret safely fetches the value for global configuration 'OIDCClientSecret' field
-func GetOIDCClientSecret() string { return global.GetOIDCClientSecret() }
+// GetAdminDomainRiskOK safely fetches the value for global configuration 'AdminDomainRiskOK' field
+func GetAdminDomainRiskOK() bool { return global.GetAdminDomainRiskOK() }
 
-// SetOIDCClientSecret safely sets the value for global configuration 'OIDCClientSecret' field
-func SetOIDCClientSecret(v string) { global.SetOIDCClientSecret(v) }
+// SetAdminDomainRiskOK safely sets the value for global configuration 'AdminDomainRiskOK' field
+func SetAdminDomainRiskOK(v bool) { global.SetAdminDomainRiskOK(v) }
 
-// GetOIDCScopes safely fetches the Configuration value for state's 'OIDCScopes' field
-func (st *ConfigState) GetOIDCScopes() (v []string) {
+// GetAdminMigrationName safely fetches the Configuration value for state's 'AdminMigrationName' field
+func (st *ConfigState) GetAdminMigrationName() (v string) {
 	st.mutex.Lock()
-	v = st.config.OIDCScopes
+	v = st.config.AdminMigrationName
 	st.mutex.Unlock()
 	return
 }
 
-// SetOIDCScopes safely sets the Configuration value for state's 'OIDCScopes' field
-func (st *ConfigState) SetOIDCScopes(v []string) {
+// SetAdminMigrationName safely sets the Configuration value for state's 'AdminMigrationName' field
+func (st *ConfigState) SetAdminMigrationName(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCScopes = v
+	st.config.AdminMigrationName = v
 	st.reloadToViper()
 }
 
-// OIDCScopesFlag returns the flag name for the 'OIDCScopes' field
-func OIDCScopesFlag() string { return "oidc-scopes" }
+// AdminMigrationNameFlag returns the flag name for the 'AdminMigrationName' field
+func AdminMigrationNameFlag() string { return "name" }
 
-// GetOIDCScopes safely fetches the value for global configuration 'OIDCScopes' field
-func GetOIDCScopes() []string { return global.GetOIDCScopes() }
+// GetAdminMigrationName safely fetches the value for global configuration 'AdminMigrationName' field
+func GetAdminMigrationName() string { return global.GetAdminMigrationName() }
 
-// SetOIDCScopes safely sets the value for global configuration 'OIDCScopes' field
-func SetOIDCScopes(v []string) { global.SetOIDCScopes(v) }
+// SetAdminMigrationName safely sets the value for global configuration 'AdminMigrationName' field
+func SetAdminMigrationName(v string) { global.SetAdminMigrationName(v) }
 
-// GetSMTPHost safely fetches the Configuration value for state's 'SMTPHost' field
-func (st *ConfigState) GetSMTPHost() (v string) {
+// GetAdminIDsTolerance safely fetches the Configuration value for state's 'AdminIDsTolerance' field
+func (st *ConfigState) GetAdminIDsTolerance() (v int) {
 	st.mutex.Lock()
-	v = st.config.SMTPHost
+	v = st.config.AdminIDsTolerance
 	st.mutex.Unlock()
 	return
 }
 
-// SetSMTPHost safely sets the Configuration value for state's 'SMTPHost' field
-func (st *ConfigState) SetSMTPHost(v string) {
+// SetAdminIDsTolerance safely sets the Configuration value for state's 'AdminIDsTolerance' field
+func (st *ConfigState) SetAdminIDsTolerance(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPHost = v
+	st.config.AdminIDsTolerance = v
 	st.reloadToViper()
 }
 
-// SMTPHostFlag returns the flag name for the 'SMTPHost' field
-func SMTPHostFlag() string { return "smtp-host" }
+// AdminIDsToleranceFlag returns the flag name for the 'AdminIDsTolerance' field
+func AdminIDsToleranceFlag() string { return "tolerance-seconds" }
 
-// GetSMTPHost safely fetches the value for global configuration 'SMTPHost' field
-func GetSMTPHost() string { return global.GetSMTPHost() }
+// GetAdminIDsTolerance safely fetches the value for global configuration 'AdminIDsTolerance' field
+func GetAdminIDsTolerance() int { return global.GetAdminIDsTolerance() }
 
-// SetSMTPHost safely sets the value for global configuration 'SMTPHost' field
-func SetSMTPHost(v string) { global.SetSMTPHost(v) }
+// SetAdminIDsTolerance safely sets the value for global configuration 'AdminIDsTolerance' field
+func SetAdminIDsTolerance(v int) { global.SetAdminIDsTolerance(v) }
 
-// GetSMTPPort safely fetches the Configuration value for state's 'SMTPPort' field
-func (st *ConfigState) GetSMTPPort() (v int) {
+// GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
+func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
 	st.mutex.Lock()
-	v = st.config.SMTPPort
+	v = st.config.AdvancedCookiesSamesite
 	st.mutex.Unlock()
 	return
 }
 
-// SetSMTPPort safely sets the Configuration value for state's 'SMTPPort' field
-func (st *ConfigState) SetSMTPPort(v int) {
+// SetAdvancedCookiesSamesite safely sets the Configuration value for state's 'AdvancedCookiesSamesite' field
+func (st *ConfigState) SetAdvancedCookiesSamesite(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPPort = v
+	st.config.AdvancedCookiesSamesite = v
 	st.reloadToViper()
 }
 
-// SMTPPortFlag returns the flag name for the 'SMTPPort' field
-func SMTPPortFlag() string { return "smtp-port" }
+// AdvancedCookiesSamesiteFlag returns the flag name for the 'AdvancedCookiesSamesite' field
+func AdvancedCookiesSamesiteFlag() string { return "advanced-cookies-samesite" }
 
-// GetSMTPPort safely fetches the value for global configuration 'SMTPPort' field
-func GetSMTPPort() int { return global.GetSMTPPort() }
+// GetAdvancedCookiesSamesite safely fetches the value for global configuration 'AdvancedCookiesSamesite' field
+func GetAdvancedCookiesSamesite() string { return global.GetAdvancedCookiesSamesite() }
 
-// SetSMTPPort safely sets the value for global configuration 'SMTPPort' field
-func SetSMTPPort(v int) { global.SetSMTPPort(v) }
+// SetAdvancedCookiesSamesite safely sets the value for global configuration 'AdvancedCookiesSamesite' field
+func SetAdvancedCookiesSamesite(v string) { global.SetAdvancedCookiesSamesite(v) }
 
-// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) GetSMTPUsername() (v string) {
+// GetAdvancedRateLimitRequests safely fetches the Configuration value for state's 'AdvancedRateLimitRequests' field
+func (st *ConfigState) GetAdvancedRateLimitRequests() (v int) {
 	st.mutex.Lock()
-	v = st.config.SMTPUsername
+	v = st.config.AdvancedRateLimitRequests
 	st.mutex.Unlock()
 	return
 }
 
-// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) SetSMTPUsername(v string) {
+// SetAdvancedRateLimitRequests safely sets the Configuration value for state's 'AdvancedRateLimitRequests' field
+func (st *ConfigState) SetAdvancedRateLimitRequests(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPUsername = v
+	st.config.AdvancedRateLimitRequests = v
 	st.reloadToViper()
 }
 
-// SMTPUsernameFlag returns the flag name for the 'SMTPUsername' field
-func SMTPUsernameFlag() string { return "smtp-username" }
+// AdvancedRateLimitRequestsFlag returns the flag name for the 'AdvancedRateLimitRequests' field
+func AdvancedRateLimitRequestsFlag() string { return "advanced-rate-limit-requests" }
 
-// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
-func GetSMTPUsername() string { return global.GetSMTPUsername() }
+// GetAdvancedRateLimitRequests safely fetches the value for global configuration 'AdvancedRateLimitRequests' field
+func GetAdvancedRateLimitRequests() int { return global.GetAdvancedRateLimitRequests() }
 
-// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
-func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
+// SetAdvancedRateLimitRequests safely sets the value for global configuration 'AdvancedRateLimitRequests' field
+func SetAdvancedRateLimitRequests(v int) { global.SetAdvancedRateLimitRequests(v) }
 
-// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) GetSMTPPassword() (v string) {
+// GetAdvancedRemoteHostRequestsPerMinute safely fetches the Configuration value for state's 'AdvancedRemoteHostRequestsPerMinute' field
+func (st *ConfigState) GetAdvancedRemoteHostRequestsPerMinute() (v int) {
 	st.mutex.Lock()
-	v = st.config.SMTPPassword
+	v = st.config.AdvancedRemoteHostRequestsPerMinute
 	st.mutex.Unlock()
 	return
 }
 
-// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) SetSMTPPassword(v string) {
+// SetAdvancedRemoteHostRequestsPerMinute safely sets the Configuration value for state's 'AdvancedRemoteHostRequestsPerMinute' field
+func (st *ConfigState) SetAdvancedRemoteHostRequestsPerMinute(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPPassword = v
+	st.config.AdvancedRemoteHostRequestsPerMinute = v
 	st.reloadToViper()
 }
 
-// SMTPPasswordFlag returns the flag name for the 'SMTPPassword' field
-func SMTPPasswordFlag() string { return "smtp-password" }
+// AdvancedRemoteHostRequestsPerMinuteFlag returns the flag name for the 'AdvancedRemoteHostRequestsPerMinute' field
+func AdvancedRemoteHostRequestsPerMinuteFlag() string {
+	return "advanced-remote-host-requests-per-minute"
+}
 
-// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
-func GetSMTPPassword() string { return global.GetSMTPPassword() }
+// GetAdvancedRemoteHostRequestsPerMinute safely fetches the value for global configuration 'AdvancedRemoteHostRequestsPerMinute' field
+func GetAdvancedRemoteHostRequestsPerMinute() int {
+	return global.GetAdvancedRemoteHostRequestsPerMinute()
+}
 
-// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
-func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
+// SetAdvancedRemoteHostRequestsPerMinute safely sets the value for global configuration 'AdvancedRemoteHostRequestsPerMinute' field
+func SetAdvancedRemoteHostRequestsPerMinute(v int) { global.SetAdvancedRemoteHostRequestsPerMinute(v) }
 
-// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) GetSMTPFrom() (v string) {
+// GetAdvancedInboxQueueSize safely fetches the Configuration value for state's 'AdvancedInboxQueueSize' field
+func (st *ConfigState) GetAdvancedInboxQueueSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.SMTPFrom
+	v = st.config.AdvancedInboxQueueSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) SetSMTPFrom(v string) {
+// SetAdvancedInboxQueueSize safely sets the Configuration value for state's 'AdvancedInboxQueueSize' field
+func (st *ConfigState) SetAdvancedInboxQueueSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPFrom = v
+	st.config.AdvancedInboxQueueSize = v
 	st.reloadToViper()
 }
 
-// SMTPFromFlag returns the flag name for the 'SMTPFrom' field
-func SMTPFromFlag() string { return "smtp-from" }
+// AdvancedInboxQueueSizeFlag returns the flag name for the 'AdvancedInboxQueueSize' field
+func AdvancedInboxQueueSizeFlag() string { return "advanced-inbox-queue-size" }
 
-// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
-func GetSMTPFrom() string { return global.GetSMTPFrom() }
+// GetAdvancedInboxQueueSize safely fetches the value for global configuration 'AdvancedInboxQueueSize' field
+func GetAdvancedInboxQueueSize() int { return global.GetAdvancedInboxQueueSize() }
 
-// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
-func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
+// SetAdvancedInboxQueueSize safely sets the value for global configuration 'AdvancedInboxQueueSize' field
+func SetAdvancedInboxQueueSize(v int) { global.SetAdvancedInboxQueueSize(v) }
 
-// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) GetSyslogEnabled() (v bool) {
+// GetAdvancedInboxQueueShedSize safely fetches the Configuration value for state's 'AdvancedInboxQueueShedSize' field
+func (st *ConfigState) GetAdvancedInboxQueueShedSize() (v int) {
 	st.mutex.Lock()
-	v = st.config.SyslogEnabled
+	v = st.config.AdvancedInboxQueueShedSize
 	st.mutex.Unlock()
 	return
 }
 
-// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) SetSyslogEnabled(v bool) {
+// SetAdvancedInboxQueueShedSize safely sets the Configuration value for state's 'AdvancedInboxQueueShedSize' field
+func (st *ConfigState) SetAdvancedInboxQueueShedSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogEnabled = v
+	st.config.AdvancedInboxQueueShedSize = v
 	st.reloadToViper()
 }
 
-// SyslogEnabledFlag returns the flag name for the 'SyslogEnabled' field
-func SyslogEnabledFlag() string { return "syslog-enabled" }
+// AdvancedInboxQueueShedSizeFlag returns the flag name for the 'AdvancedInboxQueueShedSize' field
+func AdvancedInboxQueueShedSizeFlag() string { return "advanced-inbox-queue-shed-size" }
 
-// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
-func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
+// GetAdvancedInboxQueueShedSize safely fetches the value for global configuration 'AdvancedInboxQueueShedSize' field
+func GetAdvancedInboxQueueShedSize() int { return global.GetAdvancedInboxQueueShedSize() }
 
-// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
-func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
+// SetAdvancedInboxQueueShedSize safely sets the value for global configuration 'AdvancedInboxQueueShedSize' field
+func SetAdvancedInboxQueueShedSize(v int) { global.SetAdvancedInboxQueueShedSize(v) }
 
-// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) GetSyslogProtocol() (v string) {
+// GetAdvancedInboxMaxBodySize safely fetches the Configuration value for state's 'AdvancedInboxMaxBodySize' field
+func (st *ConfigState) GetAdvancedInboxMaxBodySize() (v bytesize.Size) {
 	st.mutex.Lock()
-	v = st.config.SyslogProtocol
+	v = st.config.AdvancedInboxMaxBodySize
 	st.mutex.Unlock()
 	return
 }
 
-// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) SetSyslogProtocol(v string) {
+// SetAdvancedInboxMaxBodySize safely sets the Configuration value for state's 'AdvancedInboxMaxBodySize' field
+func (st *ConfigState) SetAdvancedInboxMaxBodySize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogProtocol = v
+	st.config.AdvancedInboxMaxBodySize = v
 	st.reloadToViper()
 }
 
-// SyslogProtocolFlag returns the flag name for the 'SyslogProtocol' field
-func SyslogProtocolFlag() string { return "syslog-protocol" }
+// AdvancedInboxMaxBodySizeFlag returns the flag name for the 'AdvancedInboxMaxBodySize' field
+func AdvancedInboxMaxBodySizeFlag() string { return "advanced-inbox-max-body-size" }
 
-// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
-func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
+// GetAdvancedInboxMaxBodySize safely fetches the value for global configuration 'AdvancedInboxMaxBodySize' field
+func GetAdvancedInboxMaxBodySize() bytesize.Size { return global.GetAdvancedInboxMaxBodySize() }
 
-// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
-func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
+// SetAdvancedInboxMaxBodySize safely sets the value for global configuration 'AdvancedInboxMaxBodySize' field
+func SetAdvancedInboxMaxBodySize(v bytesize.Size) { global.SetAdvancedInboxMaxBodySize(v) }
 
-// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) GetSyslogAddress() (v string) {
+// GetAdvancedInboxMaxJSONDepth safely fetches the Configuration value for state's 'AdvancedInboxMaxJSONDepth' field
+func (st *ConfigState) GetAdvancedInboxMaxJSONDepth() (v int) {
 	st.mutex.Lock()
-	v = st.config.SyslogAddress
+	v = st.config.AdvancedInboxMaxJSONDepth
 	st.mutex.Unlock()
 	return
 }
 
-// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) SetSyslogAddress(v string) {
+// SetAdvancedInboxMaxJSONDepth safely sets the Configuration value for state's 'AdvancedInboxMaxJSONDepth' field
+func (st *ConfigState) SetAdvancedInboxMaxJSONDepth(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogAddress = v
+	st.config.AdvancedInboxMaxJSONDepth = v
 	st.reloadToViper()
 }
 
-// SyslogAddressFlag returns the flag name for the 'SyslogAddress' field
-func SyslogAddressFlag() string { return "syslog-address" }
+// AdvancedInboxMaxJSONDepthFlag returns the flag name for the 'AdvancedInboxMaxJSONDepth' field
+func AdvancedInboxMaxJSONDepthFlag() string { return "advanced-inbox-max-json-depth" }
 
-// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
-func GetSyslogAddress() string { return global.GetSyslogAddress() }
+// GetAdvancedInboxMaxJSONDepth safely fetches the value for global configuration 'AdvancedInboxMaxJSONDepth' field
+func GetAdvancedInboxMaxJSONDepth() int { return global.GetAdvancedInboxMaxJSONDepth() }
 
-// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
-func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
+// SetAdvancedInboxMaxJSONDepth safely sets the value for global configuration 'AdvancedInboxMaxJSONDepth' field
+func SetAdvancedInboxMaxJSONDepth(v int) { global.SetAdvancedInboxMaxJSONDepth(v) }
 
-// GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
-func (st *ConfigState) GetAdminAccountUsername() (v string) {
+// GetAdvancedInboxMaxJSONArrayLength safely fetches the Configuration value for state's 'AdvancedInboxMaxJSONArrayLength' field
+func (st *ConfigState) GetAdvancedInboxMaxJSONArrayLength() (v int) {
 	st.mutex.Lock()
-	v = st.config.AdminAccountUsername
+	v = st.config.AdvancedInboxMaxJSONArrayLength
 	st.mutex.Unlock()
 	return
 }
 
-// SetAdminAccountUsername safely sets the Configuration value for state's 'AdminAccountUsername' field
-func (st *ConfigState) SetAdminAccountUsername(v string) {
+// SetAdvancedInboxMaxJSONArrayLength safely sets the Configuration value for state's 'AdvancedInboxMaxJSONArrayLength' field
+func (st *ConfigState) SetAdvancedInboxMaxJSONArrayLength(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminAccountUsername = v
+	st.config.AdvancedInboxMaxJSONArrayLength = v
 	st.reloadToViper()
 }
 
-// AdminAccountUsernameFlag returns the flag name for the 'AdminAccountUsername' field
-func AdminAccountUsernameFlag() string { return "username" }
+// AdvancedInboxMaxJSONArrayLengthFlag returns the flag name for the 'AdvancedInboxMaxJSONArrayLength' field
+func AdvancedInboxMaxJSONArrayLengthFlag() string { return "advanced-inbox-max-json-array-length" }
 
-// GetAdminAccountUsername safely fetches the value for global configuration 'AdminAccountUsername' field
-func GetAdminAccountUsername() string { return global.GetAdminAccountUsername() }
+// GetAdvancedInboxMaxJSONArrayLength safely fetches the value for global configuration 'AdvancedInboxMaxJSONArrayLength' field
+func GetAdvancedInboxMaxJSONArrayLength() int { return global.GetAdvancedInboxMaxJSONArrayLength() }
 
-// SetAdminAccountUsername safely sets the value for global configuration 'AdminAccountUsername' field
-func SetAdminAccountUsername(v string) { global.SetAdminAccountUsername(v) }
+// SetAdvancedInboxMaxJSONArrayLength safely sets the value for global configuration 'AdvancedInboxMaxJSONArrayLength' field
+func SetAdvancedInboxMaxJSONArrayLength(v int) { global.SetAdvancedInboxMaxJSONArrayLength(v) }
 
-// GetAdminAccountEmail safely fetches the Configuration value for state's 'AdminAccountEmail' field
-func (st *ConfigState) GetAdminAccountEmail() (v string) {
+// GetAdvancedThreadMaxDepth safely fetches the Configuration value for state's 'AdvancedThreadMaxDepth' field
+func (st *ConfigState) GetAdvancedThreadMaxDepth() (v int) {
 	st.mutex.Lock()
-	v = st.config.AdminAccountEmail
+	v = st.config.AdvancedThreadMaxDepth
 	st.mutex.Unlock()
 	return
 }
 
-// SetAdminAccountEmail safely sets the Configuration value for state's 'AdminAccountEmail' field
-func (st *ConfigState) SetAdminAccountEmail(v string) {
+// SetAdvancedThreadMaxDepth safely sets the Configuration value for state's 'AdvancedThreadMaxDepth' field
+func (st *ConfigState) SetAdvancedThreadMaxDepth(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminAccountEmail = v
+	st.config.AdvancedThreadMaxDepth = v
 	st.reloadToViper()
 }
 
-// AdminAccountEmailFlag returns the flag name for the 'AdminAccountEmail' field
-func AdminAccountEmailFlag() string { return "email" }
+// AdvancedThreadMaxDepthFlag returns the flag name for the 'AdvancedThreadMaxDepth' field
+func AdvancedThreadMaxDepthFlag() string { return "advanced-thread-max-depth" }
 
-// GetAdminAccountEmail safely fetches the value for global configuration 'AdminAccountEmail' field
-func GetAdminAccountEmail() string { return global.GetAdminAccountEmail() }
+// GetAdvancedThreadMaxDepth safely fetches the value for global configuration 'AdvancedThreadMaxDepth' field
+func GetAdvancedThreadMaxDepth() int { return global.GetAdvancedThreadMaxDepth() }
 
-// SetAdminAccountEmail safely sets the value for global configuration 'AdminAccountEmail' field
-func SetAdminAccountEmail(v string) { global.SetAdminAccountEmail(v) }
+// SetAdvancedThreadMaxDepth safely sets the value for global configuration 'AdvancedThreadMaxDepth' field
+func SetAdvancedThreadMaxDepth(v int) { global.SetAdvancedThreadMaxDepth(v) }
 
-// GetAdminAccountPassword safely fetches the Configuration value for state's 'AdminAccountPassword' field
-func (st *ConfigState) GetAdminAccountPassword() (v string) {
+// GetAdvancedThreadMaxReplies safely fetches the Configuration value for state's 'AdvancedThreadMaxReplies' field
+func (st *ConfigState) GetAdvancedThreadMaxReplies() (v int) {
 	st.mutex.Lock()
-	v = st.config.AdminAccountPassword
+	v = st.config.AdvancedThreadMaxReplies
 	st.mutex.Unlock()
 	return
 }
 
-// SetAdminAccountPassword safely sets the Configuration value for state's 'AdminAccountPassword' field
-func (st *ConfigState) SetAdminAccountPassword(v string) {
+// SetAdvancedThreadMaxReplies safely sets the Configuration value for state's 'AdvancedThreadMaxReplies' field
+func (st *ConfigState) SetAdvancedThreadMaxReplies(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminAccountPassword = v
+	st.config.AdvancedThreadMaxReplies = v
 	st.reloadToViper()
 }
 
-// AdminAccountPasswordFlag returns the flag name for the 'AdminAccountPassword' field
-func AdminAccountPasswordFlag() string { return "password" }
+// AdvancedThreadMaxRepliesFlag returns the flag name for the 'AdvancedThreadMaxReplies' field
+func AdvancedThreadMaxRepliesFlag() string { return "advanced-thread-max-replies" }
 
-// GetAdminAccountPassword safely fetches the value for global configuration 'AdminAccountPassword' field
-func GetAdminAccountPassword() string { return global.GetAdminAccountPassword() }
+// GetAdvancedThreadMaxReplies safely fetches the value for global configuration 'AdvancedThreadMaxReplies' field
+func GetAdvancedThreadMaxReplies() int { return global.GetAdvancedThreadMaxReplies() }
 
-// SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
-func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
+// SetAdvancedThreadMaxReplies safely sets the value for global configuration 'AdvancedThreadMaxReplies' field
+func SetAdvancedThreadMaxReplies(v int) { global.SetAdvancedThreadMaxReplies(v) }
 
-// GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
-func (st *ConfigState) GetAdminTransPath() (v string) {
+// GetAdvancedThreadReplyAncestors safely fetches the Configuration value for state's 'AdvancedThreadReplyAncestors' field
+func (st *ConfigState) GetAdvancedThreadReplyAncestors() (v int) {
 	st.mutex.Lock()
-	v = st.config.AdminTransPath
+	v = st.config.AdvancedThreadReplyAncestors
 	st.mutex.Unlock()
 	return
 }
 
-// SetAdminTransPath safely sets the Configuration value for state's 'AdminTransPath' field
-func (st *ConfigState) SetAdminTransPath(v string) {
+// SetAdvancedThreadReplyAncestors safely sets the Configuration value for state's 'AdvancedThreadReplyAncestors' field
+func (st *ConfigState) SetAdvancedThreadReplyAncestors(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdminTransPath = v
+	st.config.AdvancedThreadReplyAncestors = v
 	st.reloadToViper()
 }
 
-// AdminTransPathFlag returns the flag name for the 'AdminTransPath' field
-func AdminTransPathFlag() string { return "path" }
+// AdvancedThreadReplyAncestorsFlag returns the flag name for the 'AdvancedThreadReplyAncestors' field
+func AdvancedThreadReplyAncestorsFlag() string { return "advanced-thread-reply-ancestors" }
 
-// GetAdminTransPath safely fetches the value for global configuration 'AdminTransPath' field
-func GetAdminTransPath() string { return global.GetAdminTransPath() }
+// GetAdvancedThreadReplyAncestors safely fetches the value for global configuration 'AdvancedThreadReplyAncestors' field
+func GetAdvancedThreadReplyAncestors() int { return global.GetAdvancedThreadReplyAncestors() }
 
-// SetAdminTransPath safely sets the value for global configuration 'AdminTransPath' field
-func SetAdminTransPath(v string) { global.SetAdminTransPath(v) }
+// SetAdvancedThreadReplyAncestors safely sets the value for global configuration 'AdvancedThreadReplyAncestors' field
+func SetAdvancedThreadReplyAncestors(v int) { global.SetAdvancedThreadReplyAncestors(v) }
 
-// GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
-func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
+// GetAdvancedBlockedUserAgents safely fetches the Configuration value for state's 'AdvancedBlockedUserAgents' field
+func (st *ConfigState) GetAdvancedBlockedUserAgents() (v []string) {
 	st.mutex.Lock()
-	v = st.config.AdvancedCookiesSamesite
+	v = st.config.AdvancedBlockedUserAgents
 	st.mutex.Unlock()
 	return
 }
 
-// SetAdvancedCookiesSamesite safely sets the Configuration value for state's 'AdvancedCookiesSamesite' field
-func (st *ConfigState) SetAdvancedCookiesSamesite(v string) {
+// SetAdvancedBlockedUserAgents safely sets the Configuration value for state's 'AdvancedBlockedUserAgents' field
+func (st *ConfigState) SetAdvancedBlockedUserAgents(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdvancedCookiesSamesite = v
+	st.config.AdvancedBlockedUserAgents = v
 	st.reloadToViper()
 }
 
-// AdvancedCookiesSamesiteFlag returns the flag name for the 'AdvancedCookiesSamesite' field
-func AdvancedCookiesSamesiteFlag() string { return "advanced-cookies-samesite" }
+// AdvancedBlockedUserAgentsFlag returns the flag name for the 'AdvancedBlockedUserAgents' field
+func AdvancedBlockedUserAgentsFlag() string { return "advanced-blocked-user-agents" }
 
-// GetAdvancedCookiesSamesite safely fetches the value for global configuration 'AdvancedCookiesSamesite' field
-func GetAdvancedCookiesSamesite() string { return global.GetAdvancedCookiesSamesite() }
+// GetAdvancedBlockedUserAgents safely fetches the value for global configuration 'AdvancedBlockedUserAgents' field
+func GetAdvancedBlockedUserAgents() []string { return global.GetAdvancedBlockedUserAgents() }
 
-// SetAdvancedCookiesSamesite safely sets the value for global configuration 'AdvancedCookiesSamesite' field
-func SetAdvancedCookiesSamesite(v string) { global.SetAdvancedCookiesSamesite(v) }
+// SetAdvancedBlockedUserAgents safely sets the value for global configuration 'AdvancedBlockedUserAgents' field
+func SetAdvancedBlockedUserAgents(v []string) { global.SetAdvancedBlockedUserAgents(v) }
 
-// GetAdvancedRateLimitRequests safely fetches the Configuration value for state's 'AdvancedRateLimitRequests' field
-func (st *ConfigState) GetAdvancedRateLimitRequests() (v int) {
+// GetAdvancedBlockAIScrapers safely fetches the Configuration value for state's 'AdvancedBlockAIScrapers' field
+func (st *ConfigState) GetAdvancedBlockAIScrapers() (v bool) {
 	st.mutex.Lock()
-	v = st.config.AdvancedRateLimitRequests
+	v = st.config.AdvancedBlockAIScrapers
 	st.mutex.Unlock()
 	return
 }
 
-// SetAdvancedRateLimitRequests safely sets the Configuration value for state's 'AdvancedRateLimitRequests' field
-func (st *ConfigState) SetAdvancedRateLimitRequests(v int) {
+// SetAdvancedBlockAIScrapers safely sets the Configuration value for state's 'AdvancedBlockAIScrapers' field
+func (st *ConfigState) SetAdvancedBlockAIScrapers(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdvancedRateLimitRequests = v
+	st.config.AdvancedBlockAIScrapers = v
 	st.reloadToViper()
 }
 
-// AdvancedRateLimitRequestsFlag returns the flag name for the 'AdvancedRateLimitRequests' field
-func AdvancedRateLimitRequestsFlag() string { return "advanced-rate-limit-requests" }
+// AdvancedBlockAIScrapersFlag returns the flag name for the 'AdvancedBlockAIScrapers' field
+func AdvancedBlockAIScrapersFlag() string { return "advanced-block-ai-scrapers" }
 
-// GetAdvancedRateLimitRequests safely fetches the value for global configuration 'AdvancedRateLimitRequests' field
-func GetAdvancedRateLimitRequests() int { return global.GetAdvancedRateLimitRequests() }
+// GetAdvancedBlockAIScrapers safely fetches the value for global configuration 'AdvancedBlockAIScrapers' field
+func GetAdvancedBlockAIScrapers() bool { return global.GetAdvancedBlockAIScrapers() }
 
-// SetAdvancedRateLimitRequests safely sets the value for global configuration 'AdvancedRateLimitRequests' field
-func SetAdvancedRateLimitRequests(v int) { global.SetAdvancedRateLimitRequests(v) }
+// SetAdvancedBlockAIScrapers safely sets the value for global configuration 'AdvancedBlockAIScrapers' field
+func SetAdvancedBlockAIScrapers(v bool) { global.SetAdvancedBlockAIScrapers(v) }
@@ -21,7 +21,9 @@ package config
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -67,6 +69,59 @@ func Validate() error {
 		errs = append(errs, fmt.Errorf("%s must be set", WebAssetBaseDirFlag()))
 	}
 
+	// mail gateway; addresses, the secret, and who to trust to authenticate emails can't be guessed at
+	if GetMailGatewayEnabled() {
+		if GetMailGatewayDomain() == "" {
+			errs = append(errs, fmt.Errorf("%s must be set when %s is true", MailGatewayDomainFlag(), MailGatewayEnabledFlag()))
+		}
+		if GetMailGatewaySecret() == "" {
+			errs = append(errs, fmt.Errorf("%s must be set when %s is true", MailGatewaySecretFlag(), MailGatewayEnabledFlag()))
+		}
+		if GetMailGatewayAuthservID() == "" {
+			errs = append(errs, fmt.Errorf("%s must be set when %s is true", MailGatewayAuthservIDFlag(), MailGatewayEnabledFlag()))
+		}
+	}
+
+	// blocked user agents; catch typos in patterns at startup rather than on the first request
+	for _, pattern := range GetAdvancedBlockedUserAgents() {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = append(errs, fmt.Errorf("%s contained invalid pattern %s: %s", AdvancedBlockedUserAgentsFlag(), pattern, err))
+		}
+	}
+
+	// caches; a ttl of 0 would evict everything on the next sweep, which is never what's wanted
+	caches := []struct {
+		maxSizeFlag string
+		maxSize     int
+		ttlFlag     string
+		ttl         time.Duration
+	}{
+		{CacheAccountMaxSizeFlag(), GetCacheAccountMaxSize(), CacheAccountTTLFlag(), GetCacheAccountTTL()},
+		{CacheDomainBlockMaxSizeFlag(), GetCacheDomainBlockMaxSize(), CacheDomainBlockTTLFlag(), GetCacheDomainBlockTTL()},
+		{CacheEmojiMaxSizeFlag(), GetCacheEmojiMaxSize(), CacheEmojiTTLFlag(), GetCacheEmojiTTL()},
+		{CacheEmojiCategoryMaxSizeFlag(), GetCacheEmojiCategoryMaxSize(), CacheEmojiCategoryTTLFlag(), GetCacheEmojiCategoryTTL()},
+		{CacheMentionMaxSizeFlag(), GetCacheMentionMaxSize(), CacheMentionTTLFlag(), GetCacheMentionTTL()},
+		{CacheNotificationMaxSizeFlag(), GetCacheNotificationMaxSize(), CacheNotificationTTLFlag(), GetCacheNotificationTTL()},
+		{CacheStatusMaxSizeFlag(), GetCacheStatusMaxSize(), CacheStatusTTLFlag(), GetCacheStatusTTL()},
+		{CacheUserMaxSizeFlag(), GetCacheUserMaxSize(), CacheUserTTLFlag(), GetCacheUserTTL()},
+	}
+	for _, c := range caches {
+		if c.maxSize < 0 {
+			errs = append(errs, fmt.Errorf("%s must be 0 or more, provided value was %d", c.maxSizeFlag, c.maxSize))
+		}
+		if c.ttl <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be greater than 0, provided value was %s", c.ttlFlag, c.ttl))
+		}
+	}
+
+	// media cross-origin resource policy
+	switch corp := GetMediaCrossOriginResourcePolicy(); corp {
+	case "", "same-origin", "same-site", "cross-origin":
+		// no problem
+	default:
+		errs = append(errs, fmt.Errorf("%s must be one of same-origin, same-site or cross-origin, provided value was %s", MediaCrossOriginResourcePolicyFlag(), corp))
+	}
+
 	if len(errs) > 0 {
 		errStrings := []string{}
 		for _, err := range errs {
@@ -141,6 +141,57 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set; protocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateMailGatewayNotConfigured() {
+	testrig.InitTestConfig()
+
+	config.SetMailGatewayEnabled(true)
+
+	err := config.Validate()
+	suite.EqualError(err, "mail-gateway-domain must be set when mail-gateway-enabled is true; mail-gateway-secret must be set when mail-gateway-enabled is true; mail-gateway-authserv-id must be set when mail-gateway-enabled is true")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateMailGatewayOK() {
+	testrig.InitTestConfig()
+
+	config.SetMailGatewayEnabled(true)
+	config.SetMailGatewayDomain("post.example.org")
+	config.SetMailGatewaySecret("hunter2")
+	config.SetMailGatewayAuthservID("mx.example.org")
+
+	err := config.Validate()
+	suite.NoError(err)
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateBlockedUserAgentsBadPattern() {
+	testrig.InitTestConfig()
+
+	config.SetAdvancedBlockedUserAgents([]string{"^curl/", "(GPTBot"})
+
+	err := config.Validate()
+	suite.EqualError(err, "advanced-blocked-user-agents contained invalid pattern (GPTBot: error parsing regexp: missing closing ): `(GPTBot`")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateCacheBadSettings() {
+	testrig.InitTestConfig()
+
+	config.SetCacheStatusMaxSize(-1)
+	config.SetCacheEmojiTTL(0)
+
+	err := config.Validate()
+	suite.EqualError(err, "cache-emoji-ttl must be greater than 0, provided value was 0s; cache-status-max-size must be 0 or more, provided value was -1")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateMediaCrossOriginResourcePolicy() {
+	testrig.InitTestConfig()
+
+	config.SetMediaCrossOriginResourcePolicy("same-site")
+	suite.NoError(config.Validate())
+
+	config.SetMediaCrossOriginResourcePolicy("anyone")
+	err := config.Validate()
+	suite.EqualError(err, "media-cross-origin-resource-policy must be one of same-origin, same-site or cross-origin, provided value was anyone")
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
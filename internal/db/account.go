@@ -25,6 +25,17 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
+// AccountInteraction summarizes how an account has interacted with one local account.
+type AccountInteraction struct {
+	LocalAccountID  string
+	Following       bool      // the account follows the local account
+	Requested       bool      // the account has requested to follow the local account
+	FollowedBy      bool      // the local account follows the account
+	BlockedBy       bool      // the local account blocks the account
+	MentionsCount   int       // the number of times the account has mentioned the local account
+	LastMentionedAt time.Time // when the account last mentioned the local account, if ever
+}
+
 // Account contains functions related to account getting/setting/creation.
 type Account interface {
 	// GetAccountByID returns one account with the given ID, or an error if something goes wrong.
@@ -37,8 +48,13 @@ type Account interface {
 	GetAccountByURL(ctx context.Context, uri string) (*gtsmodel.Account, Error)
 
 	// GetAccountByUsernameDomain returns one account with the given username and domain, or an error if something goes wrong.
+	// If domain is empty, a local account which had the given username before it was renamed will also be returned.
 	GetAccountByUsernameDomain(ctx context.Context, username string, domain string) (*gtsmodel.Account, Error)
 
+	// GetAccountsByIDs returns the accounts with the given IDs, in the same order as the IDs, fetching
+	// all of the ones that aren't cached in one query. IDs with no account are skipped.
+	GetAccountsByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Account, Error)
+
 	// GetAccountByPubkeyID returns one account with the given public key URI (ID), or an error if something goes wrong.
 	GetAccountByPubkeyID(ctx context.Context, id string) (*gtsmodel.Account, Error)
 
@@ -48,11 +64,25 @@ type Account interface {
 	// UpdateAccount updates one account by ID.
 	UpdateAccount(ctx context.Context, account *gtsmodel.Account) (*gtsmodel.Account, Error)
 
-	// DeleteAccount deletes one account from the database by its ID.
+	// ChangeAccountUsername stores the new username and URL of the given local account, along
+	// with the given alias for its old username, which GetAccountByUsernameDomain will then also
+	// find the account by. If the account had the new username before, that alias is removed.
+	ChangeAccountUsername(ctx context.Context, account *gtsmodel.Account, alias *gtsmodel.AccountUsernameAlias) (*gtsmodel.Account, Error)
+
+	// GetAccountUsernameAliases returns the usernames that the given local account had before it was renamed, newest first.
+	GetAccountUsernameAliases(ctx context.Context, accountID string) ([]*gtsmodel.AccountUsernameAlias, Error)
+
+	// DeleteAccount marks one account and all of its statuses, and boosts of them, as deleted in the database.
+	// The account stays as it is until PurgeDeletedAccounts, but its statuses are no longer returned.
 	// DO NOT USE THIS WHEN SUSPENDING ACCOUNTS! In that case you should mark the
 	// account as suspended instead, rather than deleting from the db entirely.
 	DeleteAccount(ctx context.Context, id string) Error
 
+	// PurgeDeletedAccounts removes the emoji links of accounts marked as deleted from the database, batchSize
+	// accounts at a time, along with the accounts themselves if they're remote. Local accounts are kept as a stub
+	// so that their usernames can't be taken again. It returns the number of accounts purged.
+	PurgeDeletedAccounts(ctx context.Context, batchSize int) (int, Error)
+
 	// GetAccountCustomCSSByUsername returns the custom css of an account on this instance with the given username.
 	GetAccountCustomCSSByUsername(ctx context.Context, username string) (string, Error)
 
@@ -69,12 +99,29 @@ type Account interface {
 	GetAccountStatuses(ctx context.Context, accountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinnedOnly bool, mediaOnly bool, publicOnly bool) ([]*gtsmodel.Status, Error)
 
 	// GetAccountWebStatuses is similar to GetAccountStatuses, but it's specifically for returning statuses that
-	// should be visible via the web view of an account. So, only public, federated statuses that aren't boosts
-	// or replies.
-	GetAccountWebStatuses(ctx context.Context, accountID string, limit int, maxID string) ([]*gtsmodel.Status, Error)
+	// should be visible via the web view of an account. So, only public, federated statuses, optionally excluding
+	// boosts and replies, or selecting only statuses with media attached.
+	GetAccountWebStatuses(ctx context.Context, accountID string, limit int, maxID string, excludeReplies bool, excludeReblogs bool, mediaOnly bool) ([]*gtsmodel.Status, Error)
+
+	// GetAccountWebStatusesByTag is like GetAccountWebStatuses, but it only returns
+	// statuses of the account that use the given tag, for showing on its web profile.
+	GetAccountWebStatusesByTag(ctx context.Context, accountID string, tagID string, limit int, maxID string) ([]*gtsmodel.Status, Error)
+
+	// CountAccountStatusesByTag returns how many of the given account's public statuses use the
+	// given tag, and when the latest of them was created (zero if there are none).
+	CountAccountStatusesByTag(ctx context.Context, accountID string, tagID string) (int, time.Time, Error)
+
+	// GetAccountFeaturedTags returns the tags featured by the given account, with
+	// their Tag populated, in the order they were featured in.
+	GetAccountFeaturedTags(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, Error)
 
 	GetAccountBlocks(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.Account, string, string, Error)
 
+	// SearchAccountsForAutocomplete returns up to limit unsuspended accounts whose username or display name
+	// starts with the given query (case-insensitively), out of the accounts that the given account follows,
+	// has mentioned, or has been mentioned by, ordered by username. Only the database is searched.
+	SearchAccountsForAutocomplete(ctx context.Context, accountID string, query string, limit int) ([]*gtsmodel.Account, Error)
+
 	// GetAccountLastPosted simply gets the timestamp of the most recent post by the account.
 	//
 	// If webOnly is true, then the time of the last non-reply, non-boost, public status of the account will be returned.
@@ -85,6 +132,22 @@ type Account interface {
 	// SetAccountHeaderOrAvatar sets the header or avatar for the given accountID to the given media attachment.
 	SetAccountHeaderOrAvatar(ctx context.Context, mediaAttachment *gtsmodel.MediaAttachment, accountID string) Error
 
+	// GetAccountLocalInteractions returns how the given account has interacted with each local account,
+	// through follows, follow requests, blocks and mentions, most mentioned local accounts first.
+	GetAccountLocalInteractions(ctx context.Context, accountID string) ([]*AccountInteraction, Error)
+
+	// GetAccountDailyStats returns the stored daily stats rollups of the given account
+	// for days starting at or after since, ordered by day, oldest first.
+	GetAccountDailyStats(ctx context.Context, accountID string, since time.Time) ([]*gtsmodel.AccountDailyStats, Error)
+
+	// PutAccountDailyStats stores the given daily stats rollups.
+	PutAccountDailyStats(ctx context.Context, stats []*gtsmodel.AccountDailyStats) Error
+
+	// ComputeAccountDailyStats computes (but does not store) daily stats of the given account
+	// from its statuses and followers, for each UTC day from the day of from until the day before
+	// the day of until. The returned stats have no ID, and are ordered by day, oldest first.
+	ComputeAccountDailyStats(ctx context.Context, accountID string, from time.Time, until time.Time) ([]*gtsmodel.AccountDailyStats, Error)
+
 	// GetInstanceAccount returns the instance account for the given domain.
 	// If domain is empty, this instance account will be returned.
 	GetInstanceAccount(ctx context.Context, domain string) (*gtsmodel.Account, Error)
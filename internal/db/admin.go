@@ -21,6 +21,7 @@ package db
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -51,4 +52,68 @@ type Admin interface {
 	// Ie., if the instance is hosted at 'example.org' the instance will have a domain of 'example.org'.
 	// This is needed for things like serving instance information through /api/v1/instance
 	CreateInstanceInstance(ctx context.Context) Error
+
+	// RenameHost rewrites every stored URI and URL pointing at oldHost so that it points at
+	// the currently configured host value instead, and renames the instance account and
+	// instance entry to match. It must only be run while the server is stopped, since
+	// cached models are not invalidated. Remote instances will keep referring to the old
+	// host unless they process Update activities for the renamed accounts.
+	RenameHost(ctx context.Context, oldHost string) Error
+
+	// Maintain runs routine maintenance on the database, refreshing query planner statistics.
+	// If vacuum is true and the database is sqlite, unused space in the database file is also
+	// reclaimed, while if reindex is true and the database is postgres, indexes are rebuilt.
+	// Database size before and after maintenance is reported in the result. If maintenance
+	// is already running, ErrMaintenanceInProgress is returned.
+	Maintain(ctx context.Context, vacuum bool, reindex bool) (*MaintenanceResult, Error)
+
+	// AuditIDs checks that the time encoded in the ID of each row of the tables that are paged
+	// through by ID agrees with the time the row was created, to within tolerance. Rows created
+	// in the future are compared against the current time instead, so that IDs generated while
+	// the clock was wrong are caught even if their created_at is wrong too.
+	AuditIDs(ctx context.Context, tolerance time.Duration) ([]*IDAudit, Error)
+
+	// ResequenceIDs audits IDs as AuditIDs does, and gives skewed rows new IDs generated from
+	// the time they were created, updating any columns that refer to them. Only rows whose IDs
+	// are not exposed to other servers, eg., in URIs, are resequenced. It must only be run while
+	// the server is stopped, since cached models and timelines are not invalidated.
+	ResequenceIDs(ctx context.Context, tolerance time.Duration) ([]*IDAudit, Error)
+}
+
+// IDAudit describes the outcome of checking the IDs of one table.
+type IDAudit struct {
+	// Table is the name of the table that was checked.
+	Table string
+	// Checked is the number of rows checked.
+	Checked int
+	// Invalid is the number of rows whose ID is not a valid ULID.
+	Invalid int
+	// Skewed is the number of rows whose ID time differs from their creation time by more than the tolerance.
+	Skewed int
+	// Future is the number of skewed rows whose ID time is in the future.
+	Future int
+	// Resequenceable is the number of skewed rows which can safely be given new IDs.
+	Resequenceable int
+	// Resequenced is the number of rows which were given new IDs.
+	Resequenced int
+}
+
+// MaintenanceResult describes the outcome of a database maintenance run.
+type MaintenanceResult struct {
+	// SizeBefore is the size of the database in bytes before maintenance.
+	SizeBefore int64
+	// SizeAfter is the size of the database in bytes after maintenance.
+	SizeAfter int64
+	// Vacuumed is true if the database was vacuumed.
+	Vacuumed bool
+	// Reindexed is true if the database indexes were rebuilt.
+	Reindexed bool
+}
+
+// Reclaimed returns the number of bytes freed by maintenance, or 0 if the database grew.
+func (r *MaintenanceResult) Reclaimed() int64 {
+	if r.SizeAfter >= r.SizeBefore {
+		return 0
+	}
+	return r.SizeBefore - r.SizeAfter
 }
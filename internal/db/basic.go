@@ -18,7 +18,12 @@
 
 package db
 
-import "context"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+)
 
 // Basic wraps basic database functionality.
 type Basic interface {
@@ -41,6 +46,14 @@ type Basic interface {
 	// IsHealthy should return nil if the database connection is healthy, or an error if not.
 	IsHealthy(ctx context.Context) Error
 
+	// PoolStats returns statistics for each pool of connections to the database, eg., the primary and any replicas.
+	// For implementations that don't use connection pools, this can just return nil.
+	PoolStats() []PoolStats
+
+	// CacheStats returns statistics for each of the caches kept in front of the database, sorted by name.
+	// For implementations that don't cache anything, this can just return nil.
+	CacheStats() []CacheStats
+
 	// GetByID gets one entry by its id. In a database like postgres, this might be the 'id' field of the entry,
 	// for other implementations (for example, in-memory) it might just be the key of a map.
 	// The given interface i will be set to the result of the query, whatever it is. Use a pointer or a slice.
@@ -79,3 +92,17 @@ type Basic interface {
 	// If i didn't exist anyway, then no error should be returned.
 	DeleteWhere(ctx context.Context, where []Where, i interface{}) Error
 }
+
+// PoolStats are the statistics for one pool of connections to the database.
+type PoolStats struct {
+	// Name of the pool, eg., primary, or the address of a replica.
+	Name string
+	sql.DBStats
+}
+
+// CacheStats are the statistics for one cache kept in front of the database.
+type CacheStats struct {
+	// Name of the cache, eg., accounts or statuses.
+	Name string
+	cache.Stats
+}
@@ -0,0 +1,49 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// BookmarkFolder contains functions for getting and setting bookmark folders and the bookmarks filed in them.
+type BookmarkFolder interface {
+	// GetBookmarkFolderByID returns one bookmark folder from the database.
+	GetBookmarkFolderByID(ctx context.Context, id string) (*gtsmodel.BookmarkFolder, Error)
+
+	// GetBookmarkFoldersForAccountID returns the bookmark folders owned by the given account, in the order they were created in.
+	GetBookmarkFoldersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BookmarkFolder, Error)
+
+	// UpdateBookmarkFolder updates the given columns of the given bookmark folder. If no columns
+	// are given, every column is updated. UpdatedAt is always updated.
+	UpdateBookmarkFolder(ctx context.Context, folder *gtsmodel.BookmarkFolder, columns ...string) Error
+
+	// DeleteBookmarkFolderByID deletes the bookmark folder with the given ID.
+	// The bookmarks filed in it are kept, but no longer filed in any folder.
+	DeleteBookmarkFolderByID(ctx context.Context, id string) Error
+
+	// GetBookmarkedStatusIDs returns which of the given statuses have been bookmarked by the given account.
+	GetBookmarkedStatusIDs(ctx context.Context, accountID string, statusIDs []string) ([]string, Error)
+
+	// FileBookmarks files the given account's bookmarks of the given statuses in the bookmark folder with the given ID,
+	// moving them out of any folder they were filed in before. If folderID is empty, the bookmarks are taken out of their folders.
+	FileBookmarks(ctx context.Context, accountID string, statusIDs []string, folderID string) Error
+}
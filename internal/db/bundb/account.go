@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect"
 )
@@ -39,7 +40,7 @@ type accountDB struct {
 	status *statusDB
 }
 
-func (a *accountDB) newAccountQ(account *gtsmodel.Account) *bun.SelectQuery {
+func (a *accountDB) newAccountQ(account interface{}) *bun.SelectQuery {
 	return a.conn.
 		NewSelect().
 		Model(account).
@@ -49,27 +50,31 @@ func (a *accountDB) newAccountQ(account *gtsmodel.Account) *bun.SelectQuery {
 }
 
 func (a *accountDB) GetAccountByID(ctx context.Context, id string) (*gtsmodel.Account, db.Error) {
-	return a.getAccount(
-		ctx,
-		func() (*gtsmodel.Account, bool) {
-			return a.cache.GetByID(id)
-		},
-		func(account *gtsmodel.Account) error {
-			return a.newAccountQ(account).Where("? = ?", bun.Ident("account.id"), id).Scan(ctx)
-		},
-	)
+	return cache.RequestCached(ctx, cacheAccounts, "id:"+id, func() (*gtsmodel.Account, error) {
+		return a.getAccount(
+			ctx,
+			func() (*gtsmodel.Account, bool) {
+				return a.cache.GetByID(id)
+			},
+			func(account *gtsmodel.Account) error {
+				return a.newAccountQ(account).Where("? = ?", bun.Ident("account.id"), id).Scan(ctx)
+			},
+		)
+	})
 }
 
 func (a *accountDB) GetAccountByURI(ctx context.Context, uri string) (*gtsmodel.Account, db.Error) {
-	return a.getAccount(
-		ctx,
-		func() (*gtsmodel.Account, bool) {
-			return a.cache.GetByURI(uri)
-		},
-		func(account *gtsmodel.Account) error {
-			return a.newAccountQ(account).Where("? = ?", bun.Ident("account.uri"), uri).Scan(ctx)
-		},
-	)
+	return cache.RequestCached(ctx, cacheAccounts, "uri:"+uri, func() (*gtsmodel.Account, error) {
+		return a.getAccount(
+			ctx,
+			func() (*gtsmodel.Account, bool) {
+				return a.cache.GetByURI(uri)
+			},
+			func(account *gtsmodel.Account) error {
+				return a.newAccountQ(account).Where("? = ?", bun.Ident("account.uri"), uri).Scan(ctx)
+			},
+		)
+	})
 }
 
 func (a *accountDB) GetAccountByURL(ctx context.Context, url string) (*gtsmodel.Account, db.Error) {
@@ -85,6 +90,27 @@ func (a *accountDB) GetAccountByURL(ctx context.Context, url string) (*gtsmodel.
 }
 
 func (a *accountDB) GetAccountByUsernameDomain(ctx context.Context, username string, domain string) (*gtsmodel.Account, db.Error) {
+	account, err := a.getAccountByUsernameDomain(ctx, username, domain)
+	if err != db.ErrNoEntries || domain != "" {
+		return account, err
+	}
+
+	// the username might be one that a
+	// local account had before it was renamed
+	var accountID string
+	if err := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_username_aliases"), bun.Ident("account_username_alias")).
+		Column("account_username_alias.account_id").
+		Where("? = ?", bun.Ident("account_username_alias.username"), strings.ToLower(username)).
+		Scan(ctx, &accountID); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return a.GetAccountByID(ctx, accountID)
+}
+
+func (a *accountDB) getAccountByUsernameDomain(ctx context.Context, username string, domain string) (*gtsmodel.Account, db.Error) {
 	return a.getAccount(
 		ctx,
 		func() (*gtsmodel.Account, bool) {
@@ -118,6 +144,26 @@ func (a *accountDB) GetAccountByPubkeyID(ctx context.Context, id string) (*gtsmo
 	)
 }
 
+func (a *accountDB) GetAccountsByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Account, db.Error) {
+	accounts, err := loadByIDs(
+		"GetAccountsByIDs",
+		ids,
+		func(account *gtsmodel.Account) string { return account.ID },
+		a.cache.GetByID,
+		func(missing []string) ([]*gtsmodel.Account, error) {
+			dbAccounts := []*gtsmodel.Account{}
+			err := a.newAccountQ(&dbAccounts).Where("? IN (?)", bun.Ident("account.id"), bun.In(missing)).Scan(ctx)
+			return dbAccounts, err
+		},
+		a.cache.Put,
+	)
+	if err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return accounts, nil
+}
+
 func (a *accountDB) getAccount(ctx context.Context, cacheGet func() (*gtsmodel.Account, bool), dbQuery func(*gtsmodel.Account) error) (*gtsmodel.Account, db.Error) {
 	// Attempt to fetch cached account
 	account, cached := cacheGet()
@@ -203,35 +249,175 @@ func (a *accountDB) UpdateAccount(ctx context.Context, account *gtsmodel.Account
 	}
 
 	a.cache.Put(account)
+	a.conn.bus.Publish(ctx, cacheAccounts, account.ID)
+	cache.RequestInvalidate(ctx, cacheAccounts)
 	return account, nil
 }
 
-func (a *accountDB) DeleteAccount(ctx context.Context, id string) db.Error {
+func (a *accountDB) ChangeAccountUsername(ctx context.Context, account *gtsmodel.Account, alias *gtsmodel.AccountUsernameAlias) (*gtsmodel.Account, db.Error) {
+	account.UpdatedAt = time.Now()
+
 	if err := a.conn.RunInTx(ctx, func(tx bun.Tx) error {
-		// clear out any emoji links
+		// if the account is going back to a username
+		// it had before, it doesn't need the alias anymore
 		if _, err := tx.
 			NewDelete().
-			TableExpr("? AS ?", bun.Ident("account_to_emojis"), bun.Ident("account_to_emoji")).
-			Where("? = ?", bun.Ident("account_to_emoji.account_id"), id).
+			TableExpr("? AS ?", bun.Ident("account_username_aliases"), bun.Ident("account_username_alias")).
+			Where("? = ?", bun.Ident("account_username_alias.account_id"), account.ID).
+			Where("? = ?", bun.Ident("account_username_alias.username"), account.Username).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.
+			NewInsert().
+			Model(alias).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewUpdate().
+			Model(account).
+			Column("username", "url", "updated_at").
+			Where("? = ?", bun.Ident("account.id"), account.ID).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	// drop the cached account first, so that
+	// it can't be found by its old username
+	a.cache.Invalidate(account.ID)
+	a.cache.Put(account)
+	a.conn.bus.Publish(ctx, cacheAccounts, account.ID)
+	cache.RequestInvalidate(ctx, cacheAccounts)
+	return account, nil
+}
+
+func (a *accountDB) GetAccountUsernameAliases(ctx context.Context, accountID string) ([]*gtsmodel.AccountUsernameAlias, db.Error) {
+	aliases := []*gtsmodel.AccountUsernameAlias{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&aliases).
+		Where("? = ?", bun.Ident("account_username_alias.account_id"), accountID).
+		Order("account_username_alias.created_at DESC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return aliases, nil
+}
+
+func (a *accountDB) DeleteAccount(ctx context.Context, id string) db.Error {
+	// only mark the account and its statuses as deleted here, in one go;
+	// they're removed later by PurgeDeletedAccounts and PurgeDeletedStatuses,
+	// along with everything that links to them, a batch at a time
+	deletedAt := time.Now()
+
+	if err := a.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// boosts of the account's statuses go along with them
+		if _, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			Set("? = ?", bun.Ident("deleted_at"), deletedAt).
+			Where("? IS NULL", bun.Ident("status.deleted_at")).
+			WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+				return q.
+					Where("? = ?", bun.Ident("status.account_id"), id).
+					WhereOr("? = ?", bun.Ident("status.boost_of_account_id"), id)
+			}).
 			Exec(ctx); err != nil {
 			return err
 		}
 
-		// delete the account
 		_, err := tx.
 			NewUpdate().
 			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			Set("? = ?", bun.Ident("deleted_at"), deletedAt).
 			Where("? = ?", bun.Ident("account.id"), id).
+			Where("? IS NULL", bun.Ident("account.deleted_at")).
 			Exec(ctx)
 		return err
 	}); err != nil {
 		return a.conn.ProcessError(err)
 	}
 
+	a.status.cache.InvalidateAccount(id)
+	a.conn.bus.Publish(ctx, cacheAccountStatuses, id)
 	a.cache.Invalidate(id)
+	a.conn.bus.Publish(ctx, cacheAccounts, id)
+	cache.RequestInvalidate(ctx, cacheAccounts)
 	return nil
 }
 
+func (a *accountDB) PurgeDeletedAccounts(ctx context.Context, batchSize int) (int, db.Error) {
+	total := 0
+
+	for {
+		// local accounts keep their suspended stub, so that their username
+		// and uris can't be taken again, so only their links are removed;
+		// once they have none left, they're no longer selected here
+		accountIDs := []string{}
+		if err := a.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			Column("account.id").
+			Where("? IS NOT NULL", bun.Ident("account.deleted_at")).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("? IS NOT NULL", bun.Ident("account.domain")).
+					WhereOr("EXISTS (?)", a.conn.
+						NewSelect().
+						TableExpr("? AS ?", bun.Ident("account_to_emojis"), bun.Ident("account_to_emoji")).
+						Column("account_to_emoji.account_id").
+						Where("? = ?", bun.Ident("account_to_emoji.account_id"), bun.Ident("account.id")))
+			}).
+			Limit(batchSize).
+			Scan(ctx, &accountIDs); err != nil {
+			return total, a.conn.ProcessError(err)
+		}
+
+		if len(accountIDs) == 0 {
+			return total, nil
+		}
+
+		if err := a.conn.RunInTx(ctx, func(tx bun.Tx) error {
+			if _, err := tx.
+				NewDelete().
+				TableExpr("? AS ?", bun.Ident("account_to_emojis"), bun.Ident("account_to_emoji")).
+				Where("? IN (?)", bun.Ident("account_to_emoji.account_id"), bun.In(accountIDs)).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			_, err := tx.
+				NewDelete().
+				TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+				Where("? IN (?)", bun.Ident("account.id"), bun.In(accountIDs)).
+				Where("? IS NOT NULL", bun.Ident("account.domain")).
+				Exec(ctx)
+			return err
+		}); err != nil {
+			return total, a.conn.ProcessError(err)
+		}
+
+		// cached copies were invalidated when the accounts were marked as
+		// deleted, and any cached since are suspended stubs, so other
+		// processes can let theirs expire rather than be told of each one
+		for _, accountID := range accountIDs {
+			a.cache.Invalidate(accountID)
+		}
+
+		total += len(accountIDs)
+		if len(accountIDs) < batchSize {
+			return total, nil
+		}
+	}
+}
+
 func (a *accountDB) GetInstanceAccount(ctx context.Context, domain string) (*gtsmodel.Account, db.Error) {
 	account := new(gtsmodel.Account)
 
@@ -260,6 +446,7 @@ func (a *accountDB) GetAccountLastPosted(ctx context.Context, accountID string,
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		Column("status.created_at").
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.account_id"), accountID).
 		Order("status.id DESC").
 		Limit(1)
@@ -310,6 +497,7 @@ func (a *accountDB) SetAccountHeaderOrAvatar(ctx context.Context, mediaAttachmen
 		return a.conn.ProcessError(err)
 	}
 
+	cache.RequestInvalidate(ctx, cacheAccounts)
 	return nil
 }
 
@@ -340,6 +528,7 @@ func (a *accountDB) CountAccountStatuses(ctx context.Context, accountID string)
 	return a.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.account_id"), accountID).
 		Count(ctx)
 }
@@ -351,16 +540,12 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		Column("status.id").
-		Order("status.id DESC")
+		WhereGroup(" AND ", whereNotDeleted)
 
 	if accountID != "" {
 		q = q.Where("? = ?", bun.Ident("status.account_id"), accountID)
 	}
 
-	if limit != 0 {
-		q = q.Limit(limit)
-	}
-
 	if excludeReplies {
 		// include self-replies (threads)
 		whereGroup := func(*bun.SelectQuery) *bun.SelectQuery {
@@ -376,66 +561,56 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 		q = q.WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id"))
 	}
 
-	if maxID != "" {
-		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
-	}
-
-	if minID != "" {
-		q = q.Where("? > ?", bun.Ident("status.id"), minID)
-	}
-
 	if pinnedOnly {
 		q = q.Where("? = ?", bun.Ident("status.pinned"), true)
 	}
 
 	if mediaOnly {
-		// attachments are stored as a json object;
-		// this implementation differs between sqlite and postgres,
-		// so we have to be thorough to cover all eventualities
-		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
-			switch a.conn.Dialect().Name() {
-			case dialect.PG:
-				return q.
-					Where("? IS NOT NULL", bun.Ident("status.attachments")).
-					Where("? != '{}'", bun.Ident("status.attachments"))
-			case dialect.SQLite:
-				return q.
-					Where("? IS NOT NULL", bun.Ident("status.attachments")).
-					Where("? != ''", bun.Ident("status.attachments")).
-					Where("? != 'null'", bun.Ident("status.attachments")).
-					Where("? != '{}'", bun.Ident("status.attachments")).
-					Where("? != '[]'", bun.Ident("status.attachments"))
-			default:
-				log.Panic("db dialect was neither pg nor sqlite")
-				return q
-			}
-		})
+		q = q.WhereGroup(" AND ", a.whereHasAttachments)
 	}
 
 	if publicOnly {
 		q = q.Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic)
 	}
 
+	page := paging.Page{Max: maxID, Min: minID, Limit: limit}
+	q = page.Apply(q, "status.id", paging.OrderDescending)
+
 	if err := q.Scan(ctx, &statusIDs); err != nil {
 		return nil, a.conn.ProcessError(err)
 	}
 
+	// statuses just newer than minID were selected oldest
+	// first, so put them back to newest first for the caller
+	paging.Reorder(page, statusIDs)
+
 	return a.statusesFromIDs(ctx, statusIDs)
 }
 
-func (a *accountDB) GetAccountWebStatuses(ctx context.Context, accountID string, limit int, maxID string) ([]*gtsmodel.Status, db.Error) {
+func (a *accountDB) GetAccountWebStatuses(ctx context.Context, accountID string, limit int, maxID string, excludeReplies bool, excludeReblogs bool, mediaOnly bool) ([]*gtsmodel.Status, db.Error) {
 	statusIDs := []string{}
 
 	q := a.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		Column("status.id").
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.account_id"), accountID).
-		WhereGroup(" AND ", whereEmptyOrNull("status.in_reply_to_uri")).
-		WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id")).
 		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
 		Where("? = ?", bun.Ident("status.federated"), true)
 
+	if excludeReplies {
+		q = q.WhereGroup(" AND ", whereEmptyOrNull("status.in_reply_to_uri"))
+	}
+
+	if excludeReblogs {
+		q = q.WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id"))
+	}
+
+	if mediaOnly {
+		q = q.WhereGroup(" AND ", a.whereHasAttachments)
+	}
+
 	if maxID != "" {
 		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
 	}
@@ -449,27 +624,121 @@ func (a *accountDB) GetAccountWebStatuses(ctx context.Context, accountID string,
 	return a.statusesFromIDs(ctx, statusIDs)
 }
 
-func (a *accountDB) GetAccountBlocks(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.Account, string, string, db.Error) {
-	blocks := []*gtsmodel.Block{}
+func (a *accountDB) GetAccountWebStatusesByTag(ctx context.Context, accountID string, tagID string, limit int, maxID string) ([]*gtsmodel.Status, db.Error) {
+	statusIDs := []string{}
 
-	fq := a.conn.
+	q := a.conn.
 		NewSelect().
-		Model(&blocks).
-		Where("? = ?", bun.Ident("block.account_id"), accountID).
-		Relation("TargetAccount").
-		Order("block.id DESC")
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Column("status.id").
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id")).
+		WhereGroup(" AND ", whereNotDeleted).
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Where("? = ?", bun.Ident("status.federated"), true)
 
 	if maxID != "" {
-		fq = fq.Where("? < ?", bun.Ident("block.id"), maxID)
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	q = q.Limit(limit).Order("status.id DESC")
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return a.statusesFromIDs(ctx, statusIDs)
+}
+
+func (a *accountDB) CountAccountStatusesByTag(ctx context.Context, accountID string, tagID string) (int, time.Time, db.Error) {
+	newQ := func() *bun.SelectQuery {
+		return a.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+			Join("JOIN ? AS ? ON ? = ?",
+				bun.Ident("statuses"), bun.Ident("status"),
+				bun.Ident("status.id"), bun.Ident("status_to_tag.status_id")).
+			WhereGroup(" AND ", whereNotDeleted).
+			Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID).
+			Where("? = ?", bun.Ident("status.account_id"), accountID).
+			Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+			Where("? = ?", bun.Ident("status.federated"), true)
+	}
+
+	count, err := newQ().Count(ctx)
+	if err != nil {
+		return 0, time.Time{}, a.conn.ProcessError(err)
+	}
+
+	if count == 0 {
+		return 0, time.Time{}, nil
+	}
+
+	lastStatusAt := time.Time{}
+	if err := newQ().
+		Column("status.created_at").
+		Order("status.id DESC").
+		Limit(1).
+		Scan(ctx, &lastStatusAt); err != nil {
+		return 0, time.Time{}, a.conn.ProcessError(err)
 	}
 
-	if sinceID != "" {
-		fq = fq.Where("? > ?", bun.Ident("block.id"), sinceID)
+	return count, lastStatusAt, nil
+}
+
+func (a *accountDB) GetAccountFeaturedTags(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, db.Error) {
+	featuredTags := []*gtsmodel.FeaturedTag{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&featuredTags).
+		Relation("Tag").
+		Where("? = ?", bun.Ident("featured_tag.account_id"), accountID).
+		Order("featured_tag.id ASC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
 	}
 
-	if limit > 0 {
-		fq = fq.Limit(limit)
+	return featuredTags, nil
+}
+
+// whereHasAttachments selects only statuses that have at least one media attachment.
+func (a *accountDB) whereHasAttachments(q *bun.SelectQuery) *bun.SelectQuery {
+	// attachments are stored as a json object;
+	// this implementation differs between sqlite and postgres,
+	// so we have to be thorough to cover all eventualities
+	switch a.conn.Dialect().Name() {
+	case dialect.PG:
+		return q.
+			Where("? IS NOT NULL", bun.Ident("status.attachments")).
+			Where("? != '{}'", bun.Ident("status.attachments"))
+	case dialect.SQLite:
+		return q.
+			Where("? IS NOT NULL", bun.Ident("status.attachments")).
+			Where("? != ''", bun.Ident("status.attachments")).
+			Where("? != 'null'", bun.Ident("status.attachments")).
+			Where("? != '{}'", bun.Ident("status.attachments")).
+			Where("? != '[]'", bun.Ident("status.attachments"))
+	default:
+		log.Panic("db dialect was neither pg nor sqlite")
+		return q
 	}
+}
+
+func (a *accountDB) GetAccountBlocks(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.Account, string, string, db.Error) {
+	blocks := []*gtsmodel.Block{}
+
+	fq := a.conn.
+		NewSelect().
+		Model(&blocks).
+		Where("? = ?", bun.Ident("block.account_id"), accountID).
+		Relation("TargetAccount")
+
+	page := paging.Page{Max: maxID, Since: sinceID, Limit: limit}
+	fq = page.Apply(fq, "block.id", paging.OrderDescending)
 
 	if err := fq.Scan(ctx); err != nil {
 		return nil, "", "", a.conn.ProcessError(err)
@@ -489,26 +758,51 @@ func (a *accountDB) GetAccountBlocks(ctx context.Context, accountID string, maxI
 	return accounts, nextMaxID, prevMinID, nil
 }
 
-func (a *accountDB) statusesFromIDs(ctx context.Context, statusIDs []string) ([]*gtsmodel.Status, db.Error) {
-	// Catch case of no statuses early
-	if len(statusIDs) == 0 {
-		return nil, db.ErrNoEntries
+func (a *accountDB) SearchAccountsForAutocomplete(ctx context.Context, accountID string, query string, limit int) ([]*gtsmodel.Account, db.Error) {
+	prefix := likeEscaper.Replace(strings.ToLower(query)) + "%"
+
+	// interacted selects the accounts in the given column
+	// of the given table, where the other column is accountID
+	interacted := func(table string, alias string, column string, otherColumn string) *bun.SelectQuery {
+		return a.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident(table), bun.Ident(alias)).
+			ColumnExpr("?.?", bun.Ident(alias), bun.Ident(column)).
+			Where("?.? = ?", bun.Ident(alias), bun.Ident(otherColumn), accountID)
 	}
 
-	// Allocate return slice (will be at most len statusIDS)
-	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+	accountIDs := []string{}
+	if err := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			// these can use the indexes on lower(username) and lower(display_name)
+			return q.
+				Where("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.username"), prefix, likeEscapeChar).
+				WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.display_name"), prefix, likeEscapeChar)
+		}).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IN (?)", bun.Ident("account.id"), interacted("follows", "follow", "target_account_id", "account_id")).
+				WhereOr("? IN (?)", bun.Ident("account.id"), interacted("mentions", "mention", "target_account_id", "origin_account_id")).
+				WhereOr("? IN (?)", bun.Ident("account.id"), interacted("mentions", "mention", "origin_account_id", "target_account_id"))
+		}).
+		OrderExpr("LOWER(?) ASC", bun.Ident("account.username")).
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
 
-	for _, id := range statusIDs {
-		// Fetch from status from database by ID
-		status, err := a.status.GetStatusByID(ctx, id)
-		if err != nil {
-			log.Errorf("statusesFromIDs: error getting status %q: %v", id, err)
-			continue
-		}
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
 
-		// Append to return slice
-		statuses = append(statuses, status)
+func (a *accountDB) statusesFromIDs(ctx context.Context, statusIDs []string) ([]*gtsmodel.Status, db.Error) {
+	// Catch case of no statuses early
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
 	}
 
-	return statuses, nil
+	return a.status.GetStatusesByIDs(ctx, statusIDs)
 }
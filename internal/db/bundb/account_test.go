@@ -27,6 +27,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/uptrace/bun"
@@ -42,6 +44,38 @@ func (suite *AccountTestSuite) TestGetAccountStatuses() {
 	suite.Len(statuses, 5)
 }
 
+func (suite *AccountTestSuite) TestGetAccountStatusesTimeout() {
+	// a context whose deadline has already passed
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	statuses, err := suite.db.GetAccountStatuses(ctx, suite.testAccounts["local_account_1"].ID, 20, false, false, "", "", false, false, false)
+	suite.ErrorIs(err, db.ErrTimeout)
+	suite.Empty(statuses)
+}
+
+func (suite *AccountTestSuite) TestGetAccountStatusesPaging() {
+	accountID := suite.testAccounts["local_account_1"].ID
+
+	all, err := suite.db.GetAccountStatuses(context.Background(), accountID, 20, false, false, "", "", false, false, false)
+	suite.NoError(err)
+	suite.Len(all, 5)
+
+	// the next page starts just after max ID
+	next, err := suite.db.GetAccountStatuses(context.Background(), accountID, 2, false, false, all[1].ID, "", false, false, false)
+	suite.NoError(err)
+	suite.Len(next, 2)
+	suite.Equal(all[2].ID, next[0].ID)
+	suite.Equal(all[3].ID, next[1].ID)
+
+	// the prev page ends just before min ID, and is still newest first
+	prev, err := suite.db.GetAccountStatuses(context.Background(), accountID, 2, false, false, "", all[4].ID, false, false, false)
+	suite.NoError(err)
+	suite.Len(prev, 2)
+	suite.Equal(all[2].ID, prev[0].ID)
+	suite.Equal(all[3].ID, prev[1].ID)
+}
+
 func (suite *AccountTestSuite) TestGetAccountStatusesExcludeRepliesAndReblogs() {
 	statuses, err := suite.db.GetAccountStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, 20, true, true, "", "", false, false, false)
 	suite.NoError(err)
@@ -60,6 +94,43 @@ func (suite *AccountTestSuite) TestGetAccountStatusesMediaOnly() {
 	suite.Len(statuses, 1)
 }
 
+func (suite *AccountTestSuite) TestGetAccountWebStatuses() {
+	statuses, err := suite.db.GetAccountWebStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, 20, "", true, true, false)
+	suite.NoError(err)
+	suite.NotEmpty(statuses)
+	for _, s := range statuses {
+		suite.Empty(s.InReplyToURI)
+		suite.Empty(s.BoostOfID)
+		suite.Equal(gtsmodel.VisibilityPublic, s.Visibility)
+		suite.True(*s.Federated)
+	}
+}
+
+func (suite *AccountTestSuite) TestGetAccountWebStatusesIncludeRepliesAndReblogs() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+
+	excluded, err := suite.db.GetAccountWebStatuses(ctx, accountID, 20, "", true, true, false)
+	suite.NoError(err)
+
+	included, err := suite.db.GetAccountWebStatuses(ctx, accountID, 20, "", false, false, false)
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(included), len(excluded))
+	for _, s := range included {
+		suite.Equal(gtsmodel.VisibilityPublic, s.Visibility)
+	}
+}
+
+func (suite *AccountTestSuite) TestGetAccountWebStatusesMediaOnly() {
+	statuses, err := suite.db.GetAccountWebStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, 20, "", false, false, true)
+	if err != nil && err != db.ErrNoEntries {
+		suite.FailNow(err.Error())
+	}
+	for _, s := range statuses {
+		suite.NotEmpty(s.AttachmentIDs)
+	}
+}
+
 func (suite *AccountTestSuite) TestGetAccountByIDWithExtras() {
 	account, err := suite.db.GetAccountByID(context.Background(), suite.testAccounts["local_account_1"].ID)
 	if err != nil {
@@ -72,6 +143,34 @@ func (suite *AccountTestSuite) TestGetAccountByIDWithExtras() {
 	suite.NotEmpty(account.HeaderMediaAttachment.URL)
 }
 
+func (suite *AccountTestSuite) TestGetAccountByIDRequestCached() {
+	ctx := cache.WithRequestCache(context.Background())
+	testAccount := suite.testAccounts["local_account_1"]
+
+	account1, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	suite.NoError(err)
+
+	// the same account is handed out again for the rest of the request
+	account2, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	suite.NoError(err)
+	suite.Same(account1, account2)
+
+	// but not once it's been changed
+	account2.Note = "new note for this test"
+	_, err = suite.db.UpdateAccount(ctx, account2)
+	suite.NoError(err)
+
+	account3, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	suite.NoError(err)
+	suite.NotSame(account1, account3)
+	suite.Equal("new note for this test", account3.Note)
+
+	// and never without a request cache
+	account4, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.NotSame(account3, account4)
+}
+
 func (suite *AccountTestSuite) TestGetAccountByUsernameDomain() {
 	testAccount1 := suite.testAccounts["local_account_1"]
 	account1, err := suite.db.GetAccountByUsernameDomain(context.Background(), testAccount1.Username, testAccount1.Domain)
@@ -151,6 +250,49 @@ func (suite *AccountTestSuite) TestUpdateAccount() {
 	suite.WithinDuration(time.Now(), noCache.UpdatedAt, 5*time.Second)
 }
 
+func (suite *AccountTestSuite) TestPurgeDeletedAccounts() {
+	ctx := context.Background()
+
+	dbService, ok := suite.db.(*bundb.DBService)
+	if !ok {
+		panic("db was not *bundb.DBService")
+	}
+
+	// give the local account an emoji to be unlinked from
+	localAccount := suite.testAccounts["local_account_1"]
+	localAccount.EmojiIDs = []string{"01GD36ZKWTKY3T1JJ24JR7KY1Q"}
+	_, err := suite.db.UpdateAccount(ctx, localAccount)
+	suite.NoError(err)
+
+	remoteAccount := suite.testAccounts["remote_account_1"]
+	suite.NoError(suite.db.DeleteAccount(ctx, localAccount.ID))
+	suite.NoError(suite.db.DeleteAccount(ctx, remoteAccount.ID))
+
+	purged, err := suite.db.PurgeDeletedAccounts(ctx, 1)
+	suite.NoError(err)
+	suite.Equal(2, purged)
+
+	// the local account should be kept as a stub, without its emoji links
+	_, err = suite.db.GetAccountByID(ctx, localAccount.ID)
+	suite.NoError(err)
+	links, err := dbService.GetConn().
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_to_emojis"), bun.Ident("account_to_emoji")).
+		Where("? = ?", bun.Ident("account_to_emoji.account_id"), localAccount.ID).
+		Count(ctx)
+	suite.NoError(err)
+	suite.Zero(links)
+
+	// but the remote account should be gone
+	_, err = suite.db.GetAccountByID(ctx, remoteAccount.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// and there should be nothing left to purge
+	purged, err = suite.db.PurgeDeletedAccounts(ctx, 1)
+	suite.NoError(err)
+	suite.Zero(purged)
+}
+
 func (suite *AccountTestSuite) TestGetAccountLastPosted() {
 	lastPosted, err := suite.db.GetAccountLastPosted(context.Background(), suite.testAccounts["local_account_1"].ID, false)
 	suite.NoError(err)
@@ -191,6 +333,169 @@ func (suite *AccountTestSuite) TestInsertAccountWithDefaults() {
 	suite.False(*newAccount.HideCollections)
 }
 
+func (suite *AccountTestSuite) TestChangeAccountUsername() {
+	ctx := context.Background()
+
+	account, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	oldUsername := account.Username
+
+	account.Username = "zork_renamed"
+	account.URL = "http://localhost:8080/@zork_renamed"
+	_, err = suite.db.ChangeAccountUsername(ctx, account, &gtsmodel.AccountUsernameAlias{
+		ID:        "01GKFG5Y3JY2F0MY9NGS1BK4VZ",
+		AccountID: account.ID,
+		Username:  oldUsername,
+	})
+	suite.NoError(err)
+
+	// the account can be found by its new username and its old one
+	renamed, err := suite.db.GetAccountByUsernameDomain(ctx, "zork_renamed", "")
+	suite.NoError(err)
+	suite.Equal(account.ID, renamed.ID)
+	suite.Equal("http://localhost:8080/@zork_renamed", renamed.URL)
+
+	aliased, err := suite.db.GetAccountByUsernameDomain(ctx, oldUsername, "")
+	suite.NoError(err)
+	suite.Equal(account.ID, aliased.ID)
+	suite.Equal("zork_renamed", aliased.Username)
+
+	// the old username is still taken
+	available, err := suite.db.IsUsernameAvailable(ctx, oldUsername)
+	suite.NoError(err)
+	suite.False(available)
+
+	// going back to the old username drops its alias
+	account.Username = oldUsername
+	account.URL = "http://localhost:8080/@" + oldUsername
+	_, err = suite.db.ChangeAccountUsername(ctx, account, &gtsmodel.AccountUsernameAlias{
+		ID:        "01GKFG8MZ9YZ3RJ4C1DKHD5WQ2",
+		AccountID: account.ID,
+		Username:  "zork_renamed",
+	})
+	suite.NoError(err)
+
+	aliases, err := suite.db.GetAccountUsernameAliases(ctx, account.ID)
+	suite.NoError(err)
+	if suite.Len(aliases, 1) {
+		suite.Equal("zork_renamed", aliases[0].Username)
+	}
+
+	// an account that never had the username isn't found by it
+	_, err = suite.db.GetAccountByUsernameDomain(ctx, "never_had_this_name", "")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *AccountTestSuite) TestAccountFeaturedTags() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["admin_account"].ID
+	tag := suite.testTags["welcome"]
+
+	err := suite.db.Put(ctx, &gtsmodel.FeaturedTag{
+		ID:        "01GKHS3ZB6X1Q2DCW0KDYHB8JP",
+		AccountID: accountID,
+		TagID:     tag.ID,
+	})
+	suite.NoError(err)
+
+	featuredTags, err := suite.db.GetAccountFeaturedTags(ctx, accountID)
+	suite.NoError(err)
+	if suite.Len(featuredTags, 1) && suite.NotNil(featuredTags[0].Tag) {
+		suite.Equal("welcome", featuredTags[0].Tag.Name)
+	}
+
+	statuses, err := suite.db.GetAccountWebStatusesByTag(ctx, accountID, tag.ID, 10, "")
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(suite.testStatuses["admin_account_status_1"].ID, statuses[0].ID)
+	}
+
+	count, lastStatusAt, err := suite.db.CountAccountStatusesByTag(ctx, accountID, tag.ID)
+	suite.NoError(err)
+	suite.Equal(1, count)
+	suite.WithinDuration(suite.testStatuses["admin_account_status_1"].CreatedAt, lastStatusAt, time.Second)
+
+	// nobody else has used the tag
+	count, lastStatusAt, err = suite.db.CountAccountStatusesByTag(ctx, suite.testAccounts["local_account_1"].ID, tag.ID)
+	suite.NoError(err)
+	suite.Zero(count)
+	suite.True(lastStatusAt.IsZero())
+}
+
+func (suite *AccountTestSuite) TestGetAccountLocalInteractions() {
+	ctx := context.Background()
+	remoteAccount := suite.testAccounts["remote_account_1"]
+	localAccount1 := suite.testAccounts["local_account_1"]
+	localAccount2 := suite.testAccounts["local_account_2"]
+
+	err := suite.db.Put(ctx, &gtsmodel.Follow{
+		ID:              "01GKKTQ1FJ0W1ZTXQ2T7G5D0P1",
+		URI:             "http://fossbros-anonymous.io/users/foss_satan/follows/01GKKTQ1FJ0W1ZTXQ2T7G5D0P1",
+		AccountID:       remoteAccount.ID,
+		TargetAccountID: localAccount1.ID,
+	})
+	suite.NoError(err)
+
+	mentionedAt := time.Now().Add(-time.Hour)
+	err = suite.db.Put(ctx, &gtsmodel.Mention{
+		ID:               "01GKKTQ8R1GJ1T8F5EV1A1G0YV",
+		CreatedAt:        mentionedAt,
+		StatusID:         suite.testStatuses["remote_account_1_status_1"].ID,
+		OriginAccountID:  remoteAccount.ID,
+		OriginAccountURI: remoteAccount.URI,
+		TargetAccountID:  localAccount1.ID,
+	})
+	suite.NoError(err)
+
+	interactions, err := suite.db.GetAccountLocalInteractions(ctx, remoteAccount.ID)
+	suite.NoError(err)
+	if !suite.Len(interactions, 2) {
+		suite.FailNow("")
+	}
+
+	// most mentioned first
+	suite.Equal(localAccount1.ID, interactions[0].LocalAccountID)
+	suite.True(interactions[0].Following)
+	suite.False(interactions[0].BlockedBy)
+	suite.Equal(1, interactions[0].MentionsCount)
+	suite.WithinDuration(mentionedAt, interactions[0].LastMentionedAt, time.Second)
+
+	suite.Equal(localAccount2.ID, interactions[1].LocalAccountID)
+	suite.True(interactions[1].BlockedBy)
+	suite.False(interactions[1].Following)
+	suite.Zero(interactions[1].MentionsCount)
+	suite.True(interactions[1].LastMentionedAt.IsZero())
+}
+
+func (suite *AccountTestSuite) TestSearchAccountsForAutocomplete() {
+	ctx := context.Background()
+	zork := suite.testAccounts["local_account_1"]
+
+	// followed by zork, matched on display name
+	accounts, err := suite.db.SearchAccountsForAutocomplete(ctx, zork.ID, "HAPPY", 10)
+	suite.NoError(err)
+	if suite.Len(accounts, 1) {
+		suite.Equal(suite.testAccounts["local_account_2"].ID, accounts[0].ID)
+	}
+
+	// mentioned by zork
+	accounts, err = suite.db.SearchAccountsForAutocomplete(ctx, zork.ID, "foss", 10)
+	suite.NoError(err)
+	if suite.Len(accounts, 1) {
+		suite.Equal(suite.testAccounts["remote_account_1"].ID, accounts[0].ID)
+	}
+
+	// zork has never interacted with this account
+	accounts, err = suite.db.SearchAccountsForAutocomplete(ctx, zork.ID, "some", 10)
+	suite.NoError(err)
+	suite.Empty(accounts)
+
+	// LIKE wildcards are matched literally
+	accounts, err = suite.db.SearchAccountsForAutocomplete(ctx, zork.ID, "%", 10)
+	suite.NoError(err)
+	suite.Empty(accounts)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
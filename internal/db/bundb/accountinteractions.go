@@ -0,0 +1,126 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/uptrace/bun"
+)
+
+func (a *accountDB) GetAccountLocalInteractions(ctx context.Context, accountID string) ([]*db.AccountInteraction, db.Error) {
+	interactions := map[string]*db.AccountInteraction{}
+	interaction := func(localAccountID string) *db.AccountInteraction {
+		i, ok := interactions[localAccountID]
+		if !ok {
+			i = &db.AccountInteraction{LocalAccountID: localAccountID}
+			interactions[localAccountID] = i
+		}
+		return i
+	}
+
+	// localIDs selects the IDs of local accounts in the given
+	// column of the given table, where the other column is accountID
+	localIDs := func(table string, alias string, localColumn string, otherColumn string) ([]string, error) {
+		ids := []string{}
+		err := a.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident(table), bun.Ident(alias)).
+			ColumnExpr("?.?", bun.Ident(alias), bun.Ident(localColumn)).
+			Join("JOIN ? AS ? ON ? = ?.?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident(alias), bun.Ident(localColumn)).
+			Where("? IS NULL", bun.Ident("account.domain")).
+			Where("?.? = ?", bun.Ident(alias), bun.Ident(otherColumn), accountID).
+			Scan(ctx, &ids)
+		return ids, err
+	}
+
+	following, err := localIDs("follows", "follow", "target_account_id", "account_id")
+	if err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+	for _, id := range following {
+		interaction(id).Following = true
+	}
+
+	requested, err := localIDs("follow_requests", "follow_request", "target_account_id", "account_id")
+	if err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+	for _, id := range requested {
+		interaction(id).Requested = true
+	}
+
+	followedBy, err := localIDs("follows", "follow", "account_id", "target_account_id")
+	if err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+	for _, id := range followedBy {
+		interaction(id).FollowedBy = true
+	}
+
+	blockedBy, err := localIDs("blocks", "block", "account_id", "target_account_id")
+	if err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+	for _, id := range blockedBy {
+		interaction(id).BlockedBy = true
+	}
+
+	// count the mentions of each local account in one grouped query
+	mentions := []struct {
+		TargetAccountID string
+		MentionsCount   int
+		LastMentionedAt time.Time
+	}{}
+	if err := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+		ColumnExpr("? AS ?", bun.Ident("mention.target_account_id"), bun.Ident("target_account_id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("mentions_count")).
+		ColumnExpr("MAX(?) AS ?", bun.Ident("mention.created_at"), bun.Ident("last_mentioned_at")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("mention.target_account_id")).
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? = ?", bun.Ident("mention.origin_account_id"), accountID).
+		GroupExpr("?", bun.Ident("mention.target_account_id")).
+		Scan(ctx, &mentions); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+	for _, m := range mentions {
+		i := interaction(m.TargetAccountID)
+		i.MentionsCount = m.MentionsCount
+		i.LastMentionedAt = m.LastMentionedAt
+	}
+
+	// most mentioned first, then most recently created local accounts
+	result := make([]*db.AccountInteraction, 0, len(interactions))
+	for _, i := range interactions {
+		result = append(result, i)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].MentionsCount != result[j].MentionsCount {
+			return result[i].MentionsCount > result[j].MentionsCount
+		}
+		return result[i].LocalAccountID > result[j].LocalAccountID
+	})
+
+	return result, nil
+}
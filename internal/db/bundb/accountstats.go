@@ -0,0 +1,162 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+// oneDay is the length of a UTC day.
+const oneDay = 24 * time.Hour
+
+func (a *accountDB) GetAccountDailyStats(ctx context.Context, accountID string, since time.Time) ([]*gtsmodel.AccountDailyStats, db.Error) {
+	stats := []*gtsmodel.AccountDailyStats{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&stats).
+		Where("? = ?", bun.Ident("account_daily_stats.account_id"), accountID).
+		Where("? >= ?", bun.Ident("account_daily_stats.day"), since.UTC()).
+		Order("account_daily_stats.day ASC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return stats, nil
+}
+
+func (a *accountDB) PutAccountDailyStats(ctx context.Context, stats []*gtsmodel.AccountDailyStats) db.Error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	// another request may have stored some of
+	// the same days in the meantime; that's fine
+	_, err := a.conn.
+		NewInsert().
+		Model(&stats).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("day")).
+		Exec(ctx)
+	return a.conn.ProcessError(err)
+}
+
+func (a *accountDB) ComputeAccountDailyStats(ctx context.Context, accountID string, from time.Time, until time.Time) ([]*gtsmodel.AccountDailyStats, db.Error) {
+	from = from.UTC().Truncate(oneDay)
+	until = until.UTC().Truncate(oneDay)
+	if !from.Before(until) {
+		return []*gtsmodel.AccountDailyStats{}, nil
+	}
+
+	stats := make([]*gtsmodel.AccountDailyStats, until.Sub(from)/oneDay)
+	for i := range stats {
+		stats[i] = &gtsmodel.AccountDailyStats{
+			AccountID: accountID,
+			Day:       from.Add(time.Duration(i) * oneDay),
+			Tags:      []gtsmodel.AccountTagCount{},
+		}
+	}
+
+	// dayOf returns the stats covering t, which must be between from and until
+	dayOf := func(t time.Time) *gtsmodel.AccountDailyStats {
+		return stats[t.UTC().Sub(from)/oneDay]
+	}
+
+	statuses := []*gtsmodel.Status{}
+	if err := a.conn.
+		NewSelect().
+		Model(&statuses).
+		Column("status.created_at", "status.boost_of_id", "status.tags").
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? >= ?", bun.Ident("status.created_at"), from).
+		Where("? < ?", bun.Ident("status.created_at"), until).
+		Scan(ctx); err != nil {
+		if err := a.conn.ProcessError(err); err != db.ErrNoEntries {
+			return nil, err
+		}
+	}
+
+	tagCounts := make(map[*gtsmodel.AccountDailyStats]map[string]int)
+	for _, s := range statuses {
+		stat := dayOf(s.CreatedAt)
+		if s.BoostOfID != "" {
+			stat.Boosts++
+			continue
+		}
+		stat.Statuses++
+
+		if len(s.TagIDs) == 0 {
+			continue
+		}
+		if tagCounts[stat] == nil {
+			tagCounts[stat] = make(map[string]int)
+		}
+		for _, tagID := range s.TagIDs {
+			tagCounts[stat][tagID]++
+		}
+	}
+
+	for stat, counts := range tagCounts {
+		for tagID, count := range counts {
+			stat.Tags = append(stat.Tags, gtsmodel.AccountTagCount{TagID: tagID, Count: count})
+		}
+		sort.Slice(stat.Tags, func(i, j int) bool {
+			if stat.Tags[i].Count != stat.Tags[j].Count {
+				return stat.Tags[i].Count > stat.Tags[j].Count
+			}
+			return stat.Tags[i].TagID < stat.Tags[j].TagID
+		})
+	}
+
+	// only current follows are known, so followers who have since
+	// left aren't counted on the days when they were still there
+	follows := []*gtsmodel.Follow{}
+	if err := a.conn.
+		NewSelect().
+		Model(&follows).
+		Column("follow.created_at").
+		Where("? = ?", bun.Ident("follow.target_account_id"), accountID).
+		Where("? < ?", bun.Ident("follow.created_at"), until).
+		Scan(ctx); err != nil {
+		if err := a.conn.ProcessError(err); err != db.ErrNoEntries {
+			return nil, err
+		}
+	}
+
+	followers := 0
+	for _, f := range follows {
+		if f.CreatedAt.Before(from) {
+			followers++
+			continue
+		}
+		dayOf(f.CreatedAt).NewFollowers++
+	}
+
+	for _, stat := range stats {
+		followers += stat.NewFollowers
+		stat.Followers = followers
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,156 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AccountStatsTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AccountStatsTestSuite) TestComputeAccountDailyStats() {
+	account := suite.testAccounts["local_account_1"]
+
+	stats, err := suite.db.ComputeAccountDailyStats(context.Background(), account.ID, testrig.TimeMustParse("2021-10-19T15:00:00Z"), testrig.TimeMustParse("2021-10-22T00:00:00Z"))
+	suite.NoError(err)
+	suite.Len(stats, 3)
+
+	suite.Equal(testrig.TimeMustParse("2021-10-19T00:00:00Z"), stats[0].Day)
+	suite.Equal(0, stats[0].Statuses)
+	suite.Equal(testrig.TimeMustParse("2021-10-20T00:00:00Z"), stats[1].Day)
+	suite.Equal(4, stats[1].Statuses)
+	suite.Equal(testrig.TimeMustParse("2021-10-21T00:00:00Z"), stats[2].Day)
+	suite.Equal(0, stats[2].Statuses)
+
+	for _, s := range stats {
+		suite.Empty(s.ID)
+		suite.Equal(account.ID, s.AccountID)
+		suite.Zero(s.Boosts)
+		suite.Zero(s.Followers)
+		suite.Empty(s.Tags)
+	}
+}
+
+func (suite *AccountStatsTestSuite) TestComputeAccountDailyStatsFollowers() {
+	account := suite.testAccounts["local_account_1"]
+
+	stats, err := suite.db.ComputeAccountDailyStats(context.Background(), account.ID, testrig.TimeMustParse("2022-05-13T00:00:00Z"), testrig.TimeMustParse("2022-05-16T00:00:00Z"))
+	suite.NoError(err)
+	suite.Len(stats, 3)
+
+	suite.Equal(0, stats[0].NewFollowers)
+	suite.Equal(0, stats[0].Followers)
+	suite.Equal(2, stats[1].NewFollowers)
+	suite.Equal(2, stats[1].Followers)
+	suite.Equal(0, stats[2].NewFollowers)
+	suite.Equal(2, stats[2].Followers)
+
+	// followers from before the computed days should be counted too
+	stats, err = suite.db.ComputeAccountDailyStats(context.Background(), account.ID, testrig.TimeMustParse("2022-05-20T00:00:00Z"), testrig.TimeMustParse("2022-05-21T00:00:00Z"))
+	suite.NoError(err)
+	suite.Len(stats, 1)
+	suite.Equal(1, stats[0].Statuses)
+	suite.Equal(0, stats[0].NewFollowers)
+	suite.Equal(2, stats[0].Followers)
+}
+
+func (suite *AccountStatsTestSuite) TestComputeAccountDailyStatsBoostsAndTags() {
+	account := suite.testAccounts["local_account_2"]
+	welcome := suite.testTags["welcome"]
+	createdAt := testrig.TimeMustParse("2022-11-28T10:00:00Z")
+
+	boost := &gtsmodel.Status{}
+	*boost = *suite.testStatuses["local_account_2_status_1"]
+	boost.ID = "01GJYAV0Q5GQF8DA3D8YHB1VQM"
+	boost.URI = "http://localhost:8080/users/1happyturtle/statuses/01GJYAV0Q5GQF8DA3D8YHB1VQM"
+	boost.CreatedAt = createdAt
+	boost.BoostOfID = suite.testStatuses["admin_account_status_1"].ID
+	boost.BoostOfAccountID = suite.testStatuses["admin_account_status_1"].AccountID
+	boost.TagIDs = nil
+
+	tagged := &gtsmodel.Status{}
+	*tagged = *suite.testStatuses["local_account_2_status_1"]
+	tagged.ID = "01GJYAV8S7S4G4GZ1RHBFR3BM9"
+	tagged.URI = "http://localhost:8080/users/1happyturtle/statuses/01GJYAV8S7S4G4GZ1RHBFR3BM9"
+	tagged.CreatedAt = createdAt
+	tagged.TagIDs = []string{welcome.ID}
+
+	for _, s := range []*gtsmodel.Status{boost, tagged} {
+		if err := suite.db.Put(context.Background(), s); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	stats, err := suite.db.ComputeAccountDailyStats(context.Background(), account.ID, createdAt, createdAt.Add(24*time.Hour))
+	suite.NoError(err)
+	suite.Len(stats, 1)
+	suite.Equal(1, stats[0].Statuses)
+	suite.Equal(1, stats[0].Boosts)
+	suite.Equal([]gtsmodel.AccountTagCount{{TagID: welcome.ID, Count: 1}}, stats[0].Tags)
+}
+
+func (suite *AccountStatsTestSuite) TestPutGetAccountDailyStats() {
+	account := suite.testAccounts["local_account_1"]
+
+	stats, err := suite.db.ComputeAccountDailyStats(context.Background(), account.ID, testrig.TimeMustParse("2022-05-13T00:00:00Z"), testrig.TimeMustParse("2022-05-21T00:00:00Z"))
+	suite.NoError(err)
+	suite.Len(stats, 8)
+
+	ids := []string{
+		"01GJYB1T3KXN4E2BVDV6GQ7Z4D",
+		"01GJYB1T3KZ7QXH0MPCCNWS4DK",
+		"01GJYB1T3M0YWXA4PK6R4QG2VS",
+		"01GJYB1T3M3RCGSGCYZC7ZBV9N",
+		"01GJYB1T3M5D3HJYF1T3R4WDK0",
+		"01GJYB1T3M7P9SWZTC3EB0A0V3",
+		"01GJYB1T3M9T60D8DHK8C2RE64",
+		"01GJYB1T3MBQJ4E4EQBTJ8SMHM",
+	}
+	for i, s := range stats {
+		s.ID = ids[i]
+	}
+
+	err = suite.db.PutAccountDailyStats(context.Background(), stats)
+	suite.NoError(err)
+
+	// storing the same days again should be a no-op
+	err = suite.db.PutAccountDailyStats(context.Background(), stats[5:])
+	suite.NoError(err)
+
+	stored, err := suite.db.GetAccountDailyStats(context.Background(), account.ID, testrig.TimeMustParse("2022-05-15T00:00:00Z"))
+	suite.NoError(err)
+	suite.Len(stored, 6)
+	suite.Equal(ids[2], stored[0].ID)
+	suite.True(stored[0].Day.Equal(testrig.TimeMustParse("2022-05-15T00:00:00Z")))
+	suite.Equal(2, stored[0].Followers)
+	suite.Equal(1, stored[5].Statuses)
+	suite.True(stored[5].Day.Equal(testrig.TimeMustParse("2022-05-20T00:00:00Z")))
+}
+
+func TestAccountStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountStatsTestSuite))
+}
@@ -26,6 +26,7 @@ import (
 	"net"
 	"net/mail"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -37,6 +38,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -47,6 +49,7 @@ type adminDB struct {
 	conn         *DBConn
 	userCache    *cache.UserCache
 	accountCache *cache.AccountCache
+	maintenance  sync.Mutex // held while maintenance is running
 }
 
 func (a *adminDB) IsUsernameAvailable(ctx context.Context, username string) (bool, db.Error) {
@@ -56,7 +59,18 @@ func (a *adminDB) IsUsernameAvailable(ctx context.Context, username string) (boo
 		Column("account.id").
 		Where("? = ?", bun.Ident("account.username"), username).
 		Where("? IS NULL", bun.Ident("account.domain"))
-	return a.conn.NotExists(ctx, q)
+	if available, err := a.conn.NotExists(ctx, q); err != nil || !available {
+		return available, err
+	}
+
+	// usernames that accounts had before they
+	// were renamed stay reserved for those accounts
+	aliasQ := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_username_aliases"), bun.Ident("account_username_alias")).
+		Column("account_username_alias.id").
+		Where("? = ?", bun.Ident("account_username_alias.username"), username)
+	return a.conn.NotExists(ctx, aliasQ)
 }
 
 func (a *adminDB) IsEmailAvailable(ctx context.Context, email string) (bool, db.Error) {
@@ -306,3 +320,190 @@ func (a *adminDB) CreateInstanceInstance(ctx context.Context) db.Error {
 	log.Infof("created instance instance %s with id %s", host, i.ID)
 	return nil
 }
+
+// hostURIColumns lists the columns of each table which may contain URIs or
+// URLs that point at this instance, and so must be rewritten on a host change.
+// This includes rendered html, since status content and account notes link to
+// mentioned accounts and hashtags with the host they were rendered under.
+var hostURIColumns = []struct {
+	table   string
+	columns []string
+}{
+	{"accounts", []string{"note", "uri", "url", "inbox_uri", "shared_inbox_uri", "outbox_uri", "following_uri", "followers_uri", "featured_collection_uri", "public_key_uri"}},
+	{"blocks", []string{"uri"}},
+	{"emojis", []string{"uri", "image_url", "image_static_url"}},
+	{"follows", []string{"uri"}},
+	{"follow_requests", []string{"uri"}},
+	{"media_attachments", []string{"url", "thumbnail_url", "medium_url"}},
+	{"mentions", []string{"origin_account_uri"}},
+	{"poll_votes", []string{"uri"}},
+	{"statuses", []string{"content", "uri", "url", "account_uri", "in_reply_to_uri"}},
+	{"status_faves", []string{"uri"}},
+	{"tags", []string{"url"}},
+	{"tombstones", []string{"uri"}},
+}
+
+func (a *adminDB) RenameHost(ctx context.Context, oldHost string) db.Error {
+	protocol := config.GetProtocol()
+	newHost := config.GetHost()
+
+	if oldHost == "" || oldHost == newHost {
+		return fmt.Errorf("RenameHost: old host %q must be set and differ from the configured host %q", oldHost, newHost)
+	}
+
+	// only match the host part of a uri, so that paths
+	// which happen to contain the old host are left alone
+	from := "://" + oldHost + "/"
+	to := "://" + newHost + "/"
+
+	if err := a.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		for _, t := range hostURIColumns {
+			for _, column := range t.columns {
+				if _, err := tx.
+					NewUpdate().
+					Table(t.table).
+					Set("? = REPLACE(?, ?, ?)", bun.Ident(column), bun.Ident(column), from, to).
+					Where("? LIKE ?", bun.Ident(column), "%"+from+"%").
+					Exec(ctx); err != nil {
+					return fmt.Errorf("error rewriting %s.%s: %w", t.table, column, err)
+				}
+			}
+		}
+
+		// the instance account is named after the host, so
+		// its username and uris need to be changed wholesale
+		newAccountURIs := uris.GenerateURIsForAccount(newHost)
+		if _, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			Set("? = ?", bun.Ident("username"), newHost).
+			Set("? = ?", bun.Ident("display_name"), newHost).
+			Set("? = ?", bun.Ident("url"), newAccountURIs.UserURL).
+			Set("? = ?", bun.Ident("uri"), newAccountURIs.UserURI).
+			Set("? = ?", bun.Ident("inbox_uri"), newAccountURIs.InboxURI).
+			Set("? = ?", bun.Ident("outbox_uri"), newAccountURIs.OutboxURI).
+			Set("? = ?", bun.Ident("followers_uri"), newAccountURIs.FollowersURI).
+			Set("? = ?", bun.Ident("following_uri"), newAccountURIs.FollowingURI).
+			Set("? = ?", bun.Ident("featured_collection_uri"), newAccountURIs.CollectionURI).
+			Set("? = ?", bun.Ident("public_key_uri"), newAccountURIs.PublicKeyURI).
+			Where("? = ?", bun.Ident("account.username"), oldHost).
+			Where("(? IS NULL OR ? = '')", bun.Ident("account.domain"), bun.Ident("account.domain")).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("error renaming instance account: %w", err)
+		}
+
+		if _, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("instances"), bun.Ident("instance")).
+			Set("? = ?", bun.Ident("domain"), newHost).
+			Set("? = ?", bun.Ident("uri"), fmt.Sprintf("%s://%s", protocol, newHost)).
+			Where("? = ?", bun.Ident("instance.domain"), oldHost).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("error renaming instance entry: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return a.conn.ProcessError(err)
+	}
+
+	log.Infof("renamed host %s to %s", oldHost, newHost)
+	return nil
+}
+
+func (a *adminDB) Maintain(ctx context.Context, vacuum bool, reindex bool) (*db.MaintenanceResult, db.Error) {
+	// maintenance can be run by schedule, command
+	// and API, but only one run at a time makes sense
+	if !a.maintenance.TryLock() {
+		return nil, db.ErrMaintenanceInProgress
+	}
+	defer a.maintenance.Unlock()
+
+	result := &db.MaintenanceResult{}
+
+	var err error
+	if result.SizeBefore, err = a.size(ctx); err != nil {
+		return nil, err
+	}
+
+	switch a.conn.Dialect().Name() {
+	case dialect.SQLite:
+		if vacuum {
+			if err := a.vacuumSQLite(ctx); err != nil {
+				return nil, a.conn.ProcessError(err)
+			}
+			result.Vacuumed = true
+		}
+	case dialect.PG:
+		// postgres reclaims space using autovacuum, and a full
+		// vacuum would lock tables for far too long, so we only
+		// rebuild bloated indexes (without locking writes) if asked
+		if reindex {
+			var dbName string
+			if err := a.conn.QueryRowContext(ctx, "SELECT current_database()").Scan(&dbName); err != nil {
+				return nil, a.conn.ProcessError(err)
+			}
+			if _, err := a.conn.ExecContext(ctx, "REINDEX DATABASE CONCURRENTLY ?", bun.Ident(dbName)); err != nil {
+				return nil, a.conn.ProcessError(err)
+			}
+			result.Reindexed = true
+		}
+	default:
+		log.Panic("db dialect was neither pg nor sqlite")
+	}
+
+	if _, err := a.conn.ExecContext(ctx, "ANALYZE"); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	if result.SizeAfter, err = a.size(ctx); err != nil {
+		return nil, err
+	}
+
+	log.Infof("database maintenance finished: size before %d bytes, size after %d bytes, reclaimed %d bytes", result.SizeBefore, result.SizeAfter, result.Reclaimed())
+	return result, nil
+}
+
+// vacuumSQLite reclaims unused space in an sqlite database. Databases using incremental
+// auto vacuum just have their free pages released, which is much quicker than a full vacuum,
+// while other databases are rebuilt. Either has to run outside of a transaction.
+func (a *adminDB) vacuumSQLite(ctx context.Context) error {
+	var autoVacuum int
+	if err := a.conn.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&autoVacuum); err != nil {
+		return err
+	}
+
+	// 2 is incremental: https://www.sqlite.org/pragma.html#pragma_auto_vacuum
+	if autoVacuum == 2 {
+		_, err := a.conn.ExecContext(ctx, "PRAGMA incremental_vacuum")
+		return err
+	}
+
+	_, err := a.conn.ExecContext(ctx, "VACUUM")
+	return err
+}
+
+// size returns the current size of the database in bytes.
+func (a *adminDB) size(ctx context.Context) (int64, db.Error) {
+	var size int64
+
+	switch a.conn.Dialect().Name() {
+	case dialect.SQLite:
+		var pageCount, pageSize int64
+		if err := a.conn.QueryRowContext(ctx, "PRAGMA page_count").Scan(&pageCount); err != nil {
+			return 0, a.conn.ProcessError(err)
+		}
+		if err := a.conn.QueryRowContext(ctx, "PRAGMA page_size").Scan(&pageSize); err != nil {
+			return 0, a.conn.ProcessError(err)
+		}
+		size = pageCount * pageSize
+	case dialect.PG:
+		if err := a.conn.QueryRowContext(ctx, "SELECT pg_database_size(current_database())").Scan(&size); err != nil {
+			return 0, a.conn.ProcessError(err)
+		}
+	default:
+		log.Panic("db dialect was neither pg nor sqlite")
+	}
+
+	return size, nil
+}
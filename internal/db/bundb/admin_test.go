@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations/20211113114307_init"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -90,6 +92,80 @@ func (suite *AdminTestSuite) TestCreateInstanceAccount() {
 	suite.NotNil(acct)
 }
 
+func (suite *AdminTestSuite) TestRenameHost() {
+	ctx := context.Background()
+	config.SetHost("new.example.org")
+
+	// give a local status a rendered mention of a local account and a
+	// hashtag, and a local account a note linking to another account
+	status := *suite.testStatuses["local_account_1_status_1"]
+	status.Content = `<p>hey <span class="h-card"><a href="http://localhost:8080/@1happyturtle" class="u-url mention">@<span>1happyturtle</span></a></span> look at <a href="http://localhost:8080/tags/welcome" class="mention hashtag" rel="tag">#<span>welcome</span></a></p>`
+	suite.NoError(suite.db.UpdateByID(ctx, &status, status.ID, "content"))
+
+	account := *suite.testAccounts["local_account_1"]
+	account.Note = `<p>friends with <span class="h-card"><a href="http://localhost:8080/@1happyturtle" class="u-url mention">@<span>1happyturtle</span></a></span></p>`
+	suite.NoError(suite.db.UpdateByID(ctx, &account, account.ID, "note"))
+
+	err := suite.db.RenameHost(ctx, "localhost:8080")
+	suite.NoError(err)
+
+	// local account uris should point at the new host
+	zork, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.Equal("http://new.example.org/users/the_mighty_zork", zork.URI)
+	suite.Equal("http://new.example.org/@the_mighty_zork", zork.URL)
+	suite.Equal("http://new.example.org/users/the_mighty_zork/inbox", zork.InboxURI)
+	suite.Equal("http://new.example.org/users/the_mighty_zork/main-key", zork.PublicKeyURI)
+	suite.Equal(`<p>friends with <span class="h-card"><a href="http://new.example.org/@1happyturtle" class="u-url mention">@<span>1happyturtle</span></a></span></p>`, zork.Note)
+
+	// and so should local statuses
+	renamed, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal("http://new.example.org/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY", renamed.URI)
+	suite.Equal("http://new.example.org/users/the_mighty_zork", renamed.AccountURI)
+
+	// including the links in their rendered content
+	suite.Equal(`<p>hey <span class="h-card"><a href="http://new.example.org/@1happyturtle" class="u-url mention">@<span>1happyturtle</span></a></span> look at <a href="http://new.example.org/tags/welcome" class="mention hashtag" rel="tag">#<span>welcome</span></a></p>`, renamed.Content)
+
+	// remote accounts should be left alone
+	remote, err := suite.db.GetAccountByID(ctx, suite.testAccounts["remote_account_1"].ID)
+	suite.NoError(err)
+	suite.Equal(suite.testAccounts["remote_account_1"].URI, remote.URI)
+
+	// the instance account should have been renamed
+	instanceAccount, err := suite.db.GetInstanceAccount(ctx, "")
+	suite.NoError(err)
+	suite.Equal("new.example.org", instanceAccount.Username)
+	suite.Equal("http://new.example.org/users/new.example.org", instanceAccount.URI)
+
+	// as should the instance entry
+	instance := &gtsmodel.Instance{}
+	err = suite.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: "new.example.org"}}, instance)
+	suite.NoError(err)
+	suite.Equal("http://new.example.org", instance.URI)
+}
+
+func (suite *AdminTestSuite) TestRenameHostSameHost() {
+	err := suite.db.RenameHost(context.Background(), config.GetHost())
+	suite.Error(err)
+}
+
+func (suite *AdminTestSuite) TestMaintain() {
+	result, err := suite.db.Maintain(context.Background(), true, true)
+	suite.NoError(err)
+	suite.True(result.Vacuumed)
+	suite.Positive(result.SizeBefore)
+	suite.Positive(result.SizeAfter)
+}
+
+func (suite *AdminTestSuite) TestMaintainNoVacuum() {
+	result, err := suite.db.Maintain(context.Background(), false, false)
+	suite.NoError(err)
+	suite.False(result.Vacuumed)
+	suite.False(result.Reindexed)
+	suite.Positive(result.SizeAfter)
+}
+
 func TestAdminTestSuite(t *testing.T) {
 	suite.Run(t, new(AdminTestSuite))
 }
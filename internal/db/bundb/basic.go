@@ -21,7 +21,9 @@ package bundb
 import (
 	"context"
 	"errors"
+	"sort"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -29,7 +31,8 @@ import (
 )
 
 type basicDB struct {
-	conn *DBConn
+	conn   *DBConn
+	caches map[string]interface{ Stats() cache.Stats }
 }
 
 func (b *basicDB) Put(ctx context.Context, i interface{}) db.Error {
@@ -76,6 +79,7 @@ func (b *basicDB) DeleteByID(ctx context.Context, id string, i interface{}) db.E
 		Where("id = ?", id)
 
 	_, err := q.Exec(ctx)
+	requestInvalidate(ctx, i)
 	return b.conn.ProcessError(err)
 }
 
@@ -91,6 +95,7 @@ func (b *basicDB) DeleteWhere(ctx context.Context, where []db.Where, i interface
 	deleteWhere(q, where)
 
 	_, err := q.Exec(ctx)
+	requestInvalidate(ctx, i)
 	return b.conn.ProcessError(err)
 }
 
@@ -102,6 +107,7 @@ func (b *basicDB) UpdateByID(ctx context.Context, i interface{}, id string, colu
 		Where("? = ?", bun.Ident("id"), id)
 
 	_, err := q.Exec(ctx)
+	requestInvalidate(ctx, i)
 	return b.conn.ProcessError(err)
 }
 
@@ -113,25 +119,63 @@ func (b *basicDB) UpdateWhere(ctx context.Context, where []db.Where, key string,
 	q = q.Set("? = ?", bun.Ident(key), value)
 
 	_, err := q.Exec(ctx)
+	requestInvalidate(ctx, i)
 	return b.conn.ProcessError(err)
 }
 
+// requestInvalidate forgets the lookups remembered in the request cache of ctx for models
+// of the same type as i, for those models which are only ever changed through basicDB.
+func requestInvalidate(ctx context.Context, i interface{}) {
+	switch i.(type) {
+	case *gtsmodel.MediaAttachment, *[]*gtsmodel.MediaAttachment:
+		cache.RequestInvalidate(ctx, requestCacheAttachments)
+	}
+}
+
 func (b *basicDB) CreateTable(ctx context.Context, i interface{}) db.Error {
-	_, err := b.conn.NewCreateTable().Model(i).IfNotExists().Exec(ctx)
-	return err
+	if _, err := b.conn.NewCreateTable().Model(i).IfNotExists().Exec(ctx); err != nil {
+		return err
+	}
+
+	// the status search index hangs off the statuses
+	// table, so it has to be created along with it
+	if _, ok := i.(*gtsmodel.Status); ok {
+		return createStatusSearchIndex(ctx, b.conn.DB)
+	}
+
+	return nil
 }
 
 func (b *basicDB) CreateAllTables(ctx context.Context) db.Error {
 	models := []interface{}{
 		&gtsmodel.Account{},
+		&gtsmodel.AccountDomainBlock{},
+		&gtsmodel.AccountDailyStats{},
+		&gtsmodel.AccountEmojiUsage{},
+		&gtsmodel.AccountUsernameAlias{},
 		&gtsmodel.Application{},
+		&gtsmodel.ApplicationConsent{},
+		&gtsmodel.ClientSetting{},
 		&gtsmodel.Block{},
+		&gtsmodel.BookmarkFolder{},
 		&gtsmodel.DomainBlock{},
+		&gtsmodel.DomainNote{},
+		&gtsmodel.DomainEmojiPolicy{},
 		&gtsmodel.EmailDomainBlock{},
+		&gtsmodel.FeaturedTag{},
+		&gtsmodel.InboxItem{},
+		&gtsmodel.LegalDocument{},
+		&gtsmodel.List{},
+		&gtsmodel.ListEntry{},
+		&gtsmodel.Filter{},
+		&gtsmodel.FilterKeyword{},
+		&gtsmodel.FilterStatus{},
 		&gtsmodel.Follow{},
 		&gtsmodel.FollowRequest{},
 		&gtsmodel.MediaAttachment{},
 		&gtsmodel.Mention{},
+		&gtsmodel.Poll{},
+		&gtsmodel.PollVote{},
 		&gtsmodel.Status{},
 		&gtsmodel.StatusToEmoji{},
 		&gtsmodel.StatusToTag{},
@@ -156,14 +200,36 @@ func (b *basicDB) CreateAllTables(ctx context.Context) db.Error {
 }
 
 func (b *basicDB) DropTable(ctx context.Context, i interface{}) db.Error {
-	_, err := b.conn.NewDropTable().Model(i).IfExists().Exec(ctx)
-	return b.conn.ProcessError(err)
+	if _, err := b.conn.NewDropTable().Model(i).IfExists().Exec(ctx); err != nil {
+		return b.conn.ProcessError(err)
+	}
+
+	if _, ok := i.(*gtsmodel.Status); ok {
+		return b.conn.ProcessError(dropStatusSearchIndex(ctx, b.conn.DB))
+	}
+
+	return nil
 }
 
 func (b *basicDB) IsHealthy(ctx context.Context) db.Error {
 	return b.conn.Ping()
 }
 
+func (b *basicDB) PoolStats() []db.PoolStats {
+	return b.conn.PoolStats()
+}
+
+func (b *basicDB) CacheStats() []db.CacheStats {
+	stats := make([]db.CacheStats, 0, len(b.caches))
+	for name, c := range b.caches {
+		stats = append(stats, db.CacheStats{Name: name, Stats: c.Stats()})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+	return stats
+}
+
 func (b *basicDB) Stop(ctx context.Context) db.Error {
 	log.Info("closing db connection")
 	return b.conn.Close()
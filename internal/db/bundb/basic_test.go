@@ -134,6 +134,18 @@ func (suite *BasicTestSuite) TestGetAllNotNull() {
 	}
 }
 
+func (suite *BasicTestSuite) TestPoolStats() {
+	// run a query so the pool has a connection open
+	a := &gtsmodel.Account{}
+	suite.NoError(suite.db.GetByID(context.Background(), suite.testAccounts["local_account_1"].ID, a))
+
+	stats := suite.db.PoolStats()
+	suite.Len(stats, 1)
+	suite.Equal("primary", stats[0].Name)
+	suite.Positive(stats[0].OpenConnections)
+	suite.Equal(stats[0].OpenConnections, stats[0].InUse+stats[0].Idle)
+}
+
 func TestBasicTestSuite(t *testing.T) {
 	suite.Run(t, new(BasicTestSuite))
 }
@@ -0,0 +1,137 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type bookmarkFolderDB struct {
+	conn *DBConn
+}
+
+func (b *bookmarkFolderDB) GetBookmarkFolderByID(ctx context.Context, id string) (*gtsmodel.BookmarkFolder, db.Error) {
+	folder := &gtsmodel.BookmarkFolder{}
+
+	if err := b.conn.
+		NewSelect().
+		Model(folder).
+		Where("? = ?", bun.Ident("bookmark_folder.id"), id).
+		Scan(ctx); err != nil {
+		return nil, b.conn.ProcessError(err)
+	}
+
+	return folder, nil
+}
+
+func (b *bookmarkFolderDB) GetBookmarkFoldersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BookmarkFolder, db.Error) {
+	folders := []*gtsmodel.BookmarkFolder{}
+
+	if err := b.conn.
+		NewSelect().
+		Model(&folders).
+		Where("? = ?", bun.Ident("bookmark_folder.account_id"), accountID).
+		Order("bookmark_folder.id ASC").
+		Scan(ctx); err != nil {
+		return nil, b.conn.ProcessError(err)
+	}
+
+	return folders, nil
+}
+
+func (b *bookmarkFolderDB) UpdateBookmarkFolder(ctx context.Context, folder *gtsmodel.BookmarkFolder, columns ...string) db.Error {
+	folder.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := b.conn.
+		NewUpdate().
+		Model(folder).
+		Where("? = ?", bun.Ident("bookmark_folder.id"), folder.ID).
+		Column(columns...).
+		Exec(ctx)
+	return b.conn.ProcessError(err)
+}
+
+func (b *bookmarkFolderDB) DeleteBookmarkFolderByID(ctx context.Context, id string) db.Error {
+	return b.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewUpdate().
+			Table("status_bookmarks").
+			Set("? = NULL", bun.Ident("folder_id")).
+			Where("? = ?", bun.Ident("folder_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("bookmark_folders"), bun.Ident("bookmark_folder")).
+			Where("? = ?", bun.Ident("bookmark_folder.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (b *bookmarkFolderDB) GetBookmarkedStatusIDs(ctx context.Context, accountID string, statusIDs []string) ([]string, db.Error) {
+	bookmarkedIDs := []string{}
+	if len(statusIDs) == 0 {
+		return bookmarkedIDs, nil
+	}
+
+	if err := b.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_bookmarks"), bun.Ident("status_bookmark")).
+		Column("status_bookmark.status_id").
+		Where("? = ?", bun.Ident("status_bookmark.account_id"), accountID).
+		Where("? IN (?)", bun.Ident("status_bookmark.status_id"), bun.In(statusIDs)).
+		Scan(ctx, &bookmarkedIDs); err != nil {
+		return nil, b.conn.ProcessError(err)
+	}
+
+	return bookmarkedIDs, nil
+}
+
+func (b *bookmarkFolderDB) FileBookmarks(ctx context.Context, accountID string, statusIDs []string, folderID string) db.Error {
+	if len(statusIDs) == 0 {
+		return nil
+	}
+
+	q := b.conn.
+		NewUpdate().
+		Table("status_bookmarks").
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? IN (?)", bun.Ident("status_id"), bun.In(statusIDs))
+
+	if folderID == "" {
+		q = q.Set("? = NULL", bun.Ident("folder_id"))
+	} else {
+		q = q.Set("? = ?", bun.Ident("folder_id"), folderID)
+	}
+
+	_, err := q.Exec(ctx)
+	return b.conn.ProcessError(err)
+}
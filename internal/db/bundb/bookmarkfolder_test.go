@@ -0,0 +1,96 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type BookmarkFolderTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *BookmarkFolderTestSuite) putBookmark(id string, statusKey string) {
+	status := suite.testStatuses[statusKey]
+	suite.NoError(suite.db.Put(context.Background(), &gtsmodel.StatusBookmark{
+		ID:              id,
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+	}))
+}
+
+func (suite *BookmarkFolderTestSuite) TestFileBookmarks() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+	folder := suite.testBookmarkFolders["local_account_1_bookmark_folder_1"]
+	statusIDs := []string{suite.testStatuses["admin_account_status_1"].ID, suite.testStatuses["local_account_2_status_1"].ID}
+
+	suite.putBookmark("01GKZBS1TTBN4W5C6SDM1E5NXA", "admin_account_status_1")
+	suite.putBookmark("01GKZBSAV0Y0D5WD2VMX3DJS4R", "local_account_2_status_1")
+
+	bookmarkedIDs, err := suite.db.GetBookmarkedStatusIDs(ctx, accountID, append(statusIDs, suite.testStatuses["local_account_1_status_1"].ID))
+	suite.NoError(err)
+	suite.ElementsMatch(statusIDs, bookmarkedIDs)
+
+	suite.NoError(suite.db.FileBookmarks(ctx, accountID, statusIDs[:1], folder.ID))
+
+	statuses, _, _, err := suite.db.GetBookmarkedTimeline(ctx, accountID, folder.ID, "", "", 20)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(statusIDs[0], statuses[0].ID)
+	}
+
+	statuses, _, _, err = suite.db.GetBookmarkedTimeline(ctx, accountID, "", "", "", 20)
+	suite.NoError(err)
+	suite.Len(statuses, 2)
+
+	suite.NoError(suite.db.FileBookmarks(ctx, accountID, statusIDs[:1], ""))
+
+	_, _, _, err = suite.db.GetBookmarkedTimeline(ctx, accountID, folder.ID, "", "", 20)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *BookmarkFolderTestSuite) TestDeleteBookmarkFolderByID() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+	folder := suite.testBookmarkFolders["local_account_1_bookmark_folder_1"]
+
+	suite.putBookmark("01GKZBS1TTBN4W5C6SDM1E5NXA", "admin_account_status_1")
+	suite.NoError(suite.db.FileBookmarks(ctx, accountID, []string{suite.testStatuses["admin_account_status_1"].ID}, folder.ID))
+
+	suite.NoError(suite.db.DeleteBookmarkFolderByID(ctx, folder.ID))
+
+	_, err := suite.db.GetBookmarkFolderByID(ctx, folder.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// the bookmark is kept, but no longer filed
+	bookmark := &gtsmodel.StatusBookmark{}
+	suite.NoError(suite.db.GetByID(ctx, "01GKZBS1TTBN4W5C6SDM1E5NXA", bookmark))
+	suite.Empty(bookmark.FolderID)
+}
+
+func TestBookmarkFolderTestSuite(t *testing.T) {
+	suite.Run(t, new(BookmarkFolderTestSuite))
+}
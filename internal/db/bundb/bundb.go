@@ -77,13 +77,19 @@ type DBService struct {
 	db.Account
 	db.Admin
 	db.Basic
+	db.BookmarkFolder
 	db.Domain
 	db.Emoji
+	db.Filter
+	db.Inbox
 	db.Instance
+	db.List
 	db.Media
 	db.Mention
 	db.Notification
+	db.Poll
 	db.Relationship
+	db.Search
 	db.Session
 	db.Status
 	db.Timeline
@@ -125,41 +131,27 @@ func doMigration(ctx context.Context, db *bun.DB) error {
 // NewBunDBService returns a bunDB derived from the provided config, which implements the go-fed DB interface.
 // Under the hood, it uses https://github.com/uptrace/bun to create and maintain a database connection.
 func NewBunDBService(ctx context.Context) (db.DB, error) {
-	var conn *DBConn
-	var err error
-	dbType := strings.ToLower(config.GetDbType())
-
-	switch dbType {
-	case dbTypePostgres:
-		conn, err = pgConn(ctx)
-		if err != nil {
-			return nil, err
-		}
-	case dbTypeSqlite:
-		conn, err = sqliteConn(ctx)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, fmt.Errorf("database type %s not supported for bundb", dbType)
-	}
-
-	// Add database query hook
-	conn.DB.AddQueryHook(queryHook{})
-
-	// table registration is needed for many-to-many, see:
-	// https://bun.uptrace.dev/orm/many-to-many-relation/
-	for _, t := range registerTables {
-		conn.RegisterModel(t)
+	conn, err := newConn(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// perform any pending database migrations: this includes
 	// the very first 'migration' on startup which just creates
-	// necessary tables
-	if err := doMigration(ctx, conn.DB); err != nil {
+	// necessary tables; if the admin would rather run them
+	// separately, just make sure there aren't any pending
+	if config.GetDbSkipMigrations() {
+		if err := checkNoPendingMigrations(ctx, conn.DB); err != nil {
+			return nil, err
+		}
+	} else if err := doMigration(ctx, conn.DB); err != nil {
 		return nil, fmt.Errorf("db migration error: %s", err)
 	}
 
+	if err := conn.useCacheBus(ctx); err != nil {
+		return nil, err
+	}
+
 	// Prepare caches required by more than one struct
 	userCache := cache.NewUserCache()
 	accountCache := cache.NewAccountCache()
@@ -167,20 +159,42 @@ func NewBunDBService(ctx context.Context) (db.DB, error) {
 	// Prepare other caches
 	// Prepare mentions cache
 	// TODO: move into internal/cache
-	mentionCache := grufcache.New[string, *gtsmodel.Mention]()
-	mentionCache.SetTTL(time.Minute*5, false)
+	mentionCache := cache.NewCounted(grufcache.New[string, *gtsmodel.Mention]())
+	mentionCache.SetTTL(config.GetCacheMentionTTL(), false)
+	mentionCache.SetMaxSize(config.GetCacheMentionMaxSize())
 	mentionCache.Start(time.Second * 10)
 
 	// Prepare notifications cache
 	// TODO: move into internal/cache
-	notifCache := grufcache.New[string, *gtsmodel.Notification]()
-	notifCache.SetTTL(time.Minute*5, false)
+	notifCache := cache.NewCounted(grufcache.New[string, *gtsmodel.Notification]())
+	notifCache.SetTTL(config.GetCacheNotificationTTL(), false)
+	notifCache.SetMaxSize(config.GetCacheNotificationMaxSize())
 	notifCache.Start(time.Second * 10)
 
+	statusCache := cache.NewStatusCache()
+	emojiCache := cache.NewEmojiCache()
+	emojiCategoryCache := cache.NewEmojiCategoryCache()
+	domainBlockCache := cache.NewDomainBlockCache()
+
+	// Drop cached copies of things when another
+	// process using the same database changes them
+	conn.bus.Subscribe(cacheAccounts, accountCache.Invalidate)
+	conn.bus.Subscribe(cacheAccountStatuses, statusCache.InvalidateAccount)
+	conn.bus.Subscribe(cacheDomainBlocks, domainBlockCache.InvalidateByDomain)
+	conn.bus.Subscribe(cacheEmojiCategories, func(key string) {
+		emojiCategoryCache.Invalidate(key)
+		emojiCache.InvalidateCategory(key)
+	})
+	conn.bus.Subscribe(cacheEmojis, emojiCache.Invalidate)
+	conn.bus.Subscribe(cacheMentions, func(key string) { mentionCache.Invalidate(key) })
+	conn.bus.Subscribe(cacheNotifications, func(key string) { notifCache.Invalidate(key) })
+	conn.bus.Subscribe(cacheStatuses, statusCache.Invalidate)
+	conn.bus.Subscribe(cacheUsers, userCache.Invalidate)
+
 	// Create DB structs that require ptrs to each other
 	accounts := &accountDB{conn: conn, cache: accountCache}
-	status := &statusDB{conn: conn, cache: cache.NewStatusCache()}
-	emoji := &emojiDB{conn: conn, emojiCache: cache.NewEmojiCache(), categoryCache: cache.NewEmojiCategoryCache()}
+	status := &statusDB{conn: conn, cache: statusCache}
+	emoji := &emojiDB{conn: conn, emojiCache: emojiCache, categoryCache: emojiCategoryCache}
 	timeline := &timelineDB{conn: conn}
 	tombstone := &tombstoneDB{conn: conn}
 
@@ -201,15 +215,38 @@ func NewBunDBService(ctx context.Context) (db.DB, error) {
 		},
 		Basic: &basicDB{
 			conn: conn,
+			caches: map[string]interface{ Stats() cache.Stats }{
+				cacheAccounts:        accountCache,
+				cacheDomainBlocks:    domainBlockCache,
+				cacheEmojiCategories: emojiCategoryCache,
+				cacheEmojis:          emojiCache,
+				cacheMentions:        mentionCache,
+				cacheNotifications:   notifCache,
+				cacheStatuses:        statusCache,
+				cacheUsers:           userCache,
+			},
+		},
+		BookmarkFolder: &bookmarkFolderDB{
+			conn: conn,
 		},
 		Domain: &domainDB{
 			conn:  conn,
-			cache: cache.NewDomainBlockCache(),
+			cache: domainBlockCache,
 		},
 		Emoji: emoji,
+		Filter: &filterDB{
+			conn: conn,
+		},
+		Inbox: &inboxDB{
+			conn: conn,
+		},
 		Instance: &instanceDB{
 			conn: conn,
 		},
+		List: &listDB{
+			conn:     conn,
+			accounts: accounts,
+		},
 		Media: &mediaDB{
 			conn: conn,
 		},
@@ -221,9 +258,16 @@ func NewBunDBService(ctx context.Context) (db.DB, error) {
 			conn:  conn,
 			cache: notifCache,
 		},
+		Poll: &pollDB{
+			conn: conn,
+		},
 		Relationship: &relationshipDB{
 			conn: conn,
 		},
+		Search: &searchDB{
+			conn:   conn,
+			status: status,
+		},
 		Session: &sessionDB{
 			conn: conn,
 		},
@@ -241,6 +285,50 @@ func NewBunDBService(ctx context.Context) (db.DB, error) {
 	return ps, nil
 }
 
+// newConn opens a connection to the configured database, ready for use
+// by bun, but without running any migrations against it.
+func newConn(ctx context.Context) (*DBConn, error) {
+	var conn *DBConn
+	var err error
+	dbType := strings.ToLower(config.GetDbType())
+
+	switch dbType {
+	case dbTypePostgres:
+		conn, err = pgConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+	case dbTypeSqlite:
+		conn, err = sqliteConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("database type %s not supported for bundb", dbType)
+	}
+
+	// Add database query hook
+	hook := newQueryHook()
+	conn.DB.AddQueryHook(hook)
+
+	// table registration is needed for many-to-many, see:
+	// https://bun.uptrace.dev/orm/many-to-many-relation/
+	for _, t := range registerTables {
+		conn.RegisterModel(t)
+	}
+
+	// replicas need the same setup as the primary,
+	// since queries on them are built the same way
+	for _, r := range conn.replicas {
+		r.AddQueryHook(hook)
+		for _, t := range registerTables {
+			r.RegisterModel(t)
+		}
+	}
+
+	return conn, nil
+}
+
 func sqliteConn(ctx context.Context) (*DBConn, error) {
 	// validate db address has actually been set
 	dbAddress := config.GetDbAddress()
@@ -252,26 +340,23 @@ func sqliteConn(ctx context.Context) (*DBConn, error) {
 	dbAddress = strings.Split(dbAddress, "?")[0]
 	dbAddress = strings.TrimPrefix(dbAddress, "file:")
 
-	// Append our own SQLite preferences
-	dbAddress = "file:" + dbAddress + "?cache=shared"
+	// Append our own SQLite preferences; transactions take the write
+	// lock as soon as they begin, so that they never have to wait for
+	// it part way through while holding a read lock (see sqlitebusy.go)
+	dbAddress = "file:" + dbAddress + "?cache=shared&_txlock=immediate"
 
 	var inMem bool
 
-	if dbAddress == "file::memory:?cache=shared" {
-		dbAddress = fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.NewString())
+	if dbAddress == "file::memory:?cache=shared&_txlock=immediate" {
+		dbAddress = fmt.Sprintf("file:%s?mode=memory&cache=shared&_txlock=immediate", uuid.NewString())
 		log.Infof("using in-memory database address " + dbAddress)
 		log.Warn("sqlite in-memory database should only be used for debugging")
 		inMem = true
 	}
 
-	// Open new DB instance
-	sqldb, err := sql.Open("sqlite", dbAddress)
-	if err != nil {
-		if errWithCode, ok := err.(*sqlite.Error); ok {
-			err = errors.New(sqlite.ErrorCodeString[errWithCode.Code()])
-		}
-		return nil, fmt.Errorf("could not open sqlite db: %s", err)
-	}
+	// Open new DB instance, retrying queries while the database is busy
+	busyTimeout := time.Duration(config.GetDbSqliteBusyTimeoutSeconds()) * time.Second
+	sqldb := sql.OpenDB(newSQLiteConnector(dbAddress, busyTimeout))
 
 	tweakConnectionValues(sqldb)
 
@@ -284,6 +369,10 @@ func sqliteConn(ctx context.Context) (*DBConn, error) {
 
 	conn := WrapDBConn(bun.NewDB(sqldb, sqlitedialect.New()))
 
+	if len(config.GetDbReplicaAddresses()) != 0 {
+		log.Warnf("%s is only supported for postgres, all queries will go to the sqlite database", config.DbReplicaAddressesFlag())
+	}
+
 	// ping to check the db is there and listening
 	if err := conn.PingContext(ctx); err != nil {
 		if errWithCode, ok := err.(*sqlite.Error); ok {
@@ -313,7 +402,13 @@ func pgConn(ctx context.Context) (*DBConn, error) {
 		return nil, fmt.Errorf("postgres ping: %s", err)
 	}
 
-	log.Info("connected to POSTGRES database")
+	replicas, err := pgReplicaConns()
+	if err != nil {
+		return nil, err
+	}
+	conn.useReplicas(ctx, replicas)
+
+	log.Infof("connected to POSTGRES database with %d read replicas", len(replicas))
 	return conn, nil
 }
 
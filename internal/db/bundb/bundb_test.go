@@ -31,17 +31,22 @@ type BunDBStandardTestSuite struct {
 	db db.DB
 
 	// standard suite models
-	testTokens       map[string]*gtsmodel.Token
-	testClients      map[string]*gtsmodel.Client
-	testApplications map[string]*gtsmodel.Application
-	testUsers        map[string]*gtsmodel.User
-	testAccounts     map[string]*gtsmodel.Account
-	testAttachments  map[string]*gtsmodel.MediaAttachment
-	testStatuses     map[string]*gtsmodel.Status
-	testTags         map[string]*gtsmodel.Tag
-	testMentions     map[string]*gtsmodel.Mention
-	testFollows      map[string]*gtsmodel.Follow
-	testEmojis       map[string]*gtsmodel.Emoji
+	testTokens          map[string]*gtsmodel.Token
+	testClients         map[string]*gtsmodel.Client
+	testApplications    map[string]*gtsmodel.Application
+	testUsers           map[string]*gtsmodel.User
+	testAccounts        map[string]*gtsmodel.Account
+	testAttachments     map[string]*gtsmodel.MediaAttachment
+	testStatuses        map[string]*gtsmodel.Status
+	testTags            map[string]*gtsmodel.Tag
+	testMentions        map[string]*gtsmodel.Mention
+	testFollows         map[string]*gtsmodel.Follow
+	testEmojis          map[string]*gtsmodel.Emoji
+	testLists           map[string]*gtsmodel.List
+	testListEntries     map[string]*gtsmodel.ListEntry
+	testFilters         map[string]*gtsmodel.Filter
+	testFilterKeywords  map[string]*gtsmodel.FilterKeyword
+	testBookmarkFolders map[string]*gtsmodel.BookmarkFolder
 }
 
 func (suite *BunDBStandardTestSuite) SetupSuite() {
@@ -56,6 +61,11 @@ func (suite *BunDBStandardTestSuite) SetupSuite() {
 	suite.testMentions = testrig.NewTestMentions()
 	suite.testFollows = testrig.NewTestFollows()
 	suite.testEmojis = testrig.NewTestEmojis()
+	suite.testLists = testrig.NewTestLists()
+	suite.testListEntries = testrig.NewTestListEntries()
+	suite.testFilters = testrig.NewTestFilters()
+	suite.testFilterKeywords = testrig.NewTestFilterKeywords()
+	suite.testBookmarkFolders = testrig.NewTestBookmarkFolders()
 }
 
 func (suite *BunDBStandardTestSuite) SetupTest() {
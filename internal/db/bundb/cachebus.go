@@ -0,0 +1,205 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// cacheInvalidationPostgres carries cache invalidations over postgres LISTEN/NOTIFY.
+const cacheInvalidationPostgres = "postgres"
+
+// Names of the caches, used when sharing invalidations with other processes and in cache statistics.
+const (
+	cacheAccounts        = "accounts"
+	cacheAccountStatuses = "account_statuses"
+	cacheDomainBlocks    = "domain_blocks"
+	cacheEmojiCategories = "emoji_categories"
+	cacheEmojis          = "emojis"
+	cacheMentions        = "mentions"
+	cacheNotifications   = "notifications"
+	cacheStatuses        = "statuses"
+	cacheUsers           = "users"
+)
+
+const (
+	// pgCacheBusChannel is the postgres notification channel that invalidations are sent on.
+	pgCacheBusChannel = "gotosocial_cache_invalidation"
+	// pgCacheBusRetryInterval is how long to wait before listening again after losing the connection.
+	pgCacheBusRetryInterval = 5 * time.Second
+)
+
+// useCacheBus sets up the transport configured for sharing cache invalidations
+// with other processes that use the same database, if there is one.
+func (conn *DBConn) useCacheBus(ctx context.Context) error {
+	switch transport := config.GetDbCacheInvalidation(); transport {
+	case "":
+		return nil
+	case cacheInvalidationPostgres:
+		if conn.Dialect().Name() != dialect.PG {
+			return fmt.Errorf("%s %s requires a postgres database", config.DbCacheInvalidationFlag(), transport)
+		}
+
+		cfg, err := deriveBunDBPGOptions() //nolint:contextcheck
+		if err != nil {
+			return fmt.Errorf("could not create postgres options for cache invalidation: %s", err)
+		}
+
+		bus := &pgCacheBus{
+			db:     conn.DB,
+			config: cfg,
+			node:   uuid.NewString(),
+		}
+
+		listenCtx, cancel := context.WithCancel(context.Background())
+		if err := bus.start(ctx, listenCtx); err != nil {
+			cancel()
+			return fmt.Errorf("could not listen for cache invalidations: %s", err)
+		}
+
+		conn.bus = bus
+		conn.stopBus = cancel
+		log.Info("sharing cache invalidations with other processes using postgres LISTEN/NOTIFY")
+		return nil
+	default:
+		return fmt.Errorf("%s %s not supported, must be one of: '', '%s'", config.DbCacheInvalidationFlag(), transport, cacheInvalidationPostgres)
+	}
+}
+
+// pgCacheBusMessage is the payload of one cache invalidation notification.
+type pgCacheBusMessage struct {
+	Node  string `json:"node"` // Node identifies the process which sent the message, so it can ignore its own
+	Cache string `json:"cache"`
+	Key   string `json:"key"`
+}
+
+// pgCacheBus is a cache.Bus which carries invalidations between processes using postgres LISTEN/NOTIFY.
+type pgCacheBus struct {
+	cache.Subscribers
+	db     *bun.DB         // db is used to send notifications
+	config *pgx.ConnConfig // config is used to open the connection that notifications are received on
+	node   string          // node identifies this process
+}
+
+func (b *pgCacheBus) Publish(ctx context.Context, name string, key string) {
+	payload, err := json.Marshal(pgCacheBusMessage{
+		Node:  b.node,
+		Cache: name,
+		Key:   key,
+	})
+	if err != nil {
+		log.Errorf("pgCacheBus: error encoding invalidation of %s %s: %s", name, key, err)
+		return
+	}
+
+	if _, err := b.db.ExecContext(ctx, "SELECT pg_notify(?, ?)", pgCacheBusChannel, string(payload)); err != nil {
+		log.Warnf("pgCacheBus: error publishing invalidation of %s %s, other processes may serve it stale: %s", name, key, err)
+	}
+}
+
+// start begins listening for invalidations from other processes, until listenCtx is
+// cancelled. If the first connection can't be made, it returns an error instead.
+func (b *pgCacheBus) start(ctx context.Context, listenCtx context.Context) error {
+	conn, err := b.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	go b.listen(listenCtx, conn)
+	return nil
+}
+
+// connect opens a connection to the database and listens for invalidations on it.
+func (b *pgCacheBus) connect(ctx context.Context) (*pgx.Conn, error) {
+	conn, err := pgx.ConnectConfig(ctx, b.config.Copy())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgCacheBusChannel); err != nil {
+		_ = conn.Close(ctx)
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// listen passes invalidations received on conn to subscribers until ctx is cancelled,
+// reconnecting whenever the connection is lost. Invalidations sent while there's no
+// connection are missed, so cached copies may be stale until they expire.
+func (b *pgCacheBus) listen(ctx context.Context, conn *pgx.Conn) {
+	for {
+		err := b.receive(ctx, conn)
+		_ = conn.Close(context.Background())
+		if ctx.Err() != nil {
+			return
+		}
+		log.Errorf("pgCacheBus: lost connection listening for cache invalidations, cached data may be stale for a few minutes: %s", err)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(pgCacheBusRetryInterval):
+			}
+
+			if conn, err = b.connect(ctx); err == nil {
+				log.Info("pgCacheBus: listening for cache invalidations again")
+				break
+			}
+			log.Errorf("pgCacheBus: error reconnecting to listen for cache invalidations, retrying in %s: %s", pgCacheBusRetryInterval, err)
+		}
+	}
+}
+
+// receive passes invalidations received on conn to subscribers until there's an error.
+func (b *pgCacheBus) receive(ctx context.Context, conn *pgx.Conn) error {
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		msg := pgCacheBusMessage{}
+		if err := json.Unmarshal([]byte(notification.Payload), &msg); err != nil {
+			log.Warnf("pgCacheBus: error decoding invalidation %q: %s", notification.Payload, err)
+			continue
+		}
+
+		if msg.Node == b.node {
+			// we sent this one
+			continue
+		}
+
+		if !b.Invalidate(msg.Cache, msg.Key) {
+			log.Debugf("pgCacheBus: received invalidation for unknown cache %s", msg.Cache)
+		}
+	}
+}
@@ -3,8 +3,12 @@ package bundb
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"sync/atomic"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect"
 )
@@ -13,6 +17,14 @@ import (
 type DBConn struct {
 	errProc func(error) db.Error // errProc is the SQL-type specific error processor
 	*bun.DB                      // DB is the underlying bun.DB connection
+
+	replicas     []*replica         // replicas are read-only copies of DB that selects may be routed to
+	nextReplica  atomic.Uint32      // nextReplica is used to spread reads across replicas
+	stopReplicas context.CancelFunc // stopReplicas stops checking replication lag, if replicas are in use
+	primaryLSN   string             // primaryLSN is the write-ahead log position of the primary at the last check of replicas
+
+	bus     cache.Bus          // bus shares cache invalidations with other processes using the same database
+	stopBus context.CancelFunc // stopBus stops listening for invalidations from other processes, if the bus is in use
 }
 
 // WrapDBConn wraps a bun DB connection to provide our own error processing dependent on DB dialect.
@@ -29,7 +41,60 @@ func WrapDBConn(dbConn *bun.DB) *DBConn {
 	return &DBConn{
 		errProc: errProc,
 		DB:      dbConn,
+		bus:     cache.NewLocalBus(),
+	}
+}
+
+// NewSelect returns a new select query on a replica, if there's one that's usable,
+// or otherwise on the primary. Selects done inside transactions always use the primary,
+// as do selects made with a context that's been used to write (see db.WithReadYourWrites).
+func (conn *DBConn) NewSelect() *bun.SelectQuery {
+	if len(conn.replicas) == 0 {
+		return conn.DB.NewSelect()
+	}
+	return conn.DB.NewSelect().Conn(readRouter{conn: conn})
+}
+
+// useReplicas starts routing selects to the given replicas, while
+// they're reachable and not too far behind the primary.
+func (conn *DBConn) useReplicas(ctx context.Context, replicas []*replica) {
+	if len(replicas) == 0 {
+		return
+	}
+
+	conn.replicas = replicas
+	conn.checkReplicas(ctx)
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	conn.stopReplicas = cancel
+	go conn.watchReplicas(watchCtx)
+}
+
+// Close stops listening for cache invalidations and checking replicas, and closes
+// the connections to replicas, then closes the primary connection.
+func (conn *DBConn) Close() error {
+	if conn.stopBus != nil {
+		conn.stopBus()
+	}
+	if conn.stopReplicas != nil {
+		conn.stopReplicas()
+	}
+	for _, r := range conn.replicas {
+		if err := r.Close(); err != nil {
+			log.Errorf("error closing connection to replica %s: %s", r.address, err)
+		}
+	}
+	return conn.DB.Close()
+}
+
+// PoolStats returns the statistics for the connection pool to the primary, followed by those for each replica.
+func (conn *DBConn) PoolStats() []db.PoolStats {
+	stats := make([]db.PoolStats, 0, 1+len(conn.replicas))
+	stats = append(stats, db.PoolStats{Name: "primary", DBStats: conn.DB.Stats()})
+	for _, r := range conn.replicas {
+		stats = append(stats, db.PoolStats{Name: r.address, DBStats: r.Stats()})
 	}
+	return stats
 }
 
 // RunInTx wraps execution of the supplied transaction function.
@@ -69,6 +134,8 @@ func (conn *DBConn) ProcessError(err error) db.Error {
 		return nil
 	case err == sql.ErrNoRows:
 		return db.ErrNoEntries
+	case errors.Is(err, context.DeadlineExceeded):
+		return db.ErrTimeout
 	default:
 		return conn.errProc(err)
 	}
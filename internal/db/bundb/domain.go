@@ -23,11 +23,13 @@ import (
 	"database/sql"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/uptrace/bun"
 	"golang.org/x/net/idna"
 )
@@ -62,8 +64,10 @@ func (d *domainDB) CreateDomainBlock(ctx context.Context, block *gtsmodel.Domain
 		return d.conn.ProcessError(err)
 	}
 
-	// Cache this domain block
+	// Cache this domain block, and tell other processes, which
+	// may have cached that the domain isn't blocked
 	d.cache.Put(block.Domain, block)
+	d.conn.bus.Publish(ctx, cacheDomainBlocks, block.Domain)
 
 	return nil
 }
@@ -134,6 +138,186 @@ func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) db.Erro
 
 	// Clear domain from cache
 	d.cache.InvalidateByDomain(domain)
+	d.conn.bus.Publish(ctx, cacheDomainBlocks, domain)
+
+	return nil
+}
+
+func (d *domainDB) GetExpiredDomainBlocks(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.DomainBlock, db.Error) {
+	blocks := []*gtsmodel.DomainBlock{}
+
+	q := d.conn.
+		NewSelect().
+		Model(&blocks).
+		Where("? IS NOT NULL", bun.Ident("domain_block.expires_at")).
+		Where("? <= ?", bun.Ident("domain_block.expires_at"), now).
+		Order("domain_block.expires_at ASC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return blocks, nil
+}
+
+func (d *domainDB) PutDomainNote(ctx context.Context, note *gtsmodel.DomainNote) db.Error {
+	domain, err := normalizeDomain(note.Domain)
+	if err != nil {
+		return err
+	}
+	note.Domain = domain
+	note.UpdatedAt = time.Now()
+
+	// if a note already exists for this domain, just update the text and editor
+	if _, err := d.conn.
+		NewInsert().
+		Model(note).
+		On("CONFLICT (?) DO UPDATE", bun.Ident("domain")).
+		Set("? = EXCLUDED.?", bun.Ident("text"), bun.Ident("text")).
+		Set("? = EXCLUDED.?", bun.Ident("account_id"), bun.Ident("account_id")).
+		Set("? = EXCLUDED.?", bun.Ident("updated_at"), bun.Ident("updated_at")).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) GetDomainNote(ctx context.Context, domain string) (*gtsmodel.DomainNote, db.Error) {
+	var err error
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &gtsmodel.DomainNote{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(note).
+		Where("? = ?", bun.Ident("domain_note.domain"), domain).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return note, nil
+}
+
+func (d *domainDB) GetDomainNotes(ctx context.Context) ([]*gtsmodel.DomainNote, db.Error) {
+	notes := []*gtsmodel.DomainNote{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(&notes).
+		Order("domain_note.domain ASC").
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	if len(notes) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return notes, nil
+}
+
+func (d *domainDB) DeleteDomainNote(ctx context.Context, domain string) db.Error {
+	var err error
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.conn.NewDelete().
+		Model((*gtsmodel.DomainNote)(nil)).
+		Where("? = ?", bun.Ident("domain_note.domain"), domain).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) PutDomainEmojiPolicy(ctx context.Context, policy *gtsmodel.DomainEmojiPolicy) db.Error {
+	domain, err := normalizeDomain(policy.Domain)
+	if err != nil {
+		return err
+	}
+	policy.Domain = domain
+	policy.UpdatedAt = time.Now()
+
+	// if a policy already exists for this domain, just update the policy and editor
+	if _, err := d.conn.
+		NewInsert().
+		Model(policy).
+		On("CONFLICT (?) DO UPDATE", bun.Ident("domain")).
+		Set("? = EXCLUDED.?", bun.Ident("policy"), bun.Ident("policy")).
+		Set("? = EXCLUDED.?", bun.Ident("account_id"), bun.Ident("account_id")).
+		Set("? = EXCLUDED.?", bun.Ident("updated_at"), bun.Ident("updated_at")).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) GetDomainEmojiPolicy(ctx context.Context, domain string) (*gtsmodel.DomainEmojiPolicy, db.Error) {
+	var err error
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &gtsmodel.DomainEmojiPolicy{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(policy).
+		Where("? = ?", bun.Ident("domain_emoji_policy.domain"), domain).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return policy, nil
+}
+
+func (d *domainDB) GetDomainEmojiPolicies(ctx context.Context) ([]*gtsmodel.DomainEmojiPolicy, db.Error) {
+	policies := []*gtsmodel.DomainEmojiPolicy{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(&policies).
+		Order("domain_emoji_policy.domain ASC").
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	if len(policies) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return policies, nil
+}
+
+func (d *domainDB) DeleteDomainEmojiPolicy(ctx context.Context, domain string) db.Error {
+	var err error
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.conn.NewDelete().
+		Model((*gtsmodel.DomainEmojiPolicy)(nil)).
+		Where("? = ?", bun.Ident("domain_emoji_policy.domain"), domain).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
 
 	return nil
 }
@@ -171,3 +355,105 @@ func (d *domainDB) AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, d
 	}
 	return false, nil
 }
+
+func (d *domainDB) PutAccountDomainBlock(ctx context.Context, block *gtsmodel.AccountDomainBlock) db.Error {
+	domain, err := normalizeDomain(block.Domain)
+	if err != nil {
+		return err
+	}
+	block.Domain = domain
+
+	if _, err := d.conn.NewInsert().
+		Model(block).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) GetAccountDomainBlock(ctx context.Context, accountID string, domain string) (*gtsmodel.AccountDomainBlock, db.Error) {
+	var err error
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accounts can't block *us*
+	if domain == "" || domain == config.GetAccountDomain() {
+		return nil, db.ErrNoEntries
+	}
+
+	block := &gtsmodel.AccountDomainBlock{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(block).
+		Where("? = ?", bun.Ident("account_domain_block.account_id"), accountID).
+		Where("? = ?", bun.Ident("account_domain_block.domain"), domain).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return block, nil
+}
+
+func (d *domainDB) GetAccountDomainBlocks(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.AccountDomainBlock, db.Error) {
+	blocks := []*gtsmodel.AccountDomainBlock{}
+
+	q := d.conn.
+		NewSelect().
+		Model(&blocks).
+		Where("? = ?", bun.Ident("account_domain_block.account_id"), accountID)
+
+	page := paging.Page{Max: maxID, Since: sinceID, Limit: limit}
+	q = page.Apply(q, "account_domain_block.id", paging.OrderDescending)
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	if len(blocks) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return blocks, nil
+}
+
+func (d *domainDB) DeleteAccountDomainBlock(ctx context.Context, accountID string, domain string) db.Error {
+	var err error
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.conn.NewDelete().
+		Model((*gtsmodel.AccountDomainBlock)(nil)).
+		Where("? = ?", bun.Ident("account_domain_block.account_id"), accountID).
+		Where("? = ?", bun.Ident("account_domain_block.domain"), domain).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) IsDomainBlockedByAccount(ctx context.Context, accountID string, domain string) (bool, db.Error) {
+	block, err := d.GetAccountDomainBlock(ctx, accountID, domain)
+	if err == nil || err == db.ErrNoEntries {
+		return (block != nil), nil
+	}
+	return false, err
+}
+
+func (d *domainDB) AreDomainsBlockedByAccount(ctx context.Context, accountID string, domains []string) (bool, db.Error) {
+	for _, domain := range domains {
+		if blocked, err := d.IsDomainBlockedByAccount(ctx, accountID, domain); err != nil {
+			return false, err
+		} else if blocked {
+			return blocked, nil
+		}
+	}
+	return false, nil
+}
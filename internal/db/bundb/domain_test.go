@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -128,6 +129,76 @@ func (suite *DomainTestSuite) TestIsDomainBlockedNonASCII2() {
 	suite.True(blocked)
 }
 
+func (suite *DomainTestSuite) TestPutDomainNote() {
+	ctx := context.Background()
+
+	note := &gtsmodel.DomainNote{
+		ID:        "01GJ9Z3QK5V4RZ3WN6XB8A0TKM",
+		Domain:    "Some.Bad.Apples",
+		Text:      "limited 2023-06 due to spam wave, re-evaluate",
+		AccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	err := suite.db.PutDomainNote(ctx, note)
+	suite.NoError(err)
+
+	// putting a note for the same domain again should update the existing note
+	err = suite.db.PutDomainNote(ctx, &gtsmodel.DomainNote{
+		ID:        "01GJ9Z4C8RNG4WJ1QJ7A5MHC0E",
+		Domain:    "some.bad.apples",
+		Text:      "spam wave is over",
+		AccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.NoError(err)
+
+	dbNote, err := suite.db.GetDomainNote(ctx, "some.bad.apples")
+	suite.NoError(err)
+	suite.Equal(note.ID, dbNote.ID)
+	suite.Equal("spam wave is over", dbNote.Text)
+
+	notes, err := suite.db.GetDomainNotes(ctx)
+	suite.NoError(err)
+	suite.Len(notes, 1)
+
+	err = suite.db.DeleteDomainNote(ctx, "some.bad.apples")
+	suite.NoError(err)
+
+	_, err = suite.db.GetDomainNote(ctx, "some.bad.apples")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *DomainTestSuite) TestGetExpiredDomainBlocks() {
+	ctx := context.Background()
+	now := time.Now()
+
+	expired := &gtsmodel.DomainBlock{
+		ID:                 "01GKN1X8T1MJ3GQ6C6PZ0VXQ0E",
+		Domain:             "expired.example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		ExpiresAt:          now.Add(-time.Minute),
+	}
+	notYet := &gtsmodel.DomainBlock{
+		ID:                 "01GKN1XF7JHD6AQ4W1Q3SXYTRM",
+		Domain:             "not-yet.example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		ExpiresAt:          now.Add(time.Hour),
+	}
+	never := &gtsmodel.DomainBlock{
+		ID:                 "01GKN1XNB7B1R0F3C3Q2SQ4W9Z",
+		Domain:             "never.example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	for _, block := range []*gtsmodel.DomainBlock{expired, notYet, never} {
+		suite.NoError(suite.db.CreateDomainBlock(ctx, block))
+	}
+
+	blocks, err := suite.db.GetExpiredDomainBlocks(ctx, now, 0)
+	suite.NoError(err)
+	if suite.Len(blocks, 1) {
+		suite.Equal(expired.ID, blocks[0].ID)
+	}
+}
+
 func TestDomainTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainTestSuite))
 }
@@ -26,7 +26,9 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect"
 )
@@ -73,9 +75,55 @@ func (e *emojiDB) UpdateEmoji(ctx context.Context, emoji *gtsmodel.Emoji, column
 	}
 
 	e.emojiCache.Invalidate(emoji.ID)
+	e.emojiCache.InvalidateMisses(emoji)
+	e.conn.bus.Publish(ctx, cacheEmojis, emoji.ID)
+	cache.RequestInvalidate(ctx, cacheEmojis)
 	return emoji, nil
 }
 
+func (e *emojiDB) UpdateEmojisDisabledByDomain(ctx context.Context, domain string, disabled bool) ([]string, db.Error) {
+	emojiIDs := []string{}
+
+	if err := e.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// find emojis from this domain that need changing
+		if err := tx.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+			Column("emoji.id").
+			Where("? = ?", bun.Ident("emoji.domain"), domain).
+			Where("? != ?", bun.Ident("emoji.disabled"), disabled).
+			Scan(ctx, &emojiIDs); err != nil {
+			return err
+		}
+
+		if len(emojiIDs) == 0 {
+			return nil
+		}
+
+		// change them all in one go
+		if _, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+			Set("? = ?", bun.Ident("disabled"), disabled).
+			Set("? = ?", bun.Ident("updated_at"), time.Now()).
+			Where("? IN (?)", bun.Ident("emoji.id"), bun.In(emojiIDs)).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	for _, emojiID := range emojiIDs {
+		e.emojiCache.Invalidate(emojiID)
+		e.conn.bus.Publish(ctx, cacheEmojis, emojiID)
+	}
+	cache.RequestInvalidate(ctx, cacheEmojis)
+	return emojiIDs, nil
+}
+
 func (e *emojiDB) DeleteEmojiByID(ctx context.Context, id string) db.Error {
 	if err := e.conn.RunInTx(ctx, func(tx bun.Tx) error {
 		// delete links between this emoji and any statuses that use it
@@ -96,6 +144,15 @@ func (e *emojiDB) DeleteEmojiByID(ctx context.Context, id string) db.Error {
 			return err
 		}
 
+		// delete counts of how often accounts have used this emoji
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("account_emoji_usages"), bun.Ident("account_emoji_usage")).
+			Where("? = ?", bun.Ident("account_emoji_usage.emoji_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		if _, err := tx.
 			NewDelete().
 			TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
@@ -110,6 +167,8 @@ func (e *emojiDB) DeleteEmojiByID(ctx context.Context, id string) db.Error {
 	}
 
 	e.emojiCache.Invalidate(id)
+	e.conn.bus.Publish(ctx, cacheEmojis, id)
+	cache.RequestInvalidate(ctx, cacheEmojis)
 	return nil
 }
 
@@ -174,24 +233,13 @@ func (e *emojiDB) GetEmojis(ctx context.Context, domain string, includeDisabled
 		subQuery = subQuery.Where("LOWER(?) = LOWER(?)", bun.Ident("emoji.shortcode"), shortcode)
 	}
 
-	// assume we want to sort ASC (a-z) unless informed otherwise
-	order := "ASC"
-
-	if maxShortcodeDomain != "" {
-		subQuery = subQuery.Where("? > LOWER(?)", bun.Ident("shortcode_domain"), maxShortcodeDomain)
-	}
-
-	if minShortcodeDomain != "" {
-		subQuery = subQuery.Where("? < LOWER(?)", bun.Ident("shortcode_domain"), minShortcodeDomain)
-		// if we have a minShortcodeDomain we're paging upwards/backwards
-		order = "DESC"
-	}
-
-	subQuery = subQuery.Order("shortcode_domain " + order)
-
-	if limit > 0 {
-		subQuery = subQuery.Limit(limit)
+	// emojis are listed a-z by shortcode_domain, which is lowercase
+	page := paging.Page{
+		Max:   strings.ToLower(maxShortcodeDomain),
+		Min:   strings.ToLower(minShortcodeDomain),
+		Limit: limit,
 	}
+	subQuery = page.Apply(subQuery, "shortcode_domain", paging.OrderAscending)
 
 	// Wrap the subQuery in a query, since we don't need to select the shortcode_domain column.
 	//
@@ -216,21 +264,14 @@ func (e *emojiDB) GetEmojis(ctx context.Context, domain string, includeDisabled
 		return nil, e.conn.ProcessError(err)
 	}
 
-	if order == "DESC" {
-		// Reverse the slice order so the caller still
-		// gets emojis in expected a-z alphabetical order.
-		//
-		// See https://github.com/golang/go/wiki/SliceTricks#reversing
-		for i := len(emojiIDs)/2 - 1; i >= 0; i-- {
-			opp := len(emojiIDs) - 1 - i
-			emojiIDs[i], emojiIDs[opp] = emojiIDs[opp], emojiIDs[i]
-		}
-	}
+	// if we were paging upwards/backwards, make sure the
+	// caller still gets emojis in a-z alphabetical order
+	paging.Reorder(page, emojiIDs)
 
-	return e.emojisFromIDs(ctx, emojiIDs)
+	return e.GetEmojisByIDs(ctx, emojiIDs)
 }
 
-func (e *emojiDB) GetUseableEmojis(ctx context.Context) ([]*gtsmodel.Emoji, db.Error) {
+func (e *emojiDB) GetUseableEmojis(ctx context.Context, shortcode string, categoryID string, limit int) ([]*gtsmodel.Emoji, db.Error) {
 	emojiIDs := []string{}
 
 	q := e.conn.
@@ -242,28 +283,114 @@ func (e *emojiDB) GetUseableEmojis(ctx context.Context) ([]*gtsmodel.Emoji, db.E
 		Where("? IS NULL", bun.Ident("emoji.domain")).
 		Order("emoji.shortcode ASC")
 
+	if shortcode != "" {
+		// underscores are common in shortcodes, but are
+		// also a LIKE wildcard, so they need escaping
+		pattern := "%" + likeEscaper.Replace(strings.ToLower(shortcode)) + "%"
+		q = q.Where("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("emoji.shortcode"), pattern, likeEscapeChar)
+	}
+
+	if categoryID != "" {
+		q = q.Where("? = ?", bun.Ident("emoji.category_id"), categoryID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
 	if err := q.Scan(ctx, &emojiIDs); err != nil {
 		return nil, e.conn.ProcessError(err)
 	}
 
-	return e.emojisFromIDs(ctx, emojiIDs)
+	return e.GetEmojisByIDs(ctx, emojiIDs)
+}
+
+func (e *emojiDB) SearchUseableEmojis(ctx context.Context, query string, limit int) ([]*gtsmodel.Emoji, db.Error) {
+	escaped := likeEscaper.Replace(strings.ToLower(query))
+	prefix := escaped + "%"
+	substring := "%" + escaped + "%"
+
+	useable := func() *bun.SelectQuery {
+		return e.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+			Column("emoji.id").
+			Where("? = ?", bun.Ident("emoji.visible_in_picker"), true).
+			Where("? = ?", bun.Ident("emoji.disabled"), false).
+			Where("? IS NULL", bun.Ident("emoji.domain")).
+			Order("emoji.shortcode ASC").
+			Limit(limit)
+	}
+
+	// prefix matches first; these can use the index on lower(shortcode)
+	emojiIDs := []string{}
+	if err := useable().
+		Where("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("emoji.shortcode"), prefix, likeEscapeChar).
+		Scan(ctx, &emojiIDs); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	// then fill up the rest with matches in the middle of shortcodes
+	if remaining := limit - len(emojiIDs); remaining > 0 {
+		moreIDs := []string{}
+		if err := useable().
+			Where("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("emoji.shortcode"), substring, likeEscapeChar).
+			Where("LOWER(?) NOT LIKE ? ESCAPE ?", bun.Ident("emoji.shortcode"), prefix, likeEscapeChar).
+			Limit(remaining).
+			Scan(ctx, &moreIDs); err != nil {
+			return nil, e.conn.ProcessError(err)
+		}
+		emojiIDs = append(emojiIDs, moreIDs...)
+	}
+
+	return e.GetEmojisByIDs(ctx, emojiIDs)
+}
+
+func (e *emojiDB) GetAllEmojis(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Emoji, db.Error) {
+	emojiIDs := []string{}
+
+	q := e.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+		Column("emoji.id").
+		Order("emoji.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("emoji.id"), maxID)
+	}
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &emojiIDs); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	return e.GetEmojisByIDs(ctx, emojiIDs)
 }
 
 func (e *emojiDB) GetEmojiByID(ctx context.Context, id string) (*gtsmodel.Emoji, db.Error) {
-	return e.getEmoji(
-		ctx,
-		func() (*gtsmodel.Emoji, bool) {
-			return e.emojiCache.GetByID(id)
-		},
-		func(emoji *gtsmodel.Emoji) error {
-			return e.newEmojiQ(emoji).Where("? = ?", bun.Ident("emoji.id"), id).Scan(ctx)
-		},
-	)
+	return cache.RequestCached(ctx, cacheEmojis, "id:"+id, func() (*gtsmodel.Emoji, error) {
+		return e.getEmoji(
+			ctx,
+			"id",
+			id,
+			func() (*gtsmodel.Emoji, bool) {
+				return e.emojiCache.GetByID(id)
+			},
+			func(emoji *gtsmodel.Emoji) error {
+				return e.newEmojiQ(emoji).Where("? = ?", bun.Ident("emoji.id"), id).Scan(ctx)
+			},
+		)
+	})
 }
 
 func (e *emojiDB) GetEmojiByURI(ctx context.Context, uri string) (*gtsmodel.Emoji, db.Error) {
 	return e.getEmoji(
 		ctx,
+		"uri",
+		uri,
 		func() (*gtsmodel.Emoji, bool) {
 			return e.emojiCache.GetByURI(uri)
 		},
@@ -276,6 +403,8 @@ func (e *emojiDB) GetEmojiByURI(ctx context.Context, uri string) (*gtsmodel.Emoj
 func (e *emojiDB) GetEmojiByShortcodeDomain(ctx context.Context, shortcode string, domain string) (*gtsmodel.Emoji, db.Error) {
 	return e.getEmoji(
 		ctx,
+		"shortcodedomain",
+		cache.ShortcodeDomainKey(shortcode, domain),
 		func() (*gtsmodel.Emoji, bool) {
 			return e.emojiCache.GetByShortcodeDomain(shortcode, domain)
 		},
@@ -298,6 +427,8 @@ func (e *emojiDB) GetEmojiByShortcodeDomain(ctx context.Context, shortcode strin
 func (e *emojiDB) GetEmojiByStaticURL(ctx context.Context, imageStaticURL string) (*gtsmodel.Emoji, db.Error) {
 	return e.getEmoji(
 		ctx,
+		"imagestaticurl",
+		imageStaticURL,
 		func() (*gtsmodel.Emoji, bool) {
 			return e.emojiCache.GetByImageStaticURL(imageStaticURL)
 		},
@@ -332,12 +463,14 @@ func (e *emojiDB) GetEmojiCategories(ctx context.Context) ([]*gtsmodel.EmojiCate
 		return nil, e.conn.ProcessError(err)
 	}
 
-	return e.emojiCategoriesFromIDs(ctx, emojiCategoryIDs)
+	return e.GetEmojiCategoriesByIDs(ctx, emojiCategoryIDs)
 }
 
 func (e *emojiDB) GetEmojiCategory(ctx context.Context, id string) (*gtsmodel.EmojiCategory, db.Error) {
 	return e.getEmojiCategory(
 		ctx,
+		"id",
+		id,
 		func() (*gtsmodel.EmojiCategory, bool) {
 			return e.categoryCache.GetByID(id)
 		},
@@ -350,6 +483,8 @@ func (e *emojiDB) GetEmojiCategory(ctx context.Context, id string) (*gtsmodel.Em
 func (e *emojiDB) GetEmojiCategoryByName(ctx context.Context, name string) (*gtsmodel.EmojiCategory, db.Error) {
 	return e.getEmojiCategory(
 		ctx,
+		"name",
+		name,
 		func() (*gtsmodel.EmojiCategory, bool) {
 			return e.categoryCache.GetByName(name)
 		},
@@ -359,17 +494,222 @@ func (e *emojiDB) GetEmojiCategoryByName(ctx context.Context, name string) (*gts
 	)
 }
 
-func (e *emojiDB) getEmoji(ctx context.Context, cacheGet func() (*gtsmodel.Emoji, bool), dbQuery func(*gtsmodel.Emoji) error) (*gtsmodel.Emoji, db.Error) {
+func (e *emojiDB) UpdateEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.EmojiCategory, columns ...string) (*gtsmodel.EmojiCategory, db.Error) {
+	// Update the emoji category's last-updated
+	emojiCategory.UpdatedAt = time.Now()
+
+	if _, err := e.conn.
+		NewUpdate().
+		Model(emojiCategory).
+		Where("? = ?", bun.Ident("emoji_category.id"), emojiCategory.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	e.categoryCache.Invalidate(emojiCategory.ID)
+	e.categoryCache.InvalidateMisses(emojiCategory)
+	e.emojiCache.InvalidateCategory(emojiCategory.ID)
+	e.conn.bus.Publish(ctx, cacheEmojiCategories, emojiCategory.ID)
+	cache.RequestInvalidate(ctx, cacheEmojis)
+	return emojiCategory, nil
+}
+
+func (e *emojiDB) DeleteEmojiCategory(ctx context.Context, id string) db.Error {
+	if err := e.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// uncategorize emojis that are still in this category
+		if _, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+			Set("? = NULL", bun.Ident("category_id")).
+			Where("? = ?", bun.Ident("emoji.category_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("emoji_categories"), bun.Ident("emoji_category")).
+			Where("? = ?", bun.Ident("emoji_category.id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return e.conn.ProcessError(err)
+	}
+
+	e.emojiCache.InvalidateCategory(id)
+	cache.RequestInvalidate(ctx, cacheEmojis)
+	e.categoryCache.Invalidate(id)
+	e.conn.bus.Publish(ctx, cacheEmojiCategories, id)
+	return nil
+}
+
+func (e *emojiDB) GetEmojisByCategoryID(ctx context.Context, categoryID string) ([]*gtsmodel.Emoji, db.Error) {
+	emojiIDs := []string{}
+
+	if err := e.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+		Column("emoji.id").
+		Where("? = ?", bun.Ident("emoji.category_id"), categoryID).
+		Order("emoji.shortcode ASC").
+		Scan(ctx, &emojiIDs); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	if len(emojiIDs) == 0 {
+		return []*gtsmodel.Emoji{}, nil
+	}
+
+	return e.GetEmojisByIDs(ctx, emojiIDs)
+}
+
+func (e *emojiDB) GetEmojiUsage(ctx context.Context, domain string, ascending bool, limit int) ([]*db.EmojiUsage, db.Error) {
+	usage := []*db.EmojiUsage{}
+
+	// Count uses of each emoji with correlated subqueries on the
+	// status and account join tables, then sort by the total in
+	// an outer query, since pg won't let us use the column aliases
+	// in an ORDER BY expression. The final query looks like:
+	//
+	//	SELECT * FROM (
+	//		SELECT
+	//			"emoji"."id" AS "emoji_id",
+	//			(SELECT COUNT(*) FROM "status_to_emojis" AS "status_to_emoji" WHERE "status_to_emoji"."emoji_id" = "emoji"."id") AS "statuses_count",
+	//			(SELECT COUNT(*) FROM "account_to_emojis" AS "account_to_emoji" WHERE "account_to_emoji"."emoji_id" = "emoji"."id") AS "accounts_count"
+	//		FROM "emojis" AS "emoji"
+	//	) AS "emoji_usage"
+	//	ORDER BY "statuses_count" + "accounts_count" DESC, "emoji_id" ASC
+	statusesCountQ := e.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_emojis"), bun.Ident("status_to_emoji")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("status_to_emoji.emoji_id"), bun.Ident("emoji.id"))
+
+	accountsCountQ := e.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_to_emojis"), bun.Ident("account_to_emoji")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("account_to_emoji.emoji_id"), bun.Ident("emoji.id"))
+
+	subQuery := e.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+		ColumnExpr("? AS ?", bun.Ident("emoji.id"), bun.Ident("emoji_id")).
+		ColumnExpr("(?) AS ?", statusesCountQ, bun.Ident("statuses_count")).
+		ColumnExpr("(?) AS ?", accountsCountQ, bun.Ident("accounts_count"))
+
+	if domain == "" {
+		subQuery = subQuery.Where("? IS NULL", bun.Ident("emoji.domain"))
+	} else if domain != db.EmojiAllDomains {
+		subQuery = subQuery.Where("? = ?", bun.Ident("emoji.domain"), domain)
+	}
+
+	order := "DESC"
+	if ascending {
+		order = "ASC"
+	}
+
+	q := e.conn.
+		NewSelect().
+		TableExpr("(?) AS ?", subQuery, bun.Ident("emoji_usage")).
+		Column("emoji_id", "statuses_count", "accounts_count").
+		OrderExpr("? + ? "+order, bun.Ident("statuses_count"), bun.Ident("accounts_count")).
+		OrderExpr("? ASC", bun.Ident("emoji_id"))
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &usage); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	return usage, nil
+}
+
+func (e *emojiDB) PutAccountEmojiUsage(ctx context.Context, accountID string, emojiIDs []string) db.Error {
+	now := time.Now()
+
+	for _, emojiID := range util.UniqueStrings(emojiIDs) {
+		usageID, err := id.NewULID()
+		if err != nil {
+			return err
+		}
+
+		usage := &gtsmodel.AccountEmojiUsage{
+			ID:        usageID,
+			CreatedAt: now,
+			UpdatedAt: now,
+			AccountID: accountID,
+			EmojiID:   emojiID,
+			Uses:      1,
+		}
+
+		// if the account has used this emoji before, just count one more use
+		if _, err := e.conn.
+			NewInsert().
+			Model(usage).
+			On("CONFLICT (?, ?) DO UPDATE", bun.Ident("account_id"), bun.Ident("emoji_id")).
+			Set("? = ? + 1", bun.Ident("uses"), bun.Ident("account_emoji_usage.uses")).
+			Set("? = ?", bun.Ident("updated_at"), now).
+			Exec(ctx); err != nil {
+			return e.conn.ProcessError(err)
+		}
+	}
+
+	return nil
+}
+
+func (e *emojiDB) GetFrequentlyUsedEmojiIDs(ctx context.Context, accountID string, limit int) ([]string, db.Error) {
+	emojiIDs := []string{}
+
+	q := e.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_emoji_usages"), bun.Ident("account_emoji_usage")).
+		Column("account_emoji_usage.emoji_id").
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("emojis"), bun.Ident("emoji"), bun.Ident("emoji.id"), bun.Ident("account_emoji_usage.emoji_id")).
+		Where("? = ?", bun.Ident("account_emoji_usage.account_id"), accountID).
+		Where("? = ?", bun.Ident("emoji.visible_in_picker"), true).
+		Where("? = ?", bun.Ident("emoji.disabled"), false).
+		Where("? IS NULL", bun.Ident("emoji.domain")).
+		Order("account_emoji_usage.uses DESC", "account_emoji_usage.updated_at DESC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &emojiIDs); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	return emojiIDs, nil
+}
+
+func (e *emojiDB) getEmoji(ctx context.Context, lookup string, key string, cacheGet func() (*gtsmodel.Emoji, bool), dbQuery func(*gtsmodel.Emoji) error) (*gtsmodel.Emoji, db.Error) {
 	// Attempt to fetch cached emoji
 	emoji, cached := cacheGet()
 
 	if !cached {
+		// Check whether we recently looked for
+		// this and found nothing in the database
+		if e.emojiCache.IsMiss(lookup, key) {
+			return nil, db.ErrNoEntries
+		}
+
 		emoji = &gtsmodel.Emoji{}
 
 		// Not cached! Perform database query
 		err := dbQuery(emoji)
 		if err != nil {
-			return nil, e.conn.ProcessError(err)
+			err = e.conn.ProcessError(err)
+			if err == db.ErrNoEntries {
+				e.emojiCache.PutMiss(lookup, key)
+			}
+			return nil, err
 		}
 
 		// Place in the cache
@@ -379,37 +719,57 @@ func (e *emojiDB) getEmoji(ctx context.Context, cacheGet func() (*gtsmodel.Emoji
 	return emoji, nil
 }
 
-func (e *emojiDB) emojisFromIDs(ctx context.Context, emojiIDs []string) ([]*gtsmodel.Emoji, db.Error) {
+func (e *emojiDB) GetEmojisByIDs(ctx context.Context, emojiIDs []string) ([]*gtsmodel.Emoji, db.Error) {
 	// Catch case of no emojis early
 	if len(emojiIDs) == 0 {
 		return nil, db.ErrNoEntries
 	}
 
-	emojis := make([]*gtsmodel.Emoji, 0, len(emojiIDs))
-
-	for _, id := range emojiIDs {
-		emoji, err := e.GetEmojiByID(ctx, id)
-		if err != nil {
-			log.Errorf("emojisFromIDs: error getting emoji %q: %v", id, err)
-		}
-
-		emojis = append(emojis, emoji)
+	emojis, err := loadByIDs(
+		"GetEmojisByIDs",
+		emojiIDs,
+		func(emoji *gtsmodel.Emoji) string { return emoji.ID },
+		e.emojiCache.GetByID,
+		func(missing []string) ([]*gtsmodel.Emoji, error) {
+			dbEmojis := []*gtsmodel.Emoji{}
+			err := e.conn.
+				NewSelect().
+				Model(&dbEmojis).
+				Relation("Category").
+				Where("? IN (?)", bun.Ident("emoji.id"), bun.In(missing)).
+				Scan(ctx)
+			return dbEmojis, err
+		},
+		e.emojiCache.Put,
+	)
+	if err != nil {
+		return nil, e.conn.ProcessError(err)
 	}
 
 	return emojis, nil
 }
 
-func (e *emojiDB) getEmojiCategory(ctx context.Context, cacheGet func() (*gtsmodel.EmojiCategory, bool), dbQuery func(*gtsmodel.EmojiCategory) error) (*gtsmodel.EmojiCategory, db.Error) {
+func (e *emojiDB) getEmojiCategory(ctx context.Context, lookup string, key string, cacheGet func() (*gtsmodel.EmojiCategory, bool), dbQuery func(*gtsmodel.EmojiCategory) error) (*gtsmodel.EmojiCategory, db.Error) {
 	// Attempt to fetch cached emoji categories
 	emojiCategory, cached := cacheGet()
 
 	if !cached {
+		// Check whether we recently looked for
+		// this and found nothing in the database
+		if e.categoryCache.IsMiss(lookup, key) {
+			return nil, db.ErrNoEntries
+		}
+
 		emojiCategory = &gtsmodel.EmojiCategory{}
 
 		// Not cached! Perform database query
 		err := dbQuery(emojiCategory)
 		if err != nil {
-			return nil, e.conn.ProcessError(err)
+			err = e.conn.ProcessError(err)
+			if err == db.ErrNoEntries {
+				e.categoryCache.PutMiss(lookup, key)
+			}
+			return nil, err
 		}
 
 		// Place in the cache
@@ -419,21 +779,30 @@ func (e *emojiDB) getEmojiCategory(ctx context.Context, cacheGet func() (*gtsmod
 	return emojiCategory, nil
 }
 
-func (e *emojiDB) emojiCategoriesFromIDs(ctx context.Context, emojiCategoryIDs []string) ([]*gtsmodel.EmojiCategory, db.Error) {
+func (e *emojiDB) GetEmojiCategoriesByIDs(ctx context.Context, emojiCategoryIDs []string) ([]*gtsmodel.EmojiCategory, db.Error) {
 	// Catch case of no emoji categories early
 	if len(emojiCategoryIDs) == 0 {
 		return nil, db.ErrNoEntries
 	}
 
-	emojiCategories := make([]*gtsmodel.EmojiCategory, 0, len(emojiCategoryIDs))
-
-	for _, id := range emojiCategoryIDs {
-		emojiCategory, err := e.GetEmojiCategory(ctx, id)
-		if err != nil {
-			log.Errorf("emojiCategoriesFromIDs: error getting emoji category %q: %v", id, err)
-		}
-
-		emojiCategories = append(emojiCategories, emojiCategory)
+	emojiCategories, err := loadByIDs(
+		"GetEmojiCategoriesByIDs",
+		emojiCategoryIDs,
+		func(emojiCategory *gtsmodel.EmojiCategory) string { return emojiCategory.ID },
+		e.categoryCache.GetByID,
+		func(missing []string) ([]*gtsmodel.EmojiCategory, error) {
+			dbEmojiCategories := []*gtsmodel.EmojiCategory{}
+			err := e.conn.
+				NewSelect().
+				Model(&dbEmojiCategories).
+				Where("? IN (?)", bun.Ident("emoji_category.id"), bun.In(missing)).
+				Scan(ctx)
+			return dbEmojiCategories, err
+		},
+		e.categoryCache.Put,
+	)
+	if err != nil {
+		return nil, e.conn.ProcessError(err)
 	}
 
 	return emojiCategories, nil
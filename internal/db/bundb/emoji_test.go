@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -32,13 +33,93 @@ type EmojiTestSuite struct {
 }
 
 func (suite *EmojiTestSuite) TestGetUseableEmojis() {
-	emojis, err := suite.db.GetUseableEmojis(context.Background())
+	emojis, err := suite.db.GetUseableEmojis(context.Background(), "", "", 0)
 
 	suite.NoError(err)
 	suite.Equal(1, len(emojis))
 	suite.Equal("rainbow", emojis[0].Shortcode)
 }
 
+func (suite *EmojiTestSuite) TestGetUseableEmojisByShortcode() {
+	emojis, err := suite.db.GetUseableEmojis(context.Background(), "AINB", "", 0)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	// underscores should be matched literally, not as a wildcard
+	emojis, err = suite.db.GetUseableEmojis(context.Background(), "rain_ow", "", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(emojis)
+}
+
+func (suite *EmojiTestSuite) TestSearchUseableEmojis() {
+	emojis, err := suite.db.SearchUseableEmojis(context.Background(), "Rain", 10)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	// matches in the middle of a shortcode are found too
+	emojis, err = suite.db.SearchUseableEmojis(context.Background(), "bow", 10)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+
+	// remote emojis are never useable
+	emojis, err = suite.db.SearchUseableEmojis(context.Background(), "yell", 10)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(emojis)
+}
+
+func (suite *EmojiTestSuite) TestSearchUseableEmojisPrefixFirst() {
+	ctx := context.Background()
+
+	// add a local emoji that only matches "rain" in the middle
+	emoji := *suite.testEmojis["rainbow"]
+	emoji.ID = "01GJTHZ6RZ8DN1J5QKD6KSBGNR"
+	emoji.Shortcode = "brain"
+	emoji.URI = "http://localhost:8080/emoji/01GJTHZ6RZ8DN1J5QKD6KSBGNR"
+	suite.NoError(suite.db.PutEmoji(ctx, &emoji))
+
+	emojis, err := suite.db.SearchUseableEmojis(ctx, "rain", 10)
+	suite.NoError(err)
+	suite.Len(emojis, 2)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+	suite.Equal("brain", emojis[1].Shortcode)
+
+	// with a limit of 1, only the prefix match is returned
+	emojis, err = suite.db.SearchUseableEmojis(ctx, "rain", 1)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+}
+
+func (suite *EmojiTestSuite) TestGetUseableEmojisByCategory() {
+	emojis, err := suite.db.GetUseableEmojis(context.Background(), "", suite.testEmojis["rainbow"].CategoryID, 0)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+
+	emojis, err = suite.db.GetUseableEmojis(context.Background(), "", "01GGQ8V4993XK67B2JB396YFB8", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(emojis)
+}
+
+func (suite *EmojiTestSuite) TestGetAllEmojisPaging() {
+	emojis, err := suite.db.GetAllEmojis(context.Background(), "", 1)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("yell", emojis[0].Shortcode)
+
+	// page down from the last emoji we got
+	emojis, err = suite.db.GetAllEmojis(context.Background(), emojis[0].ID, 1)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	// and there should be nothing after that
+	emojis, err = suite.db.GetAllEmojis(context.Background(), emojis[0].ID, 1)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(emojis)
+}
+
 func (suite *EmojiTestSuite) TestDeleteEmojiByID() {
 	testEmoji := suite.testEmojis["rainbow"]
 
@@ -50,6 +131,27 @@ func (suite *EmojiTestSuite) TestDeleteEmojiByID() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *EmojiTestSuite) TestUpdateEmojisDisabledByDomain() {
+	testEmoji := suite.testEmojis["yell"]
+
+	// prime the cache so we can check it's invalidated
+	_, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+
+	emojiIDs, err := suite.db.UpdateEmojisDisabledByDomain(context.Background(), testEmoji.Domain, true)
+	suite.NoError(err)
+	suite.Equal([]string{testEmoji.ID}, emojiIDs)
+
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.True(*dbEmoji.Disabled)
+
+	// already disabled, so nothing should change
+	emojiIDs, err = suite.db.UpdateEmojisDisabledByDomain(context.Background(), testEmoji.Domain, true)
+	suite.NoError(err)
+	suite.Empty(emojiIDs)
+}
+
 func (suite *EmojiTestSuite) TestGetEmojiByStaticURL() {
 	emoji, err := suite.db.GetEmojiByStaticURL(context.Background(), "http://localhost:8080/fileserver/01F8MH17FWEB39HZJ76B6VXSKF/emoji/static/01F8MH9H8E4VG3KDYJR9EGPXCQ.png")
 	suite.NoError(err)
@@ -146,6 +248,36 @@ func (suite *EmojiTestSuite) TestGetSpecificEmojisFromDomain2() {
 	suite.Equal("yell", emojis[0].Shortcode)
 }
 
+func (suite *EmojiTestSuite) TestGetEmojisByIDs() {
+	emojis, err := suite.db.GetEmojisByIDs(context.Background(), []string{
+		suite.testEmojis["yell"].ID,
+		"01GJBJ3QJ0VW6N4AS8S9S5D2ZV", // doesn't exist
+		suite.testEmojis["rainbow"].ID,
+	})
+	suite.NoError(err)
+	suite.Len(emojis, 2)
+
+	// check input order is preserved
+	suite.Equal("yell", emojis[0].Shortcode)
+	suite.Equal("rainbow", emojis[1].Shortcode)
+	suite.NotNil(emojis[1].Category)
+}
+
+func (suite *EmojiTestSuite) TestGetEmojiCategoriesByIDs() {
+	testCategories := testrig.NewTestEmojiCategories()
+
+	categories, err := suite.db.GetEmojiCategoriesByIDs(context.Background(), []string{
+		testCategories["reactions"].ID,
+		testCategories["cute stuff"].ID,
+	})
+	suite.NoError(err)
+	suite.Len(categories, 2)
+
+	// check input order is preserved
+	suite.Equal("reactions", categories[0].Name)
+	suite.Equal("cute stuff", categories[1].Name)
+}
+
 func (suite *EmojiTestSuite) TestGetEmojiCategories() {
 	categories, err := suite.db.GetEmojiCategories(context.Background())
 	suite.NoError(err)
@@ -155,12 +287,186 @@ func (suite *EmojiTestSuite) TestGetEmojiCategories() {
 	suite.Equal(categories[1].Name, "reactions")
 }
 
+func (suite *EmojiTestSuite) TestGetEmojiByShortcodeDomainMissCached() {
+	newEmoji := &gtsmodel.Emoji{}
+	*newEmoji = *suite.testEmojis["yell"]
+	newEmoji.ID = "01GKRZ5TYD2ECR0ST2KBMMSFRY"
+	newEmoji.Shortcode = "shout"
+	newEmoji.URI = "http://fossbros-anonymous.io/emoji/01GKRZ5TYD2ECR0ST2KBMMSFRY"
+	newEmoji.ImageStaticURL = "http://localhost:8080/fileserver/01GKRZ5TYD2ECR0ST2KBMMSFRY/emoji/static/01GKRZ5TYD2ECR0ST2KBMMSFRY.png"
+
+	_, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), newEmoji.Shortcode, newEmoji.Domain)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// insert behind the cache's back; the
+	// miss should still be remembered
+	err = suite.db.Put(context.Background(), newEmoji)
+	suite.NoError(err)
+
+	_, err = suite.db.GetEmojiByShortcodeDomain(context.Background(), newEmoji.Shortcode, newEmoji.Domain)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// updating through the emoji db should clear the miss
+	_, err = suite.db.UpdateEmoji(context.Background(), newEmoji, "updated_at")
+	suite.NoError(err)
+
+	dbEmoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), newEmoji.Shortcode, newEmoji.Domain)
+	suite.NoError(err)
+	suite.Equal(newEmoji.ID, dbEmoji.ID)
+}
+
+func (suite *EmojiTestSuite) TestPutEmojiClearsMiss() {
+	newEmoji := &gtsmodel.Emoji{}
+	*newEmoji = *suite.testEmojis["yell"]
+	newEmoji.ID = "01GKRZ5TYD2ECR0ST2KBMMSFRY"
+	newEmoji.Shortcode = "shout"
+	newEmoji.URI = "http://fossbros-anonymous.io/emoji/01GKRZ5TYD2ECR0ST2KBMMSFRY"
+	newEmoji.ImageStaticURL = "http://localhost:8080/fileserver/01GKRZ5TYD2ECR0ST2KBMMSFRY/emoji/static/01GKRZ5TYD2ECR0ST2KBMMSFRY.png"
+
+	_, err := suite.db.GetEmojiByURI(context.Background(), newEmoji.URI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetEmojiByStaticURL(context.Background(), newEmoji.ImageStaticURL)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	err = suite.db.PutEmoji(context.Background(), newEmoji)
+	suite.NoError(err)
+
+	dbEmoji, err := suite.db.GetEmojiByURI(context.Background(), newEmoji.URI)
+	suite.NoError(err)
+	suite.Equal(newEmoji.ID, dbEmoji.ID)
+
+	dbEmoji, err = suite.db.GetEmojiByStaticURL(context.Background(), newEmoji.ImageStaticURL)
+	suite.NoError(err)
+	suite.Equal(newEmoji.ID, dbEmoji.ID)
+}
+
+func (suite *EmojiTestSuite) TestPutEmojiCategoryClearsMiss() {
+	_, err := suite.db.GetEmojiCategoryByName(context.Background(), "Blobcats")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	err = suite.db.PutEmojiCategory(context.Background(), &gtsmodel.EmojiCategory{
+		ID:   "01GKRZB5TWBPKDHJ7ZZT5Q3GKZ",
+		Name: "blobcats",
+	})
+	suite.NoError(err)
+
+	category, err := suite.db.GetEmojiCategoryByName(context.Background(), "Blobcats")
+	suite.NoError(err)
+	suite.Equal("blobcats", category.Name)
+}
+
 func (suite *EmojiTestSuite) TestGetEmojiCategory() {
 	category, err := suite.db.GetEmojiCategory(context.Background(), testrig.NewTestEmojiCategories()["reactions"].ID)
 	suite.NoError(err)
 	suite.NotNil(category)
 }
 
+func (suite *EmojiTestSuite) TestUpdateEmojiCategory() {
+	category := testrig.NewTestEmojiCategories()["reactions"]
+	category.Name = "reacts"
+
+	updated, err := suite.db.UpdateEmojiCategory(context.Background(), category, "updated_at", "name")
+	suite.NoError(err)
+	suite.Equal("reacts", updated.Name)
+
+	dbCategory, err := suite.db.GetEmojiCategory(context.Background(), category.ID)
+	suite.NoError(err)
+	suite.Equal("reacts", dbCategory.Name)
+}
+
+func (suite *EmojiTestSuite) TestGetEmojisByCategoryID() {
+	emojis, err := suite.db.GetEmojisByCategoryID(context.Background(), testrig.NewTestEmojiCategories()["reactions"].ID)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	emojis, err = suite.db.GetEmojisByCategoryID(context.Background(), testrig.NewTestEmojiCategories()["cute stuff"].ID)
+	suite.NoError(err)
+	suite.Empty(emojis)
+}
+
+func (suite *EmojiTestSuite) TestDeleteEmojiCategory() {
+	category := testrig.NewTestEmojiCategories()["reactions"]
+
+	// warm the cache with the categorized emoji
+	emoji, err := suite.db.GetEmojiByID(context.Background(), suite.testEmojis["rainbow"].ID)
+	suite.NoError(err)
+	suite.Equal(category.ID, emoji.CategoryID)
+
+	err = suite.db.DeleteEmojiCategory(context.Background(), category.ID)
+	suite.NoError(err)
+
+	_, err = suite.db.GetEmojiCategory(context.Background(), category.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// emoji should still be there, but uncategorized
+	emoji, err = suite.db.GetEmojiByID(context.Background(), suite.testEmojis["rainbow"].ID)
+	suite.NoError(err)
+	suite.Empty(emoji.CategoryID)
+	suite.Nil(emoji.Category)
+}
+
+func (suite *EmojiTestSuite) TestGetEmojiUsage() {
+	usage, err := suite.db.GetEmojiUsage(context.Background(), db.EmojiAllDomains, false, 0)
+	suite.NoError(err)
+	suite.Len(usage, 2)
+
+	// most used first
+	suite.Equal(suite.testEmojis["rainbow"].ID, usage[0].EmojiID)
+	suite.Equal(1, usage[0].StatusesCount)
+	suite.Equal(0, usage[0].AccountsCount)
+	suite.Equal(suite.testEmojis["yell"].ID, usage[1].EmojiID)
+	suite.Equal(0, usage[1].StatusesCount)
+	suite.Equal(0, usage[1].AccountsCount)
+}
+
+func (suite *EmojiTestSuite) TestGetEmojiUsageAscending() {
+	usage, err := suite.db.GetEmojiUsage(context.Background(), db.EmojiAllDomains, true, 1)
+	suite.NoError(err)
+	suite.Len(usage, 1)
+
+	// least used first
+	suite.Equal(suite.testEmojis["yell"].ID, usage[0].EmojiID)
+}
+
+func (suite *EmojiTestSuite) TestGetEmojiUsageLocal() {
+	usage, err := suite.db.GetEmojiUsage(context.Background(), "", false, 0)
+	suite.NoError(err)
+	suite.Len(usage, 1)
+	suite.Equal(suite.testEmojis["rainbow"].ID, usage[0].EmojiID)
+}
+
+func (suite *EmojiTestSuite) TestPutAccountEmojiUsage() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+	rainbow := suite.testEmojis["rainbow"]
+	yell := suite.testEmojis["yell"]
+
+	// duplicate IDs within one status only count once
+	err := suite.db.PutAccountEmojiUsage(ctx, accountID, []string{rainbow.ID, yell.ID, rainbow.ID})
+	suite.NoError(err)
+
+	err = suite.db.PutAccountEmojiUsage(ctx, accountID, []string{rainbow.ID})
+	suite.NoError(err)
+
+	usages := []*gtsmodel.AccountEmojiUsage{}
+	err = suite.db.GetWhere(ctx, []db.Where{{Key: "account_id", Value: accountID}, {Key: "emoji_id", Value: rainbow.ID}}, &usages)
+	suite.NoError(err)
+	suite.Len(usages, 1)
+	suite.Equal(2, usages[0].Uses)
+
+	// yell is a remote emoji, so it's not offered in the picker
+	emojiIDs, err := suite.db.GetFrequentlyUsedEmojiIDs(ctx, accountID, 0)
+	suite.NoError(err)
+	suite.Equal([]string{rainbow.ID}, emojiIDs)
+
+	// other accounts aren't affected
+	emojiIDs, err = suite.db.GetFrequentlyUsedEmojiIDs(ctx, suite.testAccounts["local_account_2"].ID, 0)
+	suite.NoError(err)
+	suite.Empty(emojiIDs)
+}
+
 func TestEmojiTestSuite(t *testing.T) {
 	suite.Run(t, new(EmojiTestSuite))
 }
@@ -20,6 +20,8 @@ func processPostgresError(err error) db.Error {
 	switch pgErr.Code {
 	case "23505" /* unique_violation */ :
 		return db.ErrAlreadyExists
+	case "57014" /* query_canceled */ :
+		return db.ErrTimeout
 	default:
 		return err
 	}
@@ -37,6 +39,10 @@ func processSQLiteError(err error) db.Error {
 	switch sqliteErr.Code() {
 	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
 		return db.ErrAlreadyExists
+	case sqlite3.SQLITE_INTERRUPT:
+		// sqlite only interrupts queries
+		// when their context is done
+		return db.ErrTimeout
 	default:
 		return err
 	}
@@ -0,0 +1,258 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type filterDB struct {
+	conn *DBConn
+}
+
+func (f *filterDB) GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, db.Error) {
+	filter := &gtsmodel.Filter{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(filter).
+		Where("? = ?", bun.Ident("filter.id"), id).
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if err := f.populateFilters(ctx, []*gtsmodel.Filter{filter}); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func (f *filterDB) GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, db.Error) {
+	filters := []*gtsmodel.Filter{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(&filters).
+		Where("? = ?", bun.Ident("filter.account_id"), accountID).
+		Order("filter.id ASC").
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if err := f.populateFilters(ctx, filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+// populateFilters loads the keywords and statuses of the given filters.
+func (f *filterDB) populateFilters(ctx context.Context, filters []*gtsmodel.Filter) db.Error {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	filterIDs := make([]string, 0, len(filters))
+	byID := make(map[string]*gtsmodel.Filter, len(filters))
+	for _, filter := range filters {
+		filterIDs = append(filterIDs, filter.ID)
+		byID[filter.ID] = filter
+		filter.Keywords = []*gtsmodel.FilterKeyword{}
+		filter.Statuses = []*gtsmodel.FilterStatus{}
+	}
+
+	keywords := []*gtsmodel.FilterKeyword{}
+	if err := f.conn.
+		NewSelect().
+		Model(&keywords).
+		Where("? IN (?)", bun.Ident("filter_keyword.filter_id"), bun.In(filterIDs)).
+		Order("filter_keyword.id ASC").
+		Scan(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	for _, keyword := range keywords {
+		filter := byID[keyword.FilterID]
+		filter.Keywords = append(filter.Keywords, keyword)
+	}
+
+	statuses := []*gtsmodel.FilterStatus{}
+	if err := f.conn.
+		NewSelect().
+		Model(&statuses).
+		Where("? IN (?)", bun.Ident("filter_status.filter_id"), bun.In(filterIDs)).
+		Order("filter_status.id ASC").
+		Scan(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	for _, status := range statuses {
+		filter := byID[status.FilterID]
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	return nil
+}
+
+func (f *filterDB) PutFilter(ctx context.Context, filter *gtsmodel.Filter) db.Error {
+	return f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(filter).Exec(ctx); err != nil {
+			return err
+		}
+
+		if len(filter.Keywords) != 0 {
+			if _, err := tx.NewInsert().Model(&filter.Keywords).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if len(filter.Statuses) != 0 {
+			if _, err := tx.NewInsert().Model(&filter.Statuses).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (f *filterDB) UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) db.Error {
+	filter.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := f.conn.
+		NewUpdate().
+		Model(filter).
+		Where("? = ?", bun.Ident("filter.id"), filter.ID).
+		Column(columns...).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) DeleteFilterByID(ctx context.Context, id string) db.Error {
+	return f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("filter_keywords"), bun.Ident("filter_keyword")).
+			Where("? = ?", bun.Ident("filter_keyword.filter_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("filter_statuses"), bun.Ident("filter_status")).
+			Where("? = ?", bun.Ident("filter_status.filter_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("filters"), bun.Ident("filter")).
+			Where("? = ?", bun.Ident("filter.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (f *filterDB) GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, db.Error) {
+	filterKeyword := &gtsmodel.FilterKeyword{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(filterKeyword).
+		Where("? = ?", bun.Ident("filter_keyword.id"), id).
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	return filterKeyword, nil
+}
+
+func (f *filterDB) PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) db.Error {
+	_, err := f.conn.
+		NewInsert().
+		Model(filterKeyword).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) db.Error {
+	filterKeyword.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := f.conn.
+		NewUpdate().
+		Model(filterKeyword).
+		Where("? = ?", bun.Ident("filter_keyword.id"), filterKeyword.ID).
+		Column(columns...).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) DeleteFilterKeywordByID(ctx context.Context, id string) db.Error {
+	_, err := f.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("filter_keywords"), bun.Ident("filter_keyword")).
+		Where("? = ?", bun.Ident("filter_keyword.id"), id).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) GetFilterStatusByID(ctx context.Context, id string) (*gtsmodel.FilterStatus, db.Error) {
+	filterStatus := &gtsmodel.FilterStatus{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(filterStatus).
+		Where("? = ?", bun.Ident("filter_status.id"), id).
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	return filterStatus, nil
+}
+
+func (f *filterDB) PutFilterStatus(ctx context.Context, filterStatus *gtsmodel.FilterStatus) db.Error {
+	_, err := f.conn.
+		NewInsert().
+		Model(filterStatus).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) DeleteFilterStatusByID(ctx context.Context, id string) db.Error {
+	_, err := f.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("filter_statuses"), bun.Ident("filter_status")).
+		Where("? = ?", bun.Ident("filter_status.id"), id).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
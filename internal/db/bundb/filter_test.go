@@ -0,0 +1,85 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type FilterTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FilterTestSuite) TestGetFiltersForAccountID() {
+	filters, err := suite.db.GetFiltersForAccountID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	if suite.Len(filters, 1) {
+		suite.Equal(suite.testFilters["local_account_1_filter_1"].ID, filters[0].ID)
+		if suite.Len(filters[0].Keywords, 1) {
+			suite.Equal(suite.testFilterKeywords["local_account_1_filter_1_keyword_1"].ID, filters[0].Keywords[0].ID)
+		}
+		suite.Empty(filters[0].Statuses)
+	}
+
+	filters, err = suite.db.GetFiltersForAccountID(context.Background(), suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.Empty(filters)
+}
+
+func (suite *FilterTestSuite) TestPutFilterStatusTwice() {
+	ctx := context.Background()
+	filter := suite.testFilters["local_account_1_filter_1"]
+
+	filterStatus := &gtsmodel.FilterStatus{
+		ID:        "01GKZAMVB5S4SPXTCGA1ZX4H0C",
+		AccountID: filter.AccountID,
+		FilterID:  filter.ID,
+		StatusID:  suite.testStatuses["admin_account_status_1"].ID,
+	}
+	suite.NoError(suite.db.PutFilterStatus(ctx, filterStatus))
+
+	filterStatus.ID = "01GKZAN4CS3C5PZ6JZ7BJWQQD0"
+	suite.ErrorIs(suite.db.PutFilterStatus(ctx, filterStatus), db.ErrAlreadyExists)
+
+	got, err := suite.db.GetFilterByID(ctx, filter.ID)
+	suite.NoError(err)
+	suite.Len(got.Statuses, 1)
+}
+
+func (suite *FilterTestSuite) TestDeleteFilterByID() {
+	ctx := context.Background()
+	filter := suite.testFilters["local_account_1_filter_1"]
+
+	suite.NoError(suite.db.DeleteFilterByID(ctx, filter.ID))
+
+	_, err := suite.db.GetFilterByID(ctx, filter.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetFilterKeywordByID(ctx, suite.testFilterKeywords["local_account_1_filter_1_keyword_1"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
@@ -20,32 +20,88 @@ package bundb
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/uptrace/bun"
 )
 
 // queryHook implements bun.QueryHook
-type queryHook struct{}
+type queryHook struct {
+	// slowQueryThreshold is how long a query can take before it's
+	// logged as slow. If 0 or less, slow queries aren't logged.
+	slowQueryThreshold time.Duration
 
-func (queryHook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
-	return ctx // do nothing
+	// queryTimeout is how long a query can run before
+	// it's cancelled. If 0 or less, queries don't time out.
+	queryTimeout time.Duration
 }
 
-// AfterQuery logs the time taken to query, the operation (select, update, etc), and the query itself as translated by bun.
-func (queryHook) AfterQuery(_ context.Context, event *bun.QueryEvent) {
+// cancelKey is the event stash key for
+// cancelling a query's timeout context.
+type cancelKey struct{}
+
+// newQueryHook returns a query hook using the slow query threshold and query timeout from config.
+func newQueryHook() queryHook {
+	return queryHook{
+		slowQueryThreshold: time.Duration(config.GetDbSlowQueryThresholdMilliseconds()) * time.Millisecond,
+		queryTimeout:       time.Duration(config.GetDbQueryTimeoutSeconds()) * time.Second,
+	}
+}
+
+// BeforeQuery applies the query timeout, if set, to the context that the query is run with.
+//
+// Raw queries (eg., conn.QueryRowContext) are left alone, since bun calls AfterQuery for
+// those before the caller has read the returned rows, which would then already be cancelled.
+func (h queryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	if h.queryTimeout <= 0 || event.IQuery == nil {
+		return ctx
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.queryTimeout)
+	if event.Stash == nil {
+		event.Stash = make(map[interface{}]interface{})
+	}
+	event.Stash[cancelKey{}] = cancel
+	return ctx
+}
+
+// AfterQuery marks the context as having written to the database if the query wasn't a select,
+// and logs the time taken to query, the operation (select, update, etc), and the query itself as translated by bun.
+func (h queryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	// Release the query timeout context
+	if cancel, ok := event.Stash[cancelKey{}].(context.CancelFunc); ok {
+		cancel()
+	}
+
+	// Reads made with this context from now on
+	// should see what this query wrote, if anything
+	if event.Err == nil && !strings.EqualFold(event.Operation(), "SELECT") {
+		db.MarkWritten(ctx)
+	}
+
 	// Get the DB query duration
 	dur := time.Since(event.StartTime)
 
 	switch {
-	// Warn on slow database queries
-	case dur > time.Second:
+	// Warn on slow database queries, including how long
+	// callers have waited for connections, to tell a slow
+	// query apart from one that was queued behind others
+	case h.slowQueryThreshold > 0 && dur > h.slowQueryThreshold:
+		stats := event.DB.Stats()
 		log.WithFields(kv.Fields{
 			{"duration", dur},
+			{"operation", event.Operation()},
 			{"query", event.Query},
+			{"inUse", stats.InUse},
+			{"maxOpen", stats.MaxOpenConnections},
+			{"waitCount", stats.WaitCount},
+			{"waitDuration", stats.WaitDuration},
 		}...).Warn("SLOW DATABASE QUERY")
 
 	// On trace, we log query information,
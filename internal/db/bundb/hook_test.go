@@ -0,0 +1,51 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type HookTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *HookTestSuite) TestMarkWritten() {
+	ctx := db.WithReadYourWrites(context.Background())
+
+	// reading shouldn't count as writing
+	_, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.False(db.Written(ctx))
+
+	// but updating should
+	account := suite.testAccounts["local_account_1"]
+	account.Note = "i've been updated"
+	_, err = suite.db.UpdateAccount(ctx, account)
+	suite.NoError(err)
+	suite.True(db.Written(ctx))
+}
+
+func TestHookTestSuite(t *testing.T) {
+	suite.Run(t, new(HookTestSuite))
+}
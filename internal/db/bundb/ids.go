@@ -0,0 +1,213 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+// idAuditBatchSize is how many rows are selected at a time while auditing ids.
+const idAuditBatchSize = 500
+
+// idColumn is a column which holds ids of rows in another table.
+type idColumn struct {
+	table  string
+	column string
+}
+
+// idTable is a table whose rows are paged through by id.
+type idTable struct {
+	name string
+
+	// resequence is true if rows of this table can be given new ids. They
+	// can't if their ids are part of uris that other servers know about.
+	resequence bool
+
+	// remoteOnly limits resequencing to rows with local = false,
+	// for tables where only local rows have ids in their uris.
+	remoteOnly bool
+
+	// references are the columns which hold ids of this table's rows.
+	references []idColumn
+}
+
+// idTables are the tables audited for skewed ids.
+var idTables = func() []idTable {
+	statusReferences := []idColumn{
+		{table: "statuses", column: "in_reply_to_id"},
+		{table: "statuses", column: "boost_of_id"},
+		{table: "media_attachments", column: "status_id"},
+	}
+	for _, table := range statusLinkTables {
+		statusReferences = append(statusReferences, idColumn{table: table, column: "status_id"})
+	}
+
+	return []idTable{
+		{name: "statuses", resequence: true, remoteOnly: true, references: statusReferences},
+		{name: "notifications", resequence: true},
+		{name: "status_bookmarks", resequence: true},
+		{name: "status_faves"},
+		{name: "follow_requests"},
+	}
+}()
+
+// idRow is the part of a row needed to audit its id.
+type idRow struct {
+	ID        string    `bun:"id"`
+	CreatedAt time.Time `bun:"created_at"`
+	Local     *bool     `bun:"local"`
+}
+
+func (a *adminDB) AuditIDs(ctx context.Context, tolerance time.Duration) ([]*db.IDAudit, db.Error) {
+	return a.auditIDs(ctx, tolerance, false)
+}
+
+func (a *adminDB) ResequenceIDs(ctx context.Context, tolerance time.Duration) ([]*db.IDAudit, db.Error) {
+	return a.auditIDs(ctx, tolerance, true)
+}
+
+func (a *adminDB) auditIDs(ctx context.Context, tolerance time.Duration, resequence bool) ([]*db.IDAudit, db.Error) {
+	audits := make([]*db.IDAudit, 0, len(idTables))
+	now := time.Now()
+
+	for _, table := range idTables {
+		audit := &db.IDAudit{Table: table.name}
+		audits = append(audits, audit)
+
+		maxID := ""
+		for {
+			rows := []*idRow{}
+
+			q := a.conn.
+				NewSelect().
+				TableExpr("?", bun.Ident(table.name)).
+				Column("id", "created_at").
+				Where("? > ?", bun.Ident("id"), maxID).
+				Order("id ASC").
+				Limit(idAuditBatchSize)
+
+			if table.remoteOnly {
+				q = q.Column("local")
+			}
+
+			if err := q.Scan(ctx, &rows); err != nil {
+				return nil, a.conn.ProcessError(err)
+			}
+
+			if len(rows) == 0 {
+				break
+			}
+			maxID = rows[len(rows)-1].ID
+
+			for _, row := range rows {
+				audit.Checked++
+
+				idTime, target, err := idTimes(row, now)
+				if err != nil {
+					log.Warnf("auditIDs: %s row %s has an invalid id: %s", table.name, row.ID, err)
+					audit.Invalid++
+					continue
+				}
+
+				skew := idTime.Sub(target)
+				if skew <= tolerance && skew >= -tolerance {
+					continue
+				}
+
+				audit.Skewed++
+				if idTime.After(now) {
+					audit.Future++
+				}
+
+				if !table.resequence || (table.remoteOnly && (row.Local == nil || *row.Local)) {
+					continue
+				}
+				audit.Resequenceable++
+
+				if !resequence {
+					continue
+				}
+
+				if err := a.resequenceID(ctx, table, row.ID, target); err != nil {
+					return nil, err
+				}
+				audit.Resequenced++
+			}
+
+			if len(rows) < idAuditBatchSize {
+				break
+			}
+		}
+	}
+
+	return audits, nil
+}
+
+// idTimes returns the time encoded in the id of the given row, and the time
+// that it should be, ie., the time the row was created, or now if that's later.
+func idTimes(row *idRow, now time.Time) (time.Time, time.Time, error) {
+	parsed, err := ulid.Parse(row.ID)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	target := row.CreatedAt
+	if target.After(now) {
+		target = now
+	}
+
+	return ulid.Time(parsed.Time()), target, nil
+}
+
+// resequenceID gives the row of table with the given id a new id generated from
+// the given time, and updates the columns which refer to it, in one transaction.
+func (a *adminDB) resequenceID(ctx context.Context, table idTable, oldID string, t time.Time) db.Error {
+	newID, err := id.NewULIDFromTime(t)
+	if err != nil {
+		return err
+	}
+
+	return a.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		for _, ref := range table.references {
+			if _, err := tx.
+				NewUpdate().
+				TableExpr("?", bun.Ident(ref.table)).
+				Set("? = ?", bun.Ident(ref.column), newID).
+				Where("? = ?", bun.Ident(ref.column), oldID).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.
+			NewUpdate().
+			TableExpr("?", bun.Ident(table.name)).
+			Set("? = ?", bun.Ident("id"), newID).
+			Where("? = ?", bun.Ident("id"), oldID).
+			Exec(ctx)
+		return err
+	})
+}
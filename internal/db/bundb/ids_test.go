@@ -0,0 +1,178 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type IDsTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// idsTestTolerance is wide enough that none of the test models count as skewed.
+const idsTestTolerance = 100 * 365 * 24 * time.Hour
+
+// putFutureStatus puts a copy of a remote status with an id far in the
+// future in the database, along with a fave of it, and returns them both.
+func (suite *IDsTestSuite) putFutureStatus() (*gtsmodel.Status, *gtsmodel.StatusFave) {
+	ctx := context.Background()
+
+	futureID, err := id.NewULIDFromTime(time.Now().Add(2 * idsTestTolerance))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["remote_account_1_status_1"]
+	status.ID = futureID
+	status.URI = "http://fossbros-anonymous.io/users/foss_satan/statuses/" + futureID
+	status.URL = "http://fossbros-anonymous.io/@foss_satan/statuses/" + futureID
+	status.AttachmentIDs = nil
+	status.Attachments = nil
+	status.TagIDs = nil
+	status.Tags = nil
+	status.EmojiIDs = nil
+	status.Emojis = nil
+	status.MentionIDs = nil
+	status.Mentions = nil
+	if err := suite.db.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	faveID, err := id.NewULID()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	fave := &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+		URI:             "http://localhost:8080/users/the_mighty_zork/liked/" + faveID,
+	}
+	if err := suite.db.Put(ctx, fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return status, fave
+}
+
+func (suite *IDsTestSuite) auditFor(audits []*db.IDAudit, table string) *db.IDAudit {
+	for _, a := range audits {
+		if a.Table == table {
+			return a
+		}
+	}
+	suite.FailNow("no audit for table " + table)
+	return nil
+}
+
+func (suite *IDsTestSuite) TestAuditIDs() {
+	audits, err := suite.db.AuditIDs(context.Background(), idsTestTolerance)
+	suite.NoError(err)
+	for _, a := range audits {
+		suite.Zero(a.Skewed, a.Table)
+	}
+	suite.NotZero(suite.auditFor(audits, "statuses").Checked)
+
+	suite.putFutureStatus()
+
+	audits, err = suite.db.AuditIDs(context.Background(), idsTestTolerance)
+	suite.NoError(err)
+
+	statuses := suite.auditFor(audits, "statuses")
+	suite.Equal(1, statuses.Skewed)
+	suite.Equal(1, statuses.Future)
+	suite.Equal(1, statuses.Resequenceable)
+	suite.Zero(statuses.Resequenced)
+}
+
+func (suite *IDsTestSuite) TestResequenceIDs() {
+	ctx := context.Background()
+	status, fave := suite.putFutureStatus()
+
+	audits, err := suite.db.ResequenceIDs(ctx, idsTestTolerance)
+	suite.NoError(err)
+	suite.Equal(1, suite.auditFor(audits, "statuses").Resequenced)
+
+	// the status has a new id based on when it was created
+	resequenced, err := suite.db.GetStatusByURI(ctx, status.URI)
+	suite.NoError(err)
+	suite.NotEqual(status.ID, resequenced.ID)
+	suite.Equal(ulid.Timestamp(status.CreatedAt), ulid.MustParse(resequenced.ID).Time())
+
+	// and things that pointed at it point at the new id
+	dbFave := &gtsmodel.StatusFave{}
+	err = suite.db.GetByID(ctx, fave.ID, dbFave)
+	suite.NoError(err)
+	suite.Equal(resequenced.ID, dbFave.StatusID)
+
+	// nothing left to do
+	audits, err = suite.db.AuditIDs(ctx, idsTestTolerance)
+	suite.NoError(err)
+	suite.Zero(suite.auditFor(audits, "statuses").Skewed)
+}
+
+func (suite *IDsTestSuite) TestAuditIDsLocalStatusNotResequenceable() {
+	ctx := context.Background()
+
+	futureID, err := id.NewULIDFromTime(time.Now().Add(2 * idsTestTolerance))
+	suite.NoError(err)
+
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.ID = futureID
+	status.URI = "http://localhost:8080/users/the_mighty_zork/statuses/" + futureID
+	status.URL = "http://localhost:8080/@the_mighty_zork/statuses/" + futureID
+	status.AttachmentIDs = nil
+	status.Attachments = nil
+	status.TagIDs = nil
+	status.Tags = nil
+	status.EmojiIDs = nil
+	status.Emojis = nil
+	status.MentionIDs = nil
+	status.Mentions = nil
+	suite.NoError(suite.db.PutStatus(ctx, status))
+
+	audits, err := suite.db.ResequenceIDs(ctx, idsTestTolerance)
+	suite.NoError(err)
+
+	// the id of a local status is in its uri, so it's left alone
+	statuses := suite.auditFor(audits, "statuses")
+	suite.Equal(1, statuses.Skewed)
+	suite.Zero(statuses.Resequenceable)
+	suite.Zero(statuses.Resequenced)
+
+	_, err = suite.db.GetStatusByID(ctx, futureID)
+	suite.NoError(err)
+}
+
+func TestIDsTestSuite(t *testing.T) {
+	suite.Run(t, new(IDsTestSuite))
+}
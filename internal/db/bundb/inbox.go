@@ -0,0 +1,83 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type inboxDB struct {
+	conn *DBConn
+}
+
+func (i *inboxDB) PutInboxItem(ctx context.Context, item *gtsmodel.InboxItem) db.Error {
+	if _, err := i.conn.
+		NewInsert().
+		Model(item).
+		Exec(ctx); err != nil {
+		return i.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (i *inboxDB) GetInboxItem(ctx context.Context, id string) (*gtsmodel.InboxItem, db.Error) {
+	item := &gtsmodel.InboxItem{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(item).
+		Where("? = ?", bun.Ident("inbox_item.id"), id).
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return item, nil
+}
+
+func (i *inboxDB) GetInboxItemIDs(ctx context.Context) ([]string, db.Error) {
+	ids := []string{}
+
+	if err := i.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("inbox_items"), bun.Ident("inbox_item")).
+		Column("inbox_item.id").
+		Order("inbox_item.id ASC").
+		Scan(ctx, &ids); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return ids, nil
+}
+
+func (i *inboxDB) DeleteInboxItem(ctx context.Context, id string) db.Error {
+	if _, err := i.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("inbox_items"), bun.Ident("inbox_item")).
+		Where("? = ?", bun.Ident("inbox_item.id"), id).
+		Exec(ctx); err != nil {
+		return i.conn.ProcessError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InboxTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *InboxTestSuite) TestPutGetDeleteInboxItem() {
+	ctx := context.Background()
+
+	item := &gtsmodel.InboxItem{
+		ID:       "01GJG7BYEFN0ZXCK1JTJ2XMC2P",
+		InboxURI: "http://localhost:8080/users/the_mighty_zork/inbox",
+		Header:   http.Header{"Content-Type": []string{"application/activity+json"}},
+		Body:     []byte(`{"type":"Like"}`),
+	}
+
+	err := suite.db.PutInboxItem(ctx, item)
+	suite.NoError(err)
+
+	ids, err := suite.db.GetInboxItemIDs(ctx)
+	suite.NoError(err)
+	suite.Equal([]string{item.ID}, ids)
+
+	dbItem, err := suite.db.GetInboxItem(ctx, item.ID)
+	suite.NoError(err)
+	suite.Equal(item.InboxURI, dbItem.InboxURI)
+	suite.Equal("application/activity+json", dbItem.Header.Get("Content-Type"))
+	suite.Equal(item.Body, dbItem.Body)
+
+	err = suite.db.DeleteInboxItem(ctx, item.ID)
+	suite.NoError(err)
+
+	_, err = suite.db.GetInboxItem(ctx, item.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestInboxTestSuite(t *testing.T) {
+	suite.Run(t, new(InboxTestSuite))
+}
@@ -20,6 +20,8 @@ package bundb
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -56,7 +58,8 @@ func (i *instanceDB) CountInstanceUsers(ctx context.Context, domain string) (int
 func (i *instanceDB) CountInstanceStatuses(ctx context.Context, domain string) (int, db.Error) {
 	q := i.conn.
 		NewSelect().
-		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status"))
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		WhereGroup(" AND ", whereNotDeleted)
 
 	if domain == config.GetHost() || domain == config.GetAccountDomain() {
 		// if the domain is *this* domain, just count where local is true
@@ -98,6 +101,125 @@ func (i *instanceDB) CountInstanceDomains(ctx context.Context, domain string) (i
 	return count, nil
 }
 
+// domainCount is one row of a count grouped by domain.
+type domainCount struct {
+	Domain string
+	Count  int
+	Bytes  int64
+}
+
+func (i *instanceDB) GetDomainStorage(ctx context.Context) ([]*db.DomainStorage, db.Error) {
+	// accounts: SELECT "account"."domain" AS "domain", COUNT(*) AS "count" FROM "accounts" AS "account"
+	// WHERE "account"."domain" IS NOT NULL GROUP BY "account"."domain"
+	accounts := []domainCount{}
+	if err := i.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? IS NOT NULL", bun.Ident("account.domain")).
+		GroupExpr("?", bun.Ident("account.domain")).
+		Scan(ctx, &accounts); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	// statuses, grouped by the domain of their account
+	statuses := []domainCount{}
+	if err := i.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("status.account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? IS NOT NULL", bun.Ident("account.domain")).
+		WhereGroup(" AND ", whereNotDeleted).
+		GroupExpr("?", bun.Ident("account.domain")).
+		Scan(ctx, &statuses); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	// attachments, grouped by the domain of their account; only
+	// attachments which are currently cached take up any storage
+	attachments := []domainCount{}
+	if err := i.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("media_attachment.account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		ColumnExpr("CAST(COALESCE(SUM(CASE WHEN ? = ? THEN ? + ? + COALESCE(?, 0) ELSE 0 END), 0) AS BIGINT) AS ?",
+			bun.Ident("media_attachment.cached"), true,
+			bun.Ident("media_attachment.file_file_size"),
+			bun.Ident("media_attachment.thumbnail_file_size"),
+			bun.Ident("media_attachment.medium_file_size"),
+			bun.Ident("bytes"),
+		).
+		Where("? IS NOT NULL", bun.Ident("account.domain")).
+		GroupExpr("?", bun.Ident("account.domain")).
+		Scan(ctx, &attachments); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	emojis := []domainCount{}
+	if err := i.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+		ColumnExpr("? AS ?", bun.Ident("emoji.domain"), bun.Ident("domain")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		ColumnExpr("CAST(COALESCE(SUM(? + ?), 0) AS BIGINT) AS ?", bun.Ident("emoji.image_file_size"), bun.Ident("emoji.image_static_file_size"), bun.Ident("bytes")).
+		Where("? IS NOT NULL", bun.Ident("emoji.domain")).
+		GroupExpr("?", bun.Ident("emoji.domain")).
+		Scan(ctx, &emojis); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	byDomain := make(map[string]*db.DomainStorage)
+	get := func(domain string) *db.DomainStorage {
+		storage, ok := byDomain[domain]
+		if !ok {
+			storage = &db.DomainStorage{Domain: domain}
+			byDomain[domain] = storage
+		}
+		return storage
+	}
+
+	for _, c := range accounts {
+		get(c.Domain).AccountsCount = c.Count
+	}
+	for _, c := range statuses {
+		get(c.Domain).StatusesCount = c.Count
+	}
+	for _, c := range attachments {
+		storage := get(c.Domain)
+		storage.AttachmentsCount = c.Count
+		storage.AttachmentsBytes = c.Bytes
+	}
+	for _, c := range emojis {
+		storage := get(c.Domain)
+		storage.EmojisCount = c.Count
+		storage.EmojisBytes = c.Bytes
+	}
+
+	storages := make([]*db.DomainStorage, 0, len(byDomain))
+	for _, storage := range byDomain {
+		storages = append(storages, storage)
+	}
+
+	sort.Slice(storages, func(a, b int) bool {
+		aBytes := storages[a].AttachmentsBytes + storages[a].EmojisBytes
+		bBytes := storages[b].AttachmentsBytes + storages[b].EmojisBytes
+		if aBytes != bBytes {
+			return aBytes > bBytes
+		}
+		if storages[a].StatusesCount != storages[b].StatusesCount {
+			return storages[a].StatusesCount > storages[b].StatusesCount
+		}
+		return storages[a].Domain < storages[b].Domain
+	})
+
+	return storages, nil
+}
+
 func (i *instanceDB) GetInstancePeers(ctx context.Context, includeSuspended bool) ([]*gtsmodel.Instance, db.Error) {
 	instances := []*gtsmodel.Instance{}
 
@@ -143,3 +265,107 @@ func (i *instanceDB) GetInstanceAccounts(ctx context.Context, domain string, max
 
 	return accounts, nil
 }
+
+func (i *instanceDB) PutLegalDocument(ctx context.Context, document *gtsmodel.LegalDocument) db.Error {
+	return i.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		var latest int
+		if err := tx.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("legal_documents"), bun.Ident("legal_document")).
+			ColumnExpr("COALESCE(MAX(?), 0)", bun.Ident("legal_document.version")).
+			Where("? = ?", bun.Ident("legal_document.kind"), document.Kind).
+			Scan(ctx, &latest); err != nil {
+			return err
+		}
+
+		document.Version = latest + 1
+		_, err := tx.NewInsert().Model(document).Exec(ctx)
+		return err
+	})
+}
+
+func (i *instanceDB) GetLegalDocumentByID(ctx context.Context, id string) (*gtsmodel.LegalDocument, db.Error) {
+	document := &gtsmodel.LegalDocument{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(document).
+		Where("? = ?", bun.Ident("legal_document.id"), id).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return document, nil
+}
+
+func (i *instanceDB) GetLegalDocument(ctx context.Context, kind gtsmodel.LegalDocumentKind, version int) (*gtsmodel.LegalDocument, db.Error) {
+	document := &gtsmodel.LegalDocument{}
+
+	q := i.conn.
+		NewSelect().
+		Model(document).
+		Where("? = ?", bun.Ident("legal_document.kind"), kind).
+		Order("legal_document.version DESC").
+		Limit(1)
+
+	if version != 0 {
+		q = q.Where("? = ?", bun.Ident("legal_document.version"), version)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return document, nil
+}
+
+func (i *instanceDB) GetLegalDocuments(ctx context.Context, kind gtsmodel.LegalDocumentKind) ([]*gtsmodel.LegalDocument, db.Error) {
+	documents := []*gtsmodel.LegalDocument{}
+
+	q := i.conn.
+		NewSelect().
+		Model(&documents).
+		Order("legal_document.created_at DESC", "legal_document.version DESC")
+
+	if kind != "" {
+		q = q.Where("? = ?", bun.Ident("legal_document.kind"), kind)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	if len(documents) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return documents, nil
+}
+
+func (i *instanceDB) GetLegalDocumentKindsToAccept(ctx context.Context, acceptedAt time.Time) ([]gtsmodel.LegalDocumentKind, db.Error) {
+	kinds := []string{}
+
+	q := i.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("legal_documents"), bun.Ident("legal_document")).
+		Column("legal_document.kind").
+		Distinct().
+		Where("? = ?", bun.Ident("legal_document.require_acceptance"), true).
+		Order("legal_document.kind ASC")
+
+	if !acceptedAt.IsZero() {
+		q = q.Where("? > ?", bun.Ident("legal_document.created_at"), acceptedAt)
+	}
+
+	if err := q.Scan(ctx, &kinds); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	documentKinds := make([]gtsmodel.LegalDocumentKind, 0, len(kinds))
+	for _, kind := range kinds {
+		documentKinds = append(documentKinds, gtsmodel.LegalDocumentKind(kind))
+	}
+
+	return documentKinds, nil
+}
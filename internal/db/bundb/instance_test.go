@@ -21,9 +21,13 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 )
 
 type InstanceTestSuite struct {
@@ -78,6 +82,92 @@ func (suite *InstanceTestSuite) TestGetInstanceAccounts() {
 	suite.Len(accounts, 1)
 }
 
+func (suite *InstanceTestSuite) TestLegalDocumentVersions() {
+	ctx := context.Background()
+	adminAccount := suite.testAccounts["admin_account"]
+
+	_, err := suite.db.GetLegalDocuments(ctx, "")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	put := func(kind gtsmodel.LegalDocumentKind, requireAcceptance bool) *gtsmodel.LegalDocument {
+		documentID, err := id.NewULID()
+		suite.NoError(err)
+		document := &gtsmodel.LegalDocument{
+			ID:                documentID,
+			Kind:              kind,
+			Text:              "be nice",
+			RequireAcceptance: &requireAcceptance,
+			AccountID:         adminAccount.ID,
+		}
+		suite.NoError(suite.db.PutLegalDocument(ctx, document))
+		return document
+	}
+
+	terms1 := put(gtsmodel.LegalDocumentTermsOfService, true)
+	privacy1 := put(gtsmodel.LegalDocumentPrivacyPolicy, false)
+	terms2 := put(gtsmodel.LegalDocumentTermsOfService, false)
+	suite.Equal(1, terms1.Version)
+	suite.Equal(1, privacy1.Version)
+	suite.Equal(2, terms2.Version)
+
+	latest, err := suite.db.GetLegalDocument(ctx, gtsmodel.LegalDocumentTermsOfService, 0)
+	suite.NoError(err)
+	suite.Equal(terms2.ID, latest.ID)
+
+	first, err := suite.db.GetLegalDocument(ctx, gtsmodel.LegalDocumentTermsOfService, 1)
+	suite.NoError(err)
+	suite.Equal(terms1.ID, first.ID)
+
+	_, err = suite.db.GetLegalDocument(ctx, gtsmodel.LegalDocumentTermsOfService, 3)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	terms, err := suite.db.GetLegalDocuments(ctx, gtsmodel.LegalDocumentTermsOfService)
+	suite.NoError(err)
+	suite.Len(terms, 2)
+
+	// only the first terms of service required acceptance
+	kinds, err := suite.db.GetLegalDocumentKindsToAccept(ctx, time.Time{})
+	suite.NoError(err)
+	suite.Equal([]gtsmodel.LegalDocumentKind{gtsmodel.LegalDocumentTermsOfService}, kinds)
+
+	kinds, err = suite.db.GetLegalDocumentKindsToAccept(ctx, time.Now())
+	suite.NoError(err)
+	suite.Empty(kinds)
+}
+
+func (suite *InstanceTestSuite) TestGetDomainStorage() {
+	storages, err := suite.db.GetDomainStorage(context.Background())
+	suite.NoError(err)
+
+	// local accounts and emojis aren't included
+	for _, s := range storages {
+		suite.NotEqual(config.GetHost(), s.Domain)
+		suite.NotEmpty(s.Domain)
+	}
+
+	// fossbros has the most bytes stored, so it comes first
+	suite.NotEmpty(storages)
+	fossbros := storages[0]
+	suite.Equal("fossbros-anonymous.io", fossbros.Domain)
+	suite.Equal(1, fossbros.AccountsCount)
+	suite.Equal(1, fossbros.StatusesCount)
+	suite.Equal(2, fossbros.AttachmentsCount)
+	suite.EqualValues(2*(19310+20395), fossbros.AttachmentsBytes)
+	suite.Equal(1, fossbros.EmojisCount)
+	suite.EqualValues(10889+10808, fossbros.EmojisBytes)
+
+	var exampleOrg *db.DomainStorage
+	for _, s := range storages {
+		if s.Domain == "example.org" {
+			exampleOrg = s
+		}
+	}
+	suite.NotNil(exampleOrg)
+	suite.Equal(1, exampleOrg.AccountsCount)
+	suite.Zero(exampleOrg.AttachmentsBytes)
+	suite.Zero(exampleOrg.EmojisBytes)
+}
+
 func TestInstanceTestSuite(t *testing.T) {
 	suite.Run(t, new(InstanceTestSuite))
 }
@@ -0,0 +1,193 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/uptrace/bun"
+)
+
+type listDB struct {
+	conn     *DBConn
+	accounts *accountDB
+}
+
+func (l *listDB) GetListByID(ctx context.Context, id string) (*gtsmodel.List, db.Error) {
+	list := &gtsmodel.List{}
+
+	if err := l.conn.
+		NewSelect().
+		Model(list).
+		Where("? = ?", bun.Ident("list.id"), id).
+		Scan(ctx); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	return list, nil
+}
+
+func (l *listDB) GetListsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.List, db.Error) {
+	lists := []*gtsmodel.List{}
+
+	if err := l.conn.
+		NewSelect().
+		Model(&lists).
+		Where("? = ?", bun.Ident("list.account_id"), accountID).
+		Order("list.id ASC").
+		Scan(ctx); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	return lists, nil
+}
+
+func (l *listDB) GetListsContainingFollowID(ctx context.Context, followID string) ([]*gtsmodel.List, db.Error) {
+	lists := []*gtsmodel.List{}
+
+	if err := l.conn.
+		NewSelect().
+		Model(&lists).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("list_entries"), bun.Ident("list_entry"), bun.Ident("list_entry.list_id"), bun.Ident("list.id")).
+		Where("? = ?", bun.Ident("list_entry.follow_id"), followID).
+		Order("list.id ASC").
+		Scan(ctx); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	return lists, nil
+}
+
+func (l *listDB) UpdateList(ctx context.Context, list *gtsmodel.List, columns ...string) db.Error {
+	list.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := l.conn.
+		NewUpdate().
+		Model(list).
+		Where("? = ?", bun.Ident("list.id"), list.ID).
+		Column(columns...).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
+
+func (l *listDB) DeleteListByID(ctx context.Context, id string) db.Error {
+	return l.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+			Where("? = ?", bun.Ident("list_entry.list_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("lists"), bun.Ident("list")).
+			Where("? = ?", bun.Ident("list.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (l *listDB) GetListEntries(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ListEntry, db.Error) {
+	entries := []*gtsmodel.ListEntry{}
+
+	// the follow is joined rather than loaded separately,
+	// so that entries of removed follows are left out
+	q := l.conn.
+		NewSelect().
+		Model(&entries).
+		Relation("Follow").
+		Where("? = ?", bun.Ident("list_entry.list_id"), listID).
+		Where("? IS NOT NULL", bun.Ident("follow.id"))
+
+	page := paging.Page{Max: maxID, Since: sinceID, Min: minID, Limit: limit}
+	q = page.Apply(q, "list_entry.id", paging.OrderDescending)
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	paging.Reorder(page, entries)
+
+	// load the followed accounts through the account cache
+	accountIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		accountIDs = append(accountIDs, entry.Follow.TargetAccountID)
+	}
+
+	accounts, err := l.accounts.GetAccountsByIDs(ctx, accountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*gtsmodel.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	for _, entry := range entries {
+		entry.Follow.TargetAccount = byID[entry.Follow.TargetAccountID]
+	}
+
+	return entries, nil
+}
+
+func (l *listDB) PutListEntries(ctx context.Context, entries []*gtsmodel.ListEntry) db.Error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	_, err := l.conn.
+		NewInsert().
+		Model(&entries).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("list_id"), bun.Ident("follow_id")).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
+
+func (l *listDB) DeleteListEntries(ctx context.Context, listID string, followIDs []string) db.Error {
+	if len(followIDs) == 0 {
+		return nil
+	}
+
+	_, err := l.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		Where("? = ?", bun.Ident("list_entry.list_id"), listID).
+		Where("? IN (?)", bun.Ident("list_entry.follow_id"), bun.In(followIDs)).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
+
+func (l *listDB) DeleteListEntriesForFollowID(ctx context.Context, followID string) db.Error {
+	_, err := l.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		Where("? = ?", bun.Ident("list_entry.follow_id"), followID).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
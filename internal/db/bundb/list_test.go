@@ -0,0 +1,146 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ListTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ListTestSuite) TestGetListsForAccountID() {
+	lists, err := suite.db.GetListsForAccountID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	if suite.Len(lists, 1) {
+		suite.Equal(suite.testLists["local_account_1_list_1"].ID, lists[0].ID)
+	}
+
+	lists, err = suite.db.GetListsForAccountID(context.Background(), suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.Empty(lists)
+}
+
+func (suite *ListTestSuite) TestGetListEntries() {
+	list := suite.testLists["local_account_1_list_1"]
+
+	entries, err := suite.db.GetListEntries(context.Background(), list.ID, "", "", "", 0)
+	suite.NoError(err)
+	if suite.Len(entries, 1) {
+		suite.Equal(suite.testListEntries["local_account_1_list_1_entry_1"].ID, entries[0].ID)
+		suite.NotNil(entries[0].Follow)
+		suite.NotNil(entries[0].Follow.TargetAccount)
+		suite.Equal(suite.testAccounts["local_account_2"].ID, entries[0].Follow.TargetAccount.ID)
+	}
+}
+
+func (suite *ListTestSuite) TestPutAndDeleteListEntries() {
+	ctx := context.Background()
+	list := suite.testLists["local_account_1_list_1"]
+	follow := suite.testFollows["local_account_1_admin_account"]
+
+	entries := []*gtsmodel.ListEntry{
+		{
+			ID:       "01GKZ3BW9V1Y0C5N6D9N3JZ1YJ",
+			ListID:   list.ID,
+			FollowID: follow.ID,
+		},
+		{
+			// the existing entry shouldn't be added a second time
+			ID:       "01GKZ3CGX8N6VAR0JQ0Q5DS6S9",
+			ListID:   list.ID,
+			FollowID: suite.testListEntries["local_account_1_list_1_entry_1"].FollowID,
+		},
+	}
+	suite.NoError(suite.db.PutListEntries(ctx, entries))
+
+	got, err := suite.db.GetListEntries(ctx, list.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(got, 2)
+
+	lists, err := suite.db.GetListsContainingFollowID(ctx, follow.ID)
+	suite.NoError(err)
+	suite.Len(lists, 1)
+
+	suite.NoError(suite.db.DeleteListEntriesForFollowID(ctx, follow.ID))
+
+	got, err = suite.db.GetListEntries(ctx, list.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(got, 1)
+}
+
+func (suite *ListTestSuite) TestGetListTimeline() {
+	list := suite.testLists["local_account_1_list_1"]
+
+	statuses, err := suite.db.GetListTimeline(context.Background(), list.ID, "", "", "", 20)
+	suite.NoError(err)
+	suite.NotEmpty(statuses)
+
+	// only statuses of accounts in the list should be returned, newest first
+	for i, s := range statuses {
+		suite.Equal(suite.testAccounts["local_account_2"].ID, s.AccountID)
+		if i > 0 {
+			suite.Less(s.ID, statuses[i-1].ID)
+		}
+	}
+}
+
+func (suite *ListTestSuite) TestGetListTimelineMinID() {
+	ctx := context.Background()
+	list := suite.testLists["local_account_1_list_1"]
+
+	all, err := suite.db.GetListTimeline(ctx, list.ID, "", "", "", 0)
+	suite.NoError(err)
+	if !suite.GreaterOrEqual(len(all), 3) {
+		suite.FailNow("need at least 3 statuses in the list timeline")
+	}
+
+	// paging up from the oldest status should give the statuses
+	// just above it, not the newest ones, still newest first
+	oldest := all[len(all)-1]
+	statuses, err := suite.db.GetListTimeline(ctx, list.ID, "", "", oldest.ID, 2)
+	suite.NoError(err)
+	suite.Len(statuses, 2)
+	suite.Equal(all[len(all)-2].ID, statuses[1].ID)
+	suite.Equal(all[len(all)-3].ID, statuses[0].ID)
+}
+
+func (suite *ListTestSuite) TestDeleteListByID() {
+	ctx := context.Background()
+	list := suite.testLists["local_account_1_list_1"]
+
+	suite.NoError(suite.db.DeleteListByID(ctx, list.ID))
+
+	_, err := suite.db.GetListByID(ctx, list.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	entries, err := suite.db.GetListEntries(ctx, list.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Empty(entries)
+}
+
+func TestListTestSuite(t *testing.T) {
+	suite.Run(t, new(ListTestSuite))
+}
@@ -0,0 +1,89 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// loadByIDs returns the models with the given IDs, in the same order as the IDs.
+//
+// As many models as possible are taken from the cache with cacheGet, and all of the
+// cache misses are then fetched in one go by dbQuery (usually with a single `IN` query),
+// and placed in the cache with cachePut. This saves a database round trip per cache
+// miss compared to getting the models one by one.
+//
+// IDs with no model are logged under the given name and left out of the returned
+// slice, rather than failing the whole lookup. An error is only returned if dbQuery
+// fails; the caller should process it with conn.ProcessError.
+func loadByIDs[T any](
+	name string,
+	ids []string,
+	modelID func(T) string,
+	cacheGet func(id string) (T, bool),
+	dbQuery func(missing []string) ([]T, error),
+	cachePut func(T),
+) ([]T, error) {
+	// Take what we can from the cache,
+	// and note which IDs we still need.
+	models := make(map[string]T, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	missing := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			// given more than once
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if model, cached := cacheGet(id); cached {
+			models[id] = model
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) != 0 {
+		// Fetch all the cache misses in one go
+		dbModels, err := dbQuery(missing)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, model := range dbModels {
+			// Place in the cache
+			cachePut(model)
+			models[modelID(model)] = model
+		}
+	}
+
+	// Return models in the same order as the given IDs
+	ordered := make([]T, 0, len(ids))
+	for _, id := range ids {
+		model, ok := models[id]
+		if !ok {
+			log.Errorf("%s: %q not found", name, id)
+			continue
+		}
+		ordered = append(ordered, model)
+	}
+
+	return ordered, nil
+}
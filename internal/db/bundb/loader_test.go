@@ -0,0 +1,124 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type loaderModel struct {
+	ID string
+}
+
+type LoaderTestSuite struct {
+	suite.Suite
+	cache   map[string]*loaderModel
+	db      map[string]*loaderModel
+	queries [][]string
+}
+
+func (suite *LoaderTestSuite) SetupTest() {
+	suite.cache = map[string]*loaderModel{
+		"1": {ID: "1"},
+		"3": {ID: "3"},
+	}
+	suite.db = map[string]*loaderModel{
+		"1": {ID: "1"},
+		"2": {ID: "2"},
+		"3": {ID: "3"},
+		"4": {ID: "4"},
+	}
+	suite.queries = nil
+}
+
+func (suite *LoaderTestSuite) load(ids []string, dbErr error) ([]*loaderModel, error) {
+	return loadByIDs(
+		"load",
+		ids,
+		func(m *loaderModel) string { return m.ID },
+		func(id string) (*loaderModel, bool) {
+			m, ok := suite.cache[id]
+			return m, ok
+		},
+		func(missing []string) ([]*loaderModel, error) {
+			suite.queries = append(suite.queries, missing)
+			if dbErr != nil {
+				return nil, dbErr
+			}
+			models := []*loaderModel{}
+			for _, id := range missing {
+				if m, ok := suite.db[id]; ok {
+					models = append(models, m)
+				}
+			}
+			return models, nil
+		},
+		func(m *loaderModel) {
+			suite.cache[m.ID] = m
+		},
+	)
+}
+
+func (suite *LoaderTestSuite) ids(models []*loaderModel) []string {
+	ids := []string{}
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+func (suite *LoaderTestSuite) TestLoadByIDs() {
+	models, err := suite.load([]string{"4", "1", "2", "3", "2"}, nil)
+	suite.NoError(err)
+	suite.Equal([]string{"4", "1", "2", "3", "2"}, suite.ids(models))
+
+	// only the cache misses were queried, once each, in one go
+	suite.Equal([][]string{{"4", "2"}}, suite.queries)
+
+	// and they were cached, so they don't need to be queried again
+	models, err = suite.load([]string{"2", "4"}, nil)
+	suite.NoError(err)
+	suite.Equal([]string{"2", "4"}, suite.ids(models))
+	suite.Len(suite.queries, 1)
+}
+
+func (suite *LoaderTestSuite) TestLoadByIDsMissing() {
+	models, err := suite.load([]string{"1", "5", "2"}, nil)
+	suite.NoError(err)
+	suite.Equal([]string{"1", "2"}, suite.ids(models))
+}
+
+func (suite *LoaderTestSuite) TestLoadByIDsAllCached() {
+	models, err := suite.load([]string{"3", "1"}, nil)
+	suite.NoError(err)
+	suite.Equal([]string{"3", "1"}, suite.ids(models))
+	suite.Empty(suite.queries)
+}
+
+func (suite *LoaderTestSuite) TestLoadByIDsError() {
+	_, err := suite.load([]string{"1", "2"}, errors.New("oh no"))
+	suite.EqualError(err, "oh no")
+}
+
+func TestLoaderTestSuite(t *testing.T) {
+	suite.Run(t, &LoaderTestSuite{})
+}
@@ -22,11 +22,15 @@ import (
 	"context"
 	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/uptrace/bun"
 )
 
+// requestCacheAttachments is the kind of lookup that media attachments are remembered under in request caches.
+const requestCacheAttachments = "attachments"
+
 type mediaDB struct {
 	conn *DBConn
 }
@@ -39,15 +43,17 @@ func (m *mediaDB) newMediaQ(i interface{}) *bun.SelectQuery {
 }
 
 func (m *mediaDB) GetAttachmentByID(ctx context.Context, id string) (*gtsmodel.MediaAttachment, db.Error) {
-	attachment := &gtsmodel.MediaAttachment{}
+	return cache.RequestCached(ctx, requestCacheAttachments, id, func() (*gtsmodel.MediaAttachment, error) {
+		attachment := &gtsmodel.MediaAttachment{}
 
-	q := m.newMediaQ(attachment).
-		Where("? = ?", bun.Ident("media_attachment.id"), id)
+		q := m.newMediaQ(attachment).
+			Where("? = ?", bun.Ident("media_attachment.id"), id)
 
-	if err := q.Scan(ctx); err != nil {
-		return nil, m.conn.ProcessError(err)
-	}
-	return attachment, nil
+		if err := q.Scan(ctx); err != nil {
+			return nil, m.conn.ProcessError(err)
+		}
+		return attachment, nil
+	})
 }
 
 func (m *mediaDB) GetRemoteOlderThan(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.MediaAttachment, db.Error) {
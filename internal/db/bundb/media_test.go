@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -38,6 +39,26 @@ func (suite *MediaTestSuite) TestGetAttachmentByID() {
 	suite.NotNil(attachment)
 }
 
+func (suite *MediaTestSuite) TestGetAttachmentByIDRequestCached() {
+	ctx := cache.WithRequestCache(context.Background())
+	testAttachment := suite.testAttachments["admin_account_status_1_attachment_1"]
+
+	attachment1, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+
+	attachment2, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Same(attachment1, attachment2)
+
+	attachment2.Description = "new description for this test"
+	suite.NoError(suite.db.UpdateByID(ctx, attachment2, attachment2.ID, "description"))
+
+	attachment3, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.NotSame(attachment1, attachment3)
+	suite.Equal("new description for this test", attachment3.Description)
+}
+
 func (suite *MediaTestSuite) TestGetOlder() {
 	attachments, err := suite.db.GetRemoteOlderThan(context.Background(), time.Now(), 20)
 	suite.NoError(err)
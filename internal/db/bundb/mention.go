@@ -24,7 +24,6 @@ import (
 	"codeberg.org/gruf/go-cache/v2"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/uptrace/bun"
 )
 
@@ -66,18 +65,23 @@ func (m *mentionDB) GetMention(ctx context.Context, id string) (*gtsmodel.Mentio
 }
 
 func (m *mentionDB) GetMentions(ctx context.Context, ids []string) ([]*gtsmodel.Mention, db.Error) {
-	mentions := make([]*gtsmodel.Mention, 0, len(ids))
-
-	for _, id := range ids {
-		// Attempt fetch from DB
-		mention, err := m.GetMention(ctx, id)
-		if err != nil {
-			log.Errorf("GetMentions: error getting mention %q: %v", id, err)
-			continue
-		}
-
-		// Append mention
-		mentions = append(mentions, mention)
+	mentions, err := loadByIDs(
+		"GetMentions",
+		ids,
+		func(mention *gtsmodel.Mention) string { return mention.ID },
+		m.cache.Get,
+		func(missing []string) ([]*gtsmodel.Mention, error) {
+			dbMentions := []*gtsmodel.Mention{}
+			err := m.newMentionQ(&dbMentions).Where("? IN (?)", bun.Ident("mention.id"), bun.In(missing)).Scan(ctx)
+			return dbMentions, err
+		},
+		func(mention *gtsmodel.Mention) {
+			copy := *mention
+			m.cache.Set(mention.ID, &copy)
+		},
+	)
+	if err != nil {
+		return nil, m.conn.ProcessError(err)
 	}
 
 	return mentions, nil
@@ -0,0 +1,60 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		q := db.NewCreateIndex().
+			Table("emojis").
+			Index("emojis_lower_shortcode_idx").
+			IfNotExists()
+
+		switch db.Dialect().Name() {
+		case dialect.PG:
+			// text_pattern_ops lets prefix LIKE queries use the
+			// index regardless of the database's collation
+			q = q.ColumnExpr("LOWER(?) text_pattern_ops", bun.Ident("shortcode"))
+		case dialect.SQLite:
+			q = q.ColumnExpr("LOWER(?)", bun.Ident("shortcode"))
+		default:
+			log.Panic("db dialect was neither pg nor sqlite")
+		}
+
+		_, err := q.Exec(ctx)
+		return err
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			var statements []string
+			switch tx.Dialect().Name() {
+			case dialect.SQLite:
+				statements = []string{
+					// index the statuses table as external content, so that
+					// the text of statuses isn't stored twice in the database
+					`CREATE VIRTUAL TABLE IF NOT EXISTS status_fts USING fts5(content_warning, text, content, content='statuses', content_rowid='rowid')`,
+					`INSERT INTO status_fts(status_fts) VALUES('rebuild')`,
+					`CREATE TRIGGER IF NOT EXISTS statuses_fts_insert AFTER INSERT ON statuses BEGIN
+						INSERT INTO status_fts(rowid, content_warning, text, content) VALUES (new.rowid, new.content_warning, new.text, new.content);
+					END`,
+					`CREATE TRIGGER IF NOT EXISTS statuses_fts_delete AFTER DELETE ON statuses BEGIN
+						INSERT INTO status_fts(status_fts, rowid, content_warning, text, content) VALUES ('delete', old.rowid, old.content_warning, old.text, old.content);
+					END`,
+					`CREATE TRIGGER IF NOT EXISTS statuses_fts_update AFTER UPDATE OF content_warning, text, content ON statuses BEGIN
+						INSERT INTO status_fts(status_fts, rowid, content_warning, text, content) VALUES ('delete', old.rowid, old.content_warning, old.text, old.content);
+						INSERT INTO status_fts(rowid, content_warning, text, content) VALUES (new.rowid, new.content_warning, new.text, new.content);
+					END`,
+				}
+			case dialect.PG:
+				statements = []string{
+					`ALTER TABLE statuses ADD COLUMN IF NOT EXISTS search_vector tsvector`,
+					`CREATE OR REPLACE FUNCTION statuses_search_vector_update() RETURNS trigger AS $$
+					BEGIN
+						NEW.search_vector := to_tsvector('simple', COALESCE(NEW.content_warning, '') || ' ' || COALESCE(NULLIF(NEW.text, ''), NEW.content, ''));
+						RETURN NEW;
+					END
+					$$ LANGUAGE plpgsql`,
+					`DROP TRIGGER IF EXISTS statuses_search_vector_update ON statuses`,
+					`CREATE TRIGGER statuses_search_vector_update BEFORE INSERT OR UPDATE OF content_warning, text, content ON statuses
+						FOR EACH ROW EXECUTE PROCEDURE statuses_search_vector_update()`,
+					`CREATE INDEX IF NOT EXISTS statuses_search_vector_idx ON statuses USING GIN (search_vector)`,
+					`UPDATE statuses SET search_vector = to_tsvector('simple', COALESCE(content_warning, '') || ' ' || COALESCE(NULLIF(text, ''), content, '')) WHERE search_vector IS NULL`,
+				}
+			default:
+				log.Panic("db dialect was neither pg nor sqlite")
+			}
+
+			for _, statement := range statements {
+				if _, err := tx.ExecContext(ctx, statement); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
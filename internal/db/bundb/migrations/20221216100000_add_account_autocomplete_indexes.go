@@ -0,0 +1,94 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// indexes for prefix matching usernames
+			// and display names when autocompleting
+			for index, column := range map[string]string{
+				"accounts_lower_username_idx":     "username",
+				"accounts_lower_display_name_idx": "display_name",
+			} {
+				q := tx.NewCreateIndex().
+					Table("accounts").
+					Index(index).
+					IfNotExists()
+
+				switch db.Dialect().Name() {
+				case dialect.PG:
+					// text_pattern_ops lets prefix LIKE queries use the
+					// index regardless of the database's collation
+					q = q.ColumnExpr("LOWER(?) text_pattern_ops", bun.Ident(column))
+				case dialect.SQLite:
+					q = q.ColumnExpr("LOWER(?)", bun.Ident(column))
+				default:
+					log.Panic("db dialect was neither pg nor sqlite")
+				}
+
+				if _, err := q.Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// index for finding the accounts an account has mentioned
+			if _, err := tx.
+				NewCreateIndex().
+				Table("mentions").
+				Index("mentions_origin_account_id_idx").
+				Column("origin_account_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// index for finding the accounts that have mentioned an account
+			if _, err := tx.
+				NewCreateIndex().
+				Table("mentions").
+				Index("mentions_target_account_id_idx").
+				Column("target_account_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
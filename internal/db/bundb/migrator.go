@@ -0,0 +1,198 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+)
+
+// MigrationStatus describes one database migration, and whether it has been applied.
+type MigrationStatus struct {
+	Name       string    // eg., 20221202100000_add_status_search_index
+	Applied    bool      // whether the migration has been applied to the database
+	MigratedAt time.Time // when the migration was applied, zero if not applied
+}
+
+// Migrator inspects and manages the migrations of the configured database,
+// so that they can be run separately from starting the server.
+type Migrator struct {
+	conn     *DBConn
+	migrator *migrate.Migrator
+}
+
+// NewMigrator connects to the configured database, without running any migrations against it.
+func NewMigrator(ctx context.Context) (*Migrator, error) {
+	conn, err := newConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	migrator := migrate.NewMigrator(conn.DB, migrations.Migrations)
+	if err := migrator.Init(ctx); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("error initializing migrations tables: %w", err)
+	}
+
+	return &Migrator{
+		conn:     conn,
+		migrator: migrator,
+	}, nil
+}
+
+// Status returns the status of every known migration, oldest first.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	ms, err := m.migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(ms))
+	for _, migration := range ms {
+		statuses = append(statuses, MigrationStatus{
+			Name:       migration.String(),
+			Applied:    migration.IsApplied(),
+			MigratedAt: migration.MigratedAt,
+		})
+	}
+
+	return statuses, nil
+}
+
+// Up runs all pending migrations, returning the names of those which were run.
+// If a migration fails, its name will be the last one returned alongside the error.
+func (m *Migrator) Up(ctx context.Context) ([]string, error) {
+	group, err := m.migrator.Migrate(ctx)
+	if group == nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(group.Migrations))
+	for _, migration := range group.Migrations {
+		names = append(names, migration.String())
+	}
+
+	return names, err
+}
+
+// Down rolls back the most recently applied migration, returning its name,
+// or an empty string if there were no applied migrations to roll back.
+//
+// Most migrations don't undo their changes to the database when rolled back;
+// rolling back one of these just marks it as pending, so it will be run again.
+func (m *Migrator) Down(ctx context.Context) (string, error) {
+	if err := m.migrator.Lock(ctx); err != nil {
+		return "", fmt.Errorf("error locking migrations: %w", err)
+	}
+	defer m.migrator.Unlock(ctx) //nolint:errcheck
+
+	ms, err := m.migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// find the migration applied last, which isn't
+	// necessarily the newest, eg., if it was marked
+	// as applied by hand after newer ones were run
+	var last *migrate.Migration
+	for i := range ms {
+		if ms[i].IsApplied() && (last == nil || ms[i].ID > last.ID) {
+			last = &ms[i]
+		}
+	}
+
+	if last == nil {
+		return "", nil
+	}
+
+	if last.Down != nil {
+		if err := last.Down(ctx, m.conn.DB); err != nil {
+			return last.String(), err
+		}
+	}
+
+	return last.String(), m.migrator.MarkUnapplied(ctx, last)
+}
+
+// MarkApplied marks the pending migration with the given name as applied,
+// without running it, for when its changes have already been made to the
+// database by some other means. Name may be given either in full, or as
+// just the timestamp at the start of it.
+func (m *Migrator) MarkApplied(ctx context.Context, name string) error {
+	if err := m.migrator.Lock(ctx); err != nil {
+		return fmt.Errorf("error locking migrations: %w", err)
+	}
+	defer m.migrator.Unlock(ctx) //nolint:errcheck
+
+	ms, err := m.migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	var target *migrate.Migration
+	for i := range ms {
+		if ms[i].Name == name || ms[i].String() == name {
+			target = &ms[i]
+			break
+		}
+	}
+
+	if target == nil {
+		return fmt.Errorf("no migration found with name %s", name)
+	}
+
+	if target.IsApplied() {
+		return fmt.Errorf("migration %s has already been applied", target)
+	}
+
+	// put it in a group of its own, like a migration run by Up would be
+	target.GroupID = ms.LastGroupID() + 1
+	return m.migrator.MarkApplied(ctx, target)
+}
+
+// Close closes the connection to the database.
+func (m *Migrator) Close() error {
+	return m.conn.Close()
+}
+
+// checkNoPendingMigrations returns an error if there are
+// any migrations still to be run against the given database.
+func checkNoPendingMigrations(ctx context.Context, db *bun.DB) error {
+	migrator := migrate.NewMigrator(db, migrations.Migrations)
+	if err := migrator.Init(ctx); err != nil {
+		return fmt.Errorf("db migration error: %s", err)
+	}
+
+	ms, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("db migration error: %s", err)
+	}
+
+	if pending := ms.Unapplied(); len(pending) != 0 {
+		return fmt.Errorf("%s is set, but there are %d pending database migrations; run them with 'gotosocial admin migrations up' first", config.DbSkipMigrationsFlag(), len(pending))
+	}
+
+	return nil
+}
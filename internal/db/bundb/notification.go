@@ -20,11 +20,12 @@ package bundb
 
 import (
 	"context"
+	"time"
 
 	"codeberg.org/gruf/go-cache/v2"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/uptrace/bun"
 )
 
@@ -33,6 +34,15 @@ type notificationDB struct {
 	cache cache.Cache[string, *gtsmodel.Notification]
 }
 
+func (n *notificationDB) newNotificationQ(i interface{}) *bun.SelectQuery {
+	return n.conn.
+		NewSelect().
+		Model(i).
+		Relation("OriginAccount").
+		Relation("TargetAccount").
+		Relation("Status")
+}
+
 func (n *notificationDB) GetNotification(ctx context.Context, id string) (*gtsmodel.Notification, db.Error) {
 	if notification, ok := n.cache.Get(id); ok {
 		return notification, nil
@@ -40,11 +50,7 @@ func (n *notificationDB) GetNotification(ctx context.Context, id string) (*gtsmo
 
 	dst := gtsmodel.Notification{ID: id}
 
-	q := n.conn.NewSelect().
-		Model(&dst).
-		Relation("OriginAccount").
-		Relation("TargetAccount").
-		Relation("Status").
+	q := n.newNotificationQ(&dst).
 		Where("? = ?", bun.Ident("notification.id"), id)
 
 	if err := q.Scan(ctx); err != nil {
@@ -71,58 +77,168 @@ func (n *notificationDB) GetNotifications(ctx context.Context, accountID string,
 		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
 		Column("notification.id")
 
-	if maxID != "" {
-		q = q.Where("? < ?", bun.Ident("notification.id"), maxID)
-	}
-
-	if sinceID != "" {
-		q = q.Where("? > ?", bun.Ident("notification.id"), sinceID)
-	}
-
 	for _, excludeType := range excludeTypes {
 		q = q.Where("? != ?", bun.Ident("notification.notification_type"), excludeType)
 	}
 
-	q = q.
-		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
-		Order("notification.id DESC")
+	q = q.Where("? = ?", bun.Ident("notification.target_account_id"), accountID)
 
-	if limit != 0 {
-		q = q.Limit(limit)
-	}
+	page := paging.Page{Max: maxID, Since: sinceID, Limit: limit}
+	q = page.Apply(q, "notification.id", paging.OrderDescending)
 
 	if err := q.Scan(ctx, &notifIDs); err != nil {
 		return nil, n.conn.ProcessError(err)
 	}
 
-	notifs := make([]*gtsmodel.Notification, 0, limit)
+	// now we have the IDs, take what we can from the
+	// cache, and select the rest of the notifs in one go
+	notifs, err := loadByIDs(
+		"GetNotifications",
+		notifIDs,
+		func(notif *gtsmodel.Notification) string { return notif.ID },
+		n.cache.Get,
+		func(missing []string) ([]*gtsmodel.Notification, error) {
+			dbNotifs := []*gtsmodel.Notification{}
+			err := n.newNotificationQ(&dbNotifs).Where("? IN (?)", bun.Ident("notification.id"), bun.In(missing)).Scan(ctx)
+			return dbNotifs, err
+		},
+		func(notif *gtsmodel.Notification) {
+			copy := *notif
+			n.cache.Set(notif.ID, &copy)
+		},
+	)
+	if err != nil {
+		return nil, n.conn.ProcessError(err)
+	}
 
-	// now we have the IDs, select the notifs one by one
-	// reason for this is that for each notif, we can instead get it from our cache if it's cached
-	for _, id := range notifIDs {
-		// Attempt fetch from DB
-		notif, err := n.GetNotification(ctx, id)
-		if err != nil {
-			log.Errorf("GetNotifications: error getting notification %q: %v", id, err)
-			continue
-		}
+	return notifs, nil
+}
+
+func (n *notificationDB) ClearNotifications(ctx context.Context, accountID string, types []string) db.Error {
+	q := n.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID)
 
-		// Append notification
-		notifs = append(notifs, notif)
+	if len(types) != 0 {
+		q = q.Where("? IN (?)", bun.Ident("notification.notification_type"), bun.In(types))
 	}
 
-	return notifs, nil
+	if _, err := q.Exec(ctx); err != nil {
+		return n.conn.ProcessError(err)
+	}
+
+	n.cache.Clear()
+	return nil
 }
 
-func (n *notificationDB) ClearNotifications(ctx context.Context, accountID string) db.Error {
+func (n *notificationDB) MarkNotificationsRead(ctx context.Context, ids []string) db.Error {
+	if len(ids) == 0 {
+		return nil
+	}
+
 	if _, err := n.conn.
-		NewDelete().
+		NewUpdate().
 		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
-		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
+		Set("? = ?", bun.Ident("read"), true).
+		Where("? IN (?)", bun.Ident("notification.id"), bun.In(ids)).
 		Exec(ctx); err != nil {
 		return n.conn.ProcessError(err)
 	}
 
-	n.cache.Clear()
+	for _, id := range ids {
+		n.cache.Invalidate(id)
+		n.conn.bus.Publish(ctx, cacheNotifications, id)
+	}
 	return nil
 }
+
+func (n *notificationDB) PruneReadNotifications(ctx context.Context, defaultRetentionDays int, batchSize int) (int, db.Error) {
+	// find every retention period that
+	// accounts have chosen for themselves
+	retentionDays := []int{}
+	if err := n.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		ColumnExpr("DISTINCT ?", bun.Ident("account.notifications_retention_days")).
+		Where("? > 0", bun.Ident("account.notifications_retention_days")).
+		Scan(ctx, &retentionDays); err != nil {
+		return 0, n.conn.ProcessError(err)
+	}
+
+	total := 0
+	defer func() {
+		if total != 0 {
+			n.cache.Clear()
+		}
+	}()
+
+	for _, days := range retentionDays {
+		accountIDs := n.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			Column("account.id").
+			Where("? = ?", bun.Ident("account.notifications_retention_days"), days)
+
+		pruned, err := n.pruneReadNotifications(ctx, accountIDs, days, batchSize)
+		total += pruned
+		if err != nil {
+			return total, err
+		}
+	}
+
+	if defaultRetentionDays > 0 {
+		accountIDs := n.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			Column("account.id").
+			Where("? IS NULL", bun.Ident("account.notifications_retention_days"))
+
+		pruned, err := n.pruneReadNotifications(ctx, accountIDs, defaultRetentionDays, batchSize)
+		total += pruned
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// pruneReadNotifications deletes read notifications older than the given number of days which
+// pertain to the accounts selected by accountIDs, batchSize at a time so as not to hold long locks.
+func (n *notificationDB) pruneReadNotifications(ctx context.Context, accountIDs *bun.SelectQuery, days int, batchSize int) (int, db.Error) {
+	olderThan := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	total := 0
+
+	for {
+		ids := []string{}
+		if err := n.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+			Column("notification.id").
+			Where("? = ?", bun.Ident("notification.read"), true).
+			Where("? < ?", bun.Ident("notification.created_at"), olderThan).
+			Where("? IN (?)", bun.Ident("notification.target_account_id"), accountIDs).
+			Limit(batchSize).
+			Scan(ctx, &ids); err != nil {
+			return total, n.conn.ProcessError(err)
+		}
+
+		if len(ids) == 0 {
+			return total, nil
+		}
+
+		if _, err := n.conn.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+			Where("? IN (?)", bun.Ident("notification.id"), bun.In(ids)).
+			Exec(ctx); err != nil {
+			return total, n.conn.ProcessError(err)
+		}
+
+		total += len(ids)
+		if len(ids) < batchSize {
+			return total, nil
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -122,7 +123,7 @@ func (suite *NotificationTestSuite) TestGetNotificationsWithoutSpam() {
 func (suite *NotificationTestSuite) TestClearNotificationsWithSpam() {
 	suite.spamNotifs()
 	testAccount := suite.testAccounts["local_account_1"]
-	err := suite.db.ClearNotifications(context.Background(), testAccount.ID)
+	err := suite.db.ClearNotifications(context.Background(), testAccount.ID, nil)
 	suite.NoError(err)
 
 	notifications, err := suite.db.GetNotifications(context.Background(), testAccount.ID, []string{}, 20, "ZZZZZZZZZZZZZZZZZZZZZZZZZZ", "00000000000000000000000000")
@@ -134,7 +135,7 @@ func (suite *NotificationTestSuite) TestClearNotificationsWithSpam() {
 func (suite *NotificationTestSuite) TestClearNotificationsWithTwoAccounts() {
 	suite.spamNotifs()
 	testAccount := suite.testAccounts["local_account_1"]
-	err := suite.db.ClearNotifications(context.Background(), testAccount.ID)
+	err := suite.db.ClearNotifications(context.Background(), testAccount.ID, nil)
 	suite.NoError(err)
 
 	notifications, err := suite.db.GetNotifications(context.Background(), testAccount.ID, []string{}, 20, "ZZZZZZZZZZZZZZZZZZZZZZZZZZ", "00000000000000000000000000")
@@ -148,6 +149,121 @@ func (suite *NotificationTestSuite) TestClearNotificationsWithTwoAccounts() {
 	suite.NotEmpty(notif)
 }
 
+func (suite *NotificationTestSuite) TestClearNotificationsByType() {
+	testAccount := suite.testAccounts["local_account_1"]
+	err := suite.db.ClearNotifications(context.Background(), testAccount.ID, []string{string(gtsmodel.NotificationMention)})
+	suite.NoError(err)
+
+	// the test notification is a fave, so it should still be there
+	notifications, err := suite.db.GetNotifications(context.Background(), testAccount.ID, []string{}, 20, "ZZZZZZZZZZZZZZZZZZZZZZZZZZ", "00000000000000000000000000")
+	suite.NoError(err)
+	suite.Len(notifications, 1)
+
+	err = suite.db.ClearNotifications(context.Background(), testAccount.ID, []string{string(gtsmodel.NotificationFave)})
+	suite.NoError(err)
+
+	notifications, err = suite.db.GetNotifications(context.Background(), testAccount.ID, []string{}, 20, "ZZZZZZZZZZZZZZZZZZZZZZZZZZ", "00000000000000000000000000")
+	suite.NoError(err)
+	suite.Empty(notifications)
+}
+
+func (suite *NotificationTestSuite) TestMarkNotificationsRead() {
+	testNotification := testrig.NewTestNotifications()["local_account_1_like"]
+
+	// prime the cache so we can check it's invalidated
+	_, err := suite.db.GetNotification(context.Background(), testNotification.ID)
+	suite.NoError(err)
+
+	err = suite.db.MarkNotificationsRead(context.Background(), []string{testNotification.ID})
+	suite.NoError(err)
+
+	dbNotification, err := suite.db.GetNotification(context.Background(), testNotification.ID)
+	suite.NoError(err)
+	suite.True(*dbNotification.Read)
+}
+
+// putNotification puts a fave notification for the given account, created the given number of days ago.
+func (suite *NotificationTestSuite) putNotification(targetAccountID string, daysAgo int, read bool) *gtsmodel.Notification {
+	notifID, err := id.NewRandomULID()
+	if err != nil {
+		panic(err)
+	}
+
+	notif := &gtsmodel.Notification{
+		ID:               notifID,
+		NotificationType: gtsmodel.NotificationFave,
+		CreatedAt:        time.Now().Add(-time.Duration(daysAgo) * 24 * time.Hour),
+		TargetAccountID:  targetAccountID,
+		OriginAccountID:  suite.testAccounts["admin_account"].ID,
+		StatusID:         suite.testStatuses["local_account_1_status_1"].ID,
+		Read:             &read,
+	}
+
+	if err := suite.db.Put(context.Background(), notif); err != nil {
+		panic(err)
+	}
+
+	return notif
+}
+
+func (suite *NotificationTestSuite) TestPruneReadNotifications() {
+	testAccount := suite.testAccounts["local_account_1"]
+	oldRead1 := suite.putNotification(testAccount.ID, 40, true)
+	oldRead2 := suite.putNotification(testAccount.ID, 35, true)
+	oldUnread := suite.putNotification(testAccount.ID, 40, false)
+	newRead := suite.putNotification(testAccount.ID, 5, true)
+
+	// use a batch size of 1 to make sure batching works
+	pruned, err := suite.db.PruneReadNotifications(context.Background(), 30, 1)
+	suite.NoError(err)
+	suite.Equal(2, pruned)
+
+	for _, notif := range []*gtsmodel.Notification{oldRead1, oldRead2} {
+		_, err := suite.db.GetNotification(context.Background(), notif.ID)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	for _, notif := range []*gtsmodel.Notification{oldUnread, newRead} {
+		_, err := suite.db.GetNotification(context.Background(), notif.ID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *NotificationTestSuite) TestPruneReadNotificationsAccountRetention() {
+	// this account keeps notifications for a week
+	shortAccount := suite.testAccounts["local_account_1"]
+	shortDays := 7
+	shortAccount.NotificationsRetentionDays = &shortDays
+	err := suite.db.UpdateByID(context.Background(), shortAccount, shortAccount.ID, "notifications_retention_days")
+	suite.NoError(err)
+
+	// this account keeps notifications forever
+	foreverAccount := suite.testAccounts["local_account_2"]
+	foreverDays := 0
+	foreverAccount.NotificationsRetentionDays = &foreverDays
+	err = suite.db.UpdateByID(context.Background(), foreverAccount, foreverAccount.ID, "notifications_retention_days")
+	suite.NoError(err)
+
+	shortNotif := suite.putNotification(shortAccount.ID, 10, true)
+	foreverNotif := suite.putNotification(foreverAccount.ID, 400, true)
+
+	// no instance default, so only the account's own setting applies
+	pruned, err := suite.db.PruneReadNotifications(context.Background(), 0, 500)
+	suite.NoError(err)
+	suite.Equal(1, pruned)
+
+	_, err = suite.db.GetNotification(context.Background(), shortNotif.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// the instance default doesn't apply to an account that chose to keep notifications
+	pruned, err = suite.db.PruneReadNotifications(context.Background(), 30, 500)
+	suite.NoError(err)
+	suite.Zero(pruned)
+
+	_, err = suite.db.GetNotification(context.Background(), foreverNotif.ID)
+	suite.NoError(err)
+}
+
 func TestNotificationTestSuite(t *testing.T) {
 	suite.Run(t, new(NotificationTestSuite))
 }
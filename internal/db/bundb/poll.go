@@ -0,0 +1,165 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type pollDB struct {
+	conn *DBConn
+}
+
+// pollChoiceCount is the number of votes for one option of a poll.
+type pollChoiceCount struct {
+	Choice int `bun:"choice"`
+	Count  int `bun:"count"`
+}
+
+func (p *pollDB) GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, db.Error) {
+	return p.getPoll(ctx, "poll.id", id)
+}
+
+func (p *pollDB) GetPollByStatusID(ctx context.Context, statusID string) (*gtsmodel.Poll, db.Error) {
+	return p.getPoll(ctx, "poll.status_id", statusID)
+}
+
+func (p *pollDB) getPoll(ctx context.Context, column string, value string) (*gtsmodel.Poll, db.Error) {
+	poll := &gtsmodel.Poll{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(poll).
+		Where("? = ?", bun.Ident(column), value).
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return poll, nil
+}
+
+func (p *pollDB) UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) db.Error {
+	poll.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := p.conn.
+		NewUpdate().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.id"), poll.ID).
+		Column(columns...).
+		Exec(ctx)
+	return p.conn.ProcessError(err)
+}
+
+func (p *pollDB) GetExpiredPolls(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.Poll, db.Error) {
+	polls := []*gtsmodel.Poll{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(&polls).
+		Where("? <= ?", bun.Ident("poll.expires_at"), now).
+		Where("? IS NULL", bun.Ident("poll.closed_at")).
+		Order("poll.expires_at ASC").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return polls, nil
+}
+
+func (p *pollDB) PutPollVotes(ctx context.Context, votes []*gtsmodel.PollVote) db.Error {
+	_, err := p.conn.
+		NewInsert().
+		Model(&votes).
+		Exec(ctx)
+	return p.conn.ProcessError(err)
+}
+
+func (p *pollDB) GetPollVotesBy(ctx context.Context, pollID string, accountID string) ([]*gtsmodel.PollVote, db.Error) {
+	votes := []*gtsmodel.PollVote{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(&votes).
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+		Where("? = ?", bun.Ident("poll_vote.account_id"), accountID).
+		Order("poll_vote.choice ASC").
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return votes, nil
+}
+
+func (p *pollDB) GetPollVoterIDs(ctx context.Context, pollID string) ([]string, db.Error) {
+	accountIDs := []string{}
+
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+		ColumnExpr("DISTINCT ?", bun.Ident("poll_vote.account_id")).
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return accountIDs, nil
+}
+
+func (p *pollDB) CountPollVotes(ctx context.Context, pollID string, options int) ([]int, int, db.Error) {
+	counts := []pollChoiceCount{}
+
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+		Column("poll_vote.choice").
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+		Group("poll_vote.choice").
+		Scan(ctx, &counts); err != nil {
+		return nil, 0, p.conn.ProcessError(err)
+	}
+
+	votes := make([]int, options)
+	for _, c := range counts {
+		if c.Choice >= 0 && c.Choice < options {
+			votes[c.Choice] = c.Count
+		}
+	}
+
+	var voters int
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+		ColumnExpr("COUNT(DISTINCT ?)", bun.Ident("poll_vote.account_id")).
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+		Scan(ctx, &voters); err != nil {
+		return nil, 0, p.conn.ProcessError(err)
+	}
+
+	return votes, voters, nil
+}
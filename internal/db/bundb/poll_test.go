@@ -0,0 +1,162 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type PollTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// putPollStatus puts a new status from local_account_1 in the
+// database, with a poll attached which expires at the given time.
+func (suite *PollTestSuite) putPollStatus(expiresAt time.Time, multiple bool) *gtsmodel.Status {
+	account := suite.testAccounts["local_account_1"]
+
+	statusID, err := id.NewULID()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	pollID, err := id.NewULID()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	local := true
+	hideCounts := false
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 account.URI + "/statuses/" + statusID,
+		URL:                 account.URL + "/statuses/" + statusID,
+		Content:             "tea or coffee?",
+		Local:               &local,
+		AccountID:           account.ID,
+		AccountURI:          account.URI,
+		Visibility:          gtsmodel.VisibilityPublic,
+		ActivityStreamsType: "Question",
+		Poll: &gtsmodel.Poll{
+			ID:         pollID,
+			Options:    []string{"tea", "coffee", "neither"},
+			Votes:      []int{0, 0, 0},
+			Multiple:   &multiple,
+			HideCounts: &hideCounts,
+			ExpiresAt:  expiresAt,
+		},
+	}
+
+	if err := suite.db.PutStatus(context.Background(), status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return status
+}
+
+func (suite *PollTestSuite) newVote(poll *gtsmodel.Poll, account *gtsmodel.Account, choice int) *gtsmodel.PollVote {
+	voteID, err := id.NewULID()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return &gtsmodel.PollVote{
+		ID:        voteID,
+		URI:       account.URI + "#votes/" + voteID,
+		PollID:    poll.ID,
+		AccountID: account.ID,
+		Choice:    choice,
+	}
+}
+
+func (suite *PollTestSuite) TestGetPoll() {
+	status := suite.putPollStatus(time.Now().Add(time.Hour), false)
+	suite.Equal(status.Poll.ID, status.PollID)
+
+	dbStatus, err := suite.db.GetStatusByID(context.Background(), status.ID)
+	suite.NoError(err)
+	suite.Equal(status.PollID, dbStatus.PollID)
+
+	poll, err := suite.db.GetPollByStatusID(context.Background(), status.ID)
+	suite.NoError(err)
+	suite.Equal(status.PollID, poll.ID)
+	suite.Equal([]string{"tea", "coffee", "neither"}, poll.Options)
+	suite.Equal([]int{0, 0, 0}, poll.Votes)
+	suite.False(*poll.Multiple)
+	suite.False(poll.Expired(time.Now()))
+}
+
+func (suite *PollTestSuite) TestCountPollVotes() {
+	status := suite.putPollStatus(time.Now().Add(time.Hour), true)
+	poll := status.Poll
+
+	votes := []*gtsmodel.PollVote{
+		suite.newVote(poll, suite.testAccounts["local_account_2"], 0),
+		suite.newVote(poll, suite.testAccounts["local_account_2"], 2),
+		suite.newVote(poll, suite.testAccounts["admin_account"], 0),
+	}
+	suite.NoError(suite.db.PutPollVotes(context.Background(), votes))
+
+	counts, voters, err := suite.db.CountPollVotes(context.Background(), poll.ID, len(poll.Options))
+	suite.NoError(err)
+	suite.Equal([]int{2, 0, 1}, counts)
+	suite.Equal(2, voters)
+
+	ownVotes, err := suite.db.GetPollVotesBy(context.Background(), poll.ID, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.Len(ownVotes, 2)
+	suite.Equal(0, ownVotes[0].Choice)
+	suite.Equal(2, ownVotes[1].Choice)
+
+	voterIDs, err := suite.db.GetPollVoterIDs(context.Background(), poll.ID)
+	suite.NoError(err)
+	suite.ElementsMatch([]string{suite.testAccounts["local_account_2"].ID, suite.testAccounts["admin_account"].ID}, voterIDs)
+
+	// the same vote can't be cast twice
+	again := suite.newVote(poll, suite.testAccounts["admin_account"], 0)
+	suite.ErrorIs(suite.db.PutPollVotes(context.Background(), []*gtsmodel.PollVote{again}), db.ErrAlreadyExists)
+}
+
+func (suite *PollTestSuite) TestGetExpiredPolls() {
+	ended := suite.putPollStatus(time.Now().Add(-time.Minute), false)
+	suite.putPollStatus(time.Now().Add(time.Hour), false)
+
+	polls, err := suite.db.GetExpiredPolls(context.Background(), time.Now(), 10)
+	suite.NoError(err)
+	suite.Len(polls, 1)
+	suite.Equal(ended.PollID, polls[0].ID)
+
+	// once it's closed it shouldn't come back
+	polls[0].ClosedAt = time.Now()
+	suite.NoError(suite.db.UpdatePoll(context.Background(), polls[0], "closed_at"))
+
+	polls, err = suite.db.GetExpiredPolls(context.Background(), time.Now(), 10)
+	suite.NoError(err)
+	suite.Empty(polls)
+}
+
+func TestPollTestSuite(t *testing.T) {
+	suite.Run(t, new(PollTestSuite))
+}
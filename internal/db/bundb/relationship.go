@@ -22,6 +22,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -98,7 +99,7 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	if err := r.conn.
 		NewSelect().
 		Model(follow).
-		Column("follow.show_reblogs", "follow.notify").
+		Column("follow.show_reblogs", "follow.show_replies", "follow.notify").
 		Where("? = ?", bun.Ident("follow.account_id"), requestingAccount).
 		Where("? = ?", bun.Ident("follow.target_account_id"), targetAccount).
 		Limit(1).
@@ -109,11 +110,13 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 		// no follow exists so these are all false
 		rel.Following = false
 		rel.ShowingReblogs = false
+		rel.ShowingReplies = false
 		rel.Notifying = false
 	} else {
 		// follow exists so we can fill these fields out...
 		rel.Following = true
 		rel.ShowingReblogs = *follow.ShowReblogs
+		rel.ShowingReplies = *follow.ShowReplies
 		rel.Notifying = *follow.Notify
 	}
 
@@ -169,6 +172,22 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	}
 	rel.BlockedBy = blockedBy
 
+	// check if the requesting account is blocking the target account's domain
+	domainBlockingQ := r.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_domain_blocks"), bun.Ident("account_domain_block")).
+		Column("account_domain_block.id").
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.domain"), bun.Ident("account_domain_block.domain")).
+		Where("? = ?", bun.Ident("account_domain_block.account_id"), requestingAccount).
+		Where("? = ?", bun.Ident("account.id"), targetAccount)
+	domainBlocking, err := r.conn.Exists(ctx, domainBlockingQ)
+	if err != nil {
+		return nil, fmt.Errorf("GetRelationship: error checking domainBlocking: %s", err)
+	}
+	rel.DomainBlocking = domainBlocking
+
 	return rel, nil
 }
 
@@ -187,6 +206,22 @@ func (r *relationshipDB) IsFollowing(ctx context.Context, sourceAccount *gtsmode
 	return r.conn.Exists(ctx, q)
 }
 
+func (r *relationshipDB) GetFollow(ctx context.Context, sourceAccountID string, targetAccountID string) (*gtsmodel.Follow, db.Error) {
+	follow := &gtsmodel.Follow{}
+
+	if err := r.conn.
+		NewSelect().
+		Model(follow).
+		Where("? = ?", bun.Ident("follow.account_id"), sourceAccountID).
+		Where("? = ?", bun.Ident("follow.target_account_id"), targetAccountID).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, r.conn.ProcessError(err)
+	}
+
+	return follow, nil
+}
+
 func (r *relationshipDB) IsFollowRequested(ctx context.Context, sourceAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (bool, db.Error) {
 	if sourceAccount == nil || targetAccount == nil {
 		return false, nil
@@ -237,12 +272,16 @@ func (r *relationshipDB) AcceptFollowRequest(ctx context.Context, originAccountI
 			return err
 		}
 
-		// create a new follow to 'replace' the request with
+		// create a new follow to 'replace' the request with,
+		// keeping the options the request was made with
 		follow = &gtsmodel.Follow{
 			ID:              followRequest.ID,
 			AccountID:       originAccountID,
 			TargetAccountID: targetAccountID,
+			ShowReblogs:     followRequest.ShowReblogs,
+			ShowReplies:     followRequest.ShowReplies,
 			URI:             followRequest.URI,
+			Notify:          followRequest.Notify,
 		}
 
 		// if the follow already exists, just update the URI -- we don't need to do anything else
@@ -318,6 +357,25 @@ func (r *relationshipDB) GetAccountFollowRequests(ctx context.Context, accountID
 	return followRequests, nil
 }
 
+func (r *relationshipDB) GetExpiredFollowRequests(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.FollowRequest, db.Error) {
+	followRequests := []*gtsmodel.FollowRequest{}
+
+	q := r.newFollowQ(&followRequests).
+		Where("? IS NULL", bun.Ident("target_account.domain")).
+		Where("? < ?", bun.Ident("follow_request.updated_at"), olderThan).
+		Order("follow_request.updated_at ASC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, r.conn.ProcessError(err)
+	}
+
+	return followRequests, nil
+}
+
 func (r *relationshipDB) GetAccountFollows(ctx context.Context, accountID string) ([]*gtsmodel.Follow, db.Error) {
 	follows := []*gtsmodel.Follow{}
 
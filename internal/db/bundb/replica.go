@@ -0,0 +1,186 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// replicaCheckInterval is how often the
+// replication lag of replicas is checked.
+const replicaCheckInterval = 5 * time.Second
+
+// primaryLSNQuery returns the current write-ahead log position of the postgres primary.
+const primaryLSNQuery = `SELECT pg_current_wal_lsn()::text`
+
+// replicaLagQuery returns the number of seconds a postgres replica is behind its primary,
+// given a write-ahead log position that the primary has reached. If the replica has replayed
+// up to that position, it's considered to be caught up, since otherwise a quiet primary would
+// make the replica look like it's lagging behind. If it hasn't, it's behind by however long
+// it's been since it last replayed a transaction, which keeps growing if it's stopped
+// receiving changes from the primary altogether.
+const replicaLagQuery = `SELECT CASE
+	WHEN pg_last_wal_replay_lsn() >= ?::pg_lsn THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())::float8, 'Infinity'::float8)
+END`
+
+// replica is a read-only copy of the primary database.
+type replica struct {
+	*bun.DB
+	address string      // address of the replica, for logging
+	usable  atomic.Bool // whether the replica is reachable and not lagging too far behind
+}
+
+// pgReplicaConns opens connections to the postgres read replicas
+// given in config. Replicas which can't be reached are still
+// returned, they just won't be used until they come up.
+func pgReplicaConns() ([]*replica, error) {
+	dsns := config.GetDbReplicaAddresses()
+	replicas := make([]*replica, 0, len(dsns))
+
+	for _, dsn := range dsns {
+		cfg, err := pgx.ParseConfig(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse replica address: %s", err)
+		}
+
+		sqldb := stdlib.OpenDB(*cfg)
+		tweakConnectionValues(sqldb)
+
+		replicas = append(replicas, &replica{
+			DB:      bun.NewDB(sqldb, pgdialect.New()),
+			address: fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		})
+	}
+
+	return replicas, nil
+}
+
+// checkReplicas marks each replica usable or not, depending on
+// whether it responds, and how far behind the primary it is.
+func (conn *DBConn) checkReplicas(ctx context.Context) {
+	maxLag := float64(config.GetDbReplicaMaxLagSeconds())
+
+	var primaryLSN string
+	if err := conn.DB.NewRaw(primaryLSNQuery).Scan(ctx, &primaryLSN); err != nil {
+		log.Warnf("checkReplicas: error getting position of the primary, leaving replicas as they were: %s", err)
+		return
+	}
+
+	// Replicas are checked against where the primary was at the
+	// last check, so that one isn't counted as behind just for not
+	// having received a write made a moment ago; this means usable
+	// replicas can be up to replicaCheckInterval behind the primary.
+	caughtUpLSN := conn.primaryLSN
+	if caughtUpLSN == "" {
+		caughtUpLSN = primaryLSN
+	}
+	conn.primaryLSN = primaryLSN
+
+	for _, r := range conn.replicas {
+		var lag float64
+		err := r.NewRaw(replicaLagQuery, caughtUpLSN).Scan(ctx, &lag)
+
+		var usable bool
+		switch {
+		case err != nil:
+			log.Warnf("checkReplicas: error checking replica %s, reads will go to the primary: %s", r.address, err)
+		case lag > maxLag:
+			log.Warnf("checkReplicas: replica %s is %.1fs behind the primary, reads will go to the primary", r.address, lag)
+		default:
+			usable = true
+		}
+
+		if r.usable.Swap(usable) != usable && usable {
+			log.Infof("checkReplicas: replica %s is caught up, sending reads to it", r.address)
+		}
+	}
+}
+
+// watchReplicas checks replicas every replicaCheckInterval until the given context is cancelled.
+func (conn *DBConn) watchReplicas(ctx context.Context) {
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			conn.checkReplicas(ctx)
+		}
+	}
+}
+
+// reader returns a connection to read from: the next usable
+// replica in turn if there is one, otherwise the primary.
+func (conn *DBConn) reader() *bun.DB {
+	n := len(conn.replicas)
+	if n == 0 {
+		return conn.DB
+	}
+
+	start := int(conn.nextReplica.Add(1))
+	for i := 0; i < n; i++ {
+		r := conn.replicas[(start+i)%n]
+		if r.usable.Load() {
+			return r.DB
+		}
+	}
+
+	return conn.DB
+}
+
+// readRouter routes the queries made through it to a replica from reader,
+// unless the context they're made with has been used to write to the
+// database, in which case they go to the primary so they see that write.
+type readRouter struct {
+	conn *DBConn
+}
+
+func (r readRouter) route(ctx context.Context) bun.IConn {
+	if db.Written(ctx) {
+		return r.conn.DB.DB
+	}
+	return r.conn.reader().DB
+}
+
+func (r readRouter) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return r.route(ctx).QueryContext(ctx, query, args...)
+}
+
+func (r readRouter) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return r.route(ctx).ExecContext(ctx, query, args...)
+}
+
+func (r readRouter) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return r.route(ctx).QueryRowContext(ctx, query, args...)
+}
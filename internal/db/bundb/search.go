@@ -0,0 +1,205 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+type searchDB struct {
+	conn   *DBConn
+	status *statusDB
+}
+
+func (s *searchDB) SearchStatuses(ctx context.Context, accountID string, query string, fromAccountID string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, db.Error) {
+	statusIDs := []string{}
+
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		WhereGroup(" AND ", whereNotDeleted).
+		WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id")).
+		Order("status.id DESC")
+
+	switch s.conn.Dialect().Name() {
+	case dialect.SQLite:
+		terms := ftsTerms(query)
+		if terms == "" {
+			return nil, db.ErrNoEntries
+		}
+		q = q.Where("? IN (SELECT ? FROM ? WHERE ? MATCH ?)",
+			bun.Ident("status.rowid"), bun.Ident("rowid"), bun.Ident("status_fts"), bun.Ident("status_fts"), terms)
+	case dialect.PG:
+		q = q.Where("? @@ plainto_tsquery('simple', ?)", bun.Ident("status.search_vector"), query)
+	default:
+		log.Panic("db dialect was neither pg nor sqlite")
+	}
+
+	// only search statuses the account was involved in
+	q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			WhereOr("? = ?", bun.Ident("status.account_id"), accountID).
+			WhereOr("? IN (SELECT ? FROM ? WHERE ? = ?)",
+				bun.Ident("status.id"), bun.Ident("status_id"), bun.Ident("mentions"), bun.Ident("target_account_id"), accountID)
+	})
+
+	if fromAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("status.account_id"), fromAccountID)
+	}
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return s.status.GetStatusesByIDs(ctx, statusIDs)
+}
+
+// ftsTerms converts a user provided search query into an sqlite FTS5 query
+// matching all of the words in it, quoting each word so that characters
+// which have special meaning in FTS5 query syntax are treated literally.
+func ftsTerms(query string) string {
+	words := strings.Fields(query)
+	for i, word := range words {
+		words[i] = `"` + strings.ReplaceAll(word, `"`, `""`) + `"`
+	}
+	return strings.Join(words, " ")
+}
+
+// createStatusSearchIndex creates the full-text search index of statuses, and the triggers
+// which keep it up to date as statuses are inserted, updated and deleted, indexing any
+// statuses which already exist. It's safe to call if the index already exists.
+//
+// On sqlite the index is an FTS5 table using the statuses table as external content;
+// on postgres it's a tsvector column on the statuses table with a GIN index.
+func createStatusSearchIndex(ctx context.Context, conn bun.IDB) error {
+	return conn.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		switch tx.Dialect().Name() {
+		case dialect.SQLite:
+			exists, err := tx.
+				NewSelect().
+				Table("sqlite_master").
+				Where("? = ?", bun.Ident("type"), "table").
+				Where("? = ?", bun.Ident("name"), "status_fts").
+				Exists(ctx)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				if _, err := tx.ExecContext(ctx, sqliteStatusFTSCreate); err != nil {
+					return err
+				}
+				if _, err := tx.ExecContext(ctx, sqliteStatusFTSRebuild); err != nil {
+					return err
+				}
+			}
+
+			for _, trigger := range sqliteStatusFTSTriggers {
+				if _, err := tx.ExecContext(ctx, trigger); err != nil {
+					return err
+				}
+			}
+		case dialect.PG:
+			for _, statement := range pgStatusSearchStatements {
+				if _, err := tx.ExecContext(ctx, statement); err != nil {
+					return err
+				}
+			}
+		default:
+			log.Panic("db dialect was neither pg nor sqlite")
+		}
+		return nil
+	})
+}
+
+// dropStatusSearchIndex drops anything created by createStatusSearchIndex
+// that wouldn't be dropped along with the statuses table itself.
+func dropStatusSearchIndex(ctx context.Context, conn bun.IDB) error {
+	var err error
+	switch conn.Dialect().Name() {
+	case dialect.SQLite:
+		_, err = conn.ExecContext(ctx, "DROP TABLE IF EXISTS status_fts")
+	case dialect.PG:
+		_, err = conn.ExecContext(ctx, "DROP FUNCTION IF EXISTS statuses_search_vector_update")
+	default:
+		log.Panic("db dialect was neither pg nor sqlite")
+	}
+	return err
+}
+
+const (
+	sqliteStatusFTSCreate  = `CREATE VIRTUAL TABLE status_fts USING fts5(content_warning, text, content, content='statuses', content_rowid='rowid')`
+	sqliteStatusFTSRebuild = `INSERT INTO status_fts(status_fts) VALUES('rebuild')`
+)
+
+var sqliteStatusFTSTriggers = []string{
+	`CREATE TRIGGER IF NOT EXISTS statuses_fts_insert AFTER INSERT ON statuses BEGIN
+		INSERT INTO status_fts(rowid, content_warning, text, content) VALUES (new.rowid, new.content_warning, new.text, new.content);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS statuses_fts_delete AFTER DELETE ON statuses BEGIN
+		INSERT INTO status_fts(status_fts, rowid, content_warning, text, content) VALUES ('delete', old.rowid, old.content_warning, old.text, old.content);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS statuses_fts_update AFTER UPDATE OF content_warning, text, content ON statuses BEGIN
+		INSERT INTO status_fts(status_fts, rowid, content_warning, text, content) VALUES ('delete', old.rowid, old.content_warning, old.text, old.content);
+		INSERT INTO status_fts(rowid, content_warning, text, content) VALUES (new.rowid, new.content_warning, new.text, new.content);
+	END`,
+}
+
+var pgStatusSearchStatements = []string{
+	`ALTER TABLE statuses ADD COLUMN IF NOT EXISTS search_vector tsvector`,
+	`CREATE OR REPLACE FUNCTION statuses_search_vector_update() RETURNS trigger AS $$
+	BEGIN
+		NEW.search_vector := to_tsvector('simple', COALESCE(NEW.content_warning, '') || ' ' || COALESCE(NULLIF(NEW.text, ''), NEW.content, ''));
+		RETURN NEW;
+	END
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS statuses_search_vector_update ON statuses`,
+	`CREATE TRIGGER statuses_search_vector_update BEFORE INSERT OR UPDATE OF content_warning, text, content ON statuses
+		FOR EACH ROW EXECUTE PROCEDURE statuses_search_vector_update()`,
+	`CREATE INDEX IF NOT EXISTS statuses_search_vector_idx ON statuses USING GIN (search_vector)`,
+	`UPDATE statuses SET search_vector = to_tsvector('simple', COALESCE(content_warning, '') || ' ' || COALESCE(NULLIF(text, ''), content, '')) WHERE search_vector IS NULL`,
+}
@@ -0,0 +1,119 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type SearchTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func statusIDs(statuses []*gtsmodel.Status) []string {
+	ids := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func (suite *SearchTestSuite) TestSearchStatuses() {
+	statuses, err := suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, "hi", "", "", "", 0, 0)
+	suite.NoError(err)
+
+	// own statuses and statuses mentioning the account, newest first
+	suite.Equal([]string{
+		suite.testStatuses["local_account_2_status_6"].ID,
+		suite.testStatuses["admin_account_status_3"].ID,
+		suite.testStatuses["local_account_1_status_5"].ID,
+		suite.testStatuses["local_account_2_status_5"].ID,
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesPaging() {
+	statuses, err := suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, "hi", "", "", "", 2, 1)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["admin_account_status_3"].ID,
+		suite.testStatuses["local_account_1_status_5"].ID,
+	}, statusIDs(statuses))
+
+	statuses, err = suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, "hi", "", suite.testStatuses["local_account_1_status_5"].ID, "", 0, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["local_account_2_status_5"].ID,
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesFromAccount() {
+	statuses, err := suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, "hi", suite.testAccounts["local_account_2"].ID, "", "", 0, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["local_account_2_status_6"].ID,
+		suite.testStatuses["local_account_2_status_5"].ID,
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesAllWords() {
+	statuses, err := suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, "DIRECT message", "", "", "", 0, 0)
+	suite.NoError(err)
+	suite.Equal([]string{suite.testStatuses["local_account_2_status_6"].ID}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesContentWarning() {
+	statuses, err := suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, "contact", "", "", "", 0, 0)
+	suite.NoError(err)
+	suite.Equal([]string{suite.testStatuses["local_account_1_status_4"].ID}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesNotInvolved() {
+	// local_account_2 posted about turtles, but local_account_1 wasn't mentioned
+	statuses, err := suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, "turtle", "", "", "", 0, 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesQuerySyntax() {
+	// characters with special meaning to the search index are taken literally
+	statuses, err := suite.db.SearchStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, `"hi"`, "", "", "", 0, 0)
+	suite.NoError(err)
+	suite.Len(statuses, 4)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesAfterDelete() {
+	ctx := context.Background()
+
+	err := suite.db.DeleteStatusByID(ctx, suite.testStatuses["local_account_1_status_5"].ID)
+	suite.NoError(err)
+
+	statuses, err := suite.db.SearchStatuses(ctx, suite.testAccounts["local_account_1"].ID, "hi", "", "", "", 0, 0)
+	suite.NoError(err)
+	suite.NotContains(statusIDs(statuses), suite.testStatuses["local_account_1_status_5"].ID)
+	suite.Len(statuses, 3)
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
@@ -0,0 +1,301 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	sqliteBusyMinBackoff = 5 * time.Millisecond // sqliteBusyMinBackoff is the wait before the first retry of a busy query
+	sqliteBusyMaxBackoff = time.Second          // sqliteBusyMaxBackoff is the longest wait between retries of a busy query
+)
+
+// sqliteConnector opens connections to an sqlite database, which retry
+// queries that fail because the database is busy or locked by another
+// connection, with jittered backoff, until the given timeout has passed.
+//
+// This stops brief lock contention, eg., during bursts of federation
+// traffic on slow disks, from surfacing as errors to callers.
+//
+// Only statements outside of a transaction, and the beginning and commit
+// of a transaction, are retried. A statement inside a transaction can't be
+// retried in place: sqlite returns busy without waiting exactly when the
+// transaction holds a lock that whoever it's waiting on needs, so retrying
+// would only spin until the timeout. To avoid that, the database address
+// should set _txlock=immediate, so that transactions take the write lock
+// when they begin, where waiting for it is safe.
+type sqliteConnector struct {
+	driver  *sqlite.Driver
+	address string
+	timeout time.Duration
+}
+
+// newSQLiteConnector returns a connector for the sqlite database at
+// address, which retries busy queries until timeout has passed.
+// If timeout is 0, busy queries aren't retried.
+func newSQLiteConnector(address string, timeout time.Duration) *sqliteConnector {
+	return &sqliteConnector{
+		driver:  &sqlite.Driver{},
+		address: address,
+		timeout: timeout,
+	}
+}
+
+// Connect implements driver.Connector.
+func (c *sqliteConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	var conn driver.Conn
+	if err := retryOnBusy(ctx, c.timeout, func() (err error) {
+		conn, err = c.driver.Open(c.address)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return &sqliteRetryConn{
+		sqliteDriverConn: conn.(sqliteDriverConn),
+		timeout:          c.timeout,
+	}, nil
+}
+
+// Driver implements driver.Connector.
+func (c *sqliteConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// sqliteDriverConn is the set of interfaces implemented by connections of the sqlite driver.
+type sqliteDriverConn interface {
+	driver.Conn
+	driver.Pinger
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+}
+
+// sqliteRetryConn wraps an sqlite driver connection to retry busy queries.
+type sqliteRetryConn struct {
+	sqliteDriverConn
+	timeout time.Duration
+	inTx    bool // whether a transaction is open on this connection
+}
+
+// retry calls fn, retrying it while the database is busy,
+// unless a transaction is open on this connection.
+func (c *sqliteRetryConn) retry(ctx context.Context, fn func() error) error {
+	if c.inTx {
+		return fn()
+	}
+	return retryOnBusy(ctx, c.timeout, fn)
+}
+
+func (c *sqliteRetryConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *sqliteRetryConn) BeginTx(ctx context.Context, opts driver.TxOptions) (tx driver.Tx, err error) {
+	err = c.retry(ctx, func() (err error) {
+		tx, err = c.sqliteDriverConn.BeginTx(ctx, opts)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.inTx = true
+	return &sqliteRetryTx{Tx: tx, conn: c}, nil
+}
+
+func (c *sqliteRetryConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *sqliteRetryConn) PrepareContext(ctx context.Context, query string) (stmt driver.Stmt, err error) {
+	err = c.retry(ctx, func() (err error) {
+		stmt, err = c.sqliteDriverConn.PrepareContext(ctx, query)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteRetryStmt{sqliteDriverStmt: stmt.(sqliteDriverStmt), conn: c}, nil
+}
+
+func (c *sqliteRetryConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (res driver.Result, err error) {
+	err = c.retry(ctx, func() (err error) {
+		res, err = c.sqliteDriverConn.ExecContext(ctx, query, args)
+		return
+	})
+	return
+}
+
+func (c *sqliteRetryConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (rows driver.Rows, err error) {
+	err = c.retry(ctx, func() (err error) {
+		rows, err = c.sqliteDriverConn.QueryContext(ctx, query, args)
+		return
+	})
+	return
+}
+
+// sqliteRetryTx wraps an sqlite driver transaction to retry busy commits.
+// A commit that fails with SQLITE_BUSY leaves the transaction open,
+// so it's safe to try it again.
+type sqliteRetryTx struct {
+	driver.Tx
+	conn *sqliteRetryConn
+}
+
+func (tx *sqliteRetryTx) Commit() error {
+	defer func() { tx.conn.inTx = false }()
+
+	err := retryOnBusy(context.Background(), tx.conn.timeout, tx.Tx.Commit)
+	if err != nil {
+		// the transaction is still open if the commit
+		// failed, so roll it back to free the connection
+		_ = tx.Tx.Rollback()
+	}
+	return err
+}
+
+func (tx *sqliteRetryTx) Rollback() error {
+	defer func() { tx.conn.inTx = false }()
+	return tx.Tx.Rollback()
+}
+
+// sqliteDriverStmt is the set of interfaces implemented by statements of the sqlite driver.
+type sqliteDriverStmt interface {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+}
+
+// sqliteRetryStmt wraps an sqlite driver prepared statement to retry busy queries.
+type sqliteRetryStmt struct {
+	sqliteDriverStmt
+	conn *sqliteRetryConn
+}
+
+func (s *sqliteRetryStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), toNamedValues(args))
+}
+
+func (s *sqliteRetryStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), toNamedValues(args))
+}
+
+func (s *sqliteRetryStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (res driver.Result, err error) {
+	err = s.conn.retry(ctx, func() (err error) {
+		res, err = s.sqliteDriverStmt.ExecContext(ctx, args)
+		return
+	})
+	return
+}
+
+func (s *sqliteRetryStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (rows driver.Rows, err error) {
+	err = s.conn.retry(ctx, func() (err error) {
+		rows, err = s.sqliteDriverStmt.QueryContext(ctx, args)
+		return
+	})
+	return
+}
+
+// toNamedValues converts positional driver values to named values.
+func toNamedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return named
+}
+
+// retryOnBusy calls fn, and keeps calling it again with jittered, exponential
+// backoff for as long as it fails because the database is busy or locked, until
+// timeout has passed or ctx is done. The last error from fn is returned.
+func retryOnBusy(ctx context.Context, timeout time.Duration, fn func() error) error {
+	err := fn()
+	if timeout <= 0 || !isBusyErr(err) {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	backoff := sqliteBusyMinBackoff
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			log.Warnf("sqlite database still busy after retrying for %s: %s", timeout, err)
+			return err
+		}
+
+		// Wait for somewhere between half and all of the backoff, so
+		// that queries which hit the same lock don't all retry at once.
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)) //nolint:gosec
+		if wait > remaining {
+			wait = remaining
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		if err = fn(); !isBusyErr(err) {
+			return err
+		}
+
+		if backoff *= 2; backoff > sqliteBusyMaxBackoff {
+			backoff = sqliteBusyMaxBackoff
+		}
+	}
+}
+
+// isBusyErr returns whether err is an sqlite error caused by the database
+// being busy, or a table in it being locked, which may pass if retried.
+func isBusyErr(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+
+	code := sqliteErr.Code()
+	if code == sqlite3.SQLITE_BUSY_SNAPSHOT {
+		// The transaction's snapshot of the database is
+		// out of date, so it can never succeed; only
+		// starting the transaction again will help.
+		return false
+	}
+
+	// Mask off any other extended result codes.
+	switch code & 0xff {
+	case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,160 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SQLiteBusyTestSuite struct {
+	suite.Suite
+	address string
+}
+
+func (suite *SQLiteBusyTestSuite) SetupTest() {
+	suite.address = "file:" + filepath.Join(suite.T().TempDir(), "busy.db")
+}
+
+// lockDB opens a connection to the test database and starts a write
+// transaction on it, which is committed after the given delay.
+func (suite *SQLiteBusyTestSuite) lockDB(commitAfter time.Duration) *sql.DB {
+	sqldb := sql.OpenDB(newSQLiteConnector(suite.address, 0))
+	suite.T().Cleanup(func() { sqldb.Close() })
+
+	if _, err := sqldb.Exec("CREATE TABLE IF NOT EXISTS things (id INTEGER)"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tx, err := sqldb.Begin()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := tx.Exec("INSERT INTO things VALUES (1)"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	commitDone := make(chan struct{})
+	go func() {
+		defer close(commitDone)
+		time.Sleep(commitAfter)
+		suite.NoError(tx.Commit())
+	}()
+	suite.T().Cleanup(func() { <-commitDone })
+
+	return sqldb
+}
+
+func (suite *SQLiteBusyTestSuite) TestNoRetry() {
+	suite.lockDB(100 * time.Millisecond)
+
+	sqldb := sql.OpenDB(newSQLiteConnector(suite.address, 0))
+	defer sqldb.Close()
+
+	_, err := sqldb.Exec("INSERT INTO things VALUES (2)")
+	suite.True(isBusyErr(err))
+}
+
+func (suite *SQLiteBusyTestSuite) TestRetryUntilUnlocked() {
+	suite.lockDB(100 * time.Millisecond)
+
+	sqldb := sql.OpenDB(newSQLiteConnector(suite.address, 5*time.Second))
+	defer sqldb.Close()
+
+	_, err := sqldb.Exec("INSERT INTO things VALUES (2)")
+	suite.NoError(err)
+
+	var count int
+	suite.NoError(sqldb.QueryRow("SELECT COUNT(*) FROM things").Scan(&count))
+	suite.Equal(2, count)
+}
+
+func (suite *SQLiteBusyTestSuite) TestRetryTimeout() {
+	suite.lockDB(time.Second)
+
+	sqldb := sql.OpenDB(newSQLiteConnector(suite.address, 100*time.Millisecond))
+	defer sqldb.Close()
+
+	start := time.Now()
+	_, err := sqldb.Exec("INSERT INTO things VALUES (2)")
+	suite.True(isBusyErr(err))
+	suite.Less(time.Since(start), time.Second)
+}
+
+func (suite *SQLiteBusyTestSuite) TestRetryContextCanceled() {
+	suite.lockDB(time.Second)
+
+	sqldb := sql.OpenDB(newSQLiteConnector(suite.address, 5*time.Second))
+	defer sqldb.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := sqldb.ExecContext(ctx, "INSERT INTO things VALUES (2)")
+	suite.Error(err)
+	suite.Less(time.Since(start), time.Second)
+}
+
+func (suite *SQLiteBusyTestSuite) TestRetryBeginImmediate() {
+	suite.lockDB(100 * time.Millisecond)
+
+	sqldb := sql.OpenDB(newSQLiteConnector(suite.address+"?_txlock=immediate", 5*time.Second))
+	defer sqldb.Close()
+
+	// beginning should wait for the write lock
+	tx, err := sqldb.Begin()
+	suite.NoError(err)
+
+	_, err = tx.Exec("INSERT INTO things VALUES (2)")
+	suite.NoError(err)
+	suite.NoError(tx.Commit())
+
+	var count int
+	suite.NoError(sqldb.QueryRow("SELECT COUNT(*) FROM things").Scan(&count))
+	suite.Equal(2, count)
+}
+
+func (suite *SQLiteBusyTestSuite) TestNoRetryInTx() {
+	suite.lockDB(time.Second)
+
+	sqldb := sql.OpenDB(newSQLiteConnector(suite.address, 5*time.Second))
+	defer sqldb.Close()
+
+	// a deferred transaction takes no lock when it begins...
+	tx, err := sqldb.Begin()
+	suite.NoError(err)
+	defer tx.Rollback() //nolint:errcheck
+
+	// ...so its first write finds the database busy, which
+	// should be returned straight away rather than retried
+	start := time.Now()
+	_, err = tx.Exec("INSERT INTO things VALUES (2)")
+	suite.True(isBusyErr(err))
+	suite.Less(time.Since(start), time.Second)
+}
+
+func TestSQLiteBusyTestSuite(t *testing.T) {
+	suite.Run(t, &SQLiteBusyTestSuite{})
+}
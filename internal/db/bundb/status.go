@@ -28,10 +28,28 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
 )
 
+// statusLinkTables are the tables with a status_id
+// column whose rows are purged along with their status.
+var statusLinkTables = []string{
+	"status_to_emojis",
+	"status_to_tags",
+	"status_faves",
+	"status_bookmarks",
+	"status_mutes",
+	"mentions",
+	"notifications",
+	"status_view_counts",
+	"status_deliveries",
+	"polls",
+	"filter_statuses",
+}
+
 type statusDB struct {
 	conn  *DBConn
 	cache *cache.StatusCache
@@ -136,63 +154,81 @@ func (s *statusDB) getStatus(ctx context.Context, cacheGet func() (*gtsmodel.Sta
 	return status, nil
 }
 
-func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) db.Error {
-	err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
-		// create links between this status and any emojis it uses
-		for _, i := range status.EmojiIDs {
-			if _, err := tx.
-				NewInsert().
-				Model(&gtsmodel.StatusToEmoji{
-					StatusID: status.ID,
-					EmojiID:  i,
-				}).Exec(ctx); err != nil {
-				err = s.conn.errProc(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
-				}
+func (s *statusDB) GetStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Status, db.Error) {
+	statuses, err := loadByIDs(
+		"GetStatusesByIDs",
+		ids,
+		func(status *gtsmodel.Status) string { return status.ID },
+		s.cache.GetByID,
+		func(missing []string) ([]*gtsmodel.Status, error) {
+			dbStatuses := []*gtsmodel.Status{}
+			if err := s.newStatusQ(&dbStatuses).Where("? IN (?)", bun.Ident("status.id"), bun.In(missing)).Scan(ctx); err != nil {
+				return nil, err
 			}
-		}
 
-		// create links between this status and any tags it uses
-		for _, i := range status.TagIDs {
-			if _, err := tx.
-				NewInsert().
-				Model(&gtsmodel.StatusToTag{
-					StatusID: status.ID,
-					TagID:    i,
-				}).Exec(ctx); err != nil {
-				err = s.conn.errProc(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
+			// Fetch all the boosted statuses in one go too
+			boostOfIDs := []string{}
+			for _, status := range dbStatuses {
+				if status.BoostOfID != "" {
+					boostOfIDs = append(boostOfIDs, status.BoostOfID)
 				}
 			}
-		}
 
-		// change the status ID of the media attachments to the new status
-		for _, a := range status.Attachments {
-			a.StatusID = status.ID
-			a.UpdatedAt = time.Now()
-			if _, err := tx.
-				NewUpdate().
-				Model(a).
-				Where("? = ?", bun.Ident("media_attachment.id"), a.ID).
-				Exec(ctx); err != nil {
-				err = s.conn.errProc(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
+			if len(boostOfIDs) != 0 {
+				boostOfs, err := s.GetStatusesByIDs(ctx, boostOfIDs)
+				if err == nil {
+					boostOfMap := make(map[string]*gtsmodel.Status, len(boostOfs))
+					for _, boostOf := range boostOfs {
+						boostOfMap[boostOf.ID] = boostOf
+					}
+					for _, status := range dbStatuses {
+						status.BoostOf = boostOfMap[status.BoostOfID]
+					}
 				}
 			}
-		}
 
-		// Finally, insert the status
-		if _, err := tx.
-			NewInsert().
-			Model(status).
-			Exec(ctx); err != nil {
-			return err
+			return dbStatuses, nil
+		},
+		s.cache.Put,
+	)
+	if err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	// Set the status author accounts, fetching them in one go
+	accountIDs := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		accountIDs = append(accountIDs, status.AccountID)
+	}
+
+	authors, err := s.accounts.GetAccountsByIDs(ctx, accountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	authorMap := make(map[string]*gtsmodel.Account, len(authors))
+	for _, author := range authors {
+		authorMap[author.ID] = author
+	}
+
+	// Return the prepared statuses
+	prepared := make([]*gtsmodel.Status, 0, len(statuses))
+	for _, status := range statuses {
+		author, ok := authorMap[status.AccountID]
+		if !ok {
+			log.Errorf("GetStatusesByIDs: author %q of status %q not found", status.AccountID, status.ID)
+			continue
 		}
+		status.Account = author
+		prepared = append(prepared, status)
+	}
 
-		return nil
+	return prepared, nil
+}
+
+func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) db.Error {
+	err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		return s.putStatus(ctx, tx, status)
 	})
 	if err != nil {
 		return s.conn.ProcessError(err)
@@ -202,37 +238,168 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) db.Er
 	return nil
 }
 
-func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.Status, db.Error) {
+func (s *statusDB) PutStatuses(ctx context.Context, statuses []*gtsmodel.Status) db.Error {
 	err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
-		// create links between this status and any emojis it uses
-		for _, i := range status.EmojiIDs {
-			if _, err := tx.
-				NewInsert().
-				Model(&gtsmodel.StatusToEmoji{
-					StatusID: status.ID,
-					EmojiID:  i,
-				}).Exec(ctx); err != nil {
-				err = s.conn.errProc(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
-				}
+		for _, status := range statuses {
+			if err := s.putStatusMentionsTags(ctx, tx, status); err != nil {
+				return err
+			}
+			if err := s.putStatus(ctx, tx, status); err != nil {
+				return err
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return s.conn.ProcessError(err)
+	}
 
-		// create links between this status and any tags it uses
-		for _, i := range status.TagIDs {
-			if _, err := tx.
-				NewInsert().
-				Model(&gtsmodel.StatusToTag{
-					StatusID: status.ID,
-					TagID:    i,
-				}).Exec(ctx); err != nil {
-				err = s.conn.errProc(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
-				}
+	for _, status := range statuses {
+		s.cache.Put(status)
+	}
+	return nil
+}
+
+// putStatus inserts the given status, along with its emoji + tag links, using the given transaction,
+// and updates any attachments of the status to point to it. Any deleted status with the same uri
+// that hasn't been purged yet is purged first.
+func (s *statusDB) putStatus(ctx context.Context, tx bun.Tx, status *gtsmodel.Status) error {
+	// a deleted status keeps its uri until it's purged, so if this is
+	// the same status being fetched or created again, purge it right away
+	deletedIDs := []string{}
+	if err := tx.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.uri"), status.URI).
+		Where("? IS NOT NULL", bun.Ident("status.deleted_at")).
+		Scan(ctx, &deletedIDs); err != nil {
+		return err
+	}
+	if len(deletedIDs) != 0 {
+		if err := s.purgeStatuses(ctx, tx, deletedIDs); err != nil {
+			return err
+		}
+	}
+
+	// create links between this status and any emojis + tags it uses
+	if err := s.putStatusLinks(ctx, tx, status); err != nil {
+		return err
+	}
+
+	// change the status ID of the media attachments to the new status
+	for _, a := range status.Attachments {
+		a.StatusID = status.ID
+		a.UpdatedAt = time.Now()
+		if _, err := tx.
+			NewUpdate().
+			Model(a).
+			Where("? = ?", bun.Ident("media_attachment.id"), a.ID).
+			Exec(ctx); err != nil {
+			err = s.conn.errProc(err)
+			if !errors.Is(err, db.ErrAlreadyExists) {
+				return err
 			}
 		}
+	}
+
+	// insert the poll attached to the status, if it has one
+	if status.Poll != nil {
+		status.Poll.StatusID = status.ID
+		status.PollID = status.Poll.ID
+		if _, err := tx.
+			NewInsert().
+			Model(status.Poll).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Finally, insert the status
+	if _, err := tx.
+		NewInsert().
+		Model(status).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// putStatusMentionsTags inserts the mentions of the given status, and any of its tags that
+// aren't in the database yet, using the given transaction.
+func (s *statusDB) putStatusMentionsTags(ctx context.Context, tx bun.Tx, status *gtsmodel.Status) error {
+	if len(status.Mentions) != 0 {
+		if _, err := tx.
+			NewInsert().
+			Model(&status.Mentions).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(status.Tags) != 0 {
+		if _, err := tx.
+			NewInsert().
+			Model(&status.Tags).
+			On("CONFLICT DO NOTHING").
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// putStatusLinks inserts the links between the given status and the emojis + tags it uses, using
+// the given transaction. Each kind of link is inserted in a single statement, rather than one per
+// row, since remote statuses can use dozens of custom emojis. Links that already exist are skipped.
+func (s *statusDB) putStatusLinks(ctx context.Context, tx bun.Tx, status *gtsmodel.Status) error {
+	if emojiIDs := util.UniqueStrings(status.EmojiIDs); len(emojiIDs) != 0 {
+		links := make([]*gtsmodel.StatusToEmoji, 0, len(emojiIDs))
+		for _, id := range emojiIDs {
+			links = append(links, &gtsmodel.StatusToEmoji{
+				StatusID: status.ID,
+				EmojiID:  id,
+			})
+		}
+
+		if _, err := tx.
+			NewInsert().
+			Model(&links).
+			On("CONFLICT (?, ?) DO NOTHING", bun.Ident("status_id"), bun.Ident("emoji_id")).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if tagIDs := util.UniqueStrings(status.TagIDs); len(tagIDs) != 0 {
+		links := make([]*gtsmodel.StatusToTag, 0, len(tagIDs))
+		for _, id := range tagIDs {
+			links = append(links, &gtsmodel.StatusToTag{
+				StatusID: status.ID,
+				TagID:    id,
+			})
+		}
+
+		if _, err := tx.
+			NewInsert().
+			Model(&links).
+			On("CONFLICT (?, ?) DO NOTHING", bun.Ident("status_id"), bun.Ident("tag_id")).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.Status, db.Error) {
+	err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// create links between this status and any emojis + tags it uses
+		if err := s.putStatusLinks(ctx, tx, status); err != nil {
+			return err
+		}
 
 		// change the status ID of the media attachments to this status
 		for _, a := range status.Attachments {
@@ -263,46 +430,105 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status) (*
 	}
 
 	s.cache.Put(status)
+	s.conn.bus.Publish(ctx, cacheStatuses, status.ID)
 	return status, nil
 }
 
 func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) db.Error {
-	err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
-		// delete links between this status and any emojis it uses
-		if _, err := tx.
-			NewDelete().
-			TableExpr("? AS ?", bun.Ident("status_to_emojis"), bun.Ident("status_to_emoji")).
-			Where("? = ?", bun.Ident("status_to_emoji.status_id"), id).
-			Exec(ctx); err != nil {
-			return err
+	// only mark the status as deleted here; the status and
+	// its links are removed later by PurgeDeletedStatuses
+	if _, err := s.conn.
+		NewUpdate().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Set("? = ?", bun.Ident("deleted_at"), time.Now()).
+		Where("? = ?", bun.Ident("status.id"), id).
+		Where("? IS NULL", bun.Ident("status.deleted_at")).
+		Exec(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	s.cache.Invalidate(id)
+	s.conn.bus.Publish(ctx, cacheStatuses, id)
+	return nil
+}
+
+func (s *statusDB) PurgeDeletedStatuses(ctx context.Context, batchSize int) (int, db.Error) {
+	total := 0
+
+	for {
+		statusIDs := []string{}
+		if err := s.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			Column("status.id").
+			Where("? IS NOT NULL", bun.Ident("status.deleted_at")).
+			Limit(batchSize).
+			Scan(ctx, &statusIDs); err != nil {
+			return total, s.conn.ProcessError(err)
 		}
 
-		// delete links between this status and any tags it uses
-		if _, err := tx.
-			NewDelete().
-			TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
-			Where("? = ?", bun.Ident("status_to_tag.status_id"), id).
-			Exec(ctx); err != nil {
-			return err
+		if len(statusIDs) == 0 {
+			return total, nil
+		}
+
+		if err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
+			return s.purgeStatuses(ctx, tx, statusIDs)
+		}); err != nil {
+			return total, s.conn.ProcessError(err)
 		}
 
-		// delete the status itself
+		total += len(statusIDs)
+		if len(statusIDs) < batchSize {
+			return total, nil
+		}
+	}
+}
+
+// purgeStatuses removes the statuses with the given IDs from the database,
+// along with any rows that link to them, using the given transaction.
+func (s *statusDB) purgeStatuses(ctx context.Context, tx bun.Tx, statusIDs []string) error {
+	// votes point at the polls of these statuses rather
+	// than the statuses themselves, so go via the polls
+	if _, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+		Where("? IN (?)", bun.Ident("poll_vote.poll_id"), tx.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("polls"), bun.Ident("poll")).
+			Column("poll.id").
+			Where("? IN (?)", bun.Ident("poll.status_id"), bun.In(statusIDs))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// delete everything else that points at these statuses first
+	for _, table := range statusLinkTables {
 		if _, err := tx.
 			NewDelete().
-			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
-			Where("? = ?", bun.Ident("status.id"), id).
+			TableExpr("?", bun.Ident(table)).
+			Where("? IN (?)", bun.Ident("status_id"), bun.In(statusIDs)).
 			Exec(ctx); err != nil {
 			return err
 		}
+	}
 
-		return nil
-	})
-	if err != nil {
-		return s.conn.ProcessError(err)
+	// leave any attachments for the media pruning jobs to clean up
+	if _, err := tx.
+		NewUpdate().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+		Set("? = NULL", bun.Ident("status_id")).
+		Where("? IN (?)", bun.Ident("media_attachment.status_id"), bun.In(statusIDs)).
+		Exec(ctx); err != nil {
+		return err
 	}
 
-	s.cache.Invalidate(id)
-	return nil
+	// then the statuses themselves
+	_, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? IN (?)", bun.Ident("status.id"), bun.In(statusIDs)).
+		Exec(ctx)
+	return err
 }
 
 func (s *statusDB) GetStatusParents(ctx context.Context, status *gtsmodel.Status, onlyDirect bool) ([]*gtsmodel.Status, db.Error) {
@@ -356,6 +582,7 @@ func (s *statusDB) statusChildren(ctx context.Context, status *gtsmodel.Status,
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		Column("status.id").
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.in_reply_to_id"), status.ID)
 	if minID != "" {
 		q = q.Where("? > ?", bun.Ident("status.id"), minID)
@@ -368,13 +595,13 @@ func (s *statusDB) statusChildren(ctx context.Context, status *gtsmodel.Status,
 		return
 	}
 
-	for _, id := range childIDs {
-		// Fetch child with ID from database
-		child, err := s.GetStatusByID(ctx, id)
-		if err != nil {
-			log.Errorf("statusChildren: error getting child status %q: %v", id, err)
-			continue
-		}
+	children, err := s.GetStatusesByIDs(ctx, childIDs)
+	if err != nil {
+		log.Errorf("statusChildren: error getting child statuses of %q: %v", status.ID, err)
+		return
+	}
+
+	for _, child := range children {
 
 	insertLoop:
 		for e := foundStatuses.Front(); e != nil; e = e.Next() {
@@ -401,6 +628,7 @@ func (s *statusDB) CountStatusReplies(ctx context.Context, status *gtsmodel.Stat
 	return s.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.in_reply_to_id"), status.ID).
 		Count(ctx)
 }
@@ -409,6 +637,7 @@ func (s *statusDB) CountStatusReblogs(ctx context.Context, status *gtsmodel.Stat
 	return s.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.boost_of_id"), status.ID).
 		Count(ctx)
 }
@@ -435,6 +664,7 @@ func (s *statusDB) IsStatusRebloggedBy(ctx context.Context, status *gtsmodel.Sta
 	q := s.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.boost_of_id"), status.ID).
 		Where("? = ?", bun.Ident("status.account_id"), accountID)
 
@@ -451,6 +681,60 @@ func (s *statusDB) IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status,
 	return s.conn.Exists(ctx, q)
 }
 
+func (s *statusDB) GetStatusThreadIDs(ctx context.Context, status *gtsmodel.Status) ([]string, db.Error) {
+	ids := []string{status.ID}
+	seen := map[string]bool{status.ID: true}
+
+	// walk up the thread using only the reply columns,
+	// since we don't need to load the full parent statuses
+	for parentID := status.InReplyToID; parentID != "" && !seen[parentID]; {
+		parent := &gtsmodel.Status{}
+		if err := s.conn.
+			NewSelect().
+			Model(parent).
+			Column("status.id", "status.in_reply_to_id").
+			Where("? = ?", bun.Ident("status.id"), parentID).
+			Scan(ctx); err != nil {
+			err = s.conn.ProcessError(err)
+			if err == db.ErrNoEntries {
+				// we don't have the parent, so
+				// this is as far up as we can go
+				break
+			}
+			return nil, err
+		}
+
+		ids = append(ids, parent.ID)
+		seen[parent.ID] = true
+		parentID = parent.InReplyToID
+	}
+
+	return ids, nil
+}
+
+func (s *statusDB) IsThreadMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, db.Error) {
+	threadIDs, err := s.GetStatusThreadIDs(ctx, status)
+	if err != nil {
+		return false, err
+	}
+
+	return s.IsThreadIDsMutedBy(ctx, threadIDs, accountID)
+}
+
+func (s *statusDB) IsThreadIDsMutedBy(ctx context.Context, threadIDs []string, accountID string) (bool, db.Error) {
+	if len(threadIDs) == 0 {
+		return false, nil
+	}
+
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_mutes"), bun.Ident("status_mute")).
+		Where("? IN (?)", bun.Ident("status_mute.status_id"), bun.In(threadIDs)).
+		Where("? = ?", bun.Ident("status_mute.account_id"), accountID)
+
+	return s.conn.Exists(ctx, q)
+}
+
 func (s *statusDB) IsStatusBookmarkedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, db.Error) {
 	q := s.conn.
 		NewSelect().
@@ -486,3 +770,95 @@ func (s *statusDB) GetStatusReblogs(ctx context.Context, status *gtsmodel.Status
 	}
 	return reblogs, nil
 }
+
+func (s *statusDB) GetStatusViewCount(ctx context.Context, statusID string) (*gtsmodel.StatusViewCount, db.Error) {
+	viewCount := &gtsmodel.StatusViewCount{}
+
+	if err := s.conn.
+		NewSelect().
+		Model(viewCount).
+		Where("? = ?", bun.Ident("status_view_count.status_id"), statusID).
+		Scan(ctx); err != nil {
+		err = s.conn.ProcessError(err)
+		if err != db.ErrNoEntries {
+			return nil, err
+		}
+
+		// never viewed
+		return &gtsmodel.StatusViewCount{StatusID: statusID}, nil
+	}
+
+	return viewCount, nil
+}
+
+func (s *statusDB) IncrementStatusViewCount(ctx context.Context, statusID string, fetch bool) db.Error {
+	viewCountID, err := id.NewULID()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	viewCount := &gtsmodel.StatusViewCount{
+		ID:        viewCountID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		StatusID:  statusID,
+	}
+
+	column := "web_views"
+	if fetch {
+		column = "fetches"
+		viewCount.Fetches = 1
+	} else {
+		viewCount.WebViews = 1
+	}
+
+	// if the status has been viewed before, just count one more view
+	if _, err := s.conn.
+		NewInsert().
+		Model(viewCount).
+		On("CONFLICT (?) DO UPDATE", bun.Ident("status_id")).
+		Set("? = ? + 1", bun.Ident(column), bun.Ident("status_view_count."+column)).
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Exec(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (s *statusDB) GetStatusDeliveries(ctx context.Context, statusID string) ([]*gtsmodel.StatusDelivery, db.Error) {
+	deliveries := []*gtsmodel.StatusDelivery{}
+
+	if err := s.conn.
+		NewSelect().
+		Model(&deliveries).
+		Where("? = ?", bun.Ident("status_delivery.status_id"), statusID).
+		Order("status_delivery.domain ASC").
+		Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	return deliveries, nil
+}
+
+func (s *statusDB) PutStatusDeliveries(ctx context.Context, deliveries []*gtsmodel.StatusDelivery) db.Error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	// if the status was delivered to a domain before, keep only the latest outcome
+	if _, err := s.conn.
+		NewInsert().
+		Model(&deliveries).
+		On("CONFLICT (?, ?) DO UPDATE", bun.Ident("status_id"), bun.Ident("domain")).
+		Set("? = EXCLUDED.?", bun.Ident("updated_at"), bun.Ident("updated_at")).
+		Set("? = EXCLUDED.?", bun.Ident("delivered"), bun.Ident("delivered")).
+		Set("? = EXCLUDED.?", bun.Ident("failed"), bun.Ident("failed")).
+		Set("? = EXCLUDED.?", bun.Ident("error"), bun.Ident("error")).
+		Exec(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	return nil
+}
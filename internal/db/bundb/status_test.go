@@ -26,6 +26,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
 )
 
 type StatusTestSuite struct {
@@ -50,6 +53,34 @@ func (suite *StatusTestSuite) TestGetStatusByID() {
 	suite.True(*status.Likeable)
 }
 
+func (suite *StatusTestSuite) TestGetStatusesByIDs() {
+	ids := []string{
+		suite.testStatuses["admin_account_status_4"].ID,
+		"01GKHQ4SF7KQ0C0X2BXD5ZMYFS", // doesn't exist
+		suite.testStatuses["local_account_1_status_1"].ID,
+		suite.testStatuses["local_account_2_status_1"].ID,
+	}
+
+	statuses, err := suite.db.GetStatusesByIDs(context.Background(), ids)
+	suite.NoError(err)
+	if !suite.Len(statuses, 3) {
+		suite.FailNow("")
+	}
+
+	// returned in the order the ids were given, with their authors
+	suite.Equal(ids[0], statuses[0].ID)
+	suite.Equal(ids[2], statuses[1].ID)
+	suite.Equal(ids[3], statuses[2].ID)
+	for _, status := range statuses {
+		suite.NotNil(status.Account)
+		suite.Equal(status.AccountID, status.Account.ID)
+	}
+
+	// the boosted status is loaded too
+	suite.NotNil(statuses[0].BoostOf)
+	suite.Equal(suite.testStatuses["admin_account_status_4"].BoostOfID, statuses[0].BoostOf.ID)
+}
+
 func (suite *StatusTestSuite) TestGetStatusByURI() {
 	status, err := suite.db.GetStatusByURI(context.Background(), suite.testStatuses["local_account_2_status_3"].URI)
 	if err != nil {
@@ -133,6 +164,40 @@ func (suite *StatusTestSuite) TestGetStatusChildren() {
 	}
 }
 
+func (suite *StatusTestSuite) TestGetStatusThreadIDs() {
+	targetStatus := suite.testStatuses["admin_account_status_3"]
+	threadIDs, err := suite.db.GetStatusThreadIDs(context.Background(), targetStatus)
+	suite.NoError(err)
+	suite.Equal([]string{targetStatus.ID, suite.testStatuses["local_account_1_status_1"].ID}, threadIDs)
+}
+
+func (suite *StatusTestSuite) TestIsThreadMutedBy() {
+	rootStatus := suite.testStatuses["local_account_1_status_1"]
+	replyStatus := suite.testStatuses["admin_account_status_3"]
+	otherStatus := suite.testStatuses["local_account_1_status_2"]
+	mutingAccount := suite.testAccounts["local_account_2"]
+
+	err := suite.db.Put(context.Background(), &gtsmodel.StatusMute{
+		ID:              "01GHXJ7V8SBJBDMS3N3CXK1ZPB",
+		AccountID:       mutingAccount.ID,
+		TargetAccountID: rootStatus.AccountID,
+		StatusID:        rootStatus.ID,
+	})
+	suite.NoError(err)
+
+	muted, err := suite.db.IsThreadMutedBy(context.Background(), replyStatus, mutingAccount.ID)
+	suite.NoError(err)
+	suite.True(muted)
+
+	muted, err = suite.db.IsThreadMutedBy(context.Background(), otherStatus, mutingAccount.ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	muted, err = suite.db.IsThreadMutedBy(context.Background(), replyStatus, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.False(muted)
+}
+
 func (suite *StatusTestSuite) TestDeleteStatus() {
 	targetStatus := suite.testStatuses["admin_account_status_1"]
 	err := suite.db.DeleteStatusByID(context.Background(), targetStatus.ID)
@@ -142,6 +207,256 @@ func (suite *StatusTestSuite) TestDeleteStatus() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *StatusTestSuite) TestPurgeDeletedStatuses() {
+	ctx := context.Background()
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	// nothing to purge yet
+	purged, err := suite.db.PurgeDeletedStatuses(ctx, 1)
+	suite.NoError(err)
+	suite.Zero(purged)
+
+	err = suite.db.DeleteStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+
+	// the status is hidden, but still there until it's purged
+	dbService, ok := suite.db.(*bundb.DBService)
+	if !ok {
+		panic("db was not *bundb.DBService")
+	}
+
+	deleted, err := dbService.GetConn().
+		NewSelect().
+		Model(&gtsmodel.Status{}).
+		Where("? = ?", bun.Ident("status.id"), targetStatus.ID).
+		WhereDeleted().
+		Count(ctx)
+	suite.NoError(err)
+	suite.Equal(1, deleted)
+
+	err = suite.db.DeleteStatusByID(ctx, suite.testStatuses["local_account_1_status_1"].ID)
+	suite.NoError(err)
+
+	// purge with a batch size smaller than the
+	// number of deleted statuses to check paging
+	purged, err = suite.db.PurgeDeletedStatuses(ctx, 1)
+	suite.NoError(err)
+	suite.Equal(2, purged)
+
+	deleted, err = dbService.GetConn().
+		NewSelect().
+		Model(&gtsmodel.Status{}).
+		Where("? = ?", bun.Ident("status.id"), targetStatus.ID).
+		WhereAllWithDeleted().
+		Count(ctx)
+	suite.NoError(err)
+	suite.Zero(deleted)
+
+	// links to the status are gone too
+	links, err := dbService.GetConn().
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Where("? = ?", bun.Ident("status_to_tag.status_id"), targetStatus.ID).
+		Count(ctx)
+	suite.NoError(err)
+	suite.Zero(links)
+
+	// attachments are left for the media pruning jobs
+	attachment, err := suite.db.GetAttachmentByID(ctx, suite.testAttachments["admin_account_status_1_attachment_1"].ID)
+	suite.NoError(err)
+	suite.Empty(attachment.StatusID)
+}
+
+func (suite *StatusTestSuite) TestDeleteAccountMarksStatusesDeleted() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	err := suite.db.DeleteAccount(ctx, testAccount.ID)
+	suite.NoError(err)
+
+	_, err = suite.db.GetStatusByID(ctx, suite.testStatuses["local_account_1_status_1"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// boosts of the account's statuses by others should be gone too
+	_, err = suite.db.GetStatusByID(ctx, suite.testStatuses["admin_account_status_4"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// but the account itself is only marked until it's purged
+	account, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	suite.NoError(err)
+	suite.False(account.DeletedAt.IsZero())
+
+	count, err := suite.db.CountAccountStatuses(ctx, testAccount.ID)
+	suite.NoError(err)
+	suite.Zero(count)
+
+	purged, err := suite.db.PurgeDeletedStatuses(ctx, 100)
+	suite.NoError(err)
+	suite.NotZero(purged)
+}
+
+func (suite *StatusTestSuite) TestDeletedStatusHidden() {
+	ctx := context.Background()
+	viewingAccount := suite.testAccounts["local_account_1"]
+	postingAccount := suite.testAccounts["local_account_2"]
+	list := suite.testLists["local_account_1_list_1"]
+
+	// a public status that isn't a reply shows on every timeline,
+	// and a reply mentioning the viewing account shows in its search
+	timelineStatus := suite.testStatuses["local_account_2_status_1"]
+	searchStatus := suite.testStatuses["local_account_2_status_5"]
+
+	timelines := func() map[string][]string {
+		home, err := suite.db.GetHomeTimeline(ctx, viewingAccount.ID, "", "", "", 0, false)
+		suite.NoError(err)
+		public, err := suite.db.GetPublicTimeline(ctx, "", "", "", 0, false)
+		suite.NoError(err)
+		listed, err := suite.db.GetListTimeline(ctx, list.ID, "", "", "", 0)
+		suite.NoError(err)
+		account, err := suite.db.GetAccountStatuses(ctx, postingAccount.ID, 0, false, false, "", "", false, false, false)
+		suite.NoError(err)
+		return map[string][]string{
+			"home":    statusIDs(home),
+			"public":  statusIDs(public),
+			"list":    statusIDs(listed),
+			"account": statusIDs(account),
+		}
+	}
+
+	for name, ids := range timelines() {
+		suite.Contains(ids, timelineStatus.ID, name)
+	}
+	found, err := suite.db.SearchStatuses(ctx, viewingAccount.ID, "hi", "", "", "", 0, 0)
+	suite.NoError(err)
+	suite.Contains(statusIDs(found), searchStatus.ID)
+
+	suite.NoError(suite.db.DeleteStatusByID(ctx, timelineStatus.ID))
+	suite.NoError(suite.db.DeleteStatusByID(ctx, searchStatus.ID))
+
+	for name, ids := range timelines() {
+		suite.NotContains(ids, timelineStatus.ID, name)
+		suite.NotContains(ids, searchStatus.ID, name)
+	}
+	found, err = suite.db.SearchStatuses(ctx, viewingAccount.ID, "hi", "", "", "", 0, 0)
+	suite.NoError(err)
+	suite.NotContains(statusIDs(found), searchStatus.ID)
+}
+
+func (suite *StatusTestSuite) TestPutStatusWithDeletedURI() {
+	ctx := context.Background()
+	deletedStatus := suite.testStatuses["local_account_1_status_1"]
+
+	suite.NoError(suite.db.DeleteStatusByID(ctx, deletedStatus.ID))
+
+	// the same status fetched again before the deleted one is purged
+	status := &gtsmodel.Status{}
+	*status = *deletedStatus
+	status.ID = "01GKZ5A4YB6RDF4Y7XQZ3Q3W2K"
+	status.AttachmentIDs = nil
+	status.Attachments = nil
+	status.TagIDs = nil
+	status.Tags = nil
+	status.EmojiIDs = nil
+	status.Emojis = nil
+	status.MentionIDs = nil
+	status.Mentions = nil
+	suite.NoError(suite.db.PutStatus(ctx, status))
+
+	got, err := suite.db.GetStatusByURI(ctx, deletedStatus.URI)
+	suite.NoError(err)
+	suite.Equal(status.ID, got.ID)
+
+	// the deleted status was purged to make way for it
+	dbService, ok := suite.db.(*bundb.DBService)
+	if !ok {
+		panic("db was not *bundb.DBService")
+	}
+
+	deleted, err := dbService.GetConn().
+		NewSelect().
+		Model(&gtsmodel.Status{}).
+		Where("? = ?", bun.Ident("status.id"), deletedStatus.ID).
+		WhereAllWithDeleted().
+		Count(ctx)
+	suite.NoError(err)
+	suite.Zero(deleted)
+}
+
+func (suite *StatusTestSuite) TestStatusViewCount() {
+	ctx := context.Background()
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// never viewed
+	viewCount, err := suite.db.GetStatusViewCount(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Zero(viewCount.WebViews)
+	suite.Zero(viewCount.Fetches)
+
+	for i := 0; i < 3; i++ {
+		err = suite.db.IncrementStatusViewCount(ctx, targetStatus.ID, false)
+		suite.NoError(err)
+	}
+	err = suite.db.IncrementStatusViewCount(ctx, targetStatus.ID, true)
+	suite.NoError(err)
+
+	viewCount, err = suite.db.GetStatusViewCount(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Equal(3, viewCount.WebViews)
+	suite.Equal(1, viewCount.Fetches)
+
+	// counts go when the status is purged
+	err = suite.db.DeleteStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+	_, err = suite.db.PurgeDeletedStatuses(ctx, 10)
+	suite.NoError(err)
+
+	viewCount, err = suite.db.GetStatusViewCount(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Zero(viewCount.WebViews)
+	suite.Zero(viewCount.Fetches)
+}
+
+func (suite *StatusTestSuite) TestUpdateStatusLinks() {
+	ctx := context.Background()
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	// the status already links to rainbow + welcome;
+	// existing and repeated links should be skipped
+	targetStatus.EmojiIDs = []string{
+		suite.testEmojis["rainbow"].ID,
+		suite.testEmojis["yell"].ID,
+		suite.testEmojis["yell"].ID,
+	}
+	targetStatus.TagIDs = []string{
+		suite.testTags["welcome"].ID,
+		suite.testTags["Hashtag"].ID,
+	}
+
+	_, err := suite.db.UpdateStatus(ctx, targetStatus)
+	suite.NoError(err)
+
+	dbService, ok := suite.db.(*bundb.DBService)
+	if !ok {
+		panic("db was not *bundb.DBService")
+	}
+
+	emojiLinks, err := dbService.GetConn().
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_emojis"), bun.Ident("status_to_emoji")).
+		Where("? = ?", bun.Ident("status_to_emoji.status_id"), targetStatus.ID).
+		Count(ctx)
+	suite.NoError(err)
+	suite.Equal(2, emojiLinks)
+
+	tagLinks, err := dbService.GetConn().
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Where("? = ?", bun.Ident("status_to_tag.status_id"), targetStatus.ID).
+		Count(ctx)
+	suite.NoError(err)
+	suite.Equal(2, tagLinks)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
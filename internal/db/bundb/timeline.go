@@ -25,7 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/uptrace/bun"
 	"golang.org/x/exp/slices"
 )
@@ -49,6 +49,7 @@ func (t *timelineDB) GetHomeTimeline(ctx context.Context, accountID string, maxI
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		// Select only IDs from table
 		Column("status.id").
+		WhereGroup(" AND ", whereNotDeleted).
 		// Find out who accountID follows.
 		Join("LEFT JOIN ? AS ? ON ? = ? AND ? = ?",
 			bun.Ident("follows"),
@@ -107,21 +108,52 @@ func (t *timelineDB) GetHomeTimeline(ctx context.Context, accountID string, maxI
 		return nil, t.conn.ProcessError(err)
 	}
 
-	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+	return t.status.GetStatusesByIDs(ctx, statusIDs)
+}
+
+func (t *timelineDB) GetListTimeline(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Status, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	statusIDs := make([]string, 0, limit)
 
-	for _, id := range statusIDs {
-		// Fetch status from db for ID
-		status, err := t.status.GetStatusByID(ctx, id)
+	// Select the accounts followed through entries of the list
+	listAccountIDs := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		Column("follow.target_account_id").
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("follows"), bun.Ident("follow"), bun.Ident("follow.id"), bun.Ident("list_entry.follow_id")).
+		Where("? = ?", bun.Ident("list_entry.list_id"), listID)
+
+	q := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		WhereGroup(" AND ", whereNotDeleted).
+		Where("? IN (?)", bun.Ident("status.account_id"), listAccountIDs)
+
+	if maxID == "" {
+		var err error
+		// don't return statuses more than five minutes in the future
+		maxID, err = id.NewULIDFromTime(time.Now().Add(5 * time.Minute))
 		if err != nil {
-			log.Errorf("GetHomeTimeline: error fetching status %q: %v", id, err)
-			continue
+			return nil, err
 		}
+	}
+
+	page := paging.Page{Max: maxID, Since: sinceID, Min: minID, Limit: limit}
+	q = page.Apply(q, "status.id", paging.OrderDescending)
 
-		// Append status to slice
-		statuses = append(statuses, status)
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, t.conn.ProcessError(err)
 	}
 
-	return statuses, nil
+	paging.Reorder(page, statusIDs)
+
+	return t.status.GetStatusesByIDs(ctx, statusIDs)
 }
 
 func (t *timelineDB) GetPublicTimeline(ctx context.Context, maxID string, sinceID string, minID string, limit int, local bool) ([]*gtsmodel.Status, db.Error) {
@@ -137,6 +169,7 @@ func (t *timelineDB) GetPublicTimeline(ctx context.Context, maxID string, sinceI
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		Column("status.id").
+		WhereGroup(" AND ", whereNotDeleted).
 		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
 		WhereGroup(" AND ", whereEmptyOrNull("status.in_reply_to_id")).
 		WhereGroup(" AND ", whereEmptyOrNull("status.in_reply_to_uri")).
@@ -175,21 +208,7 @@ func (t *timelineDB) GetPublicTimeline(ctx context.Context, maxID string, sinceI
 		return nil, t.conn.ProcessError(err)
 	}
 
-	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
-
-	for _, id := range statusIDs {
-		// Fetch status from db for ID
-		status, err := t.status.GetStatusByID(ctx, id)
-		if err != nil {
-			log.Errorf("GetPublicTimeline: error fetching status %q: %v", id, err)
-			continue
-		}
-
-		// Append status to slice
-		statuses = append(statuses, status)
-	}
-
-	return statuses, nil
+	return t.status.GetStatusesByIDs(ctx, statusIDs)
 }
 
 // TODO optimize this query and the logic here, because it's slow as balls -- it takes like a literal second to return with a limit of 20!
@@ -235,21 +254,73 @@ func (t *timelineDB) GetFavedTimeline(ctx context.Context, accountID string, max
 		return a.ID < b.ID
 	})
 
-	statuses := make([]*gtsmodel.Status, 0, len(faves))
-
+	// Fetch the statuses of the favourites in one go
+	statusIDs := make([]string, 0, len(faves))
 	for _, fave := range faves {
-		// Fetch status from db for corresponding favourite
-		status, err := t.status.GetStatusByID(ctx, fave.StatusID)
-		if err != nil {
-			log.Errorf("GetFavedTimeline: error fetching status for fave %q: %v", fave.ID, err)
-			continue
-		}
+		statusIDs = append(statusIDs, fave.StatusID)
+	}
 
-		// Append status to slice
-		statuses = append(statuses, status)
+	statuses, err := t.status.GetStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, "", "", err
 	}
 
 	nextMaxID := faves[len(faves)-1].ID
 	prevMinID := faves[0].ID
 	return statuses, nextMaxID, prevMinID, nil
 }
+
+func (t *timelineDB) GetBookmarkedTimeline(ctx context.Context, accountID string, folderID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, string, string, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	bookmarks := make([]*gtsmodel.StatusBookmark, 0, limit)
+
+	bq := t.conn.
+		NewSelect().
+		Model(&bookmarks).
+		Where("? = ?", bun.Ident("status_bookmark.account_id"), accountID).
+		Order("status_bookmark.id DESC")
+
+	if folderID != "" {
+		bq = bq.Where("? = ?", bun.Ident("status_bookmark.folder_id"), folderID)
+	}
+
+	if maxID != "" {
+		bq = bq.Where("? < ?", bun.Ident("status_bookmark.id"), maxID)
+	}
+
+	if minID != "" {
+		bq = bq.Where("? > ?", bun.Ident("status_bookmark.id"), minID)
+	}
+
+	if limit > 0 {
+		bq = bq.Limit(limit)
+	}
+
+	if err := bq.Scan(ctx); err != nil {
+		return nil, "", "", t.conn.ProcessError(err)
+	}
+
+	if len(bookmarks) == 0 {
+		return nil, "", "", db.ErrNoEntries
+	}
+
+	// Fetch the statuses of the bookmarks in one go, newest bookmark first
+	statusIDs := make([]string, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		statusIDs = append(statusIDs, bookmark.StatusID)
+	}
+
+	statuses, err := t.status.GetStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	nextMaxID := bookmarks[len(bookmarks)-1].ID
+	prevMinID := bookmarks[0].ID
+	return statuses, nextMaxID, prevMinID, nil
+}
@@ -20,10 +20,12 @@ package bundb
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/uptrace/bun"
 
 	"codeberg.org/gruf/go-cache/v3/result"
@@ -70,9 +72,21 @@ func (t *tombstoneDB) GetTombstoneByURI(ctx context.Context, uri string) (*gtsmo
 func (t *tombstoneDB) TombstoneExistsWithURI(ctx context.Context, uri string) (bool, db.Error) {
 	tomb, err := t.GetTombstoneByURI(ctx, uri)
 	if err == db.ErrNoEntries {
-		err = nil
+		return false, nil
+	} else if err != nil {
+		return false, err
 	}
-	return (tomb != nil), err
+
+	if tomb.Expired(time.Now()) {
+		// the uri may be back, so
+		// let it be looked up again
+		if err := t.DeleteTombstone(ctx, tomb.ID); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	return true, nil
 }
 
 func (t *tombstoneDB) PutTombstone(ctx context.Context, tombstone *gtsmodel.Tombstone) db.Error {
@@ -85,6 +99,53 @@ func (t *tombstoneDB) PutTombstone(ctx context.Context, tombstone *gtsmodel.Tomb
 	})
 }
 
+func (t *tombstoneDB) PutTombstoneForURI(ctx context.Context, uri *url.URL, expiresAt time.Time) db.Error {
+	tomb, err := t.GetTombstoneByURI(ctx, uri.String())
+	if err != nil && err != db.ErrNoEntries {
+		return err
+	}
+
+	if tomb != nil {
+		if tomb.ExpiresAt.IsZero() || (!expiresAt.IsZero() && !expiresAt.After(tomb.ExpiresAt)) {
+			// existing tombstone lasts
+			// at least as long already
+			return nil
+		}
+
+		tomb.ExpiresAt = expiresAt
+		tomb.UpdatedAt = time.Now()
+		if _, err := t.conn.
+			NewUpdate().
+			Model(tomb).
+			Column("expires_at", "updated_at").
+			Where("? = ?", bun.Ident("tombstone.id"), tomb.ID).
+			Exec(ctx); err != nil {
+			return t.conn.ProcessError(err)
+		}
+
+		t.cache.Invalidate("ID", tomb.ID)
+		return nil
+	}
+
+	tombstoneID, err := id.NewULID()
+	if err != nil {
+		return err
+	}
+
+	err = t.PutTombstone(ctx, &gtsmodel.Tombstone{
+		ID:        tombstoneID,
+		Domain:    uri.Host,
+		URI:       uri.String(),
+		ExpiresAt: expiresAt,
+	})
+	if err == db.ErrAlreadyExists {
+		// put concurrently
+		// by someone else
+		err = nil
+	}
+	return err
+}
+
 func (t *tombstoneDB) DeleteTombstone(ctx context.Context, id string) db.Error {
 	if _, err := t.conn.
 		NewDelete().
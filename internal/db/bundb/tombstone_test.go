@@ -0,0 +1,72 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TombstoneTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TombstoneTestSuite) TestTombstoneExpires() {
+	ctx := context.Background()
+	uri := testrig.URLMustParse("https://example.org/users/suspended")
+
+	err := suite.db.PutTombstoneForURI(ctx, uri, time.Now().Add(-time.Minute))
+	suite.NoError(err)
+
+	// the tombstone has expired, so it no longer applies
+	gone, err := suite.db.TombstoneExistsWithURI(ctx, uri.String())
+	suite.NoError(err)
+	suite.False(gone)
+}
+
+func (suite *TombstoneTestSuite) TestPutTombstoneForURIMakesPermanent() {
+	ctx := context.Background()
+	uri := testrig.URLMustParse("https://example.org/users/deleted")
+
+	// first the account responds 410, then it's deleted for good
+	err := suite.db.PutTombstoneForURI(ctx, uri, time.Now().Add(time.Hour))
+	suite.NoError(err)
+	err = suite.db.PutTombstoneForURI(ctx, uri, time.Time{})
+	suite.NoError(err)
+
+	tomb, err := suite.db.GetTombstoneByURI(ctx, uri.String())
+	suite.NoError(err)
+	suite.Zero(tomb.ExpiresAt)
+
+	// a later 410 mustn't make it expire again
+	err = suite.db.PutTombstoneForURI(ctx, uri, time.Now().Add(time.Hour))
+	suite.NoError(err)
+
+	tomb, err = suite.db.GetTombstoneByURI(ctx, uri.String())
+	suite.NoError(err)
+	suite.Zero(tomb.ExpiresAt)
+}
+
+func TestTombstoneTestSuite(t *testing.T) {
+	suite.Run(t, new(TombstoneTestSuite))
+}
@@ -20,6 +20,7 @@ package bundb
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
@@ -108,6 +109,18 @@ func (u *userDB) GetUserByConfirmationToken(ctx context.Context, confirmationTok
 	)
 }
 
+func (u *userDB) GetUserByPostByMailToken(ctx context.Context, postByMailToken string) (*gtsmodel.User, db.Error) {
+	return u.getUser(
+		ctx,
+		func() (*gtsmodel.User, bool) {
+			return u.cache.GetByPostByMailToken(postByMailToken)
+		},
+		func(user *gtsmodel.User) error {
+			return u.newUserQ(user).Where("? = ?", bun.Ident("user.post_by_mail_token"), postByMailToken).Scan(ctx)
+		},
+	)
+}
+
 func (u *userDB) PutUser(ctx context.Context, user *gtsmodel.User) (*gtsmodel.User, db.Error) {
 	if _, err := u.conn.
 		NewInsert().
@@ -134,9 +147,62 @@ func (u *userDB) UpdateUser(ctx context.Context, user *gtsmodel.User, columns ..
 	}
 
 	u.cache.Invalidate(user.ID)
+	u.conn.bus.Publish(ctx, cacheUsers, user.ID)
 	return user, nil
 }
 
+func (u *userDB) UseRecoveryCode(ctx context.Context, user *gtsmodel.User, hash string) db.Error {
+	remaining := make([]string, 0, len(user.RecoveryCodes))
+	for _, h := range user.RecoveryCodes {
+		if h != hash {
+			remaining = append(remaining, h)
+		}
+	}
+
+	// recovery codes are stored as json, so compare
+	// against the json encoding of the codes we fetched
+	oldCodes, err := json.Marshal(user.RecoveryCodes)
+	if err != nil {
+		return err
+	}
+
+	q := u.conn.
+		NewUpdate().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("user.id"), user.ID).
+		Where("? = ?", bun.Ident("user.recovery_codes"), string(oldCodes))
+
+	if len(remaining) == 0 {
+		q = q.Set("? = NULL", bun.Ident("recovery_codes"))
+	} else {
+		newCodes, err := json.Marshal(remaining)
+		if err != nil {
+			return err
+		}
+		q = q.Set("? = ?", bun.Ident("recovery_codes"), string(newCodes))
+	}
+
+	res, err := q.Exec(ctx)
+	if err != nil {
+		return u.conn.ProcessError(err)
+	}
+
+	u.cache.Invalidate(user.ID)
+	u.conn.bus.Publish(ctx, cacheUsers, user.ID)
+
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		// someone else changed the
+		// codes before we got here
+		return db.ErrNoEntries
+	}
+
+	user.RecoveryCodes = remaining
+	return nil
+}
+
 func (u *userDB) DeleteUserByID(ctx context.Context, userID string) db.Error {
 	if _, err := u.conn.
 		NewDelete().
@@ -147,5 +213,6 @@ func (u *userDB) DeleteUserByID(ctx context.Context, userID string) db.Error {
 	}
 
 	u.cache.Invalidate(userID)
+	u.conn.bus.Publish(ctx, cacheUsers, userID)
 	return nil
 }
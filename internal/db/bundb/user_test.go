@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -68,6 +69,39 @@ func (suite *UserTestSuite) TestUpdateUserSelectedColumns() {
 	suite.Equal(testUser.AccountID, dbUser.AccountID)
 }
 
+func (suite *UserTestSuite) TestUseRecoveryCode() {
+	ctx := context.Background()
+	testUser := suite.testUsers["local_account_1"]
+
+	user, err := suite.db.GetUserByID(ctx, testUser.ID)
+	suite.NoError(err)
+	user.RecoveryCodes = []string{"first", "second"}
+	_, err = suite.db.UpdateUser(ctx, user, "recovery_codes")
+	suite.NoError(err)
+
+	// two concurrent sign ins fetch the same user
+	user1, err := suite.db.GetUserByID(ctx, testUser.ID)
+	suite.NoError(err)
+	user2 := &gtsmodel.User{}
+	*user2 = *user1
+
+	suite.NoError(suite.db.UseRecoveryCode(ctx, user1, "first"))
+	suite.Equal([]string{"second"}, user1.RecoveryCodes)
+
+	// the second one must not be able to use the code again
+	suite.ErrorIs(suite.db.UseRecoveryCode(ctx, user2, "first"), db.ErrNoEntries)
+
+	dbUser, err := suite.db.GetUserByID(ctx, testUser.ID)
+	suite.NoError(err)
+	suite.Equal([]string{"second"}, dbUser.RecoveryCodes)
+
+	// using up the last code clears them
+	suite.NoError(suite.db.UseRecoveryCode(ctx, dbUser, "second"))
+	dbUser, err = suite.db.GetUserByID(ctx, testUser.ID)
+	suite.NoError(err)
+	suite.Empty(dbUser.RecoveryCodes)
+}
+
 func TestUserTestSuite(t *testing.T) {
 	suite.Run(t, new(UserTestSuite))
 }
@@ -19,10 +19,20 @@
 package bundb
 
 import (
+	"strings"
+
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/uptrace/bun"
 )
 
+// likeEscapeChar is the escape character to use in LIKE patterns made safe with likeEscaper.
+// It's not a backslash because the dialects don't agree on how to quote one.
+const likeEscapeChar = "!"
+
+// likeEscaper escapes the LIKE wildcards in a string, so that it only matches
+// literally. Use it with an `ESCAPE ?` clause set to likeEscapeChar.
+var likeEscaper = strings.NewReplacer(likeEscapeChar, likeEscapeChar+likeEscapeChar, "%", likeEscapeChar+"%", "_", likeEscapeChar+"_")
+
 // whereEmptyOrNull is a convenience function to return a bun WhereGroup that specifies
 // that the given column should be EITHER an empty string OR null.
 //
@@ -37,6 +47,18 @@ func whereEmptyOrNull(column string) func(*bun.SelectQuery) *bun.SelectQuery {
 	}
 }
 
+// whereNotDeleted is a convenience function for a bun WhereGroup that specifies that a
+// status, selected from the statuses table as "status", hasn't been marked as deleted.
+// Bun leaves out deleted statuses by itself when selecting into a gtsmodel.Status, but
+// any other query of the statuses table must use this to do so until they're purged.
+//
+// Use it as follows:
+//
+//	q = q.WhereGroup(" AND ", whereNotDeleted)
+func whereNotDeleted(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Where("? IS NULL", bun.Ident("status.deleted_at"))
+}
+
 // whereNotEmptyAndNotNull is a convenience function to return a bun WhereGroup that specifies
 // that the given column should be NEITHER an empty string NOR null.
 //
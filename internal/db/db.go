@@ -34,13 +34,19 @@ type DB interface {
 	Account
 	Admin
 	Basic
+	BookmarkFolder
 	Domain
 	Emoji
+	Filter
+	Inbox
 	Instance
+	List
 	Media
 	Mention
 	Notification
+	Poll
 	Relationship
+	Search
 	Session
 	Status
 	Timeline
@@ -21,6 +21,7 @@ package db
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -36,6 +37,34 @@ type Domain interface {
 	// DeleteDomainBlock ...
 	DeleteDomainBlock(ctx context.Context, domain string) Error
 
+	// GetExpiredDomainBlocks returns up to limit domain blocks with an expiry at or before now,
+	// soonest expiry first. A limit of 0 means no limit.
+	GetExpiredDomainBlocks(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.DomainBlock, Error)
+
+	// PutDomainNote stores the given admin note on a domain, replacing the text and editor of any existing note for that domain.
+	PutDomainNote(ctx context.Context, note *gtsmodel.DomainNote) Error
+
+	// GetDomainNote returns the admin note on the given domain, if it exists.
+	GetDomainNote(ctx context.Context, domain string) (*gtsmodel.DomainNote, Error)
+
+	// GetDomainNotes returns all admin notes on domains, ordered by domain. Returns db.ErrNoEntries if there are none.
+	GetDomainNotes(ctx context.Context) ([]*gtsmodel.DomainNote, Error)
+
+	// DeleteDomainNote deletes the admin note on the given domain, if it exists.
+	DeleteDomainNote(ctx context.Context, domain string) Error
+
+	// PutDomainEmojiPolicy stores the given emoji policy for a domain, replacing the policy and editor of any existing policy for that domain.
+	PutDomainEmojiPolicy(ctx context.Context, policy *gtsmodel.DomainEmojiPolicy) Error
+
+	// GetDomainEmojiPolicy returns the emoji policy for the given domain, if it exists.
+	GetDomainEmojiPolicy(ctx context.Context, domain string) (*gtsmodel.DomainEmojiPolicy, Error)
+
+	// GetDomainEmojiPolicies returns all domain emoji policies, ordered by domain. Returns db.ErrNoEntries if there are none.
+	GetDomainEmojiPolicies(ctx context.Context) ([]*gtsmodel.DomainEmojiPolicy, Error)
+
+	// DeleteDomainEmojiPolicy deletes the emoji policy for the given domain, if it exists.
+	DeleteDomainEmojiPolicy(ctx context.Context, domain string) Error
+
 	// IsDomainBlocked checks if an instance-level domain block exists for the given domain string (eg., `example.org`).
 	IsDomainBlocked(ctx context.Context, domain string) (bool, Error)
 
@@ -47,4 +76,23 @@ type Domain interface {
 
 	// AreURIsBlocked checks if an instance-level domain block exists for any `host` in the given URI slice, and returns true if even one is found.
 	AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, Error)
+
+	// PutAccountDomainBlock stores an account-level domain block in the database.
+	PutAccountDomainBlock(ctx context.Context, block *gtsmodel.AccountDomainBlock) Error
+
+	// GetAccountDomainBlock returns the account-level block of the given domain by the given account, if it exists.
+	GetAccountDomainBlock(ctx context.Context, accountID string, domain string) (*gtsmodel.AccountDomainBlock, Error)
+
+	// GetAccountDomainBlocks returns account-level domain blocks created by the given account, newest first,
+	// optionally paged using maxID / sinceID / limit. Returns db.ErrNoEntries if there are none.
+	GetAccountDomainBlocks(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.AccountDomainBlock, Error)
+
+	// DeleteAccountDomainBlock deletes the account-level block of the given domain by the given account, if it exists.
+	DeleteAccountDomainBlock(ctx context.Context, accountID string, domain string) Error
+
+	// IsDomainBlockedByAccount checks if the given account has an account-level block in place for the given domain string (eg., `example.org`).
+	IsDomainBlockedByAccount(ctx context.Context, accountID string, domain string) (bool, Error)
+
+	// AreDomainsBlockedByAccount checks if the given account has an account-level block in place for any of the given domain strings, and returns true if even one is found.
+	AreDomainsBlockedByAccount(ctx context.Context, accountID string, domains []string) (bool, Error)
 }
@@ -28,6 +28,13 @@ import (
 // query to indicate that emojis from all domains should be returned.
 const EmojiAllDomains string = "all"
 
+// EmojiUsage is the number of statuses and accounts that use one emoji.
+type EmojiUsage struct {
+	EmojiID       string
+	StatusesCount int
+	AccountsCount int
+}
+
 // Emoji contains functions for getting emoji in the database.
 type Emoji interface {
 	// PutEmoji puts one emoji in the database.
@@ -35,14 +42,28 @@ type Emoji interface {
 	// UpdateEmoji updates the given columns of one emoji.
 	// If no columns are specified, every column is updated.
 	UpdateEmoji(ctx context.Context, emoji *gtsmodel.Emoji, columns ...string) (*gtsmodel.Emoji, Error)
+	// UpdateEmojisDisabledByDomain disables or enables every emoji from the given domain at once,
+	// returning the IDs of the emojis which were changed. Emojis already in the desired state are left alone.
+	UpdateEmojisDisabledByDomain(ctx context.Context, domain string, disabled bool) ([]string, Error)
 	// DeleteEmojiByID deletes one emoji by its database ID.
 	DeleteEmojiByID(ctx context.Context, id string) Error
-	// GetUseableEmojis gets all emojis which are useable by accounts on this instance.
-	GetUseableEmojis(ctx context.Context) ([]*gtsmodel.Emoji, Error)
+	// GetUseableEmojis gets emojis which are useable by accounts on this instance, ordered by shortcode.
+	// If shortcode is set, only emojis whose shortcode contains it (case-insensitive) are returned.
+	// If categoryID is set, only emojis in that category are returned. A limit of 0 means no limit.
+	GetUseableEmojis(ctx context.Context, shortcode string, categoryID string, limit int) ([]*gtsmodel.Emoji, Error)
+	// SearchUseableEmojis gets up to limit emojis useable by accounts on this instance whose shortcode
+	// contains query (case-insensitive), for autocompletion. Emojis whose shortcode starts with query
+	// come first, followed by other matches, each ordered by shortcode.
+	SearchUseableEmojis(ctx context.Context, query string, limit int) ([]*gtsmodel.Emoji, Error)
 	// GetEmojis gets emojis based on given parameters. Useful for admin actions.
 	GetEmojis(ctx context.Context, domain string, includeDisabled bool, includeEnabled bool, shortcode string, maxShortcodeDomain string, minShortcodeDomain string, limit int) ([]*gtsmodel.Emoji, Error)
+	// GetAllEmojis pages through emojis from all domains, returning up to limit emojis
+	// with IDs lower than maxID, newest first. Use an empty maxID to start from the newest.
+	GetAllEmojis(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Emoji, Error)
 	// GetEmojiByID gets a specific emoji by its database ID.
 	GetEmojiByID(ctx context.Context, id string) (*gtsmodel.Emoji, Error)
+	// GetEmojisByIDs gets emojis for the given IDs in one query, in the same order as the IDs. IDs with no emoji are skipped.
+	GetEmojisByIDs(ctx context.Context, emojiIDs []string) ([]*gtsmodel.Emoji, Error)
 	// GetEmojiByShortcodeDomain gets an emoji based on its shortcode and domain.
 	// For local emoji, domain should be an empty string.
 	GetEmojiByShortcodeDomain(ctx context.Context, shortcode string, domain string) (*gtsmodel.Emoji, Error)
@@ -56,6 +77,26 @@ type Emoji interface {
 	GetEmojiCategories(ctx context.Context) ([]*gtsmodel.EmojiCategory, Error)
 	// GetEmojiCategory gets one emoji category by its id.
 	GetEmojiCategory(ctx context.Context, id string) (*gtsmodel.EmojiCategory, Error)
+	// GetEmojiCategoriesByIDs gets emoji categories for the given IDs in one query, in the same order as the IDs. IDs with no category are skipped.
+	GetEmojiCategoriesByIDs(ctx context.Context, emojiCategoryIDs []string) ([]*gtsmodel.EmojiCategory, Error)
 	// GetEmojiCategoryByName gets one emoji category by its name.
 	GetEmojiCategoryByName(ctx context.Context, name string) (*gtsmodel.EmojiCategory, Error)
+	// UpdateEmojiCategory updates the given columns of one emoji category.
+	// If no columns are specified, every column is updated.
+	UpdateEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.EmojiCategory, columns ...string) (*gtsmodel.EmojiCategory, Error)
+	// DeleteEmojiCategory deletes one emoji category by its database ID.
+	// Any emojis still in the category will be left uncategorized.
+	DeleteEmojiCategory(ctx context.Context, id string) Error
+	// GetEmojisByCategoryID gets all emojis in the emoji category with the given ID.
+	GetEmojisByCategoryID(ctx context.Context, categoryID string) ([]*gtsmodel.Emoji, Error)
+	// GetEmojiUsage counts how many statuses and accounts use each emoji from the given domain,
+	// sorted by total use count, most used first (or least used first if ascending is true).
+	// For local emoji, domain should be an empty string; use EmojiAllDomains for all emojis.
+	GetEmojiUsage(ctx context.Context, domain string, ascending bool, limit int) ([]*EmojiUsage, Error)
+	// PutAccountEmojiUsage records that the given account has used each of the given emojis in one more status.
+	PutAccountEmojiUsage(ctx context.Context, accountID string, emojiIDs []string) Error
+	// GetFrequentlyUsedEmojiIDs gets the IDs of up to limit useable emojis which the given account has used,
+	// ordered by how many statuses the account has used them in, most used first, then by most recently used.
+	// A limit of 0 means no limit.
+	GetFrequentlyUsedEmojiIDs(ctx context.Context, accountID string, limit int) ([]string, Error)
 }
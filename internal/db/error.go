@@ -30,6 +30,10 @@ var (
 	ErrMultipleEntries Error = fmt.Errorf("multiple entries")
 	// ErrAlreadyExists is returned when a conflict was encountered in the db when doing an insert.
 	ErrAlreadyExists Error = fmt.Errorf("already exists")
+	// ErrTimeout is returned when a query was cancelled because it took longer than the configured query timeout.
+	ErrTimeout Error = fmt.Errorf("query timed out")
+	// ErrMaintenanceInProgress is returned when database maintenance is requested while it's already running.
+	ErrMaintenanceInProgress Error = fmt.Errorf("database maintenance already in progress")
 	// ErrUnknown denotes an unknown database error.
 	ErrUnknown Error = fmt.Errorf("unknown error")
 )
@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Filter contains functions for getting and setting filters, along with their keywords and statuses.
+type Filter interface {
+	// GetFilterByID returns one filter from the database, with its keywords and statuses populated.
+	GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, Error)
+
+	// GetFiltersForAccountID returns the filters owned by the given account, in the order
+	// they were created in, with their keywords and statuses populated.
+	GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, Error)
+
+	// PutFilter stores the given filter, along with any keywords and statuses it has.
+	PutFilter(ctx context.Context, filter *gtsmodel.Filter) Error
+
+	// UpdateFilter updates the given columns of the given filter, leaving its keywords and statuses
+	// untouched. If no columns are given, every column is updated. UpdatedAt is always updated.
+	UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) Error
+
+	// DeleteFilterByID deletes the filter with the given ID, along with its keywords and statuses.
+	DeleteFilterByID(ctx context.Context, id string) Error
+
+	// GetFilterKeywordByID returns one filter keyword from the database.
+	GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, Error)
+
+	// PutFilterKeyword stores the given filter keyword.
+	PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) Error
+
+	// UpdateFilterKeyword updates the given columns of the given filter keyword. If no
+	// columns are given, every column is updated. UpdatedAt is always updated.
+	UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) Error
+
+	// DeleteFilterKeywordByID deletes the filter keyword with the given ID.
+	DeleteFilterKeywordByID(ctx context.Context, id string) Error
+
+	// GetFilterStatusByID returns one filter status from the database.
+	GetFilterStatusByID(ctx context.Context, id string) (*gtsmodel.FilterStatus, Error)
+
+	// PutFilterStatus stores the given filter status. ErrAlreadyExists is
+	// returned if the status has already been added to the filter.
+	PutFilterStatus(ctx context.Context, filterStatus *gtsmodel.FilterStatus) Error
+
+	// DeleteFilterStatusByID deletes the filter status with the given ID.
+	DeleteFilterStatusByID(ctx context.Context, id string) Error
+}
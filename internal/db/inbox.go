@@ -0,0 +1,40 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Inbox contains functions for storing incoming federated activities that are waiting to be processed.
+type Inbox interface {
+	// PutInboxItem stores one queued incoming activity.
+	PutInboxItem(ctx context.Context, item *gtsmodel.InboxItem) Error
+
+	// GetInboxItem returns one queued incoming activity by its database ID.
+	GetInboxItem(ctx context.Context, id string) (*gtsmodel.InboxItem, Error)
+
+	// GetInboxItemIDs returns the IDs of all queued incoming activities, oldest first.
+	GetInboxItemIDs(ctx context.Context) ([]string, Error)
+
+	// DeleteInboxItem deletes one queued incoming activity by its database ID.
+	DeleteInboxItem(ctx context.Context, id string) Error
+}
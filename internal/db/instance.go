@@ -20,10 +20,23 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
+// DomainStorage is the number of rows and bytes of storage attributable to one remote domain.
+type DomainStorage struct {
+	Domain           string
+	AccountsCount    int
+	StatusesCount    int
+	AttachmentsCount int
+	// AttachmentsBytes only includes attachments currently cached by this instance.
+	AttachmentsBytes int64
+	EmojisCount      int
+	EmojisBytes      int64
+}
+
 // Instance contains functions for instance-level actions (counting instance users etc.).
 type Instance interface {
 	// CountInstanceUsers returns the number of known accounts registered with the given domain.
@@ -38,6 +51,29 @@ type Instance interface {
 	// GetInstanceAccounts returns a slice of accounts from the given instance, arranged by ID.
 	GetInstanceAccounts(ctx context.Context, domain string, maxID string, limit int) ([]*gtsmodel.Account, Error)
 
+	// GetDomainStorage returns the number of accounts, statuses, media attachments and emojis stored from each
+	// remote domain, and how many bytes the attachments and emojis take up, sorted by bytes, most first.
+	GetDomainStorage(ctx context.Context) ([]*DomainStorage, Error)
+
 	// GetInstancePeers returns a slice of instances that the host instance knows about.
 	GetInstancePeers(ctx context.Context, includeSuspended bool) ([]*gtsmodel.Instance, Error)
+
+	// PutLegalDocument stores the given document as the next version of its kind,
+	// setting its Version to one more than the latest version of that kind.
+	PutLegalDocument(ctx context.Context, document *gtsmodel.LegalDocument) Error
+
+	// GetLegalDocumentByID returns one version of a legal document.
+	GetLegalDocumentByID(ctx context.Context, id string) (*gtsmodel.LegalDocument, Error)
+
+	// GetLegalDocument returns the given version of the given kind of legal document, or its latest version if version is 0.
+	GetLegalDocument(ctx context.Context, kind gtsmodel.LegalDocumentKind, version int) (*gtsmodel.LegalDocument, Error)
+
+	// GetLegalDocuments returns all versions of the given kind of legal document, or of every kind if kind is empty,
+	// newest first. Returns db.ErrNoEntries if there are none.
+	GetLegalDocuments(ctx context.Context, kind gtsmodel.LegalDocumentKind) ([]*gtsmodel.LegalDocument, Error)
+
+	// GetLegalDocumentKindsToAccept returns the kinds of legal document which have had a version
+	// requiring acceptance published after the given time, ie., which a user who last accepted
+	// them at that time needs to accept again.
+	GetLegalDocumentKindsToAccept(ctx context.Context, acceptedAt time.Time) ([]gtsmodel.LegalDocumentKind, Error)
 }
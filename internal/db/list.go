@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// List contains functions for getting and setting lists and the follows in them.
+//
+// Entries whose follow has since been removed are treated as if they didn't exist.
+type List interface {
+	// GetListByID returns one list from the database.
+	GetListByID(ctx context.Context, id string) (*gtsmodel.List, Error)
+
+	// GetListsForAccountID returns the lists owned by the given account, in the order they were created in.
+	GetListsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.List, Error)
+
+	// GetListsContainingFollowID returns the lists that the given follow has been added to.
+	GetListsContainingFollowID(ctx context.Context, followID string) ([]*gtsmodel.List, Error)
+
+	// UpdateList updates the given columns of the given list. If no columns
+	// are given, every column is updated. UpdatedAt is always updated.
+	UpdateList(ctx context.Context, list *gtsmodel.List, columns ...string) Error
+
+	// DeleteListByID deletes the list with the given ID, along with its entries.
+	DeleteListByID(ctx context.Context, id string) Error
+
+	// GetListEntries returns entries of the given list, with their follow and its target account
+	// populated, newest first. If limit is 0, all entries from the given IDs onwards are returned.
+	GetListEntries(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ListEntry, Error)
+
+	// PutListEntries stores the given entries, skipping any for follows already in their list.
+	PutListEntries(ctx context.Context, entries []*gtsmodel.ListEntry) Error
+
+	// DeleteListEntries removes the given follows from the given list.
+	DeleteListEntries(ctx context.Context, listID string, followIDs []string) Error
+
+	// DeleteListEntriesForFollowID removes the given follow from every list it has been added to.
+	DeleteListEntriesForFollowID(ctx context.Context, followID string) Error
+}
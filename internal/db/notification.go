@@ -33,5 +33,14 @@ type Notification interface {
 	// GetNotification returns one notification according to its id.
 	GetNotification(ctx context.Context, id string) (*gtsmodel.Notification, Error)
 	// ClearNotifications deletes every notification that pertain to the given accountID.
-	ClearNotifications(ctx context.Context, accountID string) Error
+	// If types is not empty, only notifications of the given types are deleted.
+	ClearNotifications(ctx context.Context, accountID string, types []string) Error
+	// MarkNotificationsRead marks the notifications with the given IDs as read.
+	MarkNotificationsRead(ctx context.Context, ids []string) Error
+	// PruneReadNotifications deletes read notifications which are older than the retention period of the
+	// account they pertain to. Accounts which haven't chosen their own retention period use defaultRetentionDays,
+	// and a retention period of 0 days keeps notifications forever. Notifications are deleted batchSize at a time.
+	//
+	// Returns the number of notifications deleted.
+	PruneReadNotifications(ctx context.Context, defaultRetentionDays int, batchSize int) (int, Error)
 }
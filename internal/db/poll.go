@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Poll contains functions for getting polls, and for storing and counting votes in them.
+//
+// Polls themselves are stored along with the status they're attached to, by PutStatus.
+type Poll interface {
+	// GetPollByID returns one poll from the database.
+	GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, Error)
+
+	// GetPollByStatusID returns the poll attached to the status with the given ID.
+	GetPollByStatusID(ctx context.Context, statusID string) (*gtsmodel.Poll, Error)
+
+	// UpdatePoll updates the given columns of the given poll. If no columns
+	// are given, every column is updated. UpdatedAt is always updated.
+	UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) Error
+
+	// GetExpiredPolls returns up to limit polls which had ended by the given time, but haven't been closed yet.
+	GetExpiredPolls(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.Poll, Error)
+
+	// PutPollVotes stores the given votes in one statement. If any of them
+	// has already been stored, none of them are, and ErrAlreadyExists is returned.
+	PutPollVotes(ctx context.Context, votes []*gtsmodel.PollVote) Error
+
+	// GetPollVotesBy returns the votes cast in the given poll by the given account, ordered by choice.
+	GetPollVotesBy(ctx context.Context, pollID string, accountID string) ([]*gtsmodel.PollVote, Error)
+
+	// GetPollVoterIDs returns the IDs of all the accounts which have voted in the given poll.
+	GetPollVoterIDs(ctx context.Context, pollID string) ([]string, Error)
+
+	// CountPollVotes counts the votes stored for each of the given number of options of the
+	// given poll, and the number of accounts which cast them. This is only the full count for
+	// local polls; remote polls only have votes from local accounts stored.
+	CountPollVotes(ctx context.Context, pollID string, options int) ([]int, int, Error)
+}
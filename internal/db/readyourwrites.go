@@ -0,0 +1,51 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// writtenKey is the context key under which
+// a *atomic.Bool is stored by WithReadYourWrites.
+type writtenKey struct{}
+
+// WithReadYourWrites returns a context which, once anything has been written to the
+// database with it, sends every later read made with it to the primary database
+// rather than to a replica, so that those reads see what was written even if the
+// replicas haven't caught up yet.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writtenKey{}, new(atomic.Bool))
+}
+
+// MarkWritten records that something has been written to the database
+// with the given context, if it was made with WithReadYourWrites.
+func MarkWritten(ctx context.Context) {
+	if written, ok := ctx.Value(writtenKey{}).(*atomic.Bool); ok {
+		written.Store(true)
+	}
+}
+
+// Written returns whether something has been written to the
+// database with the given context, since WithReadYourWrites.
+func Written(ctx context.Context) bool {
+	written, ok := ctx.Value(writtenKey{}).(*atomic.Bool)
+	return ok && written.Load()
+}
@@ -20,6 +20,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -42,6 +43,12 @@ type Relationship interface {
 	// IsFollowing returns true if sourceAccount follows target account, or an error if something goes wrong while finding out.
 	IsFollowing(ctx context.Context, sourceAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (bool, Error)
 
+	// GetFollow returns the follow from sourceAccountID targeting targetAccountID, if it exists, or an error if it doesn't.
+	//
+	// Because this is slower than IsFollowing, only use it if you need the actual Follow struct for some reason,
+	// such as checking the options the follow was created with.
+	GetFollow(ctx context.Context, sourceAccountID string, targetAccountID string) (*gtsmodel.Follow, Error)
+
 	// IsFollowRequested returns true if sourceAccount has requested to follow target account, or an error if something goes wrong while finding out.
 	IsFollowRequested(ctx context.Context, sourceAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (bool, Error)
 
@@ -62,6 +69,10 @@ type Relationship interface {
 	// GetAccountFollowRequests returns all follow requests targeting the given account.
 	GetAccountFollowRequests(ctx context.Context, accountID string) ([]*gtsmodel.FollowRequest, Error)
 
+	// GetExpiredFollowRequests returns up to limit follow requests targeting local accounts
+	// which were last updated before olderThan, oldest first. A limit of 0 means no limit.
+	GetExpiredFollowRequests(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.FollowRequest, Error)
+
 	// GetAccountFollows returns a slice of follows owned by the given accountID.
 	GetAccountFollows(ctx context.Context, accountID string) ([]*gtsmodel.Follow, Error)
 
@@ -29,6 +29,11 @@ type Status interface {
 	// GetStatusByID returns one status from the database, with no rel fields populated, only their linking ID / URIs
 	GetStatusByID(ctx context.Context, id string) (*gtsmodel.Status, Error)
 
+	// GetStatusesByIDs returns the statuses with the given IDs, in the same order as the IDs, fetching
+	// all of the ones that aren't cached (and their authors) in as few queries as possible.
+	// IDs with no status, or whose author can't be found, are skipped.
+	GetStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Status, Error)
+
 	// GetStatusByURI returns one status from the database, with no rel fields populated, only their linking ID / URIs
 	GetStatusByURI(ctx context.Context, uri string) (*gtsmodel.Status, Error)
 
@@ -38,12 +43,22 @@ type Status interface {
 	// PutStatus stores one status in the database.
 	PutStatus(ctx context.Context, status *gtsmodel.Status) Error
 
+	// PutStatuses stores multiple new statuses in the database in one transaction, along
+	// with their mentions and any of their tags that don't exist yet; either all of the
+	// given statuses are stored, or none of them are, so nothing is left behind on failure.
+	PutStatuses(ctx context.Context, statuses []*gtsmodel.Status) Error
+
 	// UpdateStatus updates one status in the database and returns it to the caller.
 	UpdateStatus(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.Status, Error)
 
-	// DeleteStatusByID deletes one status from the database.
+	// DeleteStatusByID marks one status as deleted in the database, so that it's no longer returned.
+	// The status itself is removed later by PurgeDeletedStatuses.
 	DeleteStatusByID(ctx context.Context, id string) Error
 
+	// PurgeDeletedStatuses removes statuses marked as deleted from the database, along with any
+	// rows that link to them, batchSize statuses at a time. It returns the number of statuses removed.
+	PurgeDeletedStatuses(ctx context.Context, batchSize int) (int, Error)
+
 	// CountStatusReplies returns the amount of replies recorded for a status, or an error if something goes wrong
 	CountStatusReplies(ctx context.Context, status *gtsmodel.Status) (int, Error)
 
@@ -72,6 +87,18 @@ type Status interface {
 	// IsStatusMutedBy checks if a given status has been muted by a given account ID
 	IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
+	// GetStatusThreadIDs returns the ids of the given status and all of its parents that are known to the
+	// database, ordered from the given status up to the top-most status of the thread it's part of.
+	GetStatusThreadIDs(ctx context.Context, status *gtsmodel.Status) ([]string, Error)
+
+	// IsThreadMutedBy checks if the thread that a given status is part of has been muted by a given
+	// account ID, ie., whether the account has muted the status itself or any of its parents.
+	IsThreadMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
+
+	// IsThreadIDsMutedBy works like IsThreadMutedBy, but takes thread ids as returned by GetStatusThreadIDs,
+	// so that callers checking the same thread for many accounts only need to walk up the thread once.
+	IsThreadIDsMutedBy(ctx context.Context, threadIDs []string, accountID string) (bool, Error)
+
 	// IsStatusBookmarkedBy checks if a given status has been bookmarked by a given account ID
 	IsStatusBookmarkedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
@@ -82,4 +109,19 @@ type Status interface {
 	// GetStatusReblogs returns a slice of statuses that are a boost/reblog of the given status.
 	// This slice will be unfiltered, not taking account of blocks and whatnot, so filter it before serving it back to a user.
 	GetStatusReblogs(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Status, Error)
+
+	// GetStatusViewCount returns the view counts of the given status.
+	// If the status has never been viewed, zeroed counts are returned.
+	GetStatusViewCount(ctx context.Context, statusID string) (*gtsmodel.StatusViewCount, Error)
+
+	// IncrementStatusViewCount counts one more view of the given status; a view
+	// of its web page if fetch is false, or an ActivityPub fetch if fetch is true.
+	IncrementStatusViewCount(ctx context.Context, statusID string, fetch bool) Error
+
+	// GetStatusDeliveries returns the outcome of delivering the given status to each remote domain.
+	GetStatusDeliveries(ctx context.Context, statusID string) ([]*gtsmodel.StatusDelivery, Error)
+
+	// PutStatusDeliveries stores the given delivery outcomes, replacing
+	// any stored outcome of an earlier delivery to the same domain.
+	PutStatusDeliveries(ctx context.Context, deliveries []*gtsmodel.StatusDelivery) Error
 }
@@ -45,4 +45,17 @@ type Timeline interface {
 	//
 	// Also note the extra return values, which correspond to the nextMaxID and prevMinID for building Link headers.
 	GetFavedTimeline(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, string, string, Error)
+
+	// GetBookmarkedTimeline fetches the account's BOOKMARKED timeline -- ie., posts and replies that the requesting account has bookmarked.
+	//
+	// Like GetFavedTimeline, the returned statuses are arranged by their BOOKMARK id, in descending order of when they were bookmarked,
+	// and the extra return values are the nextMaxID and prevMinID for building Link headers.
+	//
+	// If folderID is set, only bookmarks filed in the bookmark folder with that ID are returned.
+	GetBookmarkedTimeline(ctx context.Context, accountID string, folderID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, string, string, Error)
+
+	// GetListTimeline returns a slice of statuses from accounts whose follows are in the given list.
+	//
+	// Statuses should be returned in descending order of when they were created (newest first).
+	GetListTimeline(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Status, Error)
 }
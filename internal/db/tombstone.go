@@ -20,6 +20,8 @@ package db
 
 import (
 	"context"
+	"net/url"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -29,12 +31,18 @@ type Tombstone interface {
 	// GetTombstoneByURI attempts to fetch a tombstone by the given URI.
 	GetTombstoneByURI(ctx context.Context, uri string) (*gtsmodel.Tombstone, Error)
 
-	// TombstoneExistsWithURI returns true if a tombstone with the given URI exists.
+	// TombstoneExistsWithURI returns true if an unexpired tombstone with the given URI exists.
+	// If the tombstone with the given URI has expired, it is deleted, and false is returned.
 	TombstoneExistsWithURI(ctx context.Context, uri string) (bool, Error)
 
 	// PutTombstone creates a new tombstone in the database.
 	PutTombstone(ctx context.Context, tombstone *gtsmodel.Tombstone) Error
 
+	// PutTombstoneForURI marks the AP Actor or Object with the given uri as gone, by putting a tombstone for it
+	// in the database. If expiresAt is set, the tombstone stops applying at that time; otherwise it's permanent.
+	// If a tombstone for the uri already exists, it's only changed if that makes it last longer.
+	PutTombstoneForURI(ctx context.Context, uri *url.URL, expiresAt time.Time) Error
+
 	// DeleteTombstone deletes a tombstone with the given ID.
 	DeleteTombstone(ctx context.Context, id string) Error
 }
@@ -34,9 +34,15 @@ type User interface {
 	GetUserByEmailAddress(ctx context.Context, emailAddress string) (*gtsmodel.User, Error)
 	// GetUserByConfirmationToken returns one user by its confirmation token, or an error if something goes wrong.
 	GetUserByConfirmationToken(ctx context.Context, confirmationToken string) (*gtsmodel.User, Error)
+	// GetUserByPostByMailToken returns one user by its post by mail token, or an error if something goes wrong.
+	GetUserByPostByMailToken(ctx context.Context, postByMailToken string) (*gtsmodel.User, Error)
 	// UpdateUser updates one user by its primary key. If columns is set, only given columns
 	// will be updated. If not set, all columns will be updated.
 	UpdateUser(ctx context.Context, user *gtsmodel.User, columns ...string) (*gtsmodel.User, Error)
+	// UseRecoveryCode removes the recovery code hash from the given user, but only if the user's
+	// recovery codes haven't changed in the database since the user was fetched. If they have,
+	// for instance because the code was used concurrently, ErrNoEntries is returned.
+	UseRecoveryCode(ctx context.Context, user *gtsmodel.User, hash string) Error
 	// DeleteUserByID deletes one user by its ID.
 	DeleteUserByID(ctx context.Context, userID string) Error
 }
@@ -0,0 +1,225 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package email
+
+import (
+	"strings"
+)
+
+// authResult is a single result from an Authentication-Results
+// header, such as dkim=pass header.d=example.org, as specified
+// in RFC 8601. Comments in the result have been removed.
+type authResult struct {
+	// Lowercased method, without its version, eg., "dkim".
+	method string
+	// Lowercased result, eg., "pass".
+	result string
+	// Properties of the result, keyed by lowercased ptype.property,
+	// eg., "header.d". Values have any quotes removed.
+	props map[string]string
+}
+
+// authResults parses the given Authentication-Results header values, and
+// returns the results from those whose authserv-id is authservID. Headers
+// with any other authserv-id are ignored.
+func authResults(headers []string, authservID string) []authResult {
+	results := []authResult{}
+
+	for _, header := range headers {
+		segments := tokenizeAuthResults(header)
+
+		// the first segment is the authserv-id,
+		// optionally followed by a version number
+		if len(segments) == 0 || len(segments[0]) == 0 || !strings.EqualFold(segments[0][0], authservID) {
+			continue
+		}
+
+		for _, segment := range segments[1:] {
+			if result, ok := parseAuthResult(segment); ok {
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results
+}
+
+// parseAuthResult parses the tokens of one result from an Authentication-Results
+// header, eg., 'spf=pass smtp.mailfrom=zork@example.org', returning false if the
+// result couldn't be parsed. Reasons aren't needed, so they're skipped.
+func parseAuthResult(tokens []string) (authResult, bool) {
+	if len(tokens) == 0 {
+		return authResult{}, false
+	}
+
+	method, result, ok := strings.Cut(tokens[0], "=")
+	if !ok {
+		// eg., 'none', which means no checks were done
+		return authResult{}, false
+	}
+	method, _, _ = strings.Cut(method, "/")
+
+	props := make(map[string]string, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		key, value, ok := strings.Cut(token, "=")
+		if !ok || !strings.Contains(key, ".") {
+			continue
+		}
+		props[strings.ToLower(key)] = strings.Trim(value, `"`)
+	}
+
+	return authResult{
+		method: strings.ToLower(method),
+		result: strings.ToLower(result),
+		props:  props,
+	}, true
+}
+
+// tokenizeAuthResults splits the given Authentication-Results header value into
+// its semicolon separated segments, and each segment into its whitespace separated
+// tokens. Comments, which are in (possibly nested) parentheses, are removed.
+//
+// Values such as the address in smtp.mailfrom may be quoted strings, which can
+// contain anything, so semicolons, whitespace and parentheses inside quotes are
+// kept as part of the token they're in, rather than being trusted to mean anything.
+func tokenizeAuthResults(header string) [][]string {
+	var (
+		segments [][]string
+		tokens   []string
+		token    strings.Builder
+		depth    int  // depth of comment we're in, if any
+		quoted   bool // whether we're in a quoted string
+	)
+
+	endToken := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for i := 0; i < len(header); i++ {
+		c := header[i]
+		switch {
+		case c == '\\' && (quoted || depth > 0):
+			// quoted-pair, so take the escaped character as it is
+			if i+1 < len(header) {
+				i++
+				if depth == 0 {
+					token.WriteByte(c)
+					token.WriteByte(header[i])
+				}
+			}
+		case quoted:
+			token.WriteByte(c)
+			if c == '"' {
+				quoted = false
+			}
+		case c == '(':
+			// comments separate tokens like whitespace does
+			endToken()
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case depth > 0:
+			// inside a comment
+		case c == '"':
+			token.WriteByte(c)
+			quoted = true
+		case c == ';':
+			endToken()
+			segments = append(segments, tokens)
+			tokens = nil
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			endToken()
+		default:
+			token.WriteByte(c)
+		}
+	}
+
+	endToken()
+	return append(segments, tokens)
+}
+
+// Authenticated returns true if the mail service with the given authserv-id recorded, in an
+// Authentication-Results header, that the message passed DMARC for the domain of its From address,
+// or passed DKIM or SPF for that domain or one of its parents. Authentication-Results headers added
+// by anyone else are ignored, since the sender of the message could have added them to it.
+func (m *InboundMessage) Authenticated(authservID string) bool {
+	if authservID == "" {
+		return false
+	}
+
+	fromDomain := addressDomain(m.From)
+	if fromDomain == "" {
+		return false
+	}
+
+	for _, result := range authResults(m.authenticationResults, authservID) {
+		if result.result != "pass" {
+			continue
+		}
+
+		switch result.method {
+		case "dmarc":
+			if strings.EqualFold(result.props["header.from"], fromDomain) {
+				return true
+			}
+		case "dkim":
+			domain := result.props["header.d"]
+			if domain == "" {
+				// the signing identity, eg., @example.org
+				domain = addressDomain(result.props["header.i"])
+			}
+			if alignedDomain(fromDomain, domain) {
+				return true
+			}
+		case "spf":
+			domain := addressDomain(result.props["smtp.mailfrom"])
+			if alignedDomain(fromDomain, domain) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// alignedDomain returns true if authenticated is the same domain as from, or one of its parent
+// domains, so that passing a check for authenticated vouches for from too. This is like DMARC's
+// relaxed alignment, but stricter, since authenticated can't be a subdomain of from, and it can't
+// be a top level domain either.
+func alignedDomain(from string, authenticated string) bool {
+	from = strings.ToLower(strings.TrimSuffix(from, "."))
+	authenticated = strings.ToLower(strings.TrimSuffix(authenticated, "."))
+
+	if !strings.Contains(authenticated, ".") {
+		return false
+	}
+
+	return from == authenticated || strings.HasSuffix(from, "."+authenticated)
+}
+
+// addressDomain returns the domain of the given email address. The local part of an
+// address may be quoted, and contain an @ itself, so the domain is whatever follows
+// the last @. Addresses without an @ are taken to be just a domain, since
+// smtp.mailfrom may leave out the local part, and the @ along with it.
+func addressDomain(address string) string {
+	return address[strings.LastIndex(address, "@")+1:]
+}
@@ -0,0 +1,221 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package email
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+)
+
+const (
+	maxInboundTextSize  = 64 * 1024 // maxInboundTextSize is the most bytes of plain text read from an inbound email
+	maxInboundPartDepth = 5         // maxInboundPartDepth is how deeply nested multipart bodies are searched for plain text
+)
+
+// recipientHeaders are the headers that inbound email recipients are
+// read from, in order. Mail services usually record the envelope
+// recipient in Delivered-To or X-Original-To, which catches Bcc.
+var recipientHeaders = []string{"Delivered-To", "X-Original-To", "To", "Cc"}
+
+// InboundMessage is an email received from a mail service, reduced
+// to the parts that are needed to post it as a status.
+type InboundMessage struct {
+	// ID of the message from its Message-ID header, without
+	// angle brackets. May be empty if the header wasn't set.
+	ID string
+	// Lowercased address that the message is from.
+	From string
+	// Lowercased addresses that the message was sent to.
+	Recipients []string
+	// Decoded subject of the message. May be empty.
+	Subject string
+	// Plain text body of the message, with line endings
+	// normalized, and any signature block trimmed off.
+	Text string
+
+	// values of the message's Authentication-Results
+	// headers, which are checked by Authenticated
+	authenticationResults []string
+}
+
+// ParseInbound parses a raw email message, as specified in RFC 5322, into an InboundMessage.
+//
+// The text of the message is taken from its first plain text part that isn't an attachment.
+// Other attachments are ignored, and messages that only have an HTML body are rejected.
+func ParseInbound(r io.Reader) (*InboundMessage, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("ParseInbound: error reading message: %s", err)
+	}
+
+	from, err := msg.Header.AddressList("From")
+	if err != nil || len(from) != 1 {
+		return nil, errors.New("ParseInbound: message must be from exactly one address")
+	}
+
+	recipients := []string{}
+	for _, header := range recipientHeaders {
+		// ignore headers that aren't set or can't be parsed,
+		// there's a good chance one of the others will do
+		addresses, err := msg.Header.AddressList(header)
+		if err != nil {
+			continue
+		}
+		for _, address := range addresses {
+			recipients = append(recipients, strings.ToLower(address.Address))
+		}
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		return nil, fmt.Errorf("ParseInbound: error decoding subject: %s", err)
+	}
+
+	text, err := plainText(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body, 0)
+	if err != nil {
+		return nil, fmt.Errorf("ParseInbound: %s", err)
+	}
+
+	return &InboundMessage{
+		ID:         strings.Trim(strings.TrimSpace(msg.Header.Get("Message-Id")), "<>"),
+		From:       strings.ToLower(from[0].Address),
+		Recipients: recipients,
+		Subject:    strings.TrimSpace(subject),
+		Text:       trimSignature(normalizeLineEndings(text)),
+
+		authenticationResults: msg.Header["Authentication-Results"],
+	}, nil
+}
+
+// plainText returns the first plain text found in the given body,
+// searching through the parts of multipart bodies if necessary.
+func plainText(contentType string, transferEncoding string, body io.Reader, depth int) (string, error) {
+	if contentType == "" {
+		// RFC 2045: this is the default
+		contentType = "text/plain; charset=us-ascii"
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("error parsing content type %s: %s", contentType, err)
+	}
+
+	switch {
+	case mediaType == "text/plain":
+		return decodeText(transferEncoding, params["charset"], body)
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if depth >= maxInboundPartDepth {
+			return "", errors.New("multipart message is nested too deeply")
+		}
+
+		// keep the first error from the parts, since it's likely
+		// to be more helpful than just saying nothing was found
+		var partErr error
+
+		parts := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := parts.NextPart()
+			if err == io.EOF {
+				if partErr != nil {
+					return "", partErr
+				}
+				return "", errors.New("no plain text found in multipart message")
+			}
+			if err != nil {
+				return "", fmt.Errorf("error reading multipart message: %s", err)
+			}
+
+			if disposition, _, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition")); disposition == "attachment" {
+				continue
+			}
+
+			text, err := plainText(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part, depth+1)
+			if err == nil {
+				return text, nil
+			}
+			if partErr == nil {
+				partErr = err
+			}
+		}
+	default:
+		return "", fmt.Errorf("content type %s isn't supported, please send plain text", mediaType)
+	}
+}
+
+// decodeText reads text from body, according to the given transfer encoding
+// and charset. Only UTF-8 and its subsets, US-ASCII and ISO-8859-1, are supported.
+func decodeText(transferEncoding string, charset string, body io.Reader) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(transferEncoding)) {
+	case "", "7bit", "8bit", "binary":
+		// nothing to decode
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	default:
+		return "", fmt.Errorf("content transfer encoding %s isn't supported", transferEncoding)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(body, maxInboundTextSize+1))
+	if err != nil {
+		return "", fmt.Errorf("error reading text: %s", err)
+	}
+	if len(b) > maxInboundTextSize {
+		return "", fmt.Errorf("text is longer than %d bytes", maxInboundTextSize)
+	}
+
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "utf8", "us-ascii":
+		return strings.ToValidUTF8(string(b), "�"), nil
+	case "iso-8859-1", "latin1":
+		// every byte is the code point of the same value
+		runes := make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+		return string(runes), nil
+	default:
+		return "", fmt.Errorf("charset %s isn't supported, please send UTF-8", charset)
+	}
+}
+
+// normalizeLineEndings replaces CRLF line endings with LF.
+func normalizeLineEndings(text string) string {
+	return strings.ReplaceAll(text, "\r\n", "\n")
+}
+
+// trimSignature trims off the signature block from the end of text, if it
+// has one, along with any leading or trailing whitespace. Signature blocks
+// are separated from the rest of the text by a line containing only "-- ".
+func trimSignature(text string) string {
+	if strings.HasPrefix(text, "-- \n") {
+		return ""
+	}
+	if i := strings.LastIndex(text, "\n-- \n"); i != -1 {
+		text = text[:i]
+	}
+	return strings.TrimSpace(text)
+}
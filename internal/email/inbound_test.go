@@ -0,0 +1,196 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package email_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+)
+
+type InboundTestSuite struct {
+	suite.Suite
+}
+
+func (suite *InboundTestSuite) TestParsePlain() {
+	raw := "From: \"Zork\" <Zork@Example.org>\r\n" +
+		"To: abcdef@post.example.org\r\n" +
+		"Subject: Backup report\r\n" +
+		"Message-ID: <1234@example.org>\r\n" +
+		"\r\n" +
+		"All backups completed.\r\n" +
+		"\r\n" +
+		"-- \r\n" +
+		"sent from my toaster\r\n"
+
+	msg, err := email.ParseInbound(strings.NewReader(raw))
+	suite.NoError(err)
+	suite.Equal("1234@example.org", msg.ID)
+	suite.Equal("zork@example.org", msg.From)
+	suite.Equal([]string{"abcdef@post.example.org"}, msg.Recipients)
+	suite.Equal("Backup report", msg.Subject)
+	suite.Equal("All backups completed.", msg.Text)
+}
+
+func (suite *InboundTestSuite) TestParseRecipients() {
+	raw := "From: zork@example.org\r\n" +
+		"Delivered-To: Secret@Post.Example.org\r\n" +
+		"To: Someone <someone@example.org>, another@example.org\r\n" +
+		"Cc: not an address\r\n" +
+		"\r\n" +
+		"hello\r\n"
+
+	msg, err := email.ParseInbound(strings.NewReader(raw))
+	suite.NoError(err)
+	suite.Equal([]string{"secret@post.example.org", "someone@example.org", "another@example.org"}, msg.Recipients)
+	suite.Empty(msg.Subject)
+	suite.Equal("hello", msg.Text)
+}
+
+func (suite *InboundTestSuite) TestParseMultipartAlternative() {
+	raw := "From: zork@example.org\r\n" +
+		"To: abcdef@post.example.org\r\n" +
+		"Subject: =?UTF-8?Q?caf=C3=A9?=\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/mixed; boundary=\"outer\"\r\n" +
+		"\r\n" +
+		"--outer\r\n" +
+		"Content-Type: multipart/alternative; boundary=\"inner\"\r\n" +
+		"\r\n" +
+		"--inner\r\n" +
+		"Content-Type: text/html; charset=utf-8\r\n" +
+		"\r\n" +
+		"<p>html version</p>\r\n" +
+		"--inner\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"Content-Transfer-Encoding: quoted-printable\r\n" +
+		"\r\n" +
+		"Let's meet at the caf=C3=A9, it's a really really really really long li=\r\n" +
+		"ne.\r\n" +
+		"--inner--\r\n" +
+		"--outer\r\n" +
+		"Content-Type: text/plain\r\n" +
+		"Content-Disposition: attachment; filename=\"notes.txt\"\r\n" +
+		"\r\n" +
+		"attached notes\r\n" +
+		"--outer--\r\n"
+
+	msg, err := email.ParseInbound(strings.NewReader(raw))
+	suite.NoError(err)
+	suite.Equal("café", msg.Subject)
+	suite.Equal("Let's meet at the café, it's a really really really really long line.", msg.Text)
+}
+
+func (suite *InboundTestSuite) TestParseBase64Latin1() {
+	raw := "From: zork@example.org\r\n" +
+		"To: abcdef@post.example.org\r\n" +
+		"Content-Type: text/plain; charset=ISO-8859-1\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"Y2Fm6Q==\r\n"
+
+	msg, err := email.ParseInbound(strings.NewReader(raw))
+	suite.NoError(err)
+	suite.Equal("café", msg.Text)
+}
+
+func (suite *InboundTestSuite) TestParseHTMLOnly() {
+	raw := "From: zork@example.org\r\n" +
+		"To: abcdef@post.example.org\r\n" +
+		"Content-Type: text/html; charset=utf-8\r\n" +
+		"\r\n" +
+		"<p>hello</p>\r\n"
+
+	msg, err := email.ParseInbound(strings.NewReader(raw))
+	suite.Nil(msg)
+	suite.EqualError(err, "ParseInbound: content type text/html isn't supported, please send plain text")
+}
+
+func (suite *InboundTestSuite) TestParseNoFrom() {
+	raw := "To: abcdef@post.example.org\r\n" +
+		"\r\n" +
+		"hello\r\n"
+
+	msg, err := email.ParseInbound(strings.NewReader(raw))
+	suite.Nil(msg)
+	suite.EqualError(err, "ParseInbound: message must be from exactly one address")
+}
+
+// authenticated parses a message from zork@mail.example.org with the given
+// Authentication-Results headers, and returns whether it's authenticated
+// according to mx.example.org.
+func (suite *InboundTestSuite) authenticated(authenticationResults ...string) bool {
+	raw := "From: zork@mail.example.org\r\n"
+	for _, header := range authenticationResults {
+		raw += "Authentication-Results: " + header + "\r\n"
+	}
+	raw += "To: abcdef@post.example.org\r\n" +
+		"\r\n" +
+		"hello\r\n"
+
+	msg, err := email.ParseInbound(strings.NewReader(raw))
+	suite.NoError(err)
+	return msg.Authenticated("mx.example.org")
+}
+
+func (suite *InboundTestSuite) TestAuthenticatedDMARC() {
+	suite.True(suite.authenticated("mx.example.org; dmarc=pass (p=reject dis=none) header.from=mail.example.org"))
+	suite.False(suite.authenticated("mx.example.org; dmarc=pass header.from=example.org"))
+	suite.False(suite.authenticated("mx.example.org; dmarc=fail header.from=mail.example.org"))
+}
+
+func (suite *InboundTestSuite) TestAuthenticatedDKIM() {
+	suite.True(suite.authenticated("mx.example.org; dkim=pass header.d=mail.example.org header.s=selector"))
+	suite.True(suite.authenticated("MX.Example.org 1;\r\n  dkim=pass (2048-bit key) header.d=example.org"))
+	suite.True(suite.authenticated("mx.example.org; dkim=pass header.i=@mail.example.org"))
+	suite.False(suite.authenticated("mx.example.org; dkim=pass header.d=evil.example.com"))
+	suite.False(suite.authenticated("mx.example.org; dkim=pass header.d=org"))
+	suite.False(suite.authenticated("mx.example.org; dkim=pass header.d=sub.mail.example.org"))
+	suite.False(suite.authenticated("mx.example.org; dkim=fail header.d=mail.example.org"))
+}
+
+func (suite *InboundTestSuite) TestAuthenticatedSPF() {
+	suite.True(suite.authenticated("mx.example.org; spf=pass smtp.mailfrom=bounces@mail.example.org"))
+	suite.True(suite.authenticated("mx.example.org; dkim=none; spf=pass smtp.mailfrom=mail.example.org"))
+	suite.False(suite.authenticated("mx.example.org; spf=pass smtp.mailfrom=zork@evil.example.com"))
+	suite.False(suite.authenticated("mx.example.org; spf=softfail smtp.mailfrom=zork@mail.example.org"))
+}
+
+func (suite *InboundTestSuite) TestAuthenticatedUntrustedHeader() {
+	// results from anyone but the configured mail service could have come from the sender
+	suite.False(suite.authenticated("evil.example.com; dkim=pass header.d=mail.example.org"))
+	suite.False(suite.authenticated("evil.example.com; dkim=pass header.d=mail.example.org", "mx.example.org; dkim=fail header.d=mail.example.org"))
+	suite.True(suite.authenticated("evil.example.com; none", "mx.example.org; dkim=pass header.d=mail.example.org"))
+	suite.False(suite.authenticated("mx.example.org; none"))
+	suite.False(suite.authenticated())
+}
+
+func (suite *InboundTestSuite) TestAuthenticatedQuotedAndComments() {
+	// semicolons and results in quoted strings or comments mustn't be taken as results themselves
+	suite.False(suite.authenticated(`mx.example.org; spf=pass smtp.mailfrom="x; dkim=pass header.d=mail.example.org"@evil.example.com`))
+	suite.False(suite.authenticated(`mx.example.org; spf=pass smtp.mailfrom="zork@mail.example.org"@evil.example.com`))
+	suite.False(suite.authenticated("mx.example.org; dkim=fail (; dkim=pass header.d=mail.example.org) header.d=mail.example.org"))
+	suite.False(suite.authenticated("mx.example.org (; dkim=pass header.d=mail.example.org)"))
+}
+
+func TestInboundTestSuite(t *testing.T) {
+	suite.Run(t, &InboundTestSuite{})
+}
@@ -0,0 +1,264 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emojipack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// maxShortcodeLength is the longest shortcode that passes validation.
+const maxShortcodeLength = 30
+
+// OnConflict determines what ImportDir does with a file whose shortcode is already taken.
+type OnConflict string
+
+const (
+	// OnConflictSkip leaves the existing emoji alone and skips the file.
+	OnConflictSkip OnConflict = "skip"
+	// OnConflictReplace replaces the image of the existing local emoji with the file.
+	// Files conflicting with an earlier file in the same directory are still skipped.
+	OnConflictReplace OnConflict = "replace"
+	// OnConflictRename adds the file under the shortcode with the lowest free numeric suffix, eg. blob_2.
+	OnConflictRename OnConflict = "rename"
+)
+
+// ParseOnConflict returns the OnConflict matching s, or an error if there is none.
+func ParseOnConflict(s string) (OnConflict, error) {
+	switch o := OnConflict(s); o {
+	case OnConflictSkip, OnConflictReplace, OnConflictRename:
+		return o, nil
+	}
+	return "", fmt.Errorf("on-conflict value %q not recognised, must be one of %s, %s, or %s", s, OnConflictSkip, OnConflictReplace, OnConflictRename)
+}
+
+// DirAction is what ImportDir did, or would do in a dry run, with one file.
+type DirAction string
+
+const (
+	DirActionAdd     DirAction = "add"     // a new local emoji was created for the file
+	DirActionReplace DirAction = "replace" // the image of an existing local emoji was replaced by the file
+	DirActionSkip    DirAction = "skip"    // nothing was done with the file
+)
+
+// DirResult describes the outcome of ImportDir for one file.
+type DirResult struct {
+	File      string          // name of the file within the directory
+	Shortcode string          // shortcode for the file, after any renaming
+	Action    DirAction       // what was done with the file
+	Reason    string          // why the file was skipped, if it was
+	Emoji     *gtsmodel.Emoji // the added or replaced emoji; nil for skips and dry runs
+}
+
+// ShortcodeFromFilename derives an emoji shortcode from the given file name, by dropping
+// its extension, replacing runs of characters not allowed in shortcodes with a single
+// underscore, and truncating it to the maximum shortcode length. The result may still
+// be too short to be valid, if the name contained too few usable characters.
+func ShortcodeFromFilename(name string) string {
+	name = strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+
+	var b strings.Builder
+	underscore := false
+	for _, r := range name {
+		if r < 128 && (r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')) {
+			b.WriteRune(r)
+			underscore = r == '_'
+			continue
+		}
+
+		if !underscore {
+			b.WriteRune('_')
+			underscore = true
+		}
+	}
+
+	shortcode := strings.Trim(b.String(), "_")
+	if len(shortcode) > maxShortcodeLength {
+		shortcode = strings.TrimRight(shortcode[:maxShortcodeLength], "_")
+	}
+	return shortcode
+}
+
+// dirImportable reports whether a file with the given name looks like an emoji image.
+func dirImportable(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".png", ".gif", ".svg":
+		return true
+	}
+	return false
+}
+
+func (i *importer) ImportDir(ctx context.Context, dir string, category string, onConflict OnConflict, dryRun bool) ([]*DirResult, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("ImportDir: error reading directory %s: %s", dir, err)
+	}
+
+	var ai *media.AdditionalEmojiInfo
+	if category != "" && !dryRun {
+		c, err := getOrCreateCategory(ctx, i.db, category)
+		if err != nil {
+			return nil, fmt.Errorf("ImportDir: %s", err)
+		}
+		ai = &media.AdditionalEmojiInfo{
+			CategoryID: &c.ID,
+		}
+	}
+
+	// shortcodes claimed by earlier files in this directory
+	claimed := make(map[string]bool, len(entries))
+
+	results := make([]*DirResult, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !dirImportable(entry.Name()) {
+			continue
+		}
+
+		result := &DirResult{
+			File:      entry.Name(),
+			Shortcode: ShortcodeFromFilename(entry.Name()),
+			Action:    DirActionAdd,
+		}
+		results = append(results, result)
+
+		if err := validate.EmojiShortcode(result.Shortcode); err != nil {
+			result.Action = DirActionSkip
+			result.Reason = err.Error()
+			continue
+		}
+
+		existing, err := i.db.GetEmojiByShortcodeDomain(ctx, result.Shortcode, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return results, fmt.Errorf("ImportDir: error checking existence of emoji %s: %s", result.Shortcode, err)
+		}
+
+		if existing != nil || claimed[result.Shortcode] {
+			switch {
+			case onConflict == OnConflictRename:
+				shortcode, err := i.freeShortcode(ctx, result.Shortcode, claimed)
+				if err != nil {
+					return results, fmt.Errorf("ImportDir: %s", err)
+				}
+				result.Shortcode = shortcode
+				existing = nil
+			case onConflict == OnConflictReplace && !claimed[result.Shortcode]:
+				result.Action = DirActionReplace
+			default:
+				result.Action = DirActionSkip
+				result.Reason = "an emoji with this shortcode already exists"
+				continue
+			}
+		}
+		claimed[result.Shortcode] = true
+
+		if dryRun {
+			continue
+		}
+
+		emoji, err := i.importFile(ctx, filepath.Join(dir, entry.Name()), result.Shortcode, existing, ai)
+		if err != nil {
+			log.Warnf("ImportDir: skipping emoji %s: %s", result.Shortcode, err)
+			result.Action = DirActionSkip
+			result.Reason = err.Error()
+			continue
+		}
+		result.Emoji = emoji
+	}
+
+	return results, nil
+}
+
+// freeShortcode returns the first shortcode of the form shortcode_N, N >= 2,
+// which is neither used by a local emoji nor claimed by an earlier file.
+func (i *importer) freeShortcode(ctx context.Context, shortcode string, claimed map[string]bool) (string, error) {
+	for n := 2; ; n++ {
+		suffix := "_" + strconv.Itoa(n)
+
+		base := shortcode
+		if len(base)+len(suffix) > maxShortcodeLength {
+			base = base[:maxShortcodeLength-len(suffix)]
+		}
+
+		candidate := base + suffix
+		if claimed[candidate] {
+			continue
+		}
+
+		_, err := i.db.GetEmojiByShortcodeDomain(ctx, candidate, "")
+		if errors.Is(err, db.ErrNoEntries) {
+			return candidate, nil
+		} else if err != nil {
+			return "", fmt.Errorf("error checking existence of emoji %s: %s", candidate, err)
+		}
+	}
+}
+
+// importFile creates a local emoji with the given shortcode from the file at path,
+// or replaces the image of existing with it if existing is not nil.
+func (i *importer) importFile(ctx context.Context, path string, shortcode string, existing *gtsmodel.Emoji, ai *media.AdditionalEmojiInfo) (*gtsmodel.Emoji, error) {
+	var emojiID, emojiURI string
+	refresh := existing != nil
+	if refresh {
+		emojiID = existing.ID
+		emojiURI = existing.URI
+	} else {
+		var err error
+		emojiID, err = id.NewRandomULID()
+		if err != nil {
+			return nil, fmt.Errorf("error creating id for new emoji: %s", err)
+		}
+		emojiURI = uris.GenerateURIForEmoji(emojiID)
+	}
+
+	data := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+
+		return f, fi.Size(), nil
+	}
+
+	processingEmoji, err := i.mediaManager.ProcessEmoji(ctx, data, nil, shortcode, emojiID, emojiURI, ai, refresh)
+	if err != nil {
+		return nil, fmt.Errorf("error processing emoji: %s", err)
+	}
+
+	return processingEmoji.LoadEmoji(ctx)
+}
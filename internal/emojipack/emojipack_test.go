@@ -0,0 +1,241 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emojipack_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/emojipack"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmojiPackTestSuite struct {
+	suite.Suite
+	db           db.DB
+	storage      storage.Driver
+	mediaManager media.Manager
+	testEmojis   map[string]*gtsmodel.Emoji
+}
+
+func (suite *EmojiPackTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.testEmojis = testrig.NewTestEmojis()
+
+	suite.db = testrig.NewTestDB()
+	suite.storage = testrig.NewInMemoryStorage()
+	suite.mediaManager = testrig.NewTestMediaManager(suite.db, suite.storage)
+	testrig.StandardDBSetup(suite.db, nil)
+	testrig.StandardStorageSetup(suite.storage, "../../testrig/media")
+}
+
+func (suite *EmojiPackTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StandardStorageTeardown(suite.storage)
+}
+
+func (suite *EmojiPackTestSuite) export(category string) *bytes.Reader {
+	buf := new(bytes.Buffer)
+	count, err := emojipack.NewExporter(suite.db, suite.storage).Export(context.Background(), buf, category)
+	suite.NoError(err)
+	suite.Equal(1, count)
+	return bytes.NewReader(buf.Bytes())
+}
+
+func (suite *EmojiPackTestSuite) TestExport() {
+	r := suite.export("reactions")
+
+	archive, err := zip.NewReader(r, r.Size())
+	suite.NoError(err)
+	suite.Len(archive.File, 2)
+
+	rc, err := archive.Open(emojipack.ManifestName)
+	suite.NoError(err)
+	defer rc.Close()
+
+	b, err := io.ReadAll(rc)
+	suite.NoError(err)
+
+	pack := &emojipack.Pack{}
+	suite.NoError(json.Unmarshal(b, pack))
+	suite.Equal(map[string]string{"rainbow": "rainbow.png"}, pack.Files)
+	suite.Equal(1, pack.FilesCount)
+	suite.True(pack.Pack.ShareFiles)
+
+	f, err := archive.Open("rainbow.png")
+	suite.NoError(err)
+	defer f.Close()
+
+	fi, err := f.Stat()
+	suite.NoError(err)
+	suite.EqualValues(suite.testEmojis["rainbow"].ImageFileSize, fi.Size())
+}
+
+func (suite *EmojiPackTestSuite) TestExportUnknownCategory() {
+	_, err := emojipack.NewExporter(suite.db, suite.storage).Export(context.Background(), io.Discard, "does not exist")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *EmojiPackTestSuite) TestImportSkipsExisting() {
+	r := suite.export("")
+
+	emojis, err := emojipack.NewImporter(suite.db, suite.mediaManager).Import(context.Background(), r, r.Size(), "")
+	suite.NoError(err)
+	suite.Empty(emojis)
+}
+
+func (suite *EmojiPackTestSuite) TestImport() {
+	r := suite.export("")
+
+	// remove the exported emoji so that its shortcode is free again
+	suite.NoError(suite.db.DeleteEmojiByID(context.Background(), suite.testEmojis["rainbow"].ID))
+
+	emojis, err := emojipack.NewImporter(suite.db, suite.mediaManager).Import(context.Background(), r, r.Size(), "imported")
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+
+	emoji := emojis[0]
+	suite.Equal("rainbow", emoji.Shortcode)
+	suite.Empty(emoji.Domain)
+	suite.Equal("image/png", emoji.ImageContentType)
+
+	category, err := suite.db.GetEmojiCategoryByName(context.Background(), "imported")
+	suite.NoError(err)
+	suite.Equal(category.ID, emoji.CategoryID)
+}
+
+// emojiDir returns a temporary directory containing a copy
+// of the rainbow emoji image under each of the given names.
+func (suite *EmojiPackTestSuite) emojiDir(names ...string) string {
+	b, err := os.ReadFile("../../testrig/media/rainbow-original.png")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dir := suite.T().TempDir()
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), b, 0o600); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+	return dir
+}
+
+func (suite *EmojiPackTestSuite) TestShortcodeFromFilename() {
+	for name, shortcode := range map[string]string{
+		"blobcat.png":        "blobcat",
+		"blob-cat heart.gif": "blob_cat_heart",
+		"--ablobcat--.png":   "ablobcat",
+		"neko.tar.svg":       "neko_tar",
+		"ü.png":              "",
+		"a_very_long_file_name_that_goes_on_and_on.png": "a_very_long_file_name_that_goe",
+	} {
+		suite.Equal(shortcode, emojipack.ShortcodeFromFilename(name), name)
+	}
+}
+
+func (suite *EmojiPackTestSuite) TestImportDir() {
+	dir := suite.emojiDir("blob-cat.png", "blob_cat.png", "rainbow.png", "x.png", "notes.txt")
+
+	results, err := emojipack.NewImporter(suite.db, suite.mediaManager).ImportDir(context.Background(), dir, "blobs", emojipack.OnConflictSkip, false)
+	suite.NoError(err)
+	suite.Len(results, 4)
+
+	// results are in file name order, and the text file is ignored
+	suite.Equal("blob-cat.png", results[0].File)
+	suite.Equal("blob_cat", results[0].Shortcode)
+	suite.Equal(emojipack.DirActionAdd, results[0].Action)
+	suite.NotNil(results[0].Emoji)
+
+	// same shortcode as the previous file
+	suite.Equal(emojipack.DirActionSkip, results[1].Action)
+	suite.Nil(results[1].Emoji)
+
+	// already used by an existing emoji
+	suite.Equal("rainbow", results[2].Shortcode)
+	suite.Equal(emojipack.DirActionSkip, results[2].Action)
+
+	// too short to be a valid shortcode
+	suite.Equal(emojipack.DirActionSkip, results[3].Action)
+
+	emoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "blob_cat", "")
+	suite.NoError(err)
+	category, err := suite.db.GetEmojiCategoryByName(context.Background(), "blobs")
+	suite.NoError(err)
+	suite.Equal(category.ID, emoji.CategoryID)
+}
+
+func (suite *EmojiPackTestSuite) TestImportDirRename() {
+	dir := suite.emojiDir("rainbow.png", "rainbow.gif")
+
+	results, err := emojipack.NewImporter(suite.db, suite.mediaManager).ImportDir(context.Background(), dir, "", emojipack.OnConflictRename, false)
+	suite.NoError(err)
+	suite.Len(results, 2)
+
+	suite.Equal("rainbow_2", results[0].Shortcode)
+	suite.Equal(emojipack.DirActionAdd, results[0].Action)
+	suite.Equal("rainbow_3", results[1].Shortcode)
+	suite.Equal(emojipack.DirActionAdd, results[1].Action)
+}
+
+func (suite *EmojiPackTestSuite) TestImportDirReplace() {
+	dir := suite.emojiDir("rainbow.png")
+	existing := suite.testEmojis["rainbow"]
+
+	results, err := emojipack.NewImporter(suite.db, suite.mediaManager).ImportDir(context.Background(), dir, "", emojipack.OnConflictReplace, false)
+	suite.NoError(err)
+	suite.Len(results, 1)
+
+	suite.Equal(emojipack.DirActionReplace, results[0].Action)
+	suite.Equal(existing.ID, results[0].Emoji.ID)
+	suite.NotEqual(existing.ImagePath, results[0].Emoji.ImagePath)
+}
+
+func (suite *EmojiPackTestSuite) TestImportDirDryRun() {
+	dir := suite.emojiDir("blobcat.png")
+
+	results, err := emojipack.NewImporter(suite.db, suite.mediaManager).ImportDir(context.Background(), dir, "blobs", emojipack.OnConflictSkip, true)
+	suite.NoError(err)
+	suite.Len(results, 1)
+	suite.Equal(emojipack.DirActionAdd, results[0].Action)
+	suite.Nil(results[0].Emoji)
+
+	// nothing should have been created
+	_, err = suite.db.GetEmojiByShortcodeDomain(context.Background(), "blobcat", "")
+	suite.ErrorIs(err, db.ErrNoEntries)
+	_, err = suite.db.GetEmojiCategoryByName(context.Background(), "blobs")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestEmojiPackTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiPackTestSuite{})
+}
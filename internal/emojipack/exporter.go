@@ -0,0 +1,132 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emojipack
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+)
+
+// Exporter wraps functionality for exporting local emojis as an emoji pack.
+type Exporter interface {
+	// Export writes the enabled local emojis of this instance to w as a Pleroma/Akkoma-style
+	// emoji pack archive (pack.json + images). If category is set, only emojis in the emoji
+	// category with that name are exported.
+	//
+	// The number of exported emojis is returned.
+	Export(ctx context.Context, w io.Writer, category string) (int, error)
+}
+
+type exporter struct {
+	db      db.DB
+	storage storage.Driver
+}
+
+// NewExporter returns a new Exporter that will use the given db and storage.
+func NewExporter(db db.DB, storage storage.Driver) Exporter {
+	return &exporter{
+		db:      db,
+		storage: storage,
+	}
+}
+
+func (e *exporter) Export(ctx context.Context, w io.Writer, category string) (int, error) {
+	var categoryID string
+	if category != "" {
+		c, err := e.db.GetEmojiCategoryByName(ctx, category)
+		if err != nil {
+			return 0, fmt.Errorf("Export: error getting emoji category %s: %w", category, err)
+		}
+		categoryID = c.ID
+	}
+
+	emojis, err := e.db.GetEmojis(ctx, "", false, true, "", "", "", 0)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return 0, fmt.Errorf("Export: error getting local emojis: %s", err)
+	}
+
+	pack := &Pack{
+		Files: make(map[string]string, len(emojis)),
+		Pack: Meta{
+			Description: fmt.Sprintf("Emojis exported from %s", config.GetHost()),
+			ShareFiles:  true,
+		},
+	}
+
+	archive := zip.NewWriter(w)
+	for _, emoji := range emojis {
+		if categoryID != "" && emoji.CategoryID != categoryID {
+			continue
+		}
+
+		name := emoji.Shortcode + path.Ext(emoji.ImagePath)
+		if err := e.writeFile(ctx, archive, name, emoji.ImagePath); err != nil {
+			return 0, fmt.Errorf("Export: error writing emoji %s: %s", emoji.Shortcode, err)
+		}
+
+		pack.Files[emoji.Shortcode] = name
+	}
+	pack.FilesCount = len(pack.Files)
+
+	manifest, err := json.MarshalIndent(pack, "", "  ")
+	if err != nil {
+		return 0, fmt.Errorf("Export: error encoding %s: %s", ManifestName, err)
+	}
+
+	fw, err := archive.Create(ManifestName)
+	if err != nil {
+		return 0, fmt.Errorf("Export: error creating %s: %s", ManifestName, err)
+	}
+
+	if _, err := fw.Write(manifest); err != nil {
+		return 0, fmt.Errorf("Export: error writing %s: %s", ManifestName, err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return 0, fmt.Errorf("Export: error closing archive: %s", err)
+	}
+
+	return pack.FilesCount, nil
+}
+
+// writeFile copies the file at storagePath into the archive under the given name.
+func (e *exporter) writeFile(ctx context.Context, archive *zip.Writer, name string, storagePath string) error {
+	rc, err := e.storage.GetStream(ctx, storagePath)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	fw, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, rc)
+	return err
+}
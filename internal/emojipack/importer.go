@@ -0,0 +1,156 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emojipack
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// Importer wraps functionality for importing emoji packs as local emojis.
+type Importer interface {
+	// Import reads a Pleroma/Akkoma-style emoji pack archive (pack.json + images) of the given
+	// size from r, and creates a local emoji for every file listed in its manifest.
+	//
+	// If category is set, the new emojis are put in the emoji category with that name, which
+	// will be created if it doesn't exist yet. Shortcodes which are invalid or already in use
+	// on this instance, and files which can't be processed as emojis, are skipped.
+	//
+	// The successfully imported emojis are returned.
+	Import(ctx context.Context, r io.ReaderAt, size int64, category string) ([]*gtsmodel.Emoji, error)
+
+	// ImportDir creates a local emoji for every png, gif or svg file directly inside dir, deriving
+	// each shortcode from the file name with ShortcodeFromFilename. Category works as for Import.
+	//
+	// onConflict decides what happens to a file whose shortcode is already used by a local emoji,
+	// or by an earlier file in dir. If dryRun is true, nothing is created or changed, but the
+	// returned results still describe what would have been done with each file.
+	ImportDir(ctx context.Context, dir string, category string, onConflict OnConflict, dryRun bool) ([]*DirResult, error)
+}
+
+type importer struct {
+	db           db.DB
+	mediaManager media.Manager
+}
+
+// NewImporter returns a new Importer that will use the given db and media manager.
+func NewImporter(db db.DB, mediaManager media.Manager) Importer {
+	return &importer{
+		db:           db,
+		mediaManager: mediaManager,
+	}
+}
+
+func (i *importer) Import(ctx context.Context, r io.ReaderAt, size int64, category string) ([]*gtsmodel.Emoji, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("Import: error reading archive: %s", err)
+	}
+
+	dir, pack, err := readManifest(archive)
+	if err != nil {
+		return nil, fmt.Errorf("Import: %s", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	var ai *media.AdditionalEmojiInfo
+	if category != "" {
+		c, err := getOrCreateCategory(ctx, i.db, category)
+		if err != nil {
+			return nil, fmt.Errorf("Import: %s", err)
+		}
+		ai = &media.AdditionalEmojiInfo{
+			CategoryID: &c.ID,
+		}
+	}
+
+	// import in a stable order so that reruns behave the same
+	shortcodes := make([]string, 0, len(pack.Files))
+	for shortcode := range pack.Files {
+		shortcodes = append(shortcodes, shortcode)
+	}
+	sort.Strings(shortcodes)
+
+	emojis := make([]*gtsmodel.Emoji, 0, len(shortcodes))
+	for _, shortcode := range shortcodes {
+		if err := validate.EmojiShortcode(shortcode); err != nil {
+			log.Warnf("Import: skipping emoji: %s", err)
+			continue
+		}
+
+		if _, err := i.db.GetEmojiByShortcodeDomain(ctx, shortcode, ""); err == nil {
+			log.Infof("Import: skipping emoji %s: an emoji with this shortcode already exists", shortcode)
+			continue
+		} else if !errors.Is(err, db.ErrNoEntries) {
+			return emojis, fmt.Errorf("Import: error checking existence of emoji %s: %s", shortcode, err)
+		}
+
+		f, ok := files[path.Join(dir, pack.Files[shortcode])]
+		if !ok {
+			log.Warnf("Import: skipping emoji %s: file %s not found in archive", shortcode, pack.Files[shortcode])
+			continue
+		}
+
+		emoji, err := i.importEmoji(ctx, shortcode, f, ai)
+		if err != nil {
+			log.Warnf("Import: skipping emoji %s: %s", shortcode, err)
+			continue
+		}
+
+		emojis = append(emojis, emoji)
+	}
+
+	return emojis, nil
+}
+
+func (i *importer) importEmoji(ctx context.Context, shortcode string, f *zip.File, ai *media.AdditionalEmojiInfo) (*gtsmodel.Emoji, error) {
+	emojiID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, fmt.Errorf("error creating id for new emoji: %s", err)
+	}
+
+	data := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
+		rc, err := f.Open()
+		return rc, int64(f.UncompressedSize64), err
+	}
+
+	processingEmoji, err := i.mediaManager.ProcessEmoji(ctx, data, nil, shortcode, emojiID, uris.GenerateURIForEmoji(emojiID), ai, false)
+	if err != nil {
+		return nil, fmt.Errorf("error processing emoji: %s", err)
+	}
+
+	return processingEmoji.LoadEmoji(ctx)
+}
@@ -0,0 +1,117 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emojipack
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// ManifestName is the name of the manifest file of an emoji pack archive.
+const ManifestName = "pack.json"
+
+// Pack models the manifest of a Pleroma/Akkoma-style emoji pack.
+//
+// See https://docs.pleroma.social/backend/development/API/admin_api/#emoji-packs
+type Pack struct {
+	// Files maps emoji shortcodes to the names of their image files, relative to the manifest.
+	Files map[string]string `json:"files"`
+	// Pack contains metadata about the pack as a whole.
+	Pack Meta `json:"pack"`
+	// FilesCount is the number of entries in Files.
+	FilesCount int `json:"files_count"`
+}
+
+// Meta models the metadata of a Pleroma/Akkoma-style emoji pack.
+type Meta struct {
+	Description string `json:"description,omitempty"`
+	License     string `json:"license,omitempty"`
+	Homepage    string `json:"homepage,omitempty"`
+	ShareFiles  bool   `json:"share-files"`
+}
+
+// readManifest finds and parses the manifest of the given archive. Packs are
+// usually archived with the manifest at the root, but some tools put everything
+// in a single top-level directory, so the least deeply nested manifest is used.
+// The returned dir is the directory of the manifest, which pack files are relative to.
+func readManifest(archive *zip.Reader) (dir string, pack *Pack, err error) {
+	var manifest *zip.File
+	for _, f := range archive.File {
+		if path.Base(f.Name) != ManifestName {
+			continue
+		}
+
+		if manifest == nil || len(path.Dir(f.Name)) < len(path.Dir(manifest.Name)) {
+			manifest = f
+		}
+	}
+
+	if manifest == nil {
+		return "", nil, fmt.Errorf("readManifest: no %s found in archive", ManifestName)
+	}
+
+	rc, err := manifest.Open()
+	if err != nil {
+		return "", nil, fmt.Errorf("readManifest: error opening %s: %s", manifest.Name, err)
+	}
+	defer rc.Close()
+
+	pack = &Pack{}
+	if err := json.NewDecoder(rc).Decode(pack); err != nil {
+		return "", nil, fmt.Errorf("readManifest: error decoding %s: %s", manifest.Name, err)
+	}
+
+	return path.Dir(manifest.Name), pack, nil
+}
+
+// getOrCreateCategory returns the emoji category with the given name, creating it if necessary.
+func getOrCreateCategory(ctx context.Context, dbService db.DB, name string) (*gtsmodel.EmojiCategory, error) {
+	category, err := dbService.GetEmojiCategoryByName(ctx, name)
+	if err == nil {
+		return category, nil
+	}
+
+	if !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("getOrCreateCategory: database error getting emoji category %s: %s", name, err)
+	}
+
+	categoryID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, fmt.Errorf("getOrCreateCategory: error generating id for new emoji category: %s", err)
+	}
+
+	category = &gtsmodel.EmojiCategory{
+		ID:   categoryID,
+		Name: name,
+	}
+
+	if err := dbService.PutEmojiCategory(ctx, category); err != nil {
+		return nil, fmt.Errorf("getOrCreateCategory: error putting new emoji category in the database: %s", err)
+	}
+
+	return category, nil
+}
@@ -25,6 +25,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -114,7 +115,7 @@ func getPublicKeyFromResponse(c context.Context, b []byte, keyID *url.URL) (voca
 //
 // Also note that this function *does not* dereference the remote account that the signature key is associated with.
 // Other functions should use the returned URL to dereference the remote account, if required.
-func (f *federator) AuthenticateFederatedRequest(ctx context.Context, requestedUsername string) (*url.URL, gtserror.WithCode) {
+func (f *federator) AuthenticateFederatedRequest(ctx context.Context, requestedUsername string) (_ *url.URL, errWithCode gtserror.WithCode) {
 	var publicKey interface{}
 	var pkOwnerURI *url.URL
 	var err error
@@ -167,6 +168,23 @@ func (f *federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 		requestingHost          = requestingPublicKeyID.Host
 	)
 
+	// Keep track of requests from remote hosts that we couldn't
+	// authenticate, so admins can see why a host isn't getting through.
+	defer func() {
+		if errWithCode == nil || errWithCode.Code() != http.StatusUnauthorized ||
+			strings.EqualFold(requestingHost, config.GetHost()) {
+			return
+		}
+
+		f.transportController.FederationErrors().Record(transport.FederationError{
+			Domain:     requestingPublicKeyID.Hostname(),
+			Inbound:    true,
+			Kind:       transport.FederationErrorSignature,
+			StatusCode: errWithCode.Code(),
+			Message:    errWithCode.Error(),
+		})
+	}()
+
 	if host := config.GetHost(); strings.EqualFold(requestingHost, host) {
 		// LOCAL ACCOUNT REQUEST
 		// the request is coming from INSIDE THE HOUSE so skip the remote dereferencing
@@ -310,7 +328,7 @@ func (f *federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 		log.Tracef("authentication for %s NOT PASSED with algorithm %s: %s", pkOwnerURI, algo, err)
 	}
 
-	errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("authentication not passed for public key owner %s; signature value was '%s'", pkOwnerURI, signature))
+	errWithCode = gtserror.NewErrorUnauthorized(fmt.Errorf("authentication not passed for public key owner %s; signature value was '%s'", pkOwnerURI, signature))
 	log.Debug(errWithCode)
 	return nil, errWithCode
 }
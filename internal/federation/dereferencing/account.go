@@ -182,7 +182,7 @@ func (d *deref) GetRemoteAccount(ctx context.Context, params GetRemoteAccountPar
 			// if we didn't already have it, we have dereference it from remote and just...
 			accountable, err = d.dereferenceAccountable(ctx, params.RequestingUsername, params.RemoteAccountID)
 			if err != nil {
-				err = fmt.Errorf("GetRemoteAccount: error dereferencing accountable: %s", err)
+				err = fmt.Errorf("GetRemoteAccount: error dereferencing accountable: %w", err)
 				return
 			}
 
@@ -246,7 +246,7 @@ func (d *deref) GetRemoteAccount(ctx context.Context, params GetRemoteAccountPar
 		if accountable == nil {
 			accountable, err = d.dereferenceAccountable(ctx, params.RequestingUsername, params.RemoteAccountID)
 			if err != nil {
-				err = fmt.Errorf("GetRemoteAccount: error dereferencing accountable: %s", err)
+				err = fmt.Errorf("GetRemoteAccount: error dereferencing accountable: %w", err)
 				return
 			}
 		}
@@ -300,7 +300,7 @@ func (d *deref) GetRemoteAccount(ctx context.Context, params GetRemoteAccountPar
 		if accountable == nil {
 			accountable, err = d.dereferenceAccountable(ctx, params.RequestingUsername, params.RemoteAccountID)
 			if err != nil {
-				err = fmt.Errorf("GetRemoteAccount: error dereferencing accountable: %s", err)
+				err = fmt.Errorf("GetRemoteAccount: error dereferencing accountable: %w", err)
 				return
 			}
 		}
@@ -359,6 +359,10 @@ func (d *deref) dereferenceAccountable(ctx context.Context, username string, rem
 		return nil, fmt.Errorf("DereferenceAccountable: domain %s is blocked", remoteAccountID.Host)
 	}
 
+	if err := d.checkGone(ctx, remoteAccountID); err != nil {
+		return nil, fmt.Errorf("DereferenceAccountable: %s: %w", remoteAccountID.String(), err)
+	}
+
 	transport, err := d.transportController.NewTransportForUsername(ctx, username)
 	if err != nil {
 		return nil, fmt.Errorf("DereferenceAccountable: transport err: %s", err)
@@ -366,7 +370,8 @@ func (d *deref) dereferenceAccountable(ctx context.Context, username string, rem
 
 	b, err := transport.Dereference(ctx, remoteAccountID)
 	if err != nil {
-		return nil, fmt.Errorf("DereferenceAccountable: error deferencing %s: %s", remoteAccountID.String(), err)
+		err = d.handleGone(ctx, remoteAccountID, err)
+		return nil, fmt.Errorf("DereferenceAccountable: error deferencing %s: %w", remoteAccountID.String(), err)
 	}
 
 	m := make(map[string]interface{})
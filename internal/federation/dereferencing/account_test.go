@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -401,6 +402,97 @@ func (suite *AccountTestSuite) TestDereferenceRemoteAccountWithPartial3() {
 	suite.Equal("http://fossbros-anonymous.io/emoji/01GD5HCC2YECT012TK8PAGX4D1", fetchedAccount2.Emojis[0].URI)
 }
 
+func (suite *AccountTestSuite) dereferenceRemoteAccountWithEmoji(emoji *gtsmodel.Emoji) *gtsmodel.Account {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	remoteAccount := suite.testAccounts["remote_account_1"]
+	remoteAccountPartial := &gtsmodel.Account{
+		ID:                    remoteAccount.ID,
+		ActorType:             remoteAccount.ActorType,
+		Language:              remoteAccount.Language,
+		CreatedAt:             remoteAccount.CreatedAt,
+		UpdatedAt:             remoteAccount.UpdatedAt,
+		Username:              remoteAccount.Username,
+		Domain:                remoteAccount.Domain,
+		DisplayName:           remoteAccount.DisplayName,
+		URI:                   remoteAccount.URI,
+		InboxURI:              remoteAccount.URI,
+		SharedInboxURI:        remoteAccount.SharedInboxURI,
+		PublicKeyURI:          remoteAccount.PublicKeyURI,
+		URL:                   remoteAccount.URL,
+		FollowingURI:          remoteAccount.FollowingURI,
+		FollowersURI:          remoteAccount.FollowersURI,
+		OutboxURI:             remoteAccount.OutboxURI,
+		FeaturedCollectionURI: remoteAccount.FeaturedCollectionURI,
+		Emojis:                []*gtsmodel.Emoji{emoji},
+	}
+
+	fetchedAccount, err := suite.dereferencer.GetRemoteAccount(context.Background(), dereferencing.GetRemoteAccountParams{
+		RequestingUsername:    fetchingAccount.Username,
+		RemoteAccountID:       testrig.URLMustParse(remoteAccount.URI),
+		RemoteAccountHost:     remoteAccount.Domain,
+		RemoteAccountUsername: remoteAccount.Username,
+		PartialAccount:        remoteAccountPartial,
+		Blocking:              true,
+	})
+	suite.NoError(err)
+	suite.NotNil(fetchedAccount)
+	return fetchedAccount
+}
+
+func (suite *AccountTestSuite) putEmojiPolicy(domain string, policy gtsmodel.EmojiPolicy) {
+	if err := suite.db.PutDomainEmojiPolicy(context.Background(), &gtsmodel.DomainEmojiPolicy{
+		ID:        "01GK2E8ZQ5JKH6Q9RE9V0A3B5Y",
+		Domain:    domain,
+		Policy:    policy,
+		AccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *AccountTestSuite) TestDereferenceRemoteAccountEmojiPolicyHide() {
+	suite.putEmojiPolicy("fossbros-anonymous.io", gtsmodel.EmojiPolicyHide)
+
+	fetchedAccount := suite.dereferenceRemoteAccountWithEmoji(&gtsmodel.Emoji{
+		URI:             "http://fossbros-anonymous.io/emoji/01GD5HCC2YECT012TK8PAGX4D1",
+		Shortcode:       "kip_van_den_bos",
+		UpdatedAt:       testrig.TimeMustParse("2022-09-13T12:13:12+02:00"),
+		ImageUpdatedAt:  testrig.TimeMustParse("2022-09-13T12:13:12+02:00"),
+		ImageRemoteURL:  "http://fossbros-anonymous.io/emoji/kip.gif",
+		Disabled:        testrig.FalseBool(),
+		VisibleInPicker: testrig.FalseBool(),
+		Domain:          "fossbros-anonymous.io",
+	})
+
+	// the emoji should be stored, but disabled
+	suite.Len(fetchedAccount.Emojis, 1)
+	dbEmoji, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "kip_van_den_bos", "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.True(*dbEmoji.Disabled)
+}
+
+func (suite *AccountTestSuite) TestDereferenceRemoteAccountEmojiPolicyReject() {
+	suite.putEmojiPolicy("fossbros-anonymous.io", gtsmodel.EmojiPolicyReject)
+
+	fetchedAccount := suite.dereferenceRemoteAccountWithEmoji(&gtsmodel.Emoji{
+		URI:             "http://fossbros-anonymous.io/emoji/01GD5HCC2YECT012TK8PAGX4D1",
+		Shortcode:       "kip_van_den_bos",
+		UpdatedAt:       testrig.TimeMustParse("2022-09-13T12:13:12+02:00"),
+		ImageUpdatedAt:  testrig.TimeMustParse("2022-09-13T12:13:12+02:00"),
+		ImageRemoteURL:  "http://fossbros-anonymous.io/emoji/kip.gif",
+		Disabled:        testrig.FalseBool(),
+		VisibleInPicker: testrig.FalseBool(),
+		Domain:          "fossbros-anonymous.io",
+	})
+
+	// the emoji should be neither attached nor stored
+	suite.Empty(fetchedAccount.EmojiIDs)
+	suite.Empty(fetchedAccount.Emojis)
+	_, err := suite.db.GetEmojiByShortcodeDomain(context.Background(), "kip_van_den_bos", "fossbros-anonymous.io")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
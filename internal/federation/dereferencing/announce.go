@@ -70,6 +70,10 @@ func (d *deref) DereferenceAnnounce(ctx context.Context, announce *gtsmodel.Stat
 		boostedStatus = status
 	}
 
+	if err := d.checkAnnounceAllowed(ctx, announce, boostedStatus); err != nil {
+		return fmt.Errorf("DereferenceAnnounce: %w", err)
+	}
+
 	announce.Content = boostedStatus.Content
 	announce.ContentWarning = boostedStatus.ContentWarning
 	announce.ActivityStreamsType = boostedStatus.ActivityStreamsType
@@ -87,3 +91,62 @@ func (d *deref) DereferenceAnnounce(ctx context.Context, announce *gtsmodel.Stat
 
 	return nil
 }
+
+// checkAnnounceAllowed checks whether the given announce of boostedStatus respects
+// the boost policy of boostedStatus, returning an error if it does not.
+//
+// Authors can always announce their own non-direct statuses. Public and unlisted
+// statuses can be announced by anyone, as long as they're boostable. Followers-only
+// statuses can only be announced by followers of the author, and only if the author
+// has allowed it; in that case the announce must be addressed to followers only, so
+// that the status isn't shown to a wider audience than the author intended.
+func (d *deref) checkAnnounceAllowed(ctx context.Context, announce *gtsmodel.Status, boostedStatus *gtsmodel.Status) error {
+	if boostedStatus.Visibility == gtsmodel.VisibilityDirect {
+		return fmt.Errorf("status %s is a direct message and cannot be announced", boostedStatus.URI)
+	}
+
+	if announce.AccountID == boostedStatus.AccountID {
+		// self-boosts are fine
+		return nil
+	}
+
+	if boostedStatus.Boostable != nil && !*boostedStatus.Boostable {
+		return fmt.Errorf("status %s is not boostable", boostedStatus.URI)
+	}
+
+	switch boostedStatus.Visibility {
+	case gtsmodel.VisibilityPublic, gtsmodel.VisibilityUnlocked:
+		return nil
+	case gtsmodel.VisibilityFollowersOnly:
+		// handled below
+	default:
+		return fmt.Errorf("status %s with visibility %s cannot be announced by account %s", boostedStatus.URI, boostedStatus.Visibility, announce.AccountURI)
+	}
+
+	if announce.Visibility != gtsmodel.VisibilityFollowersOnly {
+		return fmt.Errorf("followers-only status %s was announced with wider visibility %s", boostedStatus.URI, announce.Visibility)
+	}
+
+	if announce.Account == nil {
+		return fmt.Errorf("couldn't check whether account %s follows the author of followers-only status %s", announce.AccountURI, boostedStatus.URI)
+	}
+
+	if boostedStatus.Account == nil {
+		author, err := d.db.GetAccountByID(ctx, boostedStatus.AccountID)
+		if err != nil {
+			return fmt.Errorf("error getting author of status %s: %w", boostedStatus.URI, err)
+		}
+		boostedStatus.Account = author
+	}
+
+	follows, err := d.db.IsFollowing(ctx, announce.Account, boostedStatus.Account)
+	if err != nil {
+		return fmt.Errorf("error checking follow for announce of %s: %w", boostedStatus.URI, err)
+	}
+
+	if !follows {
+		return fmt.Errorf("account %s does not follow the author of followers-only status %s", announce.AccountURI, boostedStatus.URI)
+	}
+
+	return nil
+}
@@ -22,13 +22,16 @@ import (
 	"context"
 	"net/url"
 	"sync"
+	"time"
 
+	"codeberg.org/gruf/go-cache/v2"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 )
 
 // Dereferencer wraps logic and functionality for doing dereferencing of remote accounts, statuses, etc, from federated instances.
@@ -54,6 +57,7 @@ type deref struct {
 	typeConverter            typeutils.TypeConverter
 	transportController      transport.Controller
 	mediaManager             media.Manager
+	filter                   visibility.Filter
 	dereferencingAvatars     map[string]*media.ProcessingMedia
 	dereferencingAvatarsLock *sync.Mutex
 	dereferencingHeaders     map[string]*media.ProcessingMedia
@@ -61,16 +65,22 @@ type deref struct {
 	dereferencingEmojis      map[string]*media.ProcessingEmoji
 	dereferencingEmojisLock  *sync.Mutex
 	handshakes               map[string][]*url.URL
-	handshakeSync            *sync.Mutex // mutex to lock/unlock when checking or updating the handshakes map
+	handshakeSync            *sync.Mutex                   // mutex to lock/unlock when checking or updating the handshakes map
+	unfetchable              cache.Cache[string, struct{}] // uris of thread ancestors which recently couldn't be fetched
 }
 
 // NewDereferencer returns a Dereferencer initialized with the given parameters.
 func NewDereferencer(db db.DB, typeConverter typeutils.TypeConverter, transportController transport.Controller, mediaManager media.Manager) Dereferencer {
+	unfetchable := cache.New[string, struct{}]()
+	unfetchable.SetTTL(unfetchableTTL, false)
+	unfetchable.Start(time.Minute)
+
 	return &deref{
 		db:                       db,
 		typeConverter:            typeConverter,
 		transportController:      transportController,
 		mediaManager:             mediaManager,
+		filter:                   visibility.NewFilter(db),
 		dereferencingAvatars:     make(map[string]*media.ProcessingMedia),
 		dereferencingAvatarsLock: &sync.Mutex{},
 		dereferencingHeaders:     make(map[string]*media.ProcessingMedia),
@@ -78,5 +88,6 @@ func NewDereferencer(db db.DB, typeConverter typeutils.TypeConverter, transportC
 		dereferencingEmojis:      make(map[string]*media.ProcessingEmoji),
 		dereferencingEmojisLock:  &sync.Mutex{},
 		handshakeSync:            &sync.Mutex{},
+		unfetchable:              unfetchable,
 	}
 }
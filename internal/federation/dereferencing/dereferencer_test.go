@@ -41,6 +41,7 @@ type DereferencerStandardTestSuite struct {
 	testRemoteServices    map[string]vocab.ActivityStreamsService
 	testRemoteAttachments map[string]testrig.RemoteAttachmentFile
 	testAccounts          map[string]*gtsmodel.Account
+	testStatuses          map[string]*gtsmodel.Status
 	testEmojis            map[string]*gtsmodel.Emoji
 
 	dereferencer dereferencing.Dereferencer
@@ -51,6 +52,7 @@ func (suite *DereferencerStandardTestSuite) SetupTest() {
 	testrig.InitTestLog()
 
 	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
 	suite.testRemoteStatuses = testrig.NewTestFediStatuses()
 	suite.testRemotePeople = testrig.NewTestFediPeople()
 	suite.testRemoteGroups = testrig.NewTestFediGroups()
@@ -20,6 +20,7 @@ package dereferencing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
@@ -44,6 +45,20 @@ func (d *deref) GetRemoteEmoji(ctx context.Context, requestingUsername string, r
 		// we're already on it, no worries
 		processingEmoji = alreadyProcessing
 	} else {
+		if !refresh {
+			// Another caller may have finished fetching and storing this
+			// emoji in between our caller checking the database and us
+			// taking the lock; if so, there's nothing left to do, and
+			// fetching it again would only fail on insert anyway.
+			if _, err := d.db.GetEmojiByShortcodeDomain(ctx, shortcode, domain); err == nil {
+				d.dereferencingEmojisLock.Unlock()
+				return nil, fmt.Errorf("GetRemoteEmoji: emoji %s already stored: %w", shortcodeDomain, db.ErrAlreadyExists)
+			} else if err != db.ErrNoEntries {
+				d.dereferencingEmojisLock.Unlock()
+				return nil, fmt.Errorf("GetRemoteEmoji: error checking database for emoji %s: %w", shortcodeDomain, err)
+			}
+		}
+
 		// not processing it yet, let's start
 		t, err := d.transportController.NewTransportForUsername(ctx, requestingUsername)
 		if err != nil {
@@ -81,7 +96,11 @@ func (d *deref) GetRemoteEmoji(ctx context.Context, requestingUsername string, r
 
 	cleanup := func() {
 		d.dereferencingEmojisLock.Lock()
-		delete(d.dereferencingHeaders, shortcodeDomain)
+		// only remove the entry if it's still ours, since a new
+		// fetch may have been started for the same emoji since
+		if d.dereferencingEmojis[shortcodeDomain] == processingEmoji {
+			delete(d.dereferencingEmojis, shortcodeDomain)
+		}
 		d.dereferencingEmojisLock.Unlock()
 	}
 
@@ -102,11 +121,22 @@ func (d *deref) populateEmojis(ctx context.Context, rawEmojis []*gtsmodel.Emoji,
 
 	gotEmojis := make([]*gtsmodel.Emoji, 0, len(rawEmojis))
 
+	// emoji policies of the domains seen so far, so that
+	// each domain's policy is only looked up once
+	policies := make(map[string]gtsmodel.EmojiPolicy)
+
 	for _, e := range rawEmojis {
 		var gotEmoji *gtsmodel.Emoji
 		var err error
 		shortcodeDomain := e.Shortcode + "@" + e.Domain
 
+		policy := d.emojiPolicy(ctx, e.Domain, policies)
+		if policy == gtsmodel.EmojiPolicyReject {
+			// leave the shortcode as it is
+			log.Tracef("populateEmojis: emojis from %s are rejected, skipping emoji %s", e.Domain, shortcodeDomain)
+			continue
+		}
+
 		// check if we already know this emoji
 		if e.ID != "" {
 			// we had an ID for this emoji already, which means
@@ -168,21 +198,33 @@ func (d *deref) populateEmojis(ctx context.Context, rawEmojis []*gtsmodel.Emoji,
 				continue
 			}
 
+			disabled := e.Disabled
+			if policy == gtsmodel.EmojiPolicyHide {
+				// store it, but don't show it
+				hidden := true
+				disabled = &hidden
+			}
+
 			processingEmoji, err := d.GetRemoteEmoji(ctx, requestingUsername, e.ImageRemoteURL, e.Shortcode, e.Domain, newEmojiID, e.URI, &media.AdditionalEmojiInfo{
 				Domain:               &e.Domain,
 				ImageRemoteURL:       &e.ImageRemoteURL,
 				ImageStaticRemoteURL: &e.ImageStaticRemoteURL,
-				Disabled:             e.Disabled,
+				Disabled:             disabled,
 				VisibleInPicker:      e.VisibleInPicker,
 			}, refresh)
 
-			if err != nil {
-				log.Errorf("populateEmojis: couldn't get remote emoji %s: %s", shortcodeDomain, err)
-				continue
+			if err == nil {
+				gotEmoji, err = processingEmoji.LoadEmoji(ctx)
 			}
 
-			if gotEmoji, err = processingEmoji.LoadEmoji(ctx); err != nil {
-				log.Errorf("populateEmojis: couldn't load remote emoji %s: %s", shortcodeDomain, err)
+			if errors.Is(err, db.ErrAlreadyExists) {
+				// someone else beat us to storing this
+				// emoji in the meantime, so just use theirs
+				gotEmoji, err = d.db.GetEmojiByShortcodeDomain(ctx, e.Shortcode, e.Domain)
+			}
+
+			if err != nil {
+				log.Errorf("populateEmojis: couldn't get remote emoji %s: %s", shortcodeDomain, err)
 				continue
 			}
 		}
@@ -193,3 +235,28 @@ func (d *deref) populateEmojis(ctx context.Context, rawEmojis []*gtsmodel.Emoji,
 
 	return gotEmojis, nil
 }
+
+// emojiPolicy returns the emoji policy for the given domain, looking it up
+// in the database if it isn't in the given map of policies already.
+func (d *deref) emojiPolicy(ctx context.Context, domain string, policies map[string]gtsmodel.EmojiPolicy) gtsmodel.EmojiPolicy {
+	if domain == "" {
+		// our own emojis are always fine
+		return gtsmodel.EmojiPolicyStore
+	}
+
+	if policy, ok := policies[domain]; ok {
+		return policy
+	}
+
+	policy := gtsmodel.EmojiPolicyStore
+	domainPolicy, err := d.db.GetDomainEmojiPolicy(ctx, domain)
+	switch {
+	case err == nil:
+		policy = domainPolicy.Policy
+	case !errors.Is(err, db.ErrNoEntries):
+		log.Errorf("emojiPolicy: error getting emoji policy for %s: %s", domain, err)
+	}
+
+	policies[domain] = policy
+	return policy
+}
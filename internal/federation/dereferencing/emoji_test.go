@@ -20,10 +20,13 @@ package dereferencing_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 )
 
@@ -90,6 +93,63 @@ func (suite *EmojiTestSuite) TestDereferenceEmojiBlocking() {
 	suite.Len(storedStatic, emoji.ImageStaticFileSize)
 }
 
+func (suite *EmojiTestSuite) TestDereferenceEmojiConcurrent() {
+	ctx := context.Background()
+	fetchingAccount := suite.testAccounts["local_account_1"]
+	emojiImageRemoteURL := "http://example.org/media/emojis/1781772.gif"
+	emojiURI := "http://example.org/emojis/1781772"
+	emojiShortcode := "peglin"
+	emojiDomain := "example.org"
+	emojiIDs := []string{
+		"01GHQ1ZBF3T4RK8Q1WWN6EHJ7N",
+		"01GHQ1ZQJ96R4B4W1RD0CX1TBB",
+		"01GHQ1ZZ8E2T7N0DNRYT2E3RVP",
+		"01GHQ205MSX4G4H3AS8QH0Y0AS",
+	}
+
+	// fetch the same new emoji from several places at once,
+	// as happens when a burst of statuses using it come in
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		loaded = make(map[string]struct{})
+	)
+	for _, emojiID := range emojiIDs {
+		emojiID := emojiID
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ai := &media.AdditionalEmojiInfo{
+				Domain:         &emojiDomain,
+				ImageRemoteURL: &emojiImageRemoteURL,
+			}
+
+			processingEmoji, err := suite.dereferencer.GetRemoteEmoji(ctx, fetchingAccount.Username, emojiImageRemoteURL, emojiShortcode, emojiDomain, emojiID, emojiURI, ai, false)
+			if errors.Is(err, db.ErrAlreadyExists) {
+				// someone else already finished it
+				return
+			}
+			suite.NoError(err)
+
+			emoji, err := processingEmoji.LoadEmoji(ctx)
+			suite.NoError(err)
+
+			mu.Lock()
+			loaded[emoji.ID] = struct{}{}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	// every caller that got an emoji should have got the same one
+	suite.Len(loaded, 1)
+
+	emoji, err := suite.db.GetEmojiByShortcodeDomain(ctx, emojiShortcode, emojiDomain)
+	suite.NoError(err)
+	suite.Contains(loaded, emoji.ID)
+}
+
 func TestEmojiTestSuite(t *testing.T) {
 	suite.Run(t, new(EmojiTestSuite))
 }
@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dereferencing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+)
+
+// ErrGone is returned when trying to dereference a remote AP Actor or Object
+// which we already know to be gone, or which just responded with 410 GONE.
+var ErrGone = errors.New("remote resource is gone")
+
+// checkGone returns ErrGone if a tombstone exists for the given uri.
+func (d *deref) checkGone(ctx context.Context, uri *url.URL) error {
+	gone, err := d.db.TombstoneExistsWithURI(ctx, uri.String())
+	if err != nil {
+		return fmt.Errorf("checkGone: db error checking tombstone for %s: %w", uri, err)
+	}
+
+	if gone {
+		return ErrGone
+	}
+
+	return nil
+}
+
+// GoneTombstoneTTL is how long a tombstone put because a remote server responded
+// 410 GONE lasts for. Servers also respond 410 for things which may come back, like
+// suspended accounts, so unlike tombstones for deletes, these don't last forever.
+const GoneTombstoneTTL = 24 * time.Hour
+
+// handleGone inspects the given dereference error. If it indicates that the
+// remote resource is gone, a tombstone is put in the database for the uri so
+// that we don't keep trying to dereference it for a while, and ErrGone is
+// returned. Otherwise, the original error is returned unchanged.
+func (d *deref) handleGone(ctx context.Context, uri *url.URL, derefErr error) error {
+	if !errors.Is(derefErr, transport.ErrGone) {
+		return derefErr
+	}
+
+	if err := d.db.PutTombstoneForURI(ctx, uri, time.Now().Add(GoneTombstoneTTL)); err != nil {
+		return fmt.Errorf("handleGone: db error putting tombstone for %s: %w", uri, err)
+	}
+
+	return ErrGone
+}
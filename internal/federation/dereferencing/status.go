@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -68,7 +69,7 @@ func (d *deref) GetRemoteStatus(ctx context.Context, username string, remoteStat
 
 	statusable, err := d.dereferenceStatusable(ctx, username, remoteStatusID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("GetRemoteStatus: error dereferencing statusable: %s", err)
+		return nil, nil, fmt.Errorf("GetRemoteStatus: error dereferencing statusable: %w", err)
 	}
 
 	if maybeStatus != nil && refetch {
@@ -117,6 +118,10 @@ func (d *deref) dereferenceStatusable(ctx context.Context, username string, remo
 		return nil, fmt.Errorf("DereferenceStatusable: domain %s is blocked", remoteStatusID.Host)
 	}
 
+	if err := d.checkGone(ctx, remoteStatusID); err != nil {
+		return nil, fmt.Errorf("DereferenceStatusable: %s: %w", remoteStatusID.String(), err)
+	}
+
 	transport, err := d.transportController.NewTransportForUsername(ctx, username)
 	if err != nil {
 		return nil, fmt.Errorf("DereferenceStatusable: transport err: %s", err)
@@ -124,7 +129,8 @@ func (d *deref) dereferenceStatusable(ctx context.Context, username string, remo
 
 	b, err := transport.Dereference(ctx, remoteStatusID)
 	if err != nil {
-		return nil, fmt.Errorf("DereferenceStatusable: error deferencing %s: %s", remoteStatusID.String(), err)
+		err = d.handleGone(ctx, remoteStatusID, err)
+		return nil, fmt.Errorf("DereferenceStatusable: error deferencing %s: %w", remoteStatusID.String(), err)
 	}
 
 	m := make(map[string]interface{})
@@ -169,6 +175,12 @@ func (d *deref) dereferenceStatusable(ctx context.Context, username string, remo
 			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsNote")
 		}
 		return p, nil
+	case ap.ActivityQuestion:
+		p, ok := t.(vocab.ActivityStreamsQuestion)
+		if !ok {
+			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsQuestion")
+		}
+		return p, nil
 	case ap.ObjectPage:
 		p, ok := t.(vocab.ActivityStreamsPage)
 		if !ok {
@@ -328,6 +340,18 @@ func (d *deref) populateStatusMentions(ctx context.Context, status *gtsmodel.Sta
 			continue
 		}
 
+		if targetAccount.Domain == "" {
+			// don't store mentions that the mentioned local account doesn't permit
+			permitted, err := d.mentionPermitted(ctx, status, targetAccount)
+			if err != nil {
+				return fmt.Errorf("populateStatusMentions: error checking mention policy of account %s: %s", targetAccount.ID, err)
+			}
+			if !permitted {
+				log.Debugf("populateStatusMentions: account %s doesn't permit being mentioned by %s", targetAccount.ID, status.AccountID)
+				continue
+			}
+		}
+
 		mID, err := id.NewRandomULID()
 		if err != nil {
 			return fmt.Errorf("populateStatusMentions: error generating ulid: %s", err)
@@ -363,6 +387,20 @@ func (d *deref) populateStatusMentions(ctx context.Context, status *gtsmodel.Sta
 	return nil
 }
 
+// mentionPermitted returns true if the mention policy of the given
+// target account permits the author of the given status to mention it.
+func (d *deref) mentionPermitted(ctx context.Context, status *gtsmodel.Status, targetAccount *gtsmodel.Account) (bool, error) {
+	if status.Account == nil {
+		a, err := d.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return false, fmt.Errorf("error getting author account with id %s: %s", status.AccountID, err)
+		}
+		status.Account = a
+	}
+
+	return d.filter.MentionPermitted(ctx, status.Account, targetAccount)
+}
+
 func (d *deref) populateStatusAttachments(ctx context.Context, status *gtsmodel.Status, requestingUsername string) error {
 	// At this point we should know:
 	// * the media type of the file we're looking for (a.File.ContentType)
@@ -421,6 +459,10 @@ func (d *deref) populateStatusEmojis(ctx context.Context, status *gtsmodel.Statu
 	return nil
 }
 
+// populateStatusRepliedTo fetches the status that the given status replies to, if we don't have
+// it already, along with a few of its ancestors so that the conversation has some context. If the
+// replied-to status can't be fetched, eg., because it's been deleted or its server is down, the
+// status is left as an orphan reply, since it's still worth having.
 func (d *deref) populateStatusRepliedTo(ctx context.Context, status *gtsmodel.Status, requestingUsername string) error {
 	if status.InReplyToURI != "" && status.InReplyToID == "" {
 		statusURI, err := url.Parse(status.InReplyToURI)
@@ -428,9 +470,10 @@ func (d *deref) populateStatusRepliedTo(ctx context.Context, status *gtsmodel.St
 			return err
 		}
 
-		replyToStatus, _, err := d.GetRemoteStatus(ctx, requestingUsername, statusURI, false, false)
+		replyToStatus, err := d.fetchAncestor(ctx, requestingUsername, statusURI)
 		if err != nil {
-			return fmt.Errorf("populateStatusRepliedTo: couldn't get reply to status with uri %s: %s", status.InReplyToURI, err)
+			log.Debugf("populateStatusRepliedTo: couldn't get reply to status with uri %s, leaving status %s as an orphan: %s", status.InReplyToURI, status.URI, err)
+			return nil
 		}
 
 		// we have the status
@@ -438,6 +481,14 @@ func (d *deref) populateStatusRepliedTo(ctx context.Context, status *gtsmodel.St
 		status.InReplyTo = replyToStatus
 		status.InReplyToAccountID = replyToStatus.AccountID
 		status.InReplyToAccount = replyToStatus.Account
+
+		// get some of the conversation leading up to it, too; this
+		// is best-effort, since the reply itself is what matters
+		if maxAncestors := config.GetAdvancedThreadReplyAncestors(); maxAncestors > 0 {
+			if err := d.dereferenceStatusAncestors(ctx, requestingUsername, replyToStatus, maxAncestors); err != nil {
+				log.Debugf("populateStatusRepliedTo: couldn't get all ancestors of status %s: %s", replyToStatus.URI, err)
+			}
+		}
 	}
 
 	return nil
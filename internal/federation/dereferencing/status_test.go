@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -169,6 +170,70 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithImageAndNoContent() {
 	suite.NoError(err)
 }
 
+func (suite *StatusTestSuite) TestDereferenceStatusGone() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	statusURL := testrig.URLMustParse("https://unknown-instance.com/users/brand_new_person/statuses/01FE4NTHKWW7THT67EF10EB839")
+	err := suite.db.PutTombstone(context.Background(), &gtsmodel.Tombstone{
+		ID:     "01GKWJ8M6X2Q1B9JW7VZTTJ3KN",
+		Domain: statusURL.Host,
+		URI:    statusURL.String(),
+	})
+	suite.NoError(err)
+
+	// we shouldn't even try to dereference a status that we know is gone
+	status, _, err := suite.dereferencer.GetRemoteStatus(context.Background(), fetchingAccount.Username, statusURL, false, false)
+	suite.ErrorIs(err, dereferencing.ErrGone)
+	suite.Nil(status)
+
+	// status should not be in the database
+	_, err = suite.db.GetStatusByURI(context.Background(), statusURL.String())
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *StatusTestSuite) TestEnrichReplyFetchesParent() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+	parentURI := "https://unknown-instance.com/users/brand_new_person/statuses/01FE4NTHKWW7THT67EF10EB839"
+
+	reply := &gtsmodel.Status{}
+	*reply = *suite.testStatuses["remote_account_1_status_1"]
+	reply.InReplyToURI = parentURI
+
+	reply, err := suite.dereferencer.EnrichRemoteStatus(context.Background(), fetchingAccount.Username, reply, true)
+	suite.NoError(err)
+
+	// the parent should have been fetched before the reply was stored
+	parent, err := suite.db.GetStatusByURI(context.Background(), parentURI)
+	suite.NoError(err)
+	suite.Equal(parent.ID, reply.InReplyToID)
+	suite.Equal(parent.AccountID, reply.InReplyToAccountID)
+
+	dbReply, err := suite.db.GetStatusByID(context.Background(), reply.ID)
+	suite.NoError(err)
+	suite.Equal(parent.ID, dbReply.InReplyToID)
+}
+
+func (suite *StatusTestSuite) TestEnrichOrphanReply() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+	parentURI := "https://unknown-instance.com/users/brand_new_person/statuses/01GKX0V4T4RZ3HZ9CJ0M2S8NQX"
+
+	for i := 0; i < 2; i++ {
+		reply := &gtsmodel.Status{}
+		*reply = *suite.testStatuses["remote_account_1_status_1"]
+		reply.InReplyToURI = parentURI
+
+		// the parent can't be fetched, but the reply is still worth having;
+		// the second time round, the parent isn't even tried again
+		reply, err := suite.dereferencer.EnrichRemoteStatus(context.Background(), fetchingAccount.Username, reply, true)
+		suite.NoError(err)
+		suite.Empty(reply.InReplyToID)
+		suite.Equal(parentURI, reply.InReplyToURI)
+	}
+
+	_, err := suite.db.GetStatusByURI(context.Background(), parentURI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
@@ -20,8 +20,10 @@ package dereferencing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"github.com/superseriousbusiness/activity/streams/vocab"
@@ -34,8 +36,18 @@ import (
 
 // maxIter defines how many iterations of descendants or
 // ancesters we are willing to follow before returning error.
+//
+// This is a hard backstop; the configured thread max depth
+// and max replies will usually stop us well before this.
 const maxIter = 1000
 
+// unfetchableTTL is how long a thread ancestor which couldn't be
+// fetched is remembered, so that replies to it don't keep retrying.
+const unfetchableTTL = 30 * time.Minute
+
+// errUnfetchable is returned when a thread ancestor recently couldn't be fetched.
+var errUnfetchable = errors.New("recently failed to fetch status")
+
 // DereferenceThread takes a statusable (something that has withReplies and withInReplyTo),
 // and dereferences statusables in the conversation.
 //
@@ -54,7 +66,7 @@ func (d *deref) DereferenceThread(ctx context.Context, username string, statusIR
 	l.Trace("beginning")
 
 	// Ensure that ancestors have been fully dereferenced
-	if err := d.dereferenceStatusAncestors(ctx, username, status); err != nil {
+	if err := d.dereferenceStatusAncestors(ctx, username, status, config.GetAdvancedThreadMaxDepth()); err != nil {
 		l.Errorf("error dereferencing status ancestors: %v", err)
 		// we don't return error, we have deref'd as much as we can
 	}
@@ -67,7 +79,9 @@ func (d *deref) DereferenceThread(ctx context.Context, username string, statusIR
 }
 
 // dereferenceAncestors has the goal of reaching the oldest ancestor of a given status, and stashing all statuses along the way.
-func (d *deref) dereferenceStatusAncestors(ctx context.Context, username string, status *gtsmodel.Status) error {
+// At most maxDepth ancestors are followed, or as many as it takes if maxDepth is 0 or less. Each status along the way that
+// was stored without a link to its parent is linked to it, so that the thread can be shown with its context.
+func (d *deref) dereferenceStatusAncestors(ctx context.Context, username string, status *gtsmodel.Status, maxDepth int) error {
 	// Take ref to original
 	ogIRI := status.URI
 
@@ -80,12 +94,29 @@ func (d *deref) dereferenceStatusAncestors(ctx context.Context, username string,
 	// Log function start
 	l.Trace("beginning")
 
+	// uris of the statuses we've been through,
+	// in case the thread loops back on itself
+	seen := map[string]struct{}{ogIRI: {}}
+
 	for i := 0; i < maxIter; i++ {
 		if status.InReplyToURI == "" {
 			// status doesn't reply to anything
 			return nil
 		}
 
+		if maxDepth > 0 && i >= maxDepth {
+			// we've gone as far up as we're configured to
+			l.Debugf("reached max thread depth of %d ancestors", maxDepth)
+			return nil
+		}
+
+		if _, ok := seen[status.InReplyToURI]; ok {
+			return fmt.Errorf("thread loops back on itself at %q", status.InReplyToURI)
+		}
+		seen[status.InReplyToURI] = struct{}{}
+
+		var parent *gtsmodel.Status
+
 		// Parse this status's replied IRI
 		replyIRI, err := url.Parse(status.InReplyToURI)
 		if err != nil {
@@ -107,26 +138,69 @@ func (d *deref) dereferenceStatusAncestors(ctx context.Context, username string,
 				return fmt.Errorf("error fetching local status %q: %w", id, err)
 			}
 
-			// Set the fetched status
-			status = localStatus
+			parent = localStatus
 
 		} else {
 			l.Tracef("following remote status ancestors: %s", status.InReplyToURI)
 
 			// Fetch the remote status found at this IRI
-			remoteStatus, _, err := d.GetRemoteStatus(ctx, username, replyIRI, false, false)
+			remoteStatus, err := d.fetchAncestor(ctx, username, replyIRI)
 			if err != nil {
 				return fmt.Errorf("error fetching remote status %q: %w", status.InReplyToURI, err)
 			}
 
-			// Set the fetched status
-			status = remoteStatus
+			parent = remoteStatus
 		}
+
+		if status.InReplyToID == "" {
+			// Status was stored without its parent, link them now
+			if err := d.linkParent(ctx, status, parent); err != nil {
+				return err
+			}
+		}
+
+		// Set the fetched status
+		status = parent
 	}
 
 	return fmt.Errorf("reached %d ancestor iterations for %q", maxIter, ogIRI)
 }
 
+// fetchAncestor gets the remote status at the given uri, which is an ancestor of a status in a thread,
+// from the database or by dereferencing it. If dereferencing it fails, it won't be tried again for
+// unfetchableTTL, so that a thread of replies to a status which can't be fetched doesn't retry each time.
+func (d *deref) fetchAncestor(ctx context.Context, username string, uri *url.URL) (*gtsmodel.Status, error) {
+	uriStr := uri.String()
+	if d.unfetchable.Has(uriStr) {
+		return nil, errUnfetchable
+	}
+
+	status, _, err := d.GetRemoteStatus(ctx, username, uri, false, false)
+	if err != nil {
+		if ctx.Err() == nil {
+			// only remember failures that weren't our doing
+			d.unfetchable.Set(uriStr, struct{}{})
+		}
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// linkParent sets the parent of the given status, which was stored without it, and updates it in the database.
+func (d *deref) linkParent(ctx context.Context, status *gtsmodel.Status, parent *gtsmodel.Status) error {
+	status.InReplyToID = parent.ID
+	status.InReplyTo = parent
+	status.InReplyToAccountID = parent.AccountID
+	status.InReplyToAccount = parent.Account
+
+	if _, err := d.db.UpdateStatus(ctx, status); err != nil {
+		return fmt.Errorf("error linking status %q to its parent: %w", status.URI, err)
+	}
+
+	return nil
+}
+
 func (d *deref) dereferenceStatusDescendants(ctx context.Context, username string, statusIRI *url.URL, parent ap.Statusable) error {
 	// Take ref to original
 	ogIRI := statusIRI
@@ -146,14 +220,23 @@ func (d *deref) dereferenceStatusDescendants(ctx context.Context, username strin
 	// descend, page is the current activity streams collection
 	// page of entities we are on (as we often push a frame to
 	// stack mid-paging), and item___ are entity iterators for
-	// this activity streams collection page.
+	// this activity streams collection page. depth is how
+	// far below the original status this status is, and
+	// replies is how many of its replies we've dereferenced.
 	type frame struct {
 		statusIRI  *url.URL
 		statusable ap.Statusable
 		page       ap.CollectionPageable
 		itemIter   vocab.ActivityStreamsItemsPropertyIterator
+		depth      int
+		replies    int
 	}
 
+	var (
+		maxDepth   = config.GetAdvancedThreadMaxDepth()
+		maxReplies = config.GetAdvancedThreadMaxReplies()
+	)
+
 	var (
 		// current is the current stack frame
 		current *frame
@@ -202,6 +285,12 @@ stackLoop:
 				continue stackLoop
 			}
 
+			// We've gone as far down as we're configured to
+			if maxDepth > 0 && current.depth >= maxDepth {
+				l.Debugf("reached max thread depth of %d descendants at %s", maxDepth, current.statusIRI)
+				continue stackLoop
+			}
+
 			l.Tracef("following remote status descendants: %s", current.statusIRI)
 
 			// Look for an attached status replies (as collection)
@@ -249,6 +338,12 @@ stackLoop:
 			for {
 				var itemIRI *url.URL
 
+				// We've fetched as many replies to this status as we're configured to
+				if maxReplies > 0 && current.replies >= maxReplies {
+					l.Debugf("reached max of %d thread replies for %s", maxReplies, current.statusIRI)
+					continue stackLoop
+				}
+
 				// Get next item iterator object
 				current.itemIter = current.itemIter.Next()
 				if current.itemIter == nil {
@@ -263,6 +358,11 @@ stackLoop:
 					if id := note.GetJSONLDId(); id != nil {
 						itemIRI = id.GetIRI()
 					}
+				} else if question := current.itemIter.GetActivityStreamsQuestion(); question != nil {
+					// Item is a question, fetch the question ID IRI
+					if id := question.GetJSONLDId(); id != nil {
+						itemIRI = id.GetIRI()
+					}
 				}
 
 				if itemIRI == nil {
@@ -282,10 +382,14 @@ stackLoop:
 					continue itemLoop
 				}
 
+				// Count this reply against its parent
+				current.replies++
+
 				// Put current and next frame at top of stack
 				stack = append(stack, current, &frame{
 					statusIRI:  itemIRI,
 					statusable: statusable,
+					depth:      current.depth + 1,
 				})
 
 				// Now start at top of loop
@@ -25,23 +25,50 @@ import (
 
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 // federatingActor implements the go-fed federating protocol interface
 type federatingActor struct {
 	actor pub.FederatingActor
+	db    federatingdb.DB
 }
 
 // newFederatingProtocol returns the gotosocial implementation of the GTSFederatingProtocol interface
-func newFederatingActor(c pub.CommonBehavior, s2s pub.FederatingProtocol, db pub.Database, clock pub.Clock) pub.FederatingActor {
+func newFederatingActor(c pub.CommonBehavior, s2s pub.FederatingProtocol, db federatingdb.DB, clock pub.Clock) pub.FederatingActor {
 	actor := pub.NewFederatingActor(c, s2s, db, clock)
 
 	return &federatingActor{
 		actor: actor,
+		db:    db,
 	}
 }
 
+// statusRecorder wraps a ResponseWriter
+// to record the status code written to it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// postInbox wraps the given inbox POST so that the delivered activity
+// is forgotten again if it wasn't processed successfully.
+func (f *federatingActor) postInbox(c context.Context, w http.ResponseWriter, post func(context.Context, http.ResponseWriter) (bool, error)) (bool, error) {
+	ctx, done := f.db.TrackInboxActivity(c)
+	recorder := &statusRecorder{ResponseWriter: w}
+
+	handled, err := post(ctx, recorder)
+	done(handled && err == nil && recorder.status < http.StatusBadRequest)
+
+	return handled, err
+}
+
 // Send a federated activity.
 //
 // The provided url must be the outbox of the sender. All processing of
@@ -76,14 +103,18 @@ func (f *federatingActor) Send(c context.Context, outbox *url.URL, t vocab.Type)
 // http.StatusMethodNotAllowed status code in the response. No side
 // effects occur.
 func (f *federatingActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
-	return f.actor.PostInbox(c, w, r)
+	return f.postInbox(c, w, func(ctx context.Context, w http.ResponseWriter) (bool, error) {
+		return f.actor.PostInbox(ctx, w, r)
+	})
 }
 
 // PostInboxScheme is similar to PostInbox, except clients are able to
 // specify which protocol scheme to handle the incoming request and the
 // data stored within the application (HTTP, HTTPS, etc).
 func (f *federatingActor) PostInboxScheme(c context.Context, w http.ResponseWriter, r *http.Request, scheme string) (bool, error) {
-	return f.actor.PostInboxScheme(c, w, r, scheme)
+	return f.postInbox(c, w, func(ctx context.Context, w http.ResponseWriter) (bool, error) {
+		return f.actor.PostInboxScheme(ctx, w, r, scheme)
+	})
 }
 
 // GetInbox returns true if the request was handled as an ActivityPub
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
@@ -147,8 +148,25 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 		asObjectTypeName := asObjectType.GetTypeName()
 		switch asObjectTypeName {
 		case ap.ObjectNote:
+			note := objectIter.GetActivityStreamsNote()
+
+			// votes in polls arrive as notes too
+			isVote, err := f.createPollVote(ctx, note, receivingAccount, requestingAccount)
+			if err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			if isVote {
+				continue
+			}
+
 			// CREATE A NOTE
-			if err := f.createNote(ctx, objectIter.GetActivityStreamsNote(), receivingAccount, requestingAccount); err != nil {
+			if err := f.createStatus(ctx, note, receivingAccount, requestingAccount); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case ap.ActivityQuestion:
+			// CREATE A QUESTION (status with a poll)
+			if err := f.createStatus(ctx, objectIter.GetActivityStreamsQuestion(), receivingAccount, requestingAccount); err != nil {
 				errs = append(errs, err.Error())
 			}
 		default:
@@ -163,8 +181,8 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 	return nil
 }
 
-// createNote handles a Create activity with a Note type.
-func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStreamsNote, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
+// createStatus handles a Create activity with a Note or Question type.
+func (f *federatingDB) createStatus(ctx context.Context, statusable ap.Statusable, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
 	l := log.WithFields(kv.Fields{
 		{"receivingAccount", receivingAccount.URI},
 		{"requestingAccount", requestingAccount.URI},
@@ -175,9 +193,9 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	forward := true
 
 	// note should have an attributedTo
-	noteAttributedTo := note.GetActivityStreamsAttributedTo()
+	noteAttributedTo := statusable.GetActivityStreamsAttributedTo()
 	if noteAttributedTo == nil {
-		return errors.New("createNote: note had no attributedTo")
+		return errors.New("createStatus: note had no attributedTo")
 	}
 
 	// compare the attributedTo(s) with the actor who posted this to our inbox
@@ -195,7 +213,7 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	// If we do have a forward, we should ignore the content for now and just dereference based on the URL/ID of the note instead, to get the note straight from the horse's mouth
 	if forward {
 		l.Trace("note is a forward")
-		id := note.GetJSONLDId()
+		id := statusable.GetJSONLDId()
 		if !id.IsIRI() {
 			// if the note id isn't an IRI, there's nothing we can do here
 			return nil
@@ -213,9 +231,21 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 
 	// if we reach this point, we know it's not a forwarded status, so proceed with processing it as normal
 
-	status, err := f.typeConverter.ASStatusToStatus(ctx, note)
+	// if the note has already been deleted, we don't want it back
+	if noteID := statusable.GetJSONLDId(); noteID != nil && noteID.IsIRI() {
+		gone, err := f.db.TombstoneExistsWithURI(ctx, noteID.GetIRI().String())
+		if err != nil {
+			return fmt.Errorf("createStatus: db error checking tombstone: %s", err)
+		}
+		if gone {
+			l.Debugf("note %s is gone, ignoring it", noteID.GetIRI())
+			return nil
+		}
+	}
+
+	status, err := f.typeConverter.ASStatusToStatus(ctx, statusable)
 	if err != nil {
-		return fmt.Errorf("createNote: error converting note to status: %s", err)
+		return fmt.Errorf("createStatus: error converting note to status: %s", err)
 	}
 
 	// id the status based on the time it was created
@@ -225,6 +255,17 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	}
 	status.ID = statusID
 
+	// drop mentions that the mentioned accounts don't permit,
+	// before anything is stored, so that they never see them
+	keep, err := f.dropUnpermittedMentions(ctx, status, requestingAccount)
+	if err != nil {
+		return fmt.Errorf("createStatus: error checking mention policies: %s", err)
+	}
+	if !keep {
+		l.Debugf("note %s only mentions accounts that don't permit it, ignoring it", status.URI)
+		return nil
+	}
+
 	if err := f.db.PutStatus(ctx, status); err != nil {
 		if errors.Is(err, db.ErrAlreadyExists) {
 			// the status already exists in the database, which means we've already handled everything else,
@@ -232,7 +273,7 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 			return nil
 		}
 		// an actual error has happened
-		return fmt.Errorf("createNote: database error inserting status: %s", err)
+		return fmt.Errorf("createStatus: database error inserting status: %s", err)
 	}
 
 	f.fedWorker.Queue(messages.FromFederator{
@@ -245,6 +286,112 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	return nil
 }
 
+// createPollVote handles a Create activity with a Note type which is a vote in a local poll,
+// that is, a note with a name but no content, in reply to a local status with a poll attached.
+// It returns false if the note isn't a vote at all, so that it can be handled as a status instead.
+func (f *federatingDB) createPollVote(ctx context.Context, note vocab.ActivityStreamsNote, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) (bool, error) {
+	name, err := ap.ExtractName(note)
+	if err != nil || ap.ExtractContent(note) != "" {
+		return false, nil
+	}
+
+	inReplyToURI := ap.ExtractInReplyToURI(note)
+	if inReplyToURI == nil {
+		return false, nil
+	}
+
+	status, err := f.db.GetStatusByURI(ctx, inReplyToURI.String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return false, nil
+		}
+		return false, fmt.Errorf("createPollVote: db error getting status %s: %s", inReplyToURI, err)
+	}
+
+	if !*status.Local || status.PollID == "" {
+		return false, nil
+	}
+
+	// from here on it's definitely a vote
+	l := log.WithFields(kv.Fields{
+		{"requestingAccount", requestingAccount.URI},
+		{"status", status.URI},
+	}...)
+
+	voterURI, err := ap.ExtractAttributedTo(note)
+	if err != nil || voterURI.String() != requestingAccount.URI {
+		return true, fmt.Errorf("createPollVote: vote in poll of status %s was not attributed to requesting account %s", status.URI, requestingAccount.URI)
+	}
+
+	voteID := note.GetJSONLDId()
+	if voteID == nil || !voteID.IsIRI() {
+		return true, errors.New("createPollVote: vote had no id")
+	}
+
+	poll, err := f.db.GetPollByID(ctx, status.PollID)
+	if err != nil {
+		return true, fmt.Errorf("createPollVote: db error getting poll %s: %s", status.PollID, err)
+	}
+
+	if poll.Expired(time.Now()) {
+		l.Debug("ignoring vote in poll which has already ended")
+		return true, nil
+	}
+
+	choice := -1
+	for i, title := range poll.Options {
+		if title == name {
+			choice = i
+			break
+		}
+	}
+	if choice == -1 {
+		return true, fmt.Errorf("createPollVote: poll of status %s has no option %q", status.URI, name)
+	}
+
+	if !*poll.Multiple {
+		votes, err := f.db.GetPollVotesBy(ctx, poll.ID, requestingAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return true, fmt.Errorf("createPollVote: db error getting existing votes: %s", err)
+		}
+		if len(votes) != 0 {
+			l.Debug("ignoring second vote in single choice poll")
+			return true, nil
+		}
+	}
+
+	voteULID, err := id.NewULID()
+	if err != nil {
+		return true, err
+	}
+
+	vote := &gtsmodel.PollVote{
+		ID:        voteULID,
+		URI:       voteID.GetIRI().String(),
+		PollID:    poll.ID,
+		AccountID: requestingAccount.ID,
+		Account:   requestingAccount,
+		Choice:    choice,
+	}
+
+	if err := f.db.PutPollVotes(ctx, []*gtsmodel.PollVote{vote}); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// we've already counted this vote
+			return true, nil
+		}
+		return true, fmt.Errorf("createPollVote: database error inserting vote: %s", err)
+	}
+
+	f.fedWorker.Queue(messages.FromFederator{
+		APObjectType:     ap.ActivityQuestion,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         vote,
+		ReceivingAccount: receivingAccount,
+	})
+
+	return true, nil
+}
+
 /*
 	FOLLOW HANDLERS
 */
@@ -314,3 +461,54 @@ func (f *federatingDB) activityLike(ctx context.Context, asType vocab.Type, rece
 
 	return nil
 }
+
+// dropUnpermittedMentions removes mentions of local accounts from the given status
+// whose mention policy doesn't permit the author to mention them. Mentions of
+// accounts we don't have yet are left alone, since they can't be local accounts.
+//
+// If the status is a direct message and this leaves no local accounts mentioned,
+// nobody here could see it, so false is returned to say it shouldn't be stored.
+func (f *federatingDB) dropUnpermittedMentions(ctx context.Context, status *gtsmodel.Status, author *gtsmodel.Account) (bool, error) {
+	var (
+		mentions    = make([]*gtsmodel.Mention, 0, len(status.Mentions))
+		dropped     bool
+		localRemain bool
+	)
+
+	for _, m := range status.Mentions {
+		if m.TargetAccountURI == "" {
+			mentions = append(mentions, m)
+			continue
+		}
+
+		targetAccount, err := f.db.GetAccountByURI(ctx, m.TargetAccountURI)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				return false, fmt.Errorf("error getting mentioned account %s: %w", m.TargetAccountURI, err)
+			}
+			mentions = append(mentions, m)
+			continue
+		}
+
+		if targetAccount.Domain != "" {
+			mentions = append(mentions, m)
+			continue
+		}
+
+		permitted, err := f.filter.MentionPermitted(ctx, author, targetAccount)
+		if err != nil {
+			return false, fmt.Errorf("error checking mention policy of account %s: %w", targetAccount.ID, err)
+		}
+
+		if !permitted {
+			dropped = true
+			continue
+		}
+
+		localRemain = true
+		mentions = append(mentions, m)
+	}
+
+	status.Mentions = mentions
+	return !(dropped && !localRemain && status.Visibility == gtsmodel.VisibilityDirect), nil
+}
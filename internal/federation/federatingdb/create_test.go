@@ -20,11 +20,17 @@ package federatingdb_test
 
 import (
 	"context"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type CreateTestSuite struct {
@@ -83,6 +89,109 @@ func (suite *CreateTestSuite) TestCreateNoteForward() {
 	suite.Equal("http://example.org/users/some_user/statuses/afaba698-5740-4e32-a702-af61aa543bc1", msg.APIri.String())
 }
 
+func (suite *CreateTestSuite) TestCreateNoteGone() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	// mark the note as already deleted
+	noteURI := "http://fossbros-anonymous.io/users/foss_satan/statuses/5424b153-4553-4f30-9358-7b92f7cd42f6"
+	err := suite.db.PutTombstone(context.Background(), &gtsmodel.Tombstone{
+		ID:     "01GKWGZ2XMEX7RV4N2YJXB6QHB",
+		Domain: "fossbros-anonymous.io",
+		URI:    noteURI,
+	})
+	suite.NoError(err)
+
+	create := suite.testActivities["dm_for_zork"].Activity
+
+	err = suite.federatingDB.Create(ctx, create)
+	suite.NoError(err)
+
+	// nothing should be heading to the processor
+	suite.Empty(suite.fromFederator)
+
+	// and the status should not be in the database
+	_, err = suite.db.GetStatusByURI(context.Background(), noteURI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *CreateTestSuite) TestCreateNoteMentionNotPermitted() {
+	receivingAccount := &gtsmodel.Account{}
+	*receivingAccount = *suite.testAccounts["local_account_2"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	// local_account_2 doesn't want to be mentioned by anyone
+	receivingAccount.MentionPolicy = gtsmodel.MentionPolicyNobody
+	if _, err := suite.db.UpdateAccount(context.Background(), receivingAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	// the mention is dropped, but the status is still stored,
+	// since it's not only addressed to the mentioned account
+	create := suite.testActivities["reply_to_turtle_for_turtle"].Activity
+
+	err := suite.federatingDB.Create(ctx, create)
+	suite.NoError(err)
+
+	msg := <-suite.fromFederator
+	status := msg.GTSModel.(*gtsmodel.Status)
+	suite.Empty(status.Mentions)
+
+	_, err = suite.db.GetStatusByID(context.Background(), status.ID)
+	suite.NoError(err)
+}
+
+func (suite *CreateTestSuite) TestCreateDirectNoteMentionNotPermitted() {
+	receivingAccount := &gtsmodel.Account{}
+	*receivingAccount = *suite.testAccounts["local_account_2"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	// local_account_2 doesn't want to be mentioned by anyone
+	receivingAccount.MentionPolicy = gtsmodel.MentionPolicyNobody
+	if _, err := suite.db.UpdateAccount(context.Background(), receivingAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	mention := streams.NewActivityStreamsMention()
+	hrefProp := streams.NewActivityStreamsHrefProperty()
+	hrefProp.Set(testrig.URLMustParse(receivingAccount.URI))
+	mention.SetActivityStreamsHref(hrefProp)
+	nameProp := streams.NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString("@1happyturtle@localhost:8080")
+	mention.SetActivityStreamsName(nameProp)
+
+	noteURI := "http://fossbros-anonymous.io/users/foss_satan/statuses/01GKZ7JJ7W1XWTXFJ9ZJ8KQ5E1"
+	note := testrig.NewAPNote(
+		testrig.URLMustParse(noteURI),
+		testrig.URLMustParse("http://fossbros-anonymous.io/@foss_satan/01GKZ7JJ7W1XWTXFJ9ZJ8KQ5E1"),
+		time.Now(),
+		"@1happyturtle@localhost:8080 you can't stop me",
+		"",
+		testrig.URLMustParse(requestingAccount.URI),
+		[]*url.URL{testrig.URLMustParse(receivingAccount.URI)},
+		nil,
+		false,
+		[]vocab.ActivityStreamsMention{mention},
+		nil,
+	)
+	create := testrig.WrapAPNoteInCreate(testrig.URLMustParse(noteURI+"/activity"), testrig.URLMustParse(requestingAccount.URI), time.Now(), note)
+
+	err := suite.federatingDB.Create(ctx, create)
+	suite.NoError(err)
+
+	// a direct note that only mentions an account that doesn't
+	// permit it should be dropped before anything is stored
+	suite.Empty(suite.fromFederator)
+	_, err = suite.db.GetStatusByURI(context.Background(), noteURI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestCreateTestSuite(t *testing.T) {
 	suite.Run(t, &CreateTestSuite{})
 }
@@ -20,7 +20,9 @@ package federatingdb
 
 import (
 	"context"
+	"time"
 
+	"codeberg.org/gruf/go-cache/v3/ttl"
 	"codeberg.org/gruf/go-mutexes"
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams/vocab"
@@ -28,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 )
 
 // DB wraps the pub.Database interface with a couple of custom functions for GoToSocial.
@@ -37,6 +40,7 @@ type DB interface {
 	Accept(ctx context.Context, accept vocab.ActivityStreamsAccept) error
 	Reject(ctx context.Context, reject vocab.ActivityStreamsReject) error
 	Announce(ctx context.Context, announce vocab.ActivityStreamsAnnounce) error
+	TrackInboxActivity(ctx context.Context) (context.Context, func(bool))
 }
 
 // FederatingDB uses the underlying DB interface to implement the go-fed pub.Database interface.
@@ -46,8 +50,21 @@ type federatingDB struct {
 	db            db.DB
 	fedWorker     *concurrency.WorkerPool[messages.FromFederator]
 	typeConverter typeutils.TypeConverter
+	filter        visibility.Filter
+	seen          *ttl.Cache[string, struct{}]
 }
 
+const (
+	// seenActivityWindow is how long the ID of an inbound
+	// activity is remembered for, within which any further
+	// deliveries of the same activity will be dropped.
+	seenActivityWindow = time.Minute * 30
+
+	// seenActivityMax is the maximum number of inbound
+	// activity IDs that will be remembered at any one time.
+	seenActivityMax = 10000
+)
+
 // New returns a DB interface using the given database and config
 func New(db db.DB, fedWorker *concurrency.WorkerPool[messages.FromFederator]) DB {
 	fdb := federatingDB{
@@ -55,6 +72,9 @@ func New(db db.DB, fedWorker *concurrency.WorkerPool[messages.FromFederator]) DB
 		db:            db,
 		fedWorker:     fedWorker,
 		typeConverter: typeutils.NewConverter(db),
+		filter:        visibility.NewFilter(db),
+		seen:          ttl.New[string, struct{}](0, seenActivityMax, seenActivityWindow),
 	}
+	fdb.seen.Start(time.Minute)
 	return &fdb
 }
@@ -21,6 +21,7 @@ package federatingdb
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -50,8 +51,11 @@ func (f *federatingDB) Delete(ctx context.Context, id *url.URL) error {
 
 	// in a delete we only get the URI, we can't know if we have a status or a profile or something else,
 	// so we have to try a few different things...
+	known := false
+
 	if s, err := f.db.GetStatusByURI(ctx, id.String()); err == nil && requestingAccount.ID == s.AccountID {
 		l.Debugf("uri is for STATUS with id: %s", s.ID)
+		known = true
 		f.fedWorker.Queue(messages.FromFederator{
 			APObjectType:     ap.ObjectNote,
 			APActivityType:   ap.ActivityDelete,
@@ -62,6 +66,7 @@ func (f *federatingDB) Delete(ctx context.Context, id *url.URL) error {
 
 	if a, err := f.db.GetAccountByURI(ctx, id.String()); err == nil && requestingAccount.ID == a.ID {
 		l.Debugf("uri is for ACCOUNT with id %s", a.ID)
+		known = true
 		f.fedWorker.Queue(messages.FromFederator{
 			APObjectType:     ap.ObjectProfile,
 			APActivityType:   ap.ActivityDelete,
@@ -70,5 +75,23 @@ func (f *federatingDB) Delete(ctx context.Context, id *url.URL) error {
 		})
 	}
 
+	// Mark the uri as gone so that we don't try to dereference it again, or accept
+	// it if it's sent to us later on. If we didn't know about the deleted object,
+	// only trust the requester to delete things that live on their own domain.
+	if known || sameHost(id, requestingAccount.URI) {
+		if err := f.db.PutTombstoneForURI(ctx, id, time.Time{}); err != nil {
+			l.Errorf("error putting tombstone: %s", err)
+		}
+	}
+
 	return nil
 }
+
+// sameHost returns true if the given uri is on the same host as the given account uri.
+func sameHost(uri *url.URL, accountURI string) bool {
+	parsed, err := url.Parse(accountURI)
+	if err != nil {
+		return false
+	}
+	return parsed.Host != "" && parsed.Host == uri.Host
+}
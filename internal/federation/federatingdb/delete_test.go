@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federatingdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DeleteTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *DeleteTestSuite) TestDeleteStatusPutsTombstone() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	deletedStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	err := suite.federatingDB.Delete(ctx, testrig.URLMustParse(deletedStatus.URI))
+	suite.NoError(err)
+
+	// should be a message heading to the processor now, which we can intercept here
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityDelete, msg.APActivityType)
+
+	// the status uri should now be marked as gone
+	gone, err := suite.db.TombstoneExistsWithURI(context.Background(), deletedStatus.URI)
+	suite.NoError(err)
+	suite.True(gone)
+
+	// deleting again should be fine
+	err = suite.federatingDB.Delete(ctx, testrig.URLMustParse(deletedStatus.URI))
+	suite.NoError(err)
+}
+
+func (suite *DeleteTestSuite) TestDeleteUnknownObjectSameHost() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	unknownURI := "http://fossbros-anonymous.io/users/foss_satan/statuses/01GKWJ3Y6Q8J0ZEZ1GYXQ4N3VD"
+	err := suite.federatingDB.Delete(ctx, testrig.URLMustParse(unknownURI))
+	suite.NoError(err)
+
+	// we don't know about this object, but the requester owns the domain it lives on
+	gone, err := suite.db.TombstoneExistsWithURI(context.Background(), unknownURI)
+	suite.NoError(err)
+	suite.True(gone)
+}
+
+func (suite *DeleteTestSuite) TestDeleteUnknownObjectOtherHost() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	unknownURI := "https://example.org/users/someone_else/statuses/01GKWJ3Y6Q8J0ZEZ1GYXQ4N3VD"
+	err := suite.federatingDB.Delete(ctx, testrig.URLMustParse(unknownURI))
+	suite.NoError(err)
+
+	// the requester has no business deleting things on another domain
+	gone, err := suite.db.TombstoneExistsWithURI(context.Background(), unknownURI)
+	suite.NoError(err)
+	suite.False(gone)
+}
+
+func TestDeleteTestSuite(t *testing.T) {
+	suite.Run(t, &DeleteTestSuite{})
+}
@@ -22,11 +22,14 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -35,11 +38,76 @@ import (
 //
 // The library makes this call only after acquiring a lock first.
 //
-// Implementation note: we have our own logic for inboxes so always return false here.
+// Implementation note: we don't keep inboxes, but the same activity may be
+// delivered to us more than once (eg., to a personal inbox and to the shared
+// inbox, or directly, via a relay and via forwarding). So we remember the IDs
+// of activities we've taken delivery of, and report any repeat within the
+// window as already contained. Only a delivery by an actor on the activity's
+// own host can claim its ID, so nobody else can get the genuine activity
+// dropped by sending something else under its ID first; copies from anyone
+// else are only checked against IDs already claimed. The claim is made here
+// rather than after processing, so that concurrent deliveries of the same
+// activity don't both get through, and is released again by the callback
+// from TrackInboxActivity if processing fails, so the delivery can be retried.
 func (f *federatingDB) InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error) {
+	if id == nil {
+		// Activities without an ID
+		// can't be deduplicated.
+		return false, nil
+	}
+
+	requestingAccount, ok := c.Value(ap.ContextRequestingAccount).(*gtsmodel.Account)
+	if !ok || requestingAccount == nil {
+		// Without a verified sender we can't
+		// tell where the activity came from.
+		return false, nil
+	}
+
+	key := id.String()
+
+	requestingAccountURI, err := url.Parse(requestingAccount.URI)
+	if err != nil || !strings.EqualFold(requestingAccountURI.Host, id.Host) {
+		// The activity was relayed or forwarded
+		// to us, so it can't claim its ID itself.
+		return f.seen.Has(key), nil
+	}
+
+	if !f.seen.Add(key, struct{}{}) {
+		// Already claimed by an earlier
+		// or concurrent delivery.
+		return true, nil
+	}
+
+	if tracked, ok := c.Value(trackedActivityKey{}).(*trackedActivity); ok {
+		tracked.key = key
+	}
+
 	return false, nil
 }
 
+// trackedActivityKey is the context key
+// under which a *trackedActivity is stored.
+type trackedActivityKey struct{}
+
+// trackedActivity holds the seen key claimed by
+// the activity delivered by one inbound request, if any.
+type trackedActivity struct {
+	key string
+}
+
+// TrackInboxActivity returns a context to process an inbound request with,
+// and a function to call once that request has been processed, with whether
+// it was processed successfully. If it wasn't, the ID of the activity it
+// delivered is released, so that a later delivery of it isn't dropped.
+func (f *federatingDB) TrackInboxActivity(c context.Context) (context.Context, func(bool)) {
+	tracked := &trackedActivity{}
+	return context.WithValue(c, trackedActivityKey{}, tracked), func(processed bool) {
+		if !processed && tracked.key != "" {
+			f.seen.Invalidate(tracked.key)
+		}
+	}
+}
+
 // GetInbox returns the first ordered collection page of the outbox at
 // the specified IRI, for prepending new items.
 //
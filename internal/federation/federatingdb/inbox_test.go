@@ -20,9 +20,12 @@ package federatingdb_test
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -84,6 +87,96 @@ func (suite *InboxTestSuite) TestInboxesForAccountIRIWithSharedInbox() {
 	suite.Contains(asStrings, "http://some-inbox-iri/weeeeeeeeeeeee")
 }
 
+func (suite *InboxTestSuite) TestInboxContainsDuplicateActivity() {
+	sender := suite.testAccounts["remote_account_1"]
+	ctx := context.WithValue(context.Background(), ap.ContextRequestingAccount, sender)
+	activityID := testrig.URLMustParse("http://fossbros-anonymous.io/users/foss_satan/activities/01GHM3RK9YQ0YKP33R6EZTGXD6")
+	personalInbox := testrig.URLMustParse(suite.testAccounts["local_account_1"].InboxURI)
+	sharedInbox := testrig.URLMustParse("http://localhost:8080/inbox")
+
+	// first delivery should be new
+	trackedCtx, done := suite.federatingDB.TrackInboxActivity(ctx)
+	contains, err := suite.federatingDB.InboxContains(trackedCtx, personalInbox, activityID)
+	suite.NoError(err)
+	suite.False(contains)
+
+	// if processing it failed, a retry should still be new
+	done(false)
+	trackedCtx, done = suite.federatingDB.TrackInboxActivity(ctx)
+	contains, err = suite.federatingDB.InboxContains(trackedCtx, personalInbox, activityID)
+	suite.NoError(err)
+	suite.False(contains)
+
+	// once processed, the same activity delivered via a different inbox should be seen
+	done(true)
+	contains, err = suite.federatingDB.InboxContains(ctx, sharedInbox, activityID)
+	suite.NoError(err)
+	suite.True(contains)
+
+	// and again via the same inbox
+	contains, err = suite.federatingDB.InboxContains(ctx, personalInbox, activityID)
+	suite.NoError(err)
+	suite.True(contains)
+
+	// a different activity should still be new
+	contains, err = suite.federatingDB.InboxContains(ctx, personalInbox, testrig.URLMustParse("http://fossbros-anonymous.io/users/foss_satan/activities/01GHM3S5Q0T5YB4WR1HHJRBXNC"))
+	suite.NoError(err)
+	suite.False(contains)
+}
+
+func (suite *InboxTestSuite) TestInboxContainsActivityFromTwoSenders() {
+	originCtx := context.WithValue(context.Background(), ap.ContextRequestingAccount, suite.testAccounts["remote_account_1"])
+	relayCtx := context.WithValue(context.Background(), ap.ContextRequestingAccount, suite.testAccounts["remote_account_2"])
+	activityID := testrig.URLMustParse("http://fossbros-anonymous.io/users/foss_satan/activities/01GHM3RK9YQ0YKP33R6EZTGXD6")
+	inbox := testrig.URLMustParse(suite.testAccounts["local_account_1"].InboxURI)
+
+	// a copy relayed by an account on another host
+	// is new, but shouldn't claim the activity's ID
+	contains, err := suite.federatingDB.InboxContains(relayCtx, inbox, activityID)
+	suite.NoError(err)
+	suite.False(contains)
+
+	// so the delivery from the origin should still be new
+	contains, err = suite.federatingDB.InboxContains(originCtx, inbox, activityID)
+	suite.NoError(err)
+	suite.False(contains)
+
+	// after which a relayed copy should be seen
+	contains, err = suite.federatingDB.InboxContains(relayCtx, inbox, activityID)
+	suite.NoError(err)
+	suite.True(contains)
+}
+
+func (suite *InboxTestSuite) TestInboxContainsConcurrentDeliveries() {
+	ctx := context.WithValue(context.Background(), ap.ContextRequestingAccount, suite.testAccounts["remote_account_1"])
+	activityID := testrig.URLMustParse("http://fossbros-anonymous.io/users/foss_satan/activities/01GHM3RK9YQ0YKP33R6EZTGXD6")
+	inbox := testrig.URLMustParse(suite.testAccounts["local_account_1"].InboxURI)
+
+	const deliveries = 10
+	var (
+		wg         sync.WaitGroup
+		gotThrough atomic.Int32
+	)
+
+	for i := 0; i < deliveries; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			trackedCtx, done := suite.federatingDB.TrackInboxActivity(ctx)
+			contains, err := suite.federatingDB.InboxContains(trackedCtx, inbox, activityID)
+			suite.NoError(err)
+			if !contains {
+				gotThrough.Add(1)
+			}
+			done(true)
+		}()
+	}
+	wg.Wait()
+
+	// only one of the deliveries should have got through
+	suite.EqualValues(1, gotThrough.Load())
+}
+
 func TestInboxTestSuite(t *testing.T) {
 	suite.Run(t, &InboxTestSuite{})
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
@@ -71,6 +72,15 @@ func (f *federatingDB) Update(ctx context.Context, asType vocab.Type) error {
 	}
 
 	typeName := asType.GetTypeName()
+	if typeName == ap.ActivityQuestion {
+		// it's an UPDATE to the votes or end of a poll
+		pollable, ok := asType.(ap.Pollable)
+		if !ok {
+			return errors.New("UPDATE: could not convert type to question")
+		}
+		return f.updatePoll(ctx, pollable, requestingAcct)
+	}
+
 	if typeName == ap.ActorApplication ||
 		typeName == ap.ActorGroup ||
 		typeName == ap.ActorOrganization ||
@@ -148,3 +158,49 @@ func (f *federatingDB) Update(ctx context.Context, asType vocab.Type) error {
 
 	return nil
 }
+
+// updatePoll updates the vote counts and end time of a remote poll we already know
+// about, from a Question sent to us by its author. Unknown polls are ignored.
+func (f *federatingDB) updatePoll(ctx context.Context, pollable ap.Pollable, requestingAcct *gtsmodel.Account) error {
+	idProp := pollable.GetJSONLDId()
+	if idProp == nil || !idProp.IsIRI() {
+		return errors.New("UPDATE: question had no id")
+	}
+
+	status, err := f.db.GetStatusByURI(ctx, idProp.GetIRI().String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil
+		}
+		return fmt.Errorf("UPDATE: db error getting status %s: %s", idProp.GetIRI(), err)
+	}
+
+	if *status.Local || status.PollID == "" {
+		// we keep track of our own polls
+		return nil
+	}
+
+	if requestingAcct == nil || status.AccountURI != requestingAcct.URI {
+		return fmt.Errorf("UPDATE: update for question %s was not requested by its author", status.URI)
+	}
+
+	poll, err := f.db.GetPollByID(ctx, status.PollID)
+	if err != nil {
+		return fmt.Errorf("UPDATE: db error getting poll %s: %s", status.PollID, err)
+	}
+
+	updated, err := f.typeConverter.ASPollToPoll(ctx, pollable)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error converting question to poll: %s", err)
+	}
+
+	if len(updated.Votes) != len(poll.Options) {
+		// the options have been changed, which we don't support
+		return fmt.Errorf("UPDATE: question %s has %d options, expected %d", status.URI, len(updated.Votes), len(poll.Options))
+	}
+
+	poll.Votes = updated.Votes
+	poll.Voters = updated.Voters
+	poll.ExpiresAt = updated.ExpiresAt
+	return f.db.UpdatePoll(ctx, poll, "votes", "voters", "expires_at")
+}
@@ -2,12 +2,10 @@ package federation
 
 import (
 	"context"
-	"fmt"
 	"net/url"
+	"time"
 
-	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
-	"github.com/superseriousbusiness/gotosocial/internal/id"
-	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 )
 
 // CheckGone checks if a tombstone exists in the database for AP Actor or Object with the given uri.
@@ -15,20 +13,8 @@ func (f *federator) CheckGone(ctx context.Context, uri *url.URL) (bool, error) {
 	return f.db.TombstoneExistsWithURI(ctx, uri.String())
 }
 
-// HandleGone puts a tombstone in the database, which marks an AP Actor or Object with the given uri as gone.
+// HandleGone puts a tombstone in the database, which marks an AP Actor or Object with the given uri
+// as gone, after its server responded 410 GONE. The tombstone expires after dereferencing.GoneTombstoneTTL.
 func (f *federator) HandleGone(ctx context.Context, uri *url.URL) error {
-	tombstoneID, err := id.NewULID()
-	if err != nil {
-		err = fmt.Errorf("HandleGone: error generating id for new tombstone %s: %s", uri, err)
-		log.Error(err)
-		return err
-	}
-
-	tombstone := &gtsmodel.Tombstone{
-		ID:     tombstoneID,
-		Domain: uri.Host,
-		URI:    uri.String(),
-	}
-
-	return f.db.PutTombstone(ctx, tombstone)
+	return f.db.PutTombstoneForURI(ctx, uri, time.Now().Add(dereferencing.GoneTombstoneTTL))
 }
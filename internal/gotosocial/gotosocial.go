@@ -24,6 +24,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
 	"github.com/superseriousbusiness/gotosocial/internal/router"
 )
 
@@ -42,12 +43,13 @@ type Server interface {
 // NewServer returns a new gotosocial server, initialized with the given configuration.
 // An error will be returned the caller if something goes wrong during initialization
 // eg., no db or storage connection, port for router already in use, etc.
-func NewServer(db db.DB, apiRouter router.Router, federator federation.Federator, mediaManager media.Manager) (Server, error) {
+func NewServer(db db.DB, apiRouter router.Router, federator federation.Federator, mediaManager media.Manager, processor processing.Processor) (Server, error) {
 	return &gotosocial{
 		db:           db,
 		apiRouter:    apiRouter,
 		federator:    federator,
 		mediaManager: mediaManager,
+		processor:    processor,
 	}, nil
 }
 
@@ -57,17 +59,25 @@ type gotosocial struct {
 	apiRouter    router.Router
 	federator    federation.Federator
 	mediaManager media.Manager
+	processor    processing.Processor
+
+	// stopJobs stops scheduled
+	// jobs, if they were started
+	stopJobs func()
 }
 
 // Start starts up the gotosocial server. If something goes wrong
 // while starting the server, then an error will be returned.
 func (gts *gotosocial) Start(ctx context.Context) error {
+	if err := gts.scheduleJobs(); err != nil {
+		return err
+	}
 	gts.apiRouter.Start()
 	return nil
 }
 
 // Stop closes down the gotosocial server, first closing the router,
-// then the media manager, then the database.
+// then the media manager, then scheduled jobs, then the database.
 // If something goes wrong while stopping, an error will be returned.
 func (gts *gotosocial) Stop(ctx context.Context) error {
 	if err := gts.apiRouter.Stop(ctx); err != nil {
@@ -76,6 +86,9 @@ func (gts *gotosocial) Stop(ctx context.Context) error {
 	if err := gts.mediaManager.Stop(); err != nil {
 		return err
 	}
+	if gts.stopJobs != nil {
+		gts.stopJobs()
+	}
 	if err := gts.db.Stop(ctx); err != nil {
 		return err
 	}
@@ -0,0 +1,203 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gotosocial
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// notificationsPruneBatchSize is the number of
+	// old notifications deleted per query when pruning.
+	notificationsPruneBatchSize = 500
+	// followRequestsExpireBatchSize is the number of
+	// expired follow requests fetched per query.
+	followRequestsExpireBatchSize = 100
+	// deletedStatusesPurgeBatchSize is the number of
+	// deleted statuses removed per transaction when purging.
+	deletedStatusesPurgeBatchSize = 100
+	// deletedAccountsPurgeBatchSize is the number of
+	// deleted accounts removed per transaction when purging.
+	deletedAccountsPurgeBatchSize = 100
+	// domainBlocksExpireBatchSize is the number of
+	// expired domain blocks fetched per query.
+	domainBlocksExpireBatchSize = 20
+	// pollsExpireBatchSize is the number of
+	// ended polls fetched per query.
+	pollsExpireBatchSize = 50
+)
+
+// scheduleJobs starts a cron which runs periodic database jobs: maintenance,
+// if a schedule for it is configured, purging of deleted statuses and accounts, lifting of
+// expired domain blocks, closing of ended polls, pruning of old read notifications, and expiry of
+// unanswered follow requests, if an expiry period is configured.
+func (gts *gotosocial) scheduleJobs() error {
+	// don't start a new run of a job if the previous one is somehow still going
+	c := cron.New(
+		cron.WithLogger(&logrusWrapper{}),
+		cron.WithChain(cron.SkipIfStillRunning(&logrusWrapper{})),
+	)
+	jobsCtx, jobsCancel := context.WithCancel(context.Background())
+
+	if schedule := config.GetDbMaintenanceSchedule(); schedule != "" {
+		spec, err := cron.ParseStandard(schedule)
+		if err != nil {
+			jobsCancel()
+			return fmt.Errorf("error parsing %s %q: %s", config.DbMaintenanceScheduleFlag(), schedule, err)
+		}
+
+		c.Schedule(spec, cron.FuncJob(func() {
+			begin := time.Now()
+			result, err := gts.db.Maintain(jobsCtx, config.GetDbMaintenanceVacuum(), config.GetDbMaintenanceReindex())
+			if err != nil {
+				log.Errorf("database maintenance: error running maintenance: %s", err)
+				return
+			}
+			log.Infof("database maintenance: reclaimed %d bytes in %s", result.Reclaimed(), time.Since(begin))
+		}))
+		log.Infof("database maintenance: scheduled with %q", schedule)
+	}
+
+	// statuses and accounts are only marked as deleted when they're
+	// deleted, so regularly clear them out of the way in the background;
+	// statuses first, since deleted accounts' statuses are deleted too
+	if _, err := c.AddFunc("@every 1m", func() {
+		begin := time.Now()
+		purged, err := gts.db.PurgeDeletedStatuses(jobsCtx, deletedStatusesPurgeBatchSize)
+		if err != nil {
+			log.Errorf("statuses: error purging deleted statuses: %s", err)
+			return
+		}
+		if purged != 0 {
+			log.Infof("statuses: purged %d deleted statuses in %s", purged, time.Since(begin))
+		}
+
+		begin = time.Now()
+		purged, err = gts.db.PurgeDeletedAccounts(jobsCtx, deletedAccountsPurgeBatchSize)
+		if err != nil {
+			log.Errorf("accounts: error purging deleted accounts: %s", err)
+			return
+		}
+		if purged != 0 {
+			log.Infof("accounts: purged %d deleted accounts in %s", purged, time.Since(begin))
+		}
+	}); err != nil {
+		jobsCancel()
+		return fmt.Errorf("error starting deleted statuses and accounts purge job: %s", err)
+	}
+
+	// domain blocks can be created with an expiry, after which they're lifted
+	if _, err := c.AddFunc("@every 1m", func() {
+		begin := time.Now()
+		lifted, err := gts.processor.AdminDomainBlocksExpire(jobsCtx, begin, domainBlocksExpireBatchSize)
+		if err != nil {
+			log.Errorf("domain blocks: error lifting expired domain blocks: %s", err)
+			return
+		}
+		if lifted != 0 {
+			log.Infof("domain blocks: lifted %d expired domain blocks in %s", lifted, time.Since(begin))
+		}
+	}); err != nil {
+		jobsCancel()
+		return fmt.Errorf("error starting domain blocks expiry job: %s", err)
+	}
+
+	// polls end at a set time, after which their
+	// voters are notified and the results are sent out
+	if _, err := c.AddFunc("@every 1m", func() {
+		begin := time.Now()
+		closed, err := gts.processor.PollsExpire(jobsCtx, begin, pollsExpireBatchSize)
+		if err != nil {
+			log.Errorf("polls: error closing ended polls: %s", err)
+			return
+		}
+		if closed != 0 {
+			log.Infof("polls: closed %d ended polls in %s", closed, time.Since(begin))
+		}
+	}); err != nil {
+		jobsCancel()
+		return fmt.Errorf("error starting polls expiry job: %s", err)
+	}
+
+	// accounts can choose their own retention period even if
+	// the instance doesn't have a default, so always schedule this
+	if _, err := c.AddFunc("@midnight", func() {
+		begin := time.Now()
+		pruned, err := gts.db.PruneReadNotifications(jobsCtx, config.GetAccountsNotificationsRetentionDays(), notificationsPruneBatchSize)
+		if err != nil {
+			log.Errorf("notifications: error pruning read notifications: %s", err)
+			return
+		}
+		log.Infof("notifications: pruned %d read notifications in %s", pruned, time.Since(begin))
+	}); err != nil {
+		jobsCancel()
+		return fmt.Errorf("error starting notifications prune job: %s", err)
+	}
+
+	if expiryDays := config.GetAccountsFollowRequestExpiryDays(); expiryDays > 0 {
+		if _, err := c.AddFunc("@midnight", func() {
+			begin := time.Now()
+			olderThan := begin.Add(-time.Duration(expiryDays) * 24 * time.Hour)
+			expired, err := gts.processor.FollowRequestsExpire(jobsCtx, olderThan, followRequestsExpireBatchSize)
+			if err != nil {
+				log.Errorf("follow requests: error expiring follow requests: %s", err)
+			}
+			log.Infof("follow requests: expired %d follow requests in %s", expired, time.Since(begin))
+		}); err != nil {
+			jobsCancel()
+			return fmt.Errorf("error starting follow requests expiry job: %s", err)
+		}
+	}
+
+	// try to stop any jobs gracefully by waiting til they're finished
+	gts.stopJobs = func() {
+		cronCtx := c.Stop()
+
+		select {
+		case <-cronCtx.Done():
+			log.Infof("jobs cron finished jobs and stopped gracefully")
+		case <-time.After(1 * time.Minute):
+			log.Infof("jobs cron didn't stop after 60 seconds, will force close jobs")
+		}
+
+		jobsCancel()
+	}
+
+	c.Start()
+	return nil
+}
+
+// logrusWrapper is just a util for passing the logrus logger into the cron logging system.
+type logrusWrapper struct{}
+
+// Info logs routine messages about cron's operation.
+func (l *logrusWrapper) Info(msg string, keysAndValues ...interface{}) {
+	log.Info("jobs cron logger: ", msg, keysAndValues)
+}
+
+// Error logs an error condition.
+func (l *logrusWrapper) Error(err error, msg string, keysAndValues ...interface{}) {
+	log.Error("jobs cron logger: ", err, msg, keysAndValues)
+}
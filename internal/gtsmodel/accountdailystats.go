@@ -0,0 +1,42 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// AccountDailyStats is a rollup of the activity of one local account over one UTC day.
+// Rollups are only stored for days that have ended, and are not updated afterwards,
+// so they reflect the account's activity as it was when the rollup was computed.
+type AccountDailyStats struct {
+	ID           string            `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                // id of this item in the database
+	CreatedAt    time.Time         `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`         // when was item created
+	AccountID    string            `validate:"required,ulid" bun:"type:CHAR(26),unique:accountdailystats,notnull,nullzero"` // ID of the account these stats are about
+	Day          time.Time         `validate:"required" bun:"type:timestamptz,unique:accountdailystats,notnull,nullzero"`   // midnight UTC at the start of the day these stats cover
+	Statuses     int               `validate:"min=0" bun:",notnull,default:0"`                                              // number of statuses (not boosts) posted by the account during the day
+	Boosts       int               `validate:"min=0" bun:",notnull,default:0"`                                              // number of boosts made by the account during the day
+	NewFollowers int               `validate:"min=0" bun:",notnull,default:0"`                                              // number of accounts which started following the account during the day, and still did at rollup time
+	Followers    int               `validate:"min=0" bun:",notnull,default:0"`                                              // number of accounts following the account at the end of the day, as far as known at rollup time
+	Tags         []AccountTagCount `validate:"-" bun:""`                                                                    // hashtags used by the account during the day
+}
+
+// AccountTagCount is the number of statuses in which an account used a hashtag.
+type AccountTagCount struct {
+	TagID string // ID of the tag
+	Count int    // number of statuses using the tag
+}
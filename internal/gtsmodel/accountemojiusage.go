@@ -0,0 +1,32 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// AccountEmojiUsage counts how many statuses a local account has used a custom emoji in,
+// so that the emojis an account uses most can be offered first in emoji pickers.
+type AccountEmojiUsage struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`         // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`         // when was item last updated, ie., when was the emoji last used by the account
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:accountemojiusage,notnull,nullzero"` // ID of the account which used the emoji
+	EmojiID   string    `validate:"required,ulid" bun:"type:CHAR(26),unique:accountemojiusage,notnull,nullzero"` // ID of the emoji which was used
+	Uses      int       `validate:"min=1" bun:",notnull,default:1"`                                              // number of statuses the account has used the emoji in
+}
@@ -0,0 +1,31 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// AccountUsernameAlias is a username that a local account used to have before it was
+// renamed. The old username stays reserved for the account, so that webfinger lookups,
+// mentions, and links using the old handle keep finding it.
+type AccountUsernameAlias struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created, ie., when was the account renamed
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // ID of the local account which used to have this username
+	Username  string    `validate:"required" bun:",nullzero,notnull,unique"`                             // the username the account used to have
+}
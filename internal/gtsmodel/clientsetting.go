@@ -0,0 +1,33 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// ClientSetting represents one key-value pair stored by an application on behalf of an account,
+// so that clients on different devices can sync things like pinned columns and timeline preferences.
+type ClientSetting struct {
+	ID            string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`            // id of this item in the database
+	CreatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`     // when was item created
+	UpdatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`     // when was item last updated
+	AccountID     string    `validate:"required,ulid" bun:"type:CHAR(26),unique:clientsetting,notnull,nullzero"` // ID of the account that owns this setting
+	ApplicationID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:clientsetting,notnull,nullzero"` // ID of the application this setting is namespaced to
+	Key           string    `validate:"required" bun:",unique:clientsetting,notnull,nullzero"`                   // Key of this setting, unique per account and application
+	Value         string    `validate:"-" bun:""`                                                                // Opaque value of this setting, as stored by the client
+}
@@ -32,4 +32,5 @@ type DomainBlock struct {
 	PublicComment      string    `validate:"-" bun:""`                                                            // Public comment on this block, viewable (optionally) by everyone
 	Obfuscate          *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
 	SubscriptionID     string    `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // if this block was created through a subscription, what's the subscription ID?
+	ExpiresAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when this block should automatically be lifted; zero means never
 }
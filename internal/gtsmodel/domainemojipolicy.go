@@ -0,0 +1,45 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// DomainEmojiPolicy determines what happens to custom emojis federated in from a remote domain.
+// There is at most one policy per domain; domains without a policy use EmojiPolicyStore.
+type DomainEmojiPolicy struct {
+	ID        string      `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time   `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time   `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain    string      `validate:"required,fqdn" bun:",nullzero,notnull,unique"`                        // domain this policy applies to. Eg. 'whatever.com'
+	Policy    EmojiPolicy `validate:"oneof=store hide reject" bun:",nullzero,notnull"`                     // what to do with emojis from this domain
+	AccountID string      `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the admin who last set this policy
+	Account   *Account    `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to accountID
+}
+
+// EmojiPolicy describes what to do with custom emojis federated in from a remote domain.
+type EmojiPolicy string
+
+const (
+	// EmojiPolicyStore -- emojis are fetched, stored, and shown as normal.
+	EmojiPolicyStore EmojiPolicy = "store"
+	// EmojiPolicyHide -- new emojis are fetched and stored, but disabled so that they aren't shown.
+	EmojiPolicyHide EmojiPolicy = "hide"
+	// EmojiPolicyReject -- emojis are neither fetched nor shown; statuses and accounts keep the bare shortcode.
+	EmojiPolicyReject EmojiPolicy = "reject"
+)
@@ -43,4 +43,7 @@ type Emoji struct {
 	VisibleInPicker        *bool          `validate:"-" bun:",nullzero,notnull,default:true"`                                                      // Is this emoji visible in the admin emoji picker?
 	Category               *EmojiCategory `validate:"-" bun:"rel:belongs-to"`                                                                      // In which emoji category is this emoji visible?
 	CategoryID             string         `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                 // ID of the category this emoji belongs to.
+	Author                 string         `validate:"-" bun:",nullzero"`                                                                           // Name of the artist who created this emoji, if known.
+	License                string         `validate:"-" bun:",nullzero"`                                                                           // License under which this emoji may be used, eg 'CC BY-NC-SA 4.0'.
+	SourceURL              string         `validate:"omitempty,url" bun:",nullzero"`                                                               // Where this emoji was originally published, if known.
 }
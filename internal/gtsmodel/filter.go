@@ -0,0 +1,105 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Filter stores a filter created by a local account, which hides or warns about
+// statuses matching any of its keywords or statuses in the contexts it applies to.
+type Filter struct {
+	ID                   string           `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	ExpiresAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero"`                                   // filter is no longer applied after this time, if set
+	AccountID            string           `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // id of the account that owns the filter
+	Title                string           `validate:"required" bun:",nullzero,notnull"`                                    // title of this filter, as chosen by its owner
+	Action               FilterAction     `validate:"oneof=warn hide" bun:",nullzero,notnull,default:'warn'"`              // what to do with statuses matching the filter
+	ContextHome          *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // apply the filter to the home timeline and lists
+	ContextNotifications *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // apply the filter to notifications
+	ContextPublic        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // apply the filter to the public timelines
+	ContextThread        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // apply the filter to the context of a status
+	ContextAccount       *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // apply the filter to the statuses of an account
+	Keywords             []*FilterKeyword `validate:"-" bun:"-"`                                                           // keywords of this filter
+	Statuses             []*FilterStatus  `validate:"-" bun:"-"`                                                           // statuses of this filter
+}
+
+// Expired returns true if the filter has an expiry time which has passed.
+func (f *Filter) Expired(now time.Time) bool {
+	return !f.ExpiresAt.IsZero() && !now.Before(f.ExpiresAt)
+}
+
+// AppliesIn returns true if the filter applies in the given context.
+func (f *Filter) AppliesIn(context FilterContext) bool {
+	var applies *bool
+	switch context {
+	case FilterContextHome:
+		applies = f.ContextHome
+	case FilterContextNotifications:
+		applies = f.ContextNotifications
+	case FilterContextPublic:
+		applies = f.ContextPublic
+	case FilterContextThread:
+		applies = f.ContextThread
+	case FilterContextAccount:
+		applies = f.ContextAccount
+	}
+	return applies != nil && *applies
+}
+
+// FilterKeyword is a word or phrase which causes statuses containing it to match its filter.
+type FilterKeyword struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // id of the account that owns the filter
+	FilterID  string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // id of the filter this keyword belongs to
+	Keyword   string    `validate:"required" bun:",nullzero,notnull"`                                    // word or phrase to match, case-insensitively
+	WholeWord *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // only match the keyword at word boundaries
+}
+
+// FilterStatus is a single status which matches its filter.
+type FilterStatus struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`           // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`    // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`    // when was item last updated
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                     // id of the account that owns the filter
+	FilterID  string    `validate:"required,ulid" bun:"type:CHAR(26),unique:filterstatus,nullzero,notnull"` // id of the filter this status belongs to
+	StatusID  string    `validate:"required,ulid" bun:"type:CHAR(26),unique:filterstatus,nullzero,notnull"` // id of the status to match
+}
+
+// FilterAction describes what's done with statuses matching a filter.
+type FilterAction string
+
+// FilterAction values.
+const (
+	FilterActionWarn FilterAction = "warn" // show matching statuses behind a warning with the filter title
+	FilterActionHide FilterAction = "hide" // leave matching statuses out completely
+)
+
+// FilterContext describes a place in which statuses can be filtered.
+type FilterContext string
+
+// FilterContext values.
+const (
+	FilterContextHome          FilterContext = "home"          // the home timeline and lists
+	FilterContextNotifications FilterContext = "notifications" // notifications
+	FilterContextPublic        FilterContext = "public"        // the public timelines
+	FilterContextThread        FilterContext = "thread"        // the context of a status
+	FilterContextAccount       FilterContext = "account"       // the statuses of an account
+)
@@ -31,5 +31,6 @@ type Follow struct {
 	TargetAccountID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:srctarget,notnull,nullzero"` // Who is the target of this follow ?
 	TargetAccount   *Account  `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to targetAccountID
 	ShowReblogs     *bool     `validate:"-" bun:",nullzero,notnull,default:true"`                              // Does this follow also want to see reblogs and not just posts?
+	ShowReplies     *bool     `validate:"-" bun:",nullzero,notnull,default:true"`                              // Does this follow also want to see replies to other accounts and not just posts?
 	Notify          *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // does the following account want to be notified when the followed account posts?
 }
@@ -0,0 +1,35 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import (
+	"net/http"
+	"time"
+)
+
+// InboxItem is an incoming federated activity which has been accepted into the
+// inbox queue, but not processed yet. Enough of the original request is kept to
+// check its http signature and process it as though it had only just arrived.
+type InboxItem struct {
+	ID        string      `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time   `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	InboxURI  string      `validate:"required,url" bun:",nullzero,notnull"`                                // full URL of the inbox the activity was posted to
+	Header    http.Header `validate:"-" bun:",nullzero,notnull"`                                           // headers of the original request
+	Body      []byte      `validate:"required" bun:",nullzero,notnull"`                                    // body of the original request
+}
@@ -22,22 +22,28 @@ import "time"
 
 // Instance represents a federated instance, either local or remote.
 type Instance struct {
-	ID                     string       `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                     // id of this item in the database
-	CreatedAt              time.Time    `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item created
-	UpdatedAt              time.Time    `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item last updated
-	Domain                 string       `validate:"required,fqdn" bun:",nullzero,notnull,unique"`                                     // Instance domain eg example.org
-	Title                  string       `validate:"-" bun:""`                                                                         // Title of this instance as it would like to be displayed.
-	URI                    string       `validate:"required,url" bun:",nullzero,notnull,unique"`                                      // base URI of this instance eg https://example.org
-	SuspendedAt            time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When was this instance suspended, if at all?
-	DomainBlockID          string       `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                      // ID of any existing domain block for this instance in the database
-	DomainBlock            *DomainBlock `validate:"-" bun:"rel:belongs-to"`                                                           // Domain block corresponding to domainBlockID
-	ShortDescription       string       `validate:"-" bun:""`                                                                         // Short description of this instance
-	Description            string       `validate:"-" bun:""`                                                                         // Longer description of this instance
-	Terms                  string       `validate:"-" bun:""`                                                                         // Terms and conditions of this instance
-	ContactEmail           string       `validate:"omitempty,email" bun:""`                                                           // Contact email address for this instance
-	ContactAccountUsername string       `validate:"required_with=ContactAccountID" bun:",nullzero"`                                   // Username of the contact account for this instance
-	ContactAccountID       string       `validate:"required_with=ContactAccountUsername,omitempty,ulid" bun:"type:CHAR(26),nullzero"` // Contact account ID in the database for this instance
-	ContactAccount         *Account     `validate:"-" bun:"rel:belongs-to"`                                                           // account corresponding to contactAccountID
-	Reputation             int64        `validate:"-" bun:",notnull,default:0"`                                                       // Reputation score of this instance
-	Version                string       `validate:"-" bun:",nullzero"`                                                                // Version of the software used on this instance
+	ID                      string       `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                     // id of this item in the database
+	CreatedAt               time.Time    `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item created
+	UpdatedAt               time.Time    `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item last updated
+	Domain                  string       `validate:"required,fqdn" bun:",nullzero,notnull,unique"`                                     // Instance domain eg example.org
+	Title                   string       `validate:"-" bun:""`                                                                         // Title of this instance as it would like to be displayed.
+	URI                     string       `validate:"required,url" bun:",nullzero,notnull,unique"`                                      // base URI of this instance eg https://example.org
+	SuspendedAt             time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When was this instance suspended, if at all?
+	DomainBlockID           string       `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                      // ID of any existing domain block for this instance in the database
+	DomainBlock             *DomainBlock `validate:"-" bun:"rel:belongs-to"`                                                           // Domain block corresponding to domainBlockID
+	ShortDescription        string       `validate:"-" bun:""`                                                                         // Short description of this instance
+	ShortDescriptionText    string       `validate:"-" bun:""`                                                                         // Raw markdown source of the short description, for local instance only
+	Description             string       `validate:"-" bun:""`                                                                         // Longer description of this instance
+	DescriptionText         string       `validate:"-" bun:""`                                                                         // Raw markdown source of the description, for local instance only
+	Terms                   string       `validate:"-" bun:""`                                                                         // Terms and conditions of this instance
+	TermsText               string       `validate:"-" bun:""`                                                                         // Raw markdown source of the terms, for local instance only
+	ContactEmail            string       `validate:"omitempty,email" bun:""`                                                           // Contact email address for this instance
+	ContactAccountUsername  string       `validate:"required_with=ContactAccountID" bun:",nullzero"`                                   // Username of the contact account for this instance
+	ContactAccountID        string       `validate:"required_with=ContactAccountUsername,omitempty,ulid" bun:"type:CHAR(26),nullzero"` // Contact account ID in the database for this instance
+	ContactAccount          *Account     `validate:"-" bun:"rel:belongs-to"`                                                           // account corresponding to contactAccountID
+	DeputyContactAccountIDs []string     `validate:"dive,ulid" bun:"deputy_contact_account_ids,array"`                                 // IDs of further contact accounts for this instance, alongside the main contact account; for local instance only
+	DeputyContactAccounts   []*Account   `validate:"-" bun:"-"`                                                                        // accounts corresponding to deputyContactAccountIDs
+	DeputyContactEmails     []string     `validate:"dive,email" bun:"deputy_contact_emails,array"`                                     // Further contact email addresses for this instance, alongside the main contact email; for local instance only
+	Reputation              int64        `validate:"-" bun:",notnull,default:0"`                                                       // Reputation score of this instance
+	Version                 string       `validate:"-" bun:",nullzero"`                                                                // Version of the software used on this instance
 }
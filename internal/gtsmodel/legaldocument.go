@@ -0,0 +1,46 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// LegalDocument is one version of a document which users of this instance agree to, like its terms of service or
+// privacy policy. Versions are never edited once published: to change a document, admins publish a new version.
+type LegalDocument struct {
+	ID                string            `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                       // id of this item in the database
+	CreatedAt         time.Time         `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                // when was item created, ie., when was this version published
+	Kind              LegalDocumentKind `validate:"oneof=terms_of_service privacy_policy" bun:",nullzero,notnull,unique:legaldocument"` // what kind of document is this
+	Version           int               `validate:"min=1" bun:",notnull,unique:legaldocument"`                                          // version of this document, counting up from 1 for each kind
+	Text              string            `validate:"required" bun:",nullzero,notnull"`                                                   // raw markdown source of the document
+	Content           string            `validate:"-" bun:",nullzero"`                                                                  // html of the document, rendered from text
+	Changelog         string            `validate:"-" bun:",nullzero"`                                                                  // plain text summary of what changed since the previous version
+	RequireAcceptance *bool             `validate:"-" bun:",nullzero,notnull,default:false"`                                            // must users accept this version before they can sign in again?
+	AccountID         string            `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                                 // Account ID of the admin who published this version
+	Account           *Account          `validate:"-" bun:"rel:belongs-to"`                                                             // Account corresponding to accountID
+}
+
+// LegalDocumentKind describes which document a legal document is a version of.
+type LegalDocumentKind string
+
+const (
+	// LegalDocumentTermsOfService -- the terms of service of this instance.
+	LegalDocumentTermsOfService LegalDocumentKind = "terms_of_service"
+	// LegalDocumentPrivacyPolicy -- the privacy policy of this instance.
+	LegalDocumentPrivacyPolicy LegalDocumentKind = "privacy_policy"
+)
@@ -0,0 +1,52 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// List refers to a list of follows of one account, whose statuses are shown together in a list timeline.
+type List struct {
+	ID            string            `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt     time.Time         `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time         `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Title         string            `validate:"required" bun:",nullzero,notnull"`                                    // title of this list, as chosen by its owner
+	AccountID     string            `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // id of the account that owns the list
+	Account       *Account          `validate:"-" bun:"rel:belongs-to"`                                              // account that owns the list
+	RepliesPolicy ListRepliesPolicy `validate:"oneof=followed list none" bun:",nullzero,notnull,default:'list'"`     // which replies should be shown in the list timeline
+}
+
+// ListEntry refers to one follow of the owner of a list having been added to that list.
+type ListEntry struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	ListID    string    `validate:"required,ulid" bun:"type:CHAR(26),unique:listentry,nullzero,notnull"` // id of the list this entry is in
+	FollowID  string    `validate:"required,ulid" bun:"type:CHAR(26),unique:listentry,nullzero,notnull"` // id of the follow added to the list
+	Follow    *Follow   `validate:"-" bun:"rel:belongs-to"`                                              // follow added to the list
+}
+
+// ListRepliesPolicy describes which replies by members of a list are shown in its timeline.
+type ListRepliesPolicy string
+
+// ListRepliesPolicy values.
+const (
+	ListRepliesPolicyFollowed ListRepliesPolicy = "followed" // show replies to any account followed by the list owner
+	ListRepliesPolicyList     ListRepliesPolicy = "list"     // show replies to members of the list
+	ListRepliesPolicyNone     ListRepliesPolicy = "none"     // show replies to nobody
+)
@@ -41,6 +41,7 @@ type MediaAttachment struct {
 	Processing        ProcessingStatus `validate:"oneof=0 1 2 666" bun:",notnull,default:2"`                                           // What is the processing status of this attachment
 	File              File             `validate:"required" bun:",embed:file_,notnull,nullzero"`                                       // metadata for the whole file
 	Thumbnail         Thumbnail        `validate:"required" bun:",embed:thumbnail_,notnull,nullzero"`                                  // small image thumbnail derived from a larger image, video, or audio file.
+	Medium            Thumbnail        `validate:"-" bun:",embed:medium_,nullzero"`                                                    // medium-sized version of a large image; empty if the original is small enough to be used instead.
 	Avatar            *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                                            // Is this attachment being used as an avatar?
 	Header            *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                                            // Is this attachment being used as a header?
 	Cached            *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                                            // Is this attachment currently cached by our instance?
@@ -91,6 +92,7 @@ const (
 type FileMeta struct {
 	Original Original `validate:"required" bun:"embed:original_"`
 	Small    Small    `bun:"embed:small_"`
+	Medium   Medium   `bun:"embed:medium_"`
 	Focus    Focus    `bun:"embed:focus_"`
 }
 
@@ -102,6 +104,14 @@ type Small struct {
 	Aspect float64 `validate:"required_with=Widhth Height Size"`  // aspect ratio (width / height)
 }
 
+// Medium can be used for a medium-sized version of an image
+type Medium struct {
+	Width  int     `validate:"required_with=Height Size Aspect"`  // width in pixels
+	Height int     `validate:"required_with=Width Size Aspect"`   // height in pixels
+	Size   int     `validate:"required_with=Width Height Aspect"` // size in pixels (width * height)
+	Aspect float64 `validate:"required_with=Width Height Size"`   // aspect ratio (width / height)
+}
+
 // Original can be used for original metadata for any media type
 type Original struct {
 	Width  int     `validate:"required_with=Height Size Aspect"`  // width in pixels
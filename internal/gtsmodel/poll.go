@@ -0,0 +1,53 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Poll represents a poll attached to a status, either local or remote.
+type Poll struct {
+	ID         string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated, ie., when were the vote counts last changed
+	StatusID   string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // id of the status this poll is attached to
+	Options    []string  `validate:"min=2,dive,required" bun:"options,array"`                             // titles of the options that can be voted for, in order
+	Votes      []int     `validate:"-" bun:""`                                                            // number of votes for each option, in the same order as Options
+	Voters     int       `validate:"min=0" bun:",notnull,default:0"`                                      // number of accounts which have voted in this poll
+	Multiple   *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // can more than one option be chosen?
+	HideCounts *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // should vote counts be hidden from everyone but the author until the poll has ended?
+	ExpiresAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when does this poll end? zero if it doesn't
+	ClosedAt   time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was this poll closed and its voters notified? zero if it hasn't been yet
+}
+
+// Expired returns true if the poll has ended at the given time.
+func (p *Poll) Expired(now time.Time) bool {
+	return !p.ClosedAt.IsZero() || (!p.ExpiresAt.IsZero() && !now.Before(p.ExpiresAt))
+}
+
+// PollVote represents one choice made by an account voting in a poll. An account voting
+// for more than one option of a multiple choice poll has one PollVote per option.
+type PollVote struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	URI       string    `validate:"required,url" bun:",unique,nullzero,notnull"`                         // ActivityPub URI of the Note this vote was federated as
+	PollID    string    `validate:"required,ulid" bun:"type:CHAR(26),unique:pollvote,nullzero,notnull"`  // id of the poll voted in
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:pollvote,nullzero,notnull"`  // id of the account which voted
+	Account   *Account  `validate:"-" bun:"rel:belongs-to"`                                              // account which voted
+	Choice    int       `validate:"min=0" bun:",unique:pollvote,notnull"`                                // index of the option voted for
+}
@@ -27,6 +27,7 @@ type Status struct {
 	ID                       string             `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                              // id of this item in the database
 	CreatedAt                time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                       // when was item created
 	UpdatedAt                time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                       // when was item last updated
+	DeletedAt                time.Time          `validate:"-" bun:"type:timestamptz,soft_delete,nullzero"`                                             // when was item marked as deleted; deleted items are hidden and purged later
 	URI                      string             `validate:"required,url" bun:",unique,nullzero,notnull"`                                               // activitypub URI of this status
 	URL                      string             `validate:"url" bun:",nullzero"`                                                                       // web url for viewing this status
 	Content                  string             `validate:"-" bun:""`                                                                                  // content of this status; likely html-formatted but not guaranteed
@@ -64,6 +65,8 @@ type Status struct {
 	Boostable                *bool              `validate:"-" bun:",notnull"`                                                                          // This status can be boosted/reblogged
 	Replyable                *bool              `validate:"-" bun:",notnull"`                                                                          // This status can be replied to
 	Likeable                 *bool              `validate:"-" bun:",notnull"`                                                                          // This status can be liked/faved
+	PollID                   string             `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                               // id of the poll attached to this status, if any
+	Poll                     *Poll              `validate:"-" bun:"-"`                                                                                 // poll corresponding to pollID
 }
 
 /*
@@ -31,4 +31,5 @@ type StatusBookmark struct {
 	TargetAccount   *Account  `validate:"-" bun:"rel:belongs-to"`                                              // account owning the bookmarked status
 	StatusID        string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // database id of the status that has been bookmarked
 	Status          *Status   `validate:"-" bun:"rel:belongs-to"`                                              // the bookmarked status
+	FolderID        string    `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // id of the bookmark folder that the bookmark is filed in, if any
 }
@@ -0,0 +1,34 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// StatusDelivery is the outcome of the most recent delivery of a local status to the inboxes on one
+// remote domain, kept so that the author can see which servers did, or didn't, receive their status.
+type StatusDelivery struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                   // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`            // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`            // when was item last updated, ie., when was the status last delivered to this domain
+	StatusID  string    `validate:"required,ulid" bun:"type:CHAR(26),unique:statusdeliverydomain,notnull,nullzero"` // ID of the status which was delivered
+	Domain    string    `validate:"required" bun:",unique:statusdeliverydomain,notnull,nullzero"`                   // domain the status was delivered to
+	Delivered int       `validate:"min=0" bun:",notnull,default:0"`                                                 // number of inboxes on the domain which accepted the status
+	Failed    int       `validate:"min=0" bun:",notnull,default:0"`                                                 // number of inboxes on the domain which couldn't be delivered to
+	Error     string    `validate:"-" bun:",nullzero"`                                                              // error from the last failed delivery to the domain, if any
+}
@@ -0,0 +1,32 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// StatusViewCount is the number of times a local status has been viewed. Only these
+// running totals are stored; nothing about who viewed the status, or when, is kept.
+type StatusViewCount struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated, ie., when was the status last viewed
+	StatusID  string    `validate:"required,ulid" bun:"type:CHAR(26),unique,notnull,nullzero"`           // ID of the status which was viewed
+	WebViews  int       `validate:"min=0" bun:",notnull,default:0"`                                      // number of times the web page of the status has been viewed
+	Fetches   int       `validate:"min=0" bun:",notnull,default:0"`                                      // number of times the status has been fetched over ActivityPub by other servers
+}
@@ -35,4 +35,10 @@ type Tombstone struct {
 	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
 	Domain    string    `validate:"omitempty,fqdn" bun:",nullzero,notnull"`                              // Domain of the Object/Actor.
 	URI       string    `validate:"required,url" bun:",nullzero,notnull,unique"`                         // ActivityPub URI for this Object/Actor.
+	ExpiresAt time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when this tombstone stops applying; zero means never
+}
+
+// Expired returns true if the tombstone has expired at the given time.
+func (t *Tombstone) Expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
 }
@@ -0,0 +1,153 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// english is the language that messages are written in, and the one
+// that's used when nothing better matches.
+const english = "en"
+
+// Language is one of the languages that messages can be translated into.
+type Language struct {
+	// Tag is the BCP 47 language tag of the language, eg., en or pt-BR.
+	Tag string
+	// Name is the name of the language, in that language, eg., Deutsch.
+	Name string
+}
+
+// catalogFile is the format of a message catalog file. Files are named for
+// the language they translate into, eg., de.json or pt-BR.json.
+type catalogFile struct {
+	// Name of the language, in that language.
+	Name string `json:"name"`
+	// Messages maps english messages to their translations.
+	Messages map[string]string `json:"messages"`
+}
+
+// Catalog holds translations of messages into each supported language.
+//
+// Messages are keyed by their english text, so a message which hasn't been
+// translated into a language is just shown in english.
+type Catalog struct {
+	languages []Language
+	tags      []language.Tag
+	matcher   language.Matcher
+	messages  map[string]map[string]string
+}
+
+// NewCatalog returns a catalog which only supports english.
+func NewCatalog() *Catalog {
+	c := &Catalog{messages: make(map[string]map[string]string)}
+	c.add(language.English, Language{Tag: english, Name: "English"}, nil)
+	return c
+}
+
+// LoadCatalog returns a catalog with the translations from each message catalog
+// file (*.json) in dir, as well as english.
+func LoadCatalog(dir string) (*Catalog, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("error listing message catalogs in %s: %w", dir, err)
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%s doesn't seem to contain any message catalogs (*.json)", dir)
+	}
+
+	// load in a predictable order
+	sort.Strings(paths)
+
+	c := NewCatalog()
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		tag, err := language.Parse(name)
+		if err != nil {
+			return nil, fmt.Errorf("message catalog %s isn't named for a language: %w", path, err)
+		}
+
+		if tag == language.English {
+			// messages are already english
+			continue
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading message catalog %s: %w", path, err)
+		}
+
+		file := catalogFile{}
+		if err := json.Unmarshal(b, &file); err != nil {
+			return nil, fmt.Errorf("error parsing message catalog %s: %w", path, err)
+		}
+
+		if file.Name == "" {
+			return nil, fmt.Errorf("message catalog %s doesn't give the name of its language", path)
+		}
+
+		c.add(tag, Language{Tag: tag.String(), Name: file.Name}, file.Messages)
+	}
+
+	return c, nil
+}
+
+func (c *Catalog) add(tag language.Tag, lang Language, messages map[string]string) {
+	c.languages = append(c.languages, lang)
+	c.tags = append(c.tags, tag)
+	c.matcher = language.NewMatcher(c.tags)
+	c.messages[lang.Tag] = messages
+}
+
+// Languages returns the languages supported by the catalog, english first.
+func (c *Catalog) Languages() []Language {
+	return c.languages
+}
+
+// Translate returns msg translated into the supported language lang, or msg
+// unchanged if the catalog has no translation of it into that language.
+func (c *Catalog) Translate(lang string, msg string) string {
+	if translated, ok := c.messages[lang][msg]; ok && translated != "" {
+		return translated
+	}
+	return msg
+}
+
+// Match returns the tag of the supported language which best matches the given
+// language tags, which are in order of preference. If none of them match, ok is false.
+func (c *Catalog) Match(tags ...language.Tag) (lang string, ok bool) {
+	if len(tags) == 0 {
+		return "", false
+	}
+
+	_, i, confidence := c.matcher.Match(tags...)
+	if confidence == language.No {
+		return "", false
+	}
+
+	return c.languages[i].Tag, true
+}
@@ -0,0 +1,138 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package i18n translates web pages and error messages into the language
+// of whoever is reading them, using message catalogs loaded at startup.
+package i18n
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/text/language"
+)
+
+const (
+	// LangQueryKey is the query parameter which visitors can use to choose the language of web pages.
+	LangQueryKey = "lang"
+	// LangCookie is the cookie which remembers the language a visitor chose with LangQueryKey.
+	LangCookie = "lang"
+
+	langCookieMaxAge = 365 * 24 * time.Hour
+)
+
+var catalog atomic.Pointer[Catalog]
+
+func init() {
+	catalog.Store(NewCatalog())
+}
+
+// Load loads the message catalogs in the configured i18n dir, replacing any that were
+// loaded before. If the dir is not set, messages will only be shown in english.
+func Load() error {
+	c := NewCatalog()
+
+	if dir := config.GetWebI18nDir(); dir != "" {
+		var err error
+		if c, err = LoadCatalog(dir); err != nil {
+			return err
+		}
+
+		for _, lang := range c.Languages() {
+			log.Infof("loaded messages for language %s (%s)", lang.Tag, lang.Name)
+		}
+	}
+
+	if instanceLanguage := config.GetInstanceLanguage(); instanceLanguage != "" {
+		if _, ok := match(c, instanceLanguage); !ok {
+			log.Warnf("no messages for %s language %s, so english will be used instead", config.InstanceLanguageFlag(), instanceLanguage)
+		}
+	}
+
+	catalog.Store(c)
+	return nil
+}
+
+// Languages returns the languages that messages can be shown in, english first.
+func Languages() []Language {
+	return catalog.Load().Languages()
+}
+
+// Translate returns msg translated into the given language, as returned by
+// Negotiate, or msg unchanged if there's no translation for it.
+func Translate(lang string, msg string) string {
+	return catalog.Load().Translate(lang, msg)
+}
+
+// Negotiate returns the language to use when responding to the request in c.
+//
+// In order of preference, this is: a language the visitor chose with the lang query
+// parameter, which is then remembered in a cookie; the given locale of the user making
+// the request, if any; a language remembered in the cookie; a language from the
+// request's Accept-Language header; the instance language; and finally english.
+func Negotiate(c *gin.Context, userLocale string) string {
+	cat := catalog.Load()
+
+	if lang, ok := match(cat, c.Query(LangQueryKey)); ok {
+		c.SetSameSite(http.SameSiteLaxMode)
+		c.SetCookie(LangCookie, lang, int(langCookieMaxAge.Seconds()), "/", "", config.GetProtocol() == "https", true)
+		return lang
+	}
+
+	if lang, ok := match(cat, userLocale); ok {
+		return lang
+	}
+
+	if cookie, err := c.Cookie(LangCookie); err == nil {
+		if lang, ok := match(cat, cookie); ok {
+			return lang
+		}
+	}
+
+	if header := c.GetHeader("Accept-Language"); header != "" {
+		if tags, _, err := language.ParseAcceptLanguage(header); err == nil {
+			if lang, ok := cat.Match(tags...); ok {
+				return lang
+			}
+		}
+	}
+
+	if lang, ok := match(cat, config.GetInstanceLanguage()); ok {
+		return lang
+	}
+
+	return english
+}
+
+// match returns the supported language which best matches the given language tag, if any.
+func match(cat *Catalog, s string) (string, bool) {
+	if s == "" {
+		return "", false
+	}
+
+	tag, err := language.Parse(s)
+	if err != nil {
+		return "", false
+	}
+
+	return cat.Match(tag)
+}
@@ -0,0 +1,123 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package i18n_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+	"golang.org/x/text/language"
+)
+
+type I18nTestSuite struct {
+	suite.Suite
+}
+
+func (suite *I18nTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	config.SetWebI18nDir("../../web/i18n/")
+	if err := i18n.Load(); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *I18nTestSuite) TearDownTest() {
+	config.SetWebI18nDir("")
+	if err := i18n.Load(); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *I18nTestSuite) request(target string, header http.Header) (*gin.Context, *httptest.ResponseRecorder) {
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	for k, v := range header {
+		c.Request.Header[k] = v
+	}
+	return c, recorder
+}
+
+func (suite *I18nTestSuite) TestLoadCatalog() {
+	catalog, err := i18n.LoadCatalog("../../web/i18n/")
+	suite.NoError(err)
+
+	languages := catalog.Languages()
+	suite.Equal(i18n.Language{Tag: "en", Name: "English"}, languages[0])
+	suite.Contains(languages, i18n.Language{Tag: "de", Name: "Deutsch"})
+
+	suite.Equal("Seite nicht gefunden", catalog.Translate("de", "Page Not Found"))
+	suite.Equal("Page Not Found", catalog.Translate("en", "Page Not Found"))
+	suite.Equal("some message nobody translated", catalog.Translate("de", "some message nobody translated"))
+
+	lang, ok := catalog.Match(language.MustParse("de-AT"))
+	suite.True(ok)
+	suite.Equal("de", lang)
+
+	_, ok = catalog.Match(language.MustParse("ja"))
+	suite.False(ok)
+}
+
+func (suite *I18nTestSuite) TestLoadCatalogEmptyDir() {
+	_, err := i18n.LoadCatalog(suite.T().TempDir())
+	suite.ErrorContains(err, "doesn't seem to contain any message catalogs")
+}
+
+func (suite *I18nTestSuite) TestNegotiate() {
+	// nothing asked for, so the instance language
+	c, _ := suite.request("/", nil)
+	suite.Equal("en", i18n.Negotiate(c, ""))
+
+	config.SetInstanceLanguage("fr")
+	c, _ = suite.request("/", nil)
+	suite.Equal("fr", i18n.Negotiate(c, ""))
+
+	// the browser's languages come before the instance language
+	c, _ = suite.request("/", http.Header{"Accept-Language": {"ja, nl;q=0.8, de;q=0.5"}})
+	suite.Equal("nl", i18n.Negotiate(c, ""))
+
+	// and the user's own setting comes before the browser's
+	c, _ = suite.request("/", http.Header{"Accept-Language": {"nl"}})
+	suite.Equal("es", i18n.Negotiate(c, "es"))
+
+	// a language chosen with the query parameter beats everything, and is remembered
+	c, recorder := suite.request("/?lang=de", http.Header{"Accept-Language": {"nl"}})
+	suite.Equal("de", i18n.Negotiate(c, "es"))
+	cookies := recorder.Result().Cookies()
+	suite.Len(cookies, 1)
+	suite.Equal(i18n.LangCookie, cookies[0].Name)
+	suite.Equal("de", cookies[0].Value)
+
+	c, _ = suite.request("/", http.Header{"Accept-Language": {"nl"}, "Cookie": {i18n.LangCookie + "=de"}})
+	suite.Equal("de", i18n.Negotiate(c, ""))
+
+	// languages we have no messages for are skipped
+	c, _ = suite.request("/?lang=ja", http.Header{"Accept-Language": {"ja"}})
+	suite.Equal("fr", i18n.Negotiate(c, "not a language"))
+}
+
+func TestI18nTestSuite(t *testing.T) {
+	suite.Run(t, new(I18nTestSuite))
+}
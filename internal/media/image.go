@@ -35,6 +35,8 @@ import (
 const (
 	thumbnailMaxWidth  = 512
 	thumbnailMaxHeight = 512
+	mediumMaxWidth     = 1280
+	mediumMaxHeight    = 1280
 )
 
 type imageMeta struct {
@@ -43,7 +45,7 @@ type imageMeta struct {
 	size     int
 	aspect   float64
 	blurhash string // defined only for calls to deriveThumbnail if createBlurhash is true
-	small    []byte // defined only for calls to deriveStaticEmoji or deriveThumbnail
+	small    []byte // defined only for calls to deriveStaticEmoji, deriveThumbnail, or deriveMedium
 }
 
 func decodeGif(r io.Reader) (*imageMeta, error) {
@@ -178,12 +180,72 @@ func deriveThumbnail(r io.Reader, contentType string, createBlurhash bool) (*ima
 	return im, nil
 }
 
+// needsMedium returns true if an image of the given content type and dimensions
+// is big enough that a medium-sized version of it should be derived. Gifs are left
+// alone, since re-encoding them as jpeg would lose any animation.
+func needsMedium(contentType string, width int, height int) bool {
+	if contentType != mimeImageJpeg && contentType != mimeImagePng {
+		return false
+	}
+	return width > mediumMaxWidth || height > mediumMaxHeight
+}
+
+// deriveMedium returns a byte slice and metadata for a medium-sized
+// jpeg version of the given jpeg or png, or an error if something goes wrong.
+func deriveMedium(r io.Reader, contentType string) (*imageMeta, error) {
+	var i image.Image
+	var err error
+
+	switch contentType {
+	case mimeImageJpeg:
+		i, err = imaging.Decode(r, imaging.AutoOrientation(true))
+	case mimeImagePng:
+		strippedPngReader := io.Reader(&PNGAncillaryChunkStripper{
+			Reader: r,
+		})
+		i, err = imaging.Decode(strippedPngReader, imaging.AutoOrientation(true))
+	default:
+		err = fmt.Errorf("content type %s can't be resized", contentType)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s: %s", contentType, err)
+	}
+
+	medium := imaging.Fit(i, mediumMaxWidth, mediumMaxHeight, imaging.Linear)
+
+	mediumX := medium.Bounds().Size().X
+	mediumY := medium.Bounds().Size().Y
+
+	out := &bytes.Buffer{}
+	if err := jpeg.Encode(out, medium, &jpeg.Options{
+		// this version may be looked at up close, so use a bit better quality than thumbnails
+		Quality: 85,
+	}); err != nil {
+		return nil, fmt.Errorf("error encoding medium: %s", err)
+	}
+
+	return &imageMeta{
+		width:  mediumX,
+		height: mediumY,
+		size:   mediumX * mediumY,
+		aspect: float64(mediumX) / float64(mediumY),
+		small:  out.Bytes(),
+	}, nil
+}
+
 // deriveStaticEmojji takes a given gif or png of an emoji, decodes it, and re-encodes it as a static png.
+// Svg emojis, which have already been sanitized on the way into storage, are rasterized into a png instead.
 func deriveStaticEmoji(r io.Reader, contentType string) (*imageMeta, error) {
 	var i image.Image
 	var err error
 
 	switch contentType {
+	case mimeImageSvg:
+		i, err = rasterizeSVG(r)
+		if err != nil {
+			return nil, err
+		}
 	case mimeImagePng:
 		i, err = StrippedPngDecode(r)
 		if err != nil {
@@ -92,6 +92,14 @@ type Manager interface {
 	// The returned int is the amount of media that was pruned by this function.
 	PruneUnusedLocalAttachments(ctx context.Context) (int, error)
 
+	// RegenerateEmojiStatics walks through every emoji stored on this instance, and
+	// regenerates the static image of any emoji whose static is missing from storage,
+	// or doesn't match what's recorded in the database, or can't be decoded. This is
+	// useful after storage migrations, or after bugs in static encoding are fixed.
+	//
+	// The returned int is the amount of emojis whose static was regenerated by this function.
+	RegenerateEmojiStatics(ctx context.Context) (int, error)
+
 	// Stop stops the underlying worker pool of the manager. It should be called
 	// when closing GoToSocial in order to cleanly finish any in-progress jobs.
 	// It will block until workers are finished processing.
@@ -22,9 +22,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"codeberg.org/gruf/go-store/v2/kv"
@@ -194,6 +197,127 @@ func (suite *ManagerTestSuite) TestEmojiProcessBlockingRefresh() {
 	suite.ErrorIs(err, storage.ErrNotFound)
 }
 
+func (suite *ManagerTestSuite) TestEmojiProcessBlockingSVG() {
+	ctx := context.Background()
+
+	data := func(_ context.Context) (io.ReadCloser, int64, error) {
+		// load bytes from a test svg with some nasty stuff in it
+		b, err := os.ReadFile("./test/test-svg-unsafe.svg")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), int64(len(b)), nil
+	}
+
+	emojiID := "01GDQ9G782X42BAMFASKP64343"
+	emojiURI := "http://localhost:8080/emoji/01GDQ9G782X42BAMFASKP64343"
+
+	processingEmoji, err := suite.manager.ProcessEmoji(ctx, data, nil, "rainbow_heart", emojiID, emojiURI, nil, false)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the emoji
+	emoji, err := processingEmoji.LoadEmoji(ctx)
+	suite.NoError(err)
+	suite.NotNil(emoji)
+
+	// the original should be served as an svg, and the static as a png
+	suite.Equal("image/svg+xml", emoji.ImageContentType)
+	suite.Equal("image/png", emoji.ImageStaticContentType)
+	suite.True(strings.HasSuffix(emoji.ImagePath, ".svg"))
+	suite.True(strings.HasSuffix(emoji.ImageStaticPath, ".png"))
+	suite.True(strings.HasSuffix(emoji.ImageStaticURL, ".png"))
+	suite.Equal(811, emoji.ImageFileSize)
+
+	// the stored svg should have had scripts,
+	// external references etc stripped out of it
+	processedExpected, err := os.ReadFile("./test/test-svg-sanitized.svg")
+	suite.NoError(err)
+
+	processedFullBytes, err := suite.storage.Get(ctx, emoji.ImagePath)
+	suite.NoError(err)
+	suite.Equal(processedExpected, processedFullBytes)
+
+	// the static should be the svg drawn onto a png
+	processedStaticBytes, err := suite.storage.Get(ctx, emoji.ImageStaticPath)
+	suite.NoError(err)
+	suite.Equal(len(processedStaticBytes), emoji.ImageStaticFileSize)
+
+	static, err := png.Decode(bytes.NewReader(processedStaticBytes))
+	suite.NoError(err)
+	suite.Equal(image.Rect(0, 0, 128, 128), static.Bounds())
+
+	// transparent in the corner, and the yellow
+	// middle of the rainbow in the middle
+	_, _, _, a := static.At(0, 0).RGBA()
+	suite.Zero(a)
+	r, g, b, a := static.At(64, 64).RGBA()
+	suite.Greater(r>>8, uint32(200))
+	suite.Greater(g>>8, uint32(200))
+	suite.Less(b>>8, uint32(50))
+	suite.Equal(uint32(0xffff), a)
+}
+
+func (suite *ManagerTestSuite) TestEmojiProcessBlockingSVGCSSEscape() {
+	ctx := context.Background()
+
+	data := func(_ context.Context) (io.ReadCloser, int64, error) {
+		// u\72l( is url( with a css escape in it
+		b := []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 1 1"><style>path { fill: u\72l(https://example.org/evil.svg#a); }</style></svg>`)
+		return io.NopCloser(bytes.NewBuffer(b)), int64(len(b)), nil
+	}
+
+	emojiID := "01GDQ9G782X42BAMFASKP64343"
+	emojiURI := "http://localhost:8080/emoji/01GDQ9G782X42BAMFASKP64343"
+
+	processingEmoji, err := suite.manager.ProcessEmoji(ctx, data, nil, "evil", emojiID, emojiURI, nil, false)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the emoji
+	emoji, err := processingEmoji.LoadEmoji(ctx)
+	suite.EqualError(err, "store: error sanitizing svg: sanitizeSVG: svg style sheet imports or references external resources")
+	suite.Nil(emoji)
+}
+
+func (suite *ManagerTestSuite) TestEmojiProcessBlockingSVGExternalStyle() {
+	ctx := context.Background()
+
+	data := func(_ context.Context) (io.ReadCloser, int64, error) {
+		b := []byte(`<svg xmlns="http://www.w3.org/2000/svg"><style>@import url(https://example.org/evil.css);</style></svg>`)
+		return io.NopCloser(bytes.NewBuffer(b)), int64(len(b)), nil
+	}
+
+	emojiID := "01GDQ9G782X42BAMFASKP64343"
+	emojiURI := "http://localhost:8080/emoji/01GDQ9G782X42BAMFASKP64343"
+
+	processingEmoji, err := suite.manager.ProcessEmoji(ctx, data, nil, "evil", emojiID, emojiURI, nil, false)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the emoji
+	emoji, err := processingEmoji.LoadEmoji(ctx)
+	suite.EqualError(err, "store: error sanitizing svg: sanitizeSVG: svg style sheet imports or references external resources")
+	suite.Nil(emoji)
+}
+
+func (suite *ManagerTestSuite) TestEmojiProcessBlockingNotSVG() {
+	ctx := context.Background()
+
+	data := func(_ context.Context) (io.ReadCloser, int64, error) {
+		b := []byte(`<html><body><script>alert(1)</script></body></html>`)
+		return io.NopCloser(bytes.NewBuffer(b)), int64(len(b)), nil
+	}
+
+	emojiID := "01GDQ9G782X42BAMFASKP64343"
+	emojiURI := "http://localhost:8080/emoji/01GDQ9G782X42BAMFASKP64343"
+
+	processingEmoji, err := suite.manager.ProcessEmoji(ctx, data, nil, "not_an_svg", emojiID, emojiURI, nil, false)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the emoji
+	emoji, err := processingEmoji.LoadEmoji(ctx)
+	suite.EqualError(err, "store: error sanitizing svg: sanitizeSVG: root element was not svg")
+	suite.Nil(emoji)
+}
+
 func (suite *ManagerTestSuite) TestEmojiProcessBlockingTooLarge() {
 	ctx := context.Background()
 
@@ -374,6 +498,18 @@ func (suite *ManagerTestSuite) TestSimpleJpegProcessBlocking() {
 	suite.NotEmpty(processedThumbnailBytesExpected)
 
 	suite.Equal(processedThumbnailBytesExpected, processedThumbnailBytes)
+
+	// the original is wider than 1280px so a medium variant should have been made
+	suite.EqualValues(gtsmodel.Medium{
+		Width: 1280, Height: 720, Size: 921600, Aspect: 1.7777777777777777,
+	}, attachment.FileMeta.Medium)
+	suite.Equal("image/jpeg", attachment.Medium.ContentType)
+	suite.NotEmpty(attachment.Medium.URL)
+
+	processedMediumBytes, err := suite.storage.Get(ctx, attachment.Medium.Path)
+	suite.NoError(err)
+	suite.NotEmpty(processedMediumBytes)
+	suite.Equal(len(processedMediumBytes), attachment.Medium.FileSize)
 }
 
 func (suite *ManagerTestSuite) TestSimpleJpegProcessBlockingNoContentLengthGiven() {
@@ -559,6 +695,10 @@ func (suite *ManagerTestSuite) TestPngNoAlphaChannelProcessBlocking() {
 	suite.EqualValues(gtsmodel.Small{
 		Width: 186, Height: 187, Size: 34782, Aspect: 0.9946524064171123,
 	}, attachment.FileMeta.Small)
+
+	// the image is small enough that no medium variant is needed
+	suite.Empty(attachment.Medium.Path)
+	suite.Zero(attachment.FileMeta.Medium)
 	suite.Equal("image/png", attachment.File.ContentType)
 	suite.Equal("image/jpeg", attachment.Thumbnail.ContentType)
 	suite.Equal(17471, attachment.File.FileSize)
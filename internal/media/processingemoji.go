@@ -122,6 +122,11 @@ func (p *ProcessingEmoji) LoadEmoji(ctx context.Context) (*gtsmodel.Emoji, error
 			}
 		} else {
 			if err := p.database.PutEmoji(ctx, p.emoji); err != nil {
+				if errors.Is(err, db.ErrAlreadyExists) {
+					// another process stored an emoji with the same shortcode + domain
+					// in the meantime, so the images we just stored will never be used
+					p.removeStored(ctx)
+				}
 				return nil, err
 			}
 		}
@@ -131,6 +136,16 @@ func (p *ProcessingEmoji) LoadEmoji(ctx context.Context) (*gtsmodel.Emoji, error
 	return p.emoji, nil
 }
 
+// removeStored removes the full size and static images of this emoji from storage.
+func (p *ProcessingEmoji) removeStored(ctx context.Context) {
+	if err := p.storage.Delete(ctx, p.emoji.ImagePath); err != nil && !errors.Is(err, gostore.ErrNotFound) {
+		log.Errorf("removeStored: error removing emoji image at %s: %s", p.emoji.ImagePath, err)
+	}
+	if err := p.storage.Delete(ctx, p.emoji.ImageStaticPath); err != nil && !errors.Is(err, gostore.ErrNotFound) {
+		log.Errorf("removeStored: error removing emoji static image at %s: %s", p.emoji.ImageStaticPath, err)
+	}
+}
+
 // Finished returns true if processing has finished for both the thumbnail
 // and full fized version of this piece of media.
 func (p *ProcessingEmoji) Finished() bool {
@@ -217,14 +232,21 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 
 	// extract no more than 261 bytes from the beginning of the file -- this is the header
 	firstBytes := make([]byte, maxFileHeaderBytes)
-	if _, err := rc.Read(firstBytes); err != nil {
+	n, err := rc.Read(firstBytes)
+	if err != nil {
 		return fmt.Errorf("store: error reading initial %d bytes: %s", maxFileHeaderBytes, err)
 	}
+	firstBytes = firstBytes[:n]
 
-	// now we have the file header we can work out the content type from it
+	// now we have the file header we can work out the content type from it;
+	// svgs are text so they don't have magic bytes, but they might still be
+	// an svg if they look like markup -- this is checked properly below
 	contentType, err := parseContentType(firstBytes)
 	if err != nil {
-		return fmt.Errorf("store: error parsing content type: %s", err)
+		if !maybeSVG(firstBytes) {
+			return fmt.Errorf("store: error parsing content type: %s", err)
+		}
+		contentType = mimeImageSvg
 	}
 
 	// bail if this is a type we can't process
@@ -236,6 +258,11 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 	split := strings.Split(contentType, "/")
 	extension := split[1] // something like 'gif'
 
+	// svgs are served with their usual extension, rather than 'svg+xml'
+	if contentType == mimeImageSvg {
+		extension = mimeSvg
+	}
+
 	// set some additional fields on the emoji now that
 	// we know more about what the underlying image actually is
 	var pathID string
@@ -267,6 +294,27 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 		}
 	}
 
+	if contentType == mimeImageSvg {
+		// svgs can contain scripts and references to other resources, so rather than
+		// streaming them into storage as they are, read them in (no more than the size
+		// limit), and store a sanitized version
+		b, err := io.ReadAll(io.LimitReader(readerToStore, maxEmojiSize+1))
+		if err != nil {
+			return fmt.Errorf("store: error reading svg: %s", err)
+		}
+		if int64(len(b)) > maxEmojiSize {
+			return fmt.Errorf("store: discovered emoji fileSize (%db) is larger than allowed size (%db)", len(b), maxEmojiSize)
+		}
+
+		sanitized, err := sanitizeSVG(bytes.NewReader(b))
+		if err != nil {
+			return fmt.Errorf("store: error sanitizing svg: %s", err)
+		}
+
+		readerToStore = bytes.NewReader(sanitized)
+		fileSize = int64(len(sanitized))
+	}
+
 	// store this for now -- other processes can pull it out of storage as they please
 	if fileSize, err = putStream(ctx, p.storage, p.emoji.ImagePath, readerToStore, fileSize); err != nil {
 		if !errors.Is(err, storage.ErrAlreadyExists) {
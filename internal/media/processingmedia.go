@@ -55,6 +55,7 @@ type ProcessingMedia struct {
 
 	thumbState    int32 // the processing state of the media thumbnail
 	fullSizeState int32 // the processing state of the full-sized media
+	mediumState   int32 // the processing state of the medium-sized media
 
 	/*
 		below pointers to database and storage are maintained so that
@@ -78,7 +79,7 @@ func (p *ProcessingMedia) AttachmentID() string {
 	return p.attachment.ID
 }
 
-// LoadAttachment blocks until the thumbnail and fullsize content
+// LoadAttachment blocks until the thumbnail, fullsize, and medium content
 // has been processed, and then returns the completed attachment.
 func (p *ProcessingMedia) LoadAttachment(ctx context.Context) (*gtsmodel.MediaAttachment, error) {
 	log.Tracef("LoadAttachment: getting lock for attachment %s", p.attachment.URL)
@@ -98,6 +99,10 @@ func (p *ProcessingMedia) LoadAttachment(ctx context.Context) (*gtsmodel.MediaAt
 		return nil, err
 	}
 
+	if err := p.loadMedium(ctx); err != nil {
+		return nil, err
+	}
+
 	// store the result in the database before returning it
 	if !p.insertedInDB {
 		if p.recache {
@@ -118,10 +123,12 @@ func (p *ProcessingMedia) LoadAttachment(ctx context.Context) (*gtsmodel.MediaAt
 	return p.attachment, nil
 }
 
-// Finished returns true if processing has finished for both the thumbnail
-// and full fized version of this piece of media.
+// Finished returns true if processing has finished for the thumbnail,
+// full sized, and medium sized versions of this piece of media.
 func (p *ProcessingMedia) Finished() bool {
-	return atomic.LoadInt32(&p.thumbState) == int32(complete) && atomic.LoadInt32(&p.fullSizeState) == int32(complete)
+	return atomic.LoadInt32(&p.thumbState) == int32(complete) &&
+		atomic.LoadInt32(&p.fullSizeState) == int32(complete) &&
+		atomic.LoadInt32(&p.mediumState) == int32(complete)
 }
 
 func (p *ProcessingMedia) loadThumb(ctx context.Context) error {
@@ -252,6 +259,77 @@ func (p *ProcessingMedia) loadFullSize(ctx context.Context) error {
 	return fmt.Errorf("loadFullSize: full size processing status %d unknown", p.fullSizeState)
 }
 
+// loadMedium derives a medium-sized version of the media if the original is
+// large enough to need one. It must be called after loadFullSize, since it relies
+// on the dimensions of the original.
+func (p *ProcessingMedia) loadMedium(ctx context.Context) error {
+	mediumState := atomic.LoadInt32(&p.mediumState)
+	switch processState(mediumState) {
+	case received:
+		ct := p.attachment.File.ContentType
+		original := p.attachment.FileMeta.Original
+		if !needsMedium(ct, original.Width, original.Height) {
+			// the original is small enough to serve as it is
+			atomic.StoreInt32(&p.mediumState, int32(complete))
+			return nil
+		}
+
+		// stream the original file out of storage
+		stored, err := p.storage.GetStream(ctx, p.attachment.File.Path)
+		if err != nil {
+			p.err = fmt.Errorf("loadMedium: error fetching file from storage: %s", err)
+			atomic.StoreInt32(&p.mediumState, int32(errored))
+			return p.err
+		}
+		defer stored.Close()
+
+		medium, err := deriveMedium(stored, ct)
+		if err != nil {
+			p.err = fmt.Errorf("loadMedium: error deriving medium: %s", err)
+			atomic.StoreInt32(&p.mediumState, int32(errored))
+			return p.err
+		}
+
+		// Close stored media now we're done
+		if err := stored.Close(); err != nil {
+			log.Errorf("loadMedium: error closing stored full size: %s", err)
+		}
+
+		p.attachment.Medium = gtsmodel.Thumbnail{
+			URL:         uris.GenerateURIForAttachment(p.attachment.AccountID, string(TypeAttachment), string(SizeMedium), p.attachment.ID, mimeJpeg), // all mediums are encoded as jpeg,
+			Path:        fmt.Sprintf("%s/%s/%s/%s.%s", p.attachment.AccountID, TypeAttachment, SizeMedium, p.attachment.ID, mimeJpeg),                 // all mediums are encoded as jpeg,
+			ContentType: mimeImageJpeg,
+			FileSize:    len(medium.small),
+			UpdatedAt:   time.Now(),
+		}
+
+		// put the medium in storage
+		if err := p.storage.Put(ctx, p.attachment.Medium.Path, medium.small); err != nil && err != storage.ErrAlreadyExists {
+			p.err = fmt.Errorf("loadMedium: error storing medium: %s", err)
+			atomic.StoreInt32(&p.mediumState, int32(errored))
+			return p.err
+		}
+
+		p.attachment.FileMeta.Medium = gtsmodel.Medium{
+			Width:  medium.width,
+			Height: medium.height,
+			Size:   medium.size,
+			Aspect: medium.aspect,
+		}
+
+		// we're done processing the medium!
+		atomic.StoreInt32(&p.mediumState, int32(complete))
+		log.Tracef("loadMedium: finished processing medium for attachment %s", p.attachment.URL)
+		fallthrough
+	case complete:
+		return nil
+	case errored:
+		return p.err
+	}
+
+	return fmt.Errorf("loadMedium: medium processing status %d unknown", p.mediumState)
+}
+
 // store calls the data function attached to p if it hasn't been called yet,
 // and updates the underlying attachment fields as necessary. It will then stream
 // bytes from p's reader directly into storage so that it can be retrieved later.
@@ -82,6 +82,14 @@ func (m *manager) pruneOneAvatarOrHeader(ctx context.Context, attachment *gtsmod
 		}
 	}
 
+	if attachment.Medium.Path != "" {
+		// delete the medium from storage
+		log.Tracef("pruneOneAvatarOrHeader: deleting %s", attachment.Medium.Path)
+		if err := m.storage.Delete(ctx, attachment.Medium.Path); err != nil && err != storage.ErrNotFound {
+			return err
+		}
+	}
+
 	// delete the attachment entry completely
 	return m.db.DeleteByID(ctx, attachment.ID, &gtsmodel.MediaAttachment{})
 }
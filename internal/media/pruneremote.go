@@ -88,6 +88,17 @@ func (m *manager) pruneOneRemote(ctx context.Context, attachment *gtsmodel.Media
 		changed = true
 	}
 
+	if attachment.Medium.Path != "" {
+		// delete the medium from storage
+		log.Tracef("pruneOneRemote: deleting %s", attachment.Medium.Path)
+		if err := m.storage.Delete(ctx, attachment.Medium.Path); err != nil && err != storage.ErrNotFound {
+			return err
+		}
+		cached := false
+		attachment.Cached = &cached
+		changed = true
+	}
+
 	// update the attachment to reflect that we no longer have it cached
 	if changed {
 		return m.db.UpdateByID(ctx, attachment, attachment.ID, "updated_at", "cached")
@@ -81,6 +81,14 @@ func (m *manager) pruneOneLocal(ctx context.Context, attachment *gtsmodel.MediaA
 		}
 	}
 
+	if attachment.Medium.Path != "" {
+		// delete the medium from storage
+		log.Tracef("pruneOneLocal: deleting %s", attachment.Medium.Path)
+		if err := m.storage.Delete(ctx, attachment.Medium.Path); err != nil && err != storage.ErrNotFound {
+			return err
+		}
+	}
+
 	// delete the attachment completely
 	return m.db.DeleteByID(ctx, attachment.ID, attachment)
 }
@@ -0,0 +1,131 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image/png"
+	"time"
+
+	gostore "codeberg.org/gruf/go-store/v2/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (m *manager) RegenerateEmojiStatics(ctx context.Context) (int, error) {
+	var totalRegenerated int
+	var maxID string
+	var emojis []*gtsmodel.Emoji
+	var err error
+
+	// select 20 emojis at a time and check them
+	for emojis, err = m.db.GetAllEmojis(ctx, maxID, selectPruneLimit); err == nil && len(emojis) != 0; emojis, err = m.db.GetAllEmojis(ctx, maxID, selectPruneLimit) {
+		// use the id of the last emoji in the slice as the next 'maxID' value
+		l := len(emojis)
+		log.Tracef("RegenerateEmojiStatics: got %d emojis with maxID < %s", l, maxID)
+		maxID = emojis[l-1].ID
+
+		for _, emoji := range emojis {
+			if m.emojiStaticOK(ctx, emoji) {
+				continue
+			}
+
+			// one emoji with a missing or unreadable original
+			// shouldn't stop us from fixing all the others
+			if err := m.regenerateEmojiStatic(ctx, emoji); err != nil {
+				log.Errorf("RegenerateEmojiStatics: error regenerating static of emoji %s: %s", emoji.ID, err)
+				continue
+			}
+			totalRegenerated++
+		}
+	}
+
+	// make sure we don't have a real error when we leave the loop
+	if err != nil && err != db.ErrNoEntries {
+		return totalRegenerated, err
+	}
+
+	log.Infof("RegenerateEmojiStatics: finished checking emoji statics: regenerated %d entries", totalRegenerated)
+	return totalRegenerated, nil
+}
+
+// emojiStaticOK returns true if the static image of the given emoji is in storage,
+// has the content type and size recorded in the database, and can be decoded.
+func (m *manager) emojiStaticOK(ctx context.Context, emoji *gtsmodel.Emoji) bool {
+	if emoji.ImageStaticContentType != mimeImagePng {
+		return false
+	}
+
+	b, err := m.storage.Get(ctx, emoji.ImageStaticPath)
+	if err != nil {
+		if errors.Is(err, gostore.ErrNotFound) {
+			return false
+		}
+
+		// we can't tell whether the static is broken, so leave it alone
+		log.Errorf("emojiStaticOK: error fetching static of emoji %s from storage: %s", emoji.ID, err)
+		return true
+	}
+
+	if len(b) != emoji.ImageStaticFileSize {
+		return false
+	}
+
+	_, err = png.Decode(bytes.NewReader(b))
+	return err == nil
+}
+
+// regenerateEmojiStatic derives a new static image from the original image of the given
+// emoji, replaces the static in storage with it, and updates the emoji in the database.
+func (m *manager) regenerateEmojiStatic(ctx context.Context, emoji *gtsmodel.Emoji) error {
+	original, err := m.storage.GetStream(ctx, emoji.ImagePath)
+	if err != nil {
+		return fmt.Errorf("error fetching original from storage: %s", err)
+	}
+	defer original.Close()
+
+	static, err := deriveStaticEmoji(original, emoji.ImageContentType)
+	if err != nil {
+		return fmt.Errorf("error deriving static: %s", err)
+	}
+
+	// storage won't overwrite an existing
+	// file, so clear out the broken one first
+	if err := m.storage.Delete(ctx, emoji.ImageStaticPath); err != nil && !errors.Is(err, gostore.ErrNotFound) {
+		return fmt.Errorf("error removing old static from storage: %s", err)
+	}
+
+	if err := m.storage.Put(ctx, emoji.ImageStaticPath, static.small); err != nil {
+		return fmt.Errorf("error storing static: %s", err)
+	}
+
+	emoji.ImageStaticContentType = mimeImagePng
+	emoji.ImageStaticFileSize = len(static.small)
+	emoji.UpdatedAt = time.Now()
+
+	if _, err := m.db.UpdateEmoji(ctx, emoji, "updated_at", "image_static_content_type", "image_static_file_size"); err != nil {
+		return fmt.Errorf("error updating emoji in the database: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"codeberg.org/gruf/go-store/v2/storage"
+	"github.com/stretchr/testify/suite"
+)
+
+type RegenerateEmojiStaticsTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *RegenerateEmojiStaticsTestSuite) TestRegenerateEmojiStaticsNothingToDo() {
+	regenerated, err := suite.manager.RegenerateEmojiStatics(context.Background())
+	suite.NoError(err)
+	suite.Equal(0, regenerated)
+}
+
+func (suite *RegenerateEmojiStaticsTestSuite) TestRegenerateEmojiStaticsMissing() {
+	ctx := context.Background()
+	testEmoji := suite.testEmojis["rainbow"]
+
+	// remove the static from storage, as if it got lost in a storage migration
+	if err := suite.storage.Delete(ctx, testEmoji.ImageStaticPath); err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, err := suite.storage.Get(ctx, testEmoji.ImageStaticPath)
+	suite.ErrorIs(err, storage.ErrNotFound)
+
+	regenerated, err := suite.manager.RegenerateEmojiStatics(ctx)
+	suite.NoError(err)
+	suite.Equal(1, regenerated)
+
+	// the static should be back, and be the same as what we'd derive from the original
+	staticBytes, err := suite.storage.Get(ctx, testEmoji.ImageStaticPath)
+	suite.NoError(err)
+
+	staticBytesExpected, err := os.ReadFile("./test/rainbow-static.png")
+	suite.NoError(err)
+	suite.Equal(staticBytesExpected, staticBytes)
+
+	dbEmoji, err := suite.db.GetEmojiByID(ctx, testEmoji.ID)
+	suite.NoError(err)
+	suite.Equal(len(staticBytesExpected), dbEmoji.ImageStaticFileSize)
+	suite.Equal("image/png", dbEmoji.ImageStaticContentType)
+
+	// now everything's fixed, there should be nothing left to do
+	regenerated, err = suite.manager.RegenerateEmojiStatics(ctx)
+	suite.NoError(err)
+	suite.Equal(0, regenerated)
+}
+
+func (suite *RegenerateEmojiStaticsTestSuite) TestRegenerateEmojiStaticsBroken() {
+	ctx := context.Background()
+	testEmoji := suite.testEmojis["yell"]
+
+	// replace the static with something that isn't a png
+	if err := suite.storage.Delete(ctx, testEmoji.ImageStaticPath); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := suite.storage.Put(ctx, testEmoji.ImageStaticPath, []byte("this is not a png")); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	regenerated, err := suite.manager.RegenerateEmojiStatics(ctx)
+	suite.NoError(err)
+	suite.Equal(1, regenerated)
+
+	staticBytes, err := suite.storage.Get(ctx, testEmoji.ImageStaticPath)
+	suite.NoError(err)
+	suite.NotEqual([]byte("this is not a png"), staticBytes)
+
+	dbEmoji, err := suite.db.GetEmojiByID(ctx, testEmoji.ID)
+	suite.NoError(err)
+	suite.Equal(len(staticBytes), dbEmoji.ImageStaticFileSize)
+}
+
+func TestRegenerateEmojiStaticsTestSuite(t *testing.T) {
+	suite.Run(t, &RegenerateEmojiStaticsTestSuite{})
+}
@@ -0,0 +1,338 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+)
+
+const (
+	svgNamespace   = "http://www.w3.org/2000/svg"
+	xlinkNamespace = "http://www.w3.org/1999/xlink"
+	svgStaticSize  = 128 // length in pixels of the longest side of pngs rasterized from svgs
+)
+
+// svgElements is the set of svg elements that are kept by sanitizeSVG;
+// anything else (script, foreignObject, editor metadata etc) is dropped,
+// along with everything nested inside it.
+var svgElements = map[string]bool{
+	"svg":                 true,
+	"g":                   true,
+	"defs":                true,
+	"symbol":              true,
+	"switch":              true,
+	"use":                 true,
+	"title":               true,
+	"desc":                true,
+	"style":               true,
+	"path":                true,
+	"rect":                true,
+	"circle":              true,
+	"ellipse":             true,
+	"line":                true,
+	"polyline":            true,
+	"polygon":             true,
+	"text":                true,
+	"tspan":               true,
+	"textPath":            true,
+	"image":               true,
+	"linearGradient":      true,
+	"radialGradient":      true,
+	"stop":                true,
+	"clipPath":            true,
+	"mask":                true,
+	"pattern":             true,
+	"marker":              true,
+	"animate":             true,
+	"animateMotion":       true,
+	"animateTransform":    true,
+	"set":                 true,
+	"mpath":               true,
+	"filter":              true,
+	"feBlend":             true,
+	"feColorMatrix":       true,
+	"feComponentTransfer": true,
+	"feComposite":         true,
+	"feConvolveMatrix":    true,
+	"feDiffuseLighting":   true,
+	"feDisplacementMap":   true,
+	"feDistantLight":      true,
+	"feDropShadow":        true,
+	"feFlood":             true,
+	"feFuncA":             true,
+	"feFuncB":             true,
+	"feFuncG":             true,
+	"feFuncR":             true,
+	"feGaussianBlur":      true,
+	"feMerge":             true,
+	"feMergeNode":         true,
+	"feMorphology":        true,
+	"feOffset":            true,
+	"fePointLight":        true,
+	"feSpecularLighting":  true,
+	"feSpotLight":         true,
+	"feTile":              true,
+	"feTurbulence":        true,
+}
+
+// svgURLRegex matches css-style url(...) references in attribute values and style sheets.
+var svgURLRegex = regexp.MustCompile(`(?i)url\(\s*['"]?\s*([^'")\s]*)`)
+
+// svgDataImageRegex matches inline raster images, which are the only
+// non-fragment references allowed in an href.
+var svgDataImageRegex = regexp.MustCompile(`^data:image/(png|gif|jpeg|webp);base64,`)
+
+var (
+	// svgTextEscaper escapes text content when writing sanitized svgs
+	svgTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	// svgAttrEscaper escapes attribute values when writing sanitized svgs
+	svgAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "\n", "&#xA;", "\r", "&#xD;", "\t", "&#x9;")
+)
+
+// maybeSVG returns true if the given file header looks like it could be the
+// start of an svg document; sanitizeSVG does the actual checking.
+func maybeSVG(fileHeader []byte) bool {
+	trimmed := bytes.TrimPrefix(fileHeader, []byte("\xef\xbb\xbf"))
+	trimmed = bytes.TrimSpace(trimmed)
+	return bytes.HasPrefix(trimmed, []byte("<"))
+}
+
+// sanitizeSVG parses the given svg document and writes it back out with anything
+// that could run code or fetch resources from elsewhere removed: scripts and other
+// unknown elements, event handler attributes, and references to anything other than
+// fragments within the document itself or inline raster images. Comments, processing
+// instructions and doctypes are dropped too. An error is returned if the document
+// can't be parsed, or if its root element is not svg.
+func sanitizeSVG(r io.Reader) ([]byte, error) {
+	decoder := xml.NewDecoder(r)
+	out := &bytes.Buffer{}
+
+	var (
+		depth     int  // depth of the element we're currently in
+		skipDepth int  // if > 0, depth of the element we're dropping
+		inStyle   bool // whether we're inside a style element
+		seenRoot  bool
+	)
+
+	for {
+		t, err := decoder.RawToken()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("sanitizeSVG: error parsing svg: %s", err)
+		}
+
+		switch t := t.(type) {
+		case xml.StartElement:
+			depth++
+			if skipDepth > 0 {
+				continue
+			}
+
+			if !seenRoot {
+				if t.Name.Local != "svg" || (t.Name.Space != "" && t.Name.Space != "svg") {
+					return nil, errors.New("sanitizeSVG: root element was not svg")
+				}
+				seenRoot = true
+			} else if depth == 1 {
+				return nil, errors.New("sanitizeSVG: svg had more than one root element")
+			}
+
+			if !svgElementAllowed(t) {
+				skipDepth = depth
+				continue
+			}
+
+			inStyle = t.Name.Local == "style"
+			out.WriteString("<" + svgName(t.Name))
+			for _, attr := range t.Attr {
+				if !svgAttrAllowed(attr) {
+					continue
+				}
+				out.WriteString(" " + svgName(attr.Name) + `="` + svgAttrEscaper.Replace(attr.Value) + `"`)
+			}
+			out.WriteString(">")
+		case xml.EndElement:
+			if skipDepth == 0 {
+				out.WriteString("</" + svgName(t.Name) + ">")
+			} else if skipDepth == depth {
+				skipDepth = 0
+			}
+			inStyle = false
+			depth--
+		case xml.CharData:
+			if skipDepth > 0 || depth == 0 {
+				continue
+			}
+			if inStyle && !svgStyleAllowed(string(t)) {
+				return nil, errors.New("sanitizeSVG: svg style sheet imports or references external resources")
+			}
+			out.WriteString(svgTextEscaper.Replace(string(t)))
+		}
+	}
+
+	if !seenRoot {
+		return nil, errors.New("sanitizeSVG: no svg element found")
+	}
+
+	return out.Bytes(), nil
+}
+
+// svgName returns the qualified name of an element or attribute read with RawToken,
+// where the namespace is the prefix as written in the document.
+func svgName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func svgElementAllowed(t xml.StartElement) bool {
+	if t.Name.Space != "" && t.Name.Space != "svg" {
+		return false
+	}
+
+	if !svgElements[t.Name.Local] {
+		return false
+	}
+
+	// animations can be used to set attributes we'd otherwise strip
+	switch t.Name.Local {
+	case "animate", "set":
+		for _, attr := range t.Attr {
+			if attr.Name.Local != "attributeName" {
+				continue
+			}
+			target := strings.ToLower(attr.Value)
+			if strings.HasSuffix(target, "href") || strings.HasPrefix(target, "on") {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func svgAttrAllowed(attr xml.Attr) bool {
+	local := strings.ToLower(attr.Name.Local)
+
+	switch attr.Name.Space {
+	case "":
+		if local == "xmlns" {
+			// don't let elements switch into another namespace
+			return attr.Value == svgNamespace
+		}
+	case "xmlns":
+		return (local == "svg" && attr.Value == svgNamespace) ||
+			(local == "xlink" && attr.Value == xlinkNamespace)
+	case "xml":
+		return local == "space" || local == "lang"
+	case "xlink":
+		if local != "href" {
+			return false
+		}
+	default:
+		return false
+	}
+
+	// event handlers
+	if strings.HasPrefix(local, "on") {
+		return false
+	}
+
+	value := strings.TrimSpace(attr.Value)
+	if local == "href" {
+		return strings.HasPrefix(value, "#") || svgDataImageRegex.MatchString(value)
+	}
+
+	return svgStyleAllowed(value)
+}
+
+// svgStyleAllowed returns false if the given style sheet or attribute value
+// imports another style sheet, or refers to anything outside the document.
+func svgStyleAllowed(style string) bool {
+	// css escapes can spell out anything, eg., u\72l( for url(, which
+	// the checks below wouldn't see; emojis have no need of them anyway
+	if strings.Contains(style, `\`) {
+		return false
+	}
+
+	if strings.Contains(strings.ToLower(style), "@import") {
+		return false
+	}
+
+	for _, match := range svgURLRegex.FindAllStringSubmatch(style, -1) {
+		if !strings.HasPrefix(match[1], "#") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rasterizeSVG draws the given svg document, which should already have been
+// sanitized, onto an image no more than svgStaticSize pixels wide or high, for
+// clients and servers that can't show svgs. Only the parts of svg that are
+// commonly used for icons are drawn; anything else, such as text, is skipped.
+func rasterizeSVG(r io.Reader) (img image.Image, err error) {
+	// the svg was sent to us by someone else, so don't
+	// let a bug in the parser take the whole server down
+	defer func() {
+		if r := recover(); r != nil {
+			img, err = nil, fmt.Errorf("rasterizeSVG: panic drawing svg: %v", r)
+		}
+	}()
+
+	icon, err := oksvg.ReadIconStream(r, oksvg.IgnoreErrorMode)
+	if err != nil {
+		return nil, fmt.Errorf("rasterizeSVG: error parsing svg: %s", err)
+	}
+
+	viewWidth, viewHeight := icon.ViewBox.W, icon.ViewBox.H
+	if !(viewWidth > 0 && viewHeight > 0) {
+		return nil, errors.New("rasterizeSVG: svg has no viewBox, or width and height")
+	}
+
+	// scale to fit, keeping the aspect ratio
+	width, height := svgStaticSize, svgStaticSize
+	if viewWidth > viewHeight {
+		height = int(math.Max(1, math.Round(svgStaticSize*viewHeight/viewWidth)))
+	} else {
+		width = int(math.Max(1, math.Round(svgStaticSize*viewWidth/viewHeight)))
+	}
+
+	icon.SetTarget(0, 0, float64(width), float64(height))
+	rgba := image.NewRGBA(image.Rect(0, 0, width, height))
+	scanner := rasterx.NewScannerGV(width, height, rgba, rgba.Bounds())
+	icon.Draw(rasterx.NewDasher(width, height, scanner), 1)
+
+	return rgba, nil
+}
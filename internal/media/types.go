@@ -43,6 +43,9 @@ const (
 
 	mimePng      = "png"
 	mimeImagePng = mimeImage + "/" + mimePng
+
+	mimeSvg      = "svg"
+	mimeImageSvg = mimeImage + "/" + mimeSvg + "+xml"
 )
 
 type processState int32
@@ -60,6 +63,7 @@ type Size string
 
 const (
 	SizeSmall    Size = "small"    // SizeSmall is the key for small/thumbnail versions of media
+	SizeMedium   Size = "medium"   // SizeMedium is the key for medium-sized versions of image attachments
 	SizeOriginal Size = "original" // SizeOriginal is the key for original/fullsize versions of media and emoji
 	SizeStatic   Size = "static"   // SizeStatic is the key for static (non-animated) versions of emoji
 )
@@ -77,11 +77,12 @@ func supportedImage(mimeType string) bool {
 	return false
 }
 
-// supportedEmoji checks that the content type is image/png or image/gif -- the only types supported for emoji.
+// supportedEmoji checks that the content type is image/png, image/gif, or image/svg+xml -- the only types supported for emoji.
 func supportedEmoji(mimeType string) bool {
 	acceptedEmojiTypes := []string{
 		mimeImageGif,
 		mimeImagePng,
+		mimeImageSvg,
 	}
 	for _, accepted := range acceptedEmojiTypes {
 		if mimeType == accepted {
@@ -111,6 +112,8 @@ func ParseMediaSize(s string) (Size, error) {
 	switch s {
 	case string(SizeSmall):
 		return SizeSmall, nil
+	case string(SizeMedium):
+		return SizeMedium, nil
 	case string(SizeOriginal):
 		return SizeOriginal, nil
 	case string(SizeStatic):
@@ -0,0 +1,98 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// RecoveryCodesCount is how many recovery codes are generated for a user at once.
+	RecoveryCodesCount = 10
+
+	// recoveryCodeAlphabet leaves out characters which are easily mistaken for one another,
+	// so that codes can be read out or copied by hand without problems.
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryCodeLength   = 10
+)
+
+var recoveryCodeAlphabetLength = big.NewInt(int64(len(recoveryCodeAlphabet)))
+
+// NewRecoveryCodes generates a fresh set of recovery codes, which a user can sign
+// in with once each when they can't sign in through the OIDC provider. The codes
+// are returned for showing to the user, along with bcrypt hashes of them for
+// storing in the database; the codes themselves should never be stored.
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodesCount)
+	hashes := make([]string, 0, RecoveryCodesCount)
+
+	for i := 0; i < RecoveryCodesCount; i++ {
+		b := make([]byte, recoveryCodeLength)
+		for j := range b {
+			// rand.Int picks uniformly from the alphabet, where
+			// taking a random byte modulo its length would favour
+			// the characters at the start of it
+			n, err := rand.Int(rand.Reader, recoveryCodeAlphabetLength)
+			if err != nil {
+				return nil, nil, fmt.Errorf("NewRecoveryCodes: error picking random character: %s", err)
+			}
+			b[j] = recoveryCodeAlphabet[n.Int64()]
+		}
+
+		hash, err := bcrypt.GenerateFromPassword(b, bcrypt.DefaultCost)
+		if err != nil {
+			return nil, nil, fmt.Errorf("NewRecoveryCodes: error hashing code: %s", err)
+		}
+
+		// shown split in two halves, for readability
+		codes = append(codes, string(b[:recoveryCodeLength/2])+"-"+string(b[recoveryCodeLength/2:]))
+		hashes = append(hashes, string(hash))
+	}
+
+	return codes, hashes, nil
+}
+
+// MatchRecoveryCode returns the index of the hash in hashes which matches the given
+// recovery code, or -1 if none of them do. Case, spaces and dashes in the code are
+// ignored, since these are easily changed when codes are copied by hand.
+func MatchRecoveryCode(hashes []string, code string) int {
+	code = strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+
+	if len(code) != recoveryCodeLength {
+		return -1
+	}
+
+	for i, hash := range hashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(code)) == nil {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,61 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type RecoveryCodesTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RecoveryCodesTestSuite) TestNewRecoveryCodes() {
+	codes, hashes, err := oauth.NewRecoveryCodes()
+	suite.NoError(err)
+	suite.Len(codes, oauth.RecoveryCodesCount)
+	suite.Len(hashes, oauth.RecoveryCodesCount)
+
+	for i, code := range codes {
+		suite.Regexp(`^[a-z2-9]{5}-[a-z2-9]{5}$`, code)
+		suite.NotContains(hashes[i], code)
+		suite.Equal(i, oauth.MatchRecoveryCode(hashes, code))
+	}
+}
+
+func (suite *RecoveryCodesTestSuite) TestMatchRecoveryCode() {
+	codes, hashes, err := oauth.NewRecoveryCodes()
+	suite.NoError(err)
+
+	// copied by hand, in capitals and without the dash
+	suite.Equal(3, oauth.MatchRecoveryCode(hashes, " "+strings.ToUpper(strings.ReplaceAll(codes[3], "-", ""))+" "))
+
+	suite.Equal(-1, oauth.MatchRecoveryCode(hashes, ""))
+	suite.Equal(-1, oauth.MatchRecoveryCode(hashes, "aaaaa-aaaaa"))
+	suite.Equal(-1, oauth.MatchRecoveryCode(hashes[:3], codes[3]))
+	suite.Equal(-1, oauth.MatchRecoveryCode(nil, codes[0]))
+}
+
+func TestRecoveryCodesTestSuite(t *testing.T) {
+	suite.Run(t, new(RecoveryCodesTestSuite))
+}
@@ -0,0 +1,129 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// scopeDescriptions maps OAuth scopes to a description of what
+// they allow an application to do, in terms that a user can understand.
+var scopeDescriptions = map[string]string{
+	"read":                "read all of your account data",
+	"read:accounts":       "see your account information",
+	"read:blocks":         "see your blocks",
+	"read:bookmarks":      "see your bookmarks",
+	"read:favourites":     "see your favourites",
+	"read:filters":        "see your filters",
+	"read:follows":        "see your follows",
+	"read:lists":          "see your lists",
+	"read:mutes":          "see your mutes",
+	"read:notifications":  "see your notifications",
+	"read:search":         "search on your behalf",
+	"read:statuses":       "see all posts visible to you",
+	"write":               "modify all of your account data",
+	"write:accounts":      "modify your profile",
+	"write:blocks":        "block accounts and domains",
+	"write:bookmarks":     "bookmark posts",
+	"write:favourites":    "favourite posts",
+	"write:filters":       "create filters",
+	"write:follows":       "follow people",
+	"write:lists":         "create lists",
+	"write:media":         "upload media files",
+	"write:mutes":         "mute people and conversations",
+	"write:notifications": "clear your notifications",
+	"write:statuses":      "publish posts",
+	"follow":              "modify account relationships",
+	"push":                "receive your push notifications",
+	"admin":               "perform moderation and administration actions",
+	"admin:read":          "read sensitive data of all users on the instance",
+	"admin:write":         "perform moderation actions on all users on the instance",
+}
+
+// ScopeDescriptions splits the given space-separated OAuth scope string,
+// and returns a human-readable description for each requested scope, in
+// the order they were requested. Duplicate scopes are only described once.
+func ScopeDescriptions(scope string) []string {
+	scopes := strings.Fields(scope)
+	descriptions := make([]string, 0, len(scopes))
+	seen := make(map[string]struct{}, len(scopes))
+
+	for _, s := range scopes {
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+
+		description, ok := scopeDescriptions[s]
+		if !ok {
+			description = fmt.Sprintf("use the unrecognized scope '%s'", s)
+		}
+
+		descriptions = append(descriptions, description)
+	}
+
+	return descriptions
+}
+
+// ScopesCovered returns true if every scope in the space-separated
+// requested string is covered by a scope in the space-separated granted
+// string. A parent scope covers its sub-scopes, so 'read' covers 'read:statuses'.
+func ScopesCovered(granted string, requested string) bool {
+	grantedScopes := strings.Fields(granted)
+
+	for _, r := range strings.Fields(requested) {
+		if !scopeMatchesAny(r, grantedScopes) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ScopesIntersect returns true if any scope in the space-separated scope
+// string overlaps with any of the given scopes, ie., it is equal to, is a
+// sub-scope of, or is a parent scope of one of them. Requesting 'write'
+// therefore intersects with 'write:accounts', and vice versa.
+func ScopesIntersect(scope string, scopes []string) bool {
+	for _, s := range strings.Fields(scope) {
+		if scopeMatchesAny(s, scopes) {
+			return true
+		}
+
+		for _, other := range scopes {
+			if strings.HasPrefix(other, s+":") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// scopeMatchesAny returns true if scope is equal to,
+// or is a sub-scope of, any of the given scopes.
+func scopeMatchesAny(scope string, scopes []string) bool {
+	for _, s := range scopes {
+		if scope == s || strings.HasPrefix(scope, s+":") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type ScopesTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopesTestSuite) TestScopeDescriptions() {
+	suite.Equal([]string{
+		"read all of your account data",
+		"publish posts",
+		"use the unrecognized scope 'nonsense'",
+	}, oauth.ScopeDescriptions("read  write:statuses nonsense read"))
+	suite.Empty(oauth.ScopeDescriptions(""))
+}
+
+func (suite *ScopesTestSuite) TestScopesCovered() {
+	suite.True(oauth.ScopesCovered("read write", "read"))
+	suite.True(oauth.ScopesCovered("read write", "read:statuses write:media"))
+	suite.True(oauth.ScopesCovered("read", ""))
+	suite.False(oauth.ScopesCovered("read:statuses", "read"))
+	suite.False(oauth.ScopesCovered("read", "read write"))
+	suite.False(oauth.ScopesCovered("read", "readable"))
+	suite.False(oauth.ScopesCovered("", "read"))
+}
+
+func (suite *ScopesTestSuite) TestScopesIntersect() {
+	suite.True(oauth.ScopesIntersect("read admin:read", []string{"admin"}))
+	suite.True(oauth.ScopesIntersect("read write", []string{"admin", "write"}))
+	suite.False(oauth.ScopesIntersect("read write", []string{"admin"}))
+	suite.False(oauth.ScopesIntersect("administrate", []string{"admin"}))
+	suite.False(oauth.ScopesIntersect("read", nil))
+}
+
+func (suite *ScopesTestSuite) TestScopesIntersectParentScope() {
+	suite.True(oauth.ScopesIntersect("read write", []string{"write:accounts"}))
+	suite.True(oauth.ScopesIntersect("admin", []string{"admin:read"}))
+	suite.False(oauth.ScopesIntersect("read", []string{"write:accounts"}))
+	suite.False(oauth.ScopesIntersect("admin", []string{"administrate:read"}))
+}
+
+func TestScopesTestSuite(t *testing.T) {
+	suite.Run(t, &ScopesTestSuite{})
+}
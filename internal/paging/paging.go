@@ -0,0 +1,161 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package paging implements keyset pagination for database listings,
+// and the next and prev links that clients use to walk through them.
+package paging
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/uptrace/bun"
+)
+
+// Order is the order in which a listing returns items.
+type Order int
+
+const (
+	// OrderDescending lists items from the highest key to the lowest, eg., newest ID first.
+	OrderDescending Order = iota
+	// OrderAscending lists items from the lowest key to the highest, eg., shortcodes a-z.
+	OrderAscending
+)
+
+// Page is the bounds and size of one page of a listing. Bounds are
+// keys of items in the listing, and are exclusive. Before and after
+// are in terms of the order of the listing, so for a listing of
+// statuses newest first, after Max means older than Max.
+type Page struct {
+	// Max returns only items after this key, eg., max_id.
+	// Used to get the next page, using the key of the last item.
+	Max string
+	// Since returns only items before this key, starting
+	// from the top of the listing, eg., since_id.
+	Since string
+	// Min returns only items before this key, starting from
+	// the ones closest to it, eg., min_id. Used to get the
+	// prev page, using the key of the first item.
+	Min string
+	// Limit is the maximum number of items in the page.
+	// If 0 or less, there's no limit.
+	Limit int
+}
+
+// Apply adds the bounds, order and limit of the page to the given query,
+// for a listing keyed by the given column in the given order.
+//
+// If the page has a Min, the query selects items in reverse order, so
+// that the items closest to Min are within the limit; use Reorder to put
+// the selected items back into the order of the listing.
+func (p Page) Apply(q *bun.SelectQuery, column string, order Order) *bun.SelectQuery {
+	after, before := "<", ">"
+	if order == OrderAscending {
+		after, before = ">", "<"
+	}
+
+	if p.Max != "" {
+		q = q.Where("? "+after+" ?", bun.Ident(column), p.Max)
+	}
+
+	if p.Since != "" {
+		q = q.Where("? "+before+" ?", bun.Ident(column), p.Since)
+	}
+
+	if p.Min != "" {
+		q = q.Where("? "+before+" ?", bun.Ident(column), p.Min)
+	}
+
+	if (order == OrderAscending) != p.reversed() {
+		q = q.OrderExpr("? ASC", bun.Ident(column))
+	} else {
+		q = q.OrderExpr("? DESC", bun.Ident(column))
+	}
+
+	if p.Limit > 0 {
+		q = q.Limit(p.Limit)
+	}
+
+	return q
+}
+
+// reversed returns whether Apply selects items
+// in the reverse order of the listing.
+func (p Page) reversed() bool {
+	return p.Min != ""
+}
+
+// Reorder puts items selected by a query that the page was applied
+// to back into the order of the listing, reversing them in place if
+// they were selected in reverse order.
+func Reorder[T any](p Page, items []T) {
+	if !p.reversed() {
+		return
+	}
+
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+// LinkParams are the parameters for building the next and prev links for a page of items.
+type LinkParams struct {
+	Path       string   // path of the listing, eg., /api/v1/timelines/home
+	NextKey    string   // query parameter for the next link, defaults to 'max_id'
+	NextValue  string   // value for the next link, usually the key of the last item in the page
+	PrevKey    string   // query parameter for the prev link, defaults to 'min_id'
+	PrevValue  string   // value for the prev link, usually the key of the first item in the page
+	Limit      int      // limit to include in the links, if not 0
+	ExtraQuery []string // any extra query parameters to include in the links, in the format 'example=value'
+}
+
+// Links returns the next and prev links for a page of items,
+// and a Link header containing both of them.
+func Links(params LinkParams) (next string, prev string, linkHeader string) {
+	if params.NextKey == "" {
+		params.NextKey = "max_id"
+	}
+
+	if params.PrevKey == "" {
+		params.PrevKey = "min_id"
+	}
+
+	next = link(params.Path, params.NextKey, params.NextValue, params.Limit, params.ExtraQuery)
+	prev = link(params.Path, params.PrevKey, params.PrevValue, params.Limit, params.ExtraQuery)
+	linkHeader = fmt.Sprintf("<%s>; rel=\"next\", <%s>; rel=\"prev\"", next, prev)
+	return
+}
+
+func link(path string, key string, value string, limit int, extraQuery []string) string {
+	rawQuery := key + "=" + value
+	if limit != 0 {
+		rawQuery = fmt.Sprintf("limit=%d&", limit) + rawQuery
+	}
+	for _, q := range extraQuery {
+		rawQuery = rawQuery + "&" + q
+	}
+
+	u := &url.URL{
+		Scheme:   config.GetProtocol(),
+		Host:     config.GetHost(),
+		Path:     path,
+		RawQuery: rawQuery,
+	}
+	return u.String()
+}
@@ -0,0 +1,95 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package paging_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+type PagingTestSuite struct {
+	suite.Suite
+	db *bun.DB
+}
+
+func (suite *PagingTestSuite) SetupTest() {
+	// queries are only rendered, never run
+	suite.db = bun.NewDB(&sql.DB{}, pgdialect.New())
+	config.SetProtocol("http")
+	config.SetHost("localhost:8080")
+}
+
+func (suite *PagingTestSuite) query(page paging.Page, order paging.Order) string {
+	q := suite.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id")
+	return page.Apply(q, "status.id", order).String()
+}
+
+func (suite *PagingTestSuite) TestApplyDescending() {
+	suite.Equal(`SELECT "status"."id" FROM "statuses" AS "status" ORDER BY "status"."id" DESC`, suite.query(paging.Page{}, paging.OrderDescending))
+	suite.Equal(`SELECT "status"."id" FROM "statuses" AS "status" WHERE ("status"."id" < '02') ORDER BY "status"."id" DESC LIMIT 20`, suite.query(paging.Page{Max: "02", Limit: 20}, paging.OrderDescending))
+	suite.Equal(`SELECT "status"."id" FROM "statuses" AS "status" WHERE ("status"."id" < '09') AND ("status"."id" > '01') ORDER BY "status"."id" DESC LIMIT 20`, suite.query(paging.Page{Max: "09", Since: "01", Limit: 20}, paging.OrderDescending))
+
+	// min pages select the items closest to min first
+	suite.Equal(`SELECT "status"."id" FROM "statuses" AS "status" WHERE ("status"."id" > '01') ORDER BY "status"."id" ASC LIMIT 20`, suite.query(paging.Page{Min: "01", Limit: 20}, paging.OrderDescending))
+}
+
+func (suite *PagingTestSuite) TestApplyAscending() {
+	suite.Equal(`SELECT "status"."id" FROM "statuses" AS "status" WHERE ("status"."id" > 'b') ORDER BY "status"."id" ASC LIMIT 5`, suite.query(paging.Page{Max: "b", Limit: 5}, paging.OrderAscending))
+	suite.Equal(`SELECT "status"."id" FROM "statuses" AS "status" WHERE ("status"."id" < 'y') ORDER BY "status"."id" DESC LIMIT 5`, suite.query(paging.Page{Min: "y", Limit: 5}, paging.OrderAscending))
+}
+
+func (suite *PagingTestSuite) TestReorder() {
+	items := []string{"03", "04", "05"}
+	paging.Reorder(paging.Page{Max: "06"}, items)
+	suite.Equal([]string{"03", "04", "05"}, items)
+
+	paging.Reorder(paging.Page{Min: "02"}, items)
+	suite.Equal([]string{"05", "04", "03"}, items)
+
+	empty := []string{}
+	paging.Reorder(paging.Page{Min: "02"}, empty)
+	suite.Empty(empty)
+}
+
+func (suite *PagingTestSuite) TestLinks() {
+	next, prev, linkHeader := paging.Links(paging.LinkParams{
+		Path:       "/api/v1/notifications",
+		NextValue:  "01F8Q0ANPTWW10DAKTX7BRPBJP",
+		PrevKey:    "since_id",
+		PrevValue:  "01F8Q0ANPTWW10DAKTX7BRPBJQ",
+		Limit:      10,
+		ExtraQuery: []string{"exclude_types[]=follow"},
+	})
+	suite.Equal("http://localhost:8080/api/v1/notifications?limit=10&max_id=01F8Q0ANPTWW10DAKTX7BRPBJP&exclude_types[]=follow", next)
+	suite.Equal("http://localhost:8080/api/v1/notifications?limit=10&since_id=01F8Q0ANPTWW10DAKTX7BRPBJQ&exclude_types[]=follow", prev)
+	suite.Equal(`<`+next+`>; rel="next", <`+prev+`>; rel="prev"`, linkHeader)
+}
+
+func TestPagingTestSuite(t *testing.T) {
+	suite.Run(t, &PagingTestSuite{})
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
@@ -56,11 +57,23 @@ func (p *processor) AccountUpdate(ctx context.Context, authed *oauth.Auth, form
 }
 
 func (p *processor) AccountStatusesGet(ctx context.Context, authed *oauth.Auth, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinnedOnly bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode) {
-	return p.accountProcessor.StatusesGet(ctx, authed.Account, targetAccountID, limit, excludeReplies, excludeReblogs, maxID, minID, pinnedOnly, mediaOnly, publicOnly)
+	return p.accountProcessor.StatusesGet(cache.WithRequestCache(ctx), authed.Account, targetAccountID, limit, excludeReplies, excludeReblogs, maxID, minID, pinnedOnly, mediaOnly, publicOnly)
 }
 
-func (p *processor) AccountWebStatusesGet(ctx context.Context, targetAccountID string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode) {
-	return p.accountProcessor.WebStatusesGet(ctx, targetAccountID, maxID)
+func (p *processor) AccountWebStatusesGet(ctx context.Context, targetAccountID string, maxID string, mediaOnly bool) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.accountProcessor.WebStatusesGet(cache.WithRequestCache(ctx), targetAccountID, maxID, mediaOnly)
+}
+
+func (p *processor) AccountWebPinnedStatusesGet(ctx context.Context, targetAccountID string) ([]*apimodel.Status, gtserror.WithCode) {
+	return p.accountProcessor.WebPinnedStatusesGet(cache.WithRequestCache(ctx), targetAccountID)
+}
+
+func (p *processor) AccountWebTagStatusesGet(ctx context.Context, targetAccountID string, tagName string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.accountProcessor.WebTagStatusesGet(cache.WithRequestCache(ctx), targetAccountID, tagName, maxID)
+}
+
+func (p *processor) AccountWebLayoutGet(ctx context.Context, targetAccountID string) (*apimodel.WebLayout, gtserror.WithCode) {
+	return p.accountProcessor.WebLayoutGet(ctx, targetAccountID)
 }
 
 func (p *processor) AccountFollowersGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]apimodel.Account, gtserror.WithCode) {
@@ -75,6 +88,14 @@ func (p *processor) AccountRelationshipGet(ctx context.Context, authed *oauth.Au
 	return p.accountProcessor.RelationshipGet(ctx, authed.Account, targetAccountID)
 }
 
+func (p *processor) AccountStatsGet(ctx context.Context, authed *oauth.Auth, days int) (*apimodel.AccountStats, gtserror.WithCode) {
+	return p.accountProcessor.StatsGet(ctx, authed.Account, days)
+}
+
+func (p *processor) AccountSearch(ctx context.Context, authed *oauth.Auth, query string, limit int) ([]apimodel.Account, gtserror.WithCode) {
+	return p.accountProcessor.Search(ctx, authed.Account, query, limit)
+}
+
 func (p *processor) AccountFollowCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountFollowRequest) (*apimodel.Relationship, gtserror.WithCode) {
 	return p.accountProcessor.FollowCreate(ctx, authed.Account, form)
 }
@@ -90,3 +111,27 @@ func (p *processor) AccountBlockCreate(ctx context.Context, authed *oauth.Auth,
 func (p *processor) AccountBlockRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
 	return p.accountProcessor.BlockRemove(ctx, authed.Account, targetAccountID)
 }
+
+func (p *processor) AccountFeaturedTagsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	return p.accountProcessor.FeaturedTagsGet(ctx, authed.Account)
+}
+
+func (p *processor) AccountFeaturedTagsGetForAccount(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	return p.accountProcessor.FeaturedTagsGetForAccount(ctx, authed.Account, targetAccountID)
+}
+
+func (p *processor) AccountFeaturedTagCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.FeaturedTagCreateRequest) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	return p.accountProcessor.FeaturedTagCreate(ctx, authed.Account, form.Name)
+}
+
+func (p *processor) AccountFeaturedTagDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.accountProcessor.FeaturedTagDelete(ctx, authed.Account, id)
+}
+
+func (p *processor) AccountDomainBlockCreate(ctx context.Context, authed *oauth.Auth, domain string) gtserror.WithCode {
+	return p.accountProcessor.DomainBlockCreate(ctx, authed.Account, domain)
+}
+
+func (p *processor) AccountDomainBlockRemove(ctx context.Context, authed *oauth.Auth, domain string) gtserror.WithCode {
+	return p.accountProcessor.DomainBlockRemove(ctx, authed.Account, domain)
+}
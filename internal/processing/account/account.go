@@ -58,18 +58,34 @@ type Processor interface {
 	GetRSSFeedForUsername(ctx context.Context, username string) (func() (string, gtserror.WithCode), time.Time, gtserror.WithCode)
 	// Update processes the update of an account with the given form
 	Update(ctx context.Context, account *gtsmodel.Account, form *apimodel.UpdateCredentialsRequest) (*apimodel.Account, gtserror.WithCode)
+	// ChangeUsername renames the given local account to newUsername, if password is the password of
+	// its user. The old username is kept as an alias, so the account can still be found by it.
+	ChangeUsername(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, password string, newUsername string) (*apimodel.Account, gtserror.WithCode)
 	// StatusesGet fetches a number of statuses (in time descending order) from the given account, filtered by visibility for
 	// the account given in authed.
 	StatusesGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinned bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode)
 	// WebStatusesGet fetches a number of statuses (in descending order) from the given account. It selects only
-	// statuses which are suitable for showing on the public web profile of an account.
-	WebStatusesGet(ctx context.Context, targetAccountID string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode)
+	// statuses which are suitable for showing on the public web profile of an account, respecting the account's
+	// web layout options. If mediaOnly is true, only statuses with media attached will be selected.
+	WebStatusesGet(ctx context.Context, targetAccountID string, maxID string, mediaOnly bool) (*apimodel.PageableResponse, gtserror.WithCode)
+	// WebPinnedStatusesGet fetches the public pinned statuses of the given account, for showing on its public web profile.
+	WebPinnedStatusesGet(ctx context.Context, targetAccountID string) ([]*apimodel.Status, gtserror.WithCode)
+	// WebTagStatusesGet fetches a number of public, federated statuses (in descending order) from the given
+	// account that use the given hashtag. The hashtag must be one of the account's featured tags.
+	WebTagStatusesGet(ctx context.Context, targetAccountID string, tagName string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode)
+	// WebLayoutGet returns the layout options the given account has chosen for its public web profile.
+	WebLayoutGet(ctx context.Context, targetAccountID string) (*apimodel.WebLayout, gtserror.WithCode)
 	// FollowersGet fetches a list of the target account's followers.
 	FollowersGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
 	// FollowingGet fetches a list of the accounts that target account is following.
 	FollowingGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
 	// RelationshipGet returns a relationship model describing the relationship of the targetAccount to the Authed account.
 	RelationshipGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// StatsGet returns posting and follower statistics for the given account, covering the given number of days up to and including today.
+	StatsGet(ctx context.Context, account *gtsmodel.Account, days int) (*apimodel.AccountStats, gtserror.WithCode)
+	// Search returns accounts that the requesting account has interacted with whose username
+	// or display name starts with the given query, for autocompleting mentions.
+	Search(ctx context.Context, requestingAccount *gtsmodel.Account, query string, limit int) ([]apimodel.Account, gtserror.WithCode)
 	// FollowCreate handles a follow request to an account, either remote or local.
 	FollowCreate(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.AccountFollowRequest) (*apimodel.Relationship, gtserror.WithCode)
 	// FollowRemove handles the removal of a follow/follow request to an account, either remote or local.
@@ -78,6 +94,19 @@ type Processor interface {
 	BlockCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// BlockRemove handles the removal of a block from requestingAccount to targetAccountID, either remote or local.
 	BlockRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// DomainBlockCreate handles the creation of an account-level block of the given domain by requestingAccount,
+	// removing any existing follows and follow requests between requestingAccount and accounts on that domain.
+	DomainBlockCreate(ctx context.Context, requestingAccount *gtsmodel.Account, domain string) gtserror.WithCode
+	// DomainBlockRemove handles the removal of an account-level block of the given domain by requestingAccount.
+	DomainBlockRemove(ctx context.Context, requestingAccount *gtsmodel.Account, domain string) gtserror.WithCode
+	// FeaturedTagsGet returns the hashtags featured on the profile of the given account.
+	FeaturedTagsGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+	// FeaturedTagsGetForAccount returns the hashtags featured on the profile of the target account, as seen by the requesting account.
+	FeaturedTagsGetForAccount(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+	// FeaturedTagCreate features the hashtag with the given name on the profile of the given account.
+	FeaturedTagCreate(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.FeaturedTag, gtserror.WithCode)
+	// FeaturedTagDelete stops featuring the featured tag with the given id on the profile of the given account.
+	FeaturedTagDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
 	// UpdateAvatar does the dirty work of checking the avatar part of an account update form,
 	// parsing and checking the image, and doing the necessary updates in the database for this to become
 	// the account's new avatar image.
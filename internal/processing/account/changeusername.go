@@ -0,0 +1,116 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (p *processor) ChangeUsername(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, password string, newUsername string) (*apimodel.Account, gtserror.WithCode) {
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(password)); err != nil {
+		return nil, gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	newUsername = strings.ToLower(newUsername)
+	if err := validate.Username(newUsername); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if newUsername == account.Username {
+		err := fmt.Errorf("username is already %s", newUsername)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// the account can always go back to a username it had before
+	aliases, err := p.db.GetAccountUsernameAliases(ctx, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ChangeUsername: error getting username aliases: %s", err))
+	}
+
+	ownAlias := false
+	for _, alias := range aliases {
+		if alias.Username == newUsername {
+			ownAlias = true
+			break
+		}
+	}
+
+	if !ownAlias {
+		available, err := p.db.IsUsernameAvailable(ctx, newUsername)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("ChangeUsername: error checking username: %s", err))
+		}
+		if !available {
+			err := fmt.Errorf("username %s in use", newUsername)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+	}
+
+	aliasID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	alias := &gtsmodel.AccountUsernameAlias{
+		ID:        aliasID,
+		AccountID: account.ID,
+		Username:  account.Username,
+	}
+
+	// The activitypub URIs of the account have the old username in them, but
+	// they stay as they are: remote instances know the account by its URI, and
+	// would treat an account with a new one as a different account altogether.
+	// Requests for the old URIs still find the account through the alias.
+	account.Username = newUsername
+	account.URL = uris.GenerateURIsForAccount(newUsername).UserURL
+
+	updatedAccount, err := p.db.ChangeAccountUsername(ctx, account, alias)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ChangeUsername: error changing username: %s", err))
+	}
+
+	// let remote instances know about the new
+	// username, so they can show the new handle
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       updatedAccount,
+		OriginAccount:  updatedAccount,
+	})
+
+	acctSensitive, err := p.tc.AccountToAPIAccountSensitive(ctx, updatedAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(errors.New("ChangeUsername: could not convert account into apisensitive account"))
+	}
+
+	return acctSensitive, nil
+}
@@ -21,6 +21,9 @@ package account
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -30,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
@@ -50,6 +54,17 @@ func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInf
 		return nil, gtserror.NewErrorConflict(fmt.Errorf("username %s in use", form.Username))
 	}
 
+	// if signups must be vouched for, make sure the given
+	// link actually links back to the new account before
+	// we go any further
+	var link string
+	if len(config.GetAccountsSignupLinkDomains()) != 0 {
+		if errWithCode := p.verifySignUpLink(ctx, form.Link, form.Username); errWithCode != nil {
+			return nil, errWithCode
+		}
+		link = form.Link
+	}
+
 	reasonRequired := config.GetAccountsReasonRequired()
 	approvalRequired := config.GetAccountsApprovalRequired()
 
@@ -65,6 +80,20 @@ func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInf
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating new signup in the database: %s", err))
 	}
 
+	// the form was only accepted with agreement set, so the new user
+	// has accepted the current terms of service and privacy policy
+	user.LegalAcceptedAt = time.Now()
+	columns := []string{"legal_accepted_at"}
+
+	if link != "" {
+		user.SignUpLink = link
+		columns = append(columns, "sign_up_link")
+	}
+
+	if _, err := p.db.UpdateUser(ctx, user, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error updating new user %s: %s", user.ID, err))
+	}
+
 	log.Tracef("generating a token for user %s with account %s and application %s", user.ID, user.AccountID, application.ID)
 	accessToken, err := p.oauthServer.GenerateUserAccessToken(ctx, applicationToken, application.ClientSecret, user.ID)
 	if err != nil {
@@ -95,3 +124,34 @@ func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInf
 		CreatedAt:   accessToken.GetAccessCreateAt().Unix(),
 	}, nil
 }
+
+// verifySignUpLink checks that the page at the given link contains a rel="me"
+// link back to the profile of the account with the given (not yet created) username.
+func (p *processor) verifySignUpLink(ctx context.Context, link string, username string) gtserror.WithCode {
+	linkURL, err := url.Parse(link)
+	if err != nil {
+		return gtserror.NewErrorBadRequest(err, fmt.Sprintf("link %s could not be parsed", link))
+	}
+
+	t, err := p.federator.TransportController().NewTransportForUsername(ctx, "")
+	if err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("verifySignUpLink: error creating transport: %s", err))
+	}
+
+	relMeLinks, err := t.DereferenceRelMe(ctx, linkURL)
+	if err != nil {
+		err = fmt.Errorf("verifySignUpLink: error dereferencing %s: %s", link, err)
+		return gtserror.NewErrorUnprocessableEntity(err, fmt.Sprintf("link %s could not be fetched", link))
+	}
+
+	accountURIs := uris.GenerateURIsForAccount(username)
+	for _, relMeLink := range relMeLinks {
+		relMeLink = strings.TrimSuffix(relMeLink, "/")
+		if relMeLink == accountURIs.UserURL || relMeLink == accountURIs.UserURI {
+			return nil
+		}
+	}
+
+	err = fmt.Errorf("page at %s does not contain a rel=\"me\" link to %s", link, accountURIs.UserURL)
+	return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+}
@@ -113,6 +113,9 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 		if err := p.db.DeleteByID(ctx, f.ID, f); err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing follow from db: %s", err))
 		}
+		if err := p.db.DeleteListEntriesForFollowID(ctx, f.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing follow from lists: %s", err))
+		}
 		fChanged = true
 	}
 
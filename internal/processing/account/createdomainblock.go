@@ -0,0 +1,205 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func (p *processor) DomainBlockCreate(ctx context.Context, requestingAccount *gtsmodel.Account, domain string) gtserror.WithCode {
+	domain, errWithCode := validateBlockableDomain(domain)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// if requestingAccount already blocks this domain, we don't need to do anything
+	if blocked, err := p.db.IsDomainBlockedByAccount(ctx, requestingAccount.ID, domain); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error checking existence of domain block: %s", err))
+	} else if blocked {
+		return nil
+	}
+
+	blockID, err := id.NewULID()
+	if err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	block := &gtsmodel.AccountDomainBlock{
+		ID:        blockID,
+		AccountID: requestingAccount.ID,
+		Account:   requestingAccount,
+		Domain:    domain,
+	}
+
+	if err := p.db.PutAccountDomainBlock(ctx, block); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error creating domain block in db: %s", err))
+	}
+
+	// remove anyone on the blocked domain from the requesting account's followers,
+	// and reject any of their pending follow requests -- this needs federating so that
+	// the remote instance knows they're no longer following
+	followedBy, err := p.db.GetAccountFollowedBy(ctx, requestingAccount.ID, false)
+	if err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error getting followers: %s", err))
+	}
+
+	for _, f := range followedBy {
+		follower, err := p.db.GetAccountByID(ctx, f.AccountID)
+		if err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error getting follower %s: %s", f.AccountID, err))
+		}
+
+		if !strings.EqualFold(follower.Domain, domain) {
+			continue
+		}
+
+		if err := p.db.DeleteByID(ctx, f.ID, f); err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error removing follow from db: %s", err))
+		}
+
+		p.clientWorker.Queue(messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityReject,
+			GTSModel: &gtsmodel.FollowRequest{
+				ID:              f.ID,
+				URI:             f.URI,
+				AccountID:       f.AccountID,
+				Account:         follower,
+				TargetAccountID: requestingAccount.ID,
+				TargetAccount:   requestingAccount,
+			},
+			OriginAccount: follower,
+			TargetAccount: requestingAccount,
+		})
+	}
+
+	followRequests, err := p.db.GetAccountFollowRequests(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error getting follow requests: %s", err))
+	}
+
+	for _, fr := range followRequests {
+		if fr.Account == nil || !strings.EqualFold(fr.Account.Domain, domain) {
+			continue
+		}
+
+		if _, err := p.db.RejectFollowRequest(ctx, fr.AccountID, requestingAccount.ID); err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error rejecting follow request: %s", err))
+		}
+
+		p.clientWorker.Queue(messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityReject,
+			GTSModel:       fr,
+			OriginAccount:  fr.Account,
+			TargetAccount:  requestingAccount,
+		})
+	}
+
+	// remove any follows or follow requests from the requesting account to accounts on the blocked domain
+	follows, err := p.db.GetAccountFollows(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error getting follows: %s", err))
+	}
+
+	for _, f := range follows {
+		if f.TargetAccount == nil || !strings.EqualFold(f.TargetAccount.Domain, domain) {
+			continue
+		}
+
+		if err := p.db.DeleteByID(ctx, f.ID, f); err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error removing follow from db: %s", err))
+		}
+
+		p.clientWorker.Queue(messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityUndo,
+			GTSModel: &gtsmodel.Follow{
+				AccountID:       requestingAccount.ID,
+				TargetAccountID: f.TargetAccountID,
+				URI:             f.URI,
+			},
+			OriginAccount: requestingAccount,
+			TargetAccount: f.TargetAccount,
+		})
+	}
+
+	pendingFollowRequests := []*gtsmodel.FollowRequest{}
+	if err := p.db.GetWhere(ctx, []db.Where{{Key: "account_id", Value: requestingAccount.ID}}, &pendingFollowRequests); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error getting pending follow requests: %s", err))
+	}
+
+	for _, fr := range pendingFollowRequests {
+		target, err := p.db.GetAccountByID(ctx, fr.TargetAccountID)
+		if err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error getting follow request target %s: %s", fr.TargetAccountID, err))
+		}
+
+		if !strings.EqualFold(target.Domain, domain) {
+			continue
+		}
+
+		if err := p.db.DeleteByID(ctx, fr.ID, fr); err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockCreate: error removing follow request from db: %s", err))
+		}
+
+		p.clientWorker.Queue(messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityUndo,
+			GTSModel: &gtsmodel.Follow{
+				AccountID:       requestingAccount.ID,
+				TargetAccountID: fr.TargetAccountID,
+				URI:             fr.URI,
+			},
+			OriginAccount: requestingAccount,
+			TargetAccount: target,
+		})
+	}
+
+	return nil
+}
+
+// validateBlockableDomain lowercases and trims the given domain, and checks
+// that it's something an account can reasonably block.
+func validateBlockableDomain(domain string) (string, gtserror.WithCode) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+
+	if domain == "" {
+		err := errors.New("no domain provided")
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		err := fmt.Errorf("domain %s is this instance's own domain and cannot be blocked", domain)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return domain, nil
+}
@@ -21,6 +21,7 @@ package account
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -49,11 +50,29 @@ func (p *processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// if the requesting account has blocked the target account's domain, they shouldn't be able to follow them
+	if domainBlocked, err := p.db.IsDomainBlockedByAccount(ctx, requestingAccount.ID, targetAcct.Domain); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error checking domain block in db: %s", err))
+	} else if domainBlocked {
+		err := fmt.Errorf("accountfollowcreate: account %s has blocked domain %s", requestingAccount.ID, targetAcct.Domain)
+		return nil, gtserror.NewErrorForbidden(err, "you have blocked this account's domain")
+	}
+
 	// check if a follow exists already
 	if follows, err := p.db.IsFollowing(ctx, requestingAccount, targetAcct); err != nil {
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error checking follow in db: %s", err))
 	} else if follows {
-		// already follows so just return the relationship
+		// already follows so just update the follow options and return the relationship
+		follow, err := p.db.GetFollow(ctx, requestingAccount.ID, form.ID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error getting follow from db: %s", err))
+		}
+		if followOptionsChanged(form, &follow.ShowReblogs, &follow.ShowReplies, &follow.Notify) {
+			follow.UpdatedAt = time.Now()
+			if err := p.db.UpdateByID(ctx, follow, follow.ID, "updated_at", "show_reblogs", "show_replies", "notify"); err != nil {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error updating follow in db: %s", err))
+			}
+		}
 		return p.RelationshipGet(ctx, requestingAccount, form.ID)
 	}
 
@@ -61,7 +80,17 @@ func (p *processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 	if followRequested, err := p.db.IsFollowRequested(ctx, requestingAccount, targetAcct); err != nil {
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error checking follow request in db: %s", err))
 	} else if followRequested {
-		// already follow requested so just return the relationship
+		// already follow requested so just update the follow request options and return the relationship
+		fr := &gtsmodel.FollowRequest{}
+		if err := p.db.GetWhere(ctx, []db.Where{{Key: "account_id", Value: requestingAccount.ID}, {Key: "target_account_id", Value: form.ID}}, fr); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error getting follow request from db: %s", err))
+		}
+		if followOptionsChanged(form, &fr.ShowReblogs, &fr.ShowReplies, &fr.Notify) {
+			fr.UpdatedAt = time.Now()
+			if err := p.db.UpdateByID(ctx, fr, fr.ID, "updated_at", "show_reblogs", "show_replies", "notify"); err != nil {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error updating follow request in db: %s", err))
+			}
+		}
 		return p.RelationshipGet(ctx, requestingAccount, form.ID)
 	}
 
@@ -77,18 +106,23 @@ func (p *processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 	}
 
 	showReblogs := true
+	showReplies := true
 	notify := false
 	fr := &gtsmodel.FollowRequest{
 		ID:              newFollowID,
 		AccountID:       requestingAccount.ID,
 		TargetAccountID: form.ID,
 		ShowReblogs:     &showReblogs,
+		ShowReplies:     &showReplies,
 		URI:             uris.GenerateURIForFollow(requestingAccount.Username, newFollowID),
 		Notify:          &notify,
 	}
 	if form.Reblogs != nil {
 		fr.ShowReblogs = form.Reblogs
 	}
+	if form.Replies != nil {
+		fr.ShowReplies = form.Replies
+	}
 	if form.Notify != nil {
 		fr.Notify = form.Notify
 	}
@@ -119,3 +153,26 @@ func (p *processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 	// return whatever relationship results from this
 	return p.RelationshipGet(ctx, requestingAccount, form.ID)
 }
+
+// followOptionsChanged sets the given follow or follow request
+// options to the ones requested in form, returning true if any
+// of them changed. Options not set in form are left alone.
+func followOptionsChanged(form *apimodel.AccountFollowRequest, showReblogs **bool, showReplies **bool, notify **bool) bool {
+	changed := false
+	for _, o := range []struct {
+		requested *bool
+		current   **bool
+	}{
+		{form.Reblogs, showReblogs},
+		{form.Replies, showReplies},
+		{form.Notify, notify},
+	} {
+		if o.requested == nil || (*o.current != nil && **o.current == *o.requested) {
+			continue
+		}
+		v := *o.requested
+		*o.current = &v
+		changed = true
+	}
+	return changed
+}
@@ -52,9 +52,10 @@ import (
 // 13. Delete account's mutes
 // 14. Delete account's streams
 // 15. Delete account's tags
-// 16. Delete account's user
-// 17. Delete account's timeline
-// 18. Delete account itself
+// 16. Delete account's filters
+// 17. Delete account's user
+// 18. Delete account's timeline
+// 19. Delete account itself
 func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origin string) gtserror.WithCode {
 	fields := kv.Fields{
 		{"username", account.Username},
@@ -94,6 +95,33 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 					}
 				}
 			}
+
+			// delete the application that statuses posted by email were created with, if any
+			if user.PostByMailApplicationID != "" {
+				if err := p.db.DeleteByID(ctx, user.PostByMailApplicationID, &gtsmodel.Application{}); err != nil {
+					l.Errorf("error deleting post by email application: %s", err)
+				}
+			}
+
+			// delete any remembered application consents for this user
+			if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "user_id", Value: user.ID}}, &[]*gtsmodel.ApplicationConsent{}); err != nil {
+				l.Errorf("error deleting application consents: %s", err)
+			}
+
+			// delete any client settings stored by applications for this account
+			if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.ClientSetting{}); err != nil {
+				l.Errorf("error deleting client settings: %s", err)
+			}
+
+			// delete any stats rollups computed for this account
+			if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.AccountDailyStats{}); err != nil {
+				l.Errorf("error deleting account stats: %s", err)
+			}
+
+			// delete this account's record of which emojis it has used
+			if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.AccountEmojiUsage{}); err != nil {
+				l.Errorf("error deleting account emoji usage: %s", err)
+			}
 		}
 	}
 
@@ -109,6 +137,11 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 		l.Errorf("error deleting blocks targeting account: %s", err)
 	}
 
+	// and any domain blocks this account created
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.AccountDomainBlock{}); err != nil {
+		l.Errorf("error deleting domain blocks created by account: %s", err)
+	}
+
 	// 3. Delete account's emoji
 	// nothing to do here
 
@@ -139,69 +172,12 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 	}
 
 	// 6. Delete account's statuses
-	l.Debug("deleting account statuses")
-	// we'll select statuses 20 at a time so we don't wreck the db, and pass them through to the client api channel
-	// Deleting the statuses in this way also handles 7. Delete account's media attachments, 8. Delete account's mentions, and 9. Delete account's polls,
-	// since these are all attached to statuses.
-	var maxID string
-selectStatusesLoop:
-	for {
-		statuses, err := p.db.GetAccountStatuses(ctx, account.ID, 20, false, false, maxID, "", false, false, false)
-		if err != nil {
-			if errors.Is(err, db.ErrNoEntries) {
-				// no statuses left for this instance so we're done
-				l.Infof("Delete: done iterating through statuses for account %s", account.Username)
-				break selectStatusesLoop
-			}
-
-			// an actual error has occurred
-			l.Errorf("Delete: db error selecting statuses for account %s: %s", account.Username, err)
-			break selectStatusesLoop
-		}
-
-		for i, s := range statuses {
-			// pass the status delete through the client api channel for processing
-			s.Account = account
-
-			l.Debug("putting status delete in the client api channel")
-			p.clientWorker.Queue(messages.FromClientAPI{
-				APObjectType:   ap.ObjectNote,
-				APActivityType: ap.ActivityDelete,
-				GTSModel:       s,
-				OriginAccount:  account,
-				TargetAccount:  account,
-			})
-
-			// if there are any boosts of this status, delete them as well
-			if boosts, err := p.db.GetStatusReblogs(ctx, s); err == nil {
-				for _, b := range boosts {
-					if b.Account == nil {
-						bAccount, err := p.db.GetAccountByID(ctx, b.AccountID)
-						if err != nil {
-							l.Errorf("Delete: db error populating boosted status account: %v", err)
-							continue
-						}
-						b.Account = bAccount
-					}
-
-					l.Debug("putting boost undo in the client api channel")
-					p.clientWorker.Queue(messages.FromClientAPI{
-						APObjectType:   ap.ActivityAnnounce,
-						APActivityType: ap.ActivityUndo,
-						GTSModel:       s,
-						OriginAccount:  b.Account,
-						TargetAccount:  account,
-					})
-				}
-			}
-
-			// if this is the last status in the slice, set the maxID appropriately for the next query
-			if i == len(statuses)-1 {
-				maxID = s.ID
-			}
-		}
-	}
-	l.Debug("done deleting statuses")
+	// the statuses, and boosts of them, are marked as deleted all in one go along with
+	// the account itself in step 19; they're removed later in the background, a batch
+	// at a time, which also handles 7. Delete account's media attachments, 8. Delete
+	// account's mentions, and 9. Delete account's polls, since these are all attached
+	// to statuses. Remote instances are sent a delete of the whole account instead of
+	// one for each status.
 
 	// 10. Delete account's notifications
 	l.Debug("deleting account notifications")
@@ -220,6 +196,9 @@ selectStatusesLoop:
 	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.StatusBookmark{}); err != nil {
 		l.Errorf("error deleting bookmarks created by account: %s", err)
 	}
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.BookmarkFolder{}); err != nil {
+		l.Errorf("error deleting bookmark folders of account: %s", err)
+	}
 
 	// 12. Delete account's faves
 	// TODO: federate these if necessary
@@ -238,9 +217,25 @@ selectStatusesLoop:
 	// TODO
 
 	// 15. Delete account's tags
-	// TODO
+	// the tags themselves are shared, so just stop featuring them
+	l.Debug("deleting account featured tags")
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.FeaturedTag{}); err != nil {
+		l.Errorf("error deleting featured tags of account: %s", err)
+	}
 
-	// 16. Delete account's user
+	// 16. Delete account's filters
+	l.Debug("deleting account filters")
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.FilterKeyword{}); err != nil {
+		l.Errorf("error deleting filter keywords of account: %s", err)
+	}
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.FilterStatus{}); err != nil {
+		l.Errorf("error deleting filter statuses of account: %s", err)
+	}
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.Filter{}); err != nil {
+		l.Errorf("error deleting filters of account: %s", err)
+	}
+
+	// 17. Delete account's user
 	if user != nil {
 		l.Debug("deleting account user")
 		if err := p.db.DeleteUserByID(ctx, user.ID); err != nil {
@@ -248,12 +243,13 @@ selectStatusesLoop:
 		}
 	}
 
-	// 17. Delete account's timeline
+	// 18. Delete account's timeline
 	// TODO
 
-	// 18. Delete account itself
+	// 19. Delete account itself
 	// to prevent the account being created again, set all these fields and update it in the db
-	// the account won't actually be *removed* from the database but it will be set to just a stub
+	// a local account won't actually be *removed* from the database but it will be set to just a stub;
+	// a remote one is kept as a stub until deleted accounts are next purged
 
 	account.Note = ""
 	account.DisplayName = ""
@@ -278,6 +274,11 @@ selectStatusesLoop:
 		return gtserror.NewErrorInternalError(err)
 	}
 
+	l.Debug("marking account and its statuses as deleted")
+	if err := p.db.DeleteAccount(ctx, account.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
 	l.Infof("deleted account with username %s from domain %s", account.Username, account.Domain)
 	return nil
 }
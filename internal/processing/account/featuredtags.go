@@ -0,0 +1,216 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// maxFeaturedTags is the maximum number of
+// hashtags an account can feature on its profile.
+const maxFeaturedTags = 10
+
+func (p *processor) FeaturedTagsGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	featuredTags, err := p.db.GetAccountFeaturedTags(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagsGet: error getting featured tags: %s", err))
+	}
+
+	apiFeaturedTags := make([]*apimodel.FeaturedTag, 0, len(featuredTags))
+	for _, ft := range featuredTags {
+		apiFeaturedTag, err := p.featuredTagToAPIFeaturedTag(ctx, account, ft)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagsGet: error converting featured tag: %s", err))
+		}
+		apiFeaturedTags = append(apiFeaturedTags, apiFeaturedTag)
+	}
+
+	return apiFeaturedTags, nil
+}
+
+func (p *processor) FeaturedTagsGetForAccount(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	if blocked, err := p.db.IsBlocked(ctx, requestingAccount.ID, targetAccountID, true); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	} else if blocked {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("block exists between accounts"))
+	}
+
+	targetAccount, err := p.db.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagsGetForAccount: error getting account: %s", err))
+	}
+
+	if targetAccount.Domain != "" {
+		// we don't keep track of the
+		// tags featured by remote accounts
+		return []*apimodel.FeaturedTag{}, nil
+	}
+
+	return p.FeaturedTagsGet(ctx, targetAccount)
+}
+
+func (p *processor) FeaturedTagCreate(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "#")
+
+	// the name must parse as exactly one hashtag, so reuse the status hashtag parsing
+	if tagStrings := util.DeriveHashtagsFromText("#" + name); len(tagStrings) != 1 || tagStrings[0] != name {
+		err := fmt.Errorf("%q is not a valid hashtag", name)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	featuredTags, err := p.db.GetAccountFeaturedTags(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagCreate: error getting featured tags: %s", err))
+	}
+
+	if len(featuredTags) >= maxFeaturedTags {
+		err := fmt.Errorf("you cannot feature more than %d hashtags", maxFeaturedTags)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	for _, ft := range featuredTags {
+		if ft.Tag != nil && strings.EqualFold(ft.Tag.Name, name) {
+			err := fmt.Errorf("hashtag %s is already featured", name)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+	}
+
+	tags, err := p.db.TagStringsToTags(ctx, []string{name}, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagCreate: error getting tag: %s", err))
+	}
+
+	if len(tags) != 1 {
+		err := fmt.Errorf("hashtag %s cannot be used", name)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+	tag := tags[0]
+
+	if err := p.db.Put(ctx, tag); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagCreate: error putting tag in db: %s", err))
+	}
+
+	featuredTagID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	featuredTag := &gtsmodel.FeaturedTag{
+		ID:        featuredTagID,
+		AccountID: account.ID,
+		TagID:     tag.ID,
+		Tag:       tag,
+	}
+
+	if err := p.db.Put(ctx, featuredTag); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("hashtag %s is already featured", name)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagCreate: error putting featured tag in db: %s", err))
+	}
+
+	p.federateFeaturedTagsChange(account)
+
+	apiFeaturedTag, err := p.featuredTagToAPIFeaturedTag(ctx, account, featuredTag)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagCreate: error converting featured tag: %s", err))
+	}
+
+	return apiFeaturedTag, nil
+}
+
+func (p *processor) FeaturedTagDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	featuredTag := &gtsmodel.FeaturedTag{}
+	if err := p.db.GetByID(ctx, id, featuredTag); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorNotFound(err)
+		}
+		return gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagDelete: error getting featured tag: %s", err))
+	}
+
+	if featuredTag.AccountID != account.ID {
+		err := fmt.Errorf("featured tag %s does not belong to account %s", id, account.ID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.db.DeleteByID(ctx, id, featuredTag); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("FeaturedTagDelete: error deleting featured tag: %s", err))
+	}
+
+	p.federateFeaturedTagsChange(account)
+
+	return nil
+}
+
+// federateFeaturedTagsChange sends an update of the given account's
+// profile out, so that remote instances refetch its featuredTags collection.
+func (p *processor) federateFeaturedTagsChange(account *gtsmodel.Account) {
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       account,
+		OriginAccount:  account,
+	})
+}
+
+// featuredTagToAPIFeaturedTag converts the given featured tag of account
+// to its api representation, counting the account's public statuses using it.
+func (p *processor) featuredTagToAPIFeaturedTag(ctx context.Context, account *gtsmodel.Account, featuredTag *gtsmodel.FeaturedTag) (*apimodel.FeaturedTag, error) {
+	if featuredTag.Tag == nil {
+		tag := &gtsmodel.Tag{}
+		if err := p.db.GetByID(ctx, featuredTag.TagID, tag); err != nil {
+			return nil, err
+		}
+		featuredTag.Tag = tag
+	}
+
+	count, lastStatusAt, err := p.db.CountAccountStatusesByTag(ctx, account.ID, featuredTag.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiFeaturedTag := &apimodel.FeaturedTag{
+		ID:            featuredTag.ID,
+		Name:          featuredTag.Tag.Name,
+		URL:           account.URL + "/tagged/" + featuredTag.Tag.Name,
+		StatusesCount: count,
+	}
+
+	if !lastStatusAt.IsZero() {
+		apiFeaturedTag.LastStatusAt = util.FormatISO8601(lastStatusAt)
+	}
+
+	return apiFeaturedTag, nil
+}
@@ -51,7 +51,7 @@ func (p *processor) GetRSSFeedForUsername(ctx context.Context, username string)
 	}
 
 	return func() (string, gtserror.WithCode) {
-		statuses, err := p.db.GetAccountWebStatuses(ctx, account.ID, rssFeedLength, "")
+		statuses, err := p.db.GetAccountWebStatuses(ctx, account.ID, rssFeedLength, "", true, true, false)
 		if err != nil && err != db.ErrNoEntries {
 			return "", gtserror.NewErrorInternalError(fmt.Errorf("GetRSSFeedForUsername: db error: %s", err))
 		}
@@ -21,11 +21,13 @@ package account
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -60,6 +62,11 @@ func (p *processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel
 		return util.EmptyPageableResponse(), nil
 	}
 
+	filters, err := statusfilter.Get(ctx, p.db, p.tc, requestingAccount, gtsmodel.FilterContextAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	items := []interface{}{}
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
@@ -77,7 +84,10 @@ func (p *processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel
 			prevMinIDValue = item.GetID()
 		}
 
-		items = append(items, item)
+		// statuses hidden by filters still count for paging
+		if item = filters.Apply(item); item != nil {
+			items = append(items, item)
+		}
 	}
 
 	return util.PackagePageableResponse(util.PageableResponseParams{
@@ -96,22 +106,59 @@ func (p *processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel
 	})
 }
 
-func (p *processor) WebStatusesGet(ctx context.Context, targetAccountID string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode) {
-	acct, err := p.db.GetAccountByID(ctx, targetAccountID)
+func (p *processor) WebStatusesGet(ctx context.Context, targetAccountID string, maxID string, mediaOnly bool) (*apimodel.PageableResponse, gtserror.WithCode) {
+	acct, errWithCode := p.getWebAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	layout := webLayout(acct)
+	if mediaOnly && !layout.MediaTab {
+		err := fmt.Errorf("account %s has not enabled the web media tab", targetAccountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	statuses, err := p.db.GetAccountWebStatuses(ctx, targetAccountID, 10, maxID, layout.HideReplies, layout.HideBoosts, mediaOnly)
 	if err != nil {
 		if err == db.ErrNoEntries {
-			err := fmt.Errorf("account %s not found in the db, not getting web statuses for it", targetAccountID)
-			return nil, gtserror.NewErrorNotFound(err)
+			return util.EmptyPageableResponse(), nil
 		}
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	if acct.Domain != "" {
-		err := fmt.Errorf("account %s was not a local account, not getting web statuses for it", targetAccountID)
+	path := "/@" + acct.Username
+	if mediaOnly {
+		path += "/media"
+	}
+
+	return p.packageWebStatuses(ctx, statuses, path)
+}
+
+func (p *processor) WebTagStatusesGet(ctx context.Context, targetAccountID string, tagName string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode) {
+	acct, errWithCode := p.getWebAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	featuredTags, err := p.db.GetAccountFeaturedTags(ctx, targetAccountID)
+	if err != nil && err != db.ErrNoEntries {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var tag *gtsmodel.Tag
+	for _, ft := range featuredTags {
+		if ft.Tag != nil && strings.EqualFold(ft.Tag.Name, tagName) {
+			tag = ft.Tag
+			break
+		}
+	}
+
+	if tag == nil {
+		err := fmt.Errorf("account %s does not feature tag %s", targetAccountID, tagName)
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
-	statuses, err := p.db.GetAccountWebStatuses(ctx, targetAccountID, 10, maxID)
+	statuses, err := p.db.GetAccountWebStatusesByTag(ctx, targetAccountID, tag.ID, 10, maxID)
 	if err != nil {
 		if err == db.ErrNoEntries {
 			return util.EmptyPageableResponse(), nil
@@ -119,6 +166,12 @@ func (p *processor) WebStatusesGet(ctx context.Context, targetAccountID string,
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	return p.packageWebStatuses(ctx, statuses, "/@"+acct.Username+"/tagged/"+tag.Name)
+}
+
+// packageWebStatuses converts the given statuses into a pageable
+// response for the web view, skipping any that shouldn't be shown.
+func (p *processor) packageWebStatuses(ctx context.Context, statuses []*gtsmodel.Status, path string) (*apimodel.PageableResponse, gtserror.WithCode) {
 	count := len(statuses)
 
 	if count == 0 {
@@ -129,17 +182,26 @@ func (p *processor) WebStatusesGet(ctx context.Context, targetAccountID string,
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
 	for i, s := range statuses {
-		item, err := p.tc.StatusToAPIStatus(ctx, s, nil)
-		if err != nil {
-			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status to api: %s", err))
-		}
-
 		if i == count-1 {
-			nextMaxIDValue = item.GetID()
+			nextMaxIDValue = s.ID
 		}
 
 		if i == 0 {
-			prevMinIDValue = item.GetID()
+			prevMinIDValue = s.ID
+		}
+
+		// boosts and replies may point to statuses
+		// that shouldn't be shown to the public
+		if s.BoostOfID != "" || s.InReplyToID != "" {
+			visible, err := p.filter.StatusVisible(ctx, s, nil)
+			if err != nil || !visible {
+				continue
+			}
+		}
+
+		item, err := p.tc.StatusToAPIStatus(ctx, s, nil)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status to api: %s", err))
 		}
 
 		items = append(items, item)
@@ -147,9 +209,91 @@ func (p *processor) WebStatusesGet(ctx context.Context, targetAccountID string,
 
 	return util.PackagePageableResponse(util.PageableResponseParams{
 		Items:            items,
-		Path:             "/@" + acct.Username,
+		Path:             path,
 		NextMaxIDValue:   nextMaxIDValue,
 		PrevMinIDValue:   prevMinIDValue,
 		ExtraQueryParams: []string{},
 	})
 }
+
+func (p *processor) WebPinnedStatusesGet(ctx context.Context, targetAccountID string) ([]*apimodel.Status, gtserror.WithCode) {
+	if _, errWithCode := p.getWebAccount(ctx, targetAccountID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	statuses, err := p.db.GetAccountStatuses(ctx, targetAccountID, 0, false, false, "", "", true, false, true)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			return []*apimodel.Status{}, nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiStatuses := make([]*apimodel.Status, 0, len(statuses))
+	for _, s := range statuses {
+		if !*s.Federated {
+			continue
+		}
+
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, s, nil)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status to api: %s", err))
+		}
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
+
+func (p *processor) WebLayoutGet(ctx context.Context, targetAccountID string) (*apimodel.WebLayout, gtserror.WithCode) {
+	acct, errWithCode := p.getWebAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	layout := webLayout(acct)
+
+	featuredTags, err := p.db.GetAccountFeaturedTags(ctx, targetAccountID)
+	if err != nil && err != db.ErrNoEntries {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, ft := range featuredTags {
+		if ft.Tag != nil {
+			layout.FeaturedTags = append(layout.FeaturedTags, ft.Tag.Name)
+		}
+	}
+
+	return layout, nil
+}
+
+// getWebAccount fetches the given account, returning
+// an error if it's not a local account with a web profile.
+func (p *processor) getWebAccount(ctx context.Context, targetAccountID string) (*gtsmodel.Account, gtserror.WithCode) {
+	acct, err := p.db.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			err := fmt.Errorf("account %s not found in the db, not getting web statuses for it", targetAccountID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if acct.Domain != "" {
+		err := fmt.Errorf("account %s was not a local account, not getting web statuses for it", targetAccountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return acct, nil
+}
+
+// webLayout returns the web layout options of the given account,
+// falling back to defaults for any options that haven't been set.
+func webLayout(acct *gtsmodel.Account) *apimodel.WebLayout {
+	return &apimodel.WebLayout{
+		HideBoosts:  acct.WebHideBoosts == nil || *acct.WebHideBoosts,
+		HideReplies: acct.WebHideReplies == nil || *acct.WebHideReplies,
+		PinnedFirst: acct.WebPinnedFirst != nil && *acct.WebPinnedFirst,
+		MediaTab:    acct.WebMediaTab != nil && *acct.WebMediaTab,
+	}
+}
@@ -0,0 +1,41 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) DomainBlockRemove(ctx context.Context, requestingAccount *gtsmodel.Account, domain string) gtserror.WithCode {
+	domain, errWithCode := validateBlockableDomain(domain)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// deleting a block that doesn't exist is a no-op, so we don't need to check first
+	if err := p.db.DeleteAccountDomainBlock(ctx, requestingAccount.ID, domain); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockRemove: error removing domain block from db: %s", err))
+	}
+
+	return nil
+}
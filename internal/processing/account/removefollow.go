@@ -75,6 +75,9 @@ func (p *processor) FollowRemove(ctx context.Context, requestingAccount *gtsmode
 		if err := p.db.DeleteByID(ctx, f.ID, f); err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountFollowRemove: error removing follow from db: %s", err))
 		}
+		if err := p.db.DeleteListEntriesForFollowID(ctx, f.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountFollowRemove: error removing follow from lists: %s", err))
+		}
 		fChanged = true
 	}
 
@@ -0,0 +1,70 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Search(ctx context.Context, requestingAccount *gtsmodel.Account, query string, limit int) ([]apimodel.Account, gtserror.WithCode) {
+	// people type mentions like @someone or @someone@example.org,
+	// but only the username part is useful to match on
+	query = strings.TrimPrefix(strings.TrimSpace(query), "@")
+	if i := strings.Index(query, "@"); i != -1 {
+		query = query[:i]
+	}
+
+	accounts := []apimodel.Account{}
+	if query == "" {
+		return accounts, nil
+	}
+
+	found, err := p.db.SearchAccountsForAutocomplete(ctx, requestingAccount.ID, query, limit)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			return accounts, nil
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("Search: error searching accounts: %s", err))
+	}
+
+	for _, a := range found {
+		blocked, err := p.db.IsBlocked(ctx, requestingAccount.ID, a.ID, true)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		if blocked {
+			continue
+		}
+
+		account, err := p.tc.AccountToAPIAccountPublic(ctx, a)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		accounts = append(accounts, *account)
+	}
+
+	return accounts, nil
+}
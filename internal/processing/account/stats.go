@@ -0,0 +1,182 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	oneDay       = 24 * time.Hour
+	dateFormat   = "2006-01-02"
+	statsMaxTags = 10
+)
+
+func (p *processor) StatsGet(ctx context.Context, account *gtsmodel.Account, days int) (*apimodel.AccountStats, gtserror.WithCode) {
+	today := time.Now().UTC().Truncate(oneDay)
+
+	since := today.AddDate(0, 0, 1-days)
+	if created := account.CreatedAt.UTC().Truncate(oneDay); since.Before(created) {
+		since = created
+	}
+
+	stored, err := p.db.GetAccountDailyStats(ctx, account.ID, since)
+	if err != nil && err != db.ErrNoEntries {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("StatsGet: error getting stored stats: %s", err))
+	}
+
+	byDate := make(map[string]*gtsmodel.AccountDailyStats, len(stored))
+	for _, s := range stored {
+		byDate[s.Day.UTC().Format(dateFormat)] = s
+	}
+
+	// roll up any ended days which haven't been already; usually
+	// this is just the days since stats were last requested
+	for d := since; d.Before(today); d = d.Add(oneDay) {
+		if _, ok := byDate[d.Format(dateFormat)]; ok {
+			continue
+		}
+
+		if err := p.rollupStats(ctx, account.ID, d, today, byDate); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("StatsGet: %s", err))
+		}
+		break
+	}
+
+	// today hasn't ended yet, so compute it without storing
+	todayStats, err := p.db.ComputeAccountDailyStats(ctx, account.ID, today, today.Add(oneDay))
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("StatsGet: error computing stats for today: %s", err))
+	}
+	for _, s := range todayStats {
+		byDate[s.Day.Format(dateFormat)] = s
+	}
+
+	stats := &apimodel.AccountStats{
+		Since: since.Format(dateFormat),
+		Days:  []apimodel.AccountStatsPeriod{},
+		Weeks: []apimodel.AccountStatsPeriod{},
+		Tags:  []apimodel.AccountStatsTag{},
+	}
+
+	tagCounts := make(map[string]int)
+	for d := since; !d.After(today); d = d.Add(oneDay) {
+		period := apimodel.AccountStatsPeriod{Date: d.Format(dateFormat)}
+		if s, ok := byDate[period.Date]; ok {
+			period.Statuses = s.Statuses
+			period.Boosts = s.Boosts
+			period.NewFollowers = s.NewFollowers
+			period.Followers = s.Followers
+			for _, t := range s.Tags {
+				tagCounts[t.TagID] += t.Count
+			}
+		}
+		stats.Days = append(stats.Days, period)
+
+		// weeks start on monday
+		if len(stats.Weeks) == 0 || d.Weekday() == time.Monday {
+			stats.Weeks = append(stats.Weeks, apimodel.AccountStatsPeriod{Date: period.Date})
+		}
+		week := &stats.Weeks[len(stats.Weeks)-1]
+		week.Statuses += period.Statuses
+		week.Boosts += period.Boosts
+		week.NewFollowers += period.NewFollowers
+		week.Followers = period.Followers
+	}
+
+	stats.Tags = p.topTags(ctx, tagCounts)
+
+	return stats, nil
+}
+
+// rollupStats computes and stores daily stats for the given account for every day from from until
+// the day before until, adding them to byDate. Days already present in byDate aren't stored again.
+func (p *processor) rollupStats(ctx context.Context, accountID string, from time.Time, until time.Time, byDate map[string]*gtsmodel.AccountDailyStats) error {
+	computed, err := p.db.ComputeAccountDailyStats(ctx, accountID, from, until)
+	if err != nil {
+		return fmt.Errorf("error computing stats: %s", err)
+	}
+
+	toStore := make([]*gtsmodel.AccountDailyStats, 0, len(computed))
+	for _, s := range computed {
+		date := s.Day.Format(dateFormat)
+		if _, ok := byDate[date]; ok {
+			continue
+		}
+
+		s.ID, err = id.NewRandomULID()
+		if err != nil {
+			return fmt.Errorf("error generating id: %s", err)
+		}
+
+		byDate[date] = s
+		toStore = append(toStore, s)
+	}
+
+	if err := p.db.PutAccountDailyStats(ctx, toStore); err != nil {
+		return fmt.Errorf("error storing stats: %s", err)
+	}
+
+	return nil
+}
+
+// topTags returns the most used of the given tags, by tag ID, with their names.
+func (p *processor) topTags(ctx context.Context, tagCounts map[string]int) []apimodel.AccountStatsTag {
+	tagIDs := make([]string, 0, len(tagCounts))
+	for tagID := range tagCounts {
+		tagIDs = append(tagIDs, tagID)
+	}
+	sort.Slice(tagIDs, func(i, j int) bool {
+		if tagCounts[tagIDs[i]] != tagCounts[tagIDs[j]] {
+			return tagCounts[tagIDs[i]] > tagCounts[tagIDs[j]]
+		}
+		return tagIDs[i] < tagIDs[j]
+	})
+
+	tags := make([]apimodel.AccountStatsTag, 0, statsMaxTags)
+	for _, tagID := range tagIDs {
+		if len(tags) == statsMaxTags {
+			break
+		}
+
+		tag := &gtsmodel.Tag{}
+		if err := p.db.GetByID(ctx, tagID, tag); err != nil {
+			// the tag may have been removed since
+			log.Debugf("topTags: error getting tag %s: %s", tagID, err)
+			continue
+		}
+
+		tags = append(tags, apimodel.AccountStatsTag{
+			Name:  tag.Name,
+			Count: tagCounts[tagID],
+		})
+	}
+
+	return tags
+}
@@ -0,0 +1,100 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *StatsTestSuite) TestStatsGetWeek() {
+	account := suite.testAccounts["local_account_1"]
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, -6)
+
+	stats, errWithCode := suite.accountProcessor.StatsGet(context.Background(), account, 7)
+	suite.NoError(errWithCode)
+	suite.Equal(since.Format("2006-01-02"), stats.Since)
+	suite.Len(stats.Days, 7)
+	suite.Equal(today.Format("2006-01-02"), stats.Days[6].Date)
+	for _, day := range stats.Days {
+		suite.Zero(day.Statuses)
+		suite.Equal(2, day.Followers)
+	}
+	if since.Weekday() == time.Monday {
+		suite.Len(stats.Weeks, 1)
+	} else {
+		suite.Len(stats.Weeks, 2)
+		suite.Equal(since.Format("2006-01-02"), stats.Weeks[0].Date)
+		monday := since
+		for monday.Weekday() != time.Monday {
+			monday = monday.AddDate(0, 0, 1)
+		}
+		suite.Equal(monday.Format("2006-01-02"), stats.Weeks[1].Date)
+	}
+	suite.Empty(stats.Tags)
+
+	// the ended days should have been rolled up, but not today
+	stored, err := suite.db.GetAccountDailyStats(context.Background(), account.ID, since)
+	suite.NoError(err)
+	suite.Len(stored, 6)
+
+	// getting the stats again should use what was stored
+	stats, errWithCode = suite.accountProcessor.StatsGet(context.Background(), account, 7)
+	suite.NoError(errWithCode)
+	suite.Len(stats.Days, 7)
+}
+
+func (suite *StatsTestSuite) TestStatsGetSinceCreated() {
+	account := suite.testAccounts["local_account_1"]
+
+	stats, errWithCode := suite.accountProcessor.StatsGet(context.Background(), account, 3660)
+	suite.NoError(errWithCode)
+
+	// days before the account was created aren't included, so
+	// statuses from before then shouldn't be counted either
+	suite.Equal(account.CreatedAt.UTC().Format("2006-01-02"), stats.Since)
+	suite.Equal(stats.Since, stats.Days[0].Date)
+	suite.Equal(1, stats.Days[0].Statuses)
+	suite.Equal(2, stats.Days[0].Followers)
+
+	statuses := 0
+	for _, week := range stats.Weeks {
+		statuses += week.Statuses
+	}
+	suite.Equal(1, statuses)
+
+	stored := []*gtsmodel.AccountDailyStats{}
+	err := suite.db.GetWhere(context.Background(), []db.Where{{Key: "account_id", Value: account.ID}}, &stored)
+	suite.NoError(err)
+	suite.Len(stored, len(stats.Days)-1)
+}
+
+func TestStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatsTestSuite))
+}
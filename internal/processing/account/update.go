@@ -76,6 +76,23 @@ func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		updateEmojis = true
 	}
 
+	if form.FieldsAttributes != nil {
+		if err := validate.ProfileFields(*form.FieldsAttributes); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		account.Fields = make([]gtsmodel.Field, 0, len(*form.FieldsAttributes))
+		for _, f := range *form.FieldsAttributes {
+			field := gtsmodel.Field{
+				Name: text.SanitizePlaintext(*f.Name),
+			}
+			if f.Value != nil {
+				field.Value = text.SanitizePlaintext(*f.Value)
+			}
+			account.Fields = append(account.Fields, field)
+		}
+	}
+
 	if updateEmojis {
 		// account emojis -- treat the sanitized display name and raw
 		// note like one long text for the purposes of deriving emojis
@@ -131,6 +148,12 @@ func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, form
 			account.Language = *form.Source.Language
 		}
 
+		if form.Source.Locale != nil {
+			if err := p.updateLocale(ctx, account, *form.Source.Locale); err != nil {
+				return nil, err
+			}
+		}
+
 		if form.Source.Sensitive != nil {
 			account.Sensitive = form.Source.Sensitive
 		}
@@ -150,6 +173,52 @@ func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, form
 
 			account.StatusFormat = *form.Source.StatusFormat
 		}
+
+		if form.Source.FollowersOnlyBoostable != nil {
+			account.FollowersOnlyBoostable = form.Source.FollowersOnlyBoostable
+		}
+
+		if form.Source.MentionPolicy != nil {
+			if err := validate.MentionPolicy(*form.Source.MentionPolicy); err != nil {
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+
+			account.MentionPolicy = gtsmodel.MentionPolicy(*form.Source.MentionPolicy)
+		}
+
+		if form.Source.WebHideBoosts != nil {
+			account.WebHideBoosts = form.Source.WebHideBoosts
+		}
+
+		if form.Source.WebHideReplies != nil {
+			account.WebHideReplies = form.Source.WebHideReplies
+		}
+
+		if form.Source.WebPinnedFirst != nil {
+			account.WebPinnedFirst = form.Source.WebPinnedFirst
+		}
+
+		if form.Source.WebMediaTab != nil {
+			account.WebMediaTab = form.Source.WebMediaTab
+		}
+
+		if form.Source.HideMirrors != nil {
+			account.HideMirrors = form.Source.HideMirrors
+		}
+
+		if form.Source.NotificationsRetentionDays != nil {
+			retentionDays := *form.Source.NotificationsRetentionDays
+			if err := validate.NotificationsRetentionDays(retentionDays); err != nil {
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+
+			if retentionDays == -1 {
+				// go back to the instance default
+				account.NotificationsRetentionDays = nil
+			} else {
+				account.NotificationsRetentionDays = &retentionDays
+			}
+		}
 	}
 
 	if form.CustomCSS != nil {
@@ -268,3 +337,25 @@ func (p *processor) processNote(ctx context.Context, note string, accountID stri
 
 	return p.formatter.FromPlain(ctx, note, mentions, tags), nil
 }
+
+// updateLocale sets the locale of the user of the given account, which is the language
+// web pages and error messages are shown to them in. An empty locale clears it.
+func (p *processor) updateLocale(ctx context.Context, account *gtsmodel.Account, locale string) gtserror.WithCode {
+	if locale != "" {
+		if err := validate.Language(locale); err != nil {
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("could not get user for account %s: %s", account.ID, err))
+	}
+
+	user.Locale = locale
+	if _, err := p.db.UpdateUser(ctx, user, "locale", "updated_at"); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("could not update locale of user %s: %s", user.ID, err))
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ package processing
 
 import (
 	"context"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -30,6 +31,14 @@ func (p *processor) AdminAccountAction(ctx context.Context, authed *oauth.Auth,
 	return p.adminProcessor.AccountAction(ctx, authed.Account, form)
 }
 
+func (p *processor) AdminAccountInteractionsGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.AdminAccountInteractions, gtserror.WithCode) {
+	return p.adminProcessor.AccountInteractionsGet(ctx, id)
+}
+
+func (p *processor) AdminAccountRecoveryCodesCreate(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.AdminRecoveryCodes, gtserror.WithCode) {
+	return p.adminProcessor.AccountRecoveryCodesCreate(ctx, id)
+}
+
 func (p *processor) AdminEmojiCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode) {
 	return p.adminProcessor.EmojiCreate(ctx, authed.Account, authed.User, form)
 }
@@ -42,16 +51,48 @@ func (p *processor) AdminEmojiGet(ctx context.Context, authed *oauth.Auth, id st
 	return p.adminProcessor.EmojiGet(ctx, authed.Account, authed.User, id)
 }
 
+func (p *processor) AdminEmojiUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiUpdateRequest) (*apimodel.AdminEmoji, gtserror.WithCode) {
+	return p.adminProcessor.EmojiUpdate(ctx, id, form)
+}
+
 func (p *processor) AdminEmojiDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.AdminEmoji, gtserror.WithCode) {
 	return p.adminProcessor.EmojiDelete(ctx, id)
 }
 
+func (p *processor) AdminEmojiUsageGet(ctx context.Context, authed *oauth.Auth, domain string, ascending bool, limit int) ([]*apimodel.AdminEmojiUsage, gtserror.WithCode) {
+	return p.adminProcessor.EmojiUsageGet(ctx, domain, ascending, limit)
+}
+
 func (p *processor) AdminEmojiCategoriesGet(ctx context.Context) ([]*apimodel.EmojiCategory, gtserror.WithCode) {
 	return p.adminProcessor.EmojiCategoriesGet(ctx)
 }
 
+func (p *processor) AdminEmojiCategoryUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiCategoryUpdateRequest) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	return p.adminProcessor.EmojiCategoryUpdate(ctx, id, form)
+}
+
+func (p *processor) AdminEmojiCategoryDelete(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiCategoryDeleteRequest) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	return p.adminProcessor.EmojiCategoryDelete(ctx, id, form)
+}
+
+func (p *processor) AdminEmojiCopy(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiCopyRequest) (*apimodel.Emoji, gtserror.WithCode) {
+	return p.adminProcessor.EmojiCopy(ctx, authed.Account, authed.User, id, form)
+}
+
+func (p *processor) AdminEmojiPackImport(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiPackImportRequest) ([]*apimodel.Emoji, gtserror.WithCode) {
+	return p.adminProcessor.EmojiPackImport(ctx, authed.Account, authed.User, form)
+}
+
+func (p *processor) AdminEmojiPackExport(ctx context.Context, authed *oauth.Auth, category string) (*apimodel.Content, gtserror.WithCode) {
+	return p.adminProcessor.EmojiPackExport(ctx, category)
+}
+
 func (p *processor) AdminDomainBlockCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainBlockCreateRequest) (*apimodel.DomainBlock, gtserror.WithCode) {
-	return p.adminProcessor.DomainBlockCreate(ctx, authed.Account, form.Domain, form.Obfuscate, form.PublicComment, form.PrivateComment, "")
+	var expiresAt time.Time
+	if form.ExpiresIn > 0 {
+		expiresAt = time.Now().Add(time.Duration(form.ExpiresIn) * time.Second)
+	}
+	return p.adminProcessor.DomainBlockCreate(ctx, authed.Account, form.Domain, form.Obfuscate, form.PublicComment, form.PrivateComment, "", expiresAt)
 }
 
 func (p *processor) AdminDomainBlocksImport(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainBlockCreateRequest) ([]*apimodel.DomainBlock, gtserror.WithCode) {
@@ -70,6 +111,94 @@ func (p *processor) AdminDomainBlockDelete(ctx context.Context, authed *oauth.Au
 	return p.adminProcessor.DomainBlockDelete(ctx, authed.Account, id)
 }
 
+func (p *processor) AdminDomainBlocksExpire(ctx context.Context, now time.Time, batchSize int) (int, error) {
+	return p.adminProcessor.DomainBlocksExpire(ctx, now, batchSize)
+}
+
+func (p *processor) AdminDomainNoteSet(ctx context.Context, authed *oauth.Auth, domain string, form *apimodel.DomainNoteRequest) (*apimodel.DomainNote, gtserror.WithCode) {
+	return p.adminProcessor.DomainNoteSet(ctx, authed.Account, domain, form.Text)
+}
+
+func (p *processor) AdminDomainNoteGet(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainNote, gtserror.WithCode) {
+	return p.adminProcessor.DomainNoteGet(ctx, authed.Account, domain)
+}
+
+func (p *processor) AdminDomainNotesGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.DomainNote, gtserror.WithCode) {
+	return p.adminProcessor.DomainNotesGet(ctx, authed.Account)
+}
+
+func (p *processor) AdminDomainNoteDelete(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainNote, gtserror.WithCode) {
+	return p.adminProcessor.DomainNoteDelete(ctx, authed.Account, domain)
+}
+
+func (p *processor) AdminFederationErrorDomainsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminFederationErrorDomain, gtserror.WithCode) {
+	return p.adminProcessor.FederationErrorDomainsGet(ctx)
+}
+
+func (p *processor) AdminFederationErrorsGet(ctx context.Context, authed *oauth.Auth, domain string) ([]*apimodel.AdminFederationError, gtserror.WithCode) {
+	return p.adminProcessor.FederationErrorsGet(ctx, domain)
+}
+
+func (p *processor) AdminDomainStorageGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminDomainStorage, gtserror.WithCode) {
+	return p.adminProcessor.DomainStorageGet(ctx)
+}
+
+func (p *processor) AdminDomainStorageExport(ctx context.Context, authed *oauth.Auth) (*apimodel.Content, gtserror.WithCode) {
+	return p.adminProcessor.DomainStorageExport(ctx)
+}
+
+func (p *processor) AdminUserAgentRejectionsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminUserAgentRejection, gtserror.WithCode) {
+	return p.adminProcessor.UserAgentRejectionsGet(ctx)
+}
+
+func (p *processor) AdminDBPoolStatsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminDBPoolStats, gtserror.WithCode) {
+	return p.adminProcessor.DBPoolStatsGet(ctx)
+}
+
+func (p *processor) AdminCacheStatsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminCacheStats, gtserror.WithCode) {
+	return p.adminProcessor.CacheStatsGet(ctx)
+}
+
+func (p *processor) AdminDBMaintain(ctx context.Context, authed *oauth.Auth) (*apimodel.AdminDBMaintenanceResult, gtserror.WithCode) {
+	return p.adminProcessor.DBMaintain(ctx)
+}
+
+func (p *processor) AdminDomainEmojiPolicySet(ctx context.Context, authed *oauth.Auth, domain string, form *apimodel.DomainEmojiPolicyRequest) (*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	return p.adminProcessor.DomainEmojiPolicySet(ctx, authed.Account, domain, form.Policy)
+}
+
+func (p *processor) AdminDomainEmojiPolicyGet(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	return p.adminProcessor.DomainEmojiPolicyGet(ctx, authed.Account, domain)
+}
+
+func (p *processor) AdminDomainEmojiPoliciesGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	return p.adminProcessor.DomainEmojiPoliciesGet(ctx, authed.Account)
+}
+
+func (p *processor) AdminDomainEmojiPolicyDelete(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	return p.adminProcessor.DomainEmojiPolicyDelete(ctx, authed.Account, domain)
+}
+
 func (p *processor) AdminMediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode {
 	return p.adminProcessor.MediaPrune(ctx, mediaRemoteCacheDays)
 }
+
+func (p *processor) AdminEmojiStaticsRegenerate(ctx context.Context) gtserror.WithCode {
+	return p.adminProcessor.EmojiStaticsRegenerate(ctx)
+}
+
+func (p *processor) AdminEmojiDomainAction(ctx context.Context, authed *oauth.Auth, domain string, form *apimodel.EmojiDomainActionRequest) (*apimodel.AdminEmojiDomainAction, gtserror.WithCode) {
+	return p.adminProcessor.EmojiDomainAction(ctx, domain, form)
+}
+
+func (p *processor) AdminLegalDocumentCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.LegalDocumentCreateRequest) (*apimodel.LegalDocument, gtserror.WithCode) {
+	return p.adminProcessor.LegalDocumentCreate(ctx, authed.Account, form)
+}
+
+func (p *processor) AdminLegalDocumentsGet(ctx context.Context, authed *oauth.Auth, kind string) ([]*apimodel.LegalDocument, gtserror.WithCode) {
+	return p.adminProcessor.LegalDocumentsGet(ctx, kind)
+}
+
+func (p *processor) AdminLegalDocumentGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.LegalDocument, gtserror.WithCode) {
+	return p.adminProcessor.LegalDocumentGet(ctx, id)
+}
@@ -40,6 +40,13 @@ func (p *processor) AccountAction(ctx context.Context, account *gtsmodel.Account
 			OriginAccount:  account,
 			TargetAccount:  targetAccount,
 		})
+	case string(gtsmodel.AdminActionMirror), string(gtsmodel.AdminActionUnmirror):
+		adminAction.Type = gtsmodel.AdminActionType(form.Type)
+		mirror := adminAction.Type == gtsmodel.AdminActionMirror
+		targetAccount.Mirror = &mirror
+		if _, err := p.db.UpdateAccount(ctx, targetAccount); err != nil {
+			return gtserror.NewErrorInternalError(err)
+		}
 	default:
 		return gtserror.NewErrorBadRequest(fmt.Errorf("admin action type %s is not supported for this endpoint", form.Type))
 	}
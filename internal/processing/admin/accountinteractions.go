@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) AccountInteractionsGet(ctx context.Context, targetAccountID string) (*apimodel.AdminAccountInteractions, gtserror.WithCode) {
+	targetAccount, err := p.db.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("AccountInteractionsGet: no account with id %s found in the db", targetAccountID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err = fmt.Errorf("AccountInteractionsGet: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAccount.Domain == "" {
+		err := fmt.Errorf("account %s is a local account", targetAccountID)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	interactions, err := p.db.GetAccountLocalInteractions(ctx, targetAccount.ID)
+	if err != nil {
+		err = fmt.Errorf("AccountInteractionsGet: db error getting interactions: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	localAccountIDs := make([]string, 0, len(interactions))
+	for _, i := range interactions {
+		localAccountIDs = append(localAccountIDs, i.LocalAccountID)
+	}
+
+	localAccounts, err := p.db.GetAccountsByIDs(ctx, localAccountIDs)
+	if err != nil {
+		err = fmt.Errorf("AccountInteractionsGet: db error getting local accounts: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	localAccountsByID := make(map[string]*gtsmodel.Account, len(localAccounts))
+	for _, a := range localAccounts {
+		localAccountsByID[a.ID] = a
+	}
+
+	apiInteractions := &apimodel.AdminAccountInteractions{
+		Interactions: make([]*apimodel.AdminAccountInteraction, 0, len(interactions)),
+	}
+
+	for _, i := range interactions {
+		localAccount, ok := localAccountsByID[i.LocalAccountID]
+		if !ok {
+			// account was removed in the meantime
+			continue
+		}
+
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, localAccount)
+		if err != nil {
+			err = fmt.Errorf("AccountInteractionsGet: error converting account %s: %s", localAccount.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiInteraction := &apimodel.AdminAccountInteraction{
+			Account:       apiAccount,
+			Following:     i.Following,
+			Requested:     i.Requested,
+			FollowedBy:    i.FollowedBy,
+			BlockedBy:     i.BlockedBy,
+			MentionsCount: i.MentionsCount,
+		}
+		if !i.LastMentionedAt.IsZero() {
+			apiInteraction.LastMentionedAt = util.FormatISO8601(i.LastMentionedAt)
+		}
+
+		if i.Following {
+			apiInteractions.LocalFollowing++
+		}
+		if i.Requested {
+			apiInteractions.PendingFollowRequests++
+		}
+		if i.FollowedBy {
+			apiInteractions.LocalFollowers++
+		}
+		if i.BlockedBy {
+			apiInteractions.LocalBlocks++
+		}
+		apiInteractions.MentionsCount += i.MentionsCount
+
+		apiInteractions.Interactions = append(apiInteractions.Interactions, apiInteraction)
+	}
+
+	return apiInteractions, nil
+}
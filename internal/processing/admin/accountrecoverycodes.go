@@ -0,0 +1,73 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) AccountRecoveryCodesCreate(ctx context.Context, targetAccountID string) (*apimodel.AdminRecoveryCodes, gtserror.WithCode) {
+	if !config.GetOIDCEnabled() {
+		err := errors.New("recovery codes are only used when OIDC is enabled; users can sign in with their password instead")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	targetAccount, err := p.db.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("AccountRecoveryCodesCreate: no account with id %s found in the db", targetAccountID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err = fmt.Errorf("AccountRecoveryCodesCreate: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAccount.Domain != "" {
+		err := fmt.Errorf("account %s is not a local account", targetAccountID)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, targetAccount.ID)
+	if err != nil {
+		err = fmt.Errorf("AccountRecoveryCodesCreate: db error getting user: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	codes, hashes, err := oauth.NewRecoveryCodes()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// any codes the user had before are replaced
+	user.RecoveryCodes = hashes
+	if _, err := p.db.UpdateUser(ctx, user, "recovery_codes", "updated_at"); err != nil {
+		err = fmt.Errorf("AccountRecoveryCodesCreate: db error updating user: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.AdminRecoveryCodes{Codes: codes}, nil
+}
@@ -21,6 +21,9 @@ package admin
 import (
 	"context"
 	"mime/multipart"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
@@ -29,38 +32,86 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 // Processor wraps a bunch of functions for processing admin actions.
 type Processor interface {
-	DomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string, subscriptionID string) (*apimodel.DomainBlock, gtserror.WithCode)
+	DomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string, subscriptionID string, expiresAt time.Time) (*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlocksImport(ctx context.Context, account *gtsmodel.Account, domains *multipart.FileHeader) ([]*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlocksGet(ctx context.Context, account *gtsmodel.Account, export bool) ([]*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlockGet(ctx context.Context, account *gtsmodel.Account, id string, export bool) (*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlockDelete(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.DomainBlock, gtserror.WithCode)
+	DomainBlocksExpire(ctx context.Context, now time.Time, batchSize int) (int, error)
+	DomainNoteSet(ctx context.Context, account *gtsmodel.Account, domain string, text string) (*apimodel.DomainNote, gtserror.WithCode)
+	DomainNoteGet(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainNote, gtserror.WithCode)
+	DomainNotesGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.DomainNote, gtserror.WithCode)
+	DomainNoteDelete(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainNote, gtserror.WithCode)
+	FederationErrorDomainsGet(ctx context.Context) ([]*apimodel.AdminFederationErrorDomain, gtserror.WithCode)
+	FederationErrorsGet(ctx context.Context, domain string) ([]*apimodel.AdminFederationError, gtserror.WithCode)
+	DomainStorageGet(ctx context.Context) ([]*apimodel.AdminDomainStorage, gtserror.WithCode)
+	DomainStorageExport(ctx context.Context) (*apimodel.Content, gtserror.WithCode)
+	UserAgentRejectionsGet(ctx context.Context) ([]*apimodel.AdminUserAgentRejection, gtserror.WithCode)
+	DBPoolStatsGet(ctx context.Context) ([]*apimodel.AdminDBPoolStats, gtserror.WithCode)
+	CacheStatsGet(ctx context.Context) ([]*apimodel.AdminCacheStats, gtserror.WithCode)
+	DBMaintain(ctx context.Context) (*apimodel.AdminDBMaintenanceResult, gtserror.WithCode)
+	DomainEmojiPolicySet(ctx context.Context, account *gtsmodel.Account, domain string, policy string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode)
+	DomainEmojiPolicyGet(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode)
+	DomainEmojiPoliciesGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.DomainEmojiPolicy, gtserror.WithCode)
+	DomainEmojiPolicyDelete(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode)
 	AccountAction(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminAccountActionRequest) gtserror.WithCode
+	AccountInteractionsGet(ctx context.Context, targetAccountID string) (*apimodel.AdminAccountInteractions, gtserror.WithCode)
+	AccountRecoveryCodesCreate(ctx context.Context, targetAccountID string) (*apimodel.AdminRecoveryCodes, gtserror.WithCode)
 	EmojiCreate(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode)
 	EmojisGet(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, domain string, includeDisabled bool, includeEnabled bool, shortcode string, maxShortcodeDomain string, minShortcodeDomain string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 	EmojiGet(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, id string) (*apimodel.AdminEmoji, gtserror.WithCode)
 	EmojiDelete(ctx context.Context, id string) (*apimodel.AdminEmoji, gtserror.WithCode)
+	EmojiUpdate(ctx context.Context, id string, form *apimodel.EmojiUpdateRequest) (*apimodel.AdminEmoji, gtserror.WithCode)
+	EmojiUsageGet(ctx context.Context, domain string, ascending bool, limit int) ([]*apimodel.AdminEmojiUsage, gtserror.WithCode)
 	EmojiCategoriesGet(ctx context.Context) ([]*apimodel.EmojiCategory, gtserror.WithCode)
+	EmojiCategoryUpdate(ctx context.Context, id string, form *apimodel.EmojiCategoryUpdateRequest) (*apimodel.EmojiCategory, gtserror.WithCode)
+	EmojiCategoryDelete(ctx context.Context, id string, form *apimodel.EmojiCategoryDeleteRequest) (*apimodel.EmojiCategory, gtserror.WithCode)
+	EmojiCopy(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, remoteEmojiID string, form *apimodel.EmojiCopyRequest) (*apimodel.Emoji, gtserror.WithCode)
+	EmojiPackImport(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.EmojiPackImportRequest) ([]*apimodel.Emoji, gtserror.WithCode)
+	EmojiPackExport(ctx context.Context, category string) (*apimodel.Content, gtserror.WithCode)
 	MediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode
+	EmojiStaticsRegenerate(ctx context.Context) gtserror.WithCode
+	EmojiDomainAction(ctx context.Context, domain string, form *apimodel.EmojiDomainActionRequest) (*apimodel.AdminEmojiDomainAction, gtserror.WithCode)
+	LegalDocumentCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.LegalDocumentCreateRequest) (*apimodel.LegalDocument, gtserror.WithCode)
+	LegalDocumentsGet(ctx context.Context, kind string) ([]*apimodel.LegalDocument, gtserror.WithCode)
+	LegalDocumentGet(ctx context.Context, id string) (*apimodel.LegalDocument, gtserror.WithCode)
 }
 
 type processor struct {
-	tc           typeutils.TypeConverter
-	mediaManager media.Manager
-	clientWorker *concurrency.WorkerPool[messages.FromClientAPI]
-	db           db.DB
+	tc                  typeutils.TypeConverter
+	mediaManager        media.Manager
+	transportController transport.Controller
+	clientWorker        *concurrency.WorkerPool[messages.FromClientAPI]
+	db                  db.DB
+	storage             storage.Driver
+	formatter           text.Formatter
+
+	// set while emoji statics are being regenerated,
+	// so that only one regeneration runs at a time
+	regeneratingEmojiStatics atomic.Bool
+
+	// domains whose emojis are being refreshed,
+	// so that each is only refreshed once at a time
+	refreshingEmojiDomains sync.Map
 }
 
 // New returns a new admin processor.
-func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, clientWorker *concurrency.WorkerPool[messages.FromClientAPI]) Processor {
+func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, transportController transport.Controller, clientWorker *concurrency.WorkerPool[messages.FromClientAPI], storage storage.Driver) Processor {
 	return &processor{
-		tc:           tc,
-		mediaManager: mediaManager,
-		clientWorker: clientWorker,
-		db:           db,
+		tc:                  tc,
+		mediaManager:        mediaManager,
+		transportController: transportController,
+		clientWorker:        clientWorker,
+		db:                  db,
+		storage:             storage,
+		formatter:           text.NewFormatter(db),
 	}
 }
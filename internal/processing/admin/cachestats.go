@@ -0,0 +1,49 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (p *processor) CacheStatsGet(ctx context.Context) ([]*apimodel.AdminCacheStats, gtserror.WithCode) {
+	caches := p.db.CacheStats()
+
+	apiCaches := make([]*apimodel.AdminCacheStats, 0, len(caches))
+	for _, s := range caches {
+		var hitRatio float64
+		if lookups := s.Hits + s.Misses; lookups > 0 {
+			hitRatio = float64(s.Hits) / float64(lookups)
+		}
+
+		apiCaches = append(apiCaches, &apimodel.AdminCacheStats{
+			Name:      s.Name,
+			Size:      s.Size,
+			Hits:      s.Hits,
+			Misses:    s.Misses,
+			Evictions: s.Evictions,
+			HitRatio:  hitRatio,
+		})
+	}
+
+	return apiCaches, nil
+}
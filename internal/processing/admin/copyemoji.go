@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// EmojiCopy creates a new local emoji from the image of the remote emoji with the given id.
+func (p *processor) EmojiCopy(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, remoteEmojiID string, form *apimodel.EmojiCopyRequest) (*apimodel.Emoji, gtserror.WithCode) {
+	if !*user.Admin {
+		return nil, gtserror.NewErrorUnauthorized(fmt.Errorf("user %s not an admin", user.ID), "user is not an admin")
+	}
+
+	remoteEmoji, err := p.db.GetEmojiByID(ctx, remoteEmojiID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("EmojiCopy: no emoji with id %s found in the db", remoteEmojiID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := fmt.Errorf("EmojiCopy: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if remoteEmoji.Domain == "" {
+		err := fmt.Errorf("emoji %s is already a local emoji", remoteEmojiID)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	shortcode := form.Shortcode
+	if shortcode == "" {
+		shortcode = remoteEmoji.Shortcode
+		if err := validate.EmojiShortcode(shortcode); err != nil {
+			err = fmt.Errorf("%s; please provide a different shortcode for the copy", err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	maybeExisting, err := p.db.GetEmojiByShortcodeDomain(ctx, shortcode, "")
+	if maybeExisting != nil {
+		return nil, gtserror.NewErrorConflict(fmt.Errorf("emoji with shortcode %s already exists", shortcode), fmt.Sprintf("emoji with shortcode %s already exists", shortcode))
+	}
+
+	if err != nil && err != db.ErrNoEntries {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error checking existence of emoji with shortcode %s: %s", shortcode, err))
+	}
+
+	emojiID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating id for new emoji: %s", err), "error creating emoji ID")
+	}
+
+	emojiURI := uris.GenerateURIForEmoji(emojiID)
+
+	// the original image of the remote emoji is already cached in our
+	// storage, so reprocess it from there rather than refetching it
+	data := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
+		rc, err := p.storage.GetStream(innerCtx, remoteEmoji.ImagePath)
+		return rc, int64(remoteEmoji.ImageFileSize), err
+	}
+
+	var ai *media.AdditionalEmojiInfo
+	if form.CategoryName != "" {
+		category, err := p.GetOrCreateEmojiCategory(ctx, form.CategoryName)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting id in category: %s", err), "error putting id in category")
+		}
+
+		ai = &media.AdditionalEmojiInfo{
+			CategoryID: &category.ID,
+		}
+	}
+
+	processingEmoji, err := p.mediaManager.ProcessEmoji(ctx, data, nil, shortcode, emojiID, emojiURI, ai, false)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error processing emoji: %s", err), "error processing emoji")
+	}
+
+	emoji, err := processingEmoji.LoadEmoji(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error loading emoji: %s", err), "error loading emoji")
+	}
+
+	apiEmoji, err := p.tc.EmojiToAPIEmoji(ctx, emoji)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting emoji: %s", err), "error converting emoji to api representation")
+	}
+
+	return &apiEmoji, nil
+}
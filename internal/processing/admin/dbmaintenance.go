@@ -0,0 +1,51 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (p *processor) DBMaintain(ctx context.Context) (*apimodel.AdminDBMaintenanceResult, gtserror.WithCode) {
+	begin := time.Now()
+
+	result, err := p.db.Maintain(ctx, config.GetDbMaintenanceVacuum(), config.GetDbMaintenanceReindex())
+	if err != nil {
+		if err == db.ErrMaintenanceInProgress {
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DBMaintain: error running database maintenance: %s", err))
+	}
+
+	return &apimodel.AdminDBMaintenanceResult{
+		SizeBefore: result.SizeBefore,
+		SizeAfter:  result.SizeAfter,
+		Reclaimed:  result.Reclaimed(),
+		Vacuumed:   result.Vacuumed,
+		Reindexed:  result.Reindexed,
+		DurationMS: time.Since(begin).Milliseconds(),
+	}, nil
+}
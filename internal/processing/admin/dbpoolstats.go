@@ -0,0 +1,48 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (p *processor) DBPoolStatsGet(ctx context.Context) ([]*apimodel.AdminDBPoolStats, gtserror.WithCode) {
+	pools := p.db.PoolStats()
+
+	apiPools := make([]*apimodel.AdminDBPoolStats, 0, len(pools))
+	for _, s := range pools {
+		apiPools = append(apiPools, &apimodel.AdminDBPoolStats{
+			Name:               s.Name,
+			MaxOpenConnections: s.MaxOpenConnections,
+			OpenConnections:    s.OpenConnections,
+			InUse:              s.InUse,
+			Idle:               s.Idle,
+			WaitCount:          s.WaitCount,
+			WaitDurationMS:     s.WaitDuration.Milliseconds(),
+			MaxIdleClosed:      s.MaxIdleClosed,
+			MaxIdleTimeClosed:  s.MaxIdleTimeClosed,
+			MaxLifetimeClosed:  s.MaxLifetimeClosed,
+		})
+	}
+
+	return apiPools, nil
+}
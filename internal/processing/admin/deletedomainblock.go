@@ -47,40 +47,50 @@ func (p *processor) DomainBlockDelete(ctx context.Context, account *gtsmodel.Acc
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	// Delete the domain block
-	if err := p.db.DeleteDomainBlock(ctx, domainBlock.Domain); err != nil {
+	if err := p.liftDomainBlock(ctx, domainBlock); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	return apiDomainBlock, nil
+}
+
+// liftDomainBlock deletes the given domain block, and undoes the suspension
+// of the domain's instance entry and accounts that the block caused.
+func (p *processor) liftDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock) error {
+	// Delete the domain block
+	if err := p.db.DeleteDomainBlock(ctx, block.Domain); err != nil {
+		return err
+	}
+
 	// remove the domain block reference from the instance, if we have an entry for it
 	i := &gtsmodel.Instance{}
 	if err := p.db.GetWhere(ctx, []db.Where{
-		{Key: "domain", Value: domainBlock.Domain},
-		{Key: "domain_block_id", Value: id},
+		{Key: "domain", Value: block.Domain},
+		{Key: "domain_block_id", Value: block.ID},
 	}, i); err == nil {
 		updatingColumns := []string{"suspended_at", "domain_block_id", "updated_at"}
 		i.SuspendedAt = time.Time{}
 		i.DomainBlockID = ""
 		i.UpdatedAt = time.Now()
 		if err := p.db.UpdateByID(ctx, i, i.ID, updatingColumns...); err != nil {
-			return nil, gtserror.NewErrorInternalError(fmt.Errorf("couldn't update database entry for instance %s: %s", domainBlock.Domain, err))
+			return fmt.Errorf("couldn't update database entry for instance %s: %s", block.Domain, err)
 		}
 	}
 
 	// unsuspend all accounts whose suspension origin was this domain block
 	// 1. remove the 'suspended_at' entry from their accounts
 	if err := p.db.UpdateWhere(ctx, []db.Where{
-		{Key: "suspension_origin", Value: domainBlock.ID},
+		{Key: "suspension_origin", Value: block.ID},
 	}, "suspended_at", nil, &[]*gtsmodel.Account{}); err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("database error removing suspended_at from accounts: %s", err))
+		return fmt.Errorf("database error removing suspended_at from accounts: %s", err)
 	}
 
 	// 2. remove the 'suspension_origin' entry from their accounts
 	if err := p.db.UpdateWhere(ctx, []db.Where{
-		{Key: "suspension_origin", Value: domainBlock.ID},
+		{Key: "suspension_origin", Value: block.ID},
 	}, "suspension_origin", nil, &[]*gtsmodel.Account{}); err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("database error removing suspension_origin from accounts: %s", err))
+		return fmt.Errorf("database error removing suspension_origin from accounts: %s", err)
 	}
 
-	return apiDomainBlock, nil
+	return nil
 }
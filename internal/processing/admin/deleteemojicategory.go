@@ -0,0 +1,103 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) EmojiCategoryDelete(ctx context.Context, id string, form *apimodel.EmojiCategoryDeleteRequest) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	if form.Cascade && form.ReassignTo != "" {
+		err := errors.New("cascade and reassign_to cannot be used together")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	category, err := p.db.GetEmojiCategory(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("EmojiCategoryDelete: no emoji category with id %s found in the db", id)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := fmt.Errorf("EmojiCategoryDelete: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var reassignTo *gtsmodel.EmojiCategory
+	if form.ReassignTo != "" {
+		reassignTo, err = p.GetOrCreateEmojiCategory(ctx, form.ReassignTo)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if reassignTo.ID == category.ID {
+			err := errors.New("cannot reassign emojis to the category being deleted")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	apiCategory, err := p.tc.EmojiCategoryToAPIEmojiCategory(ctx, category)
+	if err != nil {
+		err := fmt.Errorf("EmojiCategoryDelete: error converting emoji category to api emoji category: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if form.Cascade || reassignTo != nil {
+		emojis, err := p.db.GetEmojisByCategoryID(ctx, category.ID)
+		if err != nil {
+			err := fmt.Errorf("EmojiCategoryDelete: db error getting emojis in category: %s", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		for _, emoji := range emojis {
+			if reassignTo != nil {
+				emoji.CategoryID = reassignTo.ID
+				if _, err := p.db.UpdateEmoji(ctx, emoji, "updated_at", "category_id"); err != nil {
+					err := fmt.Errorf("EmojiCategoryDelete: db error reassigning emoji %s: %s", emoji.ID, err)
+					return nil, gtserror.NewErrorInternalError(err)
+				}
+				continue
+			}
+
+			// Only local emojis are deleted; any remote
+			// emojis will just be left uncategorized.
+			if emoji.Domain != "" {
+				continue
+			}
+
+			if err := p.db.DeleteEmojiByID(ctx, emoji.ID); err != nil {
+				err := fmt.Errorf("EmojiCategoryDelete: db error deleting emoji %s: %s", emoji.ID, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+		}
+	}
+
+	if err := p.db.DeleteEmojiCategory(ctx, category.ID); err != nil {
+		err := fmt.Errorf("EmojiCategoryDelete: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiCategory, nil
+}
@@ -0,0 +1,122 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"golang.org/x/net/idna"
+)
+
+func (p *processor) DomainEmojiPolicySet(ctx context.Context, account *gtsmodel.Account, domain string, policy string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	switch gtsmodel.EmojiPolicy(policy) {
+	case gtsmodel.EmojiPolicyStore, gtsmodel.EmojiPolicyHide, gtsmodel.EmojiPolicyReject:
+	default:
+		err := fmt.Errorf("emoji policy %q is not supported; use one of store, hide, reject", policy)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// emoji policies are stored against the punycode form of the domain
+	domain, err := idna.ToASCII(strings.ToLower(domain))
+	if err != nil {
+		err = fmt.Errorf("invalid domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	policyID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating id for domain emoji policy %s: %s", domain, err))
+	}
+
+	domainPolicy := &gtsmodel.DomainEmojiPolicy{
+		ID:        policyID,
+		Domain:    domain,
+		Policy:    gtsmodel.EmojiPolicy(policy),
+		AccountID: account.ID,
+	}
+
+	if err := validate.Struct(domainPolicy); err != nil {
+		err = fmt.Errorf("invalid domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// this will update the policy of an existing entry for this domain, if there is one
+	if err := p.db.PutDomainEmojiPolicy(ctx, domainPolicy); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting domain emoji policy %s: %s", domain, err))
+	}
+
+	return p.DomainEmojiPolicyGet(ctx, account, domainPolicy.Domain)
+}
+
+func (p *processor) DomainEmojiPolicyGet(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	policy, err := p.db.GetDomainEmojiPolicy(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting domain emoji policy %s: %s", domain, err))
+		}
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no emoji policy for domain %s", domain))
+	}
+
+	apiPolicy, err := p.tc.DomainEmojiPolicyToAPIDomainEmojiPolicy(ctx, policy)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPolicy, nil
+}
+
+func (p *processor) DomainEmojiPoliciesGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	policies, err := p.db.GetDomainEmojiPolicies(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting domain emoji policies: %s", err))
+	}
+
+	apiPolicies := make([]*apimodel.DomainEmojiPolicy, 0, len(policies))
+	for _, policy := range policies {
+		apiPolicy, err := p.tc.DomainEmojiPolicyToAPIDomainEmojiPolicy(ctx, policy)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiPolicies = append(apiPolicies, apiPolicy)
+	}
+
+	return apiPolicies, nil
+}
+
+func (p *processor) DomainEmojiPolicyDelete(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode) {
+	apiPolicy, errWithCode := p.DomainEmojiPolicyGet(ctx, account, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.DeleteDomainEmojiPolicy(ctx, domain); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error deleting domain emoji policy %s: %s", domain, err))
+	}
+
+	return apiPolicy, nil
+}
@@ -0,0 +1,126 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"golang.org/x/net/idna"
+)
+
+func (p *processor) DomainNoteSet(ctx context.Context, account *gtsmodel.Account, domain string, noteText string) (*apimodel.DomainNote, gtserror.WithCode) {
+	if err := validate.DomainNote(noteText); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	noteText = text.SanitizePlaintext(noteText)
+	if noteText == "" {
+		err := errors.New("note text was empty; to remove a note, use DELETE instead")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// domain notes are stored against the punycode form of the domain
+	domain, err := idna.ToASCII(strings.ToLower(domain))
+	if err != nil {
+		err = fmt.Errorf("invalid domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	noteID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating id for domain note %s: %s", domain, err))
+	}
+
+	note := &gtsmodel.DomainNote{
+		ID:        noteID,
+		Domain:    domain,
+		Text:      noteText,
+		AccountID: account.ID,
+	}
+
+	if err := validate.Struct(note); err != nil {
+		err = fmt.Errorf("invalid domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// this will update the text of an existing note for this domain, if there is one
+	if err := p.db.PutDomainNote(ctx, note); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting domain note %s: %s", domain, err))
+	}
+
+	return p.DomainNoteGet(ctx, account, note.Domain)
+}
+
+func (p *processor) DomainNoteGet(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainNote, gtserror.WithCode) {
+	note, err := p.db.GetDomainNote(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting domain note %s: %s", domain, err))
+		}
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no note for domain %s", domain))
+	}
+
+	apiNote, err := p.tc.DomainNoteToAPIDomainNote(ctx, note)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiNote, nil
+}
+
+func (p *processor) DomainNotesGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.DomainNote, gtserror.WithCode) {
+	notes, err := p.db.GetDomainNotes(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting domain notes: %s", err))
+	}
+
+	apiNotes := make([]*apimodel.DomainNote, 0, len(notes))
+	for _, n := range notes {
+		apiNote, err := p.tc.DomainNoteToAPIDomainNote(ctx, n)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiNotes = append(apiNotes, apiNote)
+	}
+
+	return apiNotes, nil
+}
+
+func (p *processor) DomainNoteDelete(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.DomainNote, gtserror.WithCode) {
+	apiNote, errWithCode := p.DomainNoteGet(ctx, account, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.DeleteDomainNote(ctx, domain); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error deleting domain note %s: %s", domain, err))
+	}
+
+	return apiNote, nil
+}
@@ -0,0 +1,106 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// domainStorageCSVHeader is the first row of a domain storage export.
+var domainStorageCSVHeader = []string{
+	"domain",
+	"accounts_count",
+	"statuses_count",
+	"attachments_count",
+	"attachments_bytes",
+	"emojis_count",
+	"emojis_bytes",
+	"total_bytes",
+}
+
+func (p *processor) DomainStorageGet(ctx context.Context) ([]*apimodel.AdminDomainStorage, gtserror.WithCode) {
+	storages, err := p.db.GetDomainStorage(ctx)
+	if err != nil {
+		err := fmt.Errorf("DomainStorageGet: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiStorages := make([]*apimodel.AdminDomainStorage, 0, len(storages))
+	for _, s := range storages {
+		apiStorages = append(apiStorages, &apimodel.AdminDomainStorage{
+			Domain:           s.Domain,
+			AccountsCount:    s.AccountsCount,
+			StatusesCount:    s.StatusesCount,
+			AttachmentsCount: s.AttachmentsCount,
+			AttachmentsBytes: s.AttachmentsBytes,
+			EmojisCount:      s.EmojisCount,
+			EmojisBytes:      s.EmojisBytes,
+			TotalBytes:       s.AttachmentsBytes + s.EmojisBytes,
+		})
+	}
+
+	return apiStorages, nil
+}
+
+func (p *processor) DomainStorageExport(ctx context.Context) (*apimodel.Content, gtserror.WithCode) {
+	apiStorages, errWithCode := p.DomainStorageGet(ctx)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	if err := w.Write(domainStorageCSVHeader); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainStorageExport: error writing header: %s", err))
+	}
+
+	for _, s := range apiStorages {
+		if err := w.Write([]string{
+			s.Domain,
+			strconv.Itoa(s.AccountsCount),
+			strconv.Itoa(s.StatusesCount),
+			strconv.Itoa(s.AttachmentsCount),
+			strconv.FormatInt(s.AttachmentsBytes, 10),
+			strconv.Itoa(s.EmojisCount),
+			strconv.FormatInt(s.EmojisBytes, 10),
+			strconv.FormatInt(s.TotalBytes, 10),
+		}); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainStorageExport: error writing domain %s: %s", s.Domain, err))
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainStorageExport: error flushing csv: %s", err))
+	}
+
+	return &apimodel.Content{
+		ContentType:   "text/csv",
+		ContentLength: int64(buf.Len()),
+		Content:       io.NopCloser(buf),
+	}, nil
+}
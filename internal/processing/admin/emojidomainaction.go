@@ -0,0 +1,138 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/net/idna"
+)
+
+const (
+	emojiDomainActionDisable = "disable"
+	emojiDomainActionEnable  = "enable"
+	emojiDomainActionRefresh = "refresh"
+)
+
+func (p *processor) EmojiDomainAction(ctx context.Context, domain string, form *apimodel.EmojiDomainActionRequest) (*apimodel.AdminEmojiDomainAction, gtserror.WithCode) {
+	// remote emojis are stored against the punycode form of their domain
+	domain, err := idna.ToASCII(strings.ToLower(domain))
+	if err != nil {
+		err = fmt.Errorf("EmojiDomainAction: invalid domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if domain == "" || domain == config.GetHost() || domain == config.GetAccountDomain() {
+		err := errors.New("EmojiDomainAction: domain must be a remote domain")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	action := &apimodel.AdminEmojiDomainAction{
+		Domain: domain,
+		Type:   form.Type,
+	}
+
+	switch form.Type {
+	case emojiDomainActionDisable, emojiDomainActionEnable:
+		emojiIDs, err := p.db.UpdateEmojisDisabledByDomain(ctx, domain, form.Type == emojiDomainActionDisable)
+		if err != nil {
+			err = fmt.Errorf("EmojiDomainAction: error updating emojis from %s: %s", domain, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		action.Count = len(emojiIDs)
+	case emojiDomainActionRefresh:
+		emojis, err := p.db.GetEmojis(ctx, domain, true, true, "", "", "", 0)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("EmojiDomainAction: error getting emojis from %s: %s", domain, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if len(emojis) == 0 {
+			break
+		}
+
+		if _, refreshing := p.refreshingEmojiDomains.LoadOrStore(domain, struct{}{}); refreshing {
+			err := fmt.Errorf("EmojiDomainAction: emojis from %s are already being refreshed", domain)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+
+		// refresh one at a time in the background, to
+		// avoid piling requests onto the remote instance
+		go func() {
+			defer p.refreshingEmojiDomains.Delete(domain)
+
+			var refreshed int
+			for _, emoji := range emojis {
+				if err := p.refreshEmoji(context.Background(), emoji); err != nil {
+					log.Errorf("EmojiDomainAction: error refreshing emoji %s@%s: %s", emoji.Shortcode, domain, err)
+					continue
+				}
+				refreshed++
+			}
+
+			log.Infof("EmojiDomainAction: refreshed %d of %d emojis from %s", refreshed, len(emojis), domain)
+		}()
+
+		action.Count = len(emojis)
+	default:
+		err := fmt.Errorf("EmojiDomainAction: emoji domain action type %s is not supported", form.Type)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return action, nil
+}
+
+// refreshEmoji fetches the image of the given remote emoji again, replacing the stored image.
+func (p *processor) refreshEmoji(ctx context.Context, emoji *gtsmodel.Emoji) error {
+	remoteURL, err := url.Parse(emoji.ImageRemoteURL)
+	if err != nil {
+		return fmt.Errorf("error parsing image url: %s", err)
+	}
+
+	dataFunc := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
+		// use the instance account to fetch the image
+		t, err := p.transportController.NewTransportForUsername(innerCtx, "")
+		if err != nil {
+			return nil, 0, err
+		}
+		return t.DereferenceMedia(innerCtx, remoteURL)
+	}
+
+	processingEmoji, err := p.mediaManager.ProcessEmoji(ctx, dataFunc, nil, emoji.Shortcode, emoji.ID, emoji.URI, nil, true)
+	if err != nil {
+		return fmt.Errorf("error processing emoji: %s", err)
+	}
+
+	if _, err := processingEmoji.LoadEmoji(ctx); err != nil {
+		return fmt.Errorf("error loading emoji: %s", err)
+	}
+
+	return nil
+}
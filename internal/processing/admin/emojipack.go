@@ -0,0 +1,80 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/emojipack"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// EmojiPackImport imports every emoji of the given Pleroma/Akkoma-style emoji pack archive as a local emoji.
+func (p *processor) EmojiPackImport(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.EmojiPackImportRequest) ([]*apimodel.Emoji, gtserror.WithCode) {
+	if !*user.Admin {
+		return nil, gtserror.NewErrorUnauthorized(fmt.Errorf("user %s not an admin", user.ID), "user is not an admin")
+	}
+
+	f, err := form.Pack.Open()
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(fmt.Errorf("EmojiPackImport: error opening pack: %s", err))
+	}
+	defer f.Close()
+
+	emojis, err := emojipack.NewImporter(p.db, p.mediaManager).Import(ctx, f, form.Pack.Size, form.CategoryName)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	apiEmojis := make([]*apimodel.Emoji, 0, len(emojis))
+	for _, emoji := range emojis {
+		apiEmoji, err := p.tc.EmojiToAPIEmoji(ctx, emoji)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("EmojiPackImport: error converting emoji: %s", err), "error converting emoji to api representation")
+		}
+		apiEmojis = append(apiEmojis, &apiEmoji)
+	}
+
+	return apiEmojis, nil
+}
+
+// EmojiPackExport exports the enabled local emojis of this instance, optionally
+// only those in the given category, as a Pleroma/Akkoma-style emoji pack archive.
+func (p *processor) EmojiPackExport(ctx context.Context, category string) (*apimodel.Content, gtserror.WithCode) {
+	buf := new(bytes.Buffer)
+	if _, err := emojipack.NewExporter(p.db, p.storage).Export(ctx, buf, category); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err, fmt.Sprintf("emoji category %s not found", category))
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.Content{
+		ContentType:   "application/zip",
+		ContentLength: int64(buf.Len()),
+		Content:       io.NopCloser(buf),
+	}, nil
+}
@@ -0,0 +1,47 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) EmojiStaticsRegenerate(ctx context.Context) gtserror.WithCode {
+	if !p.regeneratingEmojiStatics.CompareAndSwap(false, true) {
+		err := errors.New("EmojiStaticsRegenerate: emoji statics are already being regenerated")
+		return gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	go func() {
+		defer p.regeneratingEmojiStatics.Store(false)
+
+		regenerated, err := p.mediaManager.RegenerateEmojiStatics(context.Background())
+		if err != nil {
+			log.Errorf("EmojiStaticsRegenerate: error regenerating emoji statics: %s", err)
+		} else {
+			log.Infof("EmojiStaticsRegenerate: regenerated %d emoji statics", regenerated)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) DomainBlocksExpire(ctx context.Context, now time.Time, batchSize int) (int, error) {
+	var expired int
+	for {
+		blocks, err := p.db.GetExpiredDomainBlocks(ctx, now, batchSize)
+		if err != nil && err != db.ErrNoEntries {
+			return expired, fmt.Errorf("DomainBlocksExpire: db error getting expired domain blocks: %s", err)
+		}
+
+		var lifted int
+		for _, block := range blocks {
+			if err := p.liftDomainBlock(ctx, block); err != nil {
+				log.Errorf("DomainBlocksExpire: error lifting domain block %s: %s", block.ID, err)
+				continue
+			}
+			log.Infof("DomainBlocksExpire: lifted expired block of domain %s", block.Domain)
+			lifted++
+		}
+		expired += lifted
+
+		// stop once we've run out of domain blocks, or
+		// if none of this batch could be lifted, since
+		// we'd just get the same batch again next time
+		if len(blocks) < batchSize || lifted == 0 {
+			return expired, nil
+		}
+	}
+}
@@ -0,0 +1,81 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"golang.org/x/net/idna"
+)
+
+func (p *processor) FederationErrorDomainsGet(ctx context.Context) ([]*apimodel.AdminFederationErrorDomain, gtserror.WithCode) {
+	summaries := p.transportController.FederationErrors().Domains()
+
+	apiDomains := make([]*apimodel.AdminFederationErrorDomain, 0, len(summaries))
+	for _, s := range summaries {
+		apiDomains = append(apiDomains, &apimodel.AdminFederationErrorDomain{
+			Domain:      s.Domain,
+			Count:       s.Count,
+			LatestError: federationErrorToAPI(s.Latest),
+		})
+	}
+
+	return apiDomains, nil
+}
+
+func (p *processor) FederationErrorsGet(ctx context.Context, domain string) ([]*apimodel.AdminFederationError, gtserror.WithCode) {
+	// errors are recorded against the punycode form of the domain
+	domain, err := idna.ToASCII(strings.ToLower(domain))
+	if err != nil {
+		err = fmt.Errorf("invalid domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	errs := p.transportController.FederationErrors().Get(domain)
+
+	apiErrs := make([]*apimodel.AdminFederationError, 0, len(errs))
+	for _, e := range errs {
+		apiErrs = append(apiErrs, federationErrorToAPI(e))
+	}
+
+	return apiErrs, nil
+}
+
+func federationErrorToAPI(e transport.FederationError) *apimodel.AdminFederationError {
+	direction := "outbound"
+	if e.Inbound {
+		direction = "inbound"
+	}
+
+	return &apimodel.AdminFederationError{
+		CreatedAt:  util.FormatISO8601(e.Time),
+		Domain:     e.Domain,
+		Direction:  direction,
+		Kind:       string(e.Kind),
+		URL:        e.URL,
+		StatusCode: e.StatusCode,
+		Message:    e.Message,
+	}
+}
@@ -0,0 +1,79 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) EmojiUsageGet(ctx context.Context, domain string, ascending bool, limit int) ([]*apimodel.AdminEmojiUsage, gtserror.WithCode) {
+	usage, err := p.db.GetEmojiUsage(ctx, domain, ascending, limit)
+	if err != nil {
+		err := fmt.Errorf("EmojiUsageGet: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiUsage := make([]*apimodel.AdminEmojiUsage, 0, len(usage))
+	if len(usage) == 0 {
+		return apiUsage, nil
+	}
+
+	emojiIDs := make([]string, 0, len(usage))
+	for _, u := range usage {
+		emojiIDs = append(emojiIDs, u.EmojiID)
+	}
+
+	emojis, err := p.db.GetEmojisByIDs(ctx, emojiIDs)
+	if err != nil {
+		err := fmt.Errorf("EmojiUsageGet: db error getting emojis: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	emojiMap := make(map[string]*gtsmodel.Emoji, len(emojis))
+	for _, emoji := range emojis {
+		emojiMap[emoji.ID] = emoji
+	}
+
+	for _, u := range usage {
+		emoji, ok := emojiMap[u.EmojiID]
+		if !ok {
+			// deleted in the meantime
+			continue
+		}
+
+		adminEmoji, err := p.tc.EmojiToAdminAPIEmoji(ctx, emoji)
+		if err != nil {
+			err := fmt.Errorf("EmojiUsageGet: error converting emoji to admin api emoji: %s", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiUsage = append(apiUsage, &apimodel.AdminEmojiUsage{
+			Emoji:         adminEmoji,
+			StatusesCount: u.StatusesCount,
+			AccountsCount: u.AccountsCount,
+		})
+	}
+
+	return apiUsage, nil
+}
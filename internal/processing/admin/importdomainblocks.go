@@ -26,10 +26,12 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // DomainBlocksImport handles the import of a bunch of domain blocks at once, by calling the DomainBlockCreate function for each domain in the provided file.
@@ -54,7 +56,19 @@ func (p *processor) DomainBlocksImport(ctx context.Context, account *gtsmodel.Ac
 
 	blocks := []*apimodel.DomainBlock{}
 	for _, d := range d {
-		block, err := p.DomainBlockCreate(ctx, account, d.Domain.Domain, false, d.PublicComment, "", "")
+		var expiresAt time.Time
+		if d.ExpiresAt != "" {
+			expiresAt, err = util.ParseISO8601(d.ExpiresAt)
+			if err != nil {
+				return nil, gtserror.NewErrorBadRequest(fmt.Errorf("DomainBlocksImport: could not parse expiry of domain block %s: %s", d.Domain.Domain, err))
+			}
+			if !expiresAt.After(time.Now()) {
+				// no point blocking a domain only to lift the block straight away
+				continue
+			}
+		}
+
+		block, err := p.DomainBlockCreate(ctx, account, d.Domain.Domain, d.Obfuscate, d.PublicComment, d.PrivateComment, "", expiresAt)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,140 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// parseLegalDocumentKind returns the kind of legal document named by kind.
+func parseLegalDocumentKind(kind string) (gtsmodel.LegalDocumentKind, gtserror.WithCode) {
+	switch k := gtsmodel.LegalDocumentKind(kind); k {
+	case gtsmodel.LegalDocumentTermsOfService, gtsmodel.LegalDocumentPrivacyPolicy:
+		return k, nil
+	default:
+		err := fmt.Errorf("kind must be one of %s, %s, provided value was %s", gtsmodel.LegalDocumentTermsOfService, gtsmodel.LegalDocumentPrivacyPolicy, kind)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+}
+
+func (p *processor) LegalDocumentCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.LegalDocumentCreateRequest) (*apimodel.LegalDocument, gtserror.WithCode) {
+	kind, errWithCode := parseLegalDocumentKind(form.Kind)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := validate.LegalDocument(form.Text, form.Changelog); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	documentID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating id for legal document: %s", err))
+	}
+
+	document := &gtsmodel.LegalDocument{
+		ID:                documentID,
+		Kind:              kind,
+		Text:              form.Text,
+		Content:           p.formatter.FromMarkdown(ctx, form.Text, nil, nil, nil), // html is OK in legal documents, and the formatter sanitizes it
+		Changelog:         text.SanitizePlaintext(form.Changelog),
+		RequireAcceptance: &form.RequireAcceptance,
+		AccountID:         account.ID,
+	}
+
+	// this sets the version of the document to the next one for its kind
+	if err := p.db.PutLegalDocument(ctx, document); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting legal document: %s", err))
+	}
+
+	// keep the terms shown in the instance information in step with the latest published version
+	if kind == gtsmodel.LegalDocumentTermsOfService {
+		instance := &gtsmodel.Instance{}
+		if err := p.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: config.GetHost()}}, instance); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error fetching instance: %s", err))
+		}
+
+		instance.Terms = document.Content
+		instance.TermsText = document.Text
+		if err := p.db.UpdateByID(ctx, instance, instance.ID, "terms", "terms_text"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error updating instance terms: %s", err))
+		}
+	}
+
+	apiDocument, err := p.tc.LegalDocumentToAPILegalDocument(ctx, document)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDocument, nil
+}
+
+func (p *processor) LegalDocumentsGet(ctx context.Context, kind string) ([]*apimodel.LegalDocument, gtserror.WithCode) {
+	var documentKind gtsmodel.LegalDocumentKind
+	if kind != "" {
+		var errWithCode gtserror.WithCode
+		if documentKind, errWithCode = parseLegalDocumentKind(kind); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	documents, err := p.db.GetLegalDocuments(ctx, documentKind)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting legal documents: %s", err))
+	}
+
+	apiDocuments := make([]*apimodel.LegalDocument, 0, len(documents))
+	for _, d := range documents {
+		apiDocument, err := p.tc.LegalDocumentToAPILegalDocument(ctx, d)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiDocuments = append(apiDocuments, apiDocument)
+	}
+
+	return apiDocuments, nil
+}
+
+func (p *processor) LegalDocumentGet(ctx context.Context, id string) (*apimodel.LegalDocument, gtserror.WithCode) {
+	document, err := p.db.GetLegalDocumentByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting legal document %s: %s", id, err))
+		}
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no legal document with id %s", id))
+	}
+
+	apiDocument, err := p.tc.LegalDocumentToAPILegalDocument(ctx, document)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDocument, nil
+}
@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (p *processor) EmojiCategoryUpdate(ctx context.Context, id string, form *apimodel.EmojiCategoryUpdateRequest) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	category, err := p.db.GetEmojiCategory(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("EmojiCategoryUpdate: no emoji category with id %s found in the db", id)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := fmt.Errorf("EmojiCategoryUpdate: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// make sure we're not renaming this category onto another one
+	existing, err := p.db.GetEmojiCategoryByName(ctx, form.Name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := fmt.Errorf("EmojiCategoryUpdate: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil && existing.ID != category.ID {
+		err := fmt.Errorf("emoji category with name %s already exists; to move emojis into it, delete this category with reassign_to instead", form.Name)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	category.Name = form.Name
+	updatedCategory, err := p.db.UpdateEmojiCategory(ctx, category, "updated_at", "name")
+	if err != nil {
+		err := fmt.Errorf("EmojiCategoryUpdate: db error: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiCategory, err := p.tc.EmojiCategoryToAPIEmojiCategory(ctx, updatedCategory)
+	if err != nil {
+		err := fmt.Errorf("EmojiCategoryUpdate: error converting emoji category to api emoji category: %s", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiCategory, nil
+}
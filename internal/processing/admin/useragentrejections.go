@@ -0,0 +1,42 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/useragent"
+)
+
+func (p *processor) UserAgentRejectionsGet(ctx context.Context) ([]*apimodel.AdminUserAgentRejection, gtserror.WithCode) {
+	rejections := useragent.Rejections()
+
+	apiRejections := make([]*apimodel.AdminUserAgentRejection, 0, len(rejections))
+	for _, r := range rejections {
+		apiRejections = append(apiRejections, &apimodel.AdminUserAgentRejection{
+			Kind:    r.Kind,
+			Pattern: r.Pattern,
+			Count:   r.Count,
+		})
+	}
+
+	return apiRejections, nil
+}
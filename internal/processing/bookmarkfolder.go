@@ -0,0 +1,234 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+const bookmarkFolderTitleMaxChars = 200
+
+func (p *processor) BookmarkFoldersGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.BookmarkFolder, gtserror.WithCode) {
+	folders, err := p.db.GetBookmarkFoldersForAccountID(ctx, authed.Account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFoldersGet: db error getting bookmark folders: %s", err))
+	}
+
+	apiFolders := make([]*apimodel.BookmarkFolder, 0, len(folders))
+	for _, folder := range folders {
+		apiFolder, errWithCode := p.apiBookmarkFolder(ctx, folder)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiFolders = append(apiFolders, apiFolder)
+	}
+
+	return apiFolders, nil
+}
+
+func (p *processor) BookmarkFolderGet(ctx context.Context, authed *oauth.Auth, folderID string) (*apimodel.BookmarkFolder, gtserror.WithCode) {
+	folder, errWithCode := p.getOwnBookmarkFolder(ctx, authed.Account, folderID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiBookmarkFolder(ctx, folder)
+}
+
+func (p *processor) BookmarkFolderCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.BookmarkFolderCreateUpdateRequest) (*apimodel.BookmarkFolder, gtserror.WithCode) {
+	title, errWithCode := validateBookmarkFolderTitle(form.Title)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	folderID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	folder := &gtsmodel.BookmarkFolder{
+		ID:        folderID,
+		Title:     title,
+		AccountID: authed.Account.ID,
+	}
+
+	if err := p.db.Put(ctx, folder); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFolderCreate: db error putting bookmark folder: %s", err))
+	}
+
+	return p.apiBookmarkFolder(ctx, folder)
+}
+
+func (p *processor) BookmarkFolderUpdate(ctx context.Context, authed *oauth.Auth, folderID string, form *apimodel.BookmarkFolderCreateUpdateRequest) (*apimodel.BookmarkFolder, gtserror.WithCode) {
+	folder, errWithCode := p.getOwnBookmarkFolder(ctx, authed.Account, folderID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	folder.Title, errWithCode = validateBookmarkFolderTitle(form.Title)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.UpdateBookmarkFolder(ctx, folder, "title"); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFolderUpdate: db error updating bookmark folder: %s", err))
+	}
+
+	return p.apiBookmarkFolder(ctx, folder)
+}
+
+func (p *processor) BookmarkFolderDelete(ctx context.Context, authed *oauth.Auth, folderID string) gtserror.WithCode {
+	if _, errWithCode := p.getOwnBookmarkFolder(ctx, authed.Account, folderID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteBookmarkFolderByID(ctx, folderID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFolderDelete: db error deleting bookmark folder: %s", err))
+	}
+
+	return nil
+}
+
+func (p *processor) BookmarkFolderStatusesAdd(ctx context.Context, authed *oauth.Auth, folderID string, statusIDs []string) gtserror.WithCode {
+	if _, errWithCode := p.getOwnBookmarkFolder(ctx, authed.Account, folderID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if len(statusIDs) == 0 {
+		err := errors.New("no status ids given")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	bookmarkedIDs, err := p.db.GetBookmarkedStatusIDs(ctx, authed.Account.ID, statusIDs)
+	if err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFolderStatusesAdd: db error getting bookmarks: %s", err))
+	}
+
+	bookmarked := make(map[string]bool, len(bookmarkedIDs))
+	for _, statusID := range bookmarkedIDs {
+		bookmarked[statusID] = true
+	}
+
+	for _, statusID := range statusIDs {
+		if !bookmarked[statusID] {
+			err := fmt.Errorf("you must bookmark status %s to file it in a bookmark folder", statusID)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+	}
+
+	if err := p.db.FileBookmarks(ctx, authed.Account.ID, statusIDs, folderID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFolderStatusesAdd: db error filing bookmarks: %s", err))
+	}
+
+	return nil
+}
+
+func (p *processor) BookmarkFolderStatusesRemove(ctx context.Context, authed *oauth.Auth, folderID string, statusIDs []string) gtserror.WithCode {
+	if _, errWithCode := p.getOwnBookmarkFolder(ctx, authed.Account, folderID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if len(statusIDs) == 0 {
+		err := errors.New("no status ids given")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// only take out bookmarks which are actually filed in this folder
+	bookmarks := []*gtsmodel.StatusBookmark{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: authed.Account.ID},
+		{Key: "folder_id", Value: folderID},
+	}, &bookmarks); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFolderStatusesRemove: db error getting bookmarks: %s", err))
+	}
+
+	filed := make(map[string]bool, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		filed[bookmark.StatusID] = true
+	}
+
+	unfileIDs := make([]string, 0, len(statusIDs))
+	for _, statusID := range statusIDs {
+		if filed[statusID] {
+			unfileIDs = append(unfileIDs, statusID)
+		}
+	}
+
+	if err := p.db.FileBookmarks(ctx, authed.Account.ID, unfileIDs, ""); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("BookmarkFolderStatusesRemove: db error unfiling bookmarks: %s", err))
+	}
+
+	return nil
+}
+
+// getOwnBookmarkFolder returns the bookmark folder with the given ID, if it belongs to the given account.
+func (p *processor) getOwnBookmarkFolder(ctx context.Context, account *gtsmodel.Account, folderID string) (*gtsmodel.BookmarkFolder, gtserror.WithCode) {
+	folder, err := p.db.GetBookmarkFolderByID(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("bookmark folder %s not found", folderID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting bookmark folder %s: %s", folderID, err))
+	}
+
+	// other accounts' folders are private, so pretend they don't exist
+	if folder.AccountID != account.ID {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("bookmark folder %s does not belong to account %s", folderID, account.ID))
+	}
+
+	return folder, nil
+}
+
+// apiBookmarkFolder converts the given bookmark folder into its api representation.
+func (p *processor) apiBookmarkFolder(ctx context.Context, folder *gtsmodel.BookmarkFolder) (*apimodel.BookmarkFolder, gtserror.WithCode) {
+	apiFolder, err := p.tc.BookmarkFolderToAPIBookmarkFolder(ctx, folder)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting bookmark folder %s: %s", folder.ID, err))
+	}
+
+	return apiFolder, nil
+}
+
+// validateBookmarkFolderTitle returns the given bookmark folder title with surrounding
+// whitespace removed, or an error if it's empty or too long.
+func validateBookmarkFolderTitle(title string) (string, gtserror.WithCode) {
+	title = strings.TrimSpace(title)
+
+	if title == "" {
+		err := errors.New("bookmark folder title must not be empty")
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if length := len([]rune(title)); length > bookmarkFolderTitleMaxChars {
+		err := fmt.Errorf("bookmark folder title must be at most %d characters, provided title was %d characters", bookmarkFolderTitleMaxChars, length)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return title, nil
+}
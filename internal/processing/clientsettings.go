@@ -0,0 +1,174 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+func (p *processor) ClientSettingsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.ClientSetting, gtserror.WithCode) {
+	settings, err := p.getClientSettings(ctx, authed)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Key < settings[j].Key
+	})
+
+	apiSettings := make([]*apimodel.ClientSetting, 0, len(settings))
+	for _, s := range settings {
+		apiSetting, err := p.tc.ClientSettingToAPIClientSetting(ctx, s)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("ClientSettingsGet: error converting client setting: %s", err))
+		}
+		apiSettings = append(apiSettings, apiSetting)
+	}
+
+	return apiSettings, nil
+}
+
+func (p *processor) ClientSettingGet(ctx context.Context, authed *oauth.Auth, key string) (*apimodel.ClientSetting, gtserror.WithCode) {
+	setting, errWithCode := p.getClientSetting(ctx, authed, key)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.clientSettingToAPI(ctx, setting)
+}
+
+func (p *processor) ClientSettingUpdate(ctx context.Context, authed *oauth.Auth, key string, form *apimodel.ClientSettingUpdateRequest) (*apimodel.ClientSetting, gtserror.WithCode) {
+	if err := validate.ClientSettingKey(key); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	settings, err := p.getClientSettings(ctx, authed)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// work out how much space this application would be using for
+	// the account once the setting is stored, and the existing setting
+	// with this key (if any) is replaced
+	var existing *gtsmodel.ClientSetting
+	size := len(key) + len(form.Value)
+	for _, s := range settings {
+		if s.Key == key {
+			existing = s
+			continue
+		}
+		size += len(s.Key) + len(s.Value)
+	}
+
+	if maxSize := config.GetAccountsClientSettingsMaxSize(); size > maxSize {
+		err := fmt.Errorf("client settings for this application would take up %d bytes, which exceeds the maximum of %d bytes", size, maxSize)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if existing != nil {
+		existing.Value = form.Value
+		existing.UpdatedAt = time.Now()
+		if err := p.db.UpdateByID(ctx, existing, existing.ID, "value", "updated_at"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("ClientSettingUpdate: error updating client setting: %s", err))
+		}
+		return p.clientSettingToAPI(ctx, existing)
+	}
+
+	settingID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	setting := &gtsmodel.ClientSetting{
+		ID:            settingID,
+		AccountID:     authed.Account.ID,
+		ApplicationID: authed.Application.ID,
+		Key:           key,
+		Value:         form.Value,
+	}
+
+	if err := p.db.Put(ctx, setting); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ClientSettingUpdate: error storing client setting: %s", err))
+	}
+
+	return p.clientSettingToAPI(ctx, setting)
+}
+
+func (p *processor) ClientSettingDelete(ctx context.Context, authed *oauth.Auth, key string) (*apimodel.ClientSetting, gtserror.WithCode) {
+	setting, errWithCode := p.getClientSetting(ctx, authed, key)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.DeleteByID(ctx, setting.ID, setting); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ClientSettingDelete: error deleting client setting: %s", err))
+	}
+
+	return p.clientSettingToAPI(ctx, setting)
+}
+
+// getClientSettings returns all settings stored by the authed application for the authed account.
+func (p *processor) getClientSettings(ctx context.Context, authed *oauth.Auth) ([]*gtsmodel.ClientSetting, error) {
+	settings := []*gtsmodel.ClientSetting{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: authed.Account.ID},
+		{Key: "application_id", Value: authed.Application.ID},
+	}, &settings); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("error getting client settings: %s", err)
+	}
+	return settings, nil
+}
+
+// getClientSetting returns the setting with the given key stored by the authed application for the authed account.
+func (p *processor) getClientSetting(ctx context.Context, authed *oauth.Auth, key string) (*gtsmodel.ClientSetting, gtserror.WithCode) {
+	setting := &gtsmodel.ClientSetting{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: authed.Account.ID},
+		{Key: "application_id", Value: authed.Application.ID},
+		{Key: "key", Value: key},
+	}, setting); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("client setting '%s' not found", key)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting client setting: %s", err))
+	}
+	return setting, nil
+}
+
+func (p *processor) clientSettingToAPI(ctx context.Context, setting *gtsmodel.ClientSetting) (*apimodel.ClientSetting, gtserror.WithCode) {
+	apiSetting, err := p.tc.ClientSettingToAPIClientSetting(ctx, setting)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting client setting: %s", err))
+	}
+	return apiSetting, nil
+}
@@ -0,0 +1,79 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type DomainBlockTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *DomainBlockTestSuite) TestAdminDomainBlocksExpire() {
+	ctx := context.Background()
+	remoteAccount := suite.testAccounts["remote_account_1"]
+
+	// an expired block of the remote account's domain, which suspended the account
+	expired := &gtsmodel.DomainBlock{
+		ID:                 "01GKN2J6Y4B2X5Y7ZJ7KQ3F1VD",
+		Domain:             remoteAccount.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		ExpiresAt:          time.Now().Add(-time.Minute),
+	}
+	suite.NoError(suite.db.CreateDomainBlock(ctx, expired))
+
+	remoteAccount.SuspendedAt = time.Now().Add(-time.Hour)
+	remoteAccount.SuspensionOrigin = expired.ID
+	suite.NoError(suite.db.UpdateByID(ctx, remoteAccount, remoteAccount.ID, "suspended_at", "suspension_origin"))
+
+	// a block that doesn't expire, which should be left alone
+	permanent := &gtsmodel.DomainBlock{
+		ID:                 "01GKN2JDX0N0Q8H9T3W8C4S2MA",
+		Domain:             "replyguys.example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	suite.NoError(suite.db.CreateDomainBlock(ctx, permanent))
+
+	lifted, err := suite.processor.AdminDomainBlocksExpire(ctx, time.Now(), 10)
+	suite.NoError(err)
+	suite.Equal(1, lifted)
+
+	_, err = suite.db.GetDomainBlock(ctx, expired.Domain)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetDomainBlock(ctx, permanent.Domain)
+	suite.NoError(err)
+
+	// the account should no longer be suspended
+	dbAccount := &gtsmodel.Account{}
+	suite.NoError(suite.db.GetByID(ctx, remoteAccount.ID, dbAccount))
+	suite.True(dbAccount.SuspendedAt.IsZero())
+	suite.Empty(dbAccount.SuspensionOrigin)
+}
+
+func TestDomainBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainBlockTestSuite))
+}
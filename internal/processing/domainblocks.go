@@ -0,0 +1,76 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) DomainBlocksGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.DomainBlocksResponse, gtserror.WithCode) {
+	blocks, err := p.db.GetAccountDomainBlocks(ctx, authed.Account.ID, maxID, sinceID, limit)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			// there are just no entries
+			return &apimodel.DomainBlocksResponse{
+				Domains: []string{},
+			}, nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	resp := &apimodel.DomainBlocksResponse{
+		Domains: make([]string, 0, len(blocks)),
+	}
+	for _, b := range blocks {
+		resp.Domains = append(resp.Domains, b.Domain)
+	}
+
+	// prepare the next and previous links
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	path := "/api/v1/domain_blocks"
+
+	nextLink := &url.URL{
+		Scheme:   protocol,
+		Host:     host,
+		Path:     path,
+		RawQuery: fmt.Sprintf("limit=%d&max_id=%s", limit, blocks[len(blocks)-1].ID),
+	}
+	next := fmt.Sprintf("<%s>; rel=\"next\"", nextLink.String())
+
+	prevLink := &url.URL{
+		Scheme:   protocol,
+		Host:     host,
+		Path:     path,
+		RawQuery: fmt.Sprintf("limit=%d&since_id=%s", limit, blocks[0].ID),
+	}
+	prev := fmt.Sprintf("<%s>; rel=\"prev\"", prevLink.String())
+	resp.LinkHeader = fmt.Sprintf("%s, %s", next, prev)
+
+	return resp, nil
+}
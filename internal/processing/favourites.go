@@ -0,0 +1,139 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) FavouritesExport(ctx context.Context, authed *oauth.Auth) (*apimodel.Content, gtserror.WithCode) {
+	statuses, _, _, err := p.db.GetFavedTimeline(ctx, authed.Account.ID, "", "", 0)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FavouritesExport: error getting faved statuses: %s", err))
+	}
+
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	for _, s := range statuses {
+		if err := w.Write([]string{s.URI}); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("FavouritesExport: error writing status %s: %s", s.ID, err))
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FavouritesExport: error flushing csv: %s", err))
+	}
+
+	return &apimodel.Content{
+		ContentType:   "text/csv",
+		ContentLength: int64(buf.Len()),
+		Content:       io.NopCloser(buf),
+	}, nil
+}
+
+func (p *processor) FavouritesImport(ctx context.Context, authed *oauth.Auth, form *apimodel.FavouritesImportRequest) (*apimodel.FavouritesImportResult, gtserror.WithCode) {
+	f, err := form.Data.Open()
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(fmt.Errorf("FavouritesImport: error opening file: %s", err))
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	result := &apimodel.FavouritesImportResult{}
+	for {
+		record, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, gtserror.NewErrorBadRequest(err, fmt.Sprintf("error parsing csv: %s", err))
+		}
+
+		// only the first column is used; anything that
+		// isn't a url (such as a header row) is ignored
+		uri, err := url.Parse(strings.TrimSpace(record[0]))
+		if err != nil || (uri.Scheme != "https" && uri.Scheme != "http") {
+			continue
+		}
+
+		status, err := p.favouritesImportStatus(ctx, authed, uri, form.Resolve)
+		if err != nil {
+			log.Debugf("FavouritesImport: skipping %s: %s", uri, err)
+			result.Skipped++
+			continue
+		}
+
+		// let the status processor decide whether the
+		// status can be faved, and handle side effects
+		if _, errWithCode := p.statusProcessor.Fave(ctx, authed.Account, status.ID); errWithCode != nil {
+			log.Debugf("FavouritesImport: skipping %s: %s", uri, errWithCode)
+			result.Skipped++
+			continue
+		}
+
+		result.Imported++
+	}
+
+	return result, nil
+}
+
+// favouritesImportStatus looks up the status with the given uri or url in the
+// database, dereferencing it from its origin instance if resolve is true.
+func (p *processor) favouritesImportStatus(ctx context.Context, authed *oauth.Auth, uri *url.URL, resolve bool) (*gtsmodel.Status, error) {
+	uriStr := uri.String()
+
+	status, err := p.db.GetStatusByURI(ctx, uriStr)
+	if err == nil {
+		return status, nil
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	status, err = p.db.GetStatusByURL(ctx, uriStr)
+	if err == nil {
+		return status, nil
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	if !resolve {
+		return nil, errors.New("status not found")
+	}
+
+	status, _, err = p.federator.GetRemoteStatus(ctx, authed.Account.Username, uri, false, false)
+	return status, err
+}
@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FavouritesTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *FavouritesTestSuite) importForm(csv string) *apimodel.FavouritesImportRequest {
+	path := fmt.Sprintf("%s/favourites.csv", suite.T().TempDir())
+	if err := os.WriteFile(path, []byte(csv), 0o600); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	b, w, err := testrig.CreateMultipartFormData("data", path, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form, err := multipart.NewReader(&b, w.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return &apimodel.FavouritesImportRequest{Data: form.File["data"][0]}
+}
+
+func (suite *FavouritesTestSuite) TestFavouritesExport() {
+	content, errWithCode := suite.processor.FavouritesExport(context.Background(), suite.testAutheds["local_account_1"])
+	suite.NoError(errWithCode)
+	suite.Equal("text/csv", content.ContentType)
+
+	b, err := io.ReadAll(content.Content)
+	suite.NoError(err)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].URI+"\n", string(b))
+	suite.EqualValues(len(b), content.ContentLength)
+}
+
+func (suite *FavouritesTestSuite) TestFavouritesImport() {
+	ctx := context.Background()
+	authed := suite.testAutheds["local_account_2"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	// a header row should be ignored, statuses can be given by
+	// url, and unknown statuses should be skipped without resolve
+	csv := "uri\n" +
+		targetStatus.URL + "\n" +
+		"https://unknown-instance.com/users/someone/statuses/01GJRGVH2MBKVSF7N0VYEB5SY5\n"
+
+	result, errWithCode := suite.processor.FavouritesImport(ctx, authed, suite.importForm(csv))
+	suite.NoError(errWithCode)
+	suite.Equal(1, result.Imported)
+	suite.Equal(1, result.Skipped)
+
+	fave := &gtsmodel.StatusFave{}
+	err := suite.db.GetWhere(ctx, []db.Where{{Key: "status_id", Value: targetStatus.ID}, {Key: "account_id", Value: authed.Account.ID}}, fave)
+	suite.NoError(err)
+}
+
+func TestFavouritesTestSuite(t *testing.T) {
+	suite.Run(t, &FavouritesTestSuite{})
+}
@@ -39,8 +39,18 @@ func (p *processor) GetFediFollowing(ctx context.Context, requestedUsername stri
 	return p.federationProcessor.GetFollowing(ctx, requestedUsername, requestURL)
 }
 
+func (p *processor) GetFediFeaturedTags(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode) {
+	return p.federationProcessor.GetFeaturedTags(ctx, requestedUsername, requestURL)
+}
+
 func (p *processor) GetFediStatus(ctx context.Context, requestedUsername string, requestedStatusID string, requestURL *url.URL) (interface{}, gtserror.WithCode) {
-	return p.federationProcessor.GetStatus(ctx, requestedUsername, requestedStatusID, requestURL)
+	data, errWithCode := p.federationProcessor.GetStatus(ctx, requestedUsername, requestedStatusID, requestURL)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.statusProcessor.ViewCountIncrement(ctx, requestedStatusID, true)
+	return data, nil
 }
 
 func (p *processor) GetFediStatusReplies(ctx context.Context, requestedUsername string, requestedStatusID string, page bool, onlyOtherAccounts bool, minID string, requestURL *url.URL) (interface{}, gtserror.WithCode) {
@@ -45,6 +45,10 @@ type Processor interface {
 	// authentication before returning a JSON serializable interface to the caller.
 	GetFollowing(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
 
+	// GetFeaturedTags handles the getting of a fedi/activitypub representation of the hashtags featured by a user/account,
+	// performing appropriate authentication before returning a JSON serializable interface to the caller.
+	GetFeaturedTags(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
+
 	// GetStatus handles the getting of a fedi/activitypub representation of a particular status, performing appropriate
 	// authentication before returning a JSON serializable interface to the caller.
 	GetStatus(ctx context.Context, requestedUsername string, requestedStatusID string, requestURL *url.URL) (interface{}, gtserror.WithCode)
@@ -79,22 +83,47 @@ type Processor interface {
 	// If the Actor was constructed with the Federated Protocol enabled, side effects will occur.
 	//
 	// If the Federated Protocol is not enabled, writes the http.StatusMethodNotAllowed status code in the response. No side effects occur.
+	//
+	// If the inbox queue is turned on, the activity is queued to be processed later,
+	// and http.StatusAccepted is written in the response.
 	PostInbox(ctx context.Context, w http.ResponseWriter, r *http.Request) (bool, error)
+
+	// Start starts processing the inbox queue, if it's turned on.
+	Start() error
+
+	// Stop stops processing the inbox queue, if it's turned on. Activities not processed yet are kept for the next Start.
+	Stop() error
 }
 
 type processor struct {
-	db        db.DB
-	federator federation.Federator
-	tc        typeutils.TypeConverter
-	filter    visibility.Filter
+	db         db.DB
+	federator  federation.Federator
+	tc         typeutils.TypeConverter
+	filter     visibility.Filter
+	inboxQueue *inboxQueue
 }
 
 // New returns a new federation processor.
 func New(db db.DB, tc typeutils.TypeConverter, federator federation.Federator) Processor {
 	return &processor{
-		db:        db,
-		federator: federator,
-		tc:        tc,
-		filter:    visibility.NewFilter(db),
+		db:         db,
+		federator:  federator,
+		tc:         tc,
+		filter:     visibility.NewFilter(db),
+		inboxQueue: newInboxQueue(db, federator),
+	}
+}
+
+func (p *processor) Start() error {
+	if p.inboxQueue == nil {
+		return nil
+	}
+	return p.inboxQueue.Start()
+}
+
+func (p *processor) Stop() error {
+	if p.inboxQueue == nil {
+		return nil
 	}
+	return p.inboxQueue.Stop()
 }
@@ -24,12 +24,18 @@ import (
 	"net/url"
 
 	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 func (p *processor) GetOutbox(ctx context.Context, requestedUsername string, page bool, maxID string, minID string, requestURL *url.URL) (interface{}, gtserror.WithCode) {
+	if !config.GetInstanceExposeOutboxes() {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("outboxes are not exposed on this instance"))
+	}
+
 	// get the account the request is referring to
 	requestedAccount, err := p.db.GetAccountByUsernameDomain(ctx, requestedUsername, "")
 	if err != nil {
@@ -92,12 +98,40 @@ func (p *processor) GetOutbox(ctx context.Context, requestedUsername string, pag
 
 	// scenario 2 -- get the requested page
 	// limit pages to 30 entries per page
-	publicStatuses, err := p.db.GetAccountStatuses(ctx, requestedAccount.ID, 30, true, true, maxID, minID, false, false, true)
+	statuses, err := p.db.GetAccountStatuses(ctx, requestedAccount.ID, 30, true, true, maxID, minID, false, false, true)
 	if err != nil && err != db.ErrNoEntries {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	outboxPage, err := p.tc.StatusesToASOutboxPage(ctx, requestedAccount.OutboxURI, maxID, minID, publicStatuses)
+	// only serve statuses that are meant to be federated,
+	// and that the requesting account is allowed to see,
+	// but page on from all of them, so that a page whose
+	// statuses are all left out doesn't end the outbox
+	var lowestID, highestID string
+	publicStatuses := make([]*gtsmodel.Status, 0, len(statuses))
+	for _, s := range statuses {
+		if lowestID == "" || s.ID < lowestID {
+			lowestID = s.ID
+		}
+		if highestID == "" || s.ID > highestID {
+			highestID = s.ID
+		}
+
+		if s.Federated != nil && !*s.Federated {
+			continue
+		}
+
+		visible, err := p.filter.StatusVisible(ctx, s, requestingAccount)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if visible {
+			publicStatuses = append(publicStatuses, s)
+		}
+	}
+
+	outboxPage, err := p.tc.StatusesToASOutboxPage(ctx, requestedAccount.OutboxURI, maxID, minID, publicStatuses, lowestID, highestID)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -0,0 +1,337 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-fed/httpsig"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// inboxQueue is a bounded queue of incoming federated activities, which
+// are stored in the database and processed by a fixed number of workers.
+//
+// This means that a spike in incoming activities (for example, when a post
+// goes viral) costs one quick insert per activity rather than the whole of
+// its processing, which in turn can't use up every database connection.
+type inboxQueue struct {
+	db        db.DB
+	federator federation.Federator
+	ids       chan string // ids of queued items waiting for a worker
+	size      int         // no. queued items beyond which we reject new ones
+	shedSize  int         // no. queued items beyond which we drop low-priority ones
+	workers   int
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	failed    atomic.Uint64 // no. queued items dropped because they couldn't be processed
+}
+
+// newInboxQueue returns a new inbox queue using the configured
+// sizes, or nil if the inbox queue is turned off.
+func newInboxQueue(db db.DB, federator federation.Federator) *inboxQueue {
+	size := config.GetAdvancedInboxQueueSize()
+	if size <= 0 {
+		return nil
+	}
+
+	return &inboxQueue{
+		db:        db,
+		federator: federator,
+		ids:       make(chan string, size),
+		size:      size,
+		shedSize:  config.GetAdvancedInboxQueueShedSize(),
+		// the db allows 4 connections per
+		// CPU, so leave plenty for everyone else
+		workers: runtime.GOMAXPROCS(0),
+	}
+}
+
+// Start requeues any items left over from the last time the queue
+// was stopped, then starts workers to process queued items.
+func (q *inboxQueue) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+
+	ids, err := q.db.GetInboxItemIDs(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("error getting queued inbox items: %w", err)
+	}
+
+	for i, itemID := range ids {
+		select {
+		case q.ids <- itemID:
+			continue
+		default:
+		}
+
+		// the queue must have been made smaller
+		// since these were stored, so drop the rest
+		log.Warnf("inbox queue is full, dropping %d leftover activities", len(ids)-i)
+		for _, itemID := range ids[i:] {
+			if err := q.db.DeleteInboxItem(ctx, itemID); err != nil {
+				log.Errorf("error deleting inbox item %s: %s", itemID, err)
+			}
+		}
+		break
+	}
+
+	if len(ids) != 0 {
+		log.Infof("requeued %d leftover incoming activities", len(q.ids))
+	}
+
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go q.work(ctx)
+	}
+
+	return nil
+}
+
+// Stop stops the workers, waiting for any items currently being
+// processed. Items still queued stay in the database for next Start.
+func (q *inboxQueue) Stop() error {
+	q.cancel()
+	q.wg.Wait()
+	return nil
+}
+
+// Enqueue stores the given inbox request in the queue, to be processed
+// later, or returns an error explaining why it wasn't queued. Note that if
+// the queue is overloaded, low-priority activities are quietly dropped.
+func (q *inboxQueue) Enqueue(ctx context.Context, r *http.Request) gtserror.WithCode {
+	// An unsigned request can never be authenticated,
+	// so there's no point queueing it: reject it now.
+	if ctx.Value(ap.ContextRequestingPublicKeyVerifier) == nil {
+		err := errors.New("http request wasn't signed or http signature was invalid")
+		return gtserror.NewErrorUnauthorized(err, err.Error())
+	}
+
+	queued := len(q.ids)
+	if queued >= q.size {
+		err := fmt.Errorf("inbox queue is full with %d activities", queued)
+		return gtserror.NewErrorTooManyRequests(err, "too many incoming activities, try again later")
+	}
+
+	// Check the signature against the sender's key before storing anything,
+	// so that only activities we'd accept take up room in the queue, and a
+	// sender that fails authentication is told so rather than given a 202.
+	authenticated, errWithCode := q.authenticate(ctx, r)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if !authenticated {
+		// the sender has gone, so
+		// there's nothing to process
+		return nil
+	}
+
+	// the size of the body has already been
+	// checked against the configured limit
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		err := fmt.Errorf("error reading request body: %w", err)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// peek at the activity type; the rest can wait
+	activity := struct {
+		Type json.RawMessage `json:"type"`
+	}{}
+	if err := json.Unmarshal(body, &activity); err != nil {
+		err := fmt.Errorf("error parsing request body: %w", err)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if q.shedSize > 0 && queued >= q.shedSize && isLowPriorityActivity(activity.Type) {
+		log.Debugf("inbox queue is overloaded with %d activities, dropping %s", queued, activity.Type)
+		return nil
+	}
+
+	itemID, err := id.NewULID()
+	if err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	item := &gtsmodel.InboxItem{
+		ID:       itemID,
+		InboxURI: config.GetProtocol() + "://" + r.Host + r.URL.RequestURI(),
+		Header:   r.Header.Clone(),
+		Body:     body,
+	}
+
+	if err := q.db.PutInboxItem(ctx, item); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("error putting inbox item: %w", err))
+	}
+
+	select {
+	case q.ids <- item.ID:
+		return nil
+	default:
+	}
+
+	// the queue filled up since we last checked
+	if err := q.db.DeleteInboxItem(ctx, item.ID); err != nil {
+		log.Errorf("error deleting inbox item %s: %s", item.ID, err)
+	}
+
+	err = fmt.Errorf("inbox queue is full with %d activities", q.size)
+	return gtserror.NewErrorTooManyRequests(err, "too many incoming activities, try again later")
+}
+
+// authenticate authenticates the given inbox request the same way it will be
+// authenticated again when processed. If it doesn't pass, the returned error
+// has the status code the request should be answered with, except when the
+// sender has gone, in which case false and no error are returned.
+func (q *inboxQueue) authenticate(ctx context.Context, r *http.Request) (bool, gtserror.WithCode) {
+	w := &inboxResponseWriter{header: make(http.Header)}
+	_, authenticated, err := q.federator.AuthenticatePostInbox(ctx, w, r)
+	if err != nil {
+		return false, gtserror.NewErrorInternalError(fmt.Errorf("error authenticating request: %w", err))
+	}
+
+	if authenticated {
+		return true, nil
+	}
+
+	err = fmt.Errorf("request failed authentication with status %d", w.status)
+	switch w.status {
+	case http.StatusAccepted:
+		return false, nil
+	case http.StatusBadRequest:
+		return false, gtserror.NewErrorBadRequest(err, "http signature could not be verified")
+	case http.StatusForbidden:
+		return false, gtserror.NewErrorForbidden(err, "requester is not permitted to post to this inbox")
+	default:
+		return false, gtserror.NewErrorUnauthorized(err, "http signature could not be verified")
+	}
+}
+
+// isLowPriorityActivity returns whether the given JSON activity
+// type is one that we can afford to drop when overloaded.
+func isLowPriorityActivity(rawType json.RawMessage) bool {
+	var activityType string
+	if err := json.Unmarshal(rawType, &activityType); err != nil {
+		// probably an array of types,
+		// so err on the side of caution
+		return false
+	}
+	return activityType == ap.ActivityLike || activityType == ap.ActivityAnnounce
+}
+
+func (q *inboxQueue) work(ctx context.Context) {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case itemID := <-q.ids:
+			q.process(ctx, itemID)
+		}
+	}
+}
+
+func (q *inboxQueue) process(ctx context.Context, itemID string) {
+	item, err := q.db.GetInboxItem(ctx, itemID)
+	if err != nil {
+		log.Errorf("error getting inbox item %s: %s", itemID, err)
+		return
+	}
+
+	if err := q.postInbox(ctx, item); err != nil {
+		if ctx.Err() != nil {
+			// we're stopping, so leave the
+			// item to be requeued next start
+			return
+		}
+		failed := q.failed.Add(1)
+		log.Warnf("dropping queued activity for %s (%d dropped since start) after error processing it: %s", item.InboxURI, failed, err)
+	}
+
+	if err := q.db.DeleteInboxItem(ctx, item.ID); err != nil {
+		log.Errorf("error deleting inbox item %s: %s", item.ID, err)
+	}
+}
+
+// postInbox processes the queued item as though it was an inbox request that had only just arrived.
+func (q *inboxQueue) postInbox(ctx context.Context, item *gtsmodel.InboxItem) error {
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, item.InboxURI, bytes.NewReader(item.Body))
+	if err != nil {
+		return fmt.Errorf("error recreating request: %w", err)
+	}
+	r.Header = item.Header
+
+	// set what the signature check middleware would
+	// have put on the context for a brand new request
+	verifier, err := httpsig.NewVerifier(r)
+	if err != nil {
+		return fmt.Errorf("error recreating signature verifier: %w", err)
+	}
+	ctx = context.WithValue(ctx, ap.ContextRequestingPublicKeyVerifier, verifier)
+	ctx = context.WithValue(ctx, ap.ContextRequestingPublicKeySignature, r.Header.Get("Signature"))
+
+	w := &inboxResponseWriter{header: make(http.Header)}
+	if _, err := q.federator.FederatingActor().PostInbox(ctx, w, r); err != nil {
+		return err
+	}
+
+	if w.status >= http.StatusBadRequest {
+		return fmt.Errorf("activity was rejected with status %d", w.status)
+	}
+
+	return nil
+}
+
+// inboxResponseWriter records the status of a queued inbox request
+// being processed, since there's no longer anyone waiting for a response.
+type inboxResponseWriter struct {
+	header http.Header
+	status int
+}
+
+func (w *inboxResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *inboxResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *inboxResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
@@ -24,5 +24,15 @@ import (
 )
 
 func (p *processor) PostInbox(ctx context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
-	return p.federator.FederatingActor().PostInbox(ctx, w, r)
+	if p.inboxQueue == nil {
+		// no queue, so process the activity right now
+		return p.federator.FederatingActor().PostInbox(ctx, w, r)
+	}
+
+	if errWithCode := p.inboxQueue.Enqueue(ctx, r); errWithCode != nil {
+		return false, errWithCode
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return true, nil
 }
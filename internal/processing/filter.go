@@ -0,0 +1,323 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+const (
+	filterTitleMaxChars   = 200
+	filterKeywordMaxChars = 200
+)
+
+func (p *processor) FiltersV2Get(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FilterV2, gtserror.WithCode) {
+	filters, err := p.db.GetFiltersForAccountID(ctx, authed.Account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FiltersV2Get: db error getting filters: %s", err))
+	}
+
+	apiFilters := make([]*apimodel.FilterV2, 0, len(filters))
+	for _, filter := range filters {
+		apiFilter, errWithCode := p.apiFilter(ctx, filter)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiFilters = append(apiFilters, apiFilter)
+	}
+
+	return apiFilters, nil
+}
+
+func (p *processor) FilterV2Get(ctx context.Context, authed *oauth.Auth, filterID string) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter, errWithCode := p.getOwnFilter(ctx, authed.Account, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilter(ctx, filter)
+}
+
+func (p *processor) FilterV2Create(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	filterID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filter := &gtsmodel.Filter{
+		ID:        filterID,
+		AccountID: authed.Account.ID,
+		Action:    gtsmodel.FilterActionWarn,
+		Keywords:  []*gtsmodel.FilterKeyword{},
+		Statuses:  []*gtsmodel.FilterStatus{},
+	}
+
+	if form.Title == nil {
+		err := errors.New("filter title must be given")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(form.Context) == 0 {
+		err := errors.New("at least one filter context must be given")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if _, errWithCode := applyFilterForm(filter, form); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	for _, attributes := range form.KeywordsAttributes {
+		if attributes.ID != "" || attributes.Destroy {
+			err := errors.New("existing keywords can't be changed when creating a filter")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		keyword, errWithCode := newFilterKeyword(filter, attributes.Keyword, attributes.WholeWord)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		filter.Keywords = append(filter.Keywords, keyword)
+	}
+
+	if err := p.db.PutFilter(ctx, filter); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterV2Create: db error putting filter: %s", err))
+	}
+
+	return p.apiFilter(ctx, filter)
+}
+
+func (p *processor) FilterV2Update(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterCreateUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	filter, errWithCode := p.getOwnFilter(ctx, authed.Account, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns, errWithCode := applyFilterForm(filter, form)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if len(columns) == 0 && len(form.KeywordsAttributes) == 0 {
+		err := errors.New("nothing to update")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// validate all keyword changes before making any of them
+	var (
+		createKeywords   []*gtsmodel.FilterKeyword
+		updateKeywords   []*gtsmodel.FilterKeyword
+		updateColumns    [][]string
+		deleteKeywordIDs []string
+	)
+	for _, attributes := range form.KeywordsAttributes {
+		if attributes.ID == "" {
+			keyword, errWithCode := newFilterKeyword(filter, attributes.Keyword, attributes.WholeWord)
+			if errWithCode != nil {
+				return nil, errWithCode
+			}
+			createKeywords = append(createKeywords, keyword)
+			continue
+		}
+
+		var keyword *gtsmodel.FilterKeyword
+		for _, k := range filter.Keywords {
+			if k.ID == attributes.ID {
+				keyword = k
+				break
+			}
+		}
+		if keyword == nil {
+			err := fmt.Errorf("keyword %s is not part of filter %s", attributes.ID, filter.ID)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		if attributes.Destroy {
+			deleteKeywordIDs = append(deleteKeywordIDs, keyword.ID)
+			continue
+		}
+
+		keywordColumns, errWithCode := applyFilterKeywordChanges(keyword, attributes.Keyword, attributes.WholeWord)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		if len(keywordColumns) != 0 {
+			updateKeywords = append(updateKeywords, keyword)
+			updateColumns = append(updateColumns, keywordColumns)
+		}
+	}
+
+	if len(columns) != 0 {
+		if err := p.db.UpdateFilter(ctx, filter, columns...); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterV2Update: db error updating filter: %s", err))
+		}
+	}
+
+	for _, keyword := range createKeywords {
+		if err := p.db.PutFilterKeyword(ctx, keyword); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterV2Update: db error putting filter keyword: %s", err))
+		}
+	}
+
+	for i, keyword := range updateKeywords {
+		if err := p.db.UpdateFilterKeyword(ctx, keyword, updateColumns[i]...); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterV2Update: db error updating filter keyword %s: %s", keyword.ID, err))
+		}
+	}
+
+	for _, keywordID := range deleteKeywordIDs {
+		if err := p.db.DeleteFilterKeywordByID(ctx, keywordID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterV2Update: db error deleting filter keyword %s: %s", keywordID, err))
+		}
+	}
+
+	// reload the filter so that its keywords are up to date
+	return p.FilterV2Get(ctx, authed, filterID)
+}
+
+func (p *processor) FilterV2Delete(ctx context.Context, authed *oauth.Auth, filterID string) gtserror.WithCode {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	if _, errWithCode := p.getOwnFilter(ctx, authed.Account, filterID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteFilterByID(ctx, filterID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("FilterV2Delete: db error deleting filter: %s", err))
+	}
+
+	return nil
+}
+
+// getOwnFilter returns the filter with the given ID, if it belongs to the given account.
+func (p *processor) getOwnFilter(ctx context.Context, account *gtsmodel.Account, filterID string) (*gtsmodel.Filter, gtserror.WithCode) {
+	filter, err := p.db.GetFilterByID(ctx, filterID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("filter %s not found", filterID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting filter %s: %s", filterID, err))
+	}
+
+	// other accounts' filters are private, so pretend they don't exist
+	if filter.AccountID != account.ID {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("filter %s does not belong to account %s", filterID, account.ID))
+	}
+
+	return filter, nil
+}
+
+// apiFilter converts the given filter into its api representation.
+func (p *processor) apiFilter(ctx context.Context, filter *gtsmodel.Filter) (*apimodel.FilterV2, gtserror.WithCode) {
+	apiFilter, err := p.tc.FilterToAPIFilterV2(ctx, filter)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter %s: %s", filter.ID, err))
+	}
+
+	return apiFilter, nil
+}
+
+// applyFilterForm validates the given form and applies it to the given filter, leaving
+// its keywords alone. The names of the columns of the filter which were changed are returned.
+func applyFilterForm(filter *gtsmodel.Filter, form *apimodel.FilterCreateUpdateRequestV2) ([]string, gtserror.WithCode) {
+	columns := []string{}
+
+	if form.Title != nil {
+		title := strings.TrimSpace(*form.Title)
+		if title == "" {
+			err := errors.New("filter title must not be empty")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		if length := len([]rune(title)); length > filterTitleMaxChars {
+			err := fmt.Errorf("filter title must be at most %d characters, provided title was %d characters", filterTitleMaxChars, length)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		filter.Title = title
+		columns = append(columns, "title")
+	}
+
+	if form.FilterAction != nil {
+		switch action := gtsmodel.FilterAction(*form.FilterAction); action {
+		case gtsmodel.FilterActionWarn, gtsmodel.FilterActionHide:
+			filter.Action = action
+		default:
+			err := fmt.Errorf("filter action must be one of %s or %s", gtsmodel.FilterActionWarn, gtsmodel.FilterActionHide)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		columns = append(columns, "action")
+	}
+
+	if form.ExpiresIn != nil {
+		switch expiresIn := *form.ExpiresIn; {
+		case expiresIn < 0:
+			err := errors.New("filter expires_in must not be negative")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		case expiresIn == 0:
+			filter.ExpiresAt = time.Time{}
+		default:
+			filter.ExpiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+		}
+		columns = append(columns, "expires_at")
+	}
+
+	if len(form.Context) != 0 {
+		contexts := map[gtsmodel.FilterContext]bool{}
+		for _, c := range form.Context {
+			switch filterContext := gtsmodel.FilterContext(c); filterContext {
+			case gtsmodel.FilterContextHome,
+				gtsmodel.FilterContextNotifications,
+				gtsmodel.FilterContextPublic,
+				gtsmodel.FilterContextThread,
+				gtsmodel.FilterContextAccount:
+				contexts[filterContext] = true
+			default:
+				err := fmt.Errorf("filter context %q was not recognized", c)
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+		}
+
+		home := contexts[gtsmodel.FilterContextHome]
+		notifications := contexts[gtsmodel.FilterContextNotifications]
+		public := contexts[gtsmodel.FilterContextPublic]
+		thread := contexts[gtsmodel.FilterContextThread]
+		account := contexts[gtsmodel.FilterContextAccount]
+		filter.ContextHome = &home
+		filter.ContextNotifications = &notifications
+		filter.ContextPublic = &public
+		filter.ContextThread = &thread
+		filter.ContextAccount = &account
+		columns = append(columns, "context_home", "context_notifications", "context_public", "context_thread", "context_account")
+	}
+
+	return columns, nil
+}
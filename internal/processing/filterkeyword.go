@@ -0,0 +1,200 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) FilterKeywordsGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode) {
+	filter, errWithCode := p.getOwnFilter(ctx, authed.Account, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiKeywords := make([]*apimodel.FilterKeyword, 0, len(filter.Keywords))
+	for _, keyword := range filter.Keywords {
+		apiKeyword, errWithCode := p.apiFilterKeyword(ctx, keyword)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiKeywords = append(apiKeywords, apiKeyword)
+	}
+
+	return apiKeywords, nil
+}
+
+func (p *processor) FilterKeywordGet(ctx context.Context, authed *oauth.Auth, filterKeywordID string) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	keyword, errWithCode := p.getOwnFilterKeyword(ctx, authed.Account, filterKeywordID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterKeyword(ctx, keyword)
+}
+
+func (p *processor) FilterKeywordCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	filter, errWithCode := p.getOwnFilter(ctx, authed.Account, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	keyword, errWithCode := newFilterKeyword(filter, form.Keyword, form.WholeWord)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.PutFilterKeyword(ctx, keyword); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterKeywordCreate: db error putting filter keyword: %s", err))
+	}
+
+	return p.apiFilterKeyword(ctx, keyword)
+}
+
+func (p *processor) FilterKeywordUpdate(ctx context.Context, authed *oauth.Auth, filterKeywordID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	keyword, errWithCode := p.getOwnFilterKeyword(ctx, authed.Account, filterKeywordID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns, errWithCode := applyFilterKeywordChanges(keyword, form.Keyword, form.WholeWord)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if len(columns) == 0 {
+		err := errors.New("nothing to update")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := p.db.UpdateFilterKeyword(ctx, keyword, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterKeywordUpdate: db error updating filter keyword: %s", err))
+	}
+
+	return p.apiFilterKeyword(ctx, keyword)
+}
+
+func (p *processor) FilterKeywordDelete(ctx context.Context, authed *oauth.Auth, filterKeywordID string) gtserror.WithCode {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	if _, errWithCode := p.getOwnFilterKeyword(ctx, authed.Account, filterKeywordID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteFilterKeywordByID(ctx, filterKeywordID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("FilterKeywordDelete: db error deleting filter keyword: %s", err))
+	}
+
+	return nil
+}
+
+// getOwnFilterKeyword returns the filter keyword with the given ID, if it belongs to the given account.
+func (p *processor) getOwnFilterKeyword(ctx context.Context, account *gtsmodel.Account, filterKeywordID string) (*gtsmodel.FilterKeyword, gtserror.WithCode) {
+	keyword, err := p.db.GetFilterKeywordByID(ctx, filterKeywordID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("filter keyword %s not found", filterKeywordID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting filter keyword %s: %s", filterKeywordID, err))
+	}
+
+	if keyword.AccountID != account.ID {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("filter keyword %s does not belong to account %s", filterKeywordID, account.ID))
+	}
+
+	return keyword, nil
+}
+
+// apiFilterKeyword converts the given filter keyword into its api representation.
+func (p *processor) apiFilterKeyword(ctx context.Context, keyword *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	apiKeyword, err := p.tc.FilterKeywordToAPIFilterKeyword(ctx, keyword)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter keyword %s: %s", keyword.ID, err))
+	}
+
+	return apiKeyword, nil
+}
+
+// newFilterKeyword returns a new keyword for the given filter, or an error if the given keyword isn't valid.
+func newFilterKeyword(filter *gtsmodel.Filter, keyword *string, wholeWord *bool) (*gtsmodel.FilterKeyword, gtserror.WithCode) {
+	if keyword == nil {
+		err := errors.New("filter keyword must be given")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	keywordID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filterKeyword := &gtsmodel.FilterKeyword{
+		ID:        keywordID,
+		AccountID: filter.AccountID,
+		FilterID:  filter.ID,
+		WholeWord: new(bool),
+	}
+
+	if _, errWithCode := applyFilterKeywordChanges(filterKeyword, keyword, wholeWord); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return filterKeyword, nil
+}
+
+// applyFilterKeywordChanges validates the given changes and applies them to the given filter keyword.
+// The names of the columns of the keyword which were changed are returned.
+func applyFilterKeywordChanges(filterKeyword *gtsmodel.FilterKeyword, keyword *string, wholeWord *bool) ([]string, gtserror.WithCode) {
+	columns := []string{}
+
+	if keyword != nil {
+		k := strings.TrimSpace(*keyword)
+		if k == "" {
+			err := errors.New("filter keyword must not be empty")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		if length := len([]rune(k)); length > filterKeywordMaxChars {
+			err := fmt.Errorf("filter keyword must be at most %d characters, provided keyword was %d characters", filterKeywordMaxChars, length)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		filterKeyword.Keyword = k
+		columns = append(columns, "keyword")
+	}
+
+	if wholeWord != nil {
+		w := *wholeWord
+		filterKeyword.WholeWord = &w
+		columns = append(columns, "whole_word")
+	}
+
+	return columns, nil
+}
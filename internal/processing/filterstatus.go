@@ -0,0 +1,154 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) FilterStatusesGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterStatus, gtserror.WithCode) {
+	filter, errWithCode := p.getOwnFilter(ctx, authed.Account, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiStatuses := make([]*apimodel.FilterStatus, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		apiStatus, errWithCode := p.apiFilterStatus(ctx, status)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
+
+func (p *processor) FilterStatusGet(ctx context.Context, authed *oauth.Auth, filterStatusID string) (*apimodel.FilterStatus, gtserror.WithCode) {
+	filterStatus, errWithCode := p.getOwnFilterStatus(ctx, authed.Account, filterStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterStatus(ctx, filterStatus)
+}
+
+func (p *processor) FilterStatusCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterStatusCreateRequest) (*apimodel.FilterStatus, gtserror.WithCode) {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	filter, errWithCode := p.getOwnFilter(ctx, authed.Account, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.StatusID == "" {
+		err := errors.New("status id must be given")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	status, err := p.db.GetStatusByID(ctx, form.StatusID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("status %s not found", form.StatusID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterStatusCreate: db error getting status: %s", err))
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, status, authed.Account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterStatusCreate: error checking visibility of status: %s", err))
+	}
+	if !visible {
+		err := fmt.Errorf("status %s not found", form.StatusID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	filterStatusID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filterStatus := &gtsmodel.FilterStatus{
+		ID:        filterStatusID,
+		AccountID: filter.AccountID,
+		FilterID:  filter.ID,
+		StatusID:  status.ID,
+	}
+
+	if err := p.db.PutFilterStatus(ctx, filterStatus); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("status %s is already in filter %s", status.ID, filter.ID)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FilterStatusCreate: db error putting filter status: %s", err))
+	}
+
+	return p.apiFilterStatus(ctx, filterStatus)
+}
+
+func (p *processor) FilterStatusDelete(ctx context.Context, authed *oauth.Auth, filterStatusID string) gtserror.WithCode {
+	defer p.filterCache.Invalidate(authed.Account.ID)
+
+	if _, errWithCode := p.getOwnFilterStatus(ctx, authed.Account, filterStatusID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteFilterStatusByID(ctx, filterStatusID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("FilterStatusDelete: db error deleting filter status: %s", err))
+	}
+
+	return nil
+}
+
+// getOwnFilterStatus returns the filter status with the given ID, if it belongs to the given account.
+func (p *processor) getOwnFilterStatus(ctx context.Context, account *gtsmodel.Account, filterStatusID string) (*gtsmodel.FilterStatus, gtserror.WithCode) {
+	filterStatus, err := p.db.GetFilterStatusByID(ctx, filterStatusID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("filter status %s not found", filterStatusID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting filter status %s: %s", filterStatusID, err))
+	}
+
+	if filterStatus.AccountID != account.ID {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("filter status %s does not belong to account %s", filterStatusID, account.ID))
+	}
+
+	return filterStatus, nil
+}
+
+// apiFilterStatus converts the given filter status into its api representation.
+func (p *processor) apiFilterStatus(ctx context.Context, filterStatus *gtsmodel.FilterStatus) (*apimodel.FilterStatus, gtserror.WithCode) {
+	apiStatus, err := p.tc.FilterStatusToAPIFilterStatus(ctx, filterStatus)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter status %s: %s", filterStatus.ID, err))
+	}
+
+	return apiStatus, nil
+}
@@ -20,11 +20,14 @@ package processing
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
@@ -141,3 +144,46 @@ func (p *processor) FollowRequestReject(ctx context.Context, auth *oauth.Auth, a
 
 	return r, nil
 }
+
+func (p *processor) FollowRequestsExpire(ctx context.Context, olderThan time.Time, batchSize int) (int, error) {
+	var expired int
+	for {
+		followRequests, err := p.db.GetExpiredFollowRequests(ctx, olderThan, batchSize)
+		if err != nil && err != db.ErrNoEntries {
+			return expired, fmt.Errorf("FollowRequestsExpire: db error getting expired follow requests: %s", err)
+		}
+
+		var rejected int
+		for _, followRequest := range followRequests {
+			if _, err := p.db.RejectFollowRequest(ctx, followRequest.AccountID, followRequest.TargetAccountID); err != nil {
+				log.Errorf("FollowRequestsExpire: error rejecting follow request %s: %s", followRequest.ID, err)
+				continue
+			}
+			rejected++
+
+			if followRequest.Account == nil || followRequest.TargetAccount == nil {
+				// one of the accounts is already gone,
+				// so there's nobody to send a reject to
+				continue
+			}
+
+			// process the reject as though the target account rejected the request
+			// themselves, so that the requester's server is told it's no longer pending
+			p.clientWorker.Queue(messages.FromClientAPI{
+				APObjectType:   ap.ActivityFollow,
+				APActivityType: ap.ActivityReject,
+				GTSModel:       followRequest,
+				OriginAccount:  followRequest.Account,
+				TargetAccount:  followRequest.TargetAccount,
+			})
+		}
+		expired += rejected
+
+		// stop once we've run out of follow requests, or
+		// if none of this batch could be rejected, since
+		// we'd just get the same batch again next time
+		if len(followRequests) < batchSize || rejected == 0 {
+			return expired, nil
+		}
+	}
+}
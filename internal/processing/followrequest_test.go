@@ -161,6 +161,89 @@ func (suite *FollowRequestTestSuite) TestFollowRequestReject() {
 	suite.Equal("Reject", reject.Type)
 }
 
+func (suite *FollowRequestTestSuite) TestFollowRequestsExpire() {
+	ctx := context.Background()
+	localAccount := suite.testAccounts["local_account_1"]
+	staleRequester := suite.testAccounts["remote_account_2"]
+	freshRequester := suite.testAccounts["remote_account_1"]
+	longAgo := time.Now().Add(-100 * 24 * time.Hour)
+
+	// an unanswered request from long ago, which should expire
+	stale := &gtsmodel.FollowRequest{
+		ID:              "01FJ1S8DX3STJJ6CEYPMZ1M0R3",
+		CreatedAt:       longAgo,
+		UpdatedAt:       longAgo,
+		URI:             fmt.Sprintf("%s/follow/01FJ1S8DX3STJJ6CEYPMZ1M0R3", staleRequester.URI),
+		AccountID:       staleRequester.ID,
+		TargetAccountID: localAccount.ID,
+	}
+
+	// a recent request, which should be left alone
+	fresh := &gtsmodel.FollowRequest{
+		ID:              "01GK3AHM5ZS0MG7V2GVS2VRWNS",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		URI:             fmt.Sprintf("%s/follow/01GK3AHM5ZS0MG7V2GVS2VRWNS", freshRequester.URI),
+		AccountID:       freshRequester.ID,
+		TargetAccountID: localAccount.ID,
+	}
+
+	// an old request made by a local account, which is for the remote server to answer
+	outgoing := &gtsmodel.FollowRequest{
+		ID:              "01GK3AJ9Q0GWVCB1BRZ9GYAX6N",
+		CreatedAt:       longAgo,
+		UpdatedAt:       longAgo,
+		URI:             fmt.Sprintf("%s/follow/01GK3AJ9Q0GWVCB1BRZ9GYAX6N", localAccount.URI),
+		AccountID:       localAccount.ID,
+		TargetAccountID: freshRequester.ID,
+	}
+
+	for _, fr := range []*gtsmodel.FollowRequest{stale, fresh, outgoing} {
+		suite.NoError(suite.db.Put(ctx, fr))
+	}
+
+	expired, err := suite.processor.FollowRequestsExpire(ctx, time.Now().Add(-30*24*time.Hour), 1)
+	suite.NoError(err)
+	suite.Equal(1, expired)
+
+	remaining, err := suite.db.GetAccountFollowRequests(ctx, localAccount.ID)
+	suite.NoError(err)
+	suite.Len(remaining, 1)
+	suite.Equal(fresh.ID, remaining[0].ID)
+
+	requested, err := suite.db.IsFollowRequested(ctx, localAccount, freshRequester)
+	suite.NoError(err)
+	suite.True(requested)
+
+	// reject should be sent to the stale requester
+	var sent [][]byte
+	if !testrig.WaitFor(func() bool {
+		sentI, ok := suite.httpClient.SentMessages.Load(staleRequester.InboxURI)
+		if ok {
+			sent, ok = sentI.([][]byte)
+			if !ok {
+				panic("SentMessages entry was not []byte")
+			}
+			return true
+		}
+		return false
+	}) {
+		suite.FailNow("timed out waiting for message")
+	}
+
+	reject := &struct {
+		Actor  string `json:"actor"`
+		Object struct {
+			ID string `json:"id"`
+		}
+		Type string `json:"type"`
+	}{}
+	suite.NoError(json.Unmarshal(sent[0], reject))
+	suite.Equal(localAccount.URI, reject.Actor)
+	suite.Equal(stale.URI, reject.Object.ID)
+	suite.Equal("Reject", reject.Type)
+}
+
 func TestFollowRequestTestSuite(t *testing.T) {
 	suite.Run(t, &FollowRequestTestSuite{})
 }
@@ -75,6 +75,9 @@ func (p *processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ActivityBlock:
 			// CREATE BLOCK
 			return p.processCreateBlockFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// CREATE POLL VOTES
+			return p.processCreatePollVotesFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityUpdate:
 		// UPDATE
@@ -82,6 +85,9 @@ func (p *processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ObjectProfile, ap.ActorPerson:
 			// UPDATE ACCOUNT/PROFILE
 			return p.processUpdateAccountFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// UPDATE POLL
+			return p.processUpdatePollFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityAccept:
 		// ACCEPT
@@ -160,6 +166,14 @@ func (p *processor) processCreateStatusFromClientAPI(ctx context.Context, client
 		return err
 	}
 
+	if len(status.EmojiIDs) != 0 {
+		// record emoji usage so the account's emoji picker can offer frequently used emojis first;
+		// this is a nice-to-have so don't fail the whole side effect chain if it doesn't work
+		if err := p.db.PutAccountEmojiUsage(ctx, status.AccountID, status.EmojiIDs); err != nil {
+			log.Errorf("processCreateStatusFromClientAPI: error recording emoji usage: %s", err)
+		}
+	}
+
 	return p.federateStatus(ctx, status)
 }
 
@@ -219,6 +233,12 @@ func (p *processor) processCreateBlockFromClientAPI(ctx context.Context, clientM
 	if err := p.statusTimelines.WipeItemsFromAccountID(ctx, block.TargetAccountID, block.AccountID); err != nil {
 		return err
 	}
+	if err := p.wipeListTimelinesOfAccountID(ctx, block.AccountID, block.TargetAccountID); err != nil {
+		return err
+	}
+	if err := p.wipeListTimelinesOfAccountID(ctx, block.TargetAccountID, block.AccountID); err != nil {
+		return err
+	}
 
 	// TODO: same with notifications
 	// TODO: same with bookmarks
@@ -226,6 +246,43 @@ func (p *processor) processCreateBlockFromClientAPI(ctx context.Context, clientM
 	return p.federateBlock(ctx, block)
 }
 
+func (p *processor) processCreatePollVotesFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	votes, ok := clientMsg.GTSModel.([]*gtsmodel.PollVote)
+	if !ok {
+		return errors.New("votes were not parseable as []*gtsmodel.PollVote")
+	}
+	if len(votes) == 0 {
+		return nil
+	}
+
+	poll, err := p.db.GetPollByID(ctx, votes[0].PollID)
+	if err != nil {
+		return fmt.Errorf("processCreatePollVotesFromClientAPI: error getting poll: %s", err)
+	}
+
+	status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+	if err != nil {
+		return fmt.Errorf("processCreatePollVotesFromClientAPI: error getting status of poll: %s", err)
+	}
+
+	// a local poll has already been recounted, so
+	// just let everyone know about the new counts
+	if *status.Local {
+		return p.federateQuestionUpdate(ctx, status)
+	}
+
+	return p.federatePollVotes(ctx, votes, poll, status, clientMsg.OriginAccount)
+}
+
+func (p *processor) processUpdatePollFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("poll status was not parseable as *gtsmodel.Status")
+	}
+
+	return p.federateQuestionUpdate(ctx, status)
+}
+
 func (p *processor) processUpdateAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	account, ok := clientMsg.GTSModel.(*gtsmodel.Account)
 	if !ok {
@@ -335,7 +392,11 @@ func (p *processor) processDeleteAccountFromClientAPI(ctx context.Context, clien
 		return err
 	}
 
-	return p.accountProcessor.Delete(ctx, clientMsg.TargetAccount, origin)
+	if errWithCode := p.accountProcessor.Delete(ctx, clientMsg.TargetAccount, origin); errWithCode != nil {
+		return errWithCode
+	}
+
+	return p.deleteAccountFromTimelines(ctx, clientMsg.TargetAccount.ID)
 }
 
 // TODO: move all the below functions into federation.Federator
@@ -417,7 +478,7 @@ func (p *processor) federateStatus(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("federateStatus: error converting status to as format: %s", err)
 	}
 
-	create, err := p.tc.WrapNoteInCreate(asStatus, false)
+	create, err := p.tc.WrapStatusableInCreate(asStatus, false)
 	if err != nil {
 		return fmt.Errorf("federateStatus: error wrapping status in create: %s", err)
 	}
@@ -427,6 +488,9 @@ func (p *processor) federateStatus(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("federateStatus: error parsing outboxURI %s: %s", status.Account.OutboxURI, err)
 	}
 
+	// note which status this is, so that the outcome of delivering it can be recorded
+	ctx = context.WithValue(ctx, ap.ContextDeliveringStatusID, status.ID)
+
 	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, create)
 	return err
 }
@@ -470,7 +534,9 @@ func (p *processor) federateStatusDelete(ctx context.Context, status *gtsmodel.S
 
 	// Set the status as the 'object' property.
 	deleteObject := streams.NewActivityStreamsObjectProperty()
-	deleteObject.AppendActivityStreamsNote(asStatus)
+	if err := deleteObject.AppendType(asStatus); err != nil {
+		return fmt.Errorf("federateStatusDelete: error setting status as object: %s", err)
+	}
 	delete.SetActivityStreamsObject(deleteObject)
 
 	// set the to and cc as the original to/cc of the original status
@@ -24,6 +24,8 @@ import (
 	"strings"
 	"sync"
 
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -61,6 +63,24 @@ func (p *processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			continue
 		}
 
+		// don't notify about mentions the target doesn't permit
+		permitted, err := p.mentionPermitted(ctx, status, m.TargetAccount)
+		if err != nil {
+			return fmt.Errorf("notifyStatus: error checking mention policy for account %s: %s", m.TargetAccountID, err)
+		}
+		if !permitted {
+			continue
+		}
+
+		// don't notify about mentions in threads the target has muted
+		muted, err := p.db.IsThreadMutedBy(ctx, status, m.TargetAccountID)
+		if err != nil {
+			return fmt.Errorf("notifyStatus: error checking thread mute for account %s: %s", m.TargetAccountID, err)
+		}
+		if muted {
+			continue
+		}
+
 		// make sure a notif doesn't already exist for this mention
 		if err := p.db.GetWhere(ctx, []db.Where{
 			{Key: "notification_type", Value: gtsmodel.NotificationMention},
@@ -102,7 +122,7 @@ func (p *processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 		}
 
-		if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, m.TargetAccount); err != nil {
+		if err := p.streamNotificationToAccount(ctx, apiNotif, m.TargetAccount); err != nil {
 			return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 		}
 	}
@@ -149,7 +169,7 @@ func (p *processor) notifyFollowRequest(ctx context.Context, followRequest *gtsm
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
+	if err := p.streamNotificationToAccount(ctx, apiNotif, targetAccount); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
@@ -195,7 +215,7 @@ func (p *processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, t
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
+	if err := p.streamNotificationToAccount(ctx, apiNotif, targetAccount); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
@@ -222,6 +242,23 @@ func (p *processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return nil
 	}
 
+	if fave.Status == nil {
+		s, err := p.db.GetStatusByID(ctx, fave.StatusID)
+		if err != nil {
+			return err
+		}
+		fave.Status = s
+	}
+
+	// don't notify about faves in threads the target has muted
+	muted, err := p.db.IsThreadMutedBy(ctx, fave.Status, targetAccount.ID)
+	if err != nil {
+		return fmt.Errorf("notifyFave: error checking thread mute for account %s: %s", targetAccount.ID, err)
+	}
+	if muted {
+		return nil
+	}
+
 	notifID, err := id.NewULID()
 	if err != nil {
 		return err
@@ -248,7 +285,7 @@ func (p *processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
+	if err := p.streamNotificationToAccount(ctx, apiNotif, targetAccount); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
@@ -288,8 +325,17 @@ func (p *processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return nil
 	}
 
+	// don't notify about boosts in threads the target has muted
+	muted, err := p.db.IsThreadMutedBy(ctx, status.BoostOf, status.BoostOfAccountID)
+	if err != nil {
+		return fmt.Errorf("notifyAnnounce: error checking thread mute for account %s: %s", status.BoostOfAccountID, err)
+	}
+	if muted {
+		return nil
+	}
+
 	// make sure a notif doesn't already exist for this announce
-	err := p.db.GetWhere(ctx, []db.Where{
+	err = p.db.GetWhere(ctx, []db.Where{
 		{Key: "notification_type", Value: gtsmodel.NotificationReblog},
 		{Key: "target_account_id", Value: status.BoostOfAccountID},
 		{Key: "origin_account_id", Value: status.AccountID},
@@ -327,7 +373,7 @@ func (p *processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, status.BoostOfAccount); err != nil {
+	if err := p.streamNotificationToAccount(ctx, apiNotif, status.BoostOfAccount); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
@@ -360,12 +406,19 @@ func (p *processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 		})
 	}
 
+	// walk up the thread once here, rather
+	// than once for every follower below
+	threadIDs, err := p.timelineThreadIDs(ctx, status)
+	if err != nil {
+		return fmt.Errorf("timelineStatus: error getting thread of status %s: %s", status.ID, err)
+	}
+
 	wg := sync.WaitGroup{}
 	wg.Add(len(follows))
 	errors := make(chan error, len(follows))
 
 	for _, f := range follows {
-		go p.timelineStatusForAccount(ctx, status, f.AccountID, errors, &wg)
+		go p.timelineStatusForAccount(ctx, status, threadIDs, f.AccountID, errors, &wg)
 	}
 
 	// read any errors that come in from the async functions
@@ -391,11 +444,13 @@ func (p *processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 }
 
 // timelineStatusForAccount puts the given status in the HOME timeline
-// of the account with given accountID, if it's hometimelineable.
+// of the account with given accountID, if it's hometimelineable. The
+// threadIDs are those returned by timelineThreadIDs for the status.
 //
 // If the status was inserted into the home timeline of the given account,
-// it will also be streamed via websockets to the user.
-func (p *processor) timelineStatusForAccount(ctx context.Context, status *gtsmodel.Status, accountID string, errors chan error, wg *sync.WaitGroup) {
+// it will also be streamed via websockets to the user. The status is then
+// also put in the timelines of any of the account's lists it belongs in.
+func (p *processor) timelineStatusForAccount(ctx context.Context, status *gtsmodel.Status, threadIDs []string, accountID string, errors chan error, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	// get the timeline owner account
@@ -416,6 +471,31 @@ func (p *processor) timelineStatusForAccount(ctx context.Context, status *gtsmod
 		return
 	}
 
+	// direct statuses only reach the timeline owner by mentioning them,
+	// so keep them out if the owner doesn't permit the mention
+	if status.Visibility == gtsmodel.VisibilityDirect {
+		permitted, err := p.mentionPermitted(ctx, status, timelineAccount)
+		if err != nil {
+			errors <- fmt.Errorf("timelineStatusForAccount: error checking mention policy for timeline with id %s: %s", accountID, err)
+			return
+		}
+
+		if !permitted {
+			return
+		}
+	}
+
+	// make sure the status isn't part of a thread muted by the timeline owner
+	muted, err := p.timelineThreadMuted(ctx, status, threadIDs, timelineAccount.ID)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForAccount: error checking thread mute for timeline with id %s: %s", accountID, err)
+		return
+	}
+
+	if muted {
+		return
+	}
+
 	// stick the status in the timeline for the account and then immediately prepare it so they can see it right away
 	inserted, err := p.statusTimelines.IngestAndPrepare(ctx, status, timelineAccount.ID)
 	if err != nil {
@@ -431,10 +511,71 @@ func (p *processor) timelineStatusForAccount(ctx context.Context, status *gtsmod
 			return
 		}
 
-		if err := p.streamingProcessor.StreamUpdateToAccount(apiStatus, timelineAccount, stream.TimelineHome); err != nil {
+		if err := p.streamUpdateToAccount(ctx, apiStatus, timelineAccount, stream.TimelineHome); err != nil {
 			errors <- fmt.Errorf("timelineStatusForAccount: error streaming status %s: %s", status.ID, err)
+			return
 		}
 	}
+
+	if err := p.timelineStatusForLists(ctx, status, timelineAccount); err != nil {
+		errors <- fmt.Errorf("timelineStatusForAccount: %s", err)
+	}
+}
+
+// mentionPermitted returns true if the mention policy of the given
+// target account permits the author of the given status to mention it.
+func (p *processor) mentionPermitted(ctx context.Context, status *gtsmodel.Status, targetAccount *gtsmodel.Account) (bool, error) {
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return false, fmt.Errorf("mentionPermitted: error getting author account with id %s: %s", status.AccountID, err)
+		}
+		status.Account = a
+	}
+
+	return p.filter.MentionPermitted(ctx, status.Account, targetAccount)
+}
+
+// timelineThreadIDs returns the ids of the thread which the given status is
+// checked against by timelineThreadMuted: the thread of a reply, and, if
+// configured, the thread of a boosted status. For other statuses, which
+// can't be a new entry in a muted thread, nil is returned.
+func (p *processor) timelineThreadIDs(ctx context.Context, status *gtsmodel.Status) ([]string, error) {
+	if status.BoostOfID != "" {
+		if !config.GetStatusesThreadMuteBoosts() {
+			return nil, nil
+		}
+
+		if status.BoostOf == nil {
+			boostedStatus, err := p.db.GetStatusByID(ctx, status.BoostOfID)
+			if err != nil {
+				return nil, fmt.Errorf("timelineThreadIDs: error getting status with id %s: %s", status.BoostOfID, err)
+			}
+			status.BoostOf = boostedStatus
+		}
+
+		return p.db.GetStatusThreadIDs(ctx, status.BoostOf)
+	}
+
+	if status.InReplyToID == "" {
+		return nil, nil
+	}
+
+	return p.db.GetStatusThreadIDs(ctx, status)
+}
+
+// timelineThreadMuted returns true if the given status should be kept out of
+// the HOME timeline of the account with given accountID because it's a reply
+// in a thread that the account has muted. If configured, boosts of statuses in
+// a muted thread are kept out too. The threadIDs are those returned by
+// timelineThreadIDs for the status.
+func (p *processor) timelineThreadMuted(ctx context.Context, status *gtsmodel.Status, threadIDs []string, accountID string) (bool, error) {
+	if status.AccountID == accountID {
+		// always show the account its own statuses
+		return false, nil
+	}
+
+	return p.db.IsThreadIDsMutedBy(ctx, threadIDs, accountID)
 }
 
 // deleteStatusFromTimelines completely removes the given status from all timelines.
@@ -444,9 +585,23 @@ func (p *processor) deleteStatusFromTimelines(ctx context.Context, status *gtsmo
 		return err
 	}
 
+	if err := p.listTimelines.WipeItemFromAllTimelines(ctx, status.ID); err != nil {
+		return err
+	}
+
 	return p.streamingProcessor.StreamDelete(status.ID)
 }
 
+// deleteAccountFromTimelines removes all statuses of the given account,
+// and boosts of them, from all timelines.
+func (p *processor) deleteAccountFromTimelines(ctx context.Context, accountID string) error {
+	if err := p.statusTimelines.WipeAccountFromAllTimelines(ctx, accountID); err != nil {
+		return err
+	}
+
+	return p.listTimelines.WipeAccountFromAllTimelines(ctx, accountID)
+}
+
 // wipeStatus contains common logic used to totally delete a status
 // + all its attachments, notifications, boosts, and timeline entries.
 func (p *processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Status, deleteAttachments bool) error {
@@ -505,3 +660,35 @@ func (p *processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 
 	return nil
 }
+
+// streamUpdateToAccount streams the given status to the given home or list timeline of the
+// given account, unless the account has a filter which hides it in its home timeline.
+func (p *processor) streamUpdateToAccount(ctx context.Context, apiStatus *apimodel.Status, account *gtsmodel.Account, timeline string) error {
+	filters, err := p.filterCache.Get(ctx, p.db, p.tc, account, gtsmodel.FilterContextHome)
+	if err != nil {
+		return err
+	}
+
+	if apiStatus = filters.Apply(apiStatus); apiStatus == nil {
+		return nil
+	}
+
+	return p.streamingProcessor.StreamUpdateToAccount(apiStatus, account, timeline)
+}
+
+// streamNotificationToAccount streams the given notification to the given account,
+// unless the account has a filter which hides its status in notifications.
+func (p *processor) streamNotificationToAccount(ctx context.Context, apiNotif *apimodel.Notification, account *gtsmodel.Account) error {
+	if apiNotif.Status != nil {
+		filters, err := p.filterCache.Get(ctx, p.db, p.tc, account, gtsmodel.FilterContextNotifications)
+		if err != nil {
+			return err
+		}
+
+		if apiNotif.Status = filters.Apply(apiNotif.Status); apiNotif.Status == nil {
+			return nil
+		}
+	}
+
+	return p.streamingProcessor.StreamNotificationToAccount(apiNotif, account)
+}
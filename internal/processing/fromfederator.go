@@ -82,6 +82,9 @@ func (p *processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 		case ap.ActivityBlock:
 			// CREATE A BLOCK
 			return p.processCreateBlockFromFederator(ctx, federatorMsg)
+		case ap.ActivityQuestion:
+			// CREATE A POLL VOTE
+			return p.processCreatePollVoteFromFederator(ctx, federatorMsg)
 		}
 	case ap.ActivityUpdate:
 		// UPDATE SOMETHING
@@ -129,7 +132,7 @@ func (p *processor) processCreateStatusFromFederator(ctx context.Context, federa
 			return errors.New("ProcessFromFederator: status was not pinned to federatorMsg, and neither was an IRI for us to dereference")
 		}
 		var err error
-		status, _, err = p.federator.GetRemoteStatus(ctx, federatorMsg.ReceivingAccount.Username, federatorMsg.APIri, false, false)
+		status, _, err = p.federator.GetRemoteStatus(ctx, federatorMsg.ReceivingAccount.Username, federatorMsg.APIri, false, true)
 		if err != nil {
 			return err
 		}
@@ -259,6 +262,19 @@ func (p *processor) processCreateFollowRequestFromFederator(ctx context.Context,
 		followRequest.TargetAccount = a
 	}
 
+	// if the target account has blocked the domain of the requester, reject the follow request straight away
+	if blocked, err := p.db.IsDomainBlockedByAccount(ctx, followRequest.TargetAccountID, followRequest.Account.Domain); err != nil {
+		return err
+	} else if blocked {
+		rejected, err := p.db.RejectFollowRequest(ctx, followRequest.AccountID, followRequest.TargetAccountID)
+		if err != nil {
+			return err
+		}
+		rejected.Account = followRequest.Account
+		rejected.TargetAccount = followRequest.TargetAccount
+		return p.federateRejectFollowRequest(ctx, rejected)
+	}
+
 	if *followRequest.TargetAccount.Locked {
 		// if the account is locked just notify the follow request and nothing else
 		return p.notifyFollowRequest(ctx, followRequest)
@@ -351,12 +367,42 @@ func (p *processor) processCreateBlockFromFederator(ctx context.Context, federat
 	if err := p.statusTimelines.WipeItemsFromAccountID(ctx, block.TargetAccountID, block.AccountID); err != nil {
 		return err
 	}
+	if err := p.wipeListTimelinesOfAccountID(ctx, block.AccountID, block.TargetAccountID); err != nil {
+		return err
+	}
+	if err := p.wipeListTimelinesOfAccountID(ctx, block.TargetAccountID, block.AccountID); err != nil {
+		return err
+	}
 	// TODO: same with notifications
 	// TODO: same with bookmarks
 
 	return nil
 }
 
+// processCreatePollVoteFromFederator handles Activity Create and Object Note, where the note is a vote in a local poll
+func (p *processor) processCreatePollVoteFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	vote, ok := federatorMsg.GTSModel.(*gtsmodel.PollVote)
+	if !ok {
+		return errors.New("vote was not parseable as *gtsmodel.PollVote")
+	}
+
+	poll, err := p.db.GetPollByID(ctx, vote.PollID)
+	if err != nil {
+		return fmt.Errorf("processCreatePollVoteFromFederator: error getting poll: %s", err)
+	}
+
+	if err := p.recountPoll(ctx, poll); err != nil {
+		return err
+	}
+
+	status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+	if err != nil {
+		return fmt.Errorf("processCreatePollVoteFromFederator: error getting status of poll: %s", err)
+	}
+
+	return p.federateQuestionUpdate(ctx, status)
+}
+
 // processUpdateAccountFromFederator handles Activity Update and Object Profile
 func (p *processor) processUpdateAccountFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
 	incomingAccount, ok := federatorMsg.GTSModel.(*gtsmodel.Account)
@@ -405,5 +451,9 @@ func (p *processor) processDeleteAccountFromFederator(ctx context.Context, feder
 		return errors.New("account delete was not parseable as *gtsmodel.Account")
 	}
 
-	return p.accountProcessor.Delete(ctx, account, account.ID)
+	if errWithCode := p.accountProcessor.Delete(ctx, account, account.ID); errWithCode != nil {
+		return errWithCode
+	}
+
+	return p.deleteAccountFromTimelines(ctx, account.ID)
 }
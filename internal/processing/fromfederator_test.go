@@ -178,6 +178,126 @@ func (suite *FromFederatorTestSuite) TestProcessReplyMention() {
 	suite.Equal(replyingAccount.ID, notifStreamed.Account.ID)
 }
 
+func (suite *FromFederatorTestSuite) TestProcessReplyMentionMutedThread() {
+	repliedAccount := suite.testAccounts["local_account_1"]
+	repliedStatus := suite.testStatuses["local_account_1_status_1"]
+	replyingAccount := suite.testAccounts["remote_account_1"]
+
+	// local_account_1 mutes the thread of their own status
+	muteID, err := id.NewULID()
+	suite.NoError(err)
+	err = suite.db.Put(context.Background(), &gtsmodel.StatusMute{
+		ID:              muteID,
+		AccountID:       repliedAccount.ID,
+		TargetAccountID: repliedAccount.ID,
+		StatusID:        repliedStatus.ID,
+	})
+	suite.NoError(err)
+
+	replyingStatus := &gtsmodel.Status{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		URI:       "http://fossbros-anonymous.io/users/foss_satan/statuses/106221634728637553",
+		URL:       "http://fossbros-anonymous.io/@foss_satan/106221634728637553",
+		Content:   `<p><span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span> still here</p>`,
+		Mentions: []*gtsmodel.Mention{
+			{
+				TargetAccountURI: repliedAccount.URI,
+				NameString:       "@the_mighty_zork@localhost:8080",
+			},
+		},
+		AccountID:           replyingAccount.ID,
+		AccountURI:          replyingAccount.URI,
+		InReplyToID:         repliedStatus.ID,
+		InReplyToURI:        repliedStatus.URI,
+		InReplyToAccountID:  repliedAccount.ID,
+		Visibility:          gtsmodel.VisibilityUnlocked,
+		ActivityStreamsType: ap.ObjectNote,
+		Federated:           testrig.TrueBool(),
+		Boostable:           testrig.TrueBool(),
+		Replyable:           testrig.TrueBool(),
+		Likeable:            testrig.FalseBool(),
+	}
+
+	statusID, err := id.NewULIDFromTime(replyingStatus.CreatedAt)
+	suite.NoError(err)
+	replyingStatus.ID = statusID
+
+	err = suite.db.PutStatus(context.Background(), replyingStatus)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(context.Background(), messages.FromFederator{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         replyingStatus,
+		ReceivingAccount: repliedAccount,
+	})
+	suite.NoError(err)
+
+	// no notification should exist for the mention, since the thread is muted
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "status_id", Value: replyingStatus.ID}}, &gtsmodel.Notification{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *FromFederatorTestSuite) TestProcessReplyMentionNotPermitted() {
+	repliedAccount := &gtsmodel.Account{}
+	*repliedAccount = *suite.testAccounts["local_account_1"]
+	repliedStatus := suite.testStatuses["local_account_1_status_1"]
+	replyingAccount := suite.testAccounts["remote_account_1"]
+
+	// local_account_1 doesn't want to be mentioned by anyone
+	repliedAccount.MentionPolicy = gtsmodel.MentionPolicyNobody
+	if _, err := suite.db.UpdateAccount(context.Background(), repliedAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	replyingStatus := &gtsmodel.Status{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		URI:       "http://fossbros-anonymous.io/users/foss_satan/statuses/106221634728637554",
+		URL:       "http://fossbros-anonymous.io/@foss_satan/106221634728637554",
+		Content:   `<p><span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span> hello?</p>`,
+		Mentions: []*gtsmodel.Mention{
+			{
+				TargetAccountURI: repliedAccount.URI,
+				NameString:       "@the_mighty_zork@localhost:8080",
+			},
+		},
+		AccountID:           replyingAccount.ID,
+		AccountURI:          replyingAccount.URI,
+		InReplyToID:         repliedStatus.ID,
+		InReplyToURI:        repliedStatus.URI,
+		InReplyToAccountID:  repliedAccount.ID,
+		Visibility:          gtsmodel.VisibilityUnlocked,
+		ActivityStreamsType: ap.ObjectNote,
+		Federated:           testrig.TrueBool(),
+		Boostable:           testrig.TrueBool(),
+		Replyable:           testrig.TrueBool(),
+		Likeable:            testrig.FalseBool(),
+	}
+
+	statusID, err := id.NewULIDFromTime(replyingStatus.CreatedAt)
+	suite.NoError(err)
+	replyingStatus.ID = statusID
+
+	err = suite.db.PutStatus(context.Background(), replyingStatus)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(context.Background(), messages.FromFederator{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         replyingStatus,
+		ReceivingAccount: repliedAccount,
+	})
+	suite.NoError(err)
+
+	// the mention should have been dropped without being stored or notified
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "status_id", Value: replyingStatus.ID}}, &gtsmodel.Mention{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "status_id", Value: replyingStatus.ID}}, &gtsmodel.Notification{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func (suite *FromFederatorTestSuite) TestProcessFave() {
 	favedAccount := suite.testAccounts["local_account_1"]
 	favedStatus := suite.testStatuses["local_account_1_status_1"]
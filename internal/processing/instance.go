@@ -20,6 +20,9 @@ package processing
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -34,6 +37,10 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
+// instanceMaxDeputyContacts is the most deputy contact
+// accounts, or deputy contact emails, an instance may have.
+const instanceMaxDeputyContacts = 10
+
 func (p *processor) InstanceGet(ctx context.Context, domain string) (*apimodel.Instance, gtserror.WithCode) {
 	i := &gtsmodel.Instance{}
 	if err := p.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: domain}}, i); err != nil {
@@ -48,6 +55,21 @@ func (p *processor) InstanceGet(ctx context.Context, domain string) (*apimodel.I
 	return ai, nil
 }
 
+func (p *processor) InstanceGetV2(ctx context.Context) (*apimodel.InstanceV2, gtserror.WithCode) {
+	i := &gtsmodel.Instance{}
+	host := config.GetHost()
+	if err := p.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: host}}, i); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error fetching instance %s: %s", host, err))
+	}
+
+	ai, err := p.tc.InstanceToAPIV2Instance(ctx, i)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting instance to api v2 representation: %s", err))
+	}
+
+	return ai, nil
+}
+
 func (p *processor) InstancePeersGet(ctx context.Context, authed *oauth.Auth, includeSuspended bool, includeOpen bool, flat bool) (interface{}, gtserror.WithCode) {
 	domains := []*apimodel.Domain{}
 
@@ -81,12 +103,14 @@ func (p *processor) InstancePeersGet(ctx context.Context, authed *oauth.Auth, in
 		}
 
 		for _, d := range domainBlocks {
+			publicDomain := d.Domain
 			if *d.Obfuscate {
-				d.Domain = obfuscate(d.Domain)
+				publicDomain = obfuscate(d.Domain)
 			}
 
 			domain := &apimodel.Domain{
-				Domain:        d.Domain,
+				Domain:        publicDomain,
+				Digest:        digest(d.Domain),
 				SuspendedAt:   util.FormatISO8601(d.CreatedAt),
 				PublicComment: d.PublicComment,
 			}
@@ -136,37 +160,37 @@ func (p *processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 
 	// validate & update site contact account if it's set on the form
 	if form.ContactUsername != nil {
-		// make sure the account with the given username exists in the db
-		contactAccount, err := p.db.GetAccountByUsernameDomain(ctx, *form.ContactUsername, "")
-		if err != nil {
-			return nil, gtserror.NewErrorBadRequest(err, fmt.Sprintf("account with username %s not retrievable", *form.ContactUsername))
-		}
-		// make sure it has a user associated with it
-		contactUser, err := p.db.GetUserByAccountID(ctx, contactAccount.ID)
-		if err != nil {
-			return nil, gtserror.NewErrorBadRequest(err, fmt.Sprintf("user for account with username %s not retrievable", *form.ContactUsername))
+		contactAccount, errWithCode := p.instanceContactAccount(ctx, *form.ContactUsername)
+		if errWithCode != nil {
+			return nil, errWithCode
 		}
-		// suspended accounts cannot be contact accounts
-		if !contactAccount.SuspendedAt.IsZero() {
-			err := fmt.Errorf("selected contact account %s is suspended", contactAccount.Username)
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
-		}
-		// unconfirmed or unapproved users cannot be contacts
-		if contactUser.ConfirmedAt.IsZero() {
-			err := fmt.Errorf("user of selected contact account %s is not confirmed", contactAccount.Username)
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
-		}
-		if !*contactUser.Approved {
-			err := fmt.Errorf("user of selected contact account %s is not approved", contactAccount.Username)
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		updatingColumns = append(updatingColumns, "contact_account_id")
+		i.ContactAccountID = contactAccount.ID
+	}
+
+	// validate & update site deputy contact accounts if they're set on the form;
+	// empty usernames are skipped, so that the list can be cleared with a form
+	if form.ContactDeputyUsernames != nil {
+		deputies := []*gtsmodel.Account{}
+		deputyIDs := []string{}
+		for _, username := range util.UniqueStrings(*form.ContactDeputyUsernames) {
+			if username == "" {
+				continue
+			}
+			deputy, errWithCode := p.instanceContactAccount(ctx, username)
+			if errWithCode != nil {
+				return nil, errWithCode
+			}
+			deputies = append(deputies, deputy)
+			deputyIDs = append(deputyIDs, deputy.ID)
 		}
-		// contact account user must be admin or moderator otherwise what's the point of contacting them
-		if !*contactUser.Admin && !*contactUser.Moderator {
-			err := fmt.Errorf("user of selected contact account %s is neither admin nor moderator", contactAccount.Username)
+		if len(deputyIDs) > instanceMaxDeputyContacts {
+			err := fmt.Errorf("too many deputy contact accounts: the maximum is %d", instanceMaxDeputyContacts)
 			return nil, gtserror.NewErrorBadRequest(err, err.Error())
 		}
-		updatingColumns = append(updatingColumns, "contact_account_id")
-		i.ContactAccountID = contactAccount.ID
+		updatingColumns = append(updatingColumns, "deputy_contact_account_ids")
+		i.DeputyContactAccountIDs = deputyIDs
+		i.DeputyContactAccounts = deputies
 	}
 
 	// validate & update site contact email if it's set on the form
@@ -181,13 +205,34 @@ func (p *processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 		i.ContactEmail = contactEmail
 	}
 
+	// validate & update site deputy contact emails if they're set on the form
+	if form.ContactDeputyEmails != nil {
+		deputyEmails := []string{}
+		for _, email := range util.UniqueStrings(*form.ContactDeputyEmails) {
+			if email == "" {
+				continue
+			}
+			if err := validate.Email(email); err != nil {
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+			deputyEmails = append(deputyEmails, email)
+		}
+		if len(deputyEmails) > instanceMaxDeputyContacts {
+			err := fmt.Errorf("too many deputy contact emails: the maximum is %d", instanceMaxDeputyContacts)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		updatingColumns = append(updatingColumns, "deputy_contact_emails")
+		i.DeputyContactEmails = deputyEmails
+	}
+
 	// validate & update site short description if it's set on the form
 	if form.ShortDescription != nil {
 		if err := validate.SiteShortDescription(*form.ShortDescription); err != nil {
 			return nil, gtserror.NewErrorBadRequest(err, err.Error())
 		}
-		updatingColumns = append(updatingColumns, "short_description")
-		i.ShortDescription = text.SanitizeHTML(*form.ShortDescription) // html is OK in site description, but we should sanitize it
+		updatingColumns = append(updatingColumns, "short_description", "short_description_text")
+		i.ShortDescriptionText = *form.ShortDescription
+		i.ShortDescription = p.formatter.FromMarkdown(ctx, i.ShortDescriptionText, nil, nil, nil) // html is OK in site description, and the formatter sanitizes it
 	}
 
 	// validate & update site description if it's set on the form
@@ -195,8 +240,9 @@ func (p *processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 		if err := validate.SiteDescription(*form.Description); err != nil {
 			return nil, gtserror.NewErrorBadRequest(err, err.Error())
 		}
-		updatingColumns = append(updatingColumns, "description")
-		i.Description = text.SanitizeHTML(*form.Description) // html is OK in site description, but we should sanitize it
+		updatingColumns = append(updatingColumns, "description", "description_text")
+		i.DescriptionText = *form.Description
+		i.Description = p.formatter.FromMarkdown(ctx, i.DescriptionText, nil, nil, nil) // html is OK in site description, and the formatter sanitizes it
 	}
 
 	// validate & update site terms if it's set on the form
@@ -204,8 +250,9 @@ func (p *processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 		if err := validate.SiteTerms(*form.Terms); err != nil {
 			return nil, gtserror.NewErrorBadRequest(err, err.Error())
 		}
-		updatingColumns = append(updatingColumns, "terms")
-		i.Terms = text.SanitizeHTML(*form.Terms) // html is OK in site terms, but we should sanitize it
+		updatingColumns = append(updatingColumns, "terms", "terms_text")
+		i.TermsText = *form.Terms
+		i.Terms = p.formatter.FromMarkdown(ctx, i.TermsText, nil, nil, nil) // html is OK in site terms, and the formatter sanitizes it
 	}
 
 	var updateInstanceAccount bool
@@ -254,14 +301,82 @@ func (p *processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 	return ai, nil
 }
 
+// instanceContactAccount returns the local account with the given username,
+// if it's suitable for being a contact account of this instance: it must
+// belong to a confirmed and approved admin or moderator, who isn't suspended.
+func (p *processor) instanceContactAccount(ctx context.Context, username string) (*gtsmodel.Account, gtserror.WithCode) {
+	// make sure the account with the given username exists in the db
+	contactAccount, err := p.db.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, fmt.Sprintf("account with username %s not retrievable", username))
+	}
+	// make sure it has a user associated with it
+	contactUser, err := p.db.GetUserByAccountID(ctx, contactAccount.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, fmt.Sprintf("user for account with username %s not retrievable", username))
+	}
+	// suspended accounts cannot be contact accounts
+	if !contactAccount.SuspendedAt.IsZero() {
+		err := fmt.Errorf("selected contact account %s is suspended", contactAccount.Username)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	// unconfirmed or unapproved users cannot be contacts
+	if contactUser.ConfirmedAt.IsZero() {
+		err := fmt.Errorf("user of selected contact account %s is not confirmed", contactAccount.Username)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	if !*contactUser.Approved {
+		err := fmt.Errorf("user of selected contact account %s is not approved", contactAccount.Username)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	// contact account user must be admin or moderator otherwise what's the point of contacting them
+	if !*contactUser.Admin && !*contactUser.Moderator {
+		err := fmt.Errorf("user of selected contact account %s is neither admin nor moderator", contactAccount.Username)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	return contactAccount, nil
+}
+
+// obfuscate partially redacts the given domain for public display, in the
+// same way as Mastodon: the first and last quarter of the domain are left as-is,
+// and every character in between except for dots is replaced with an asterisk.
 func obfuscate(domain string) string {
-	obfuscated := make([]rune, len(domain))
-	for i, r := range domain {
-		if i%3 == 1 || i%5 == 1 {
-			obfuscated[i] = '*'
-		} else {
-			obfuscated[i] = r
+	runes := []rune(domain)
+	length := len(runes)
+	visible := length / 4
+
+	for i, r := range runes {
+		if i > visible && i < length-visible && r != '.' {
+			runes[i] = '*'
 		}
 	}
-	return string(obfuscated)
+
+	return string(runes)
+}
+
+// digest returns the hex-encoded SHA256 digest of the given domain, so that
+// people can check whether an obfuscated domain matches one they know about.
+func digest(domain string) string {
+	sum := sha256.Sum256([]byte(domain))
+	return hex.EncodeToString(sum[:])
+}
+
+func (p *processor) InstanceLegalDocumentGet(ctx context.Context, kind gtsmodel.LegalDocumentKind, version int) (*apimodel.LegalDocument, gtserror.WithCode) {
+	document, err := p.db.GetLegalDocument(ctx, kind, version)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting legal document %s: %s", kind, err))
+		}
+		if version != 0 {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("no version %d of legal document %s", version, kind))
+		}
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no legal document %s has been published", kind))
+	}
+
+	apiDocument, err := p.tc.LegalDocumentToAPILegalDocument(ctx, document)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDocument, nil
 }
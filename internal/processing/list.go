@@ -0,0 +1,342 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const listTitleMaxChars = 200
+
+func (p *processor) ListsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.List, gtserror.WithCode) {
+	lists, err := p.db.GetListsForAccountID(ctx, authed.Account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ListsGet: db error getting lists: %s", err))
+	}
+
+	apiLists := make([]*apimodel.List, 0, len(lists))
+	for _, list := range lists {
+		apiList, err := p.tc.ListToAPIList(ctx, list)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("ListsGet: error converting list %s: %s", list.ID, err))
+		}
+		apiLists = append(apiLists, apiList)
+	}
+
+	return apiLists, nil
+}
+
+func (p *processor) ListGet(ctx context.Context, authed *oauth.Auth, listID string) (*apimodel.List, gtserror.WithCode) {
+	list, errWithCode := p.getOwnList(ctx, authed.Account, listID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiList(ctx, list)
+}
+
+func (p *processor) ListCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.ListCreateRequest) (*apimodel.List, gtserror.WithCode) {
+	title, errWithCode := validateListTitle(form.Title)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	repliesPolicy := gtsmodel.ListRepliesPolicyList
+	if form.RepliesPolicy != "" {
+		repliesPolicy, errWithCode = validateListRepliesPolicy(form.RepliesPolicy)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	listID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	list := &gtsmodel.List{
+		ID:            listID,
+		Title:         title,
+		AccountID:     authed.Account.ID,
+		RepliesPolicy: repliesPolicy,
+	}
+
+	if err := p.db.Put(ctx, list); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ListCreate: db error putting list: %s", err))
+	}
+
+	return p.apiList(ctx, list)
+}
+
+func (p *processor) ListUpdate(ctx context.Context, authed *oauth.Auth, listID string, form *apimodel.ListUpdateRequest) (*apimodel.List, gtserror.WithCode) {
+	list, errWithCode := p.getOwnList(ctx, authed.Account, listID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := []string{}
+
+	if form.Title != nil {
+		list.Title, errWithCode = validateListTitle(*form.Title)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "title")
+	}
+
+	if form.RepliesPolicy != nil {
+		list.RepliesPolicy, errWithCode = validateListRepliesPolicy(*form.RepliesPolicy)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "replies_policy")
+	}
+
+	if len(columns) == 0 {
+		err := errors.New("nothing to update")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := p.db.UpdateList(ctx, list, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ListUpdate: db error updating list: %s", err))
+	}
+
+	return p.apiList(ctx, list)
+}
+
+func (p *processor) ListDelete(ctx context.Context, authed *oauth.Auth, listID string) gtserror.WithCode {
+	if _, errWithCode := p.getOwnList(ctx, authed.Account, listID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteListByID(ctx, listID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("ListDelete: db error deleting list: %s", err))
+	}
+
+	return nil
+}
+
+func (p *processor) ListAccountsGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	if _, errWithCode := p.getOwnList(ctx, authed.Account, listID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	entries, err := p.db.GetListEntries(ctx, listID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ListAccountsGet: db error getting list entries: %s", err))
+	}
+
+	if len(entries) == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := []interface{}{}
+	for _, entry := range entries {
+		if entry.Follow.TargetAccount == nil {
+			log.Debugf("ListAccountsGet: skipping entry %s because account %s can't be found", entry.ID, entry.Follow.TargetAccountID)
+			continue
+		}
+
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, entry.Follow.TargetAccount)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("ListAccountsGet: error converting account %s: %s", entry.Follow.TargetAccountID, err))
+		}
+		items = append(items, apiAccount)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/lists/" + listID + "/accounts",
+		NextMaxIDValue: entries[len(entries)-1].ID,
+		PrevMinIDValue: entries[0].ID,
+		Limit:          limit,
+	})
+}
+
+func (p *processor) ListAccountsAdd(ctx context.Context, authed *oauth.Auth, listID string, accountIDs []string) gtserror.WithCode {
+	if _, errWithCode := p.getOwnList(ctx, authed.Account, listID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if len(accountIDs) == 0 {
+		err := errors.New("no account ids given")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	entries := make([]*gtsmodel.ListEntry, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		follow, errWithCode := p.getListFollow(ctx, authed.Account, accountID)
+		if errWithCode != nil {
+			return errWithCode
+		}
+
+		if follow == nil {
+			err := fmt.Errorf("you must follow account %s to add it to a list", accountID)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		entryID, err := id.NewULID()
+		if err != nil {
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		entries = append(entries, &gtsmodel.ListEntry{
+			ID:       entryID,
+			ListID:   listID,
+			FollowID: follow.ID,
+		})
+	}
+
+	if err := p.db.PutListEntries(ctx, entries); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("ListAccountsAdd: db error putting list entries: %s", err))
+	}
+
+	return nil
+}
+
+func (p *processor) ListAccountsRemove(ctx context.Context, authed *oauth.Auth, listID string, accountIDs []string) gtserror.WithCode {
+	if _, errWithCode := p.getOwnList(ctx, authed.Account, listID); errWithCode != nil {
+		return errWithCode
+	}
+
+	if len(accountIDs) == 0 {
+		err := errors.New("no account ids given")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	followIDs := make([]string, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		follow, errWithCode := p.getListFollow(ctx, authed.Account, accountID)
+		if errWithCode != nil {
+			return errWithCode
+		}
+
+		// accounts which aren't followed can't be in the list anyway
+		if follow != nil {
+			followIDs = append(followIDs, follow.ID)
+		}
+	}
+
+	if err := p.db.DeleteListEntries(ctx, listID, followIDs); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("ListAccountsRemove: db error deleting list entries: %s", err))
+	}
+
+	// take the removed accounts' statuses out of the list timeline
+	for _, accountID := range accountIDs {
+		if err := p.listTimelines.WipeItemsFromAccountID(ctx, listID, accountID); err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("ListAccountsRemove: error wiping statuses of %s from list timeline: %s", accountID, err))
+		}
+	}
+
+	return nil
+}
+
+// getOwnList returns the list with the given ID, if it belongs to the given account.
+func (p *processor) getOwnList(ctx context.Context, account *gtsmodel.Account, listID string) (*gtsmodel.List, gtserror.WithCode) {
+	list, err := p.db.GetListByID(ctx, listID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("list %s not found", listID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting list %s: %s", listID, err))
+	}
+
+	// other accounts' lists are private, so pretend they don't exist
+	if list.AccountID != account.ID {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("list %s does not belong to account %s", listID, account.ID))
+	}
+
+	return list, nil
+}
+
+// getListFollow returns the follow of the account with the given ID by the given account,
+// or nil if it doesn't follow it. An error is returned if there's no account with the ID.
+func (p *processor) getListFollow(ctx context.Context, account *gtsmodel.Account, targetAccountID string) (*gtsmodel.Follow, gtserror.WithCode) {
+	if _, err := p.db.GetAccountByID(ctx, targetAccountID); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("account %s not found", targetAccountID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting account %s: %s", targetAccountID, err))
+	}
+
+	follow := &gtsmodel.Follow{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: account.ID},
+		{Key: "target_account_id", Value: targetAccountID},
+	}, follow); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, nil
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting follow of account %s: %s", targetAccountID, err))
+	}
+
+	return follow, nil
+}
+
+// apiList converts the given list into its api representation.
+func (p *processor) apiList(ctx context.Context, list *gtsmodel.List) (*apimodel.List, gtserror.WithCode) {
+	apiList, err := p.tc.ListToAPIList(ctx, list)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting list %s: %s", list.ID, err))
+	}
+
+	return apiList, nil
+}
+
+// validateListTitle returns the given list title with surrounding whitespace removed,
+// or an error if it's empty or too long.
+func validateListTitle(title string) (string, gtserror.WithCode) {
+	title = strings.TrimSpace(title)
+
+	if title == "" {
+		err := errors.New("list title must not be empty")
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if length := len([]rune(title)); length > listTitleMaxChars {
+		err := fmt.Errorf("list title must be at most %d characters, provided title was %d characters", listTitleMaxChars, length)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return title, nil
+}
+
+// validateListRepliesPolicy parses the given list replies policy, or returns an error if it isn't a valid one.
+func validateListRepliesPolicy(policy string) (gtsmodel.ListRepliesPolicy, gtserror.WithCode) {
+	switch repliesPolicy := gtsmodel.ListRepliesPolicy(policy); repliesPolicy {
+	case gtsmodel.ListRepliesPolicyFollowed, gtsmodel.ListRepliesPolicyList, gtsmodel.ListRepliesPolicyNone:
+		return repliesPolicy, nil
+	default:
+		err := fmt.Errorf("list replies policy must be one of %s, %s or %s", gtsmodel.ListRepliesPolicyFollowed, gtsmodel.ListRepliesPolicyList, gtsmodel.ListRepliesPolicyNone)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+}
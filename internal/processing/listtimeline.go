@@ -0,0 +1,299 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+)
+
+// List timelines are kept by a timeline manager of their own, in which
+// the timeline of each list is keyed by the list ID rather than an account ID.
+
+// ListGrabFunction returns a function that satisfies the GrabFunction interface in internal/timeline, for list timelines.
+func ListGrabFunction(database db.DB) timeline.GrabFunction {
+	return func(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]timeline.Timelineable, bool, error) {
+		statuses, err := database.GetListTimeline(ctx, listID, maxID, sinceID, minID, limit)
+		if err != nil {
+			if err == db.ErrNoEntries {
+				return nil, true, nil // we just don't have enough statuses left in the db so return stop = true
+			}
+			return nil, false, fmt.Errorf("listGrabFunction: error getting statuses from db: %s", err)
+		}
+
+		if len(statuses) == 0 {
+			return nil, true, nil
+		}
+
+		items := []timeline.Timelineable{}
+		for _, s := range statuses {
+			items = append(items, s)
+		}
+
+		return items, false, nil
+	}
+}
+
+// ListFilterFunction returns a function that satisfies the FilterFunction interface in internal/timeline, for list timelines.
+func ListFilterFunction(database db.DB, filter visibility.Filter) timeline.FilterFunction {
+	return func(ctx context.Context, listID string, item timeline.Timelineable) (shouldIndex bool, err error) {
+		status, ok := item.(*gtsmodel.Status)
+		if !ok {
+			return false, errors.New("listFilterFunction: could not convert item to *gtsmodel.Status")
+		}
+
+		list, err := database.GetListByID(ctx, listID)
+		if err != nil {
+			return false, fmt.Errorf("listFilterFunction: error getting list with id %s", listID)
+		}
+
+		listAccount, err := database.GetAccountByID(ctx, list.AccountID)
+		if err != nil {
+			return false, fmt.Errorf("listFilterFunction: error getting account with id %s", list.AccountID)
+		}
+
+		// we don't return errors here because we want to just skip this item if something goes wrong
+		timelineable, err := filter.StatusHometimelineable(ctx, status, listAccount)
+		if err != nil {
+			log.Warnf("error checking hometimelineability of status %s for list %s: %s", status.ID, listID, err)
+			return false, nil
+		}
+		if !timelineable {
+			return false, nil
+		}
+
+		permitted, err := listRepliesPermitted(ctx, database, list, status)
+		if err != nil {
+			log.Warnf("error checking replies policy of list %s for status %s: %s", listID, status.ID, err)
+			return false, nil
+		}
+
+		return permitted, nil
+	}
+}
+
+// ListPrepareFunction returns a function that satisfies the PrepareFunction interface in internal/timeline, for list timelines.
+func ListPrepareFunction(database db.DB, tc typeutils.TypeConverter) timeline.PrepareFunction {
+	return func(ctx context.Context, listID string, itemID string) (timeline.Preparable, error) {
+		status, err := database.GetStatusByID(ctx, itemID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting status with id %s", itemID)
+		}
+
+		list, err := database.GetListByID(ctx, listID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting list with id %s", listID)
+		}
+
+		listAccount, err := database.GetAccountByID(ctx, list.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting account with id %s", list.AccountID)
+		}
+
+		return tc.StatusToAPIStatus(ctx, status, listAccount)
+	}
+}
+
+// listRepliesPermitted returns true if the replies policy of the given list permits the given status
+// to be shown in the list timeline. The status should already be hometimelineable for the list owner.
+func listRepliesPermitted(ctx context.Context, database db.DB, list *gtsmodel.List, status *gtsmodel.Status) (bool, error) {
+	if status.InReplyToAccountID == "" || status.InReplyToAccountID == status.AccountID {
+		// not a reply to anyone else
+		return true, nil
+	}
+
+	switch list.RepliesPolicy {
+	case gtsmodel.ListRepliesPolicyNone:
+		return false, nil
+	case gtsmodel.ListRepliesPolicyList:
+		if status.InReplyToAccountID == list.AccountID {
+			return true, nil
+		}
+
+		follow := &gtsmodel.Follow{}
+		if err := database.GetWhere(ctx, []db.Where{
+			{Key: "account_id", Value: list.AccountID},
+			{Key: "target_account_id", Value: status.InReplyToAccountID},
+		}, follow); err != nil {
+			if err == db.ErrNoEntries {
+				return false, nil
+			}
+			return false, fmt.Errorf("listRepliesPermitted: error getting follow of replied to account %s: %s", status.InReplyToAccountID, err)
+		}
+
+		lists, err := database.GetListsContainingFollowID(ctx, follow.ID)
+		if err != nil && err != db.ErrNoEntries {
+			return false, fmt.Errorf("listRepliesPermitted: error getting lists of follow %s: %s", follow.ID, err)
+		}
+
+		for _, l := range lists {
+			if l.ID == list.ID {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	default:
+		// hometimelineable statuses only reply to followed accounts
+		return true, nil
+	}
+}
+
+func (p *processor) ListTimelineGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	ctx = cache.WithRequestCache(ctx)
+
+	if _, errWithCode := p.getOwnList(ctx, authed.Account, listID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	preparedItems, err := p.listTimelines.GetTimeline(ctx, listID, maxID, sinceID, minID, limit, false)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(preparedItems)
+
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	filters, err := statusfilter.Get(ctx, p.db, p.tc, authed.Account, gtsmodel.FilterContextHome)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := []interface{}{}
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+	for i, item := range preparedItems {
+		if i == count-1 {
+			nextMaxIDValue = item.GetID()
+		}
+
+		if i == 0 {
+			prevMinIDValue = item.GetID()
+		}
+
+		// statuses hidden by filters still count for paging
+		if apiStatus, ok := item.(*apimodel.Status); ok {
+			filtered := filters.Apply(apiStatus)
+			if filtered == nil {
+				continue
+			}
+			item = filtered
+		}
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/timelines/list/" + listID,
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
+// timelineStatusForLists puts the given status in the timelines of any lists of the
+// timeline owner account that its author is in, and streams it to the owner. The status
+// should already have been found to belong in the HOME timeline of the owner.
+func (p *processor) timelineStatusForLists(ctx context.Context, status *gtsmodel.Status, timelineAccount *gtsmodel.Account) error {
+	if status.AccountID == timelineAccount.ID {
+		// accounts can't put themselves in their lists
+		return nil
+	}
+
+	follow := &gtsmodel.Follow{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: timelineAccount.ID},
+		{Key: "target_account_id", Value: status.AccountID},
+	}, follow); err != nil {
+		if err == db.ErrNoEntries {
+			// the status is only in the home timeline because it mentions the owner
+			return nil
+		}
+		return fmt.Errorf("timelineStatusForLists: error getting follow of %s: %s", status.AccountID, err)
+	}
+
+	lists, err := p.db.GetListsContainingFollowID(ctx, follow.ID)
+	if err != nil && err != db.ErrNoEntries {
+		return fmt.Errorf("timelineStatusForLists: error getting lists of follow %s: %s", follow.ID, err)
+	}
+
+	for _, list := range lists {
+		permitted, err := listRepliesPermitted(ctx, p.db, list, status)
+		if err != nil {
+			return fmt.Errorf("timelineStatusForLists: %s", err)
+		}
+		if !permitted {
+			continue
+		}
+
+		inserted, err := p.listTimelines.IngestAndPrepare(ctx, status, list.ID)
+		if err != nil {
+			return fmt.Errorf("timelineStatusForLists: error ingesting status %s into list %s: %s", status.ID, list.ID, err)
+		}
+		if !inserted {
+			continue
+		}
+
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, timelineAccount)
+		if err != nil {
+			return fmt.Errorf("timelineStatusForLists: error converting status %s to frontend representation: %s", status.ID, err)
+		}
+
+		if err := p.streamUpdateToAccount(ctx, apiStatus, timelineAccount, stream.ListTimeline(list.ID)); err != nil {
+			return fmt.Errorf("timelineStatusForLists: error streaming status %s: %s", status.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// wipeListTimelinesOfAccountID removes all items by the given accountID from
+// the list timelines of the given timelineAccountID, eg., after a block.
+func (p *processor) wipeListTimelinesOfAccountID(ctx context.Context, timelineAccountID string, accountID string) error {
+	lists, err := p.db.GetListsForAccountID(ctx, timelineAccountID)
+	if err != nil && err != db.ErrNoEntries {
+		return fmt.Errorf("wipeListTimelinesOfAccountID: error getting lists of %s: %s", timelineAccountID, err)
+	}
+
+	for _, list := range lists {
+		if err := p.listTimelines.WipeItemsFromAccountID(ctx, list.ID, accountID); err != nil {
+			return fmt.Errorf("wipeListTimelinesOfAccountID: error wiping list %s: %s", list.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) MailGatewayPost(ctx context.Context, message io.Reader) (*apimodel.Status, gtserror.WithCode) {
+	msg, err := email.ParseInbound(message)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	gatewayDomain := config.GetMailGatewayDomain()
+	token := postByMailToken(msg.Recipients, gatewayDomain)
+	if token == "" {
+		err := fmt.Errorf("message wasn't sent to an address at %s", gatewayDomain)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.db.GetUserByPostByMailToken(ctx, token)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			err := fmt.Errorf("no user can post by email with the address %s@%s", token, gatewayDomain)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MailGatewayPost: db error getting user: %s", err))
+	}
+
+	// knowing the secret address isn't enough by itself,
+	// the message must also come from the user's own address
+	if user.Email == "" || user.ConfirmedAt.IsZero() || msg.From != strings.ToLower(user.Email) {
+		err := errors.New("message wasn't sent from the confirmed email address of the user it was sent to")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	// the from address is trivial to forge though, so
+	// the mail service must vouch that it's genuine too
+	if authservID := config.GetMailGatewayAuthservID(); !msg.Authenticated(authservID) {
+		err := fmt.Errorf("message didn't pass dmarc, dkim or spf for the domain it was sent from, according to %s", authservID)
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	if *user.Disabled || !*user.Approved {
+		err := errors.New("user is disabled or not yet approved")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	account, err := p.db.GetAccountByID(ctx, user.AccountID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MailGatewayPost: db error getting account: %s", err))
+	}
+
+	if !account.SuspendedAt.IsZero() {
+		err := errors.New("account is suspended")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	// mail services may deliver the same message more than once,
+	// eg., if they didn't get a response in time, so return the
+	// status that was already posted for the message if there is one
+	messageKey := user.ID + " " + msg.ID
+	if msg.ID != "" {
+		if statusID, ok := p.mailGatewayMessages.Get(messageKey); ok {
+			return p.statusProcessor.Get(ctx, account, statusID)
+		}
+	}
+
+	text := msg.Text
+	if msg.Subject != "" {
+		text = strings.TrimSpace(msg.Subject + "\n\n" + text)
+	}
+
+	if text == "" {
+		err := errors.New("message has no subject or text")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if length, maxChars := len([]rune(text)), config.GetStatusesMaxChars(); length > maxChars {
+		err := fmt.Errorf("status too long, %d characters provided but limit is %d", length, maxChars)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	application := &gtsmodel.Application{}
+	if err := p.db.GetByID(ctx, user.PostByMailApplicationID, application); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MailGatewayPost: db error getting post by email application: %s", err))
+	}
+
+	// visibility, language and format are left to the account's defaults
+	apiStatus, errWithCode := p.statusProcessor.Create(ctx, account, application, &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status: text,
+		},
+	})
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if msg.ID != "" {
+		p.mailGatewayMessages.Set(messageKey, apiStatus.ID)
+	}
+
+	return apiStatus, nil
+}
+
+// postByMailToken returns the post by email token from the first
+// of the given recipient addresses at the mail gateway domain.
+func postByMailToken(recipients []string, gatewayDomain string) string {
+	for _, recipient := range recipients {
+		i := strings.LastIndex(recipient, "@")
+		if i == -1 {
+			continue
+		}
+
+		if strings.EqualFold(recipient[i+1:], gatewayDomain) {
+			return recipient[:i]
+		}
+	}
+	return ""
+}
@@ -23,6 +23,7 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -42,6 +43,14 @@ func (p *processor) FileGet(ctx context.Context, authed *oauth.Auth, form *apimo
 	return p.mediaProcessor.GetFile(ctx, authed.Account, form)
 }
 
-func (p *processor) CustomEmojisGet(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode) {
-	return p.mediaProcessor.GetCustomEmojis(ctx)
+func (p *processor) CustomEmojisGet(ctx context.Context, authed *oauth.Auth, shortcode string, category string, limit int, frequentlyUsed bool) ([]*apimodel.Emoji, gtserror.WithCode) {
+	var account *gtsmodel.Account
+	if frequentlyUsed {
+		account = authed.Account
+	}
+	return p.mediaProcessor.GetCustomEmojis(ctx, account, shortcode, category, limit)
+}
+
+func (p *processor) CustomEmojisSearch(ctx context.Context, query string, limit int) ([]*apimodel.Emoji, gtserror.WithCode) {
+	return p.mediaProcessor.SearchCustomEmojis(ctx, query, limit)
 }
@@ -29,6 +29,13 @@ func (p *processor) Delete(ctx context.Context, mediaAttachmentID string) gtserr
 		}
 	}
 
+	// delete the medium from storage
+	if attachment.Medium.Path != "" {
+		if err := p.storage.Delete(ctx, attachment.Medium.Path); err != nil {
+			errs = append(errs, fmt.Sprintf("remove medium at path %s: %s", attachment.Medium.Path, err))
+		}
+	}
+
 	// delete the file from storage
 	if attachment.File.Path != "" {
 		if err := p.storage.Delete(ctx, attachment.File.Path); err != nil {
@@ -25,17 +25,100 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
-func (p *processor) GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode) {
-	emojis, err := p.db.GetUseableEmojis(ctx)
-	if err != nil {
-		if err != db.ErrNoEntries {
-			return nil, gtserror.NewErrorNotFound(fmt.Errorf("db error retrieving custom emojis: %s", err))
+func (p *processor) GetCustomEmojis(ctx context.Context, account *gtsmodel.Account, shortcode string, category string, limit int) ([]*apimodel.Emoji, gtserror.WithCode) {
+	var categoryID string
+	if category != "" {
+		c, err := p.db.GetEmojiCategoryByName(ctx, category)
+		if err != nil {
+			if err != db.ErrNoEntries {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error retrieving emoji category: %s", err))
+			}
+			// no such category, so no emojis in it either
+			return []*apimodel.Emoji{}, nil
+		}
+		categoryID = c.ID
+	}
+
+	if account == nil {
+		emojis, err := p.db.GetUseableEmojis(ctx, shortcode, categoryID, limit)
+		if err != nil {
+			if err != db.ErrNoEntries {
+				return nil, gtserror.NewErrorNotFound(fmt.Errorf("db error retrieving custom emojis: %s", err))
+			}
+		}
+
+		return p.emojisToAPIEmojis(ctx, emojis), nil
+	}
+
+	// we need to rank all matching emojis before we can
+	// apply the limit, so fetch them all up front
+	emojis, err := p.db.GetUseableEmojis(ctx, shortcode, categoryID, 0)
+	if err != nil && err != db.ErrNoEntries {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("db error retrieving custom emojis: %s", err))
+	}
+
+	frequentIDs, err := p.db.GetFrequentlyUsedEmojiIDs(ctx, account.ID, 0)
+	if err != nil && err != db.ErrNoEntries {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error retrieving frequently used emojis: %s", err))
+	}
+
+	emojis = orderByFrequency(emojis, frequentIDs)
+	if limit > 0 && len(emojis) > limit {
+		emojis = emojis[:limit]
+	}
+
+	return p.emojisToAPIEmojis(ctx, emojis), nil
+}
+
+// orderByFrequency returns the given alphabetically ordered emojis with those in
+// frequentIDs moved to the front, in the order they appear in frequentIDs.
+func orderByFrequency(emojis []*gtsmodel.Emoji, frequentIDs []string) []*gtsmodel.Emoji {
+	if len(frequentIDs) == 0 {
+		return emojis
+	}
+
+	rank := make(map[string]int, len(frequentIDs))
+	for i, id := range frequentIDs {
+		rank[id] = i
+	}
+
+	frequent := make([]*gtsmodel.Emoji, len(frequentIDs))
+	rest := make([]*gtsmodel.Emoji, 0, len(emojis))
+	for _, e := range emojis {
+		if i, ok := rank[e.ID]; ok {
+			frequent[i] = e
+			continue
 		}
+		rest = append(rest, e)
 	}
 
+	ordered := make([]*gtsmodel.Emoji, 0, len(emojis))
+	for _, e := range frequent {
+		// frequently used emojis that didn't match
+		// the shortcode or category filters are nil
+		if e != nil {
+			ordered = append(ordered, e)
+		}
+	}
+	return append(ordered, rest...)
+}
+
+func (p *processor) SearchCustomEmojis(ctx context.Context, query string, limit int) ([]*apimodel.Emoji, gtserror.WithCode) {
+	emojis, err := p.db.SearchUseableEmojis(ctx, query, limit)
+	if err != nil && err != db.ErrNoEntries {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error searching custom emojis: %s", err))
+	}
+
+	return p.emojisToAPIEmojis(ctx, emojis), nil
+}
+
+// emojisToAPIEmojis converts the given emojis to their API representation,
+// logging and leaving out any that can't be converted.
+func (p *processor) emojisToAPIEmojis(ctx context.Context, emojis []*gtsmodel.Emoji) []*apimodel.Emoji {
 	apiEmojis := make([]*apimodel.Emoji, 0, len(emojis))
 	for _, gtsEmoji := range emojis {
 		apiEmoji, err := p.tc.EmojiToAPIEmoji(ctx, gtsEmoji)
@@ -46,5 +129,5 @@ func (p *processor) GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gts
 		apiEmojis = append(apiEmojis, &apiEmoji)
 	}
 
-	return apiEmojis, nil
+	return apiEmojis
 }
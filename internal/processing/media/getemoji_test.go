@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type GetEmojiTestSuite struct {
@@ -30,13 +31,65 @@ type GetEmojiTestSuite struct {
 }
 
 func (suite *GetEmojiTestSuite) TestGetCustomEmojis() {
-	emojis, err := suite.mediaProcessor.GetCustomEmojis(context.Background())
+	emojis, err := suite.mediaProcessor.GetCustomEmojis(context.Background(), nil, "", "", 0)
 
 	suite.NoError(err)
 	suite.Equal(1, len(emojis))
 	suite.Equal("rainbow", emojis[0].Shortcode)
 }
 
+func (suite *GetEmojiTestSuite) TestGetCustomEmojisFiltered() {
+	emojis, err := suite.mediaProcessor.GetCustomEmojis(context.Background(), nil, "bow", "reactions", 1)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	emojis, err = suite.mediaProcessor.GetCustomEmojis(context.Background(), nil, "nope", "", 0)
+	suite.NoError(err)
+	suite.Empty(emojis)
+}
+
+func (suite *GetEmojiTestSuite) TestGetCustomEmojisUnknownCategory() {
+	emojis, err := suite.mediaProcessor.GetCustomEmojis(context.Background(), nil, "", "does not exist", 0)
+	suite.NoError(err)
+	suite.Empty(emojis)
+}
+
+func (suite *GetEmojiTestSuite) TestGetCustomEmojisFrequentlyUsed() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	// nothing used yet, so plain alphabetical order
+	emojis, err := suite.mediaProcessor.GetCustomEmojis(ctx, account, "", "", 0)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	suite.NoError(suite.db.PutAccountEmojiUsage(ctx, account.ID, []string{testrig.NewTestEmojis()["rainbow"].ID}))
+
+	emojis, err = suite.mediaProcessor.GetCustomEmojis(ctx, account, "rain", "", 1)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	// frequently used emojis that don't match the filters are left out
+	emojis, err = suite.mediaProcessor.GetCustomEmojis(ctx, account, "nope", "", 0)
+	suite.NoError(err)
+	suite.Empty(emojis)
+}
+
+func (suite *GetEmojiTestSuite) TestSearchCustomEmojis() {
+	emojis, err := suite.mediaProcessor.SearchCustomEmojis(context.Background(), "rain", 5)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+	suite.Equal("rainbow", emojis[0].Shortcode)
+
+	emojis, err = suite.mediaProcessor.SearchCustomEmojis(context.Background(), "nope", 5)
+	suite.NoError(err)
+	suite.NotNil(emojis)
+	suite.Empty(emojis)
+}
+
 func TestGetEmojiTestSuite(t *testing.T) {
 	suite.Run(t, &GetEmojiTestSuite{})
 }
@@ -109,6 +109,13 @@ func (p *processor) getAttachmentContent(ctx context.Context, requestingAccount
 		attachmentContent.ContentType = a.Thumbnail.ContentType
 		attachmentContent.ContentLength = int64(a.Thumbnail.FileSize)
 		storagePath = a.Thumbnail.Path
+	case media.SizeMedium:
+		if a.Medium.Path == "" {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("attachment %s has no medium version", wantedMediaID))
+		}
+		attachmentContent.ContentType = a.Medium.ContentType
+		attachmentContent.ContentLength = int64(a.Medium.FileSize)
+		storagePath = a.Medium.Path
 	default:
 		return nil, gtserror.NewErrorNotFound(fmt.Errorf("media size %s not recognized for attachment", mediaSize))
 	}
@@ -136,10 +143,10 @@ func (p *processor) getAttachmentContent(ctx context.Context, requestingAccount
 	var data media.DataFunc
 	var postDataCallback media.PostDataCallbackFunc
 
-	if mediaSize == media.SizeSmall {
-		// if it's the thumbnail that's requested then the user will have to wait a bit while we process the
-		// large version and derive a thumbnail from it, so use the normal recaching procedure: fetch the media,
-		// process it, then return the thumbnail data
+	if mediaSize != media.SizeOriginal {
+		// if it's the thumbnail or medium that's requested then the user will have to wait a bit while we process
+		// the large version and derive smaller versions from it, so use the normal recaching procedure: fetch the
+		// media, process it, then return the derived data
 		data = func(innerCtx context.Context) (io.ReadCloser, int64, error) {
 			transport, err := p.transportController.NewTransportForUsername(innerCtx, requestingUsername)
 			if err != nil {
@@ -214,8 +221,8 @@ func (p *processor) getAttachmentContent(ctx context.Context, requestingAccount
 		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error recaching media: %s", err))
 	}
 
-	// if it's the thumbnail, stream the processed thumbnail from storage, after waiting for processing to finish
-	if mediaSize == media.SizeSmall {
+	// if it's the thumbnail or medium, stream it from storage, after waiting for processing to finish
+	if mediaSize != media.SizeOriginal {
 		// below function call blocks until all processing on the attachment has finished...
 		if _, err := processingMedia.LoadAttachment(ctx); err != nil {
 			return nil, gtserror.NewErrorNotFound(fmt.Errorf("error loading recached attachment: %s", err))
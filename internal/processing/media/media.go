@@ -42,7 +42,13 @@ type Processor interface {
 	Unattach(ctx context.Context, account *gtsmodel.Account, mediaAttachmentID string) (*apimodel.Attachment, gtserror.WithCode)
 	// GetFile retrieves a file from storage and streams it back to the caller via an io.reader embedded in *apimodel.Content.
 	GetFile(ctx context.Context, account *gtsmodel.Account, form *apimodel.GetContentRequestForm) (*apimodel.Content, gtserror.WithCode)
-	GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode)
+	// GetCustomEmojis returns the custom emojis useable on this instance. If shortcode is set, only emojis whose shortcode
+	// contains it are returned; if category is set, only emojis in the category with that name. A limit of 0 means no limit.
+	// If account is set, the emojis that account has used most frequently are returned first, followed by the rest alphabetically.
+	GetCustomEmojis(ctx context.Context, account *gtsmodel.Account, shortcode string, category string, limit int) ([]*apimodel.Emoji, gtserror.WithCode)
+	// SearchCustomEmojis returns up to limit custom emojis useable on this instance whose shortcode contains query,
+	// with emojis whose shortcode starts with query first.
+	SearchCustomEmojis(ctx context.Context, query string, limit int) ([]*apimodel.Emoji, gtserror.WithCode)
 	GetMedia(ctx context.Context, account *gtsmodel.Account, mediaAttachmentID string) (*apimodel.Attachment, gtserror.WithCode)
 	Update(ctx context.Context, account *gtsmodel.Account, mediaAttachmentID string, form *apimodel.AttachmentUpdateRequest) (*apimodel.Attachment, gtserror.WithCode)
 }
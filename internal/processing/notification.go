@@ -20,15 +20,21 @@ package processing
 
 import (
 	"context"
+	"fmt"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 func (p *processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, excludeTypes []string, limit int, maxID string, sinceID string) (*apimodel.PageableResponse, gtserror.WithCode) {
+	ctx = cache.WithRequestCache(ctx)
+
 	notifs, err := p.db.GetNotifications(ctx, authed.Account.ID, excludeTypes, limit, maxID, sinceID)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -40,10 +46,20 @@ func (p *processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ex
 		return util.EmptyPageableResponse(), nil
 	}
 
+	filters, err := statusfilter.Get(ctx, p.db, p.tc, authed.Account, gtsmodel.FilterContextNotifications)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	items := []interface{}{}
+	unreadIDs := []string{}
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
 	for i, n := range notifs {
+		if n.Read == nil || !*n.Read {
+			unreadIDs = append(unreadIDs, n.ID)
+		}
+
 		item, err := p.tc.NotificationToAPINotification(ctx, n)
 		if err != nil {
 			log.Debugf("got an error converting a notification to api, will skip it: %s", err)
@@ -58,9 +74,22 @@ func (p *processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ex
 			prevMinIDValue = item.GetID()
 		}
 
+		// notifications of statuses hidden by filters still count for paging
+		if item.Status != nil {
+			if item.Status = filters.Apply(item.Status); item.Status == nil {
+				continue
+			}
+		}
+
 		items = append(items, item)
 	}
 
+	// the notifications have now been shown to the
+	// account owner, so they're eligible for pruning
+	if err := p.db.MarkNotificationsRead(ctx, unreadIDs); err != nil {
+		log.Errorf("NotificationsGet: error marking notifications as read: %s", err)
+	}
+
 	return util.PackagePageableResponse(util.PageableResponseParams{
 		Items:          items,
 		Path:           "api/v1/notifications",
@@ -71,8 +100,23 @@ func (p *processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ex
 	})
 }
 
-func (p *processor) NotificationsClear(ctx context.Context, authed *oauth.Auth) gtserror.WithCode {
-	err := p.db.ClearNotifications(ctx, authed.Account.ID)
+func (p *processor) NotificationsClear(ctx context.Context, authed *oauth.Auth, types []string) gtserror.WithCode {
+	for _, t := range types {
+		switch gtsmodel.NotificationType(t) {
+		case gtsmodel.NotificationFollow,
+			gtsmodel.NotificationFollowRequest,
+			gtsmodel.NotificationMention,
+			gtsmodel.NotificationReblog,
+			gtsmodel.NotificationFave,
+			gtsmodel.NotificationPoll,
+			gtsmodel.NotificationStatus:
+			continue
+		}
+		err := fmt.Errorf("notification type %q was not recognized", t)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	err := p.db.ClearNotifications(ctx, authed.Account.ID, types)
 	if err != nil {
 		return gtserror.NewErrorInternalError(err)
 	}
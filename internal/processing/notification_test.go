@@ -24,6 +24,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type NotificationTestSuite struct {
@@ -48,6 +50,39 @@ func (suite *NotificationTestSuite) TestGetNotifications() {
 	suite.Equal(`<http://localhost:8080/api/v1/notifications?limit=10&max_id=01F8Q0ANPTWW10DAKTX7BRPBJP>; rel="next", <http://localhost:8080/api/v1/notifications?limit=10&since_id=01F8Q0ANPTWW10DAKTX7BRPBJP>; rel="prev"`, notifsResponse.LinkHeader)
 }
 
+func (suite *NotificationTestSuite) TestGetNotificationsMarksRead() {
+	testNotification := testrig.NewTestNotifications()["local_account_1_like"]
+
+	_, err := suite.processor.NotificationsGet(context.Background(), suite.testAutheds["local_account_1"], []string{}, 10, "", "")
+	suite.NoError(err)
+
+	dbNotification, dbErr := suite.db.GetNotification(context.Background(), testNotification.ID)
+	suite.NoError(dbErr)
+	suite.True(*dbNotification.Read)
+}
+
+func (suite *NotificationTestSuite) TestClearNotificationsByType() {
+	testNotification := testrig.NewTestNotifications()["local_account_1_like"]
+
+	// the only notification is a fave, so clearing mentions should leave it alone
+	err := suite.processor.NotificationsClear(context.Background(), suite.testAutheds["local_account_1"], []string{"mention"})
+	suite.NoError(err)
+
+	_, dbErr := suite.db.GetNotification(context.Background(), testNotification.ID)
+	suite.NoError(dbErr)
+
+	err = suite.processor.NotificationsClear(context.Background(), suite.testAutheds["local_account_1"], []string{"favourite"})
+	suite.NoError(err)
+
+	_, dbErr = suite.db.GetNotification(context.Background(), testNotification.ID)
+	suite.ErrorIs(dbErr, db.ErrNoEntries)
+}
+
+func (suite *NotificationTestSuite) TestClearNotificationsUnknownType() {
+	err := suite.processor.NotificationsClear(context.Background(), suite.testAutheds["local_account_1"], []string{"boop"})
+	suite.EqualError(err, `notification type "boop" was not recognized`)
+}
+
 func TestNotificationTestSuite(t *testing.T) {
 	suite.Run(t, &NotificationTestSuite{})
 }
@@ -0,0 +1,374 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) PollGet(ctx context.Context, authed *oauth.Auth, pollID string) (*apimodel.Poll, gtserror.WithCode) {
+	poll, status, errWithCode := p.getVisiblePoll(ctx, authed.Account, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiPoll(ctx, poll, status, authed.Account)
+}
+
+func (p *processor) PollVote(ctx context.Context, authed *oauth.Auth, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode) {
+	poll, status, errWithCode := p.getVisiblePoll(ctx, authed.Account, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if poll.Expired(time.Now()) {
+		err := errors.New("poll has already ended")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if status.AccountID == authed.Account.ID {
+		err := errors.New("you can't vote in your own poll")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if len(choices) == 0 {
+		err := errors.New("no choices given")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if !*poll.Multiple && len(choices) > 1 {
+		err := errors.New("only one choice can be given in this poll")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	chosen := make(map[int]bool, len(choices))
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(poll.Options) {
+			err := fmt.Errorf("choice %d is out of range, this poll has %d options", choice, len(poll.Options))
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		if chosen[choice] {
+			err := fmt.Errorf("choice %d was given more than once", choice)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		chosen[choice] = true
+	}
+
+	existing, err := p.db.GetPollVotesBy(ctx, poll.ID, authed.Account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("PollVote: db error getting existing votes: %s", err))
+	}
+	if len(existing) != 0 {
+		err := errors.New("you have already voted in this poll")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	votes := make([]*gtsmodel.PollVote, 0, len(choices))
+	for _, choice := range choices {
+		voteID, err := id.NewULID()
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		votes = append(votes, &gtsmodel.PollVote{
+			ID:        voteID,
+			URI:       authed.Account.URI + "#votes/" + voteID,
+			PollID:    poll.ID,
+			AccountID: authed.Account.ID,
+			Account:   authed.Account,
+			Choice:    choice,
+		})
+	}
+
+	if err := p.db.PutPollVotes(ctx, votes); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := errors.New("you have already voted in this poll")
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("PollVote: db error putting votes: %s", err))
+	}
+
+	if *status.Local {
+		if err := p.recountPoll(ctx, poll); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	} else {
+		// we only see our own votes in remote polls, so just add
+		// them to the counts until the author sends an update
+		for _, choice := range choices {
+			poll.Votes[choice]++
+		}
+		poll.Voters++
+		if err := p.db.UpdatePoll(ctx, poll, "votes", "voters"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("PollVote: db error updating poll: %s", err))
+		}
+	}
+
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ActivityQuestion,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       votes,
+		OriginAccount:  authed.Account,
+	})
+
+	return p.apiPoll(ctx, poll, status, authed.Account)
+}
+
+func (p *processor) PollsExpire(ctx context.Context, now time.Time, batchSize int) (int, error) {
+	var expired int
+	for {
+		polls, err := p.db.GetExpiredPolls(ctx, now, batchSize)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return expired, fmt.Errorf("PollsExpire: db error getting expired polls: %s", err)
+		}
+
+		var closed int
+		for _, poll := range polls {
+			poll.ClosedAt = now
+			if err := p.db.UpdatePoll(ctx, poll, "closed_at"); err != nil {
+				log.Errorf("PollsExpire: error closing poll %s: %s", poll.ID, err)
+				continue
+			}
+			closed++
+
+			status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+			if err != nil {
+				log.Errorf("PollsExpire: error getting status of poll %s: %s", poll.ID, err)
+				continue
+			}
+
+			if err := p.notifyPollEnded(ctx, poll, status); err != nil {
+				log.Errorf("PollsExpire: error notifying about end of poll %s: %s", poll.ID, err)
+			}
+
+			if *status.Local && status.Account != nil {
+				// tell everyone else the final results
+				p.clientWorker.Queue(messages.FromClientAPI{
+					APObjectType:   ap.ActivityQuestion,
+					APActivityType: ap.ActivityUpdate,
+					GTSModel:       status,
+					OriginAccount:  status.Account,
+				})
+			}
+		}
+		expired += closed
+
+		// stop once we've run out of polls, or if
+		// none of this batch could be closed, since
+		// we'd just get the same batch again next time
+		if len(polls) < batchSize || closed == 0 {
+			return expired, nil
+		}
+	}
+}
+
+// getVisiblePoll gets the poll with the given ID, and the status it's attached to,
+// as long as that status is visible to the given account.
+func (p *processor) getVisiblePoll(ctx context.Context, account *gtsmodel.Account, pollID string) (*gtsmodel.Poll, *gtsmodel.Status, gtserror.WithCode) {
+	poll, err := p.db.GetPollByID(ctx, pollID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, nil, gtserror.NewErrorNotFound(fmt.Errorf("poll %s not found", pollID))
+		}
+		return nil, nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting poll %s: %s", pollID, err))
+	}
+
+	status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, nil, gtserror.NewErrorNotFound(fmt.Errorf("status of poll %s not found", pollID))
+		}
+		return nil, nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting status of poll %s: %s", pollID, err))
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, status, account)
+	if err != nil {
+		return nil, nil, gtserror.NewErrorNotFound(fmt.Errorf("error seeing if status %s is visible: %s", status.ID, err))
+	}
+	if !visible {
+		return nil, nil, gtserror.NewErrorNotFound(errors.New("poll is not visible"))
+	}
+
+	return poll, status, nil
+}
+
+// apiPoll converts the given poll into its api representation, as seen by the given account.
+func (p *processor) apiPoll(ctx context.Context, poll *gtsmodel.Poll, status *gtsmodel.Status, account *gtsmodel.Account) (*apimodel.Poll, gtserror.WithCode) {
+	status.Poll = poll
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %s", status.ID, err))
+	}
+	return apiStatus.Poll, nil
+}
+
+// recountPoll counts the votes stored for the given local poll, and updates it with the results.
+func (p *processor) recountPoll(ctx context.Context, poll *gtsmodel.Poll) error {
+	votes, voters, err := p.db.CountPollVotes(ctx, poll.ID, len(poll.Options))
+	if err != nil {
+		return fmt.Errorf("recountPoll: db error counting votes in poll %s: %s", poll.ID, err)
+	}
+
+	poll.Votes = votes
+	poll.Voters = voters
+	if err := p.db.UpdatePoll(ctx, poll, "votes", "voters"); err != nil {
+		return fmt.Errorf("recountPoll: db error updating poll %s: %s", poll.ID, err)
+	}
+
+	return nil
+}
+
+// federateQuestionUpdate sends an Update of the given local status with a poll
+// to other servers, so that they know about its latest vote counts.
+func (p *processor) federateQuestionUpdate(ctx context.Context, status *gtsmodel.Status) error {
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("federateQuestionUpdate: error getting status author: %s", err)
+		}
+		status.Account = a
+	}
+
+	asStatus, err := p.tc.StatusToAS(ctx, status)
+	if err != nil {
+		return fmt.Errorf("federateQuestionUpdate: error converting status to as format: %s", err)
+	}
+
+	update, err := p.tc.WrapStatusableInUpdate(asStatus, status.Account)
+	if err != nil {
+		return fmt.Errorf("federateQuestionUpdate: error wrapping status in update: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(status.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateQuestionUpdate: error parsing outboxURI %s: %s", status.Account.OutboxURI, err)
+	}
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, update)
+	return err
+}
+
+// federatePollVotes sends the given votes of a local account in a remote poll to the author of the poll.
+func (p *processor) federatePollVotes(ctx context.Context, votes []*gtsmodel.PollVote, poll *gtsmodel.Poll, status *gtsmodel.Status, voter *gtsmodel.Account) error {
+	outboxIRI, err := url.Parse(voter.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federatePollVotes: error parsing outboxURI %s: %s", voter.OutboxURI, err)
+	}
+
+	for _, vote := range votes {
+		create, err := p.tc.PollVoteToASCreate(ctx, vote, poll, status)
+		if err != nil {
+			return fmt.Errorf("federatePollVotes: error converting vote to as format: %s", err)
+		}
+
+		if _, err := p.federator.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyPollEnded notifies the local author of the given poll, and any local accounts which voted in it, that it has ended.
+func (p *processor) notifyPollEnded(ctx context.Context, poll *gtsmodel.Poll, status *gtsmodel.Status) error {
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("notifyPollEnded: error getting status author: %s", err)
+		}
+		status.Account = a
+	}
+
+	voterIDs, err := p.db.GetPollVoterIDs(ctx, poll.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("notifyPollEnded: db error getting voters: %s", err)
+	}
+
+	targetAccountIDs := append([]string{status.AccountID}, voterIDs...)
+	for _, targetAccountID := range targetAccountIDs {
+		targetAccount := status.Account
+		if targetAccountID != status.AccountID {
+			targetAccount, err = p.db.GetAccountByID(ctx, targetAccountID)
+			if err != nil {
+				log.Errorf("notifyPollEnded: error getting voter %s: %s", targetAccountID, err)
+				continue
+			}
+		}
+
+		// only local accounts get notified
+		if targetAccount.Domain != "" {
+			continue
+		}
+
+		if err := p.notifyPollEndedTo(ctx, status, targetAccount); err != nil {
+			// keep going, so that one failure
+			// doesn't cost the others their notification
+			log.Errorf("notifyPollEnded: error notifying account %s: %s", targetAccount.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// notifyPollEndedTo notifies the given local account that the poll on status has ended.
+func (p *processor) notifyPollEndedTo(ctx context.Context, status *gtsmodel.Status, targetAccount *gtsmodel.Account) error {
+	notifID, err := id.NewULID()
+	if err != nil {
+		return err
+	}
+
+	notif := &gtsmodel.Notification{
+		ID:               notifID,
+		NotificationType: gtsmodel.NotificationPoll,
+		TargetAccountID:  targetAccount.ID,
+		TargetAccount:    targetAccount,
+		OriginAccountID:  status.AccountID,
+		OriginAccount:    status.Account,
+		StatusID:         status.ID,
+		Status:           status,
+	}
+
+	if err := p.db.Put(ctx, notif); err != nil {
+		return fmt.Errorf("error putting notification in database: %s", err)
+	}
+
+	apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
+	if err != nil {
+		return fmt.Errorf("error converting notification to api representation: %s", err)
+	}
+
+	if err := p.streamNotificationToAccount(ctx, apiNotif, targetAccount); err != nil {
+		return fmt.Errorf("error streaming notification to account: %s", err)
+	}
+
+	return nil
+}
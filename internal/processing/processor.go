@@ -20,10 +20,12 @@ package processing
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/url"
 	"time"
 
+	"codeberg.org/gruf/go-cache/v2"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -31,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -41,8 +44,10 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/streaming"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/user"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
@@ -91,14 +96,27 @@ type Processor interface {
 	// the account given in authed.
 	AccountStatusesGet(ctx context.Context, authed *oauth.Auth, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinned bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode)
 	// AccountWebStatusesGet fetches a number of statuses (in descending order) from the given account. It selects only
-	// statuses which are suitable for showing on the public web profile of an account.
-	AccountWebStatusesGet(ctx context.Context, targetAccountID string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode)
+	// statuses which are suitable for showing on the public web profile of an account, respecting the account's
+	// web layout options. If mediaOnly is true, only statuses with media attached will be selected.
+	AccountWebStatusesGet(ctx context.Context, targetAccountID string, maxID string, mediaOnly bool) (*apimodel.PageableResponse, gtserror.WithCode)
+	// AccountWebPinnedStatusesGet fetches the public pinned statuses of the given account, for showing on its public web profile.
+	AccountWebPinnedStatusesGet(ctx context.Context, targetAccountID string) ([]*apimodel.Status, gtserror.WithCode)
+	// AccountWebTagStatusesGet fetches a number of public, federated statuses (in descending order) from the given
+	// account that use the given hashtag. The hashtag must be one of the account's featured tags.
+	AccountWebTagStatusesGet(ctx context.Context, targetAccountID string, tagName string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode)
+	// AccountWebLayoutGet returns the layout options the given account has chosen for its public web profile.
+	AccountWebLayoutGet(ctx context.Context, targetAccountID string) (*apimodel.WebLayout, gtserror.WithCode)
 	// AccountFollowersGet fetches a list of the target account's followers.
 	AccountFollowersGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
 	// AccountFollowingGet fetches a list of the accounts that target account is following.
 	AccountFollowingGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
 	// AccountRelationshipGet returns a relationship model describing the relationship of the targetAccount to the Authed account.
 	AccountRelationshipGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// AccountStatsGet returns posting and follower statistics for the authed account, covering the given number of days up to and including today.
+	AccountStatsGet(ctx context.Context, authed *oauth.Auth, days int) (*apimodel.AccountStats, gtserror.WithCode)
+	// AccountSearch returns accounts that the authed account follows or has exchanged mentions with,
+	// whose username or display name starts with the given query, for autocompleting mentions.
+	AccountSearch(ctx context.Context, authed *oauth.Auth, query string, limit int) ([]apimodel.Account, gtserror.WithCode)
 	// AccountFollowCreate handles a follow request to an account, either remote or local.
 	AccountFollowCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountFollowRequest) (*apimodel.Relationship, gtserror.WithCode)
 	// AccountFollowRemove handles the removal of a follow/follow request to an account, either remote or local.
@@ -107,9 +125,25 @@ type Processor interface {
 	AccountBlockCreate(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// AccountBlockRemove handles the removal of a block from authed account to target account, either remote or local.
 	AccountBlockRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// AccountFeaturedTagsGet returns the hashtags featured on the profile of the authed account.
+	AccountFeaturedTagsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+	// AccountFeaturedTagsGetForAccount returns the hashtags featured on the profile of the target account.
+	AccountFeaturedTagsGetForAccount(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+	// AccountFeaturedTagCreate features a hashtag on the profile of the authed account, using the given form.
+	AccountFeaturedTagCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.FeaturedTagCreateRequest) (*apimodel.FeaturedTag, gtserror.WithCode)
+	// AccountFeaturedTagDelete stops featuring the featured tag with the given id on the profile of the authed account.
+	AccountFeaturedTagDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// AccountDomainBlockCreate handles the creation of a block of an entire domain by the authed account.
+	AccountDomainBlockCreate(ctx context.Context, authed *oauth.Auth, domain string) gtserror.WithCode
+	// AccountDomainBlockRemove handles the removal of a block of an entire domain by the authed account.
+	AccountDomainBlockRemove(ctx context.Context, authed *oauth.Auth, domain string) gtserror.WithCode
 
 	// AdminAccountAction handles the creation/execution of an action on an account.
 	AdminAccountAction(ctx context.Context, authed *oauth.Auth, form *apimodel.AdminAccountActionRequest) gtserror.WithCode
+	// AdminAccountInteractionsGet returns the follows, follow requests, blocks and mentions between the given remote account and local accounts.
+	AdminAccountInteractionsGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.AdminAccountInteractions, gtserror.WithCode)
+	// AdminAccountRecoveryCodesCreate generates new recovery codes for the given local account, replacing any it had before.
+	AdminAccountRecoveryCodesCreate(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.AdminRecoveryCodes, gtserror.WithCode)
 	// AdminEmojiCreate handles the creation of a new instance emoji by an admin, using the given form.
 	AdminEmojiCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode)
 	// AdminEmojisGet allows admins to view emojis based on various filters.
@@ -119,8 +153,23 @@ type Processor interface {
 	// AdminEmojiDelete deletes one *local* emoji with the given key. Remote emojis will not be deleted this way.
 	// Only admin users in good standing should be allowed to access this function -- check this before calling it.
 	AdminEmojiDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.AdminEmoji, gtserror.WithCode)
+	// AdminEmojiUpdate updates the author, license, and source url of the emoji with the given ID.
+	AdminEmojiUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiUpdateRequest) (*apimodel.AdminEmoji, gtserror.WithCode)
+	// AdminEmojiUsageGet returns how many statuses and accounts use each emoji, sorted by use count.
+	AdminEmojiUsageGet(ctx context.Context, authed *oauth.Auth, domain string, ascending bool, limit int) ([]*apimodel.AdminEmojiUsage, gtserror.WithCode)
 	// AdminEmojiCategoriesGet gets a list of all existing emoji categories.
 	AdminEmojiCategoriesGet(ctx context.Context) ([]*apimodel.EmojiCategory, gtserror.WithCode)
+	// AdminEmojiCategoryUpdate renames the emoji category with the given ID.
+	AdminEmojiCategoryUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiCategoryUpdateRequest) (*apimodel.EmojiCategory, gtserror.WithCode)
+	// AdminEmojiCategoryDelete deletes the emoji category with the given ID, first moving or deleting its emojis
+	// if the form asks for it. Any emojis still in the category are left uncategorized.
+	AdminEmojiCategoryDelete(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiCategoryDeleteRequest) (*apimodel.EmojiCategory, gtserror.WithCode)
+	// AdminEmojiCopy creates a new local emoji from the image of the remote emoji with the given id.
+	AdminEmojiCopy(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.EmojiCopyRequest) (*apimodel.Emoji, gtserror.WithCode)
+	// AdminEmojiPackImport imports the emojis of a Pleroma/Akkoma-style emoji pack archive as local emojis.
+	AdminEmojiPackImport(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiPackImportRequest) ([]*apimodel.Emoji, gtserror.WithCode)
+	// AdminEmojiPackExport exports local emojis as a Pleroma/Akkoma-style emoji pack archive.
+	AdminEmojiPackExport(ctx context.Context, authed *oauth.Auth, category string) (*apimodel.Content, gtserror.WithCode)
 	// AdminDomainBlockCreate handles the creation of a new domain block by an admin, using the given form.
 	AdminDomainBlockCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainBlockCreateRequest) (*apimodel.DomainBlock, gtserror.WithCode)
 	// AdminDomainBlocksImport handles the import of multiple domain blocks by an admin, using the given form.
@@ -131,8 +180,53 @@ type Processor interface {
 	AdminDomainBlockGet(ctx context.Context, authed *oauth.Auth, id string, export bool) (*apimodel.DomainBlock, gtserror.WithCode)
 	// AdminDomainBlockDelete deletes one domain block, specified by ID, returning the deleted domain block.
 	AdminDomainBlockDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainBlock, gtserror.WithCode)
+	// AdminDomainBlocksExpire lifts domain blocks which expired at or before now, working through them
+	// batchSize at a time, as though they'd been deleted by an admin. It returns the number of blocks lifted.
+	AdminDomainBlocksExpire(ctx context.Context, now time.Time, batchSize int) (int, error)
+	// AdminDomainNoteSet sets the private admin note on one domain, replacing any existing note.
+	AdminDomainNoteSet(ctx context.Context, authed *oauth.Auth, domain string, form *apimodel.DomainNoteRequest) (*apimodel.DomainNote, gtserror.WithCode)
+	// AdminDomainNoteGet returns the private admin note on one domain.
+	AdminDomainNoteGet(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainNote, gtserror.WithCode)
+	// AdminDomainNotesGet returns all private admin notes on domains.
+	AdminDomainNotesGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.DomainNote, gtserror.WithCode)
+	// AdminDomainNoteDelete deletes the private admin note on one domain, returning the deleted note.
+	AdminDomainNoteDelete(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainNote, gtserror.WithCode)
+	// AdminFederationErrorDomainsGet returns a summary of recent federation errors for each remote domain, most recently failing first.
+	AdminFederationErrorDomainsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminFederationErrorDomain, gtserror.WithCode)
+	// AdminFederationErrorsGet returns the recent federation errors for one remote domain, most recent first.
+	AdminFederationErrorsGet(ctx context.Context, authed *oauth.Auth, domain string) ([]*apimodel.AdminFederationError, gtserror.WithCode)
+	// AdminDomainStorageGet returns how many accounts, statuses, attachments and emojis are stored from each remote domain, and how many bytes they take up, most bytes first.
+	AdminDomainStorageGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminDomainStorage, gtserror.WithCode)
+	// AdminDomainStorageExport returns the same as AdminDomainStorageGet, as a CSV file.
+	AdminDomainStorageExport(ctx context.Context, authed *oauth.Auth) (*apimodel.Content, gtserror.WithCode)
+	// AdminUserAgentRejectionsGet returns the number of requests rejected by each blocked user agent rule, most rejections first.
+	AdminUserAgentRejectionsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminUserAgentRejection, gtserror.WithCode)
+	// AdminDBPoolStatsGet returns statistics for each pool of connections to the database.
+	AdminDBPoolStatsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminDBPoolStats, gtserror.WithCode)
+	// AdminCacheStatsGet returns hit, miss and eviction counts and the size of each cache kept in front of the database.
+	AdminCacheStatsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AdminCacheStats, gtserror.WithCode)
+	// AdminLegalDocumentCreate publishes a new version of the terms of service or privacy policy of this instance.
+	AdminLegalDocumentCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.LegalDocumentCreateRequest) (*apimodel.LegalDocument, gtserror.WithCode)
+	// AdminLegalDocumentsGet returns every published version of the given kind of legal document, or of every kind if kind is empty, newest first.
+	AdminLegalDocumentsGet(ctx context.Context, authed *oauth.Auth, kind string) ([]*apimodel.LegalDocument, gtserror.WithCode)
+	// AdminLegalDocumentGet returns one published version of a legal document.
+	AdminLegalDocumentGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.LegalDocument, gtserror.WithCode)
+	// AdminDBMaintain runs database maintenance now, the same as a scheduled run would, and returns the outcome.
+	AdminDBMaintain(ctx context.Context, authed *oauth.Auth) (*apimodel.AdminDBMaintenanceResult, gtserror.WithCode)
+	// AdminDomainEmojiPolicySet sets the emoji policy for one domain, replacing any existing policy.
+	AdminDomainEmojiPolicySet(ctx context.Context, authed *oauth.Auth, domain string, form *apimodel.DomainEmojiPolicyRequest) (*apimodel.DomainEmojiPolicy, gtserror.WithCode)
+	// AdminDomainEmojiPolicyGet returns the emoji policy for one domain.
+	AdminDomainEmojiPolicyGet(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode)
+	// AdminDomainEmojiPoliciesGet returns all domain emoji policies.
+	AdminDomainEmojiPoliciesGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.DomainEmojiPolicy, gtserror.WithCode)
+	// AdminDomainEmojiPolicyDelete deletes the emoji policy for one domain, returning the deleted policy.
+	AdminDomainEmojiPolicyDelete(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.DomainEmojiPolicy, gtserror.WithCode)
 	// AdminMediaRemotePrune triggers a prune of remote media according to the given number of mediaRemoteCacheDays
 	AdminMediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode
+	// AdminEmojiStaticsRegenerate triggers regeneration of the static images of emojis whose static is missing or broken.
+	AdminEmojiStaticsRegenerate(ctx context.Context) gtserror.WithCode
+	// AdminEmojiDomainAction disables, enables, or refreshes the images of all emojis from the given remote domain.
+	AdminEmojiDomainAction(ctx context.Context, authed *oauth.Auth, domain string, form *apimodel.EmojiDomainActionRequest) (*apimodel.AdminEmojiDomainAction, gtserror.WithCode)
 
 	// AppCreate processes the creation of a new API application
 	AppCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.ApplicationCreateRequest) (*apimodel.Application, gtserror.WithCode)
@@ -140,8 +234,49 @@ type Processor interface {
 	// BlocksGet returns a list of accounts blocked by the requesting account.
 	BlocksGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.BlocksResponse, gtserror.WithCode)
 
-	// CustomEmojisGet returns an array of info about the custom emojis on this server
-	CustomEmojisGet(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode)
+	// BookmarkFoldersGet returns all bookmark folders owned by the requesting account.
+	BookmarkFoldersGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.BookmarkFolder, gtserror.WithCode)
+	// BookmarkFolderGet returns the given bookmark folder, if it's owned by the requesting account.
+	BookmarkFolderGet(ctx context.Context, authed *oauth.Auth, folderID string) (*apimodel.BookmarkFolder, gtserror.WithCode)
+	// BookmarkFolderCreate creates a new bookmark folder for the requesting account.
+	BookmarkFolderCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.BookmarkFolderCreateUpdateRequest) (*apimodel.BookmarkFolder, gtserror.WithCode)
+	// BookmarkFolderUpdate renames the given bookmark folder, if it's owned by the requesting account.
+	BookmarkFolderUpdate(ctx context.Context, authed *oauth.Auth, folderID string, form *apimodel.BookmarkFolderCreateUpdateRequest) (*apimodel.BookmarkFolder, gtserror.WithCode)
+	// BookmarkFolderDelete deletes the given bookmark folder, if it's owned by the requesting account.
+	// The bookmarks filed in it are kept, but no longer filed in any folder.
+	BookmarkFolderDelete(ctx context.Context, authed *oauth.Auth, folderID string) gtserror.WithCode
+	// BookmarkFolderStatusesAdd files the requesting account's bookmarks of the given statuses in the given bookmark folder,
+	// moving them out of any other folder. All of the statuses must have been bookmarked already.
+	BookmarkFolderStatusesAdd(ctx context.Context, authed *oauth.Auth, folderID string, statusIDs []string) gtserror.WithCode
+	// BookmarkFolderStatusesRemove takes the requesting account's bookmarks of the given statuses out of the given bookmark folder.
+	// The bookmarks themselves are kept.
+	BookmarkFolderStatusesRemove(ctx context.Context, authed *oauth.Auth, folderID string, statusIDs []string) gtserror.WithCode
+
+	// DomainBlocksGet returns a list of domains blocked by the requesting account.
+	DomainBlocksGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.DomainBlocksResponse, gtserror.WithCode)
+
+	// ClientSettingsGet returns all client settings stored by the authed application for the authed account.
+	ClientSettingsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.ClientSetting, gtserror.WithCode)
+	// ClientSettingGet returns the client setting with the given key stored by the authed application for the authed account.
+	ClientSettingGet(ctx context.Context, authed *oauth.Auth, key string) (*apimodel.ClientSetting, gtserror.WithCode)
+	// ClientSettingUpdate stores the client setting with the given key for the authed application and account,
+	// creating it if it doesn't exist yet, and replacing its value if it does.
+	ClientSettingUpdate(ctx context.Context, authed *oauth.Auth, key string, form *apimodel.ClientSettingUpdateRequest) (*apimodel.ClientSetting, gtserror.WithCode)
+	// ClientSettingDelete deletes the client setting with the given key stored by the authed application for the authed account.
+	ClientSettingDelete(ctx context.Context, authed *oauth.Auth, key string) (*apimodel.ClientSetting, gtserror.WithCode)
+
+	// CustomEmojisGet returns an array of info about the custom emojis on this server,
+	// optionally filtered by shortcode substring and category name, and limited in number. If frequentlyUsed is true
+	// and the request was made by an account, the emojis that account has used most are returned first.
+	CustomEmojisGet(ctx context.Context, authed *oauth.Auth, shortcode string, category string, limit int, frequentlyUsed bool) ([]*apimodel.Emoji, gtserror.WithCode)
+	// CustomEmojisSearch returns custom emojis on this server matching the given
+	// shortcode prefix or substring, for autocompletion.
+	CustomEmojisSearch(ctx context.Context, query string, limit int) ([]*apimodel.Emoji, gtserror.WithCode)
+
+	// FavouritesExport returns the URIs of all statuses faved by the authed account, as a CSV file.
+	FavouritesExport(ctx context.Context, authed *oauth.Auth) (*apimodel.Content, gtserror.WithCode)
+	// FavouritesImport faves each status listed in the CSV file of the given form, if it can be found.
+	FavouritesImport(ctx context.Context, authed *oauth.Auth, form *apimodel.FavouritesImportRequest) (*apimodel.FavouritesImportResult, gtserror.WithCode)
 
 	// FileGet handles the fetching of a media attachment file via the fileserver.
 	FileGet(ctx context.Context, authed *oauth.Auth, form *apimodel.GetContentRequestForm) (*apimodel.Content, gtserror.WithCode)
@@ -152,14 +287,76 @@ type Processor interface {
 	FollowRequestAccept(ctx context.Context, auth *oauth.Auth, accountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// FollowRequestReject handles the rejection of a follow request from the given account ID.
 	FollowRequestReject(ctx context.Context, auth *oauth.Auth, accountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// FollowRequestsExpire rejects follow requests targeting local accounts which haven't been answered since olderThan,
+	// working through them batchSize at a time. It returns the number of follow requests which were rejected.
+	FollowRequestsExpire(ctx context.Context, olderThan time.Time, batchSize int) (int, error)
+
+	// PollGet returns the poll with the given ID, if the status it's attached to is visible to the requester.
+	PollGet(ctx context.Context, authed *oauth.Auth, pollID string) (*apimodel.Poll, gtserror.WithCode)
+	// PollVote casts the requester's vote for the given choices in the poll with the given ID, returning the updated poll.
+	PollVote(ctx context.Context, authed *oauth.Auth, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode)
+	// PollsExpire closes polls which ended at or before now, working through them batchSize at a time, and notifies
+	// local authors and voters that they've ended. It returns the number of polls which were closed.
+	PollsExpire(ctx context.Context, now time.Time, batchSize int) (int, error)
+
+	// FiltersV2Get returns all filters of the requesting account.
+	FiltersV2Get(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Get returns the given filter, if it's owned by the requesting account.
+	FilterV2Get(ctx context.Context, authed *oauth.Auth, filterID string) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Create creates a new filter for the requesting account using the given form.
+	FilterV2Create(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Update updates the given filter, and adds, changes or removes its keywords, using the given form.
+	FilterV2Update(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterCreateUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Delete deletes the given filter, along with its keywords and statuses.
+	FilterV2Delete(ctx context.Context, authed *oauth.Auth, filterID string) gtserror.WithCode
+	// FilterKeywordsGet returns the keywords of the given filter.
+	FilterKeywordsGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordGet returns the given filter keyword, if it's owned by the requesting account.
+	FilterKeywordGet(ctx context.Context, authed *oauth.Auth, filterKeywordID string) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordCreate adds a keyword to the given filter using the given form.
+	FilterKeywordCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordUpdate updates the given filter keyword using the given form.
+	FilterKeywordUpdate(ctx context.Context, authed *oauth.Auth, filterKeywordID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordDelete removes the given keyword from its filter.
+	FilterKeywordDelete(ctx context.Context, authed *oauth.Auth, filterKeywordID string) gtserror.WithCode
+	// FilterStatusesGet returns the statuses of the given filter.
+	FilterStatusesGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterStatus, gtserror.WithCode)
+	// FilterStatusGet returns the given filter status, if it's owned by the requesting account.
+	FilterStatusGet(ctx context.Context, authed *oauth.Auth, filterStatusID string) (*apimodel.FilterStatus, gtserror.WithCode)
+	// FilterStatusCreate adds a status to the given filter using the given form.
+	FilterStatusCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterStatusCreateRequest) (*apimodel.FilterStatus, gtserror.WithCode)
+	// FilterStatusDelete removes the given status from its filter.
+	FilterStatusDelete(ctx context.Context, authed *oauth.Auth, filterStatusID string) gtserror.WithCode
 
 	// InstanceGet retrieves instance information for serving at api/v1/instance
 	InstanceGet(ctx context.Context, domain string) (*apimodel.Instance, gtserror.WithCode)
+	// InstanceGetV2 retrieves information about this instance for serving at api/v2/instance
+	InstanceGetV2(ctx context.Context) (*apimodel.InstanceV2, gtserror.WithCode)
 	InstancePeersGet(ctx context.Context, authed *oauth.Auth, includeSuspended bool, includeOpen bool, flat bool) (interface{}, gtserror.WithCode)
 	// InstancePatch updates this instance according to the given form.
 	//
 	// It should already be ascertained that the requesting account is authenticated and an admin.
 	InstancePatch(ctx context.Context, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.Instance, gtserror.WithCode)
+	// InstanceLegalDocumentGet returns the given version of the given kind of legal document of this instance, like its
+	// terms of service, or the latest version if version is 0.
+	InstanceLegalDocumentGet(ctx context.Context, kind gtsmodel.LegalDocumentKind, version int) (*apimodel.LegalDocument, gtserror.WithCode)
+
+	// ListsGet returns all lists owned by the requesting account.
+	ListsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.List, gtserror.WithCode)
+	// ListGet returns the given list, if it's owned by the requesting account.
+	ListGet(ctx context.Context, authed *oauth.Auth, listID string) (*apimodel.List, gtserror.WithCode)
+	// ListCreate creates a new list for the requesting account using the given form.
+	ListCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.ListCreateRequest) (*apimodel.List, gtserror.WithCode)
+	// ListUpdate updates the title and/or replies policy of the given list using the given form.
+	ListUpdate(ctx context.Context, authed *oauth.Auth, listID string, form *apimodel.ListUpdateRequest) (*apimodel.List, gtserror.WithCode)
+	// ListDelete deletes the given list and all of its entries.
+	ListDelete(ctx context.Context, authed *oauth.Auth, listID string) gtserror.WithCode
+	// ListAccountsGet returns a page of the accounts in the given list.
+	ListAccountsGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ListAccountsAdd adds the given accounts to the given list. The requesting account must follow all of them.
+	ListAccountsAdd(ctx context.Context, authed *oauth.Auth, listID string, accountIDs []string) gtserror.WithCode
+	// ListAccountsRemove removes the given accounts from the given list.
+	ListAccountsRemove(ctx context.Context, authed *oauth.Auth, listID string, accountIDs []string) gtserror.WithCode
 
 	// MediaCreate handles the creation of a media attachment, using the given form.
 	MediaCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AttachmentRequest) (*apimodel.Attachment, gtserror.WithCode)
@@ -168,10 +365,10 @@ type Processor interface {
 	// MediaUpdate handles the PUT of a media attachment with the given ID and form
 	MediaUpdate(ctx context.Context, authed *oauth.Auth, attachmentID string, form *apimodel.AttachmentUpdateRequest) (*apimodel.Attachment, gtserror.WithCode)
 
-	// NotificationsGet
+	// NotificationsGet gets notifications for the requesting account, and marks the returned notifications as read.
 	NotificationsGet(ctx context.Context, authed *oauth.Auth, excludeTypes []string, limit int, maxID string, sinceID string) (*apimodel.PageableResponse, gtserror.WithCode)
-	// NotificationsClear
-	NotificationsClear(ctx context.Context, authed *oauth.Auth) gtserror.WithCode
+	// NotificationsClear deletes notifications of the given types for the requesting account, or all of them if types is empty.
+	NotificationsClear(ctx context.Context, authed *oauth.Auth, types []string) gtserror.WithCode
 
 	OAuthHandleTokenRequest(r *http.Request) (map[string]interface{}, gtserror.WithCode)
 	OAuthHandleAuthorizeRequest(w http.ResponseWriter, r *http.Request) gtserror.WithCode
@@ -181,6 +378,8 @@ type Processor interface {
 
 	// StatusCreate processes the given form to create a new status, returning the api model representation of that status if it's OK.
 	StatusCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode)
+	// StatusThreadCreate processes the given form to create a new thread of statuses, returning the api model representations of the statuses if they're OK.
+	StatusThreadCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.StatusThreadCreateForm) ([]*apimodel.Status, gtserror.WithCode)
 	// StatusDelete processes the delete of a given status, returning the deleted status if the delete goes through.
 	StatusDelete(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// StatusFave processes the faving of a given status, returning the updated status if the fave goes through.
@@ -197,15 +396,35 @@ type Processor interface {
 	StatusGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// StatusUnfave processes the unfaving of a given status, returning the updated status if the fave goes through.
 	StatusUnfave(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusMute mutes the thread that the given status is part of, so that new replies in it no longer
+	// generate notifications or appear in the home timeline of the requesting account.
+	StatusMute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusUnmute undoes a thread mute created by StatusMute.
+	StatusUnmute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusBookmark bookmarks the given status for the requesting account, returning the updated status.
+	StatusBookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusUnbookmark removes the requesting account's bookmark of the given status, if it has one, returning the updated status.
+	StatusUnbookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// StatusGetContext returns the context (previous and following posts) from the given status ID
 	StatusGetContext(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Context, gtserror.WithCode)
+	// StatusViewCountGet returns the view counts of the given status, if the requester is its author.
+	StatusViewCountGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.StatusViewCount, gtserror.WithCode)
+	// StatusDeliveriesGet returns how the given status was delivered to other servers, if the requester is its author.
+	StatusDeliveriesGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.StatusDeliveries, gtserror.WithCode)
+	// StatusWebViewCountIncrement counts one more view of the web page of the given status, if view counting is enabled.
+	StatusWebViewCountIncrement(ctx context.Context, targetStatusID string)
 
 	// HomeTimelineGet returns statuses from the home timeline, with the given filters/parameters.
 	HomeTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ListTimelineGet returns statuses from the timeline of the given list, with the given filters/parameters.
+	ListTimelineGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 	// PublicTimelineGet returns statuses from the public/local timeline, with the given filters/parameters.
 	PublicTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
 	// FavedTimelineGet returns faved statuses, with the given filters/parameters.
 	FavedTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// BookmarkedTimelineGet returns bookmarked statuses, with the given filters/parameters.
+	// If folderID is set, only bookmarks filed in the requesting account's bookmark folder with that ID are returned.
+	BookmarkedTimelineGet(ctx context.Context, authed *oauth.Auth, folderID string, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 
 	// AuthorizeStreamingRequest returns a gotosocial account in exchange for an access token, or an error if the given token is not valid.
 	AuthorizeStreamingRequest(ctx context.Context, accessToken string) (*gtsmodel.Account, gtserror.WithCode)
@@ -214,9 +433,27 @@ type Processor interface {
 
 	// UserChangePassword changes the password for the given user, with the given form.
 	UserChangePassword(ctx context.Context, authed *oauth.Auth, form *apimodel.PasswordChangeRequest) gtserror.WithCode
+	// UserChangeUsername changes the username of the authed account, keeping the old one as an alias.
+	UserChangeUsername(ctx context.Context, authed *oauth.Auth, form *apimodel.UsernameChangeRequest) (*apimodel.Account, gtserror.WithCode)
 	// UserConfirmEmail confirms an email address using the given token.
 	// The user belonging to the confirmed email is also returned.
 	UserConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode)
+	// UserPostByMailGet returns the settings for posting statuses by email for the authed user.
+	UserPostByMailGet(ctx context.Context, authed *oauth.Auth) (*apimodel.PostByMail, gtserror.WithCode)
+	// UserPostByMailEnable gives the authed user a new secret address they can post statuses to by email.
+	UserPostByMailEnable(ctx context.Context, authed *oauth.Auth) (*apimodel.PostByMail, gtserror.WithCode)
+	// UserPostByMailDisable stops the authed user from posting statuses by email.
+	UserPostByMailDisable(ctx context.Context, authed *oauth.Auth) (*apimodel.PostByMail, gtserror.WithCode)
+	// UserLegalDocumentsToAccept returns the latest versions of the legal documents which the given user
+	// must accept before they can sign in, because a version requiring acceptance was published since they
+	// last accepted them. It returns an empty slice if there's nothing to accept.
+	UserLegalDocumentsToAccept(ctx context.Context, user *gtsmodel.User) ([]*apimodel.LegalDocument, gtserror.WithCode)
+	// UserLegalDocumentsAccept records that the given user has accepted the latest versions of all legal documents.
+	UserLegalDocumentsAccept(ctx context.Context, user *gtsmodel.User) gtserror.WithCode
+
+	// MailGatewayPost posts the given raw email message, forwarded by the mail gateway, as a status.
+	// The message must be sent to the secret post by email address of a user, from their confirmed email address.
+	MailGatewayPost(ctx context.Context, message io.Reader) (*apimodel.Status, gtserror.WithCode)
 
 	/*
 		FEDERATION API-FACING PROCESSING FUNCTIONS
@@ -235,6 +472,9 @@ type Processor interface {
 	// GetFediFollowing handles the getting of a fedi/activitypub representation of a user/account's following, performing appropriate
 	// authentication before returning a JSON serializable interface to the caller.
 	GetFediFollowing(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
+	// GetFediFeaturedTags handles the getting of a fedi/activitypub representation of the hashtags featured by a user/account,
+	// performing appropriate authentication before returning a JSON serializable interface to the caller.
+	GetFediFeaturedTags(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
 	// GetFediStatus handles the getting of a fedi/activitypub representation of a particular status, performing appropriate
 	// authentication before returning a JSON serializable interface to the caller.
 	GetFediStatus(ctx context.Context, requestedUsername string, requestedStatusID string, requestURL *url.URL) (interface{}, gtserror.WithCode)
@@ -275,8 +515,18 @@ type processor struct {
 	mediaManager    media.Manager
 	storage         storage.Driver
 	statusTimelines timeline.Manager
+	listTimelines   timeline.Manager
 	db              db.DB
 	filter          visibility.Filter
+	formatter       text.Formatter
+
+	// mailGatewayMessages maps the message IDs of emails
+	// recently posted by mail gateway to their status IDs
+	mailGatewayMessages cache.Cache[string, string]
+
+	// filterCache caches the filters of accounts that
+	// statuses and notifications are streamed to
+	filterCache *statusfilter.Cache
 
 	/*
 		SUB-PROCESSORS
@@ -308,12 +558,19 @@ func NewProcessor(
 	statusProcessor := status.New(db, tc, clientWorker, parseMentionFunc)
 	streamingProcessor := streaming.New(db, oauthServer)
 	accountProcessor := account.New(db, tc, mediaManager, oauthServer, clientWorker, federator, parseMentionFunc)
-	adminProcessor := admin.New(db, tc, mediaManager, clientWorker)
+	adminProcessor := admin.New(db, tc, mediaManager, federator.TransportController(), clientWorker, storage)
 	mediaProcessor := mediaProcessor.New(db, tc, mediaManager, federator.TransportController(), storage)
 	userProcessor := user.New(db, emailSender)
 	federationProcessor := federationProcessor.New(db, tc, federator)
 	filter := visibility.NewFilter(db)
 
+	// Mail gateway messages cache has TTL=24hr freq=1min
+	mailGatewayMessages := cache.New[string, string]()
+	mailGatewayMessages.SetTTL(24*time.Hour, false)
+	if !mailGatewayMessages.Start(time.Minute) {
+		log.Panic("failed to start mail gateway messages cache")
+	}
+
 	return &processor{
 		clientWorker: clientWorker,
 		fedWorker:    fedWorker,
@@ -324,8 +581,13 @@ func NewProcessor(
 		mediaManager:    mediaManager,
 		storage:         storage,
 		statusTimelines: timeline.NewManager(StatusGrabFunction(db), StatusFilterFunction(db, filter), StatusPrepareFunction(db, tc), StatusSkipInsertFunction()),
+		listTimelines:   timeline.NewManager(ListGrabFunction(db), ListFilterFunction(db, filter), ListPrepareFunction(db, tc), StatusSkipInsertFunction()),
 		db:              db,
 		filter:          visibility.NewFilter(db),
+		formatter:       text.NewFormatter(db),
+
+		mailGatewayMessages: mailGatewayMessages,
+		filterCache:         statusfilter.NewCache(),
 
 		accountProcessor:    accountProcessor,
 		adminProcessor:      adminProcessor,
@@ -351,11 +613,19 @@ func (p *processor) Start() error {
 		return err
 	}
 
+	// Start processing queued incoming activities
+	if err := p.federationProcessor.Start(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // Stop stops the processor cleanly, finishing handling any remaining messages before closing down.
 func (p *processor) Stop() error {
+	if err := p.federationProcessor.Stop(); err != nil {
+		return err
+	}
 	if err := p.clientWorker.Stop(); err != nil {
 		return err
 	}
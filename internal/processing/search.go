@@ -37,6 +37,10 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// searchStatusesDefaultLimit is the number of statuses
+// returned by a text search when no limit is given.
+const searchStatusesDefaultLimit = 20
+
 func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *apimodel.SearchQuery) (*apimodel.SearchResult, gtserror.WithCode) {
 	l := log.WithFields(kv.Fields{
 		{"query", search.Query},
@@ -55,10 +59,14 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		Hashtags: []apimodel.Tag{},
 	}
 
-	// currently the search will only ever return one result,
-	// so return nothing if the offset is greater than 0
-	if search.Offset > 0 {
-		return searchResult, nil
+	// looking up the query by mention or URI will only ever
+	// return one result, so skip it if the offset is greater than 0
+	lookup := search.Offset == 0
+
+	// Without an account, we can't dereference anything
+	// remote on behalf of the searcher, so don't try.
+	if authed.Account == nil {
+		search.Resolve = false
 	}
 
 	foundAccounts := []*gtsmodel.Account{}
@@ -75,7 +83,7 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		maybeNamestring = "@" + maybeNamestring
 	}
 
-	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); err == nil {
+	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); lookup && err == nil {
 		l.Debugf("search term %s is a mention, looking it up...", maybeNamestring)
 		if foundAccount, err := p.searchAccountByMention(ctx, authed, username, domain, search.Resolve); err == nil && foundAccount != nil {
 			foundAccounts = append(foundAccounts, foundAccount)
@@ -90,7 +98,7 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		SEARCH BY URI
 		check if the query is a URI with a recognizable scheme and dereference it
 	*/
-	if !foundOne {
+	if lookup && !foundOne {
 		if uri, err := url.Parse(query); err == nil && (uri.Scheme == "https" || uri.Scheme == "http") {
 			// don't attempt to resolve (ie., dereference) local accounts/statuses
 			resolve := search.Resolve
@@ -109,11 +117,41 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		}
 	}
 
+	/*
+		SEARCH BY TEXT
+		if the query didn't turn up anything by itself, search the text of statuses the searcher posted or was mentioned in
+	*/
+	if len(foundAccounts) == 0 && len(foundStatuses) == 0 && authed.Account != nil && (search.Type == "" || search.Type == "statuses") {
+		limit := search.Limit
+		if limit <= 0 {
+			limit = searchStatusesDefaultLimit
+		}
+
+		statuses, err := p.db.SearchStatuses(ctx, authed.Account.ID, query, search.AccountID, search.MaxID, search.MinID, limit, search.Offset)
+		if err != nil && err != db.ErrNoEntries {
+			err := fmt.Errorf("SearchGet: error searching statuses: %s", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		foundStatuses = append(foundStatuses, statuses...)
+		l.Debugf("got %d statuses by searching text", len(statuses))
+	}
+
 	/*
 		FROM HERE ON we have our search results, it's just a matter of filtering them according to what this user is allowed to see,
 		and then converting them into our frontend format.
 	*/
 	for _, foundAccount := range foundAccounts {
+		if authed.Account == nil {
+			// unauthenticated searches only get accounts that have opted into discovery
+			if foundAccount.Discoverable == nil || !*foundAccount.Discoverable {
+				continue
+			}
+			if apiAcct, err := p.tc.AccountToAPIAccountPublic(ctx, foundAccount); err == nil && apiAcct != nil {
+				searchResult.Accounts = append(searchResult.Accounts, *apiAcct)
+			}
+			continue
+		}
+
 		// make sure there's no block in either direction between the account and the requester
 		if blocked, err := p.db.IsBlocked(ctx, authed.Account.ID, foundAccount.ID, true); err == nil && !blocked {
 			// all good, convert it and add it to the results
@@ -191,7 +229,7 @@ func (p *processor) searchAccountByURI(ctx context.Context, authed *oauth.Auth,
 
 	// we don't have it yet, try to find it remotely
 	return p.federator.GetRemoteAccount(ctx, dereferencing.GetRemoteAccountParams{
-		RequestingUsername: authed.Account.Username,
+		RequestingUsername: searchRequestingUsername(authed),
 		RemoteAccountID:    uri,
 		Blocking:           true,
 		SkipResolve:        !resolve,
@@ -210,10 +248,20 @@ func (p *processor) searchAccountByMention(ctx context.Context, authed *oauth.Au
 
 	// we don't have it yet, try to find it remotely
 	return p.federator.GetRemoteAccount(ctx, dereferencing.GetRemoteAccountParams{
-		RequestingUsername:    authed.Account.Username,
+		RequestingUsername:    searchRequestingUsername(authed),
 		RemoteAccountUsername: username,
 		RemoteAccountHost:     domain,
 		Blocking:              true,
 		SkipResolve:           !resolve,
 	})
 }
+
+// searchRequestingUsername returns the username to make remote
+// requests as on behalf of the searcher, or an empty string
+// (ie., the instance account) if the search has no account.
+func searchRequestingUsername(authed *oauth.Auth) string {
+	if authed.Account == nil {
+		return ""
+	}
+	return authed.Account.Username
+}
@@ -0,0 +1,110 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type SearchTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *SearchTestSuite) TestSearchDiscoverableAccountNoAccount() {
+	// app token only, no user/account
+	authed := &oauth.Auth{
+		Application: suite.testApplications["application_1"],
+	}
+
+	result, errWithCode := suite.processor.SearchGet(context.Background(), authed, &apimodel.SearchQuery{
+		Query: "@the_mighty_zork@localhost:8080",
+	})
+	suite.NoError(errWithCode)
+	suite.Len(result.Accounts, 1)
+	suite.Equal(suite.testAccounts["local_account_1"].ID, result.Accounts[0].ID)
+	suite.True(result.Accounts[0].Discoverable)
+}
+
+func (suite *SearchTestSuite) TestSearchNonDiscoverableAccountNoAccount() {
+	// app token only, no user/account
+	authed := &oauth.Auth{
+		Application: suite.testApplications["application_1"],
+	}
+
+	result, errWithCode := suite.processor.SearchGet(context.Background(), authed, &apimodel.SearchQuery{
+		Query: "@1happyturtle@localhost:8080",
+	})
+	suite.NoError(errWithCode)
+	suite.Empty(result.Accounts)
+}
+
+func (suite *SearchTestSuite) TestSearchNonDiscoverableAccount() {
+	// searching with an account is unaffected by discoverable
+	result, errWithCode := suite.processor.SearchGet(context.Background(), suite.testAutheds["local_account_1"], &apimodel.SearchQuery{
+		Query: "@1happyturtle@localhost:8080",
+	})
+	suite.NoError(errWithCode)
+	suite.Len(result.Accounts, 1)
+	suite.Equal(suite.testAccounts["local_account_2"].ID, result.Accounts[0].ID)
+	suite.False(result.Accounts[0].Discoverable)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusText() {
+	result, errWithCode := suite.processor.SearchGet(context.Background(), suite.testAutheds["local_account_1"], &apimodel.SearchQuery{
+		Query: "direct message",
+	})
+	suite.NoError(errWithCode)
+	suite.Empty(result.Accounts)
+	suite.Len(result.Statuses, 1)
+	suite.Equal(suite.testStatuses["local_account_2_status_6"].ID, result.Statuses[0].ID)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusTextOffset() {
+	result, errWithCode := suite.processor.SearchGet(context.Background(), suite.testAutheds["local_account_1"], &apimodel.SearchQuery{
+		Query:  "hi",
+		Type:   "statuses",
+		Limit:  1,
+		Offset: 1,
+	})
+	suite.NoError(errWithCode)
+	suite.Len(result.Statuses, 1)
+	suite.Equal(suite.testStatuses["admin_account_status_3"].ID, result.Statuses[0].ID)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusTextNoAccount() {
+	// app token only, no user/account, so there are no statuses to search
+	authed := &oauth.Auth{
+		Application: suite.testApplications["application_1"],
+	}
+
+	result, errWithCode := suite.processor.SearchGet(context.Background(), authed, &apimodel.SearchQuery{
+		Query: "direct message",
+	})
+	suite.NoError(errWithCode)
+	suite.Empty(result.Statuses)
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, &SearchTestSuite{})
+}
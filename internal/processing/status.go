@@ -22,6 +22,7 @@ import (
 	"context"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
@@ -30,6 +31,10 @@ func (p *processor) StatusCreate(ctx context.Context, authed *oauth.Auth, form *
 	return p.statusProcessor.Create(ctx, authed.Account, authed.Application, form)
 }
 
+func (p *processor) StatusThreadCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.StatusThreadCreateForm) ([]*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.CreateThread(ctx, authed.Account, authed.Application, form.Statuses)
+}
+
 func (p *processor) StatusDelete(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
 	return p.statusProcessor.Delete(ctx, authed.Account, targetStatusID)
 }
@@ -62,6 +67,34 @@ func (p *processor) StatusUnfave(ctx context.Context, authed *oauth.Auth, target
 	return p.statusProcessor.Unfave(ctx, authed.Account, targetStatusID)
 }
 
+func (p *processor) StatusBookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Bookmark(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusUnbookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Unbookmark(ctx, authed.Account, targetStatusID)
+}
+
 func (p *processor) StatusGetContext(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Context, gtserror.WithCode) {
-	return p.statusProcessor.Context(ctx, authed.Account, targetStatusID)
+	return p.statusProcessor.Context(cache.WithRequestCache(ctx), authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusMute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Mute(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusUnmute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Unmute(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusViewCountGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.StatusViewCount, gtserror.WithCode) {
+	return p.statusProcessor.ViewCountGet(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusDeliveriesGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.StatusDeliveries, gtserror.WithCode) {
+	return p.statusProcessor.DeliveriesGet(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusWebViewCountIncrement(ctx context.Context, targetStatusID string) {
+	p.statusProcessor.ViewCountIncrement(ctx, targetStatusID, false)
 }
@@ -27,6 +27,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 )
 
 func (p *processor) Context(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Context, gtserror.WithCode) {
@@ -46,6 +47,11 @@ func (p *processor) Context(ctx context.Context, requestingAccount *gtsmodel.Acc
 		return nil, gtserror.NewErrorNotFound(errors.New("status is not visible"))
 	}
 
+	filters, err := statusfilter.Get(ctx, p.db, p.tc, requestingAccount, gtsmodel.FilterContextThread)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	context := &apimodel.Context{
 		Ancestors:   []apimodel.Status{},
 		Descendants: []apimodel.Status{},
@@ -60,7 +66,9 @@ func (p *processor) Context(ctx context.Context, requestingAccount *gtsmodel.Acc
 		if v, err := p.filter.StatusVisible(ctx, status, requestingAccount); err == nil && v {
 			apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
 			if err == nil {
-				context.Ancestors = append(context.Ancestors, *apiStatus)
+				if apiStatus = filters.Apply(apiStatus); apiStatus != nil {
+					context.Ancestors = append(context.Ancestors, *apiStatus)
+				}
 			}
 		}
 	}
@@ -78,7 +86,9 @@ func (p *processor) Context(ctx context.Context, requestingAccount *gtsmodel.Acc
 		if v, err := p.filter.StatusVisible(ctx, status, requestingAccount); err == nil && v {
 			apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
 			if err == nil {
-				context.Descendants = append(context.Descendants, *apiStatus)
+				if apiStatus = filters.Apply(apiStatus); apiStatus != nil {
+					context.Descendants = append(context.Descendants, *apiStatus)
+				}
 			}
 		}
 	}
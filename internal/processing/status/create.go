@@ -34,8 +34,43 @@ import (
 )
 
 func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode) {
+	newStatus, errWithCode := p.newStatus(ctx, account, application, form, time.Now(), nil)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// put the new status in the database, along with its mentions and tags
+	if err := p.db.PutStatuses(ctx, []*gtsmodel.Status{newStatus}); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// send it back to the processor for async processing
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  account,
+	})
+
+	// return the frontend representation of the new status to the submitter
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, newStatus, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %s", newStatus.ID, err))
+	}
+
+	return apiStatus, nil
+}
+
+// newStatus builds a new status for the given account from the given form, created at the given time.
+//
+// If inReplyTo is set, the new status will be a reply to it, and any reply ID on the form will be
+// ignored; this is used when the replied-to status has not yet been put in the database. Otherwise,
+// the reply ID on the form will be checked and processed as normal.
+//
+// The new status is NOT put in the database, that's up to the caller.
+func (p *processor) newStatus(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm, createdAt time.Time, inReplyTo *gtsmodel.Status) (*gtsmodel.Status, gtserror.WithCode) {
 	accountURIs := uris.GenerateURIsForAccount(account.Username)
-	thisStatusID, err := id.NewULID()
+	thisStatusID, err := id.NewULIDFromTime(createdAt)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -47,8 +82,8 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		ID:                       thisStatusID,
 		URI:                      accountURIs.StatusesURI + "/" + thisStatusID,
 		URL:                      accountURIs.StatusesURL + "/" + thisStatusID,
-		CreatedAt:                time.Now(),
-		UpdatedAt:                time.Now(),
+		CreatedAt:                createdAt,
+		UpdatedAt:                createdAt,
 		Local:                    &local,
 		AccountID:                account.ID,
 		AccountURI:               account.URI,
@@ -60,7 +95,11 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		Text:                     form.Status,
 	}
 
-	if errWithCode := p.ProcessReplyToID(ctx, form, account.ID, newStatus); errWithCode != nil {
+	if inReplyTo != nil {
+		newStatus.InReplyToID = inReplyTo.ID
+		newStatus.InReplyToURI = inReplyTo.URI
+		newStatus.InReplyToAccountID = inReplyTo.AccountID
+	} else if errWithCode := p.ProcessReplyToID(ctx, form, account.ID, newStatus); errWithCode != nil {
 		return nil, errWithCode
 	}
 
@@ -68,7 +107,11 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		return nil, errWithCode
 	}
 
-	if err := p.ProcessVisibility(ctx, form, account.Privacy, newStatus); err != nil {
+	if err := p.ProcessPoll(ctx, form, newStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.ProcessVisibility(ctx, form, account, newStatus); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
@@ -92,24 +135,5 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	// put the new status in the database
-	if err := p.db.PutStatus(ctx, newStatus); err != nil {
-		return nil, gtserror.NewErrorInternalError(err)
-	}
-
-	// send it back to the processor for async processing
-	p.clientWorker.Queue(messages.FromClientAPI{
-		APObjectType:   ap.ObjectNote,
-		APActivityType: ap.ActivityCreate,
-		GTSModel:       newStatus,
-		OriginAccount:  account,
-	})
-
-	// return the frontend representation of the new status to the submitter
-	apiStatus, err := p.tc.StatusToAPIStatus(ctx, newStatus, account)
-	if err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %s", newStatus.ID, err))
-	}
-
-	return apiStatus, nil
+	return newStatus, nil
 }
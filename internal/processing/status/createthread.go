@@ -0,0 +1,100 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func (p *processor) CreateThread(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, forms []*apimodel.AdvancedStatusCreateForm) ([]*apimodel.Status, gtserror.WithCode) {
+	if len(forms) == 0 {
+		err := errors.New("no statuses provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	var (
+		newStatuses = make([]*gtsmodel.Status, 0, len(forms))
+		mediaIDs    = make(map[string]struct{})
+		createdAt   = time.Now()
+		previous    *gtsmodel.Status
+	)
+
+	// Build every status in the thread before putting
+	// any of them in the database, so that if any one of
+	// them is no good we can bail without posting anything.
+	for i, form := range forms {
+		if i > 0 && form.InReplyToID != "" {
+			err := fmt.Errorf("status %d in thread has in_reply_to_id set; only the first status in a thread may reply to another status", i)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		for _, mediaID := range form.MediaIDs {
+			if _, ok := mediaIDs[mediaID]; ok {
+				err := fmt.Errorf("media with id %s is attached to more than one status in thread", mediaID)
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+			mediaIDs[mediaID] = struct{}{}
+		}
+
+		// Offset each status by a millisecond so that
+		// they're ordered correctly by creation time + ID.
+		statusCreatedAt := createdAt.Add(time.Duration(i) * time.Millisecond)
+
+		newStatus, errWithCode := p.newStatus(ctx, account, application, form, statusCreatedAt, previous)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		newStatuses = append(newStatuses, newStatus)
+		previous = newStatus
+	}
+
+	// put the whole thread in the database in one go
+	if err := p.db.PutStatuses(ctx, newStatuses); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiStatuses := make([]*apimodel.Status, 0, len(newStatuses))
+	for _, newStatus := range newStatuses {
+		// send it back to the processor for async processing
+		p.clientWorker.Queue(messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       newStatus,
+			OriginAccount:  account,
+		})
+
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, newStatus, account)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %s", newStatus.ID, err))
+		}
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
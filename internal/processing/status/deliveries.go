@@ -0,0 +1,73 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) DeliveriesGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.StatusDeliveries, gtserror.WithCode) {
+	targetStatus, err := p.db.GetStatusByID(ctx, targetStatusID)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("status %s not found", targetStatusID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching status %s: %s", targetStatusID, err))
+	}
+
+	// only the author gets to see where their status was delivered
+	if targetStatus.AccountID != requestingAccount.ID {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status %s does not belong to account %s", targetStatusID, requestingAccount.ID))
+	}
+
+	deliveries, err := p.db.GetStatusDeliveries(ctx, targetStatus.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching deliveries of status %s: %s", targetStatus.ID, err))
+	}
+
+	apiDeliveries := &apimodel.StatusDeliveries{
+		FailedDomains: []string{},
+		Domains:       make([]apimodel.StatusDeliveryDomain, 0, len(deliveries)),
+	}
+
+	for _, d := range deliveries {
+		apiDeliveries.Delivered += d.Delivered
+		apiDeliveries.Failed += d.Failed
+		if d.Failed != 0 {
+			apiDeliveries.FailedDomains = append(apiDeliveries.FailedDomains, d.Domain)
+		}
+
+		apiDeliveries.Domains = append(apiDeliveries.Domains, apimodel.StatusDeliveryDomain{
+			Domain:      d.Domain,
+			Delivered:   d.Delivered,
+			Failed:      d.Failed,
+			Error:       d.Error,
+			DeliveredAt: util.FormatISO8601(d.UpdatedAt),
+		})
+	}
+
+	return apiDeliveries, nil
+}
@@ -0,0 +1,104 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) Mute(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getMuteTargetStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	muted, err := p.db.IsThreadMutedBy(ctx, targetStatus, requestingAccount.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error checking existing mute: %s", err))
+	}
+
+	if !muted {
+		// the mute is placed on the top-most status we know of in
+		// the thread, so that it covers every reply in the thread
+		threadIDs, err := p.db.GetStatusThreadIDs(ctx, targetStatus)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting thread of status %s: %s", targetStatus.ID, err))
+		}
+
+		rootStatus := targetStatus
+		if rootID := threadIDs[len(threadIDs)-1]; rootID != targetStatus.ID {
+			rootStatus, err = p.db.GetStatusByID(ctx, rootID)
+			if err != nil {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching thread root status %s: %s", rootID, err))
+			}
+		}
+
+		muteID, err := id.NewULID()
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		mute := &gtsmodel.StatusMute{
+			ID:              muteID,
+			AccountID:       requestingAccount.ID,
+			TargetAccountID: rootStatus.AccountID,
+			StatusID:        rootStatus.ID,
+		}
+
+		if err := p.db.Put(ctx, mute); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting mute in database: %s", err))
+		}
+	}
+
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, targetStatus, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %s", targetStatus.ID, err))
+	}
+
+	return apiStatus, nil
+}
+
+// getMuteTargetStatus fetches the status with the given id,
+// making sure that it's visible to the requesting account.
+func (p *processor) getMuteTargetStatus(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*gtsmodel.Status, gtserror.WithCode) {
+	targetStatus, err := p.db.GetStatusByID(ctx, targetStatusID)
+	if err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error fetching status %s: %s", targetStatusID, err))
+	}
+	if targetStatus.Account == nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no status owner for status %s", targetStatusID))
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, targetStatus, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error seeing if status %s is visible: %s", targetStatus.ID, err))
+	}
+	if !visible {
+		return nil, gtserror.NewErrorNotFound(errors.New("status is not visible"))
+	}
+
+	return targetStatus, nil
+}
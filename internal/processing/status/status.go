@@ -36,6 +36,9 @@ import (
 type Processor interface {
 	// Create processes the given form to create a new status, returning the api model representation of that status if it's OK.
 	Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode)
+	// CreateThread processes the given forms to create a new thread of statuses, each one replying to the one before it.
+	// The statuses are only posted if every one of them is OK; the api model representations are returned in thread order.
+	CreateThread(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, forms []*apimodel.AdvancedStatusCreateForm) ([]*apimodel.Status, gtserror.WithCode)
 	// Delete processes the delete of a given status, returning the deleted status if the delete goes through.
 	Delete(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// Fave processes the faving of a given status, returning the updated status if the fave goes through.
@@ -54,14 +57,30 @@ type Processor interface {
 	Unfave(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// Context returns the context (previous and following posts) from the given status ID
 	Context(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Context, gtserror.WithCode)
+	// Mute mutes the thread that the given status is part of, returning the updated status if the mute goes through.
+	Mute(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Unmute unmutes the thread that the given status is part of, returning the updated status if the unmute goes through.
+	Unmute(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Bookmark bookmarks the given status for the requesting account, returning the updated status.
+	Bookmark(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Unbookmark removes the requesting account's bookmark of the given status, if it has one, returning the updated status.
+	Unbookmark(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// ViewCountGet returns the view counts of the given status, if the account is its author.
+	ViewCountGet(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.StatusViewCount, gtserror.WithCode)
+	// ViewCountIncrement counts one more view of the given status, if view counting is enabled.
+	// A view of the status's web page is counted if fetch is false, or an ActivityPub fetch otherwise.
+	ViewCountIncrement(ctx context.Context, targetStatusID string, fetch bool)
+	// DeliveriesGet returns how the given status was delivered to other servers, if the account is its author.
+	DeliveriesGet(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.StatusDeliveries, gtserror.WithCode)
 
 	/*
 		PROCESSING UTILS
 	*/
 
-	ProcessVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultVis gtsmodel.Visibility, status *gtsmodel.Status) error
+	ProcessVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, account *gtsmodel.Account, status *gtsmodel.Status) error
 	ProcessReplyToID(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, thisAccountID string, status *gtsmodel.Status) gtserror.WithCode
 	ProcessMediaIDs(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, thisAccountID string, status *gtsmodel.Status) gtserror.WithCode
+	ProcessPoll(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) error
 	ProcessLanguage(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error
 	ProcessMentions(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountID string, status *gtsmodel.Status) error
 	ProcessTags(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountID string, status *gtsmodel.Status) error
@@ -22,18 +22,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
-func (p *processor) ProcessVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultVis gtsmodel.Visibility, status *gtsmodel.Status) error {
+func (p *processor) ProcessVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, account *gtsmodel.Account, status *gtsmodel.Status) error {
 	// by default all flags are set to true
 	federated := true
 	boostable := true
@@ -46,8 +51,8 @@ func (p *processor) ProcessVisibility(ctx context.Context, form *apimodel.Advanc
 	switch {
 	case form.Visibility != "":
 		vis = p.tc.APIVisToVis(form.Visibility)
-	case accountDefaultVis != "":
-		vis = accountDefaultVis
+	case account.Privacy != "":
+		vis = account.Privacy
 	default:
 		vis = gtsmodel.VisibilityDefault
 	}
@@ -75,8 +80,11 @@ func (p *processor) ProcessVisibility(ctx context.Context, form *apimodel.Advanc
 		}
 
 	case gtsmodel.VisibilityFollowersOnly, gtsmodel.VisibilityMutualsOnly:
-		// for followers or mutuals only, boostable will *always* be false, but the other fields can be set so check and apply them
-		boostable = false
+		// for followers-only, boostable depends on whether the account allows followers to boost its
+		// followers-only posts; for mutuals only, boostable will *always* be false. The other fields
+		// can be set so check and apply them
+		boostable = vis == gtsmodel.VisibilityFollowersOnly &&
+			account.FollowersOnlyBoostable != nil && *account.FollowersOnlyBoostable
 
 		if form.Federated != nil {
 			federated = *form.Federated
@@ -201,6 +209,39 @@ func (p *processor) ProcessMediaIDs(ctx context.Context, form *apimodel.Advanced
 	return nil
 }
 
+func (p *processor) ProcessPoll(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) error {
+	if form.Poll == nil {
+		return nil
+	}
+
+	pollID, err := id.NewULIDFromTime(status.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	options := make([]string, 0, len(form.Poll.Options))
+	for _, o := range form.Poll.Options {
+		options = append(options, text.SanitizePlaintext(o))
+	}
+
+	multiple := form.Poll.Multiple
+	hideCounts := form.Poll.HideTotals
+	status.Poll = &gtsmodel.Poll{
+		ID:         pollID,
+		CreatedAt:  status.CreatedAt,
+		UpdatedAt:  status.CreatedAt,
+		StatusID:   status.ID,
+		Options:    options,
+		Votes:      make([]int, len(options)),
+		Multiple:   &multiple,
+		HideCounts: &hideCounts,
+		ExpiresAt:  status.CreatedAt.Add(time.Duration(form.Poll.ExpiresIn) * time.Second),
+	}
+	status.PollID = pollID
+	status.ActivityStreamsType = ap.ActivityQuestion
+	return nil
+}
+
 func (p *processor) ProcessLanguage(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error {
 	if form.Language != "" {
 		status.Language = form.Language
@@ -225,15 +266,12 @@ func (p *processor) ProcessMentions(ctx context.Context, form *apimodel.Advanced
 			continue
 		}
 
-		if err := p.db.Put(ctx, gtsMention); err != nil {
-			log.Errorf("ProcessMentions: error putting mention in db: %s", err)
-		}
-
 		mentions = append(mentions, gtsMention)
 		mentionIDs = append(mentionIDs, gtsMention.ID)
 	}
 
-	// add full populated gts menchies to the status for passing them around conveniently
+	// add full populated gts menchies to the status for passing them around conveniently,
+	// and for putting in the db along with the status
 	status.Mentions = mentions
 	// add just the ids of the mentioned accounts to the status for putting in the db
 	status.MentionIDs = mentionIDs
@@ -248,14 +286,10 @@ func (p *processor) ProcessTags(ctx context.Context, form *apimodel.AdvancedStat
 		return fmt.Errorf("error generating hashtags from status: %s", err)
 	}
 	for _, tag := range gtsTags {
-		if err := p.db.Put(ctx, tag); err != nil {
-			if !errors.Is(err, db.ErrAlreadyExists) {
-				return fmt.Errorf("error putting tags in db: %s", err)
-			}
-		}
 		tags = append(tags, tag.ID)
 	}
-	// add full populated gts tags to the status for passing them around conveniently
+	// add full populated gts tags to the status for passing them around conveniently,
+	// and for putting any new ones in the db along with the status
 	status.Tags = gtsTags
 	// add just the ids of the used tags to the status for putting in the db
 	status.TagIDs = tags
@@ -265,7 +299,11 @@ func (p *processor) ProcessTags(ctx context.Context, form *apimodel.AdvancedStat
 func (p *processor) ProcessEmojis(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountID string, status *gtsmodel.Status) error {
 	// for each emoji shortcode in the text, check if it's an enabled
 	// emoji on this instance, and if so, add it to the status
-	emojiShortcodes := util.DeriveEmojisFromText(form.SpoilerText + "\n\n" + form.Status)
+	emojiText := form.SpoilerText + "\n\n" + form.Status
+	if form.Poll != nil {
+		emojiText += "\n\n" + strings.Join(form.Poll.Options, "\n")
+	}
+	emojiShortcodes := util.DeriveEmojisFromText(emojiText)
 	status.Emojis = make([]*gtsmodel.Emoji, 0, len(emojiShortcodes))
 	status.EmojiIDs = make([]string, 0, len(emojiShortcodes))
 
@@ -0,0 +1,73 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) ViewCountGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.StatusViewCount, gtserror.WithCode) {
+	if !config.GetInstanceStatusViewCounts() {
+		err := errors.New("status view counts are not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	targetStatus, err := p.db.GetStatusByID(ctx, targetStatusID)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("status %s not found", targetStatusID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching status %s: %s", targetStatusID, err))
+	}
+
+	// only the author gets to see how often their status has been viewed
+	if targetStatus.AccountID != requestingAccount.ID {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status %s does not belong to account %s", targetStatusID, requestingAccount.ID))
+	}
+
+	viewCount, err := p.db.GetStatusViewCount(ctx, targetStatus.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching view count of status %s: %s", targetStatus.ID, err))
+	}
+
+	return &apimodel.StatusViewCount{
+		WebViews: viewCount.WebViews,
+		Fetches:  viewCount.Fetches,
+	}, nil
+}
+
+func (p *processor) ViewCountIncrement(ctx context.Context, targetStatusID string, fetch bool) {
+	if !config.GetInstanceStatusViewCounts() {
+		return
+	}
+
+	// a failure to count a view shouldn't stop the status being served, so just log it
+	if err := p.db.IncrementStatusViewCount(ctx, targetStatusID, fetch); err != nil {
+		log.Errorf("ViewCountIncrement: error counting view of status %s: %s", targetStatusID, err)
+	}
+}
@@ -24,11 +24,13 @@ import (
 	"fmt"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -138,6 +140,8 @@ func StatusSkipInsertFunction() timeline.SkipInsertFunction {
 }
 
 func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
+	ctx = cache.WithRequestCache(ctx)
+
 	preparedItems, err := p.statusTimelines.GetTimeline(ctx, authed.Account.ID, maxID, sinceID, minID, limit, local)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -149,6 +153,11 @@ func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, max
 		return util.EmptyPageableResponse(), nil
 	}
 
+	filters, err := statusfilter.Get(ctx, p.db, p.tc, authed.Account, gtsmodel.FilterContextHome)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	items := []interface{}{}
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
@@ -160,6 +169,15 @@ func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, max
 		if i == 0 {
 			prevMinIDValue = item.GetID()
 		}
+
+		// statuses hidden by filters still count for paging
+		if apiStatus, ok := item.(*apimodel.Status); ok {
+			filtered := filters.Apply(apiStatus)
+			if filtered == nil {
+				continue
+			}
+			item = filtered
+		}
 		items = append(items, item)
 	}
 
@@ -173,6 +191,8 @@ func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, max
 }
 
 func (p *processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
+	ctx = cache.WithRequestCache(ctx)
+
 	statuses, err := p.db.GetPublicTimeline(ctx, maxID, sinceID, minID, limit, local)
 	if err != nil {
 		if err == db.ErrNoEntries {
@@ -218,6 +238,8 @@ func (p *processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, m
 }
 
 func (p *processor) FavedTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	ctx = cache.WithRequestCache(ctx)
+
 	statuses, nextMaxID, prevMinID, err := p.db.GetFavedTimeline(ctx, authed.Account.ID, maxID, minID, limit)
 	if err != nil {
 		if err == db.ErrNoEntries {
@@ -228,7 +250,7 @@ func (p *processor) FavedTimelineGet(ctx context.Context, authed *oauth.Auth, ma
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	filtered, err := p.filterFavedStatuses(ctx, authed, statuses)
+	filtered, err := p.filterVisibleStatuses(ctx, authed, statuses)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -251,7 +273,59 @@ func (p *processor) FavedTimelineGet(ctx context.Context, authed *oauth.Auth, ma
 	})
 }
 
+func (p *processor) BookmarkedTimelineGet(ctx context.Context, authed *oauth.Auth, folderID string, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	ctx = cache.WithRequestCache(ctx)
+
+	var extraQueryParams []string
+	if folderID != "" {
+		if _, errWithCode := p.getOwnBookmarkFolder(ctx, authed.Account, folderID); errWithCode != nil {
+			return nil, errWithCode
+		}
+		extraQueryParams = append(extraQueryParams, "folder_id="+folderID)
+	}
+
+	statuses, nextMaxID, prevMinID, err := p.db.GetBookmarkedTimeline(ctx, authed.Account.ID, folderID, maxID, minID, limit)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			// there are just no entries left
+			return util.EmptyPageableResponse(), nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// statuses bookmarked while visible may since have become invisible,
+	// eg., because the bookmarking account was blocked by their author
+	filtered, err := p.filterVisibleStatuses(ctx, authed, statuses)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(filtered) == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := []interface{}{}
+	for _, item := range filtered {
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:            items,
+		Path:             "api/v1/bookmarks",
+		NextMaxIDValue:   nextMaxID,
+		PrevMinIDValue:   prevMinID,
+		Limit:            limit,
+		ExtraQueryParams: extraQueryParams,
+	})
+}
+
 func (p *processor) filterPublicStatuses(ctx context.Context, authed *oauth.Auth, statuses []*gtsmodel.Status) ([]*apimodel.Status, error) {
+	filters, err := statusfilter.Get(ctx, p.db, p.tc, authed.Account, gtsmodel.FilterContextPublic)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	apiStatuses := []*apimodel.Status{}
 	for _, s := range statuses {
 		targetAccount := &gtsmodel.Account{}
@@ -263,6 +337,10 @@ func (p *processor) filterPublicStatuses(ctx context.Context, authed *oauth.Auth
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("filterPublicStatuses: error getting status author: %s", err))
 		}
 
+		if hideMirrors := authed.Account.HideMirrors; hideMirrors != nil && *hideMirrors && isMirror(targetAccount) {
+			continue
+		}
+
 		timelineable, err := p.filter.StatusPublictimelineable(ctx, s, authed.Account)
 		if err != nil {
 			log.Debugf("filterPublicStatuses: skipping status %s because of an error checking status visibility: %s", s.ID, err)
@@ -278,27 +356,31 @@ func (p *processor) filterPublicStatuses(ctx context.Context, authed *oauth.Auth
 			continue
 		}
 
+		if apiStatus = filters.Apply(apiStatus); apiStatus == nil {
+			continue
+		}
+
 		apiStatuses = append(apiStatuses, apiStatus)
 	}
 
 	return apiStatuses, nil
 }
 
-func (p *processor) filterFavedStatuses(ctx context.Context, authed *oauth.Auth, statuses []*gtsmodel.Status) ([]*apimodel.Status, error) {
+func (p *processor) filterVisibleStatuses(ctx context.Context, authed *oauth.Auth, statuses []*gtsmodel.Status) ([]*apimodel.Status, error) {
 	apiStatuses := []*apimodel.Status{}
 	for _, s := range statuses {
 		targetAccount := &gtsmodel.Account{}
 		if err := p.db.GetByID(ctx, s.AccountID, targetAccount); err != nil {
 			if err == db.ErrNoEntries {
-				log.Debugf("filterFavedStatuses: skipping status %s because account %s can't be found in the db", s.ID, s.AccountID)
+				log.Debugf("filterVisibleStatuses: skipping status %s because account %s can't be found in the db", s.ID, s.AccountID)
 				continue
 			}
-			return nil, gtserror.NewErrorInternalError(fmt.Errorf("filterPublicStatuses: error getting status author: %s", err))
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("filterVisibleStatuses: error getting status author: %s", err))
 		}
 
 		timelineable, err := p.filter.StatusVisible(ctx, s, authed.Account)
 		if err != nil {
-			log.Debugf("filterFavedStatuses: skipping status %s because of an error checking status visibility: %s", s.ID, err)
+			log.Debugf("filterVisibleStatuses: skipping status %s because of an error checking status visibility: %s", s.ID, err)
 			continue
 		}
 		if !timelineable {
@@ -307,7 +389,7 @@ func (p *processor) filterFavedStatuses(ctx context.Context, authed *oauth.Auth,
 
 		apiStatus, err := p.tc.StatusToAPIStatus(ctx, s, authed.Account)
 		if err != nil {
-			log.Debugf("filterFavedStatuses: skipping status %s because it couldn't be converted to its api representation: %s", s.ID, err)
+			log.Debugf("filterVisibleStatuses: skipping status %s because it couldn't be converted to its api representation: %s", s.ID, err)
 			continue
 		}
 
@@ -316,3 +398,10 @@ func (p *processor) filterFavedStatuses(ctx context.Context, authed *oauth.Auth,
 
 	return apiStatuses, nil
 }
+
+// isMirror returns true if the given account is an automated feed, either because
+// it identifies itself as a bot (Service or Application actor), or because an admin
+// has flagged it as a mirror of an external feed.
+func isMirror(account *gtsmodel.Account) bool {
+	return (account.Bot != nil && *account.Bot) || (account.Mirror != nil && *account.Mirror)
+}
@@ -0,0 +1,73 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusTimelineTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *StatusTimelineTestSuite) publicTimelineAuthors(authed *oauth.Auth) []string {
+	resp, errWithCode := suite.processor.PublicTimelineGet(context.Background(), authed, "", "", "", 20, false)
+	suite.NoError(errWithCode)
+
+	authors := []string{}
+	for _, item := range resp.Items {
+		status, ok := item.(*apimodel.Status)
+		if !ok {
+			suite.FailNow("item in response wasn't *apimodel.Status")
+		}
+		authors = append(authors, status.Account.ID)
+	}
+	return authors
+}
+
+func (suite *StatusTimelineTestSuite) TestPublicTimelineHideMirrors() {
+	mirrorAccount := suite.testAccounts["admin_account"]
+	mirrorAccount.Mirror = testrig.TrueBool()
+	if _, err := suite.db.UpdateAccount(context.Background(), mirrorAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	authed := *suite.testAutheds["local_account_1"]
+	account := *authed.Account
+	authed.Account = &account
+
+	// mirror statuses are shown by default
+	suite.Contains(suite.publicTimelineAuthors(&authed), mirrorAccount.ID)
+
+	// and hidden once the preference is set
+	authed.Account.HideMirrors = testrig.TrueBool()
+	authors := suite.publicTimelineAuthors(&authed)
+	suite.NotEmpty(authors)
+	suite.NotContains(authors, mirrorAccount.ID)
+}
+
+func TestStatusTimelineTestSuite(t *testing.T) {
+	suite.Run(t, &StatusTimelineTestSuite{})
+}
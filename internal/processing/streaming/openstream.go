@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -39,6 +40,18 @@ func (p *processor) OpenStreamForAccount(ctx context.Context, account *gtsmodel.
 	}...)
 	l.Debug("received open stream request")
 
+	// accounts can only stream their own lists
+	if listID := stream.ListID(streamTimeline); listID != "" {
+		list, err := p.db.GetListByID(ctx, listID)
+		if err != nil && err != db.ErrNoEntries {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting list %s: %s", listID, err))
+		}
+		if list == nil || list.AccountID != account.ID {
+			err := fmt.Errorf("list %s not found", listID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+	}
+
 	// each stream needs a unique ID so we know to close it
 	streamID, err := id.NewRandomULID()
 	if err != nil {
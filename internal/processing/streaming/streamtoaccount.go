@@ -47,9 +47,17 @@ func (p *processor) streamToAccount(payload string, event string, timelines []st
 		}
 
 		for _, t := range timelines {
-			if s.Timeline == string(t) {
+			// list streams are sent the messages for their own list,
+			// as well as ones for every list, such as deletes
+			listID := stream.ListID(s.Timeline)
+			if s.Timeline == string(t) || (listID != "" && t == stream.TimelineList) {
+				streamNames := []string{string(t)}
+				if listID != "" {
+					streamNames = []string{stream.TimelineList, listID}
+				}
+
 				s.Messages <- &stream.Message{
-					Stream:  []string{string(t)},
+					Stream:  streamNames,
 					Event:   string(event),
 					Payload: payload,
 				}
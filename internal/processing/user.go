@@ -20,6 +20,8 @@ package processing
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -31,6 +33,50 @@ func (p *processor) UserChangePassword(ctx context.Context, authed *oauth.Auth,
 	return p.userProcessor.ChangePassword(ctx, authed.User, form.OldPassword, form.NewPassword)
 }
 
+func (p *processor) UserChangeUsername(ctx context.Context, authed *oauth.Auth, form *apimodel.UsernameChangeRequest) (*apimodel.Account, gtserror.WithCode) {
+	return p.accountProcessor.ChangeUsername(ctx, authed.Account, authed.User, form.Password, form.NewUsername)
+}
+
 func (p *processor) UserConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode) {
 	return p.userProcessor.ConfirmEmail(ctx, token)
 }
+
+func (p *processor) UserPostByMailGet(ctx context.Context, authed *oauth.Auth) (*apimodel.PostByMail, gtserror.WithCode) {
+	return p.userProcessor.PostByMailGet(ctx, authed.User)
+}
+
+func (p *processor) UserPostByMailEnable(ctx context.Context, authed *oauth.Auth) (*apimodel.PostByMail, gtserror.WithCode) {
+	return p.userProcessor.PostByMailEnable(ctx, authed.User)
+}
+
+func (p *processor) UserPostByMailDisable(ctx context.Context, authed *oauth.Auth) (*apimodel.PostByMail, gtserror.WithCode) {
+	return p.userProcessor.PostByMailDisable(ctx, authed.User)
+}
+
+func (p *processor) UserLegalDocumentsToAccept(ctx context.Context, user *gtsmodel.User) ([]*apimodel.LegalDocument, gtserror.WithCode) {
+	kinds, err := p.db.GetLegalDocumentKindsToAccept(ctx, user.LegalAcceptedAt)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting legal documents to accept for user %s: %s", user.ID, err))
+	}
+
+	// show the latest version of each document, even if
+	// only an earlier version was the one requiring acceptance
+	apiDocuments := make([]*apimodel.LegalDocument, 0, len(kinds))
+	for _, kind := range kinds {
+		apiDocument, errWithCode := p.InstanceLegalDocumentGet(ctx, kind, 0)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiDocuments = append(apiDocuments, apiDocument)
+	}
+
+	return apiDocuments, nil
+}
+
+func (p *processor) UserLegalDocumentsAccept(ctx context.Context, user *gtsmodel.User) gtserror.WithCode {
+	user.LegalAcceptedAt = time.Now()
+	if _, err := p.db.UpdateUser(ctx, user, "legal_accepted_at"); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("db error recording acceptance of legal documents for user %s: %s", user.ID, err))
+	}
+	return nil
+}
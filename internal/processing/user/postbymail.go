@@ -0,0 +1,149 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// postByMailApplicationName is the name of the application that statuses posted by email are created with.
+const postByMailApplicationName = "Post by email"
+
+func (p *processor) PostByMailGet(ctx context.Context, user *gtsmodel.User) (*apimodel.PostByMail, gtserror.WithCode) {
+	if !config.GetMailGatewayEnabled() {
+		err := errors.New("posting by email is not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return postByMailToAPI(user), nil
+}
+
+func (p *processor) PostByMailEnable(ctx context.Context, user *gtsmodel.User) (*apimodel.PostByMail, gtserror.WithCode) {
+	if !config.GetMailGatewayEnabled() {
+		err := errors.New("posting by email is not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	// emails are only accepted from the user's confirmed address
+	if user.Email == "" || user.ConfirmedAt.IsZero() {
+		err := errors.New("you must confirm your email address before you can post by email")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	token, err := newPostByMailToken()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// the application is kept when post by email is disabled,
+	// so that statuses posted by email before still refer to it
+	if user.PostByMailApplicationID == "" {
+		app, err := p.newPostByMailApplication(ctx)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		user.PostByMailApplicationID = app.ID
+	}
+
+	user.PostByMailToken = token
+	if _, err := p.db.UpdateUser(ctx, user, "post_by_mail_token", "post_by_mail_application_id", "updated_at"); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("PostByMailEnable: db error updating user: %s", err))
+	}
+
+	return postByMailToAPI(user), nil
+}
+
+func (p *processor) PostByMailDisable(ctx context.Context, user *gtsmodel.User) (*apimodel.PostByMail, gtserror.WithCode) {
+	if !config.GetMailGatewayEnabled() {
+		err := errors.New("posting by email is not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if user.PostByMailToken != "" {
+		user.PostByMailToken = ""
+		if _, err := p.db.UpdateUser(ctx, user, "post_by_mail_token", "updated_at"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("PostByMailDisable: db error updating user: %s", err))
+		}
+	}
+
+	return postByMailToAPI(user), nil
+}
+
+// newPostByMailApplication puts a new application in the database, for a user's statuses posted by email to be created with.
+func (p *processor) newPostByMailApplication(ctx context.Context) (*gtsmodel.Application, error) {
+	appID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, err
+	}
+
+	// no oauth client is created for this application, since
+	// it's only used for attributing statuses, not for tokens
+	clientID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, err
+	}
+
+	app := &gtsmodel.Application{
+		ID:           appID,
+		Name:         postByMailApplicationName,
+		RedirectURI:  oauth.OOBURI,
+		ClientID:     clientID,
+		ClientSecret: uuid.NewString(),
+		Scopes:       "write:statuses",
+	}
+
+	if err := p.db.Put(ctx, app); err != nil {
+		return nil, fmt.Errorf("newPostByMailApplication: db error putting application: %s", err)
+	}
+
+	return app, nil
+}
+
+// newPostByMailToken returns a new random token for use as
+// the local part of a user's post by email address.
+func newPostByMailToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("newPostByMailToken: error reading random bytes: %s", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func postByMailToAPI(user *gtsmodel.User) *apimodel.PostByMail {
+	if user.PostByMailToken == "" {
+		return &apimodel.PostByMail{Enabled: false}
+	}
+
+	return &apimodel.PostByMail{
+		Enabled: true,
+		Address: user.PostByMailToken + "@" + config.GetMailGatewayDomain(),
+	}
+}
@@ -21,6 +21,7 @@ package user
 import (
 	"context"
 
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -36,6 +37,13 @@ type Processor interface {
 	SendConfirmEmail(ctx context.Context, user *gtsmodel.User, username string) error
 	// ConfirmEmail confirms an email address using the given token.
 	ConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode)
+	// PostByMailGet returns the settings for posting statuses by email for the given user.
+	PostByMailGet(ctx context.Context, user *gtsmodel.User) (*apimodel.PostByMail, gtserror.WithCode)
+	// PostByMailEnable gives the user a new secret address they can post statuses to by email,
+	// replacing their previous address if they had one.
+	PostByMailEnable(ctx context.Context, user *gtsmodel.User) (*apimodel.PostByMail, gtserror.WithCode)
+	// PostByMailDisable stops the user from posting statuses by email, until they enable it again.
+	PostByMailDisable(ctx context.Context, user *gtsmodel.User) (*apimodel.PostByMail, gtserror.WithCode)
 }
 
 type processor struct {
@@ -45,8 +45,9 @@ const (
 )
 
 const (
-	maximumUsernameLength       = 64
-	maximumEmojiShortcodeLength = 30
+	maximumUsernameLength         = 64
+	maximumEmojiShortcodeLength   = 30
+	maximumClientSettingKeyLength = 64
 )
 
 var (
@@ -79,6 +80,10 @@ var (
 	// EmojiFinder extracts emoji strings from a piece of text.
 	EmojiFinder = regexp.MustCompile(emojiFinderString)
 
+	clientSettingKey = fmt.Sprintf(`[a-zA-Z0-9_\-\.:]{1,%d}`, maximumClientSettingKeyLength)
+	// ClientSettingKey validates the key of a setting stored via the client settings API.
+	ClientSettingKey = regexp.MustCompile(fmt.Sprintf("^%s$", clientSettingKey))
+
 	// usernameString defines an acceptable username on this instance
 	usernameString = fmt.Sprintf(`[a-z0-9_]{2,%d}`, maximumUsernameLength)
 	// Username can be used to validate usernames of new signups
@@ -29,6 +29,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"golang.org/x/crypto/acme/autocert"
 )
@@ -152,15 +153,21 @@ func New(ctx context.Context, db db.DB) (Router, error) {
 	// create the actual engine here -- this is the core request routing handler for gts
 	engine := gin.New()
 	engine.Use(loggingMiddleware)
+	engine.Use(readYourWritesMiddleware)
 
 	// 8 MiB
 	engine.MaxMultipartMemory = 8 << 20
 
 	// set up IP forwarding via x-forward-* headers.
 	trustedProxies := config.GetTrustedProxies()
+	trustedNetworks, err := parseTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
 	if err := engine.SetTrustedProxies(trustedProxies); err != nil {
 		return nil, err
 	}
+	engine.Use(clientIPMiddleware(trustedNetworks))
 
 	// enable cors on the engine
 	if err := useCors(engine); err != nil {
@@ -177,6 +184,11 @@ func New(ctx context.Context, db db.DB) (Router, error) {
 		return nil, err
 	}
 
+	// load message catalogs for translating templates
+	if err := i18n.Load(); err != nil {
+		return nil, err
+	}
+
 	// set template functions
 	LoadTemplateFunctions(engine)
 
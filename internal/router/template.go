@@ -23,11 +23,14 @@ import (
 	"html/template"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
 	"github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -58,7 +61,108 @@ func LoadTemplates(engine *gin.Engine) error {
 		return fmt.Errorf("%s doesn't seem to contain the templates; index.tmpl is missing: %w", templateBaseDir, err)
 	}
 
-	engine.LoadHTMLGlob(filepath.Join(templateBaseDir, "*"))
+	tmpl, err := template.New("").Funcs(engine.FuncMap).ParseGlob(filepath.Join(templateBaseDir, "*"))
+	if err != nil {
+		return fmt.Errorf("error parsing templates in %s: %w", templateBaseDir, err)
+	}
+
+	if err := loadErrorTemplates(tmpl); err != nil {
+		return err
+	}
+
+	htmlRender, err := newTranslatedRender(tmpl)
+	if err != nil {
+		return err
+	}
+
+	engine.HTMLRender = htmlRender
+	return nil
+}
+
+// translatedRender renders html templates in the language given as "lang" in the
+// template data, using a copy of the templates for each language whose "t" function
+// translates messages into that language. Templates without a language are english.
+type translatedRender struct {
+	templates map[string]*template.Template
+	english   *template.Template
+}
+
+func newTranslatedRender(tmpl *template.Template) (*translatedRender, error) {
+	r := &translatedRender{
+		templates: make(map[string]*template.Template),
+		english:   tmpl,
+	}
+
+	for _, lang := range i18n.Languages() {
+		clone, err := tmpl.Clone()
+		if err != nil {
+			return nil, fmt.Errorf("error copying templates for language %s: %w", lang.Tag, err)
+		}
+		r.templates[lang.Tag] = clone.Funcs(template.FuncMap{"t": translate(lang.Tag)})
+	}
+
+	return r, nil
+}
+
+// Instance implements render.HTMLRender.
+func (r *translatedRender) Instance(name string, data any) render.Render {
+	tmpl := r.english
+	if h, ok := data.(gin.H); ok {
+		if lang, ok := h["lang"].(string); ok && r.templates[lang] != nil {
+			tmpl = r.templates[lang]
+		}
+	}
+
+	return render.HTML{
+		Template: tmpl,
+		Name:     name,
+		Data:     data,
+	}
+}
+
+// translate returns a template function which translates messages into the given language.
+func translate(lang string) func(msg string) string {
+	return func(msg string) string {
+		return i18n.Translate(lang, msg)
+	}
+}
+
+// errorTemplates are the names of error page templates which
+// can be overridden by templates in the configured error template dir.
+var errorTemplates = []string{"403.tmpl", "404.tmpl", "500.tmpl"}
+
+// loadErrorTemplates parses any admin-supplied error page templates
+// from the configured error template dir into tmpl, replacing the
+// default templates of the same name.
+func loadErrorTemplates(tmpl *template.Template) error {
+	errorTemplateDir := config.GetWebErrorTemplateDir()
+	if errorTemplateDir == "" {
+		// nothing to do
+		return nil
+	}
+
+	errorTemplateDir, err := filepath.Abs(errorTemplateDir)
+	if err != nil {
+		return fmt.Errorf("error getting absolute path of %s: %s", errorTemplateDir, err)
+	}
+
+	for _, name := range errorTemplates {
+		path := filepath.Join(errorTemplateDir, name)
+		if _, err := os.Stat(path); err != nil {
+			if os.IsNotExist(err) {
+				// not overridden, keep the default
+				continue
+			}
+			return fmt.Errorf("error checking error template %s: %w", path, err)
+		}
+
+		if _, err := tmpl.ParseFiles(path); err != nil {
+			return fmt.Errorf("error parsing error template %s: %w", path, err)
+		}
+
+		log.Infof("using custom error template %s", path)
+	}
+
 	return nil
 }
 
@@ -150,6 +254,28 @@ func visibilityIcon(visibility model.Visibility) template.HTML {
 	return template.HTML(fmt.Sprintf(`<i aria-label="Visibility: %v" class="fa fa-%v"></i>`, icon.label, icon.faIcon))
 }
 
+// srcset returns the value of a srcset attribute for the given attachment, listing
+// each available version of the image with its width, so that browsers can pick the
+// smallest one that looks good. An empty string is returned for non-image attachments.
+func srcset(a model.Attachment) string {
+	if a.Type != "image" {
+		return ""
+	}
+
+	sources := []string{}
+	if a.PreviewURL != "" && a.Meta.Small.Width != 0 {
+		sources = append(sources, fmt.Sprintf("%s %dw", a.PreviewURL, a.Meta.Small.Width))
+	}
+	if a.MediumURL != "" && a.Meta.Medium != nil {
+		sources = append(sources, fmt.Sprintf("%s %dw", a.MediumURL, a.Meta.Medium.Width))
+	}
+	if a.URL != nil && a.Meta.Original.Width != 0 {
+		sources = append(sources, fmt.Sprintf("%s %dw", *a.URL, a.Meta.Original.Width))
+	}
+
+	return strings.Join(sources, ", ")
+}
+
 // text is a template.HTML to affirm that the input of this function is already escaped
 func emojify(emojis []model.Emoji, inputText template.HTML) template.HTML {
 	out := text.Emojify(emojis, string(inputText))
@@ -170,5 +296,8 @@ func LoadTemplateFunctions(engine *gin.Engine) {
 		"timestampVague":   timestampVague,
 		"timestampPrecise": timestampPrecise,
 		"emojify":          emojify,
+		"srcset":           srcset,
+		"t":                translate("en"),
+		"languages":        i18n.Languages,
 	})
 }
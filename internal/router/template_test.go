@@ -0,0 +1,109 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package router_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+	"github.com/superseriousbusiness/gotosocial/internal/router"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TemplateTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TemplateTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	config.SetWebTemplateBaseDir("../../web/template/")
+}
+
+func (suite *TemplateTestSuite) render(name string) string {
+	return suite.renderIn(name, "")
+}
+
+func (suite *TemplateTestSuite) renderIn(name string, lang string) string {
+	engine := gin.New()
+	router.LoadTemplateFunctions(engine)
+	if err := router.LoadTemplates(engine); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	engine.GET("/", func(c *gin.Context) {
+		c.HTML(http.StatusNotFound, name, gin.H{
+			"instance": &apimodel.Instance{Title: "GoToSocial Testrig Instance"},
+			"lang":     lang,
+			"code":     http.StatusNotFound,
+			"error":    http.StatusText(http.StatusNotFound),
+		})
+	})
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	return recorder.Body.String()
+}
+
+func (suite *TemplateTestSuite) TestDefaultErrorTemplate() {
+	suite.Contains(suite.render("404.tmpl"), "404: Page Not Found")
+}
+
+func (suite *TemplateTestSuite) TestCustomErrorTemplate() {
+	errorTemplateDir := suite.T().TempDir()
+	if err := os.WriteFile(filepath.Join(errorTemplateDir, "404.tmpl"), []byte(`<p>custom {{.code}}: {{.error}}</p>`), 0o600); err != nil {
+		suite.FailNow(err.Error())
+	}
+	config.SetWebErrorTemplateDir(errorTemplateDir)
+
+	suite.Equal("<p>custom 404: Not Found</p>", suite.render("404.tmpl"))
+
+	// templates that weren't overridden should be untouched
+	suite.Contains(suite.render("500.tmpl"), "Not Found")
+}
+
+func (suite *TemplateTestSuite) TestTranslatedTemplate() {
+	config.SetWebI18nDir("../../web/i18n/")
+	defer config.SetWebI18nDir("")
+	if err := i18n.Load(); err != nil {
+		suite.FailNow(err.Error())
+	}
+	defer i18n.Load() //nolint:errcheck
+
+	page := suite.renderIn("404.tmpl", "de")
+	suite.Contains(page, `<html lang="de">`)
+	suite.Contains(page, "404: Seite nicht gefunden")
+	suite.Contains(page, `<a href="?lang=fr" lang="fr" hreflang="fr" class="nounderline">Français</a>`)
+	suite.Contains(page, `aria-current="true">Deutsch</a>`)
+
+	// pages without a language, or in one we have no messages for, are in english
+	suite.Contains(suite.render("404.tmpl"), "404: Page Not Found")
+	suite.Contains(suite.renderIn("404.tmpl", "ja"), "404: Page Not Found")
+}
+
+func TestTemplateTestSuite(t *testing.T) {
+	suite.Run(t, &TemplateTestSuite{})
+}
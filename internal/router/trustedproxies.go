@@ -0,0 +1,122 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package router
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"codeberg.org/gruf/go-kv"
+	"codeberg.org/gruf/go-logger/v2/level"
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// parseTrustedProxies parses the given trusted-proxies config
+// entries into networks, returning an error for any entry that is
+// neither a valid IP address nor a valid CIDR range. A warning is
+// logged for entries that trust every possible remote address, since
+// that allows any client to spoof its IP via x-forwarded headers.
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted-proxies entry %q: not a valid IP address or CIDR range", proxy)
+			}
+
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted-proxies entry %q: %w", proxy, err)
+		}
+
+		if ones, _ := network.Mask.Size(); ones == 0 {
+			log.Warnf("trusted-proxies entry %q trusts all remote addresses; any client will be able to spoof its IP address using x-forwarded headers", proxy)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+// clientIPMiddleware returns a gin handler that logs, at debug level,
+// how the client IP of each request was resolved. If forwarded headers
+// are received from a remote address that is not a trusted proxy, a
+// one-time warning is logged, since this usually means trusted-proxies
+// has not been configured to include the reverse proxy in front of us.
+func clientIPMiddleware(trusted []*net.IPNet) gin.HandlerFunc {
+	var warnOnce sync.Once
+
+	isTrusted := func(ip net.IP) bool {
+		for _, network := range trusted {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(c *gin.Context) {
+		forwarded := c.GetHeader("X-Forwarded-For") != "" || c.GetHeader("X-Real-IP") != ""
+
+		remoteIP, _, err := net.SplitHostPort(strings.TrimSpace(c.Request.RemoteAddr))
+		if err != nil {
+			remoteIP = c.Request.RemoteAddr
+		}
+
+		trustedRemote := false
+		if ip := net.ParseIP(remoteIP); ip != nil {
+			trustedRemote = isTrusted(ip)
+		}
+
+		if forwarded && !trustedRemote {
+			warnOnce.Do(func() {
+				log.Warnf("received x-forwarded headers from untrusted remote address %s; if this is your reverse proxy, add it to trusted-proxies, otherwise client IPs will be resolved incorrectly", remoteIP)
+			})
+		}
+
+		if log.Level() >= level.DEBUG {
+			log.WithFields(kv.Fields{
+				{"remoteIP", remoteIP},
+				{"trustedProxy", trustedRemote},
+				{"forwardedHeaders", forwarded},
+				{"clientIP", c.ClientIP()},
+			}...).Debug("resolved client IP")
+		}
+
+		c.Next()
+	}
+}
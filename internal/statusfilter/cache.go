@@ -0,0 +1,73 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statusfilter
+
+import (
+	"context"
+	"time"
+
+	"codeberg.org/gruf/go-cache/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// cacheTTL is how long the filters of an account are cached for. It's kept short,
+// since changes made through another process can't invalidate the cache here.
+const cacheTTL = time.Minute
+
+// Cache caches the loaded filters of accounts, so that fanning statuses out to
+// the timelines of many accounts doesn't load and convert the filters of every
+// one of them again for every status.
+type Cache struct {
+	cache cache.Cache[string, []*loadedFilter]
+}
+
+// NewCache returns a new, started Cache.
+func NewCache() *Cache {
+	c := &Cache{cache: cache.New[string, []*loadedFilter]()}
+	c.cache.SetTTL(cacheTTL, false)
+	c.cache.Start(time.Second * 10)
+	return c
+}
+
+// Get works like the Get function of this package, but
+// returns the filters from the cache if they're there.
+func (c *Cache) Get(ctx context.Context, database db.DB, tc typeutils.TypeConverter, account *gtsmodel.Account, filterContext gtsmodel.FilterContext) (*Filters, error) {
+	if account == nil {
+		return nil, nil
+	}
+
+	loaded, ok := c.cache.Get(account.ID)
+	if !ok {
+		var err error
+		if loaded, err = load(ctx, database, tc, account.ID); err != nil {
+			return nil, err
+		}
+		c.cache.Set(account.ID, loaded)
+	}
+
+	return in(loaded, account.ID, filterContext), nil
+}
+
+// Invalidate drops the cached filters of the account with the given
+// ID. It should be called whenever any of its filters are changed.
+func (c *Cache) Invalidate(accountID string) {
+	c.cache.Invalidate(accountID)
+}
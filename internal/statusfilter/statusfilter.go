@@ -0,0 +1,249 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package statusfilter applies the filters that accounts create for
+// themselves to the statuses which are shown to them.
+package statusfilter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Filters holds the unexpired filters of one account which apply
+// in one context, ready to be matched against statuses.
+//
+// A nil *Filters is valid, and matches nothing.
+type Filters struct {
+	accountID string
+	filters   []*filter
+}
+
+type filter struct {
+	apiFilter *apimodel.FilterV2
+	hide      bool
+	keywords  []*keyword
+	statusIDs map[string]struct{}
+}
+
+type keyword struct {
+	keyword string
+	regexp  *regexp.Regexp
+}
+
+// wordChars matches the characters which make up words, for whole word keywords.
+const wordChars = `\pL\pM\pN_`
+
+// Get returns the filters of the given account which apply in the given context.
+// If account is nil, eg., because statuses are being shown to a logged-out visitor,
+// nil is returned, which matches nothing.
+func Get(ctx context.Context, database db.DB, tc typeutils.TypeConverter, account *gtsmodel.Account, filterContext gtsmodel.FilterContext) (*Filters, error) {
+	if account == nil {
+		return nil, nil
+	}
+
+	loaded, err := load(ctx, database, tc, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return in(loaded, account.ID, filterContext), nil
+}
+
+// loadedFilter is a filter of an account ready for
+// matching, along with the filter it was made from.
+type loadedFilter struct {
+	gtsFilter *gtsmodel.Filter
+	filter    *filter
+}
+
+// load returns all filters of the given account, ready for matching.
+func load(ctx context.Context, database db.DB, tc typeutils.TypeConverter, accountID string) ([]*loadedFilter, error) {
+	gtsFilters, err := database.GetFiltersForAccountID(ctx, accountID)
+	if err != nil && err != db.ErrNoEntries {
+		return nil, fmt.Errorf("statusfilter: error getting filters of account %s: %s", accountID, err)
+	}
+
+	loaded := make([]*loadedFilter, 0, len(gtsFilters))
+	for _, gtsFilter := range gtsFilters {
+		apiFilter, err := tc.FilterToAPIFilterV2(ctx, gtsFilter)
+		if err != nil {
+			return nil, fmt.Errorf("statusfilter: error converting filter %s: %s", gtsFilter.ID, err)
+		}
+
+		f := &filter{
+			apiFilter: apiFilter,
+			hide:      gtsFilter.Action == gtsmodel.FilterActionHide,
+			statusIDs: make(map[string]struct{}, len(gtsFilter.Statuses)),
+		}
+
+		for _, k := range gtsFilter.Keywords {
+			f.keywords = append(f.keywords, &keyword{
+				keyword: k.Keyword,
+				regexp:  keywordRegexp(k.Keyword, k.WholeWord != nil && *k.WholeWord),
+			})
+		}
+
+		for _, s := range gtsFilter.Statuses {
+			f.statusIDs[s.StatusID] = struct{}{}
+		}
+
+		loaded = append(loaded, &loadedFilter{gtsFilter: gtsFilter, filter: f})
+	}
+
+	return loaded, nil
+}
+
+// in returns those of the loaded filters which are
+// unexpired and apply in the given context.
+func in(loaded []*loadedFilter, accountID string, filterContext gtsmodel.FilterContext) *Filters {
+	now := time.Now()
+	filters := &Filters{accountID: accountID}
+	for _, l := range loaded {
+		if !l.gtsFilter.AppliesIn(filterContext) || l.gtsFilter.Expired(now) {
+			continue
+		}
+		filters.filters = append(filters.filters, l.filter)
+	}
+	return filters
+}
+
+// keywordRegexp returns a case-insensitive regular expression matching the given keyword.
+// Whole word keywords only match where they start and end at the boundary of a word, as
+// long as they themselves start or end with a word character, so that eg. 'cat' doesn't
+// match 'concatenate', but '#cat' does match 'the #cat'.
+func keywordRegexp(k string, wholeWord bool) *regexp.Regexp {
+	expr := regexp.QuoteMeta(k)
+
+	if wholeWord {
+		if first, _ := utf8.DecodeRuneInString(k); isWordRune(first) {
+			expr = `(?:^|[^` + wordChars + `])` + expr
+		}
+		if last, _ := utf8.DecodeLastRuneInString(k); isWordRune(last) {
+			expr = expr + `(?:$|[^` + wordChars + `])`
+		}
+	}
+
+	return regexp.MustCompile(`(?i)` + expr)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsNumber(r) || r == '_'
+}
+
+// Apply matches the given status against the filters.
+//
+// If the status matches a filter with the hide action, nil is returned, and the
+// status shouldn't be shown. If it only matches filters with the warn action, a
+// copy of the status is returned which lists the matched filters in its Filtered
+// field. Otherwise, the status itself is returned.
+//
+// Boosts are matched by the boosted status. Statuses by the owner of the filters are never matched.
+func (f *Filters) Apply(status *apimodel.Status) *apimodel.Status {
+	if f == nil || len(f.filters) == 0 || status == nil {
+		return status
+	}
+
+	target := status
+	if status.Reblog != nil && status.Reblog.Status != nil {
+		target = status.Reblog.Status
+	}
+
+	if target.Account != nil && target.Account.ID == f.accountID {
+		return status
+	}
+
+	var (
+		statusText       string
+		statusTextLoaded bool
+	)
+	results := []apimodel.FilterResult{}
+	for _, filter := range f.filters {
+		result := apimodel.FilterResult{
+			Filter:         *filter.apiFilter,
+			KeywordMatches: []string{},
+			StatusMatches:  []string{},
+		}
+
+		if _, ok := filter.statusIDs[target.ID]; ok {
+			result.StatusMatches = append(result.StatusMatches, target.ID)
+		}
+
+		if len(filter.keywords) != 0 && !statusTextLoaded {
+			statusText = searchableText(target)
+			statusTextLoaded = true
+		}
+
+		for _, k := range filter.keywords {
+			if k.regexp.MatchString(statusText) {
+				result.KeywordMatches = append(result.KeywordMatches, k.keyword)
+			}
+		}
+
+		if len(result.KeywordMatches) == 0 && len(result.StatusMatches) == 0 {
+			continue
+		}
+
+		if filter.hide {
+			return nil
+		}
+
+		results = append(results, result)
+	}
+
+	if len(results) == 0 {
+		return status
+	}
+
+	// statuses may be shared, eg. by being cached in a timeline,
+	// so the results are only set on a copy of the status
+	filtered := *status
+	filtered.Filtered = results
+	return &filtered
+}
+
+// searchableText returns the text of the given status which keywords are matched against:
+// its content warning, content, media descriptions, and poll options.
+func searchableText(status *apimodel.Status) string {
+	parts := []string{status.SpoilerText, text.SanitizePlaintext(status.Content)}
+
+	for _, a := range status.MediaAttachments {
+		if a.Description != nil {
+			parts = append(parts, *a.Description)
+		}
+	}
+
+	if status.Poll != nil {
+		for _, o := range status.Poll.Options {
+			parts = append(parts, o.Title)
+		}
+	}
+
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,126 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statusfilter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusFilterTestSuite struct {
+	suite.Suite
+}
+
+func (suite *StatusFilterTestSuite) TestKeywordRegexp() {
+	for _, test := range []struct {
+		keyword   string
+		wholeWord bool
+		text      string
+		match     bool
+	}{
+		{keyword: "cat", wholeWord: false, text: "concatenate", match: true},
+		{keyword: "cat", wholeWord: true, text: "concatenate", match: false},
+		{keyword: "cat", wholeWord: true, text: "my CAT!", match: true},
+		{keyword: "cat", wholeWord: true, text: "cat", match: true},
+		{keyword: "#cat", wholeWord: true, text: "the #cat", match: true},
+		{keyword: "#cat", wholeWord: true, text: "the #category", match: false},
+		{keyword: "kätzchen", wholeWord: true, text: "kleine kätzchenfutter", match: false},
+		{keyword: "kätzchen", wholeWord: true, text: "kleine Kätzchen.", match: true},
+		{keyword: "a.b", wholeWord: false, text: "axb", match: false},
+	} {
+		suite.Equal(test.match, keywordRegexp(test.keyword, test.wholeWord).MatchString(test.text), "%q in %q", test.keyword, test.text)
+	}
+}
+
+func (suite *StatusFilterTestSuite) TestApply() {
+	warn := &filter{
+		apiFilter: &apimodel.FilterV2{ID: "01GKZA3J0BMGF7JN6TMDX6T3V0", Title: "fnords", FilterAction: "warn"},
+		keywords:  []*keyword{{keyword: "fnord", regexp: keywordRegexp("fnord", true)}},
+		statusIDs: map[string]struct{}{"01F8MH75CBF9JFX4ZAD54N0W0R": {}},
+	}
+	hide := &filter{
+		apiFilter: &apimodel.FilterV2{ID: "01GKZJN0W9ZB1R0BZ9WPZRZ4RT", Title: "spoilers", FilterAction: "hide"},
+		hide:      true,
+		keywords:  []*keyword{{keyword: "spoiler", regexp: keywordRegexp("spoiler", false)}},
+		statusIDs: map[string]struct{}{},
+	}
+	filters := &Filters{accountID: "01F8MH1H7YV1Z7D2C8K2730QBF", filters: []*filter{warn, hide}}
+
+	// statuses matching nothing are returned as they are
+	status := &apimodel.Status{ID: "01G36SF3V6Y6V5BF9P4R7PQG7G", Content: "<p>hello world</p>"}
+	suite.Same(status, filters.Apply(status))
+
+	// keywords are matched against text, and warn
+	status = &apimodel.Status{ID: "01G36SF3V6Y6V5BF9P4R7PQG7G", Content: "<p>what <em>fnord</em>?</p>"}
+	filtered := filters.Apply(status)
+	suite.NotSame(status, filtered)
+	suite.Empty(status.Filtered)
+	if suite.Len(filtered.Filtered, 1) {
+		suite.Equal("fnords", filtered.Filtered[0].Filter.Title)
+		suite.Equal([]string{"fnord"}, filtered.Filtered[0].KeywordMatches)
+		suite.Empty(filtered.Filtered[0].StatusMatches)
+	}
+
+	// boosts are matched by the boosted status
+	boost := &apimodel.Status{ID: "01G36SF3V6Y6V5BF9P4R7PQG7H", Reblog: &apimodel.StatusReblogged{Status: &apimodel.Status{ID: "01F8MH75CBF9JFX4ZAD54N0W0R"}}}
+	filtered = filters.Apply(boost)
+	if suite.Len(filtered.Filtered, 1) {
+		suite.Equal([]string{"01F8MH75CBF9JFX4ZAD54N0W0R"}, filtered.Filtered[0].StatusMatches)
+	}
+
+	// hide filters drop statuses, including by content warning and media description
+	description := "a spoiler for the film"
+	suite.Nil(filters.Apply(&apimodel.Status{SpoilerText: "spoilers!"}))
+	suite.Nil(filters.Apply(&apimodel.Status{MediaAttachments: []apimodel.Attachment{{Description: &description}}}))
+
+	// statuses of the filter owner aren't filtered
+	own := &apimodel.Status{SpoilerText: "spoilers!", Account: &apimodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"}}
+	suite.Same(own, filters.Apply(own))
+
+	// nil filters match nothing
+	var none *Filters
+	suite.Same(status, none.Apply(status))
+}
+
+func (suite *StatusFilterTestSuite) TestIn() {
+	yes, no := true, false
+	home := &loadedFilter{
+		gtsFilter: &gtsmodel.Filter{ContextHome: &yes, ContextPublic: &no},
+		filter:    &filter{},
+	}
+	expired := &loadedFilter{
+		gtsFilter: &gtsmodel.Filter{ContextHome: &yes, ExpiresAt: time.Now().Add(-time.Minute)},
+		filter:    &filter{},
+	}
+	loaded := []*loadedFilter{home, expired}
+
+	filters := in(loaded, "01F8MH1H7YV1Z7D2C8K2730QBF", gtsmodel.FilterContextHome)
+	suite.Equal([]*filter{home.filter}, filters.filters)
+
+	filters = in(loaded, "01F8MH1H7YV1Z7D2C8K2730QBF", gtsmodel.FilterContextPublic)
+	suite.Empty(filters.filters)
+}
+
+func TestStatusFilterTestSuite(t *testing.T) {
+	suite.Run(t, &StatusFilterTestSuite{})
+}
@@ -1,6 +1,9 @@
 package stream
 
-import "sync"
+import (
+	"strings"
+	"sync"
+)
 
 const (
 	// EventTypeNotification -- a user should be shown a notification
@@ -22,6 +25,9 @@ const (
 	TimelineNotifications string = "user:notification"
 	// TimelineDirect -- statuses sent to a user directly.
 	TimelineDirect string = "direct"
+	// TimelineList -- statuses for one of a user's list timelines. Streams
+	// of a list have the timeline returned by ListTimeline for that list.
+	TimelineList string = "list"
 )
 
 // AllStatusTimelines contains all Timelines that a status could conceivably be delivered to -- useful for doing deletes.
@@ -30,6 +36,21 @@ var AllStatusTimelines = []string{
 	TimelinePublic,
 	TimelineHome,
 	TimelineDirect,
+	TimelineList,
+}
+
+// ListTimeline returns the Timeline of streams of the list with the given ID.
+func ListTimeline(listID string) string {
+	return TimelineList + ":" + listID
+}
+
+// ListID returns the ID of the list that streams with the given Timeline are for,
+// or an empty string if the timeline isn't one returned by ListTimeline.
+func ListID(timeline string) string {
+	if listID := strings.TrimPrefix(timeline, TimelineList+":"); listID != timeline {
+		return listID
+	}
+	return ""
 }
 
 // StreamsForAccount is a wrapper for the multiple streams that one account can have running at the same time.
@@ -77,6 +77,8 @@ type Manager interface {
 	WipeItemFromAllTimelines(ctx context.Context, itemID string) error
 	// WipeStatusesFromAccountID removes all items by the given accountID from the timelineAccountID's timelines.
 	WipeItemsFromAccountID(ctx context.Context, timelineAccountID string, accountID string) error
+	// WipeAccountFromAllTimelines removes all items by the given accountID, and boosts of them, from all timelines.
+	WipeAccountFromAllTimelines(ctx context.Context, accountID string) error
 }
 
 // NewManager returns a new timeline manager.
@@ -228,6 +230,29 @@ func (m *manager) WipeItemsFromAccountID(ctx context.Context, timelineAccountID
 	return err
 }
 
+func (m *manager) WipeAccountFromAllTimelines(ctx context.Context, accountID string) error {
+	errors := []string{}
+	m.accountTimelines.Range(func(k interface{}, i interface{}) bool {
+		t, ok := i.(Timeline)
+		if !ok {
+			panic("couldn't parse entry as Timeline, this should never happen so panic")
+		}
+
+		if _, err := t.RemoveAllBy(ctx, accountID); err != nil {
+			errors = append(errors, err.Error())
+		}
+
+		return true
+	})
+
+	var err error
+	if len(errors) > 0 {
+		err = fmt.Errorf("one or more errors removing statuses of account %s from all timelines: %s", accountID, strings.Join(errors, ";"))
+	}
+
+	return err
+}
+
 func (m *manager) getOrCreateTimeline(ctx context.Context, timelineAccountID string) (Timeline, error) {
 	var t Timeline
 	i, ok := m.accountTimelines.Load(timelineAccountID)
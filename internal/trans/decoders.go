@@ -70,6 +70,24 @@ func (i *importer) accountDecode(e transmodel.Entry) (*transmodel.Account, error
 	return a, nil
 }
 
+func (i *importer) accountDomainBlockDecode(e transmodel.Entry) (*transmodel.AccountDomainBlock, error) {
+	b := &transmodel.AccountDomainBlock{}
+	if err := i.simpleDecode(e, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (i *importer) applicationDecode(e transmodel.Entry) (*transmodel.Application, error) {
+	a := &transmodel.Application{}
+	if err := i.simpleDecode(e, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
 func (i *importer) blockDecode(e transmodel.Entry) (*transmodel.Block, error) {
 	b := &transmodel.Block{}
 	if err := i.simpleDecode(e, b); err != nil {
@@ -79,6 +97,15 @@ func (i *importer) blockDecode(e transmodel.Entry) (*transmodel.Block, error) {
 	return b, nil
 }
 
+func (i *importer) clientDecode(e transmodel.Entry) (*transmodel.Client, error) {
+	c := &transmodel.Client{}
+	if err := i.simpleDecode(e, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
 func (i *importer) domainBlockDecode(e transmodel.Entry) (*transmodel.DomainBlock, error) {
 	b := &transmodel.DomainBlock{}
 	if err := i.simpleDecode(e, b); err != nil {
@@ -88,6 +115,24 @@ func (i *importer) domainBlockDecode(e transmodel.Entry) (*transmodel.DomainBloc
 	return b, nil
 }
 
+func (i *importer) emojiDecode(e transmodel.Entry) (*transmodel.Emoji, error) {
+	emoji := &transmodel.Emoji{}
+	if err := i.simpleDecode(e, emoji); err != nil {
+		return nil, err
+	}
+
+	return emoji, nil
+}
+
+func (i *importer) emojiCategoryDecode(e transmodel.Entry) (*transmodel.EmojiCategory, error) {
+	c := &transmodel.EmojiCategory{}
+	if err := i.simpleDecode(e, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
 func (i *importer) followDecode(e transmodel.Entry) (*transmodel.Follow, error) {
 	f := &transmodel.Follow{}
 	if err := i.simpleDecode(e, f); err != nil {
@@ -106,6 +151,15 @@ func (i *importer) followRequestDecode(e transmodel.Entry) (*transmodel.FollowRe
 	return f, nil
 }
 
+func (i *importer) headerDecode(e transmodel.Entry) (*transmodel.Header, error) {
+	h := &transmodel.Header{}
+	if err := i.simpleDecode(e, h); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
 func (i *importer) instanceDecode(e transmodel.Entry) (*transmodel.Instance, error) {
 	inst := &transmodel.Instance{}
 	if err := i.simpleDecode(e, inst); err != nil {
@@ -115,6 +169,60 @@ func (i *importer) instanceDecode(e transmodel.Entry) (*transmodel.Instance, err
 	return inst, nil
 }
 
+func (i *importer) mediaAttachmentDecode(e transmodel.Entry) (*transmodel.MediaAttachment, error) {
+	a := &transmodel.MediaAttachment{}
+	if err := i.simpleDecode(e, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (i *importer) statusDecode(e transmodel.Entry) (*transmodel.Status, error) {
+	s := &transmodel.Status{}
+	if err := i.simpleDecode(e, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (i *importer) statusBookmarkDecode(e transmodel.Entry) (*transmodel.StatusBookmark, error) {
+	b := &transmodel.StatusBookmark{}
+	if err := i.simpleDecode(e, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (i *importer) statusFaveDecode(e transmodel.Entry) (*transmodel.StatusFave, error) {
+	f := &transmodel.StatusFave{}
+	if err := i.simpleDecode(e, f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (i *importer) statusMuteDecode(e transmodel.Entry) (*transmodel.StatusMute, error) {
+	m := &transmodel.StatusMute{}
+	if err := i.simpleDecode(e, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (i *importer) tokenDecode(e transmodel.Entry) (*transmodel.Token, error) {
+	t := &transmodel.Token{}
+	if err := i.simpleDecode(e, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func (i *importer) userDecode(e transmodel.Entry) (*transmodel.User, error) {
 	u := &transmodel.User{}
 	if err := i.simpleDecode(e, u); err != nil {
@@ -20,11 +20,30 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	transmodel "github.com/superseriousbusiness/gotosocial/internal/trans/model"
 )
 
+// headerID is used to track whether the header has been written, since headers don't have their own ID.
+const headerID = "header"
+
+func (e *exporter) exportHeader(ctx context.Context, file *os.File) error {
+	exportedAt := time.Now()
+	h := &transmodel.Header{
+		Type:       transmodel.TransHeader,
+		Version:    transmodel.CurrentVersion,
+		ExportedAt: &exportedAt,
+	}
+
+	if err := e.simpleEncode(ctx, file, h, headerID); err != nil {
+		return fmt.Errorf("exportHeader: error encoding header: %s", err)
+	}
+
+	return nil
+}
+
 func (e *exporter) exportAccounts(ctx context.Context, where []db.Where, file *os.File) ([]*transmodel.Account, error) {
 	// select using the 'where' we've been provided
 	accounts := []*transmodel.Account{}
@@ -221,3 +240,220 @@ func (e *exporter) exportUsers(ctx context.Context, file *os.File) ([]*transmode
 
 	return users, nil
 }
+
+func (e *exporter) exportStatuses(ctx context.Context, where []db.Where, file *os.File) ([]*transmodel.Status, error) {
+	// select using the 'where' we've been provided
+	statuses := []*transmodel.Status{}
+	if err := e.db.GetWhere(ctx, where, &statuses); err != nil {
+		return nil, fmt.Errorf("exportStatuses: error selecting statuses: %s", err)
+	}
+
+	// write any statuses found to file
+	for _, s := range statuses {
+		s.Type = transmodel.TransStatus
+		if err := e.simpleEncode(ctx, file, s, s.ID); err != nil {
+			return nil, fmt.Errorf("exportStatuses: error encoding status: %s", err)
+		}
+	}
+
+	return statuses, nil
+}
+
+func (e *exporter) exportStatusBookmarks(ctx context.Context, accounts []*transmodel.Account, file *os.File) ([]*transmodel.StatusBookmark, error) {
+	bookmarks := []*transmodel.StatusBookmark{}
+
+	// export the bookmarks owned by each given account
+	for _, a := range accounts {
+		whereBookmarking := []db.Where{{Key: "account_id", Value: a.ID}}
+		bookmarking := []*transmodel.StatusBookmark{}
+		if err := e.db.GetWhere(ctx, whereBookmarking, &bookmarking); err != nil {
+			return nil, fmt.Errorf("exportStatusBookmarks: error selecting bookmarks owned by account %s: %s", a.ID, err)
+		}
+		for _, b := range bookmarking {
+			b.Type = transmodel.TransStatusBookmark
+			if err := e.simpleEncode(ctx, file, b, b.ID); err != nil {
+				return nil, fmt.Errorf("exportStatusBookmarks: error encoding bookmark owned by account %s: %s", a.ID, err)
+			}
+			bookmarks = append(bookmarks, b)
+		}
+	}
+
+	return bookmarks, nil
+}
+
+func (e *exporter) exportStatusFaves(ctx context.Context, accounts []*transmodel.Account, file *os.File) ([]*transmodel.StatusFave, error) {
+	faves := []*transmodel.StatusFave{}
+
+	// export the faves created by each given account
+	for _, a := range accounts {
+		whereFaving := []db.Where{{Key: "account_id", Value: a.ID}}
+		faving := []*transmodel.StatusFave{}
+		if err := e.db.GetWhere(ctx, whereFaving, &faving); err != nil {
+			return nil, fmt.Errorf("exportStatusFaves: error selecting faves created by account %s: %s", a.ID, err)
+		}
+		for _, f := range faving {
+			f.Type = transmodel.TransStatusFave
+			if err := e.simpleEncode(ctx, file, f, f.ID); err != nil {
+				return nil, fmt.Errorf("exportStatusFaves: error encoding fave created by account %s: %s", a.ID, err)
+			}
+			faves = append(faves, f)
+		}
+	}
+
+	return faves, nil
+}
+
+func (e *exporter) exportStatusMutes(ctx context.Context, accounts []*transmodel.Account, file *os.File) ([]*transmodel.StatusMute, error) {
+	mutes := []*transmodel.StatusMute{}
+
+	// export the thread mutes owned by each given account
+	for _, a := range accounts {
+		whereMuting := []db.Where{{Key: "account_id", Value: a.ID}}
+		muting := []*transmodel.StatusMute{}
+		if err := e.db.GetWhere(ctx, whereMuting, &muting); err != nil {
+			return nil, fmt.Errorf("exportStatusMutes: error selecting mutes owned by account %s: %s", a.ID, err)
+		}
+		for _, m := range muting {
+			m.Type = transmodel.TransStatusMute
+			if err := e.simpleEncode(ctx, file, m, m.ID); err != nil {
+				return nil, fmt.Errorf("exportStatusMutes: error encoding mute owned by account %s: %s", a.ID, err)
+			}
+			mutes = append(mutes, m)
+		}
+	}
+
+	return mutes, nil
+}
+
+func (e *exporter) exportAccountDomainBlocks(ctx context.Context, accounts []*transmodel.Account, file *os.File) ([]*transmodel.AccountDomainBlock, error) {
+	blocks := []*transmodel.AccountDomainBlock{}
+
+	// export the domain blocks owned by each given account
+	for _, a := range accounts {
+		whereBlocking := []db.Where{{Key: "account_id", Value: a.ID}}
+		blocking := []*transmodel.AccountDomainBlock{}
+		if err := e.db.GetWhere(ctx, whereBlocking, &blocking); err != nil {
+			return nil, fmt.Errorf("exportAccountDomainBlocks: error selecting domain blocks owned by account %s: %s", a.ID, err)
+		}
+		for _, b := range blocking {
+			b.Type = transmodel.TransAccountDomainBlock
+			if err := e.simpleEncode(ctx, file, b, b.ID); err != nil {
+				return nil, fmt.Errorf("exportAccountDomainBlocks: error encoding domain block owned by account %s: %s", a.ID, err)
+			}
+			blocks = append(blocks, b)
+		}
+	}
+
+	return blocks, nil
+}
+
+func (e *exporter) exportMediaAttachments(ctx context.Context, statuses []*transmodel.Status, file *os.File) ([]*transmodel.MediaAttachment, error) {
+	attachments := []*transmodel.MediaAttachment{}
+
+	// export the attachments of each given status
+	for _, s := range statuses {
+		if len(s.AttachmentIDs) == 0 {
+			continue
+		}
+
+		whereAttached := []db.Where{{Key: "status_id", Value: s.ID}}
+		attached := []*transmodel.MediaAttachment{}
+		if err := e.db.GetWhere(ctx, whereAttached, &attached); err != nil {
+			return nil, fmt.Errorf("exportMediaAttachments: error selecting attachments of status %s: %s", s.ID, err)
+		}
+		for _, a := range attached {
+			a.Type = transmodel.TransMediaAttachment
+			if err := e.simpleEncode(ctx, file, a, a.ID); err != nil {
+				return nil, fmt.Errorf("exportMediaAttachments: error encoding attachment of status %s: %s", s.ID, err)
+			}
+			attachments = append(attachments, a)
+		}
+	}
+
+	return attachments, nil
+}
+
+func (e *exporter) exportEmojiCategories(ctx context.Context, file *os.File) ([]*transmodel.EmojiCategory, error) {
+	categories := []*transmodel.EmojiCategory{}
+
+	if err := e.db.GetAll(ctx, &categories); err != nil {
+		return nil, fmt.Errorf("exportEmojiCategories: error selecting emoji categories: %s", err)
+	}
+
+	for _, c := range categories {
+		c.Type = transmodel.TransEmojiCategory
+		if err := e.simpleEncode(ctx, file, c, c.ID); err != nil {
+			return nil, fmt.Errorf("exportEmojiCategories: error encoding emoji category: %s", err)
+		}
+	}
+
+	return categories, nil
+}
+
+func (e *exporter) exportEmojis(ctx context.Context, where []db.Where, file *os.File) ([]*transmodel.Emoji, error) {
+	// select using the 'where' we've been provided
+	emojis := []*transmodel.Emoji{}
+	if err := e.db.GetWhere(ctx, where, &emojis); err != nil {
+		return nil, fmt.Errorf("exportEmojis: error selecting emojis: %s", err)
+	}
+
+	for _, emoji := range emojis {
+		emoji.Type = transmodel.TransEmoji
+		if err := e.simpleEncode(ctx, file, emoji, emoji.ID); err != nil {
+			return nil, fmt.Errorf("exportEmojis: error encoding emoji: %s", err)
+		}
+	}
+
+	return emojis, nil
+}
+
+func (e *exporter) exportApplications(ctx context.Context, file *os.File) ([]*transmodel.Application, error) {
+	applications := []*transmodel.Application{}
+
+	if err := e.db.GetAll(ctx, &applications); err != nil {
+		return nil, fmt.Errorf("exportApplications: error selecting applications: %s", err)
+	}
+
+	for _, a := range applications {
+		a.Type = transmodel.TransApplication
+		if err := e.simpleEncode(ctx, file, a, a.ID); err != nil {
+			return nil, fmt.Errorf("exportApplications: error encoding application: %s", err)
+		}
+	}
+
+	return applications, nil
+}
+
+func (e *exporter) exportClients(ctx context.Context, file *os.File) ([]*transmodel.Client, error) {
+	clients := []*transmodel.Client{}
+
+	if err := e.db.GetAll(ctx, &clients); err != nil {
+		return nil, fmt.Errorf("exportClients: error selecting clients: %s", err)
+	}
+
+	for _, c := range clients {
+		c.Type = transmodel.TransClient
+		if err := e.simpleEncode(ctx, file, c, c.ID); err != nil {
+			return nil, fmt.Errorf("exportClients: error encoding client: %s", err)
+		}
+	}
+
+	return clients, nil
+}
+
+func (e *exporter) exportTokens(ctx context.Context, file *os.File) ([]*transmodel.Token, error) {
+	tokens := []*transmodel.Token{}
+
+	if err := e.db.GetAll(ctx, &tokens); err != nil {
+		return nil, fmt.Errorf("exportTokens: error selecting tokens: %s", err)
+	}
+
+	for _, t := range tokens {
+		t.Type = transmodel.TransToken
+		if err := e.simpleEncode(ctx, file, t, t.ID); err != nil {
+			return nil, fmt.Errorf("exportTokens: error encoding token: %s", err)
+		}
+	}
+
+	return tokens, nil
+}
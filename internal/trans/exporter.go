@@ -26,7 +26,13 @@ import (
 
 // Exporter wraps functionality for exporting entries from the database to a file.
 type Exporter interface {
+	// ExportMinimal exports accounts, their relationships, users, domain blocks,
+	// and instances: the minimum needed to keep an instance working after a migration.
 	ExportMinimal(ctx context.Context, path string) error
+	// ExportFull exports everything from ExportMinimal, plus the statuses of local accounts,
+	// references to their media attachments, the bookmarks, faves, and thread mutes of local accounts,
+	// local custom emojis and their categories, and oauth applications, clients, and tokens.
+	ExportFull(ctx context.Context, path string) error
 }
 
 type exporter struct {
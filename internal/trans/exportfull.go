@@ -0,0 +1,147 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package trans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	transmodel "github.com/superseriousbusiness/gotosocial/internal/trans/model"
+)
+
+func (e *exporter) ExportFull(ctx context.Context, path string) error {
+	if path == "" {
+		return errors.New("ExportFull: path empty")
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("ExportFull: couldn't export to %s: %s", path, err)
+	}
+
+	if err := e.exportHeader(ctx, file); err != nil {
+		return fmt.Errorf("ExportFull: error exporting header: %s", err)
+	}
+
+	localAccounts, err := e.exportMinimal(ctx, file)
+	if err != nil {
+		return fmt.Errorf("ExportFull: %s", err)
+	}
+
+	// export all statuses created by local accounts
+	statuses := []*transmodel.Status{}
+	for _, a := range localAccounts {
+		accountStatuses, err := e.exportStatuses(ctx, []db.Where{{Key: "account_id", Value: a.ID}}, file)
+		if err != nil {
+			return fmt.Errorf("ExportFull: error exporting statuses of account %s: %s", a.ID, err)
+		}
+		statuses = append(statuses, accountStatuses...)
+	}
+
+	// export all bookmarks owned by local accounts
+	bookmarks, err := e.exportStatusBookmarks(ctx, localAccounts, file)
+	if err != nil {
+		return fmt.Errorf("ExportFull: error exporting bookmarks: %s", err)
+	}
+
+	// export all faves created by local accounts
+	faves, err := e.exportStatusFaves(ctx, localAccounts, file)
+	if err != nil {
+		return fmt.Errorf("ExportFull: error exporting faves: %s", err)
+	}
+
+	// export all thread mutes owned by local accounts
+	mutes, err := e.exportStatusMutes(ctx, localAccounts, file)
+	if err != nil {
+		return fmt.Errorf("ExportFull: error exporting thread mutes: %s", err)
+	}
+
+	// for each status, make sure we've written out the statuses it replies to or boosts,
+	// and for each bookmark, fave, or thread mute, the status it refers to -- these might be
+	// from non-local accounts, but we need them so that threads, boosts, bookmarks, faves,
+	// and mutes still work after import
+	referencedIDs := []string{}
+	for _, s := range statuses {
+		referencedIDs = append(referencedIDs, s.InReplyToID, s.BoostOfID)
+	}
+	for _, b := range bookmarks {
+		referencedIDs = append(referencedIDs, b.StatusID)
+	}
+	for _, f := range faves {
+		referencedIDs = append(referencedIDs, f.StatusID)
+	}
+	for _, m := range mutes {
+		referencedIDs = append(referencedIDs, m.StatusID)
+	}
+	for _, statusID := range referencedIDs {
+		if _, alreadyWritten := e.writtenIDs[statusID]; statusID == "" || alreadyWritten {
+			continue
+		}
+
+		referenced, err := e.exportStatuses(ctx, []db.Where{{Key: "id", Value: statusID}}, file)
+		if err != nil {
+			return fmt.Errorf("ExportFull: error exporting referenced status: %s", err)
+		}
+		statuses = append(statuses, referenced...)
+	}
+
+	// make sure we've written out the account that owns each status
+	for _, s := range statuses {
+		if _, alreadyWritten := e.writtenIDs[s.AccountID]; alreadyWritten {
+			continue
+		}
+
+		if _, err := e.exportAccounts(ctx, []db.Where{{Key: "id", Value: s.AccountID}}, file); err != nil {
+			return fmt.Errorf("ExportFull: error exporting status owner account: %s", err)
+		}
+	}
+
+	// export references to the media attached to each status
+	if _, err := e.exportMediaAttachments(ctx, statuses, file); err != nil {
+		return fmt.Errorf("ExportFull: error exporting media attachments: %s", err)
+	}
+
+	// export local custom emojis and the categories they're sorted into
+	if _, err := e.exportEmojiCategories(ctx, file); err != nil {
+		return fmt.Errorf("ExportFull: error exporting emoji categories: %s", err)
+	}
+
+	if _, err := e.exportEmojis(ctx, []db.Where{{Key: "domain", Value: nil}}, file); err != nil {
+		return fmt.Errorf("ExportFull: error exporting emojis: %s", err)
+	}
+
+	// export oauth applications, clients, and tokens, so that
+	// users and their apps stay logged in after an import
+	if _, err := e.exportApplications(ctx, file); err != nil {
+		return fmt.Errorf("ExportFull: error exporting applications: %s", err)
+	}
+
+	if _, err := e.exportClients(ctx, file); err != nil {
+		return fmt.Errorf("ExportFull: error exporting clients: %s", err)
+	}
+
+	if _, err := e.exportTokens(ctx, file); err != nil {
+		return fmt.Errorf("ExportFull: error exporting tokens: %s", err)
+	}
+
+	return neatClose(file)
+}
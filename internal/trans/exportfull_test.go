@@ -0,0 +1,194 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package trans_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/trans"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ExportFullTestSuite struct {
+	TransTestSuite
+}
+
+func (suite *ExportFullTestSuite) TestExportImportFullOK() {
+	ctx := context.Background()
+
+	// bookmark a remote status so we can check it comes along for the ride
+	remoteStatus := testrig.NewTestStatuses()["remote_account_1_status_1"]
+	bookmark := &gtsmodel.StatusBookmark{
+		ID:              "01GJ0AJZ4X2ZBSNH0J5Z6WQ3BG",
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		TargetAccountID: remoteStatus.AccountID,
+		StatusID:        remoteStatus.ID,
+	}
+	if err := suite.db.Put(ctx, bookmark); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// mute a thread and block a domain too, so we can check that
+	// the account's own safety settings survive a migration
+	mutedStatus := testrig.NewTestStatuses()["local_account_2_status_1"]
+	mute := &gtsmodel.StatusMute{
+		ID:              "01GJQ7V1HBQ5GFJGQ5MNP4ZCCZ",
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		TargetAccountID: mutedStatus.AccountID,
+		StatusID:        mutedStatus.ID,
+	}
+	if err := suite.db.Put(ctx, mute); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	domainBlock := &gtsmodel.AccountDomainBlock{
+		ID:        "01GJQ7VNWV7Y8R1C8H3XE4T5KA",
+		AccountID: suite.testAccounts["local_account_1"].ID,
+		Domain:    "example.org",
+	}
+	if err := suite.db.Put(ctx, domainBlock); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// use a temporary file path that will be cleaned when the test is closed
+	tempFilePath := fmt.Sprintf("%s/%s", suite.T().TempDir(), uuid.NewString())
+
+	exporter := trans.NewExporter(suite.db)
+	err := exporter.ExportFull(ctx, tempFilePath)
+	suite.NoError(err)
+
+	b, err := os.ReadFile(tempFilePath)
+	suite.NoError(err)
+	suite.True(strings.HasPrefix(string(b), `{"type":"header","version":2,`))
+
+	// import into a new database with just the tables created, no entries
+	newDB := testrig.NewTestDB()
+	importer := trans.NewImporter(newDB)
+	err = importer.Import(ctx, tempFilePath)
+	suite.NoError(err)
+
+	// all statuses of local accounts should have been imported
+	localStatus := testrig.NewTestStatuses()["local_account_1_status_1"]
+	statusAfter, err := newDB.GetStatusByID(ctx, localStatus.ID)
+	if suite.NoError(err) {
+		suite.Equal(localStatus.URI, statusAfter.URI)
+		suite.Equal(localStatus.Content, statusAfter.Content)
+		suite.Equal(localStatus.Visibility, statusAfter.Visibility)
+	}
+
+	// the bookmarked remote status should have been imported, along with its attachment
+	statusAfter, err = newDB.GetStatusByID(ctx, remoteStatus.ID)
+	if suite.NoError(err) {
+		suite.Equal(remoteStatus.AttachmentIDs, statusAfter.AttachmentIDs)
+	}
+
+	attachmentAfter, err := newDB.GetAttachmentByID(ctx, remoteStatus.AttachmentIDs[0])
+	if suite.NoError(err) {
+		attachmentBefore := testrig.NewTestAttachments()["remote_account_1_status_1_attachment_1"]
+		suite.Equal(attachmentBefore.RemoteURL, attachmentAfter.RemoteURL)
+		suite.Equal(attachmentBefore.File.Path, attachmentAfter.File.Path)
+		suite.Equal(attachmentBefore.FileMeta.Original, attachmentAfter.FileMeta.Original)
+	}
+
+	bookmarks := []*gtsmodel.StatusBookmark{}
+	err = newDB.GetAll(ctx, &bookmarks)
+	suite.NoError(err)
+	if suite.Len(bookmarks, 1) {
+		suite.Equal(bookmark.StatusID, bookmarks[0].StatusID)
+	}
+
+	// faves of local accounts should have been imported with their uris intact
+	faves := []*gtsmodel.StatusFave{}
+	err = newDB.GetAll(ctx, &faves)
+	suite.NoError(err)
+	suite.Len(faves, len(suite.testFaves))
+	for _, f := range faves {
+		suite.Equal(suite.testFaves[f.ID].URI, f.URI)
+	}
+
+	// the thread mute should have been imported, along with the muted status
+	mutes := []*gtsmodel.StatusMute{}
+	err = newDB.GetAll(ctx, &mutes)
+	suite.NoError(err)
+	if suite.Len(mutes, 1) {
+		suite.Equal(mute.AccountID, mutes[0].AccountID)
+		suite.Equal(mute.StatusID, mutes[0].StatusID)
+	}
+
+	_, err = newDB.GetStatusByID(ctx, mutedStatus.ID)
+	suite.NoError(err)
+
+	// as should the account's own domain block
+	domainBlocks := []*gtsmodel.AccountDomainBlock{}
+	err = newDB.GetAll(ctx, &domainBlocks)
+	suite.NoError(err)
+	if suite.Len(domainBlocks, 1) {
+		suite.Equal(domainBlock.AccountID, domainBlocks[0].AccountID)
+		suite.Equal(domainBlock.Domain, domainBlocks[0].Domain)
+	}
+
+	// local emojis should have been imported along with their categories, but not remote ones
+	emojiBefore := testrig.NewTestEmojis()["rainbow"]
+	emojiAfter, err := newDB.GetEmojiByID(ctx, emojiBefore.ID)
+	if suite.NoError(err) {
+		suite.Equal(emojiBefore.Shortcode, emojiAfter.Shortcode)
+		suite.Equal(emojiBefore.ImagePath, emojiAfter.ImagePath)
+		suite.Equal(emojiBefore.CategoryID, emojiAfter.CategoryID)
+	}
+
+	_, err = newDB.GetEmojiByID(ctx, testrig.NewTestEmojis()["yell"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	categories := []*gtsmodel.EmojiCategory{}
+	err = newDB.GetAll(ctx, &categories)
+	suite.NoError(err)
+	suite.Len(categories, len(testrig.NewTestEmojiCategories()))
+
+	// oauth tokens should have been imported with their access tokens intact
+	for _, tokenBefore := range testrig.NewTestTokens() {
+		tokenAfter := &gtsmodel.Token{}
+		err := newDB.GetByID(ctx, tokenBefore.ID, tokenAfter)
+		if suite.NoError(err) {
+			suite.Equal(tokenBefore.Access, tokenAfter.Access)
+			suite.Equal(tokenBefore.ClientID, tokenAfter.ClientID)
+		}
+	}
+
+	applications := []*gtsmodel.Application{}
+	err = newDB.GetAll(ctx, &applications)
+	suite.NoError(err)
+	suite.Len(applications, len(testrig.NewTestApplications()))
+
+	clients := []*gtsmodel.Client{}
+	err = newDB.GetAll(ctx, &clients)
+	suite.NoError(err)
+	suite.Len(clients, len(testrig.NewTestClients()))
+}
+
+func TestExportFullTestSuite(t *testing.T) {
+	suite.Run(t, &ExportFullTestSuite{})
+}
@@ -25,6 +25,7 @@ import (
 	"os"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	transmodel "github.com/superseriousbusiness/gotosocial/internal/trans/model"
 )
 
 func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
@@ -37,16 +38,31 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		return fmt.Errorf("ExportMinimal: couldn't export to %s: %s", path, err)
 	}
 
+	if err := e.exportHeader(ctx, file); err != nil {
+		return fmt.Errorf("ExportMinimal: error exporting header: %s", err)
+	}
+
+	if _, err := e.exportMinimal(ctx, file); err != nil {
+		return fmt.Errorf("ExportMinimal: %s", err)
+	}
+
+	return neatClose(file)
+}
+
+// exportMinimal writes out the entries that make up a minimal export to the given file,
+// and returns the local accounts that were exported, so that callers can export more
+// entries relating to them.
+func (e *exporter) exportMinimal(ctx context.Context, file *os.File) ([]*transmodel.Account, error) {
 	// export all local accounts we have in the database
 	localAccounts, err := e.exportAccounts(ctx, []db.Where{{Key: "domain", Value: nil}}, file)
 	if err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting accounts: %s", err)
+		return nil, fmt.Errorf("error exporting accounts: %s", err)
 	}
 
 	// export all blocks that relate to local accounts
 	blocks, err := e.exportBlocks(ctx, localAccounts, file)
 	if err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting blocks: %s", err)
+		return nil, fmt.Errorf("error exporting blocks: %s", err)
 	}
 
 	// for each block, make sure we've written out the account owning it, or targeted by it --
@@ -56,7 +72,7 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		if !alreadyWritten {
 			_, err := e.exportAccounts(ctx, []db.Where{{Key: "id", Value: b.AccountID}}, file)
 			if err != nil {
-				return fmt.Errorf("ExportMinimal: error exporting block owner account: %s", err)
+				return nil, fmt.Errorf("error exporting block owner account: %s", err)
 			}
 		}
 
@@ -64,7 +80,7 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		if !alreadyWritten {
 			_, err := e.exportAccounts(ctx, []db.Where{{Key: "id", Value: b.TargetAccountID}}, file)
 			if err != nil {
-				return fmt.Errorf("ExportMinimal: error exporting block target account: %s", err)
+				return nil, fmt.Errorf("error exporting block target account: %s", err)
 			}
 		}
 	}
@@ -72,7 +88,7 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 	// export all follows that relate to local accounts
 	follows, err := e.exportFollows(ctx, localAccounts, file)
 	if err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting follows: %s", err)
+		return nil, fmt.Errorf("error exporting follows: %s", err)
 	}
 
 	// for each follow, make sure we've written out the account owning it, or targeted by it --
@@ -82,7 +98,7 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		if !alreadyWritten {
 			_, err := e.exportAccounts(ctx, []db.Where{{Key: "id", Value: follow.AccountID}}, file)
 			if err != nil {
-				return fmt.Errorf("ExportMinimal: error exporting follow owner account: %s", err)
+				return nil, fmt.Errorf("error exporting follow owner account: %s", err)
 			}
 		}
 
@@ -90,7 +106,7 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		if !alreadyWritten {
 			_, err := e.exportAccounts(ctx, []db.Where{{Key: "id", Value: follow.TargetAccountID}}, file)
 			if err != nil {
-				return fmt.Errorf("ExportMinimal: error exporting follow target account: %s", err)
+				return nil, fmt.Errorf("error exporting follow target account: %s", err)
 			}
 		}
 	}
@@ -98,7 +114,7 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 	// export all follow requests that relate to local accounts
 	followRequests, err := e.exportFollowRequests(ctx, localAccounts, file)
 	if err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting follow requests: %s", err)
+		return nil, fmt.Errorf("error exporting follow requests: %s", err)
 	}
 
 	// for each follow request, make sure we've written out the account owning it, or targeted by it --
@@ -108,7 +124,7 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		if !alreadyWritten {
 			_, err := e.exportAccounts(ctx, []db.Where{{Key: "id", Value: fr.AccountID}}, file)
 			if err != nil {
-				return fmt.Errorf("ExportMinimal: error exporting follow request owner account: %s", err)
+				return nil, fmt.Errorf("error exporting follow request owner account: %s", err)
 			}
 		}
 
@@ -116,24 +132,29 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		if !alreadyWritten {
 			_, err := e.exportAccounts(ctx, []db.Where{{Key: "id", Value: fr.TargetAccountID}}, file)
 			if err != nil {
-				return fmt.Errorf("ExportMinimal: error exporting follow request target account: %s", err)
+				return nil, fmt.Errorf("error exporting follow request target account: %s", err)
 			}
 		}
 	}
 
+	// export all domain blocks owned by local accounts themselves
+	if _, err := e.exportAccountDomainBlocks(ctx, localAccounts, file); err != nil {
+		return nil, fmt.Errorf("error exporting account domain blocks: %s", err)
+	}
+
 	// export all domain blocks
 	if _, err := e.exportDomainBlocks(ctx, file); err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting domain blocks: %s", err)
+		return nil, fmt.Errorf("error exporting domain blocks: %s", err)
 	}
 
 	// export all users
 	if _, err := e.exportUsers(ctx, file); err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting users: %s", err)
+		return nil, fmt.Errorf("error exporting users: %s", err)
 	}
 
 	// export all instances
 	if _, err := e.exportInstances(ctx, file); err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting instances: %s", err)
+		return nil, fmt.Errorf("error exporting instances: %s", err)
 	}
 
 	// export all SUSPENDED accounts to make sure the suspension sticks across db migration etc
@@ -143,8 +164,8 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		Value: nil,
 	}}
 	if _, err := e.exportAccounts(ctx, whereSuspended, file); err != nil {
-		return fmt.Errorf("ExportMinimal: error exporting suspended accounts: %s", err)
+		return nil, fmt.Errorf("error exporting suspended accounts: %s", err)
 	}
 
-	return neatClose(file)
+	return localAccounts, nil
 }
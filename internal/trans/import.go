@@ -43,6 +43,11 @@ func (i *importer) Import(ctx context.Context, path string) error {
 	decoder := json.NewDecoder(file)
 	decoder.UseNumber()
 
+	// files without a header were written before the
+	// format was versioned, so they're version 1
+	i.version = 1
+	first := true
+
 	for {
 		entry := transmodel.Entry{}
 		err := decoder.Decode(&entry)
@@ -53,18 +58,40 @@ func (i *importer) Import(ctx context.Context, path string) error {
 			}
 			return fmt.Errorf("Import: error decoding in readLoop: %s", err)
 		}
-		if err := i.inputEntry(ctx, entry); err != nil {
+		if err := i.inputEntry(ctx, entry, first); err != nil {
 			return fmt.Errorf("Import: error inputting entry: %s", err)
 		}
+		first = false
 	}
 }
 
-func (i *importer) inputEntry(ctx context.Context, entry transmodel.Entry) error {
+func (i *importer) inputEntry(ctx context.Context, entry transmodel.Entry, first bool) error {
 	t, ok := entry[transmodel.TypeKey].(string)
 	if !ok {
 		return errors.New("inputEntry: could not derive entry type: missing or malformed 'type' key in json")
 	}
 
+	if transmodel.Type(t) == transmodel.TransHeader {
+		if !first {
+			return errors.New("inputEntry: header must be the first entry in the file")
+		}
+		header, err := i.headerDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into header: %s", err)
+		}
+		if header.Version < 1 || header.Version > transmodel.CurrentVersion {
+			return fmt.Errorf("inputEntry: file has version %d, but this version of GoToSocial only supports versions 1 to %d", header.Version, transmodel.CurrentVersion)
+		}
+		i.version = header.Version
+		log.Infof("inputEntry: importing file with version %d", header.Version)
+		return nil
+	}
+
+	// bring entries written by older versions up to date before decoding them
+	if err := upgradeEntry(entry, i.version); err != nil {
+		return fmt.Errorf("inputEntry: error upgrading entry from version %d: %s", i.version, err)
+	}
+
 	switch transmodel.Type(t) {
 	case transmodel.TransAccount:
 		account, err := i.accountDecode(entry)
@@ -76,6 +103,26 @@ func (i *importer) inputEntry(ctx context.Context, entry transmodel.Entry) error
 		}
 		log.Infof("inputEntry: added account with id %s", account.ID)
 		return nil
+	case transmodel.TransAccountDomainBlock:
+		block, err := i.accountDomainBlockDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into account domain block: %s", err)
+		}
+		if err := i.putInDB(ctx, block); err != nil {
+			return fmt.Errorf("inputEntry: error adding account domain block to database: %s", err)
+		}
+		log.Infof("inputEntry: added account domain block with id %s", block.ID)
+		return nil
+	case transmodel.TransApplication:
+		app, err := i.applicationDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into application: %s", err)
+		}
+		if err := i.putInDB(ctx, app); err != nil {
+			return fmt.Errorf("inputEntry: error adding application to database: %s", err)
+		}
+		log.Infof("inputEntry: added application with id %s", app.ID)
+		return nil
 	case transmodel.TransBlock:
 		block, err := i.blockDecode(entry)
 		if err != nil {
@@ -86,6 +133,16 @@ func (i *importer) inputEntry(ctx context.Context, entry transmodel.Entry) error
 		}
 		log.Infof("inputEntry: added block with id %s", block.ID)
 		return nil
+	case transmodel.TransClient:
+		client, err := i.clientDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into client: %s", err)
+		}
+		if err := i.putInDB(ctx, client); err != nil {
+			return fmt.Errorf("inputEntry: error adding client to database: %s", err)
+		}
+		log.Infof("inputEntry: added client with id %s", client.ID)
+		return nil
 	case transmodel.TransDomainBlock:
 		block, err := i.domainBlockDecode(entry)
 		if err != nil {
@@ -96,6 +153,26 @@ func (i *importer) inputEntry(ctx context.Context, entry transmodel.Entry) error
 		}
 		log.Infof("inputEntry: added domain block with id %s", block.ID)
 		return nil
+	case transmodel.TransEmoji:
+		emoji, err := i.emojiDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into emoji: %s", err)
+		}
+		if err := i.putInDB(ctx, emoji); err != nil {
+			return fmt.Errorf("inputEntry: error adding emoji to database: %s", err)
+		}
+		log.Infof("inputEntry: added emoji with id %s", emoji.ID)
+		return nil
+	case transmodel.TransEmojiCategory:
+		category, err := i.emojiCategoryDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into emoji category: %s", err)
+		}
+		if err := i.putInDB(ctx, category); err != nil {
+			return fmt.Errorf("inputEntry: error adding emoji category to database: %s", err)
+		}
+		log.Infof("inputEntry: added emoji category with id %s", category.ID)
+		return nil
 	case transmodel.TransFollow:
 		follow, err := i.followDecode(entry)
 		if err != nil {
@@ -126,6 +203,66 @@ func (i *importer) inputEntry(ctx context.Context, entry transmodel.Entry) error
 		}
 		log.Infof("inputEntry: added instance with id %s", inst.ID)
 		return nil
+	case transmodel.TransMediaAttachment:
+		attachment, err := i.mediaAttachmentDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into media attachment: %s", err)
+		}
+		if err := i.putInDB(ctx, attachment); err != nil {
+			return fmt.Errorf("inputEntry: error adding media attachment to database: %s", err)
+		}
+		log.Infof("inputEntry: added media attachment with id %s", attachment.ID)
+		return nil
+	case transmodel.TransStatus:
+		status, err := i.statusDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into status: %s", err)
+		}
+		if err := i.putInDB(ctx, status); err != nil {
+			return fmt.Errorf("inputEntry: error adding status to database: %s", err)
+		}
+		log.Infof("inputEntry: added status with id %s", status.ID)
+		return nil
+	case transmodel.TransStatusBookmark:
+		bookmark, err := i.statusBookmarkDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into status bookmark: %s", err)
+		}
+		if err := i.putInDB(ctx, bookmark); err != nil {
+			return fmt.Errorf("inputEntry: error adding status bookmark to database: %s", err)
+		}
+		log.Infof("inputEntry: added status bookmark with id %s", bookmark.ID)
+		return nil
+	case transmodel.TransStatusFave:
+		fave, err := i.statusFaveDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into status fave: %s", err)
+		}
+		if err := i.putInDB(ctx, fave); err != nil {
+			return fmt.Errorf("inputEntry: error adding status fave to database: %s", err)
+		}
+		log.Infof("inputEntry: added status fave with id %s", fave.ID)
+		return nil
+	case transmodel.TransStatusMute:
+		mute, err := i.statusMuteDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into status mute: %s", err)
+		}
+		if err := i.putInDB(ctx, mute); err != nil {
+			return fmt.Errorf("inputEntry: error adding status mute to database: %s", err)
+		}
+		log.Infof("inputEntry: added status mute with id %s", mute.ID)
+		return nil
+	case transmodel.TransToken:
+		token, err := i.tokenDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into token: %s", err)
+		}
+		if err := i.putInDB(ctx, token); err != nil {
+			return fmt.Errorf("inputEntry: error adding token to database: %s", err)
+		}
+		log.Infof("inputEntry: added token with id %s", token.ID)
+		return nil
 	case transmodel.TransUser:
 		user, err := i.userDecode(entry)
 		if err != nil {
@@ -124,6 +124,36 @@ func (suite *ImportMinimalTestSuite) TestImportMinimalOK() {
 	suite.Equal(testAccountBefore.SuspensionOrigin, testAccountAfter.SuspensionOrigin)
 }
 
+func (suite *ImportMinimalTestSuite) TestImportVersion1() {
+	ctx := context.Background()
+
+	// version 1 files have no header, and call filtered languages 'filteredLanguage'
+	v1 := `{"type":"user","id":"01GJ0B2V5WXQ0R1ZJ0TGN4ZQK4","createdAt":"2021-09-06T22:00:53.97248Z","email":"v1@example.org","accountID":"01GJ0B3A1R0QY4Y7Y2VJ0N3Q1K","encryptedPassword":"$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS","filteredLanguage":["de","fr"],"locale":"en","moderator":false,"admin":false,"disabled":false,"approved":true}`
+	tempFilePath := fmt.Sprintf("%s/%s", suite.T().TempDir(), uuid.NewString())
+	if err := os.WriteFile(tempFilePath, []byte(v1+"\n"), 0o600); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	newDB := testrig.NewTestDB()
+	err := trans.NewImporter(newDB).Import(ctx, tempFilePath)
+	suite.NoError(err)
+
+	user, err := newDB.GetUserByID(ctx, "01GJ0B2V5WXQ0R1ZJ0TGN4ZQK4")
+	if suite.NoError(err) {
+		suite.Equal([]string{"de", "fr"}, user.FilteredLanguages)
+	}
+}
+
+func (suite *ImportMinimalTestSuite) TestImportUnsupportedVersion() {
+	tempFilePath := fmt.Sprintf("%s/%s", suite.T().TempDir(), uuid.NewString())
+	if err := os.WriteFile(tempFilePath, []byte(`{"type":"header","version":9001}`+"\n"), 0o600); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	err := trans.NewImporter(testrig.NewTestDB()).Import(context.Background(), tempFilePath)
+	suite.ErrorContains(err, "file has version 9001")
+}
+
 func TestImportMinimalTestSuite(t *testing.T) {
 	suite.Run(t, &ImportMinimalTestSuite{})
 }
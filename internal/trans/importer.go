@@ -30,7 +30,8 @@ type Importer interface {
 }
 
 type importer struct {
-	db db.DB
+	db      db.DB
+	version int
 }
 
 // NewImporter returns a new Importer interface that uses the given db.
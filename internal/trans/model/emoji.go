@@ -0,0 +1,51 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package trans
+
+import "time"
+
+// Emoji represents a custom emoji as serialized in an export file.
+//
+// As with media attachments, only the metadata and storage paths are exported, not the image files themselves.
+type Emoji struct {
+	Type                   Type       `json:"type" bun:"-"`
+	ID                     string     `json:"id" bun:",nullzero"`
+	CreatedAt              *time.Time `json:"createdAt" bun:",nullzero"`
+	UpdatedAt              *time.Time `json:"updatedAt" bun:",nullzero"`
+	Shortcode              string     `json:"shortcode" bun:",nullzero"`
+	Domain                 string     `json:"domain,omitempty" bun:",nullzero"`
+	ImageRemoteURL         string     `json:"imageRemoteURL,omitempty" bun:",nullzero"`
+	ImageStaticRemoteURL   string     `json:"imageStaticRemoteURL,omitempty" bun:",nullzero"`
+	ImageURL               string     `json:"imageURL,omitempty" bun:",nullzero"`
+	ImageStaticURL         string     `json:"imageStaticURL,omitempty" bun:",nullzero"`
+	ImagePath              string     `json:"imagePath" bun:",nullzero"`
+	ImageStaticPath        string     `json:"imageStaticPath" bun:",nullzero"`
+	ImageContentType       string     `json:"imageContentType" bun:",nullzero"`
+	ImageStaticContentType string     `json:"imageStaticContentType" bun:",nullzero"`
+	ImageFileSize          int        `json:"imageFileSize" bun:",nullzero"`
+	ImageStaticFileSize    int        `json:"imageStaticFileSize" bun:",nullzero"`
+	ImageUpdatedAt         *time.Time `json:"imageUpdatedAt" bun:",nullzero"`
+	Disabled               *bool      `json:"disabled" bun:",nullzero,notnull,default:false"`
+	URI                    string     `json:"uri" bun:",nullzero"`
+	VisibleInPicker        *bool      `json:"visibleInPicker" bun:",nullzero,notnull,default:true"`
+	CategoryID             string     `json:"categoryID,omitempty" bun:",nullzero"`
+	Author                 string     `json:"author,omitempty" bun:",nullzero"`
+	License                string     `json:"license,omitempty" bun:",nullzero"`
+	SourceURL              string     `json:"sourceURL,omitempty" bun:",nullzero"`
+}
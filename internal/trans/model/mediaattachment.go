@@ -0,0 +1,60 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package trans
+
+import "time"
+
+// MediaAttachment represents a reference to a media attachment as serialized in an export file.
+//
+// Only the metadata and storage paths are exported, not the files themselves, so the storage
+// backend needs to be carried over separately for local media to remain available after import.
+type MediaAttachment struct {
+	Type                 Type       `json:"type" bun:"-"`
+	ID                   string     `json:"id" bun:",nullzero"`
+	CreatedAt            *time.Time `json:"createdAt" bun:",nullzero"`
+	StatusID             string     `json:"statusID,omitempty" bun:",nullzero"`
+	URL                  string     `json:"url,omitempty" bun:",nullzero"`
+	RemoteURL            string     `json:"remoteURL,omitempty" bun:",nullzero"`
+	FileType             string     `json:"fileType" bun:"type,nullzero"`
+	OriginalWidth        int        `json:"originalWidth,omitempty" bun:"original_width"`
+	OriginalHeight       int        `json:"originalHeight,omitempty" bun:"original_height"`
+	OriginalSize         int        `json:"originalSize,omitempty" bun:"original_size"`
+	OriginalAspect       float64    `json:"originalAspect,omitempty" bun:"original_aspect"`
+	SmallWidth           int        `json:"smallWidth,omitempty" bun:"small_width"`
+	SmallHeight          int        `json:"smallHeight,omitempty" bun:"small_height"`
+	SmallSize            int        `json:"smallSize,omitempty" bun:"small_size"`
+	SmallAspect          float64    `json:"smallAspect,omitempty" bun:"small_aspect"`
+	FocusX               float32    `json:"focusX,omitempty" bun:"focus_x"`
+	FocusY               float32    `json:"focusY,omitempty" bun:"focus_y"`
+	AccountID            string     `json:"accountID" bun:",nullzero"`
+	Description          string     `json:"description,omitempty"`
+	Blurhash             string     `json:"blurhash,omitempty" bun:",nullzero"`
+	Processing           int        `json:"processing"`
+	FilePath             string     `json:"filePath" bun:"file_path,nullzero"`
+	FileContentType      string     `json:"fileContentType" bun:"file_content_type,nullzero"`
+	FileSize             int        `json:"fileSize" bun:"file_file_size"`
+	ThumbnailPath        string     `json:"thumbnailPath" bun:"thumbnail_path,nullzero"`
+	ThumbnailContentType string     `json:"thumbnailContentType" bun:"thumbnail_content_type,nullzero"`
+	ThumbnailSize        int        `json:"thumbnailSize" bun:"thumbnail_file_size"`
+	ThumbnailURL         string     `json:"thumbnailURL,omitempty" bun:"thumbnail_url,nullzero"`
+	ThumbnailRemoteURL   string     `json:"thumbnailRemoteURL,omitempty" bun:"thumbnail_remote_url,nullzero"`
+	Avatar               *bool      `json:"avatar"`
+	Header               *bool      `json:"header"`
+	Cached               *bool      `json:"cached"`
+}
@@ -0,0 +1,54 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package trans
+
+import "time"
+
+// Status represents a status as serialized in an export file.
+//
+// Tags, mentions, and emojis are not exported; the content of the status is kept as-is.
+type Status struct {
+	Type                Type       `json:"type" bun:"-"`
+	ID                  string     `json:"id" bun:",nullzero"`
+	CreatedAt           *time.Time `json:"createdAt" bun:",nullzero"`
+	UpdatedAt           *time.Time `json:"updatedAt,omitempty" bun:",nullzero"`
+	URI                 string     `json:"uri" bun:",nullzero"`
+	URL                 string     `json:"url,omitempty" bun:",nullzero"`
+	Content             string     `json:"content,omitempty"`
+	AttachmentIDs       []string   `json:"attachmentIDs,omitempty" bun:"attachments,array"`
+	Local               *bool      `json:"local"`
+	AccountID           string     `json:"accountID" bun:",nullzero"`
+	AccountURI          string     `json:"accountURI" bun:",nullzero"`
+	InReplyToID         string     `json:"inReplyToID,omitempty" bun:",nullzero"`
+	InReplyToURI        string     `json:"inReplyToURI,omitempty" bun:",nullzero"`
+	InReplyToAccountID  string     `json:"inReplyToAccountID,omitempty" bun:",nullzero"`
+	BoostOfID           string     `json:"boostOfID,omitempty" bun:",nullzero"`
+	BoostOfAccountID    string     `json:"boostOfAccountID,omitempty" bun:",nullzero"`
+	ContentWarning      string     `json:"contentWarning,omitempty" bun:",nullzero"`
+	Visibility          string     `json:"visibility" bun:",nullzero"`
+	Sensitive           *bool      `json:"sensitive"`
+	Language            string     `json:"language,omitempty" bun:",nullzero"`
+	ActivityStreamsType string     `json:"activityStreamsType" bun:",nullzero"`
+	Text                string     `json:"text,omitempty"`
+	Pinned              *bool      `json:"pinned"`
+	Federated           *bool      `json:"federated"`
+	Boostable           *bool      `json:"boostable"`
+	Replyable           *bool      `json:"replyable"`
+	Likeable            *bool      `json:"likeable"`
+}
@@ -21,19 +21,36 @@ package trans
 // TypeKey should be set on a TransEntry to indicate the type of entry it is.
 const TypeKey = "type"
 
+// CurrentVersion is the version of the trans format written by this version of GoToSocial.
+// It should be incremented whenever the format of an entry changes in a way that older entries
+// need to be upgraded on import; see the upgrades in the trans package.
+const CurrentVersion = 2
+
 // Type describes the type of a trans entry, and how it should be read/serialized.
 type Type string
 
 // Type of the trans entry. Describes how it should be read from file.
 const (
-	TransAccount          Type = "account"
-	TransBlock            Type = "block"
-	TransDomainBlock      Type = "domainBlock"
-	TransEmailDomainBlock Type = "emailDomainBlock"
-	TransFollow           Type = "follow"
-	TransFollowRequest    Type = "followRequest"
-	TransInstance         Type = "instance"
-	TransUser             Type = "user"
+	TransAccount            Type = "account"
+	TransAccountDomainBlock Type = "accountDomainBlock"
+	TransApplication        Type = "application"
+	TransBlock              Type = "block"
+	TransClient             Type = "client"
+	TransDomainBlock        Type = "domainBlock"
+	TransEmailDomainBlock   Type = "emailDomainBlock"
+	TransEmoji              Type = "emoji"
+	TransEmojiCategory      Type = "emojiCategory"
+	TransFollow             Type = "follow"
+	TransFollowRequest      Type = "followRequest"
+	TransHeader             Type = "header"
+	TransInstance           Type = "instance"
+	TransMediaAttachment    Type = "mediaAttachment"
+	TransStatus             Type = "status"
+	TransStatusBookmark     Type = "statusBookmark"
+	TransStatusFave         Type = "statusFave"
+	TransStatusMute         Type = "statusMute"
+	TransToken              Type = "token"
+	TransUser               Type = "user"
 )
 
 // Entry is used for deserializing trans entries into a rough interface so that
@@ -34,7 +34,7 @@ type User struct {
 	LastSignInAt        *time.Time `json:"lastSignInAt,omitempty" bun:",nullzero"`
 	InviteID            string     `json:"inviteID,omitempty" bun:",nullzero"`
 	ChosenLanguages     []string   `json:"chosenLanguages,omitempty" bun:",nullzero"`
-	FilteredLanguages   []string   `json:"filteredLanguage,omitempty" bun:",nullzero"`
+	FilteredLanguages   []string   `json:"filteredLanguages,omitempty" bun:",nullzero"`
 	Locale              string     `json:"locale" bun:",nullzero"`
 	LastEmailedAt       time.Time  `json:"lastEmailedAt,omitempty" bun:",nullzero"`
 	ConfirmationToken   string     `json:"confirmationToken,omitempty" bun:",nullzero"`
@@ -29,6 +29,7 @@ type TransTestSuite struct {
 	suite.Suite
 	db           db.DB
 	testAccounts map[string]*gtsmodel.Account
+	testFaves    map[string]*gtsmodel.StatusFave
 }
 
 func (suite *TransTestSuite) SetupTest() {
@@ -36,6 +37,7 @@ func (suite *TransTestSuite) SetupTest() {
 	testrig.InitTestLog()
 
 	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testFaves = testrig.NewTestFaves()
 
 	suite.db = testrig.NewTestDB()
 	testrig.StandardDBSetup(suite.db, nil)
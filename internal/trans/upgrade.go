@@ -0,0 +1,64 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package trans
+
+import (
+	transmodel "github.com/superseriousbusiness/gotosocial/internal/trans/model"
+)
+
+// entryUpgrades maps a format version to a function that upgrades
+// an entry written in that version, to the version that follows it.
+//
+// When making a change to the format that older entries won't decode
+// into cleanly, increment transmodel.CurrentVersion, and add a function
+// here to upgrade entries from the previous version.
+var entryUpgrades = map[int]func(entry transmodel.Entry) error{
+	1: upgradeFromV1,
+}
+
+// upgradeEntry upgrades the given entry in place, from
+// the given version, to transmodel.CurrentVersion.
+func upgradeEntry(entry transmodel.Entry, version int) error {
+	for v := version; v < transmodel.CurrentVersion; v++ {
+		upgrade, ok := entryUpgrades[v]
+		if !ok {
+			continue
+		}
+		if err := upgrade(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradeFromV1 upgrades a version 1 entry to version 2.
+//
+// Version 1 serialized the filtered languages of a user as 'filteredLanguage'.
+func upgradeFromV1(entry transmodel.Entry) error {
+	if entry[transmodel.TypeKey] != string(transmodel.TransUser) {
+		return nil
+	}
+
+	if filteredLanguages, ok := entry["filteredLanguage"]; ok {
+		entry["filteredLanguages"] = filteredLanguages
+		delete(entry, "filteredLanguage")
+	}
+
+	return nil
+}
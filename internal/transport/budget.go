@@ -0,0 +1,107 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRetryAfter is the longest we're willing to wait
+// when a remote host asks us to back off via Retry-After.
+const maxRetryAfter = 5 * time.Minute
+
+// hostBudget spaces out requests to a single remote host, so that
+// no more than a set amount of requests are made to it per minute.
+// Requests over the budget are queued rather than dropped.
+type hostBudget struct {
+	mu          sync.Mutex
+	next        time.Time // earliest time at which the next budgeted request may be made
+	pausedUntil time.Time // no requests at all may be made before this time
+}
+
+// wait blocks until a request to this host is permitted, or until
+// ctx is cancelled. If perMinute is greater than 0, each call reserves
+// a slot in the budget, with slots spaced evenly according to perMinute.
+// Otherwise, wait only blocks if requests to this host have been paused.
+func (b *hostBudget) wait(ctx context.Context, now time.Time, perMinute int) error {
+	b.mu.Lock()
+	slot := now
+	if slot.Before(b.pausedUntil) {
+		slot = b.pausedUntil
+	}
+	if perMinute > 0 {
+		if slot.Before(b.next) {
+			slot = b.next
+		}
+		b.next = slot.Add(time.Minute / time.Duration(perMinute))
+	}
+	b.mu.Unlock()
+
+	delay := slot.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// pauseUntil stops any more requests being permitted to
+// this host until the given time, eg., because the host
+// asked us to back off with a Retry-After header.
+func (b *hostBudget) pauseUntil(t time.Time) {
+	b.mu.Lock()
+	if b.pausedUntil.Before(t) {
+		b.pausedUntil = t
+	}
+	b.mu.Unlock()
+}
+
+// retryAfter parses the Retry-After header of the given response,
+// which may be either a number of seconds, or an HTTP date. The
+// returned duration will be 0 if the header is absent or invalid.
+func retryAfter(rsp *http.Response, now time.Time) time.Duration {
+	header := rsp.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+
+	if t, err := http.ParseTime(header); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,97 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BudgetTestSuite struct {
+	suite.Suite
+}
+
+func (suite *BudgetTestSuite) TestWaitNoBudget() {
+	b := &hostBudget{}
+	now := time.Now()
+
+	for i := 0; i < 10; i++ {
+		suite.NoError(b.wait(context.Background(), now, 0))
+	}
+	suite.True(b.next.IsZero())
+}
+
+func (suite *BudgetTestSuite) TestWaitReservesSlots() {
+	b := &hostBudget{}
+	now := time.Now()
+
+	// first request goes straight away, and
+	// reserves the next slot one second later
+	suite.NoError(b.wait(context.Background(), now, 60))
+	suite.Equal(now.Add(time.Second), b.next)
+
+	// second request has to queue for its slot
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	suite.ErrorIs(b.wait(ctx, now, 60), context.DeadlineExceeded)
+	suite.Equal(now.Add(2*time.Second), b.next)
+}
+
+func (suite *BudgetTestSuite) TestPauseUntil() {
+	b := &hostBudget{}
+	now := time.Now()
+
+	b.pauseUntil(now.Add(time.Minute))
+
+	// a pause applies even without a budget
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	suite.ErrorIs(b.wait(ctx, now, 0), context.DeadlineExceeded)
+
+	// an earlier pause doesn't shorten the current one
+	b.pauseUntil(now.Add(time.Second))
+	suite.Equal(now.Add(time.Minute), b.pausedUntil)
+}
+
+func (suite *BudgetTestSuite) TestRetryAfter() {
+	now := time.Date(2022, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	rsp := &http.Response{Header: http.Header{}}
+	suite.Zero(retryAfter(rsp, now))
+
+	rsp.Header.Set("Retry-After", "120")
+	suite.Equal(2*time.Minute, retryAfter(rsp, now))
+
+	rsp.Header.Set("Retry-After", now.Add(30*time.Second).Format(http.TimeFormat))
+	suite.Equal(30*time.Second, retryAfter(rsp, now))
+
+	rsp.Header.Set("Retry-After", now.Add(-30*time.Second).Format(http.TimeFormat))
+	suite.Zero(retryAfter(rsp, now))
+
+	rsp.Header.Set("Retry-After", "whenever you like")
+	suite.Zero(retryAfter(rsp, now))
+}
+
+func TestBudgetTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetTestSuite))
+}
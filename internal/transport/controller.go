@@ -44,6 +44,11 @@ type Controller interface {
 
 	// NewTransportForUsername searches for account with username, and returns result of .NewTransport().
 	NewTransportForUsername(ctx context.Context, username string) (Transport, error)
+
+	// FederationErrors returns the collector of recent federation errors, which
+	// holds failed requests made by transports from this controller, as well as
+	// any failures to authenticate inbound requests recorded by the federator.
+	FederationErrors() *FederationErrors
 }
 
 type controller struct {
@@ -53,6 +58,8 @@ type controller struct {
 	client    pub.HttpClient
 	trspCache cache.Cache[string, *transport]
 	badHosts  cache.Cache[string, struct{}]
+	budgets   cache.Cache[string, *hostBudget]
+	fedErrors *FederationErrors
 	userAgent string
 }
 
@@ -69,6 +76,8 @@ func NewController(db db.DB, federatingDB federatingdb.DB, clock pub.Clock, clie
 		client:    client,
 		trspCache: cache.New[string, *transport](),
 		badHosts:  cache.New[string, struct{}](),
+		budgets:   cache.New[string, *hostBudget](),
+		fedErrors: NewFederationErrors(),
 		userAgent: fmt.Sprintf("%s; %s (gofed/activity gotosocial-%s)", applicationName, host, version),
 	}
 
@@ -84,6 +93,12 @@ func NewController(db db.DB, federatingDB federatingdb.DB, clock pub.Clock, clie
 		log.Panic("failed to start transport controller cache")
 	}
 
+	// Host budgets cache has TTL=15min freq=1min
+	c.budgets.SetTTL(15*time.Minute, false)
+	if !c.budgets.Start(time.Minute) {
+		log.Panic("failed to start transport controller cache")
+	}
+
 	return c
 }
 
@@ -126,6 +141,10 @@ func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Tra
 	return transp, nil
 }
 
+func (c *controller) FederationErrors() *FederationErrors {
+	return c.fedErrors
+}
+
 func (c *controller) NewTransportForUsername(ctx context.Context, username string) (Transport, error) {
 	// We need an account to use to create a transport for dereferecing something.
 	// If a username has been given, we can fetch the account with that username and use it.
@@ -150,6 +169,25 @@ func (c *controller) NewTransportForUsername(ctx context.Context, username strin
 	return transport, nil
 }
 
+// budgetFor returns the request budget for the given host,
+// creating a new one if this host hasn't been seen recently.
+func (c *controller) budgetFor(host string) *hostBudget {
+	budget, ok := c.budgets.Get(host)
+	if ok {
+		return budget
+	}
+
+	budget = &hostBudget{}
+	if !c.budgets.Put(host, budget) {
+		// Another request beat us to it
+		if cached, ok := c.budgets.Get(host); ok {
+			budget = cached
+		}
+	}
+
+	return budget
+}
+
 // dereferenceLocalFollowers is a shortcut to dereference followers of an
 // account on this instance, without making any external api/http calls.
 //
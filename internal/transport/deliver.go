@@ -27,11 +27,21 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*url.URL) error {
+	// if this delivers a local status, tally the outcome
+	// for each domain, so that its author can see it
+	var receipts *deliveryReceipts
+	statusID, _ := ctx.Value(ap.ContextDeliveringStatusID).(string)
+	if statusID != "" {
+		receipts = newDeliveryReceipts()
+	}
+
 	// concurrently deliver to recipients; for each delivery, buffer the error if it fails
 	wg := sync.WaitGroup{}
 	errCh := make(chan error, len(recipients))
@@ -39,7 +49,11 @@ func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*ur
 		wg.Add(1)
 		go func(r *url.URL) {
 			defer wg.Done()
-			if err := t.Deliver(ctx, b, r); err != nil {
+			err := t.Deliver(ctx, b, r)
+			if receipts != nil && !isOwnHost(r.Host) {
+				receipts.record(r, err)
+			}
+			if err != nil {
 				errCh <- err
 			}
 		}(recipient)
@@ -48,6 +62,10 @@ func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*ur
 	// wait until all deliveries have succeeded or failed
 	wg.Wait()
 
+	if receipts != nil {
+		t.storeReceipts(ctx, statusID, receipts)
+	}
+
 	// receive any buffered errors
 	errs := make([]string, 0, len(recipients))
 outer:
@@ -69,7 +87,7 @@ outer:
 
 func (t *transport) Deliver(ctx context.Context, b []byte, to *url.URL) error {
 	// if the 'to' host is our own, just skip this delivery since we by definition already have the message!
-	if to.Host == config.GetHost() || to.Host == config.GetAccountDomain() {
+	if isOwnHost(to.Host) {
 		return nil
 	}
 
@@ -98,3 +116,20 @@ func (t *transport) Deliver(ctx context.Context, b []byte, to *url.URL) error {
 
 	return nil
 }
+
+// storeReceipts stores the tallied outcome of delivering the given status. Failing
+// to store it is only logged, since it doesn't affect the delivery itself.
+func (t *transport) storeReceipts(ctx context.Context, statusID string, receipts *deliveryReceipts) {
+	deliveries, err := receipts.deliveries(statusID)
+	if err == nil {
+		err = t.controller.db.PutStatusDeliveries(ctx, deliveries)
+	}
+	if err != nil {
+		log.Errorf("BatchDeliver: error storing delivery outcomes of status %s: %s", statusID, err)
+	}
+}
+
+// isOwnHost returns whether the given host is this instance.
+func isOwnHost(host string) bool {
+	return host == config.GetHost() || host == config.GetAccountDomain()
+}
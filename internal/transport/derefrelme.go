@@ -0,0 +1,94 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"golang.org/x/net/html"
+)
+
+func (t *transport) DereferenceRelMe(ctx context.Context, iri *url.URL) ([]string, error) {
+	// Build IRI just once
+	iriStr := iri.String()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", iriStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", string(api.TextHTML))
+	req.Header.Add("User-Agent", t.controller.userAgent)
+	req.Header.Set("Host", iri.Host)
+
+	rsp, err := t.GET(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iriStr, rsp.StatusCode, rsp.Status)
+	}
+
+	doc, err := html.Parse(rsp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing html from %s: %s", iriStr, err)
+	}
+
+	return relMeLinks(doc), nil
+}
+
+// relMeLinks walks the given html node tree, and returns the href
+// of every <a> or <link> element which has 'me' as one of its rels.
+func relMeLinks(n *html.Node) []string {
+	links := []string{}
+
+	if n.Type == html.ElementNode && (n.Data == "a" || n.Data == "link") {
+		var href string
+		var relMe bool
+		for _, attr := range n.Attr {
+			switch strings.ToLower(attr.Key) {
+			case "href":
+				href = attr.Val
+			case "rel":
+				for _, rel := range strings.Fields(attr.Val) {
+					if strings.EqualFold(rel, "me") {
+						relMe = true
+					}
+				}
+			}
+		}
+
+		if relMe && href != "" {
+			links = append(links, href)
+		}
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		links = append(links, relMeLinks(c)...)
+	}
+
+	return links
+}
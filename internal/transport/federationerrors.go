@@ -0,0 +1,184 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// errorsPerDomain is the number of most
+	// recent errors kept for each remote domain.
+	errorsPerDomain = 20
+
+	// maxErrorDomains is the most remote domains errors are
+	// kept for; beyond this, the domain which has gone longest
+	// without an error is forgotten to make room for a new one.
+	maxErrorDomains = 500
+)
+
+// FederationErrorKind describes the way in which a federation request failed.
+type FederationErrorKind string
+
+const (
+	FederationErrorSignature  FederationErrorKind = "signature"   // an http signature was rejected, either by us or by the remote domain
+	FederationErrorTLS        FederationErrorKind = "tls"         // a TLS handshake or certificate verification failed
+	FederationErrorHTTPStatus FederationErrorKind = "http_status" // the remote domain responded with an error status code
+	FederationErrorNetwork    FederationErrorKind = "network"     // the remote domain couldn't be reached at all
+)
+
+// FederationError is one failed federation request made to or received from a remote domain.
+type FederationError struct {
+	Time       time.Time
+	Domain     string
+	Inbound    bool // whether the request was made to us by the remote domain
+	Kind       FederationErrorKind
+	URL        string // url of the request, only set for outbound requests
+	StatusCode int    // http status code of the response, if there was one
+	Message    string
+}
+
+// FederationErrorDomain summarizes the recent federation errors for one remote domain.
+type FederationErrorDomain struct {
+	Domain string
+	Count  int             // number of errors recorded for this domain since startup
+	Latest FederationError // the most recent error recorded for this domain
+}
+
+// FederationErrors keeps the most recent federation errors for each remote
+// domain in memory, so that admins can see why federation with a domain is
+// failing without having to go through the logs. It is safe for concurrent use.
+type FederationErrors struct {
+	mu      sync.Mutex
+	domains map[string]*domainErrors
+}
+
+// domainErrors is a ring buffer of the most recent errors for one domain.
+type domainErrors struct {
+	ring  [errorsPerDomain]FederationError
+	next  int // index in ring at which the next error will be written
+	count int // number of errors recorded since startup
+}
+
+// latest returns the most recently recorded error.
+func (d *domainErrors) latest() FederationError {
+	return d.ring[(d.next+errorsPerDomain-1)%errorsPerDomain]
+}
+
+// NewFederationErrors returns a new, empty federation error collector.
+func NewFederationErrors() *FederationErrors {
+	return &FederationErrors{
+		domains: make(map[string]*domainErrors),
+	}
+}
+
+// Record adds the given error to the errors kept for its domain,
+// pushing out the oldest kept error for that domain if necessary.
+// If the error's time is not set, the current time will be used.
+func (f *FederationErrors) Record(e FederationError) {
+	e.Domain = strings.ToLower(e.Domain)
+	if e.Domain == "" {
+		return
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	d, ok := f.domains[e.Domain]
+	if !ok {
+		if len(f.domains) >= maxErrorDomains {
+			f.evict()
+		}
+		d = &domainErrors{}
+		f.domains[e.Domain] = d
+	}
+
+	d.ring[d.next] = e
+	d.next = (d.next + 1) % errorsPerDomain
+	d.count++
+}
+
+// evict forgets the domain which has gone longest without an error.
+// It must only be called while holding the mutex.
+func (f *FederationErrors) evict() {
+	var (
+		oldest   string
+		oldestAt time.Time
+	)
+
+	for domain, d := range f.domains {
+		if at := d.latest().Time; oldest == "" || at.Before(oldestAt) {
+			oldest = domain
+			oldestAt = at
+		}
+	}
+
+	delete(f.domains, oldest)
+}
+
+// Domains returns a summary of every domain for which
+// errors have been recorded, most recently failing first.
+func (f *FederationErrors) Domains() []FederationErrorDomain {
+	f.mu.Lock()
+	summaries := make([]FederationErrorDomain, 0, len(f.domains))
+	for domain, d := range f.domains {
+		summaries = append(summaries, FederationErrorDomain{
+			Domain: domain,
+			Count:  d.count,
+			Latest: d.latest(),
+		})
+	}
+	f.mu.Unlock()
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Latest.Time.After(summaries[j].Latest.Time)
+	})
+
+	return summaries
+}
+
+// Get returns the errors kept for the given domain, most recent first.
+func (f *FederationErrors) Get(domain string) []FederationError {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	d, ok := f.domains[strings.ToLower(domain)]
+	if !ok {
+		return nil
+	}
+
+	n := d.count
+	if n > errorsPerDomain {
+		n = errorsPerDomain
+	}
+
+	errs := make([]FederationError, 0, n)
+	for i := 1; i <= n; i++ {
+		errs = append(errs, d.ring[(d.next+errorsPerDomain-i)%errorsPerDomain])
+	}
+
+	return errs
+}
@@ -0,0 +1,103 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FederationErrorsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *FederationErrorsTestSuite) TestRecordAndGet() {
+	f := NewFederationErrors()
+	start := time.Now()
+
+	for i := 0; i < errorsPerDomain+5; i++ {
+		f.Record(FederationError{
+			Time:    start.Add(time.Duration(i) * time.Second),
+			Domain:  "Example.org",
+			Kind:    FederationErrorNetwork,
+			Message: fmt.Sprintf("error %d", i),
+		})
+	}
+
+	// only the most recent errors are kept, newest first
+	errs := f.Get("example.org")
+	suite.Len(errs, errorsPerDomain)
+	suite.Equal(fmt.Sprintf("error %d", errorsPerDomain+4), errs[0].Message)
+	suite.Equal("error 5", errs[errorsPerDomain-1].Message)
+
+	// but they're all counted
+	domains := f.Domains()
+	suite.Len(domains, 1)
+	suite.Equal("example.org", domains[0].Domain)
+	suite.Equal(errorsPerDomain+5, domains[0].Count)
+	suite.Equal(errs[0], domains[0].Latest)
+
+	suite.Empty(f.Get("somewhere.else"))
+}
+
+func (suite *FederationErrorsTestSuite) TestDomainsOrder() {
+	f := NewFederationErrors()
+	start := time.Now()
+
+	f.Record(FederationError{Time: start, Domain: "first.example.org"})
+	f.Record(FederationError{Time: start.Add(time.Minute), Domain: "second.example.org"})
+	f.Record(FederationError{Time: start.Add(2 * time.Minute), Domain: "first.example.org"})
+	f.Record(FederationError{Domain: ""})
+
+	domains := f.Domains()
+	suite.Len(domains, 2)
+	suite.Equal("first.example.org", domains[0].Domain)
+	suite.Equal(2, domains[0].Count)
+	suite.Equal("second.example.org", domains[1].Domain)
+	suite.Equal(1, domains[1].Count)
+}
+
+func (suite *FederationErrorsTestSuite) TestEvictOldestDomain() {
+	f := NewFederationErrors()
+	start := time.Now()
+
+	for i := 0; i < maxErrorDomains; i++ {
+		f.Record(FederationError{
+			Time:   start.Add(time.Duration(i) * time.Second),
+			Domain: fmt.Sprintf("%d.example.org", i),
+		})
+	}
+
+	// domain 0 fails again, so domain 1 is now the one that
+	// has gone longest without an error, and gets forgotten
+	f.Record(FederationError{Time: start.Add(time.Hour), Domain: "0.example.org"})
+	f.Record(FederationError{Time: start.Add(time.Hour), Domain: "new.example.org"})
+
+	suite.Len(f.Domains(), maxErrorDomains)
+	suite.Empty(f.Get("1.example.org"))
+	suite.Len(f.Get("0.example.org"), 2)
+	suite.Len(f.Get("new.example.org"), 1)
+}
+
+func TestFederationErrorsTestSuite(t *testing.T) {
+	suite.Run(t, new(FederationErrorsTestSuite))
+}
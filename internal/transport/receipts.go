@@ -0,0 +1,88 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// deliveryReceipts tallies the outcome of delivering a status
+// to each remote domain. It is safe for concurrent use.
+type deliveryReceipts struct {
+	mu      sync.Mutex
+	domains map[string]*gtsmodel.StatusDelivery
+}
+
+// newDeliveryReceipts returns a new, empty tally of delivery outcomes.
+func newDeliveryReceipts() *deliveryReceipts {
+	return &deliveryReceipts{
+		domains: make(map[string]*gtsmodel.StatusDelivery),
+	}
+}
+
+// record counts the outcome of one delivery to the given inbox; err is nil if it succeeded.
+func (r *deliveryReceipts) record(inbox *url.URL, err error) {
+	domain := strings.ToLower(inbox.Host)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.domains[domain]
+	if !ok {
+		d = &gtsmodel.StatusDelivery{Domain: domain}
+		r.domains[domain] = d
+	}
+
+	if err != nil {
+		d.Failed++
+		d.Error = err.Error()
+	} else {
+		d.Delivered++
+	}
+}
+
+// deliveries returns the tallied outcomes as delivery
+// models of the given status, ready to be stored.
+func (r *deliveryReceipts) deliveries(statusID string) ([]*gtsmodel.StatusDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	deliveries := make([]*gtsmodel.StatusDelivery, 0, len(r.domains))
+	for _, d := range r.domains {
+		deliveryID, err := id.NewULID()
+		if err != nil {
+			return nil, err
+		}
+
+		d.ID = deliveryID
+		d.CreatedAt = now
+		d.UpdatedAt = now
+		d.StatusID = statusID
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
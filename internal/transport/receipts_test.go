@@ -0,0 +1,72 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ReceiptsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ReceiptsTestSuite) TestDeliveries() {
+	receipts := newDeliveryReceipts()
+
+	inbox := func(s string) *url.URL {
+		u, err := url.Parse(s)
+		suite.NoError(err)
+		return u
+	}
+
+	receipts.record(inbox("https://example.org/users/someone/inbox"), nil)
+	receipts.record(inbox("https://EXAMPLE.org/users/someone_else/inbox"), errors.New("connection refused"))
+	receipts.record(inbox("https://example.org/inbox"), nil)
+	receipts.record(inbox("https://fossbros-anonymous.io/inbox"), nil)
+
+	deliveries, err := receipts.deliveries("01F8MHAMCHF6Y01D3HX5DKQB6Z")
+	suite.NoError(err)
+	suite.Len(deliveries, 2)
+
+	for _, d := range deliveries {
+		suite.NotEmpty(d.ID)
+		suite.Equal("01F8MHAMCHF6Y01D3HX5DKQB6Z", d.StatusID)
+
+		switch d.Domain {
+		case "example.org":
+			suite.Equal(2, d.Delivered)
+			suite.Equal(1, d.Failed)
+			suite.Equal("connection refused", d.Error)
+		case "fossbros-anonymous.io":
+			suite.Equal(1, d.Delivered)
+			suite.Zero(d.Failed)
+			suite.Empty(d.Error)
+		default:
+			suite.FailNow("unexpected domain " + d.Domain)
+		}
+	}
+}
+
+func TestReceiptsTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptsTestSuite))
+}
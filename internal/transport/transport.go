@@ -21,8 +21,10 @@ package transport
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -34,6 +36,7 @@ import (
 	"codeberg.org/gruf/go-kv"
 	"github.com/go-fed/httpsig"
 	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -52,6 +55,23 @@ type Transport interface {
 	DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error)
 	// Finger performs a webfinger request with the given username and domain, and returns the bytes from the response body.
 	Finger(ctx context.Context, targetUsername string, targetDomains string) ([]byte, error)
+	// DereferenceRelMe fetches the html page at the given IRI, and returns the hrefs of any links on it with rel="me".
+	DereferenceRelMe(ctx context.Context, iri *url.URL) ([]string, error)
+}
+
+// errBadHost is returned when a host has recently failed
+// too many requests in a row to be worth trying again yet.
+var errBadHost = errors.New("too many failed attempts")
+
+// statusError is the error generated from an http
+// response with a status code that warrants a retry.
+type statusError struct {
+	code   int
+	status string
+}
+
+func (e *statusError) Error() string {
+	return `http response "` + e.status + `"`
 }
 
 // transport implements the Transport interface
@@ -71,9 +91,11 @@ func (t *transport) GET(r *http.Request, retryOn ...int) (*http.Response, error)
 	if r.Method != http.MethodGet {
 		return nil, errors.New("must be GET request")
 	}
-	return t.do(r, func(r *http.Request) error {
+	rsp, err := t.do(r, func(r *http.Request) error {
 		return t.signGET(r)
 	}, retryOn...)
+	t.report(r, rsp, err)
+	return rsp, err
 }
 
 // POST will perform given http request using transport client, retrying on certain preset errors, or if status code is among retryOn.
@@ -81,9 +103,11 @@ func (t *transport) POST(r *http.Request, body []byte, retryOn ...int) (*http.Re
 	if r.Method != http.MethodPost {
 		return nil, errors.New("must be POST request")
 	}
-	return t.do(r, func(r *http.Request) error {
+	rsp, err := t.do(r, func(r *http.Request) error {
 		return t.signPOST(r, body)
 	}, retryOn...)
+	t.report(r, rsp, err)
+	return rsp, err
 }
 
 func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryOn ...int) (*http.Response, error) {
@@ -95,6 +119,9 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 
 		// Get request hostname
 		host = r.URL.Hostname()
+
+		// Error from the most recent attempt
+		lastErr error
 	)
 
 	// Check if recently reached max retries for this host
@@ -103,7 +130,7 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 	// domain resolution type errors, so this cached result
 	// indicates this server is likely having issues.
 	if t.controller.badHosts.Has(host) {
-		return nil, errors.New("too many failed attempts")
+		return nil, errBadHost
 	}
 
 	// Start a log entry for this request
@@ -113,7 +140,22 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 		{"url", r.URL.String()},
 	}...)
 
+	// Get the request budget for this host; fetches are spaced
+	// out according to the budget, and both fetches and deliveries
+	// respect any request from the host to back off for a while.
+	budget := t.controller.budgetFor(host)
+
 	for i := 0; i < maxRetries; i++ {
+		perMinute := 0
+		if r.Method == http.MethodGet {
+			perMinute = config.GetAdvancedRemoteHostRequestsPerMinute()
+		}
+
+		// Wait for our turn to make a request to this host
+		if err := budget.wait(r.Context(), t.controller.clock.Now(), perMinute); err != nil {
+			return nil, err
+		}
+
 		// Reset signing header fields
 		now := t.controller.clock.Now().UTC()
 		r.Header.Set("Date", now.Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
@@ -140,7 +182,28 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 			}
 
 			// Generate error from status code for logging
-			err = errors.New(`http response "` + rsp.Status + `"`)
+			err = &statusError{code: rsp.StatusCode, status: rsp.Status}
+
+			// Check whether the remote host asked us to back off
+			// for a particular length of time before trying again
+			if code := rsp.StatusCode; code == http.StatusTooManyRequests ||
+				code == http.StatusServiceUnavailable {
+				if wait := retryAfter(rsp, t.controller.clock.Now()); wait > 0 {
+					if wait > maxRetryAfter {
+						_ = rsp.Body.Close()
+						return nil, fmt.Errorf("%w: remote asked us to retry after %s, which is too long", err, wait)
+					}
+
+					// Hold back all requests to this host
+					budget.pauseUntil(t.controller.clock.Now().Add(wait))
+					if wait > backoff {
+						backoff = wait
+					}
+				}
+			}
+
+			// We won't be using this response
+			_ = rsp.Body.Close()
 		} else if errorsv2.Is(err,
 			context.DeadlineExceeded,
 			context.Canceled,
@@ -159,6 +222,7 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 		}
 
 		l.Errorf("backing off for %s after http request error: %v", backoff.String(), err)
+		lastErr = err
 
 		select {
 		// Request ctx cancelled
@@ -174,7 +238,65 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 	// Add "bad" entry for this host
 	t.controller.badHosts.Set(host, struct{}{})
 
-	return nil, errors.New("transport reached max retries")
+	return nil, fmt.Errorf("transport reached max retries: %w", lastErr)
+}
+
+// report records a finished request in the controller's federation
+// errors, if it failed in a way which would help an admin work out
+// why federation with the remote host isn't working.
+func (t *transport) report(r *http.Request, rsp *http.Response, err error) {
+	e := FederationError{
+		Domain: r.URL.Hostname(),
+		URL:    r.URL.String(),
+	}
+
+	var statusErr *statusError
+	switch {
+	case err == nil:
+		// Remote hosts reject our signatures with a 401. Other
+		// client error codes are only reported for deliveries,
+		// since dereferences which 404 or 410 are business as usual.
+		switch code := rsp.StatusCode; {
+		case code == http.StatusUnauthorized:
+			e.Kind = FederationErrorSignature
+		case code >= 400 && r.Method == http.MethodPost:
+			e.Kind = FederationErrorHTTPStatus
+		default:
+			return
+		}
+		e.StatusCode = rsp.StatusCode
+		e.Message = `http response "` + rsp.Status + `"`
+		t.controller.fedErrors.Record(e)
+		return
+	case errorsv2.Is(err,
+		errBadHost,
+		context.Canceled,
+		httpclient.ErrInvalidRequest,
+		httpclient.ErrReservedAddr,
+	):
+		// Either already reported, or not the remote host's doing
+		return
+	case errors.As(err, &statusErr):
+		e.Kind = FederationErrorHTTPStatus
+		e.StatusCode = statusErr.code
+	case isTLSError(err):
+		e.Kind = FederationErrorTLS
+	default:
+		e.Kind = FederationErrorNetwork
+	}
+
+	e.Message = err.Error()
+	t.controller.fedErrors.Record(e)
+}
+
+// isTLSError returns whether err was caused by a failed
+// TLS handshake or an untrusted certificate.
+func isTLSError(err error) bool {
+	return errors.As(err, &x509.UnknownAuthorityError{}) ||
+		errors.As(err, &x509.HostnameError{}) ||
+		errors.As(err, &x509.CertificateInvalidError{}) ||
+		errors.As(err, &tls.RecordHeaderError{}) ||
+		strings.Contains(err.Error(), "tls: ")
 }
 
 // signGET will safely sign an HTTP GET request.
@@ -22,11 +22,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
@@ -330,10 +332,11 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 
 	// advanced visibility for this status
 	// TODO: a lot of work to be done here -- a new type needs to be created for this in go-fed/activity using ASTOOL
-	// for now we just set everything to true
+	// for now we just set everything to true, except boostable, which follows the usual AP convention
+	// that only public and unlisted statuses can be boosted by anyone other than the author
 	pinned := false
 	federated := true
-	boostable := true
+	boostable := visibility == gtsmodel.VisibilityPublic || visibility == gtsmodel.VisibilityUnlocked
 	replyable := true
 	likeable := true
 
@@ -352,9 +355,65 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	// ActivityStreamsType
 	status.ActivityStreamsType = statusable.GetTypeName()
 
+	// poll, if this status is a Question
+	if pollable, ok := statusable.(ap.Pollable); ok {
+		poll, err := c.ASPollToPoll(ctx, pollable)
+		if err != nil {
+			return nil, fmt.Errorf("ASStatusToStatus: error extracting poll: %s", err)
+		}
+
+		pollID, err := id.NewULID()
+		if err != nil {
+			return nil, err
+		}
+		poll.ID = pollID
+
+		status.Poll = poll
+		status.PollID = pollID
+	}
+
 	return status, nil
 }
 
+func (c *converter) ASPollToPoll(ctx context.Context, pollable ap.Pollable) (*gtsmodel.Poll, error) {
+	options, votes, multiple, err := ap.ExtractPollOptions(pollable)
+	if err != nil {
+		return nil, err
+	}
+
+	// a poll can be closed before its end time, and not
+	// every implementation says when it was closed, so
+	// treat a poll closed at an unknown time as ending now
+	expiresAt := ap.ExtractPollEndTime(pollable)
+	if closedAt, closed := ap.ExtractPollClosed(pollable); closed {
+		if closedAt.IsZero() {
+			closedAt = time.Now()
+		}
+		if expiresAt.IsZero() || closedAt.Before(expiresAt) {
+			expiresAt = closedAt
+		}
+	}
+
+	// not everything sends a voters count, but for single
+	// choice polls it's the same as the number of votes
+	voters := ap.ExtractVotersCount(pollable)
+	if voters == 0 && !multiple {
+		for _, v := range votes {
+			voters += v
+		}
+	}
+
+	hideCounts := false
+	return &gtsmodel.Poll{
+		Options:    options,
+		Votes:      votes,
+		Voters:     voters,
+		Multiple:   &multiple,
+		HideCounts: &hideCounts,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
 func (c *converter) ASFollowToFollowRequest(ctx context.Context, followable ap.Followable) (*gtsmodel.FollowRequest, error) {
 	idProp := followable.GetJSONLDId()
 	if idProp == nil || !idProp.IsIRI() {
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
@@ -225,6 +226,32 @@ func (suite *ASToInternalTestSuite) TestParseOwncastService() {
 	fmt.Printf("\n\n\n%s\n\n\n", string(b))
 }
 
+func (suite *ASToInternalTestSuite) TestParseQuestion() {
+	m := make(map[string]interface{})
+	err := json.Unmarshal([]byte(questionAsActivityJson), &m)
+	suite.NoError(err)
+
+	t, err := streams.ToType(context.Background(), m)
+	suite.NoError(err)
+
+	statusable, ok := t.(ap.Statusable)
+	suite.True(ok)
+
+	status, err := suite.typeconverter.ASStatusToStatus(context.Background(), statusable)
+	suite.NoError(err)
+
+	suite.Equal(ap.ActivityQuestion, status.ActivityStreamsType)
+	suite.NotEmpty(status.PollID)
+	if suite.NotNil(status.Poll) {
+		suite.Equal(status.PollID, status.Poll.ID)
+		suite.Equal([]string{"tea", "coffee"}, status.Poll.Options)
+		suite.Equal([]int{3, 2}, status.Poll.Votes)
+		suite.Equal(5, status.Poll.Voters)
+		suite.False(*status.Poll.Multiple)
+		suite.Equal(time.Date(2022, 12, 13, 10, 0, 0, 0, time.UTC), status.Poll.ExpiresAt.UTC())
+	}
+}
+
 func TestASToInternalTestSuite(t *testing.T) {
 	suite.Run(t, new(ASToInternalTestSuite))
 }
@@ -81,12 +81,32 @@ type TypeConverter interface {
 	VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) model.Visibility
 	// InstanceToAPIInstance converts a gts instance into its api equivalent for serving at /api/v1/instance
 	InstanceToAPIInstance(ctx context.Context, i *gtsmodel.Instance) (*model.Instance, error)
+	// InstanceToAPIV2Instance converts a gts instance into its api equivalent for serving at /api/v2/instance
+	InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Instance) (*model.InstanceV2, error)
 	// RelationshipToAPIRelationship converts a gts relationship into its api equivalent for serving in various places
 	RelationshipToAPIRelationship(ctx context.Context, r *gtsmodel.Relationship) (*model.Relationship, error)
 	// NotificationToAPINotification converts a gts notification into a api notification
 	NotificationToAPINotification(ctx context.Context, n *gtsmodel.Notification) (*model.Notification, error)
 	// DomainBlockToAPIDomainBlock converts a gts model domin block into a api domain block, for serving at /api/v1/admin/domain_blocks
 	DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel.DomainBlock, export bool) (*model.DomainBlock, error)
+	// DomainNoteToAPIDomainNote converts a gts model domain note into its api equivalent, for serving at /api/v1/admin/domain_notes
+	DomainNoteToAPIDomainNote(ctx context.Context, n *gtsmodel.DomainNote) (*model.DomainNote, error)
+	// DomainEmojiPolicyToAPIDomainEmojiPolicy converts a gts model domain emoji policy into its api equivalent, for serving at /api/v1/admin/domain_emoji_policies
+	DomainEmojiPolicyToAPIDomainEmojiPolicy(ctx context.Context, p *gtsmodel.DomainEmojiPolicy) (*model.DomainEmojiPolicy, error)
+	// LegalDocumentToAPILegalDocument converts a gts model legal document into its api equivalent, for serving at /api/v1/instance/terms_of_service etc.
+	LegalDocumentToAPILegalDocument(ctx context.Context, d *gtsmodel.LegalDocument) (*model.LegalDocument, error)
+	// ClientSettingToAPIClientSetting converts a gts client setting into its api equivalent, for serving at /api/v1/client_settings
+	ClientSettingToAPIClientSetting(ctx context.Context, s *gtsmodel.ClientSetting) (*model.ClientSetting, error)
+	// ListToAPIList converts a gts model list into its api equivalent, for serving at /api/v1/lists
+	ListToAPIList(ctx context.Context, l *gtsmodel.List) (*model.List, error)
+	// FilterToAPIFilterV2 converts a gts model filter, with its keywords and statuses, into its api equivalent, for serving at /api/v2/filters
+	FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*model.FilterV2, error)
+	// FilterKeywordToAPIFilterKeyword converts a gts model filter keyword into its api equivalent, for serving at /api/v2/filters/keywords
+	FilterKeywordToAPIFilterKeyword(ctx context.Context, k *gtsmodel.FilterKeyword) (*model.FilterKeyword, error)
+	// FilterStatusToAPIFilterStatus converts a gts model filter status into its api equivalent, for serving at /api/v2/filters/statuses
+	FilterStatusToAPIFilterStatus(ctx context.Context, s *gtsmodel.FilterStatus) (*model.FilterStatus, error)
+	// BookmarkFolderToAPIBookmarkFolder converts a gts model bookmark folder into its api equivalent, for serving at /api/v1/bookmarks/folders
+	BookmarkFolderToAPIBookmarkFolder(ctx context.Context, f *gtsmodel.BookmarkFolder) (*model.BookmarkFolder, error)
 
 	/*
 		INTERNAL (gts) MODEL TO FRONTEND (rss) MODEL
@@ -116,6 +136,9 @@ type TypeConverter interface {
 	ASRepresentationToAccount(ctx context.Context, accountable ap.Accountable, accountDomain string, update bool) (*gtsmodel.Account, error)
 	// ASStatus converts a remote activitystreams 'status' representation into a gts model status.
 	ASStatusToStatus(ctx context.Context, statusable ap.Statusable) (*gtsmodel.Status, error)
+	// ASPollToPoll converts the options, vote counts and end time of a remote activitystreams 'question' into a gts model poll.
+	// The returned poll has no ID, and isn't attached to any status yet.
+	ASPollToPoll(ctx context.Context, pollable ap.Pollable) (*gtsmodel.Poll, error)
 	// ASFollowToFollowRequest converts a remote activitystreams `follow` representation into gts model follow request.
 	ASFollowToFollowRequest(ctx context.Context, followable ap.Followable) (*gtsmodel.FollowRequest, error)
 	// ASFollowToFollowRequest converts a remote activitystreams `follow` representation into gts model follow.
@@ -150,8 +173,12 @@ type TypeConverter interface {
 	// suitable for serving to requesters to whom we want to give as little information as possible because
 	// we don't trust them (yet).
 	AccountToASMinimal(ctx context.Context, a *gtsmodel.Account) (vocab.ActivityStreamsPerson, error)
-	// StatusToAS converts a gts model status into an activity streams note, suitable for federation
-	StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error)
+	// StatusToAS converts a gts model status into an activity streams note, suitable for federation.
+	// If the status has a poll attached, it's converted into an activity streams question instead.
+	StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error)
+	// PollVoteToASCreate converts a vote in the given poll, which is attached to the given status, into an activity streams
+	// Create of a Note, suitable for federating to the author of the poll. The Note's name is the title of the chosen option.
+	PollVoteToASCreate(ctx context.Context, vote *gtsmodel.PollVote, poll *gtsmodel.Poll, status *gtsmodel.Status) (vocab.ActivityStreamsCreate, error)
 	// FollowToASFollow converts a gts model Follow into an activity streams Follow, suitable for federation
 	FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error)
 	// MentionToAS converts a gts model mention into an activity streams Mention, suitable for federation
@@ -173,6 +200,8 @@ type TypeConverter interface {
 	// OutboxToASCollection returns an ordered collection with appropriate id, next, and last fields.
 	// The returned collection won't have any actual entries; just links to where entries can be obtained.
 	OutboxToASCollection(ctx context.Context, outboxID string) (vocab.ActivityStreamsOrderedCollection, error)
+	// FeaturedTagsToASCollection returns a collection containing the hashtags featured by the given local account.
+	FeaturedTagsToASCollection(ctx context.Context, account *gtsmodel.Account, featuredTags []*gtsmodel.FeaturedTag) (vocab.ActivityStreamsCollection, error)
 	// StatusesToASOutboxPage returns an ordered collection page using the given statuses and parameters as contents.
 	//
 	// The maxID and minID should be the parameters that were passed to the database to obtain the given statuses.
@@ -180,8 +209,11 @@ type TypeConverter interface {
 	//
 	// OutboxID is used to create the 'partOf' field in the collection.
 	//
-	// Appropriate 'next' and 'prev' fields will be created based on the highest and lowest IDs present in the statuses slice.
-	StatusesToASOutboxPage(ctx context.Context, outboxID string, maxID string, minID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollectionPage, error)
+	// The lowestID and highestID should be the lowest and highest IDs of the statuses obtained from the database,
+	// before any of them were left out of the statuses slice, eg., because the requester isn't allowed to see them.
+	// These will be used to create the 'next' and 'prev' fields of the collection, so that a page whose statuses
+	// were all left out still links on to the rest of the outbox. If they're empty, these fields are left out.
+	StatusesToASOutboxPage(ctx context.Context, outboxID string, maxID string, minID string, statuses []*gtsmodel.Status, lowestID string, highestID string) (vocab.ActivityStreamsOrderedCollectionPage, error)
 
 	/*
 		INTERNAL (gts) MODEL TO INTERNAL MODEL
@@ -198,12 +230,15 @@ type TypeConverter interface {
 
 	// WrapPersonInUpdate
 	WrapPersonInUpdate(person vocab.ActivityStreamsPerson, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
-	// WrapNoteInCreate wraps a Note with a Create activity.
+	// WrapStatusableInCreate wraps a Note or Question with a Create activity.
 	//
-	// If objectIRIOnly is set to true, then the function won't put the *entire* note in the Object field of the Create,
-	// but just the AP URI of the note. This is useful in cases where you want to give a remote server something to dereference,
+	// If objectIRIOnly is set to true, then the function won't put the *entire* status in the Object field of the Create,
+	// but just the AP URI of the status. This is useful in cases where you want to give a remote server something to dereference,
 	// and still have control over whether or not they're allowed to actually see the contents.
-	WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	WrapStatusableInCreate(status ap.Statusable, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	// WrapStatusableInUpdate wraps a Note or Question with an Update activity from its author, addressed the same way as
+	// the status itself. This is used to tell other servers about the new vote counts of a poll, or that it has closed.
+	WrapStatusableInUpdate(status ap.Statusable, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
 }
 
 type converter struct {
@@ -465,6 +465,46 @@ const (
 		"url": "https://owncast.example.org/federation/user/rgh"
 	} 
 `
+	questionAsActivityJson = `{
+		"@context": [
+			"https://www.w3.org/ns/activitystreams",
+			{
+				"votersCount": "http://joinmastodon.org/ns#votersCount",
+				"toot": "http://joinmastodon.org/ns#"
+			}
+		],
+		"id": "http://fossbros-anonymous.io/users/foss_satan/statuses/109491474218478386",
+		"type": "Question",
+		"attributedTo": "http://fossbros-anonymous.io/users/foss_satan",
+		"content": "<p>tea or coffee?</p>",
+		"published": "2022-12-12T10:00:00Z",
+		"endTime": "2022-12-13T10:00:00Z",
+		"votersCount": 5,
+		"to": [
+			"https://www.w3.org/ns/activitystreams#Public"
+		],
+		"cc": [
+			"http://fossbros-anonymous.io/users/foss_satan/followers"
+		],
+		"oneOf": [
+			{
+				"type": "Note",
+				"name": "tea",
+				"replies": {
+					"type": "Collection",
+					"totalItems": 3
+				}
+			},
+			{
+				"type": "Note",
+				"name": "coffee",
+				"replies": {
+					"type": "Collection",
+					"totalItems": 2
+				}
+			}
+		]
+	}`
 )
 
 type TypeUtilsTestSuite struct {
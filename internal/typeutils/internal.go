@@ -12,6 +12,7 @@ import (
 
 func (c *converter) FollowRequestToFollow(ctx context.Context, f *gtsmodel.FollowRequest) *gtsmodel.Follow {
 	showReblogs := *f.ShowReblogs
+	showReplies := *f.ShowReplies
 	notify := *f.Notify
 
 	return &gtsmodel.Follow{
@@ -21,6 +22,7 @@ func (c *converter) FollowRequestToFollow(ctx context.Context, f *gtsmodel.Follo
 		AccountID:       f.AccountID,
 		TargetAccountID: f.TargetAccountID,
 		ShowReblogs:     &showReblogs,
+		ShowReplies:     &showReplies,
 		URI:             f.URI,
 		Notify:          &notify,
 	}
@@ -28,10 +28,12 @@ import (
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 // const (
@@ -121,7 +123,12 @@ func (c *converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	person.SetTootFeatured(featuredProp)
 
 	// featuredTags
-	// NOT IMPLEMENTED
+	// Hashtags featured on the profile. The activity library has no
+	// property for this, so set it as an unknown property instead; it
+	// only gets serialized, never compared.
+	if a.Domain == "" {
+		person.GetUnknownProperties()["featuredTags"] = featuredTagsURI(a)
+	}
 
 	// preferredUsername
 	// Used for Webfinger lookup. Must be unique on the domain, and must correspond to a Webfinger acct: URI.
@@ -387,7 +394,27 @@ func (c *converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account)
 	return person, nil
 }
 
-func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error) {
+// asStatus is implemented by the activitystreams types that statuses are
+// converted into: a Note, or a Question if the status has a poll attached.
+type asStatus interface {
+	ap.Statusable
+
+	SetJSONLDId(vocab.JSONLDIdProperty)
+	SetActivityStreamsSummary(vocab.ActivityStreamsSummaryProperty)
+	SetActivityStreamsInReplyTo(vocab.ActivityStreamsInReplyToProperty)
+	SetActivityStreamsPublished(vocab.ActivityStreamsPublishedProperty)
+	SetActivityStreamsUrl(vocab.ActivityStreamsUrlProperty)
+	SetActivityStreamsAttributedTo(vocab.ActivityStreamsAttributedToProperty)
+	SetActivityStreamsTag(vocab.ActivityStreamsTagProperty)
+	SetActivityStreamsTo(vocab.ActivityStreamsToProperty)
+	SetActivityStreamsCc(vocab.ActivityStreamsCcProperty)
+	SetActivityStreamsContent(vocab.ActivityStreamsContentProperty)
+	SetActivityStreamsAttachment(vocab.ActivityStreamsAttachmentProperty)
+	SetActivityStreamsReplies(vocab.ActivityStreamsRepliesProperty)
+	SetActivityStreamsSensitive(vocab.ActivityStreamsSensitiveProperty)
+}
+
+func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error) {
 	// ensure prerequisites here before we get stuck in
 
 	// check if author account is already attached to status and attach it if not
@@ -400,8 +427,23 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 		s.Account = a
 	}
 
-	// create the Note!
-	status := streams.NewActivityStreamsNote()
+	// create the Note, or a Question if there's a poll to go with it
+	var (
+		status   asStatus
+		question vocab.ActivityStreamsQuestion
+		poll     *gtsmodel.Poll
+	)
+	if s.PollID != "" {
+		var err error
+		poll, err = c.db.GetPollByID(ctx, s.PollID)
+		if err != nil {
+			return nil, fmt.Errorf("StatusToAS: error getting poll %s from database: %s", s.PollID, err)
+		}
+		question = streams.NewActivityStreamsQuestion()
+		status = question
+	} else {
+		status = streams.NewActivityStreamsNote()
+	}
 
 	// id
 	statusURI, err := url.Parse(s.URI)
@@ -621,9 +663,71 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive)
 	status.SetActivityStreamsSensitive(sensitiveProp)
 
+	// poll
+	if poll != nil {
+		pollToASQuestion(poll, question)
+	}
+
 	return status, nil
 }
 
+// pollToASQuestion sets the options, vote counts, and end time of the given poll on the given Question,
+// the way Mastodon does: each option is a Note with a name, whose replies collection holds its vote count.
+func pollToASQuestion(poll *gtsmodel.Poll, question vocab.ActivityStreamsQuestion) {
+	options := make([]vocab.ActivityStreamsNote, 0, len(poll.Options))
+	for i, title := range poll.Options {
+		option := streams.NewActivityStreamsNote()
+
+		nameProp := streams.NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(title)
+		option.SetActivityStreamsName(nameProp)
+
+		votes := 0
+		if i < len(poll.Votes) {
+			votes = poll.Votes[i]
+		}
+		totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+		totalItemsProp.Set(votes)
+		replies := streams.NewActivityStreamsCollection()
+		replies.SetActivityStreamsTotalItems(totalItemsProp)
+		repliesProp := streams.NewActivityStreamsRepliesProperty()
+		repliesProp.SetActivityStreamsCollection(replies)
+		option.SetActivityStreamsReplies(repliesProp)
+
+		options = append(options, option)
+	}
+
+	if *poll.Multiple {
+		anyOfProp := streams.NewActivityStreamsAnyOfProperty()
+		for _, option := range options {
+			anyOfProp.AppendActivityStreamsNote(option)
+		}
+		question.SetActivityStreamsAnyOf(anyOfProp)
+	} else {
+		oneOfProp := streams.NewActivityStreamsOneOfProperty()
+		for _, option := range options {
+			oneOfProp.AppendActivityStreamsNote(option)
+		}
+		question.SetActivityStreamsOneOf(oneOfProp)
+	}
+
+	if !poll.ExpiresAt.IsZero() {
+		endTimeProp := streams.NewActivityStreamsEndTimeProperty()
+		endTimeProp.Set(poll.ExpiresAt)
+		question.SetActivityStreamsEndTime(endTimeProp)
+	}
+
+	if !poll.ClosedAt.IsZero() {
+		closedProp := streams.NewActivityStreamsClosedProperty()
+		closedProp.AppendXMLSchemaDateTime(poll.ClosedAt)
+		question.SetActivityStreamsClosed(closedProp)
+	}
+
+	votersCountProp := streams.NewTootVotersCountProperty()
+	votersCountProp.Set(poll.Voters)
+	question.SetTootVotersCount(votersCountProp)
+}
+
 func (c *converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error) {
 	// parse out the various URIs we need for this
 	// origin account (who's doing the follow)
@@ -970,6 +1074,93 @@ we want to end up with something like this:
 		"type":"Block"
 	}
 */
+func (c *converter) PollVoteToASCreate(ctx context.Context, vote *gtsmodel.PollVote, poll *gtsmodel.Poll, status *gtsmodel.Status) (vocab.ActivityStreamsCreate, error) {
+	if vote.Choice < 0 || vote.Choice >= len(poll.Options) {
+		return nil, fmt.Errorf("PollVoteToASCreate: choice %d out of range for poll %s", vote.Choice, poll.ID)
+	}
+
+	// check if the voting account is already pinned to this vote, and fetch it if not
+	if vote.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, vote.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreate: error fetching voting account from database: %s", err)
+		}
+		vote.Account = a
+	}
+
+	voterIRI, err := url.Parse(vote.Account.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreate: error parsing uri %s: %s", vote.Account.URI, err)
+	}
+
+	voteIRI, err := url.Parse(vote.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreate: error parsing uri %s: %s", vote.URI, err)
+	}
+
+	statusIRI, err := url.Parse(status.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreate: error parsing uri %s: %s", status.URI, err)
+	}
+
+	authorIRI, err := url.Parse(status.AccountURI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreate: error parsing uri %s: %s", status.AccountURI, err)
+	}
+
+	// the vote itself is a note with the name of the chosen option,
+	// in reply to the question, and addressed only to its author
+	note := streams.NewActivityStreamsNote()
+
+	noteIDProp := streams.NewJSONLDIdProperty()
+	noteIDProp.Set(voteIRI)
+	note.SetJSONLDId(noteIDProp)
+
+	nameProp := streams.NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString(poll.Options[vote.Choice])
+	note.SetActivityStreamsName(nameProp)
+
+	inReplyToProp := streams.NewActivityStreamsInReplyToProperty()
+	inReplyToProp.AppendIRI(statusIRI)
+	note.SetActivityStreamsInReplyTo(inReplyToProp)
+
+	attributedToProp := streams.NewActivityStreamsAttributedToProperty()
+	attributedToProp.AppendIRI(voterIRI)
+	note.SetActivityStreamsAttributedTo(attributedToProp)
+
+	noteToProp := streams.NewActivityStreamsToProperty()
+	noteToProp.AppendIRI(authorIRI)
+	note.SetActivityStreamsTo(noteToProp)
+
+	// wrap the note in a create from the voter
+	create := streams.NewActivityStreamsCreate()
+
+	createIDProp := streams.NewJSONLDIdProperty()
+	createIDProp.Set(&url.URL{
+		Scheme: voteIRI.Scheme,
+		Host:   voteIRI.Host,
+		Path:   voteIRI.Path,
+		// keep the fragment, since that's
+		// what makes each vote's URI unique
+		Fragment: voteIRI.Fragment + "/activity",
+	})
+	create.SetJSONLDId(createIDProp)
+
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(voterIRI)
+	create.SetActivityStreamsActor(actorProp)
+
+	createToProp := streams.NewActivityStreamsToProperty()
+	createToProp.AppendIRI(authorIRI)
+	create.SetActivityStreamsTo(createToProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendActivityStreamsNote(note)
+	create.SetActivityStreamsObject(objectProp)
+
+	return create, nil
+}
+
 func (c *converter) BlockToAS(ctx context.Context, b *gtsmodel.Block) (vocab.ActivityStreamsBlock, error) {
 	if b.Account == nil {
 		a, err := c.db.GetAccountByID(ctx, b.AccountID)
@@ -1189,17 +1380,17 @@ the goal is to end up with something like this:
 		]
 	}
 */
-func (c *converter) StatusesToASOutboxPage(ctx context.Context, outboxID string, maxID string, minID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+func (c *converter) StatusesToASOutboxPage(ctx context.Context, outboxID string, maxID string, minID string, statuses []*gtsmodel.Status, lowestID string, highestID string) (vocab.ActivityStreamsOrderedCollectionPage, error) {
 	page := streams.NewActivityStreamsOrderedCollectionPage()
 
 	// .id
 	pageIDProp := streams.NewJSONLDIdProperty()
 	pageID := fmt.Sprintf("%s?page=true", outboxID)
 	if minID != "" {
-		pageID = fmt.Sprintf("%s&minID=%s", pageID, minID)
+		pageID = fmt.Sprintf("%s&min_id=%s", pageID, minID)
 	}
 	if maxID != "" {
-		pageID = fmt.Sprintf("%s&maxID=%s", pageID, maxID)
+		pageID = fmt.Sprintf("%s&max_id=%s", pageID, maxID)
 	}
 	pageIDURI, err := url.Parse(pageID)
 	if err != nil {
@@ -1219,34 +1410,25 @@ func (c *converter) StatusesToASOutboxPage(ctx context.Context, outboxID string,
 
 	// .orderedItems
 	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
-	var highest string
-	var lowest string
 	for _, s := range statuses {
-		note, err := c.StatusToAS(ctx, s)
+		asStatus, err := c.StatusToAS(ctx, s)
 		if err != nil {
 			return nil, err
 		}
 
-		create, err := c.WrapNoteInCreate(note, true)
+		create, err := c.WrapStatusableInCreate(asStatus, true)
 		if err != nil {
 			return nil, err
 		}
 
 		itemsProp.AppendActivityStreamsCreate(create)
-
-		if highest == "" || s.ID > highest {
-			highest = s.ID
-		}
-		if lowest == "" || s.ID < lowest {
-			lowest = s.ID
-		}
 	}
 	page.SetActivityStreamsOrderedItems(itemsProp)
 
 	// .next
-	if lowest != "" {
+	if lowestID != "" {
 		nextProp := streams.NewActivityStreamsNextProperty()
-		nextPropIDString := fmt.Sprintf("%s?page=true&max_id=%s", outboxID, lowest)
+		nextPropIDString := fmt.Sprintf("%s?page=true&max_id=%s", outboxID, lowestID)
 		nextPropIDURI, err := url.Parse(nextPropIDString)
 		if err != nil {
 			return nil, err
@@ -1256,9 +1438,9 @@ func (c *converter) StatusesToASOutboxPage(ctx context.Context, outboxID string,
 	}
 
 	// .prev
-	if highest != "" {
+	if highestID != "" {
 		prevProp := streams.NewActivityStreamsPrevProperty()
-		prevPropIDString := fmt.Sprintf("%s?page=true&min_id=%s", outboxID, highest)
+		prevPropIDString := fmt.Sprintf("%s?page=true&min_id=%s", outboxID, highestID)
 		prevPropIDURI, err := url.Parse(prevPropIDString)
 		if err != nil {
 			return nil, err
@@ -1302,3 +1484,62 @@ func (c *converter) OutboxToASCollection(ctx context.Context, outboxID string) (
 
 	return collection, nil
 }
+
+/*
+we want something that looks like this:
+
+	{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id": "https://example.org/users/whatever/collections/tags",
+		"type": "Collection",
+		"totalItems": 1,
+		"items": [
+			{
+				"type": "Hashtag",
+				"href": "https://example.org/@whatever/tagged/gotosocial",
+				"name": "#gotosocial"
+			}
+		]
+	}
+*/
+func (c *converter) FeaturedTagsToASCollection(ctx context.Context, account *gtsmodel.Account, featuredTags []*gtsmodel.FeaturedTag) (vocab.ActivityStreamsCollection, error) {
+	collection := streams.NewActivityStreamsCollection()
+
+	collectionID := featuredTagsURI(account)
+	collectionIDURI, err := url.Parse(collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("FeaturedTagsToASCollection: error parsing url %s: %s", collectionID, err)
+	}
+	collectionIDProp := streams.NewJSONLDIdProperty()
+	collectionIDProp.SetIRI(collectionIDURI)
+	collection.SetJSONLDId(collectionIDProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(len(featuredTags))
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	// the activity library has no Hashtag type,
+	// so build the items by hand and set them as
+	// an unknown property of the collection
+	items := make([]interface{}, 0, len(featuredTags))
+	for _, ft := range featuredTags {
+		if ft.Tag == nil {
+			return nil, fmt.Errorf("FeaturedTagsToASCollection: featured tag %s had no tag", ft.ID)
+		}
+		items = append(items, map[string]interface{}{
+			"type": "Hashtag",
+			"href": account.URL + "/tagged/" + ft.Tag.Name,
+			"name": "#" + ft.Tag.Name,
+		})
+	}
+	collection.GetUnknownProperties()["items"] = items
+
+	return collection, nil
+}
+
+// featuredTagsURI returns the activitypub URI of the
+// featured tags collection of the given local account,
+// eg., https://example.org/users/example_user/collections/tags
+func featuredTagsURI(a *gtsmodel.Account) string {
+	return a.URI + "/" + uris.CollectionsPath + "/" + uris.FeaturedTagsPath
+}
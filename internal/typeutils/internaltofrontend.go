@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -40,6 +41,7 @@ const (
 	instanceMediaAttachmentsVideoFrameRateLimit = 60
 	instancePollsMinExpiration                  = 300     // seconds
 	instancePollsMaxExpiration                  = 2629746 // seconds
+	instanceSourceURL                           = "https://github.com/superseriousbusiness/gotosocial"
 )
 
 func (c *converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmodel.Account) (*model.Account, error) {
@@ -68,14 +70,35 @@ func (c *converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		statusFormat = a.StatusFormat
 	}
 
+	mentionPolicy := gtsmodel.MentionPolicyDefault
+	if a.MentionPolicy != "" {
+		mentionPolicy = a.MentionPolicy
+	}
+
+	var locale string
+	if user, err := c.db.GetUserByAccountID(ctx, a.ID); err == nil {
+		locale = user.Locale
+	} else if err != db.ErrNoEntries {
+		return nil, fmt.Errorf("error getting user: %s", err)
+	}
+
 	apiAccount.Source = &model.Source{
-		Privacy:             c.VisToAPIVis(ctx, a.Privacy),
-		Sensitive:           *a.Sensitive,
-		Language:            a.Language,
-		StatusFormat:        statusFormat,
-		Note:                a.NoteRaw,
-		Fields:              apiAccount.Fields,
-		FollowRequestsCount: frc,
+		Privacy:                    c.VisToAPIVis(ctx, a.Privacy),
+		Sensitive:                  *a.Sensitive,
+		Language:                   a.Language,
+		Locale:                     locale,
+		StatusFormat:               statusFormat,
+		FollowersOnlyBoostable:     a.FollowersOnlyBoostable != nil && *a.FollowersOnlyBoostable,
+		MentionPolicy:              string(mentionPolicy),
+		WebHideBoosts:              a.WebHideBoosts == nil || *a.WebHideBoosts,
+		WebHideReplies:             a.WebHideReplies == nil || *a.WebHideReplies,
+		WebPinnedFirst:             a.WebPinnedFirst != nil && *a.WebPinnedFirst,
+		WebMediaTab:                a.WebMediaTab != nil && *a.WebMediaTab,
+		HideMirrors:                a.HideMirrors != nil && *a.HideMirrors,
+		NotificationsRetentionDays: a.NotificationsRetentionDays,
+		Note:                       a.NoteRaw,
+		Fields:                     apiAccount.Fields,
+		FollowRequestsCount:        frc,
 	}
 
 	return apiAccount, nil
@@ -222,6 +245,7 @@ func (c *converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		Acct:           acct,
 		DisplayName:    a.DisplayName,
 		Locked:         *a.Locked,
+		Discoverable:   *a.Discoverable,
 		Bot:            *a.Bot,
 		CreatedAt:      util.FormatISO8601(a.CreatedAt),
 		Note:           a.Note,
@@ -336,6 +360,16 @@ func (c *converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.M
 		apiAttachment.PreviewRemoteURL = &i
 	}
 
+	if a.Medium.URL != "" {
+		apiAttachment.MediumURL = a.Medium.URL
+		apiAttachment.Meta.Medium = &model.MediaDimensions{
+			Width:  a.FileMeta.Medium.Width,
+			Height: a.FileMeta.Medium.Height,
+			Size:   fmt.Sprintf("%dx%d", a.FileMeta.Medium.Width, a.FileMeta.Medium.Height),
+			Aspect: float32(a.FileMeta.Medium.Aspect),
+		}
+	}
+
 	if a.Description != "" {
 		i := a.Description
 		apiAttachment.Description = &i
@@ -410,6 +444,9 @@ func (c *converter) EmojiToAdminAPIEmoji(ctx context.Context, e *gtsmodel.Emoji)
 		TotalFileSize: e.ImageFileSize + e.ImageStaticFileSize,
 		ContentType:   e.ImageContentType,
 		URI:           e.URI,
+		Author:        e.Author,
+		License:       e.License,
+		SourceURL:     e.SourceURL,
 	}, nil
 }
 
@@ -651,10 +688,18 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
 		Card:               nil, // TODO: implement cards
-		Poll:               nil, // TODO: implement polls
+		Poll:               nil,
 		Text:               s.Text,
 	}
 
+	if s.PollID != "" {
+		apiPoll, err := c.pollToAPIPoll(ctx, s, requestingAccount, apiEmojis)
+		if err != nil {
+			return nil, fmt.Errorf("error converting status poll: %s", err)
+		}
+		apiStatus.Poll = apiPoll
+	}
+
 	// nullable fields
 	if s.InReplyToID != "" {
 		i := s.InReplyToID
@@ -673,6 +718,82 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 	return apiStatus, nil
 }
 
+// pollToAPIPoll converts the poll attached to the given status into its api equivalent,
+// including whether, and how, the requesting account (if any) voted in it.
+func (c *converter) pollToAPIPoll(ctx context.Context, s *gtsmodel.Status, requestingAccount *gtsmodel.Account, statusEmojis []model.Emoji) (*model.Poll, error) {
+	poll := s.Poll
+	if poll == nil {
+		var err error
+		poll, err = c.db.GetPollByID(ctx, s.PollID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	expired := poll.Expired(time.Now())
+
+	// counts can be hidden from everyone but the author until the poll ends
+	showCounts := !*poll.HideCounts || expired || (requestingAccount != nil && requestingAccount.ID == s.AccountID)
+
+	apiOptions := make([]model.PollOptions, 0, len(poll.Options))
+	votesCount := 0
+	for i, title := range poll.Options {
+		apiOption := model.PollOptions{Title: title}
+		if i < len(poll.Votes) {
+			votes := poll.Votes[i]
+			votesCount += votes
+			if showCounts {
+				apiOption.VotesCount = &votes
+			}
+		}
+		apiOptions = append(apiOptions, apiOption)
+	}
+
+	// only include the emojis that are actually used in the options
+	apiEmojis := []model.Emoji{}
+	for _, e := range statusEmojis {
+		for _, title := range poll.Options {
+			if strings.Contains(title, ":"+e.Shortcode+":") {
+				apiEmojis = append(apiEmojis, e)
+				break
+			}
+		}
+	}
+
+	voters := poll.Voters
+	apiPoll := &model.Poll{
+		ID:          poll.ID,
+		Expired:     expired,
+		Multiple:    *poll.Multiple,
+		VotesCount:  votesCount,
+		VotersCount: &voters,
+		Options:     apiOptions,
+		Emojis:      apiEmojis,
+	}
+
+	if !poll.ExpiresAt.IsZero() {
+		apiPoll.ExpiresAt = util.FormatISO8601(poll.ExpiresAt)
+	}
+
+	if requestingAccount != nil {
+		votes, err := c.db.GetPollVotesBy(ctx, poll.ID, requestingAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		ownVotes := make([]int, 0, len(votes))
+		for _, v := range votes {
+			ownVotes = append(ownVotes, v.Choice)
+		}
+
+		// authors can't vote in their own polls, so they count as having voted already
+		apiPoll.Voted = len(ownVotes) != 0 || requestingAccount.ID == s.AccountID
+		apiPoll.OwnVotes = ownVotes
+	}
+
+	return apiPoll, nil
+}
+
 // VisToapi converts a gts visibility into its api equivalent
 func (c *converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) model.Visibility {
 	switch m {
@@ -694,6 +815,7 @@ func (c *converter) InstanceToAPIInstance(ctx context.Context, i *gtsmodel.Insta
 		Title:            i.Title,
 		Description:      i.Description,
 		ShortDescription: i.ShortDescription,
+		Terms:            i.Terms,
 		Email:            i.ContactEmail,
 		Version:          i.Version,
 		Stats:            make(map[string]int),
@@ -702,28 +824,14 @@ func (c *converter) InstanceToAPIInstance(ctx context.Context, i *gtsmodel.Insta
 	// if the requested instance is *this* instance, we can add some extra information
 	if host := config.GetHost(); i.Domain == host {
 		mi.AccountDomain = config.GetAccountDomain()
-
-		if ia, err := c.db.GetInstanceAccount(ctx, ""); err == nil {
-			// assume default logo
-			mi.Thumbnail = config.GetProtocol() + "://" + host + "/assets/logo.png"
-
-			// take instance account avatar as instance thumbnail if we can
-			if ia.AvatarMediaAttachmentID != "" {
-				if ia.AvatarMediaAttachment == nil {
-					avi, err := c.db.GetAttachmentByID(ctx, ia.AvatarMediaAttachmentID)
-					if err == nil {
-						ia.AvatarMediaAttachment = avi
-					} else if !errors.Is(err, db.ErrNoEntries) {
-						log.Errorf("InstanceToAPIInstance: error getting instance avatar attachment with id %s: %s", ia.AvatarMediaAttachmentID, err)
-					}
-				}
-
-				if ia.AvatarMediaAttachment != nil {
-					mi.Thumbnail = ia.AvatarMediaAttachment.URL
-					mi.ThumbnailType = ia.AvatarMediaAttachment.File.ContentType
-					mi.ThumbnailDescription = ia.AvatarMediaAttachment.Description
-				}
-			}
+		mi.DescriptionText = i.DescriptionText
+		mi.ShortDescriptionText = i.ShortDescriptionText
+		mi.TermsText = i.TermsText
+
+		if thumbnail, ok := c.instanceThumbnail(ctx); ok {
+			mi.Thumbnail = thumbnail.URL
+			mi.ThumbnailType = thumbnail.Type
+			mi.ThumbnailDescription = thumbnail.Description
 		}
 
 		userCount, err := c.db.CountInstanceUsers(ctx, host)
@@ -749,37 +857,93 @@ func (c *converter) InstanceToAPIInstance(ctx context.Context, i *gtsmodel.Insta
 			StreamingAPI: "wss://" + host,
 		}
 		mi.Version = config.GetSoftwareVersion()
+		mi.Configuration = instanceConfiguration()
+	}
 
-		// todo: remove hardcoded values and put them in config somewhere
-		mi.Configuration = &model.InstanceConfiguration{
-			Statuses: &model.InstanceConfigurationStatuses{
-				MaxCharacters:            config.GetStatusesMaxChars(),
-				MaxMediaAttachments:      config.GetStatusesMediaMaxFiles(),
-				CharactersReservedPerURL: instanceStatusesCharactersReservedPerURL,
-			},
-			MediaAttachments: &model.InstanceConfigurationMediaAttachments{
-				SupportedMimeTypes:  media.AllSupportedMIMETypes(),
-				ImageSizeLimit:      int(config.GetMediaImageMaxSize()),       // bytes
-				ImageMatrixLimit:    instanceMediaAttachmentsImageMatrixLimit, // height*width
-				VideoSizeLimit:      int(config.GetMediaVideoMaxSize()),       // bytes
-				VideoFrameRateLimit: instanceMediaAttachmentsVideoFrameRateLimit,
-				VideoMatrixLimit:    instanceMediaAttachmentsVideoMatrixLimit, // height*width
-			},
-			Polls: &model.InstanceConfigurationPolls{
-				MaxOptions:             config.GetStatusesPollMaxOptions(),
-				MaxCharactersPerOption: config.GetStatusesPollOptionMaxChars(),
-				MinExpiration:          instancePollsMinExpiration, // seconds
-				MaxExpiration:          instancePollsMaxExpiration, // seconds
-			},
-			Accounts: &model.InstanceConfigurationAccounts{
-				AllowCustomCSS: config.GetAccountsAllowCustomCSS(),
-			},
-			Emojis: &model.InstanceConfigurationEmojis{
-				EmojiSizeLimit: int(config.GetMediaEmojiLocalMaxSize()), // bytes
-			},
+	mi.ContactAccount, mi.ContactDeputyAccounts = c.instanceContactAccounts(ctx, i)
+	mi.ContactDeputyEmails = i.DeputyContactEmails
+
+	return mi, nil
+}
+
+func (c *converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Instance) (*model.InstanceV2, error) {
+	host := config.GetHost()
+
+	instance := &model.InstanceV2{
+		Domain:        host,
+		AccountDomain: config.GetAccountDomain(),
+		Title:         i.Title,
+		Version:       config.GetSoftwareVersion(),
+		SourceURL:     instanceSourceURL,
+		Description:   i.ShortDescription,
+		Languages:     []string{}, // todo: not supported yet
+		Configuration: instanceConfiguration(),
+		Registrations: model.InstanceV2Registrations{
+			Enabled:          config.GetAccountsRegistrationOpen(),
+			ApprovalRequired: config.GetAccountsApprovalRequired(),
+		},
+		Contact: model.InstanceV2Contact{
+			Email:        i.ContactEmail,
+			DeputyEmails: i.DeputyContactEmails,
+		},
+	}
+
+	if thumbnail, ok := c.instanceThumbnail(ctx); ok {
+		instance.Thumbnail = thumbnail
+	}
+
+	instance.Contact.Account, instance.Contact.DeputyAccounts = c.instanceContactAccounts(ctx, i)
+
+	// clients expect arrays rather than nulls here
+	if instance.Contact.DeputyEmails == nil {
+		instance.Contact.DeputyEmails = []string{}
+	}
+	if instance.Contact.DeputyAccounts == nil {
+		instance.Contact.DeputyAccounts = []*model.Account{}
+	}
+
+	return instance, nil
+}
+
+// instanceThumbnail returns the thumbnail of this instance, which is the instance account's
+// avatar if it has one, or the default logo otherwise. If the instance account can't
+// be fetched, ok will be false.
+func (c *converter) instanceThumbnail(ctx context.Context) (thumbnail model.InstanceV2Thumbnail, ok bool) {
+	ia, err := c.db.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return thumbnail, false
+	}
+
+	// assume default logo
+	thumbnail.URL = config.GetProtocol() + "://" + config.GetHost() + "/assets/logo.png"
+
+	// take instance account avatar as instance thumbnail if we can
+	if ia.AvatarMediaAttachmentID != "" {
+		if ia.AvatarMediaAttachment == nil {
+			avi, err := c.db.GetAttachmentByID(ctx, ia.AvatarMediaAttachmentID)
+			if err == nil {
+				ia.AvatarMediaAttachment = avi
+			} else if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf("instanceThumbnail: error getting instance avatar attachment with id %s: %s", ia.AvatarMediaAttachmentID, err)
+			}
+		}
+
+		if ia.AvatarMediaAttachment != nil {
+			thumbnail.URL = ia.AvatarMediaAttachment.URL
+			thumbnail.Type = ia.AvatarMediaAttachment.File.ContentType
+			thumbnail.Description = ia.AvatarMediaAttachment.Description
 		}
 	}
 
+	return thumbnail, true
+}
+
+// instanceContactAccounts returns the api representations of the main contact
+// account of the given instance, and of its deputy contact accounts, if any.
+// Accounts which can't be fetched are left out.
+func (c *converter) instanceContactAccounts(ctx context.Context, i *gtsmodel.Instance) (*model.Account, []*model.Account) {
+	var apiContactAccount *model.Account
+
 	// contact account is optional but let's try to get it
 	if i.ContactAccountID != "" {
 		if i.ContactAccount == nil {
@@ -790,11 +954,74 @@ func (c *converter) InstanceToAPIInstance(ctx context.Context, i *gtsmodel.Insta
 		}
 		ma, err := c.AccountToAPIAccountPublic(ctx, i.ContactAccount)
 		if err == nil {
-			mi.ContactAccount = ma
+			apiContactAccount = ma
 		}
 	}
 
-	return mi, nil
+	if len(i.DeputyContactAccountIDs) == 0 {
+		return apiContactAccount, nil
+	}
+
+	if len(i.DeputyContactAccounts) != len(i.DeputyContactAccountIDs) {
+		i.DeputyContactAccounts = make([]*gtsmodel.Account, 0, len(i.DeputyContactAccountIDs))
+		for _, id := range i.DeputyContactAccountIDs {
+			deputy, err := c.db.GetAccountByID(ctx, id)
+			if err != nil {
+				log.Errorf("instanceContactAccounts: error getting deputy contact account with id %s: %s", id, err)
+				continue
+			}
+			i.DeputyContactAccounts = append(i.DeputyContactAccounts, deputy)
+		}
+	}
+
+	deputies := make([]*model.Account, 0, len(i.DeputyContactAccounts))
+	for _, deputy := range i.DeputyContactAccounts {
+		ma, err := c.AccountToAPIAccountPublic(ctx, deputy)
+		if err != nil {
+			log.Errorf("instanceContactAccounts: error converting deputy contact account with id %s: %s", deputy.ID, err)
+			continue
+		}
+		deputies = append(deputies, ma)
+	}
+
+	return apiContactAccount, deputies
+}
+
+// instanceConfiguration returns the configuration of this instance, for clients.
+func instanceConfiguration() *model.InstanceConfiguration {
+	// todo: remove hardcoded values and put them in config somewhere
+	return &model.InstanceConfiguration{
+		Statuses: &model.InstanceConfigurationStatuses{
+			MaxCharacters:            config.GetStatusesMaxChars(),
+			MaxMediaAttachments:      config.GetStatusesMediaMaxFiles(),
+			CharactersReservedPerURL: instanceStatusesCharactersReservedPerURL,
+		},
+		MediaAttachments: &model.InstanceConfigurationMediaAttachments{
+			SupportedMimeTypes:  media.AllSupportedMIMETypes(),
+			ImageSizeLimit:      int(config.GetMediaImageMaxSize()),       // bytes
+			ImageMatrixLimit:    instanceMediaAttachmentsImageMatrixLimit, // height*width
+			VideoSizeLimit:      int(config.GetMediaVideoMaxSize()),       // bytes
+			VideoFrameRateLimit: instanceMediaAttachmentsVideoFrameRateLimit,
+			VideoMatrixLimit:    instanceMediaAttachmentsVideoMatrixLimit, // height*width
+		},
+		Polls: &model.InstanceConfigurationPolls{
+			MaxOptions:             config.GetStatusesPollMaxOptions(),
+			MaxCharactersPerOption: config.GetStatusesPollOptionMaxChars(),
+			MinExpiration:          instancePollsMinExpiration, // seconds
+			MaxExpiration:          instancePollsMaxExpiration, // seconds
+		},
+		Accounts: &model.InstanceConfigurationAccounts{
+			AllowCustomCSS:      config.GetAccountsAllowCustomCSS(),
+			MaxDisplayNameChars: config.GetAccountsDisplayNameMaxChars(),
+			MaxNoteChars:        config.GetAccountsNoteMaxChars(),
+			MaxProfileFields:    config.GetAccountsMaxProfileFields(),
+			SignupLinkDomains:   config.GetAccountsSignupLinkDomains(),
+			SignupEmailDomains:  config.GetAccountsSignupEmailDomains(),
+		},
+		Emojis: &model.InstanceConfigurationEmojis{
+			EmojiSizeLimit: int(config.GetMediaEmojiLocalMaxSize()), // bytes
+		},
+	}
 }
 
 func (c *converter) RelationshipToAPIRelationship(ctx context.Context, r *gtsmodel.Relationship) (*model.Relationship, error) {
@@ -802,6 +1029,7 @@ func (c *converter) RelationshipToAPIRelationship(ctx context.Context, r *gtsmod
 		ID:                  r.ID,
 		Following:           r.Following,
 		ShowingReblogs:      r.ShowingReblogs,
+		ShowingReplies:      r.ShowingReplies,
 		Notifying:           r.Notifying,
 		FollowedBy:          r.FollowedBy,
 		Blocking:            r.Blocking,
@@ -892,7 +1120,128 @@ func (c *converter) DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel
 		domainBlock.SubscriptionID = b.SubscriptionID
 		domainBlock.CreatedBy = b.CreatedByAccountID
 		domainBlock.CreatedAt = util.FormatISO8601(b.CreatedAt)
+		if !b.ExpiresAt.IsZero() {
+			domainBlock.ExpiresAt = util.FormatISO8601(b.ExpiresAt)
+		}
 	}
 
 	return domainBlock, nil
 }
+
+func (c *converter) DomainNoteToAPIDomainNote(ctx context.Context, n *gtsmodel.DomainNote) (*model.DomainNote, error) {
+	return &model.DomainNote{
+		Domain:    n.Domain,
+		Text:      n.Text,
+		UpdatedBy: n.AccountID,
+		UpdatedAt: util.FormatISO8601(n.UpdatedAt),
+	}, nil
+}
+
+func (c *converter) DomainEmojiPolicyToAPIDomainEmojiPolicy(ctx context.Context, p *gtsmodel.DomainEmojiPolicy) (*model.DomainEmojiPolicy, error) {
+	return &model.DomainEmojiPolicy{
+		Domain:    p.Domain,
+		Policy:    string(p.Policy),
+		UpdatedBy: p.AccountID,
+		UpdatedAt: util.FormatISO8601(p.UpdatedAt),
+	}, nil
+}
+
+func (c *converter) LegalDocumentToAPILegalDocument(ctx context.Context, d *gtsmodel.LegalDocument) (*model.LegalDocument, error) {
+	return &model.LegalDocument{
+		ID:                d.ID,
+		Kind:              string(d.Kind),
+		Version:           d.Version,
+		Content:           d.Content,
+		Text:              d.Text,
+		Changelog:         d.Changelog,
+		RequireAcceptance: d.RequireAcceptance != nil && *d.RequireAcceptance,
+		PublishedAt:       util.FormatISO8601(d.CreatedAt),
+	}, nil
+}
+
+func (c *converter) ClientSettingToAPIClientSetting(ctx context.Context, s *gtsmodel.ClientSetting) (*model.ClientSetting, error) {
+	return &model.ClientSetting{
+		Key:       s.Key,
+		Value:     s.Value,
+		UpdatedAt: util.FormatISO8601(s.UpdatedAt),
+	}, nil
+}
+
+func (c *converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*model.List, error) {
+	return &model.List{
+		ID:            l.ID,
+		Title:         l.Title,
+		RepliesPolicy: string(l.RepliesPolicy),
+	}, nil
+}
+
+func (c *converter) FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*model.FilterV2, error) {
+	contexts := []string{}
+	for _, filterContext := range []gtsmodel.FilterContext{
+		gtsmodel.FilterContextHome,
+		gtsmodel.FilterContextNotifications,
+		gtsmodel.FilterContextPublic,
+		gtsmodel.FilterContextThread,
+		gtsmodel.FilterContextAccount,
+	} {
+		if f.AppliesIn(filterContext) {
+			contexts = append(contexts, string(filterContext))
+		}
+	}
+
+	var expiresAt *string
+	if !f.ExpiresAt.IsZero() {
+		e := util.FormatISO8601(f.ExpiresAt)
+		expiresAt = &e
+	}
+
+	keywords := make([]model.FilterKeyword, 0, len(f.Keywords))
+	for _, k := range f.Keywords {
+		apiKeyword, err := c.FilterKeywordToAPIFilterKeyword(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+		keywords = append(keywords, *apiKeyword)
+	}
+
+	statuses := make([]model.FilterStatus, 0, len(f.Statuses))
+	for _, s := range f.Statuses {
+		apiStatus, err := c.FilterStatusToAPIFilterStatus(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, *apiStatus)
+	}
+
+	return &model.FilterV2{
+		ID:           f.ID,
+		Title:        f.Title,
+		Context:      contexts,
+		ExpiresAt:    expiresAt,
+		FilterAction: string(f.Action),
+		Keywords:     keywords,
+		Statuses:     statuses,
+	}, nil
+}
+
+func (c *converter) FilterKeywordToAPIFilterKeyword(ctx context.Context, k *gtsmodel.FilterKeyword) (*model.FilterKeyword, error) {
+	return &model.FilterKeyword{
+		ID:        k.ID,
+		Keyword:   k.Keyword,
+		WholeWord: k.WholeWord != nil && *k.WholeWord,
+	}, nil
+}
+
+func (c *converter) FilterStatusToAPIFilterStatus(ctx context.Context, s *gtsmodel.FilterStatus) (*model.FilterStatus, error) {
+	return &model.FilterStatus{
+		ID:       s.ID,
+		StatusID: s.StatusID,
+	}, nil
+}
+
+func (c *converter) BookmarkFolderToAPIBookmarkFolder(ctx context.Context, f *gtsmodel.BookmarkFolder) (*model.BookmarkFolder, error) {
+	return &model.BookmarkFolder{
+		ID:    f.ID,
+		Title: f.Title,
+	}, nil
+}
@@ -21,6 +21,7 @@ package typeutils
 import (
 	"context"
 	"fmt"
+	"html"
 	"strconv"
 	"strings"
 
@@ -44,9 +45,9 @@ func (c *converter) StatusToRSSItem(ctx context.Context, s *gtsmodel.Status) (*f
 	// example: Venice Film Festival Tries to Quit Sinking
 	var title string
 	if s.ContentWarning != "" {
-		title = trimTo(s.ContentWarning, rssMaxTitleChars)
+		title = trimTo(rssPlaintext(s.ContentWarning), rssMaxTitleChars)
 	} else {
-		title = trimTo(s.Text, rssMaxTitleChars)
+		title = trimTo(rssPlaintext(s.Text), rssMaxTitleChars)
 	}
 
 	// Link -- The URL of the item.
@@ -79,6 +80,20 @@ func (c *converter) StatusToRSSItem(ctx context.Context, s *gtsmodel.Status) (*f
 	descriptionBuilder := strings.Builder{}
 	descriptionBuilder.WriteString(authorName + " ")
 
+	// make sure we've got all the attachments, not just the first
+	if len(s.Attachments) < len(s.AttachmentIDs) {
+		attachments := make([]*gtsmodel.MediaAttachment, 0, len(s.AttachmentIDs))
+		for _, id := range s.AttachmentIDs {
+			a, err := c.db.GetAttachmentByID(ctx, id)
+			if err != nil {
+				log.Errorf("error getting attachment with id %s: %s", id, err)
+				continue
+			}
+			attachments = append(attachments, a)
+		}
+		s.Attachments = attachments
+	}
+
 	attachmentCount := len(s.Attachments)
 	if len(s.AttachmentIDs) > attachmentCount {
 		attachmentCount = len(s.AttachmentIDs)
@@ -94,7 +109,7 @@ func (c *converter) StatusToRSSItem(ctx context.Context, s *gtsmodel.Status) (*f
 
 	if s.Text != "" {
 		descriptionBuilder.WriteString(": \"")
-		descriptionBuilder.WriteString(s.Text)
+		descriptionBuilder.WriteString(rssPlaintext(s.Text))
 		descriptionBuilder.WriteString("\"")
 	}
 
@@ -105,21 +120,23 @@ func (c *converter) StatusToRSSItem(ctx context.Context, s *gtsmodel.Status) (*f
 	id := s.URL
 
 	// Enclosure -- Describes a media object that is attached to the item.
-	enclosure := &feeds.Enclosure{}
-	// get first attachment if present
-	var attachment *gtsmodel.MediaAttachment
-	if len(s.Attachments) > 0 {
-		attachment = s.Attachments[0]
-	} else if len(s.AttachmentIDs) > 0 {
-		a, err := c.db.GetAttachmentByID(ctx, s.AttachmentIDs[0])
-		if err == nil {
-			attachment = a
+	// RSS only allows one enclosure per item, so use the first attachment
+	// that can be served; any others are linked from the content instead.
+	var enclosure *feeds.Enclosure
+	otherAttachments := make([]*gtsmodel.MediaAttachment, 0, len(s.Attachments))
+	for _, attachment := range s.Attachments {
+		if !rssServeable(attachment) {
+			continue
 		}
-	}
-	if attachment != nil {
-		enclosure.Type = attachment.File.ContentType
-		enclosure.Length = strconv.Itoa(attachment.File.FileSize)
-		enclosure.Url = attachment.URL
+		if enclosure == nil {
+			enclosure = &feeds.Enclosure{
+				Url:    attachment.URL,
+				Length: strconv.Itoa(attachment.File.FileSize),
+				Type:   attachment.File.ContentType,
+			}
+			continue
+		}
+		otherAttachments = append(otherAttachments, attachment)
 	}
 
 	// Content
@@ -153,6 +170,13 @@ func (c *converter) StatusToRSSItem(ctx context.Context, s *gtsmodel.Status) (*f
 		}
 	}
 	content := text.Emojify(apiEmojis, s.Content)
+	for _, attachment := range otherAttachments {
+		linkText := attachment.Description
+		if linkText == "" {
+			linkText = attachment.URL
+		}
+		content += fmt.Sprintf(`<p><a href="%s">%s</a></p>`, html.EscapeString(attachment.URL), html.EscapeString(linkText))
+	}
 
 	return &feeds.Item{
 		Title:       title,
@@ -168,10 +192,29 @@ func (c *converter) StatusToRSSItem(ctx context.Context, s *gtsmodel.Status) (*f
 	}, nil
 }
 
+// rssServeable returns true if the given attachment
+// can be linked to as an enclosure in an RSS item.
+func rssServeable(attachment *gtsmodel.MediaAttachment) bool {
+	return attachment.Processing == gtsmodel.ProcessingStatusProcessed &&
+		attachment.URL != "" &&
+		attachment.File.ContentType != "" &&
+		attachment.File.FileSize > 0
+}
+
+// rssPlaintext strips any html from the given string and
+// collapses whitespace, so it can be used as an item title
+// or synopsis, which feed readers display as plain text.
+func rssPlaintext(in string) string {
+	return strings.Join(strings.Fields(text.SanitizePlaintext(in)), " ")
+}
+
+// trimTo trims the given string to at most the given number
+// of characters, taking care not to split multi-byte runes.
 func trimTo(in string, to int) string {
-	if len(in) <= to {
+	runes := []rune(in)
+	if len(runes) <= to {
 		return in
 	}
 
-	return in[:to-3] + "..."
+	return string(runes[:to-3]) + "..."
 }
@@ -20,9 +20,11 @@ package typeutils_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type InternalToRSSTestSuite struct {
@@ -49,9 +51,7 @@ func (suite *InternalToRSSTestSuite) TestStatusToRSSItem1() {
 	suite.Equal("http://localhost:8080/@the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY", item.Id)
 	suite.EqualValues(1634726437, item.Updated.Unix())
 	suite.EqualValues(1634726437, item.Created.Unix())
-	suite.Equal("", item.Enclosure.Length)
-	suite.Equal("", item.Enclosure.Type)
-	suite.Equal("", item.Enclosure.Url)
+	suite.Nil(item.Enclosure)
 	suite.Equal("hello everyone!", item.Content)
 }
 
@@ -81,6 +81,36 @@ func (suite *InternalToRSSTestSuite) TestStatusToRSSItem2() {
 	suite.Equal("hello world! #welcome ! first post on the instance <img src=\"http://localhost:8080/fileserver/01F8MH17FWEB39HZJ76B6VXSKF/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png\" title=\":rainbow:\" alt=\":rainbow:\" class=\"emoji\"/> !", item.Content)
 }
 
+func (suite *InternalToRSSTestSuite) TestStatusToRSSItemContentWarningAndAttachments() {
+	s := &gtsmodel.Status{}
+	*s = *suite.testStatuses["admin_account_status_1"]
+	s.ContentWarning = "<p>spoilers   for</p>\n\n<b>everything</b>"
+	s.AttachmentIDs = []string{"01F8MH6NEM8D7527KZAECTCR76", "01F8MH7TDVANYKWVE8VVKFPJTJ"}
+	s.Attachments = nil
+
+	item, err := suite.typeconverter.StatusToRSSItem(context.Background(), s)
+	suite.NoError(err)
+
+	suite.Equal("spoilers for everything", item.Title)
+	suite.Equal("@admin@localhost:8080 posted [2] attachments: \"hello world! #welcome ! first post on the instance :rainbow: !\"", item.Description)
+
+	// first attachment is the enclosure, the second is linked from the content
+	suite.Equal("62529", item.Enclosure.Length)
+	suite.Equal("image/jpeg", item.Enclosure.Type)
+	suite.Equal("http://localhost:8080/fileserver/01F8MH17FWEB39HZJ76B6VXSKF/attachment/original/01F8MH6NEM8D7527KZAECTCR76.jpeg", item.Enclosure.Url)
+	suite.True(strings.HasSuffix(item.Content, `<p><a href="http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01F8MH7TDVANYKWVE8VVKFPJTJ.gif">90&#39;s Trent Reznor turning to the camera</a></p>`))
+}
+
+func (suite *InternalToRSSTestSuite) TestStatusToRSSItemLongTitle() {
+	s := &gtsmodel.Status{}
+	*s = *suite.testStatuses["local_account_1_status_1"]
+	s.ContentWarning = strings.Repeat("🐈", 200)
+
+	item, err := suite.typeconverter.StatusToRSSItem(context.Background(), s)
+	suite.NoError(err)
+	suite.Equal(strings.Repeat("🐈", 125)+"...", item.Title)
+}
+
 func TestInternalToRSSTestSuite(t *testing.T) {
 	suite.Run(t, new(InternalToRSSTestSuite))
 }
@@ -23,7 +23,7 @@ func (c *converter) interactionsWithStatusForAccount(ctx context.Context, s *gts
 		}
 		si.Reblogged = reblogged
 
-		muted, err := c.db.IsStatusMutedBy(ctx, s, requestingAccount.ID)
+		muted, err := c.db.IsThreadMutedBy(ctx, s, requestingAccount.ID)
 		if err != nil {
 			return nil, fmt.Errorf("error checking if requesting account has muted status: %s", err)
 		}
@@ -67,21 +67,23 @@ func (c *converter) WrapPersonInUpdate(person vocab.ActivityStreamsPerson, origi
 	return update, nil
 }
 
-func (c *converter) WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error) {
+func (c *converter) WrapStatusableInCreate(status ap.Statusable, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error) {
 	create := streams.NewActivityStreamsCreate()
 
 	// Object property
 	objectProp := streams.NewActivityStreamsObjectProperty()
 	if objectIRIOnly {
-		objectProp.AppendIRI(note.GetJSONLDId().GetIRI())
+		objectProp.AppendIRI(status.GetJSONLDId().GetIRI())
 	} else {
-		objectProp.AppendActivityStreamsNote(note)
+		if err := objectProp.AppendType(status); err != nil {
+			return nil, fmt.Errorf("WrapStatusableInCreate: couldn't set object: %s", err)
+		}
 	}
 	create.SetActivityStreamsObject(objectProp)
 
 	// ID property
 	idProp := streams.NewJSONLDIdProperty()
-	createID := fmt.Sprintf("%s/activity", note.GetJSONLDId().GetIRI().String())
+	createID := fmt.Sprintf("%s/activity", status.GetJSONLDId().GetIRI().String())
 	createIDIRI, err := url.Parse(createID)
 	if err != nil {
 		return nil, err
@@ -91,25 +93,25 @@ func (c *converter) WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOn
 
 	// Actor Property
 	actorProp := streams.NewActivityStreamsActorProperty()
-	actorIRI, err := ap.ExtractAttributedTo(note)
+	actorIRI, err := ap.ExtractAttributedTo(status)
 	if err != nil {
-		return nil, fmt.Errorf("WrapNoteInCreate: couldn't extract AttributedTo: %s", err)
+		return nil, fmt.Errorf("WrapStatusableInCreate: couldn't extract AttributedTo: %s", err)
 	}
 	actorProp.AppendIRI(actorIRI)
 	create.SetActivityStreamsActor(actorProp)
 
 	// Published Property
 	publishedProp := streams.NewActivityStreamsPublishedProperty()
-	published, err := ap.ExtractPublished(note)
+	published, err := ap.ExtractPublished(status)
 	if err != nil {
-		return nil, fmt.Errorf("WrapNoteInCreate: couldn't extract Published: %s", err)
+		return nil, fmt.Errorf("WrapStatusableInCreate: couldn't extract Published: %s", err)
 	}
 	publishedProp.Set(published)
 	create.SetActivityStreamsPublished(publishedProp)
 
 	// To Property
 	toProp := streams.NewActivityStreamsToProperty()
-	tos, err := ap.ExtractTos(note)
+	tos, err := ap.ExtractTos(status)
 	if err == nil {
 		for _, to := range tos {
 			toProp.AppendIRI(to)
@@ -119,7 +121,7 @@ func (c *converter) WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOn
 
 	// Cc Property
 	ccProp := streams.NewActivityStreamsCcProperty()
-	ccs, err := ap.ExtractCCs(note)
+	ccs, err := ap.ExtractCCs(status)
 	if err == nil {
 		for _, cc := range ccs {
 			ccProp.AppendIRI(cc)
@@ -129,3 +131,44 @@ func (c *converter) WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOn
 
 	return create, nil
 }
+
+func (c *converter) WrapStatusableInUpdate(status ap.Statusable, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error) {
+	update := streams.NewActivityStreamsUpdate()
+
+	// set the actor
+	actorURI, err := url.Parse(originAccount.URI)
+	if err != nil {
+		return nil, fmt.Errorf("WrapStatusableInUpdate: error parsing url %s: %s", originAccount.URI, err)
+	}
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(actorURI)
+	update.SetActivityStreamsActor(actorProp)
+
+	// set the ID
+	newID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, err
+	}
+
+	idString := uris.GenerateURIForUpdate(originAccount.Username, newID)
+	idURI, err := url.Parse(idString)
+	if err != nil {
+		return nil, fmt.Errorf("WrapStatusableInUpdate: error parsing url %s: %s", idString, err)
+	}
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(idURI)
+	update.SetJSONLDId(idProp)
+
+	// set the status as the object here
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	if err := objectProp.AppendType(status); err != nil {
+		return nil, fmt.Errorf("WrapStatusableInUpdate: couldn't set object: %s", err)
+	}
+	update.SetActivityStreamsObject(objectProp)
+
+	// address the update the same way as the status
+	update.SetActivityStreamsTo(status.GetActivityStreamsTo())
+	update.SetActivityStreamsCc(status.GetActivityStreamsCc())
+
+	return update, nil
+}
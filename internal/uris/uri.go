@@ -37,6 +37,7 @@ const (
 	LikedPath        = "liked"         // LikedPath represents the activitypub liked location
 	CollectionsPath  = "collections"   // CollectionsPath represents the activitypub collections location
 	FeaturedPath     = "featured"      // FeaturedPath represents the activitypub featured location
+	FeaturedTagsPath = "tags"          // FeaturedTagsPath represents the activitypub featured tags location
 	PublicKeyPath    = "main-key"      // PublicKeyPath is for serving an account's public key
 	FollowPath       = "follow"        // FollowPath used to generate the URI for an individual follow or follow request
 	UpdatePath       = "updates"       // UpdatePath is used to generate the URI for an account update
@@ -0,0 +1,160 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package useragent matches the User-Agent of incoming requests against blocked
+// patterns and known AI scrapers, and counts the requests that were rejected.
+package useragent
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+)
+
+const (
+	// KindPattern is the kind of rule for a pattern configured with advanced-blocked-user-agents.
+	KindPattern = "pattern"
+	// KindAIScraper is the kind of rule for a known AI scraper.
+	KindAIScraper = "ai_scraper"
+)
+
+// AIScrapers are the user agent tokens of known AI scrapers, as used in robots.txt.
+// Requests are rejected if their User-Agent contains one of these, ignoring case.
+var AIScrapers = []string{
+	"Amazonbot",
+	"anthropic-ai",
+	"Applebot-Extended",
+	"Bytespider",
+	"CCBot",
+	"ChatGPT-User",
+	"Claude-Web",
+	"ClaudeBot",
+	"cohere-ai",
+	"Diffbot",
+	"FacebookBot",
+	"Google-Extended",
+	"GPTBot",
+	"ImagesiftBot",
+	"Meta-ExternalAgent",
+	"OAI-SearchBot",
+	"omgili",
+	"PerplexityBot",
+	"Timpibot",
+	"YouBot",
+}
+
+// Rule is one reason for rejecting a request by its User-Agent.
+type Rule struct {
+	// Kind of rule, one of KindPattern or KindAIScraper.
+	Kind string
+	// Pattern as configured, or the AI scraper's user agent token.
+	Pattern string
+}
+
+type rule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// Blocklist matches User-Agent strings against a set of rules.
+type Blocklist struct {
+	rules []rule
+}
+
+// NewBlocklist returns a blocklist for the given regular expression patterns,
+// which are matched case-insensitively, and for known AI scrapers if blockAIScrapers is true.
+func NewBlocklist(patterns []string, blockAIScrapers bool) (*Blocklist, error) {
+	b := &Blocklist{}
+
+	for _, pattern := range patterns {
+		// compile the pattern as given first, so that
+		// errors refer to the pattern as it was written
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, err
+		}
+		re := regexp.MustCompile("(?i)" + pattern)
+		b.rules = append(b.rules, rule{Rule: Rule{Kind: KindPattern, Pattern: pattern}, re: re})
+	}
+
+	if blockAIScrapers {
+		for _, token := range AIScrapers {
+			re := regexp.MustCompile("(?i)" + regexp.QuoteMeta(token))
+			b.rules = append(b.rules, rule{Rule: Rule{Kind: KindAIScraper, Pattern: token}, re: re})
+		}
+	}
+
+	return b, nil
+}
+
+// Empty returns true if the blocklist has no rules, so no User-Agent will ever match.
+func (b *Blocklist) Empty() bool {
+	return len(b.rules) == 0
+}
+
+// Match returns the first rule that the given User-Agent matches, if any.
+func (b *Blocklist) Match(userAgent string) (Rule, bool) {
+	for _, r := range b.rules {
+		if r.re.MatchString(userAgent) {
+			return r.Rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+// Rejection is the number of requests rejected by one rule.
+type Rejection struct {
+	Rule
+	Count int
+}
+
+// rejections counts requests rejected since the instance was started.
+var rejections = struct {
+	sync.Mutex
+	counts map[Rule]int
+}{counts: make(map[Rule]int)}
+
+// RecordRejection counts one request rejected by the given rule.
+func RecordRejection(r Rule) {
+	rejections.Lock()
+	rejections.counts[r]++
+	rejections.Unlock()
+}
+
+// Rejections returns the number of requests rejected by each rule since the
+// instance was started, most rejections first. Rules that haven't rejected
+// any requests are left out.
+func Rejections() []Rejection {
+	rejections.Lock()
+	rs := make([]Rejection, 0, len(rejections.counts))
+	for r, count := range rejections.counts {
+		rs = append(rs, Rejection{Rule: r, Count: count})
+	}
+	rejections.Unlock()
+
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Count != rs[j].Count {
+			return rs[i].Count > rs[j].Count
+		}
+		if rs[i].Kind != rs[j].Kind {
+			return rs[i].Kind < rs[j].Kind
+		}
+		return rs[i].Pattern < rs[j].Pattern
+	})
+
+	return rs
+}
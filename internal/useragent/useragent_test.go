@@ -0,0 +1,108 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package useragent_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/useragent"
+)
+
+type UserAgentTestSuite struct {
+	suite.Suite
+}
+
+func (suite *UserAgentTestSuite) TestMatchPatterns() {
+	blocklist, err := useragent.NewBlocklist([]string{`^curl/`, `python-requests`}, false)
+	suite.NoError(err)
+	suite.False(blocklist.Empty())
+
+	rule, ok := blocklist.Match("curl/7.68.0")
+	suite.True(ok)
+	suite.Equal(useragent.Rule{Kind: useragent.KindPattern, Pattern: `^curl/`}, rule)
+
+	// patterns are matched ignoring case
+	rule, ok = blocklist.Match("Python-Requests/2.28.1")
+	suite.True(ok)
+	suite.Equal(`python-requests`, rule.Pattern)
+
+	_, ok = blocklist.Match("Mozilla/5.0 (X11; Linux x86_64; rv:107.0) Gecko/20100101 Firefox/107.0")
+	suite.False(ok)
+
+	// AI scrapers aren't blocked unless asked for
+	_, ok = blocklist.Match("Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.0; +https://openai.com/gptbot)")
+	suite.False(ok)
+}
+
+func (suite *UserAgentTestSuite) TestMatchAIScrapers() {
+	blocklist, err := useragent.NewBlocklist(nil, true)
+	suite.NoError(err)
+
+	rule, ok := blocklist.Match("Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.0; +https://openai.com/gptbot)")
+	suite.True(ok)
+	suite.Equal(useragent.Rule{Kind: useragent.KindAIScraper, Pattern: "GPTBot"}, rule)
+
+	rule, ok = blocklist.Match("CCBot/2.0 (https://commoncrawl.org/faq/)")
+	suite.True(ok)
+	suite.Equal("CCBot", rule.Pattern)
+
+	_, ok = blocklist.Match("Mozilla/5.0 (X11; Linux x86_64; rv:107.0) Gecko/20100101 Firefox/107.0")
+	suite.False(ok)
+}
+
+func (suite *UserAgentTestSuite) TestEmpty() {
+	blocklist, err := useragent.NewBlocklist(nil, false)
+	suite.NoError(err)
+	suite.True(blocklist.Empty())
+
+	_, ok := blocklist.Match("curl/7.68.0")
+	suite.False(ok)
+}
+
+func (suite *UserAgentTestSuite) TestBadPattern() {
+	_, err := useragent.NewBlocklist([]string{"(GPTBot"}, false)
+	suite.EqualError(err, "error parsing regexp: missing closing ): `(GPTBot`")
+}
+
+func (suite *UserAgentTestSuite) TestRejections() {
+	once := useragent.Rule{Kind: useragent.KindPattern, Pattern: "test-rejections-once"}
+	twice := useragent.Rule{Kind: useragent.KindPattern, Pattern: "test-rejections-twice"}
+
+	useragent.RecordRejection(once)
+	useragent.RecordRejection(twice)
+	useragent.RecordRejection(twice)
+
+	counts := make(map[useragent.Rule]int)
+	order := []useragent.Rule{}
+	for _, r := range useragent.Rejections() {
+		counts[r.Rule] = r.Count
+		if r.Rule == once || r.Rule == twice {
+			order = append(order, r.Rule)
+		}
+	}
+
+	suite.Equal(1, counts[once])
+	suite.Equal(2, counts[twice])
+	suite.Equal([]useragent.Rule{twice, once}, order)
+}
+
+func TestUserAgentTestSuite(t *testing.T) {
+	suite.Run(t, &UserAgentTestSuite{})
+}
@@ -19,12 +19,9 @@
 package util
 
 import (
-	"fmt"
-	"net/url"
-
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
-	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // PageableResponseParams models the parameters to pass to PackagePageableResponse.
@@ -48,14 +45,6 @@ type PageableResponseParams struct {
 // a bunch of pageable items (notifications, statuses, etc), as well
 // as a Link header to inform callers of where to find next/prev items.
 func PackagePageableResponse(params PageableResponseParams) (*apimodel.PageableResponse, gtserror.WithCode) {
-	if params.NextMaxIDKey == "" {
-		params.NextMaxIDKey = "max_id"
-	}
-
-	if params.PrevMinIDKey == "" {
-		params.PrevMinIDKey = "min_id"
-	}
-
 	pageableResponse := EmptyPageableResponse()
 
 	if len(params.Items) == 0 {
@@ -65,47 +54,16 @@ func PackagePageableResponse(params PageableResponseParams) (*apimodel.PageableR
 	// items
 	pageableResponse.Items = params.Items
 
-	protocol := config.GetProtocol()
-	host := config.GetHost()
-
-	// next
-	nextRaw := params.NextMaxIDKey + "=" + params.NextMaxIDValue
-	if params.Limit != 0 {
-		nextRaw = fmt.Sprintf("limit=%d&", params.Limit) + nextRaw
-	}
-	for _, p := range params.ExtraQueryParams {
-		nextRaw = nextRaw + "&" + p
-	}
-	nextLink := &url.URL{
-		Scheme:   protocol,
-		Host:     host,
-		Path:     params.Path,
-		RawQuery: nextRaw,
-	}
-	nextLinkString := nextLink.String()
-	pageableResponse.NextLink = nextLinkString
-
-	// prev
-	prevRaw := params.PrevMinIDKey + "=" + params.PrevMinIDValue
-	if params.Limit != 0 {
-		prevRaw = fmt.Sprintf("limit=%d&", params.Limit) + prevRaw
-	}
-	for _, p := range params.ExtraQueryParams {
-		prevRaw = prevRaw + "&" + p
-	}
-	prevLink := &url.URL{
-		Scheme:   protocol,
-		Host:     host,
-		Path:     params.Path,
-		RawQuery: prevRaw,
-	}
-	prevLinkString := prevLink.String()
-	pageableResponse.PrevLink = prevLinkString
-
-	// link header
-	next := fmt.Sprintf("<%s>; rel=\"next\"", nextLinkString)
-	prev := fmt.Sprintf("<%s>; rel=\"prev\"", prevLinkString)
-	pageableResponse.LinkHeader = next + ", " + prev
+	// next, prev and link header
+	pageableResponse.NextLink, pageableResponse.PrevLink, pageableResponse.LinkHeader = paging.Links(paging.LinkParams{
+		Path:       params.Path,
+		NextKey:    params.NextMaxIDKey,
+		NextValue:  params.NextMaxIDValue,
+		PrevKey:    params.PrevMinIDKey,
+		PrevValue:  params.PrevMinIDValue,
+		Limit:      params.Limit,
+		ExtraQuery: params.ExtraQueryParams,
+	})
 
 	return pageableResponse, nil
 }
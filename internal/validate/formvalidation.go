@@ -22,10 +22,12 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"net/url"
 	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/regexes"
 	pwv "github.com/wagslane/go-password-validator"
 	"golang.org/x/text/language"
@@ -42,7 +44,13 @@ const (
 	maximumSiteTermsLength        = 5000
 	maximumUsernameLength         = 64
 	maximumCustomCSSLength        = 5000
+	maximumRetentionDays          = 3650
 	maximumEmojiCategoryLength    = 64
+	maximumEmojiAttributionLength = 255
+	maximumProfileFieldLength     = 255
+	maximumDomainNoteLength       = 5000
+	maximumLegalDocumentLength    = 50000
+	maximumLegalChangelogLength   = 1000
 )
 
 // NewPassword returns an error if the given password is not sufficiently strong, or nil if it's ok.
@@ -126,15 +134,109 @@ func SignUpReason(reason string, reasonRequired bool) error {
 	return nil
 }
 
+// SignUpEmailDomain checks that the given email address for a server signup request
+// is on one of the allowed domains, or a subdomain of one of them.
+// If no domains are allowed, then any email domain is accepted.
+func SignUpEmailDomain(email string, allowedDomains []string) error {
+	if len(allowedDomains) == 0 {
+		// allowlist mode is off
+		return nil
+	}
+
+	m, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("email %s could not be parsed: %s", email, err)
+	}
+
+	// domain will always be the part after the last @
+	domain := strings.ToLower(m.Address[strings.LastIndex(m.Address, "@")+1:])
+	for _, allowed := range allowedDomains {
+		allowed = strings.ToLower(allowed)
+		if domain == allowed || strings.HasSuffix(domain, "."+allowed) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("email %s is not on an accepted domain; accepted domains are: %s", email, strings.Join(allowedDomains, ", "))
+}
+
+// SignUpLink checks that the link given for a server signup request is
+// an http(s) URL on one of the allowed domains, or a subdomain of one of them.
+// If no domains are allowed, then no link is required, and any given link is ignored.
+func SignUpLink(link string, allowedDomains []string) error {
+	if len(allowedDomains) == 0 {
+		// we're not going to do anything
+		// with this link if none is required
+		return nil
+	}
+
+	if link == "" {
+		return errors.New("no link provided")
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return fmt.Errorf("link %s could not be parsed: %s", link, err)
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("link %s was not an http or https url", link)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range allowedDomains {
+		domain = strings.ToLower(domain)
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("link %s is not on an accepted domain; accepted domains are: %s", link, strings.Join(allowedDomains, ", "))
+}
+
 // DisplayName checks that a requested display name is valid
 func DisplayName(displayName string) error {
-	// TODO: add some validation logic here -- length, characters, etc
+	maxChars := config.GetAccountsDisplayNameMaxChars()
+	if length := len([]rune(displayName)); length > maxChars {
+		return fmt.Errorf("display name should be no more than %d chars but given display name was %d", maxChars, length)
+	}
 	return nil
 }
 
 // Note checks that a given profile/account note/bio is valid
 func Note(note string) error {
-	// TODO: add some validation logic here -- length, characters, etc
+	maxChars := config.GetAccountsNoteMaxChars()
+	if length := len([]rune(note)); length > maxChars {
+		return fmt.Errorf("note should be no more than %d chars but given note was %d", maxChars, length)
+	}
+	return nil
+}
+
+// ProfileFields checks that the given profile fields are valid: there must be
+// no more than the configured maximum, each field must have a name, and neither
+// name nor value may be longer than 255 characters.
+func ProfileFields(fields []apimodel.UpdateField) error {
+	maxFields := config.GetAccountsMaxProfileFields()
+	if length := len(fields); length > maxFields {
+		return fmt.Errorf("no more than %d profile fields are permitted but %d were given", maxFields, length)
+	}
+
+	for i, field := range fields {
+		if field.Name == nil || *field.Name == "" {
+			return fmt.Errorf("profile field %d has no name", i)
+		}
+
+		if length := len([]rune(*field.Name)); length > maximumProfileFieldLength {
+			return fmt.Errorf("profile field %d name should be no more than %d chars but was %d", i, maximumProfileFieldLength, length)
+		}
+
+		if field.Value != nil {
+			if length := len([]rune(*field.Value)); length > maximumProfileFieldLength {
+				return fmt.Errorf("profile field %d value should be no more than %d chars but was %d", i, maximumProfileFieldLength, length)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -162,6 +264,24 @@ func StatusFormat(statusFormat string) error {
 	return fmt.Errorf("status format '%s' was not recognized, valid options are 'plain', 'markdown'", statusFormat)
 }
 
+// MentionPolicy checks that the desired mention policy setting is valid.
+func MentionPolicy(mentionPolicy string) error {
+	switch gtsmodel.MentionPolicy(mentionPolicy) {
+	case gtsmodel.MentionPolicyEveryone, gtsmodel.MentionPolicyFollowing, gtsmodel.MentionPolicyNobody:
+		return nil
+	}
+	return fmt.Errorf("mention policy '%s' was not recognized, valid options are 'everyone', 'following', 'nobody'", mentionPolicy)
+}
+
+// NotificationsRetentionDays checks that the desired notification retention setting is valid.
+// -1 is accepted, and means that the instance default should be used.
+func NotificationsRetentionDays(days int) error {
+	if days < -1 || days > maximumRetentionDays {
+		return fmt.Errorf("notifications retention days %d was not valid, must be between -1 and %d", days, maximumRetentionDays)
+	}
+	return nil
+}
+
 func CustomCSS(customCSS string) error {
 	if !config.GetAccountsAllowCustomCSS() {
 		return errors.New("accounts-allow-custom-css is not enabled for this instance")
@@ -183,6 +303,15 @@ func EmojiShortcode(shortcode string) error {
 	return nil
 }
 
+// ClientSettingKey runs the given key through the regular expression for client
+// setting keys, ie., 1-64 characters, letters, numbers, underscores, hyphens, dots, and colons.
+func ClientSettingKey(key string) error {
+	if !regexes.ClientSettingKey.MatchString(key) {
+		return fmt.Errorf("client setting key '%s' did not pass validation, must be between 1 and 64 characters, letters, numbers, underscores, hyphens, dots, and colons only", key)
+	}
+	return nil
+}
+
 // EmojiCategory validates the length of the given category string.
 func EmojiCategory(category string) error {
 	if length := len(category); length > maximumEmojiCategoryLength {
@@ -191,6 +320,33 @@ func EmojiCategory(category string) error {
 	return nil
 }
 
+// EmojiAttribution validates the length of the given emoji author and license,
+// and checks that the source url, if given, is an http or https url.
+func EmojiAttribution(author string, license string, sourceURL string) error {
+	if length := len([]rune(author)); length > maximumEmojiAttributionLength {
+		return fmt.Errorf("emoji author should be no more than %d chars but given author was %d", maximumEmojiAttributionLength, length)
+	}
+
+	if length := len([]rune(license)); length > maximumEmojiAttributionLength {
+		return fmt.Errorf("emoji license should be no more than %d chars but given license was %d", maximumEmojiAttributionLength, length)
+	}
+
+	if sourceURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(sourceURL)
+	if err != nil {
+		return fmt.Errorf("emoji source url %s could not be parsed: %s", sourceURL, err)
+	}
+
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("emoji source url %s was not an http or https url", sourceURL)
+	}
+
+	return nil
+}
+
 // SiteTitle ensures that the given site title is within spec.
 func SiteTitle(siteTitle string) error {
 	if length := len([]rune(siteTitle)); length > maximumSiteTitleLength {
@@ -227,6 +383,32 @@ func SiteTerms(t string) error {
 	return nil
 }
 
+// DomainNote ensures that the given admin note on a domain is within spec.
+func DomainNote(note string) error {
+	if length := len([]rune(note)); length > maximumDomainNoteLength {
+		return fmt.Errorf("domain note should be no more than %d chars but given note was %d", maximumDomainNoteLength, length)
+	}
+
+	return nil
+}
+
+// LegalDocument ensures that the given text and changelog of a new version of a legal document are within spec.
+func LegalDocument(text string, changelog string) error {
+	if text == "" {
+		return errors.New("legal document text should not be empty")
+	}
+
+	if length := len([]rune(text)); length > maximumLegalDocumentLength {
+		return fmt.Errorf("legal document text should be no more than %d chars but given text was %d", maximumLegalDocumentLength, length)
+	}
+
+	if length := len([]rune(changelog)); length > maximumLegalChangelogLength {
+		return fmt.Errorf("legal document changelog should be no more than %d chars but given changelog was %d", maximumLegalChangelogLength, length)
+	}
+
+	return nil
+}
+
 // ULID returns true if the passed string is a valid ULID.
 func ULID(i string) bool {
 	return regexes.ULID.MatchString(i)
@@ -21,10 +21,13 @@ package validate_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
@@ -284,6 +287,161 @@ func (suite *ValidationTestSuite) TestValidateReason() {
 	}
 }
 
+func (suite *ValidationTestSuite) TestValidateSignUpEmailDomain() {
+	allowed := []string{"example.org", "Example.com"}
+
+	// no allowlist means anything goes
+	err := validate.SignUpEmailDomain("someone@whatever.net", nil)
+	assert.NoError(suite.T(), err)
+
+	err = validate.SignUpEmailDomain("someone@example.org", allowed)
+	assert.NoError(suite.T(), err)
+
+	err = validate.SignUpEmailDomain("someone@Mail.Example.com", allowed)
+	assert.NoError(suite.T(), err)
+
+	err = validate.SignUpEmailDomain("someone@notexample.org", allowed)
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("email someone@notexample.org is not on an accepted domain; accepted domains are: example.org, Example.com"), err)
+	}
+
+	err = validate.SignUpEmailDomain("someone@example.org.evil.com", allowed)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ValidationTestSuite) TestValidateSignUpLink() {
+	allowedDomains := []string{"example.org"}
+	var err error
+
+	// check with no link required
+	err = validate.SignUpLink("", nil)
+	suite.NoError(err)
+
+	err = validate.SignUpLink("not a link at all", nil)
+	suite.NoError(err)
+
+	// check with link required
+	err = validate.SignUpLink("", allowedDomains)
+	suite.EqualError(err, "no link provided")
+
+	err = validate.SignUpLink("https://example.org/staff/someone", allowedDomains)
+	suite.NoError(err)
+
+	err = validate.SignUpLink("https://staff.Example.org/someone", allowedDomains)
+	suite.NoError(err)
+
+	err = validate.SignUpLink("ftp://example.org/staff/someone", allowedDomains)
+	suite.EqualError(err, "link ftp://example.org/staff/someone was not an http or https url")
+
+	err = validate.SignUpLink("https://notexample.org/staff/someone", allowedDomains)
+	suite.EqualError(err, "link https://notexample.org/staff/someone is not on an accepted domain; accepted domains are: example.org")
+
+	err = validate.SignUpLink("https://example.org.evil.com/staff/someone", allowedDomains)
+	suite.EqualError(err, "link https://example.org.evil.com/staff/someone is not on an accepted domain; accepted domains are: example.org")
+}
+
+func (suite *ValidationTestSuite) TestValidateDisplayNameAndNote() {
+	config.SetAccountsDisplayNameMaxChars(10)
+	config.SetAccountsNoteMaxChars(20)
+
+	err := validate.DisplayName("the_mighty_zork")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("display name should be no more than 10 chars but given display name was 15"), err)
+	}
+
+	err = validate.DisplayName("⎾⎿⏀⏁⏂⏃⏄⏅⏆⏇")
+	assert.NoError(suite.T(), err)
+
+	err = validate.Note("i post about things that concern me")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("note should be no more than 20 chars but given note was 35"), err)
+	}
+
+	err = validate.Note("i post about things")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ValidationTestSuite) TestValidateProfileFields() {
+	config.SetAccountsMaxProfileFields(2)
+
+	name := "pronouns"
+	value := "they/them"
+	empty := ""
+	tooLong := strings.Repeat("a", 256)
+
+	err := validate.ProfileFields([]apimodel.UpdateField{
+		{Name: &name, Value: &value},
+		{Name: &name, Value: &value},
+	})
+	assert.NoError(suite.T(), err)
+
+	err = validate.ProfileFields([]apimodel.UpdateField{
+		{Name: &name, Value: &value},
+		{Name: &name, Value: &value},
+		{Name: &name, Value: &value},
+	})
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("no more than 2 profile fields are permitted but 3 were given"), err)
+	}
+
+	err = validate.ProfileFields([]apimodel.UpdateField{
+		{Name: &empty, Value: &value},
+	})
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("profile field 0 has no name"), err)
+	}
+
+	err = validate.ProfileFields([]apimodel.UpdateField{
+		{Name: &name, Value: &tooLong},
+	})
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("profile field 0 value should be no more than 255 chars but was 256"), err)
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateDomainNote() {
+	err := validate.DomainNote("limited 2023-06 due to spam wave, re-evaluate")
+	assert.NoError(suite.T(), err)
+
+	err = validate.DomainNote(strings.Repeat("a", 5001))
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("domain note should be no more than 5000 chars but given note was 5001"), err)
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateEmojiAttribution() {
+	err := validate.EmojiAttribution("Volpeon", "CC BY-NC-SA 4.0", "https://volpeon.ink/emojis/neocat/")
+	assert.NoError(suite.T(), err)
+
+	err = validate.EmojiAttribution("", "", "")
+	assert.NoError(suite.T(), err)
+
+	err = validate.EmojiAttribution(strings.Repeat("a", 256), "", "")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("emoji author should be no more than 255 chars but given author was 256"), err)
+	}
+
+	err = validate.EmojiAttribution("", "", "javascript:alert(1)")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("emoji source url javascript:alert(1) was not an http or https url"), err)
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateLegalDocument() {
+	err := validate.LegalDocument("Be nice to each other.", "")
+	assert.NoError(suite.T(), err)
+
+	err = validate.LegalDocument("", "")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("legal document text should not be empty"), err)
+	}
+
+	err = validate.LegalDocument("Be nice to each other.", strings.Repeat("a", 1001))
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("legal document changelog should be no more than 1000 chars but given changelog was 1001"), err)
+	}
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
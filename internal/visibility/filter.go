@@ -50,6 +50,9 @@ type Filter interface {
 	//
 	// this function will call StatusVisible internally so it's not necessary to call it beforehand.
 	StatusBoostable(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error)
+
+	// MentionPermitted returns true if the mention policy of targetAccount allows originAccount to mention it.
+	MentionPermitted(ctx context.Context, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (bool, error)
 }
 
 type filter struct {
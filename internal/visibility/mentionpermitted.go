@@ -0,0 +1,41 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package visibility
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (f *filter) MentionPermitted(ctx context.Context, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (bool, error) {
+	if originAccount.ID == targetAccount.ID {
+		// accounts can always mention themselves
+		return true, nil
+	}
+
+	switch targetAccount.MentionPolicy {
+	case gtsmodel.MentionPolicyNobody:
+		return false, nil
+	case gtsmodel.MentionPolicyFollowing:
+		return f.db.IsFollowing(ctx, targetAccount, originAccount)
+	default:
+		return true, nil
+	}
+}
@@ -170,9 +170,18 @@ func (f *filter) relevantAccounts(ctx context.Context, status *gtsmodel.Status,
 	return relAccts, nil
 }
 
-// domainBlockedRelevant checks through all relevant accounts attached to a status
-// to make sure none of them are domain blocked by this instance.
+// domainBlockedRelevant checks whether any of the relevant accounts is on an instance-level blocked domain.
 func (f *filter) domainBlockedRelevant(ctx context.Context, r *relevantAccounts) (bool, error) {
+	return f.db.AreDomainsBlocked(ctx, r.domains())
+}
+
+// domainBlockedRelevantByAccount checks whether any of the relevant accounts is on a domain blocked by the given account.
+func (f *filter) domainBlockedRelevantByAccount(ctx context.Context, r *relevantAccounts, accountID string) (bool, error) {
+	return f.db.AreDomainsBlockedByAccount(ctx, accountID, r.domains())
+}
+
+// domains returns the domains of all relevant accounts.
+func (r *relevantAccounts) domains() []string {
 	domains := []string{}
 
 	if r.Account != nil {
@@ -203,7 +212,7 @@ func (f *filter) domainBlockedRelevant(ctx context.Context, r *relevantAccounts)
 		}
 	}
 
-	return f.db.AreDomainsBlocked(ctx, domains)
+	return domains
 }
 
 func idIn(id string, mentions []*gtsmodel.Mention) bool {
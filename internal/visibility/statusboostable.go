@@ -49,12 +49,20 @@ func (f *filter) StatusBoostable(ctx context.Context, targetStatus *gtsmodel.Sta
 		return true, nil
 	}
 
-	// if status is followers-only and not the author's, it is not boostable
-	if targetStatus.Visibility == gtsmodel.VisibilityFollowersOnly {
-		log.Trace("status not boostable because it is followers-only")
+	// if status is mutuals-only and not the author's, it is not boostable
+	if targetStatus.Visibility == gtsmodel.VisibilityMutualsOnly {
+		log.Trace("status not boostable because it is mutuals-only")
 		return false, nil
 	}
 
+	// if status is followers-only and not the author's, it's only boostable if
+	// the author allowed it; we already know the requester can see the status,
+	// so they must be following the author
+	if targetStatus.Visibility == gtsmodel.VisibilityFollowersOnly {
+		log.Trace("status is followers-only, using status.boostable value")
+		return *targetStatus.Boostable, nil
+	}
+
 	// otherwise, status is as boostable as it says it is
 	log.Trace("defaulting to status.boostable value")
 	return *targetStatus.Boostable, nil
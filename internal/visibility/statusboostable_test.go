@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type StatusBoostableTestSuite struct {
@@ -128,6 +130,19 @@ func (suite *StatusBoostableTestSuite) TestOtherFollowersOnlyNotBoostable() {
 	suite.False(boostable)
 }
 
+func (suite *StatusBoostableTestSuite) TestOtherFollowersOnlyBoostableIfAllowed() {
+	testStatus := &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["local_account_2_status_7"]
+	testStatus.Boostable = testrig.TrueBool()
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	boostable, err := suite.filter.StatusBoostable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+
+	suite.True(boostable)
+}
+
 func (suite *StatusBoostableTestSuite) TestOtherDirectNotBoostable() {
 	testStatus := suite.testStatuses["local_account_2_status_6"]
 	testAccount := suite.testAccounts["local_account_1"]
@@ -20,9 +20,11 @@ package visibility
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
@@ -63,11 +65,28 @@ func (f *filter) StatusHometimelineable(ctx context.Context, targetStatus *gtsmo
 		}
 		targetStatus.Account = tsa
 	}
-	following, err := f.db.IsFollowing(ctx, timelineOwnerAccount, targetStatus.Account)
+	follow, err := f.db.GetFollow(ctx, timelineOwnerAccount.ID, targetStatus.AccountID)
 	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not following
+			return false, nil
+		}
 		return false, fmt.Errorf("StatusHometimelineable: error checking if %s follows %s: %s", timelineOwnerAccount.ID, targetStatus.AccountID, err)
 	}
-	if !following {
+
+	// respect the options the follow was created with
+	if targetStatus.BoostOfID != "" && follow.ShowReblogs != nil && !*follow.ShowReblogs {
+		l.Debug("status is not hometimelineable because the timeline owner hides boosts from its author")
+		return false, nil
+	}
+
+	// replies to the timeline owner are always shown, and the author's
+	// replies to itself are threads, so only replies to others are hidden
+	if targetStatus.InReplyToURI != "" &&
+		targetStatus.InReplyToAccountID != timelineOwnerAccount.ID &&
+		targetStatus.InReplyToAccountID != targetStatus.AccountID &&
+		follow.ShowReplies != nil && !*follow.ShowReplies {
+		l.Debug("status is not hometimelineable because the timeline owner hides replies from its author")
 		return false, nil
 	}
 
@@ -65,6 +65,65 @@ func (suite *StatusStatusHometimelineableTestSuite) TestNotFollowingStatusHometi
 	suite.False(timelineable)
 }
 
+func (suite *StatusStatusHometimelineableTestSuite) TestHiddenBoostsNotHometimelineable() {
+	testStatus := suite.testStatuses["admin_account_status_4"] // a boost
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	timelineable, err := suite.filter.StatusHometimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.True(timelineable)
+
+	// stop showing boosts from admin
+	follow := &gtsmodel.Follow{}
+	*follow = *suite.testFollows["local_account_1_admin_account"]
+	follow.ShowReblogs = testrig.FalseBool()
+	if err := suite.db.UpdateByID(ctx, follow, follow.ID, "show_reblogs"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err = suite.filter.StatusHometimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.False(timelineable)
+}
+
+func (suite *StatusStatusHometimelineableTestSuite) TestHiddenRepliesNotHometimelineable() {
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	// admin replying to turtle, who local_account_1 also follows
+	parentStatus := suite.testStatuses["local_account_2_status_1"]
+	replyToOther := &gtsmodel.Status{}
+	*replyToOther = *suite.testStatuses["admin_account_status_1"]
+	replyToOther.InReplyToID = parentStatus.ID
+	replyToOther.InReplyToURI = parentStatus.URI
+	replyToOther.InReplyToAccountID = parentStatus.AccountID
+
+	// admin replying to local_account_1
+	replyToOwner := suite.testStatuses["admin_account_status_3"]
+
+	timelineable, err := suite.filter.StatusHometimelineable(ctx, replyToOther, testAccount)
+	suite.NoError(err)
+	suite.True(timelineable)
+
+	// stop showing replies from admin
+	follow := &gtsmodel.Follow{}
+	*follow = *suite.testFollows["local_account_1_admin_account"]
+	follow.ShowReplies = testrig.FalseBool()
+	if err := suite.db.UpdateByID(ctx, follow, follow.ID, "show_replies"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err = suite.filter.StatusHometimelineable(ctx, replyToOther, testAccount)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// replies to the timeline owner are still shown
+	timelineable, err = suite.filter.StatusHometimelineable(ctx, replyToOwner, testAccount)
+	suite.NoError(err)
+	suite.True(timelineable)
+}
+
 func (suite *StatusStatusHometimelineableTestSuite) TestChainReplyFollowersOnly() {
 	ctx := context.Background()
 
@@ -126,6 +126,15 @@ func (f *filter) StatusVisible(ctx context.Context, targetStatus *gtsmodel.Statu
 		return true, nil
 	}
 
+	// Check whether the requesting account has blocked the domain of any relevant account
+	if domainBlocked, err := f.domainBlockedRelevantByAccount(ctx, relevantAccounts, requestingAccount.ID); err != nil {
+		l.Debugf("error checking account domain block: %s", err)
+		return false, fmt.Errorf("error checking account domain block: %s", err)
+	} else if domainBlocked {
+		l.Trace("requesting account blocks the domain of a relevant account")
+		return false, nil
+	}
+
 	// At this point we have a populated targetAccount, targetStatus, and requestingAccount, so we can check for blocks and whathaveyou
 	// First check if a block exists directly between the target account (which authored the status) and the requesting account.
 	if blocked, err := f.db.IsBlocked(ctx, targetAccount.ID, requestingAccount.ID, true); err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 )
 
 func (m *Module) baseHandler(c *gin.Context) {
@@ -45,6 +46,7 @@ func (m *Module) baseHandler(c *gin.Context) {
 
 	c.HTML(http.StatusOK, "index.tmpl", gin.H{
 		"instance": instance,
+		"lang":     i18n.Negotiate(c, ""),
 		"ogMeta":   ogBase(instance),
 	})
 }
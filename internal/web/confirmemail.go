@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 )
 
 func (m *Module) confirmEmailGETHandler(c *gin.Context) {
@@ -53,6 +54,7 @@ func (m *Module) confirmEmailGETHandler(c *gin.Context) {
 
 	c.HTML(http.StatusOK, "confirmed.tmpl", gin.H{
 		"instance": instance,
+		"lang":     i18n.Negotiate(c, user.Locale),
 		"email":    user.Email,
 		"username": user.Account.Username,
 	})
@@ -0,0 +1,83 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2022 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+)
+
+func (m *Module) termsGETHandler(c *gin.Context) {
+	m.legalDocumentGETHandler(c, gtsmodel.LegalDocumentTermsOfService, "Terms of Service")
+}
+
+func (m *Module) privacyGETHandler(c *gin.Context) {
+	m.legalDocumentGETHandler(c, gtsmodel.LegalDocumentPrivacyPolicy, "Privacy Policy")
+}
+
+// legalDocumentGETHandler serves the latest version of the given kind of legal
+// document, or the version given in the query, as a page with the given title.
+func (m *Module) legalDocumentGETHandler(c *gin.Context, kind gtsmodel.LegalDocumentKind, title string) {
+	ctx := c.Request.Context()
+
+	if _, err := api.NegotiateAccept(c, api.HTMLAcceptHeaders...); err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	version := 0
+	if v := c.Query(versionParam); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 1 {
+			err := fmt.Errorf("%s must be a positive integer, provided value was %s", versionParam, v)
+			api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+		version = i
+	}
+
+	document, errWithCode := m.processor.InstanceLegalDocumentGet(ctx, kind, version)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	host := config.GetHost()
+	instance, err := m.processor.InstanceGet(ctx, host)
+	if err != nil {
+		api.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGet)
+		return
+	}
+
+	c.HTML(http.StatusOK, "legal.tmpl", gin.H{
+		"instance": instance,
+		"lang":     i18n.Negotiate(c, ""),
+		"title":    title,
+		"document": document,
+		"previous": document.Version - 1,
+	})
+}
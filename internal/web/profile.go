@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -32,6 +33,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -41,6 +43,29 @@ const (
 )
 
 func (m *Module) profileGETHandler(c *gin.Context) {
+	m.profileGET(c, false, "")
+}
+
+func (m *Module) profileMediaGETHandler(c *gin.Context) {
+	m.profileGET(c, true, "")
+}
+
+func (m *Module) profileTagGETHandler(c *gin.Context) {
+	tag := strings.TrimPrefix(c.Param(tagKey), "#")
+	if tag == "" {
+		err := errors.New("no tag specified")
+		api.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	m.profileGET(c, false, tag)
+}
+
+// profileGET renders the web profile of an account. If mediaOnly
+// is true, the profile's media gallery tab will be rendered instead
+// of its latest statuses. If tag is set, the tab for that featured
+// hashtag will be rendered instead.
+func (m *Module) profileGET(c *gin.Context, mediaOnly bool, tag string) {
 	ctx := c.Request.Context()
 
 	authed, err := oauth.Authed(c, false, false, false, false)
@@ -76,11 +101,18 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 	// if we're getting an AP request on this endpoint we
 	// should render the account's AP representation instead
 	accept := c.NegotiateFormat(string(api.TextHTML), string(api.AppActivityJSON), string(api.AppActivityLDJSON))
-	if accept == string(api.AppActivityJSON) || accept == string(api.AppActivityLDJSON) {
+	if !mediaOnly && tag == "" && (accept == string(api.AppActivityJSON) || accept == string(api.AppActivityLDJSON)) {
 		m.returnAPProfile(ctx, c, username, accept)
 		return
 	}
 
+	// the account was found by a username it had before
+	// it was renamed, so send the caller to its new one
+	if username != account.Username {
+		c.Redirect(http.StatusMovedPermanently, profileTabPath(account.Username, mediaOnly, tag))
+		return
+	}
+
 	var rssFeed string
 	if account.EnableRSS {
 		rssFeed = "/@" + account.Username + "/feed.rss"
@@ -103,12 +135,34 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		showBackToTop = true
 	}
 
-	statusResp, errWithCode := m.processor.AccountWebStatusesGet(ctx, account.ID, maxStatusID)
+	layout, errWithCode := m.processor.AccountWebLayoutGet(ctx, account.ID)
+	if errWithCode != nil {
+		api.ErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	var statusResp *apimodel.PageableResponse
+	if tag != "" {
+		statusResp, errWithCode = m.processor.AccountWebTagStatusesGet(ctx, account.ID, tag, maxStatusID)
+	} else {
+		statusResp, errWithCode = m.processor.AccountWebStatusesGet(ctx, account.ID, maxStatusID, mediaOnly)
+	}
 	if errWithCode != nil {
 		api.ErrorHandler(c, errWithCode, instanceGet)
 		return
 	}
 
+	// pinned statuses are only shown at the
+	// top of the first page of latest statuses
+	var pinnedStatuses []*apimodel.Status
+	if layout.PinnedFirst && !mediaOnly && tag == "" && maxStatusID == "" {
+		pinnedStatuses, errWithCode = m.processor.AccountWebPinnedStatusesGet(ctx, account.ID)
+		if errWithCode != nil {
+			api.ErrorHandler(c, errWithCode, instanceGet)
+			return
+		}
+	}
+
 	stylesheets := []string{
 		"/assets/Fork-Awesome/css/fork-awesome.min.css",
 		"/assets/dist/status.css",
@@ -120,10 +174,16 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 
 	c.HTML(http.StatusOK, "profile.tmpl", gin.H{
 		"instance":         instance,
+		"lang":             i18n.Negotiate(c, ""),
 		"account":          account,
 		"ogMeta":           ogBase(instance).withAccount(account),
 		"rssFeed":          rssFeed,
 		"robotsMeta":       robotsMeta,
+		"layout":           layout,
+		"media_only":       mediaOnly,
+		"tagged":           tag,
+		"tab_path":         profileTabPath(account.Username, mediaOnly, tag),
+		"pinned_statuses":  pinnedStatuses,
 		"statuses":         statusResp.Items,
 		"statuses_next":    statusResp.NextLink,
 		"show_back_to_top": showBackToTop,
@@ -135,6 +195,18 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 	})
 }
 
+// profileTabPath returns the path of the given tab of an account's web profile.
+func profileTabPath(username string, mediaOnly bool, tag string) string {
+	path := "/@" + username
+	switch {
+	case mediaOnly:
+		path += "/media"
+	case tag != "":
+		path += "/tagged/" + url.PathEscape(tag)
+	}
+	return path
+}
+
 func (m *Module) returnAPProfile(ctx context.Context, c *gin.Context, username string, accept string) {
 	verifier, signed := c.Get(string(ap.ContextRequestingPublicKeyVerifier))
 	if signed {
@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 )
 
 func (m *Module) SettingsPanelHandler(c *gin.Context) {
@@ -37,6 +38,7 @@ func (m *Module) SettingsPanelHandler(c *gin.Context) {
 
 	c.HTML(http.StatusOK, "frontend.tmpl", gin.H{
 		"instance": instance,
+		"lang":     i18n.Negotiate(c, ""),
 		"stylesheets": []string{
 			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
 			assetsPathPrefix + "/dist/_colors.css",
@@ -32,6 +32,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -73,7 +74,8 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 
 	// do this check to make sure the status is actually from a local account,
 	// we shouldn't render threads from statuses that don't belong to us!
-	if _, errWithCode := m.processor.AccountGetLocalByUsername(ctx, authed, username); errWithCode != nil {
+	account, errWithCode := m.processor.AccountGetLocalByUsername(ctx, authed, username)
+	if errWithCode != nil {
 		api.ErrorHandler(c, errWithCode, instanceGet)
 		return
 	}
@@ -84,7 +86,9 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		return
 	}
 
-	if !strings.EqualFold(username, status.Account.Username) {
+	// the path username may be one the author had before
+	// it was renamed, so compare the accounts themselves
+	if account.ID != status.Account.ID {
 		err := gtserror.NewErrorNotFound(errors.New("path username not equal to status author username"))
 		api.ErrorHandler(c, gtserror.NewErrorNotFound(err), instanceGet)
 		return
@@ -98,6 +102,11 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		return
 	}
 
+	if username != account.Username {
+		c.Redirect(http.StatusMovedPermanently, "/@"+account.Username+"/statuses/"+statusID)
+		return
+	}
+
 	context, errWithCode := m.processor.StatusGetContext(ctx, authed, statusID)
 	if errWithCode != nil {
 		api.ErrorHandler(c, errWithCode, instanceGet)
@@ -112,8 +121,11 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		stylesheets = append(stylesheets, "/@"+username+"/custom.css")
 	}
 
+	m.processor.StatusWebViewCountIncrement(ctx, status.ID)
+
 	c.HTML(http.StatusOK, "thread.tmpl", gin.H{
 		"instance":    instance,
+		"lang":        i18n.Negotiate(c, ""),
 		"status":      status,
 		"context":     context,
 		"ogMeta":      ogBase(instance).withStatus(status),
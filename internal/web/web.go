@@ -36,14 +36,20 @@ const (
 	profilePath      = "/@:" + usernameKey
 	customCSSPath    = profilePath + "/custom.css"
 	rssFeedPath      = profilePath + "/feed.rss"
+	profileMediaPath = profilePath + "/media"
+	profileTagPath   = profilePath + "/tagged/:" + tagKey
 	statusPath       = profilePath + "/statuses/:" + statusIDKey
 	assetsPathPrefix = "/assets"
 	userPanelPath    = "/settings/user"
 	adminPanelPath   = "/settings/admin"
+	termsPath        = "/terms"
+	privacyPath      = "/privacy"
 
-	tokenParam  = "token"
-	usernameKey = "username"
-	statusIDKey = "status"
+	tokenParam   = "token"
+	versionParam = "version"
+	usernameKey  = "username"
+	statusIDKey  = "status"
+	tagKey       = "tag"
 
 	cacheControlHeader    = "Cache-Control"     // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control
 	cacheControlNoCache   = "no-cache"          // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control#response_directives
@@ -98,6 +104,8 @@ func (m *Module) Route(s router.Router) error {
 
 	// serve profile pages at /@username
 	s.AttachHandler(http.MethodGet, profilePath, m.profileGETHandler)
+	s.AttachHandler(http.MethodGet, profileMediaPath, m.profileMediaGETHandler)
+	s.AttachHandler(http.MethodGet, profileTagPath, m.profileTagGETHandler)
 
 	// serve custom css at /@username/custom.css
 	s.AttachHandler(http.MethodGet, customCSSPath, m.customCSSGETHandler)
@@ -110,6 +118,10 @@ func (m *Module) Route(s router.Router) error {
 	// serve email confirmation page at /confirm_email?token=whatever
 	s.AttachHandler(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
 
+	// serve the terms of service and privacy policy
+	s.AttachHandler(http.MethodGet, termsPath, m.termsGETHandler)
+	s.AttachHandler(http.MethodGet, privacyPath, m.privacyGETHandler)
+
 	// 404 handler
 	s.AttachNoRouteHandler(func(c *gin.Context) {
 		api.ErrorHandler(c, gtserror.NewErrorNotFound(errors.New(http.StatusText(http.StatusNotFound))), m.processor.InstanceGet)
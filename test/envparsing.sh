@@ -2,7 +2,7 @@
 
 set -eu
 
-EXPECT='{"account-domain":"peepee","accounts-allow-custom-css":true,"accounts-approval-required":false,"accounts-reason-required":false,"accounts-registration-open":true,"advanced-cookies-samesite":"strict","advanced-rate-limit-requests":6969,"application-name":"gts","bind-address":"127.0.0.1","config-path":"internal/config/testdata/test.yaml","db-address":":memory:","db-database":"gotosocial_prod","db-password":"hunter2","db-port":6969,"db-tls-ca-cert":"","db-tls-mode":"disable","db-type":"sqlite","db-user":"sex-haver","email":"","host":"example.com","instance-deliver-to-shared-inboxes":false,"instance-expose-peers":true,"instance-expose-public-timeline":true,"instance-expose-suspended":true,"landing-page-user":"admin","letsencrypt-cert-dir":"/gotosocial/storage/certs","letsencrypt-email-address":"","letsencrypt-enabled":true,"letsencrypt-port":80,"log-db-queries":true,"log-level":"info","media-description-max-chars":5000,"media-description-min-chars":69,"media-emoji-local-max-size":420,"media-emoji-remote-max-size":420,"media-image-max-size":420,"media-remote-cache-days":30,"media-video-max-size":420,"oidc-client-id":"1234","oidc-client-secret":"shhhh its a secret","oidc-enabled":true,"oidc-idp-name":"sex-haver","oidc-issuer":"whoknows","oidc-scopes":["read","write"],"oidc-skip-verification":true,"password":"","path":"","port":6969,"protocol":"http","smtp-from":"queen.rip.in.piss@terfisland.org","smtp-host":"example.com","smtp-password":"hunter2","smtp-port":4269,"smtp-username":"sex-haver","software-version":"","statuses-cw-max-chars":420,"statuses-max-chars":69,"statuses-media-max-files":1,"statuses-poll-max-options":1,"statuses-poll-option-max-chars":50,"storage-backend":"local","storage-local-base-path":"/root/store","storage-s3-access-key":"minio","storage-s3-bucket":"gts","storage-s3-endpoint":"localhost:9000","storage-s3-proxy":true,"storage-s3-secret-key":"miniostorage","storage-s3-use-ssl":false,"syslog-address":"127.0.0.1:6969","syslog-enabled":true,"syslog-protocol":"udp","trusted-proxies":["127.0.0.1/32","docker.host.local"],"username":"","web-asset-base-dir":"/root","web-template-base-dir":"/root"}'
+EXPECT='{"account-domain":"peepee","accounts-allow-custom-css":true,"accounts-approval-required":false,"accounts-client-settings-max-size":1024,"accounts-display-name-max-chars":69,"accounts-follow-request-expiry-days":0,"accounts-force-consent-scopes":["admin","push"],"accounts-max-profile-fields":8,"accounts-note-max-chars":420,"accounts-notifications-retention-days":0,"accounts-reason-required":false,"accounts-registration-open":true,"accounts-signup-email-domains":["example.org","example.com"],"accounts-signup-link-domains":["staff.example.org","example.com"],"advanced-block-ai-scrapers":false,"advanced-blocked-user-agents":[],"advanced-cookies-samesite":"strict","advanced-inbox-max-body-size":1048576,"advanced-inbox-max-json-array-length":1000,"advanced-inbox-max-json-depth":32,"advanced-inbox-queue-shed-size":2500,"advanced-inbox-queue-size":5000,"advanced-rate-limit-requests":6969,"advanced-remote-host-requests-per-minute":30,"advanced-thread-max-depth":50,"advanced-thread-max-replies":50,"advanced-thread-reply-ancestors":5,"application-name":"gts","bind-address":"127.0.0.1","cache-account-max-size":2000,"cache-account-ttl":300000000000,"cache-domain-block-max-size":1000,"cache-domain-block-ttl":300000000000,"cache-emoji-category-max-size":100,"cache-emoji-category-ttl":300000000000,"cache-emoji-max-size":2000,"cache-emoji-ttl":300000000000,"cache-mention-max-size":5000,"cache-mention-ttl":300000000000,"cache-notification-max-size":5000,"cache-notification-ttl":300000000000,"cache-status-max-size":10000,"cache-status-ttl":600000000000,"cache-user-max-size":500,"cache-user-ttl":300000000000,"category":"","config-path":"internal/config/testdata/test.yaml","db-address":":memory:","db-cache-invalidation":"","db-database":"gotosocial_prod","db-maintenance-reindex":false,"db-maintenance-schedule":"","db-maintenance-vacuum":true,"db-password":"hunter2","db-port":6969,"db-query-timeout-seconds":60,"db-replica-addresses":[],"db-replica-max-lag-seconds":5,"db-skip-migrations":false,"db-slow-query-threshold-milliseconds":1000,"db-sqlite-busy-timeout-seconds":10,"db-tls-ca-cert":"","db-tls-mode":"disable","db-type":"sqlite","db-user":"sex-haver","dir":"","dry-run":false,"email":"","host":"example.com","i-understand-the-risks":false,"instance-deliver-to-shared-inboxes":false,"instance-expose-outboxes":false,"instance-expose-peers":true,"instance-expose-public-timeline":true,"instance-expose-suspended":true,"instance-language":"en","instance-status-view-counts":false,"landing-page-user":"admin","letsencrypt-cert-dir":"/gotosocial/storage/certs","letsencrypt-email-address":"","letsencrypt-enabled":true,"letsencrypt-port":80,"log-db-queries":true,"log-level":"info","mail-gateway-authserv-id":"","mail-gateway-domain":"","mail-gateway-enabled":false,"mail-gateway-secret":"","media-cross-origin-resource-policy":"","media-description-max-chars":5000,"media-description-min-chars":69,"media-emoji-local-max-size":420,"media-emoji-remote-max-size":420,"media-hotlink-allowed-domains":[],"media-hotlink-protection":false,"media-image-max-size":420,"media-remote-cache-days":30,"media-video-max-size":420,"name":"","oidc-client-id":"1234","oidc-client-secret":"shhhh its a secret","oidc-enabled":true,"oidc-idp-name":"sex-haver","oidc-issuer":"whoknows","oidc-scopes":["read","write"],"oidc-skip-verification":true,"old-host":"","on-conflict":"","password":"","path":"","port":6969,"protocol":"http","smtp-from":"queen.rip.in.piss@terfisland.org","smtp-host":"example.com","smtp-password":"hunter2","smtp-port":4269,"smtp-username":"sex-haver","software-version":"","statuses-cw-max-chars":420,"statuses-max-chars":69,"statuses-media-max-files":1,"statuses-poll-max-options":1,"statuses-poll-option-max-chars":50,"statuses-thread-mute-boosts":true,"storage-backend":"local","storage-local-base-path":"/root/store","storage-s3-access-key":"minio","storage-s3-bucket":"gts","storage-s3-endpoint":"localhost:9000","storage-s3-proxy":true,"storage-s3-secret-key":"miniostorage","storage-s3-use-ssl":false,"syslog-address":"127.0.0.1:6969","syslog-enabled":true,"syslog-protocol":"udp","tolerance-seconds":0,"trusted-proxies":["127.0.0.1/32","docker.host.local"],"username":"","web-asset-base-dir":"/root","web-error-template-dir":"/gotosocial/error-templates/","web-i18n-dir":"./web/i18n/","web-template-base-dir":"/root"}'
 
 # Set all the environment variables to 
 # ensure that these are parsed without panic
@@ -24,13 +24,24 @@ GTS_DB_PASSWORD='hunter2' \
 GTS_DB_DATABASE='gotosocial_prod' \
 GTS_TLS_MODE='' \
 GTS_DB_TLS_CA_CERT='' \
+GTS_CACHE_STATUS_MAX_SIZE=10000 \
+GTS_CACHE_STATUS_TTL='10m' \
 GTS_WEB_TEMPLATE_BASE_DIR='/root' \
 GTS_WEB_ASSET_BASE_DIR='/root' \
+GTS_WEB_ERROR_TEMPLATE_DIR='/gotosocial/error-templates/' \
 GTS_INSTANCE_EXPOSE_PEERS=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED=true \
 GTS_INSTANCE_EXPOSE_PUBLIC_TIMELINE=true \
+GTS_INSTANCE_EXPOSE_OUTBOXES=false \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
+GTS_ACCOUNTS_DISPLAY_NAME_MAX_CHARS=69 \
+GTS_ACCOUNTS_NOTE_MAX_CHARS=420 \
+GTS_ACCOUNTS_MAX_PROFILE_FIELDS=8 \
+GTS_ACCOUNTS_FORCE_CONSENT_SCOPES='admin,push' \
+GTS_ACCOUNTS_CLIENT_SETTINGS_MAX_SIZE=1024 \
+GTS_ACCOUNTS_SIGNUP_LINK_DOMAINS='staff.example.org,example.com' \
+GTS_ACCOUNTS_SIGNUP_EMAIL_DOMAINS='example.org,example.com' \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_APPROVAL_REQUIRED=false \
 GTS_ACCOUNTS_REASON_REQUIRED=false \
@@ -54,6 +65,7 @@ GTS_STATUSES_CW_MAX_CHARS=420 \
 GTS_STATUSES_POLL_MAX_OPTIONS=1 \
 GTS_STATUSES_POLL_OPTIONS_MAX_CHARS=69 \
 GTS_STATUSES_MEDIA_MAX_FILES=1 \
+GTS_STATUSES_THREAD_MUTE_BOOSTS=true \
 GTS_LETS_ENCRYPT_ENABLED=false \
 GTS_LETS_ENCRYPT_PORT=8080 \
 GTS_LETS_ENCRYPT_CERT_DIR='/root/certs' \
@@ -75,6 +87,11 @@ GTS_SYSLOG_PROTOCOL='udp' \
 GTS_SYSLOG_ADDRESS='127.0.0.1:6969' \
 GTS_ADVANCED_COOKIES_SAMESITE='strict' \
 GTS_ADVANCED_RATE_LIMIT_REQUESTS=6969 \
+GTS_ADVANCED_REMOTE_HOST_REQUESTS_PER_MINUTE=30 \
+GTS_ADVANCED_INBOX_QUEUE_SIZE=5000 \
+GTS_ADVANCED_INBOX_QUEUE_SHED_SIZE=2500 \
+GTS_ADVANCED_THREAD_MAX_DEPTH=50 \
+GTS_ADVANCED_THREAD_MAX_REPLIES=50 \
 go run ./cmd/gotosocial/... --config-path internal/config/testdata/test.yaml debug config)
 
 OUTPUT_OUT=$(mktemp)
@@ -19,6 +19,8 @@
 package testrig
 
 import (
+	"time"
+
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
@@ -50,25 +52,63 @@ var testDefaults = config.Configuration{
 	DbPassword: "postgres",
 	DbDatabase: "postgres",
 
-	WebTemplateBaseDir: "./web/template/",
-	WebAssetBaseDir:    "./web/assets/",
+	DbSqliteBusyTimeoutSeconds: 10,
+
+	DbSlowQueryThresholdMilliseconds: 1000,
+
+	DbQueryTimeoutSeconds: 60,
+
+	CacheAccountMaxSize:       2000,
+	CacheAccountTTL:           time.Minute * 5,
+	CacheDomainBlockMaxSize:   1000,
+	CacheDomainBlockTTL:       time.Minute * 5,
+	CacheEmojiMaxSize:         2000,
+	CacheEmojiTTL:             time.Minute * 5,
+	CacheEmojiCategoryMaxSize: 100,
+	CacheEmojiCategoryTTL:     time.Minute * 5,
+	CacheMentionMaxSize:       5000,
+	CacheMentionTTL:           time.Minute * 5,
+	CacheNotificationMaxSize:  5000,
+	CacheNotificationTTL:      time.Minute * 5,
+	CacheStatusMaxSize:        5000,
+	CacheStatusTTL:            time.Minute * 5,
+	CacheUserMaxSize:          500,
+	CacheUserTTL:              time.Minute * 5,
+
+	WebTemplateBaseDir:  "./web/template/",
+	WebAssetBaseDir:     "./web/assets/",
+	WebErrorTemplateDir: "",
+	WebI18nDir:          "",
 
 	InstanceExposePeers:            true,
 	InstanceExposeSuspended:        true,
+	InstanceExposeOutboxes:         true,
 	InstanceDeliverToSharedInboxes: true,
-
-	AccountsRegistrationOpen: true,
-	AccountsApprovalRequired: true,
-	AccountsReasonRequired:   true,
-	AccountsAllowCustomCSS:   true,
-
-	MediaImageMaxSize:        10485760, // 10mb
-	MediaVideoMaxSize:        41943040, // 40mb
-	MediaDescriptionMinChars: 0,
-	MediaDescriptionMaxChars: 500,
-	MediaRemoteCacheDays:     30,
-	MediaEmojiLocalMaxSize:   51200,  // 50kb
-	MediaEmojiRemoteMaxSize:  102400, // 100kb
+	InstanceStatusViewCounts:       true,
+	InstanceLanguage:               "en",
+
+	AccountsRegistrationOpen:      true,
+	AccountsApprovalRequired:      true,
+	AccountsReasonRequired:        true,
+	AccountsAllowCustomCSS:        true,
+	AccountsDisplayNameMaxChars:   100,
+	AccountsNoteMaxChars:          5000,
+	AccountsMaxProfileFields:      4,
+	AccountsForceConsentScopes:    []string{"admin"},
+	AccountsClientSettingsMaxSize: 65536,
+	AccountsSignupLinkDomains:     []string{},
+	AccountsSignupEmailDomains:    []string{},
+
+	MediaImageMaxSize:              10485760, // 10mb
+	MediaVideoMaxSize:              41943040, // 40mb
+	MediaDescriptionMinChars:       0,
+	MediaDescriptionMaxChars:       500,
+	MediaRemoteCacheDays:           30,
+	MediaEmojiLocalMaxSize:         51200,  // 50kb
+	MediaEmojiRemoteMaxSize:        102400, // 100kb
+	MediaHotlinkProtection:         false,
+	MediaHotlinkAllowedDomains:     []string{},
+	MediaCrossOriginResourcePolicy: "",
 
 	// the testrig only uses in-memory storage, so we can
 	// safely set this value to 'test' to avoid running storage
@@ -81,6 +121,7 @@ var testDefaults = config.Configuration{
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesThreadMuteBoosts:   false,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         0,
@@ -105,8 +146,19 @@ var testDefaults = config.Configuration{
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
-	AdvancedCookiesSamesite:   "lax",
-	AdvancedRateLimitRequests: 0, // disabled
+	AdvancedCookiesSamesite:             "lax",
+	AdvancedRateLimitRequests:           0, // disabled
+	AdvancedRemoteHostRequestsPerMinute: 0, // disabled
+	AdvancedInboxQueueSize:              0, // process incoming activities as they arrive, so tests can check the results straight away
+	AdvancedInboxQueueShedSize:          0,
+	AdvancedInboxMaxBodySize:            1048576, // 1mb
+	AdvancedInboxMaxJSONDepth:           32,
+	AdvancedInboxMaxJSONArrayLength:     1000,
+	AdvancedThreadMaxDepth:              100,
+	AdvancedThreadMaxReplies:            100,
+	AdvancedThreadReplyAncestors:        5,
+	AdvancedBlockedUserAgents:           []string{},
+	AdvancedBlockAIScrapers:             false,
 
 	SoftwareVersion: "0.0.0-testrig",
 }
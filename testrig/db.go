@@ -33,24 +33,45 @@ import (
 var testModels = []interface{}{
 	&gtsmodel.Account{},
 	&gtsmodel.AccountToEmoji{},
+	&gtsmodel.AccountDomainBlock{},
+	&gtsmodel.AccountDailyStats{},
+	&gtsmodel.AccountEmojiUsage{},
+	&gtsmodel.AccountUsernameAlias{},
 	&gtsmodel.Application{},
+	&gtsmodel.ApplicationConsent{},
+	&gtsmodel.ClientSetting{},
 	&gtsmodel.Block{},
 	&gtsmodel.DomainBlock{},
+	&gtsmodel.DomainNote{},
+	&gtsmodel.DomainEmojiPolicy{},
 	&gtsmodel.EmailDomainBlock{},
+	&gtsmodel.FeaturedTag{},
 	&gtsmodel.Follow{},
 	&gtsmodel.FollowRequest{},
 	&gtsmodel.MediaAttachment{},
 	&gtsmodel.Mention{},
+	&gtsmodel.Poll{},
+	&gtsmodel.PollVote{},
 	&gtsmodel.Status{},
 	&gtsmodel.StatusToEmoji{},
 	&gtsmodel.StatusToTag{},
 	&gtsmodel.StatusFave{},
 	&gtsmodel.StatusBookmark{},
+	&gtsmodel.BookmarkFolder{},
 	&gtsmodel.StatusMute{},
+	&gtsmodel.StatusViewCount{},
+	&gtsmodel.StatusDelivery{},
 	&gtsmodel.Tag{},
 	&gtsmodel.User{},
 	&gtsmodel.Emoji{},
 	&gtsmodel.Instance{},
+	&gtsmodel.InboxItem{},
+	&gtsmodel.LegalDocument{},
+	&gtsmodel.List{},
+	&gtsmodel.ListEntry{},
+	&gtsmodel.Filter{},
+	&gtsmodel.FilterKeyword{},
+	&gtsmodel.FilterStatus{},
 	&gtsmodel.Notification{},
 	&gtsmodel.RouterSession{},
 	&gtsmodel.Token{},
@@ -242,6 +263,36 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestLists() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestListEntries() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestFilters() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestFilterKeywords() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestBookmarkFolders() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
 	for _, v := range NewTestNotifications() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(err)
@@ -1532,7 +1532,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Pinned:                   FalseBool(),
 			Federated:                TrueBool(),
-			Boostable:                TrueBool(),
+			Boostable:                FalseBool(),
 			Replyable:                TrueBool(),
 			Likeable:                 TrueBool(),
 			ActivityStreamsType:      ap.ObjectNote,
@@ -1706,6 +1706,7 @@ func NewTestFollows() map[string]*gtsmodel.Follow {
 			AccountID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
 			TargetAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
 			ShowReblogs:     TrueBool(),
+			ShowReplies:     TrueBool(),
 			URI:             "http://localhost:8080/users/the_mighty_zork/follow/01F8PY8RHWRQZV038T4E8T9YK8",
 			Notify:          FalseBool(),
 		},
@@ -1716,6 +1717,7 @@ func NewTestFollows() map[string]*gtsmodel.Follow {
 			AccountID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
 			TargetAccountID: "01F8MH5NBDF2MV7CTC4Q5128HF",
 			ShowReblogs:     TrueBool(),
+			ShowReplies:     TrueBool(),
 			URI:             "http://localhost:8080/users/the_mighty_zork/follow/01F8PYDCE8XE23GRE5DPZJDZDP",
 			Notify:          FalseBool(),
 		},
@@ -1726,6 +1728,7 @@ func NewTestFollows() map[string]*gtsmodel.Follow {
 			AccountID:       "01F8MH5NBDF2MV7CTC4Q5128HF",
 			TargetAccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
 			ShowReblogs:     TrueBool(),
+			ShowReplies:     TrueBool(),
 			URI:             "http://localhost:8080/users/1happyturtle/follow/01F8PYDCE8XE23GRE5DPZJDZDP",
 			Notify:          FalseBool(),
 		},
@@ -1736,6 +1739,7 @@ func NewTestFollows() map[string]*gtsmodel.Follow {
 			AccountID:       "01F8MH17FWEB39HZJ76B6VXSKF",
 			TargetAccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
 			ShowReblogs:     TrueBool(),
+			ShowReplies:     TrueBool(),
 			URI:             "http://localhost:8080/users/admin/follow/01G1TK3PQKFW1BQZ9WVYRTFECK",
 			Notify:          FalseBool(),
 		},
@@ -1755,6 +1759,78 @@ func NewTestBlocks() map[string]*gtsmodel.Block {
 	}
 }
 
+func NewTestLists() map[string]*gtsmodel.List {
+	return map[string]*gtsmodel.List{
+		"local_account_1_list_1": {
+			ID:            "01GKYX0D1J0QPJFDN2T8YXMH1F",
+			CreatedAt:     TimeMustParse("2022-12-10T12:21:09+02:00"),
+			UpdatedAt:     TimeMustParse("2022-12-10T12:21:09+02:00"),
+			Title:         "turtles",
+			AccountID:     "01F8MH1H7YV1Z7D2C8K2730QBF",
+			RepliesPolicy: gtsmodel.ListRepliesPolicyFollowed,
+		},
+	}
+}
+
+func NewTestListEntries() map[string]*gtsmodel.ListEntry {
+	return map[string]*gtsmodel.ListEntry{
+		"local_account_1_list_1_entry_1": {
+			ID:        "01GKYX19S8PXGCH1E3Q3A0RJ5Z",
+			CreatedAt: TimeMustParse("2022-12-10T12:22:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-12-10T12:22:09+02:00"),
+			ListID:    "01GKYX0D1J0QPJFDN2T8YXMH1F",
+			FollowID:  "01F8PYDCE8XE23GRE5DPZJDZDP", // zork follows 1happyturtle
+		},
+	}
+}
+
+// NewTestFilters returns a map of filters keyed according to which account created them.
+func NewTestFilters() map[string]*gtsmodel.Filter {
+	return map[string]*gtsmodel.Filter{
+		"local_account_1_filter_1": {
+			ID:                   "01GKZA3J0BMGF7JN6TMDX6T3V0",
+			CreatedAt:            TimeMustParse("2022-12-12T11:40:37+02:00"),
+			UpdatedAt:            TimeMustParse("2022-12-12T11:40:37+02:00"),
+			AccountID:            "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Title:                "fnords",
+			Action:               gtsmodel.FilterActionWarn,
+			ContextHome:          TrueBool(),
+			ContextNotifications: FalseBool(),
+			ContextPublic:        TrueBool(),
+			ContextThread:        FalseBool(),
+			ContextAccount:       FalseBool(),
+		},
+	}
+}
+
+// NewTestFilterKeywords returns a map of filter keywords keyed according to which filter they belong to.
+func NewTestFilterKeywords() map[string]*gtsmodel.FilterKeyword {
+	return map[string]*gtsmodel.FilterKeyword{
+		"local_account_1_filter_1_keyword_1": {
+			ID:        "01GKZA4G3XKQ9Y7HNBYM0F0H5S",
+			CreatedAt: TimeMustParse("2022-12-12T11:40:37+02:00"),
+			UpdatedAt: TimeMustParse("2022-12-12T11:40:37+02:00"),
+			AccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
+			FilterID:  "01GKZA3J0BMGF7JN6TMDX6T3V0",
+			Keyword:   "fnord",
+			WholeWord: TrueBool(),
+		},
+	}
+}
+
+// NewTestBookmarkFolders returns a map of bookmark folders keyed according to which account created them.
+func NewTestBookmarkFolders() map[string]*gtsmodel.BookmarkFolder {
+	return map[string]*gtsmodel.BookmarkFolder{
+		"local_account_1_bookmark_folder_1": {
+			ID:        "01GKZB7QGN3MV7Y4W9H6C1Q2XE",
+			CreatedAt: TimeMustParse("2022-12-12T12:01:12+02:00"),
+			UpdatedAt: TimeMustParse("2022-12-12T12:01:12+02:00"),
+			Title:     "recipes",
+			AccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
+		},
+	}
+}
+
 // ActivityWithSignature wraps a pub.Activity along with its signature headers, for testing.
 type ActivityWithSignature struct {
 	Activity        pub.Activity
@@ -23,6 +23,9 @@ const React = require("react");
 const { useRoute, Link, Redirect } = require("wouter");
 
 const BackButton = require("../../components/back-button");
+const MutateButton = require("../../components/mutation-button");
+
+const { useTextInput } = require("../../components/form");
 
 const query = require("../../lib/query");
 
@@ -59,14 +62,74 @@ function EmojiDetail({emoji}) {
 			<h1><BackButton to={base}/> Custom Emoji: {emoji.shortcode}</h1>
 			<DeleteButton id={emoji.id}/>
 			<p>
-				Editing custom emoji isn&apos;t implemented yet.<br/>
+				Changing the image or shortcode of custom emoji isn&apos;t implemented yet.<br/>
 				<a target="_blank" rel="noreferrer" href="https://github.com/superseriousbusiness/gotosocial/issues/797">View implementation progress.</a>
 			</p>
 			<img src={emoji.url} alt={emoji.shortcode} title={`:${emoji.shortcode}:`}/>
+			<AttributionForm emoji={emoji}/>
 		</div>
 	);
 }
 
+function AttributionForm({emoji}) {
+	const [updateEmoji, result] = query.useUpdateEmojiMutation();
+
+	const [onAuthorChange, _resetAuthor, {author}] = useTextInput("author", {defaultValue: emoji.author || ""});
+	const [onLicenseChange, _resetLicense, {license}] = useTextInput("license", {defaultValue: emoji.license || ""});
+	const [onSourceChange, _resetSource, {source}] = useTextInput("source", {defaultValue: emoji.source_url || ""});
+
+	function submitAttribution(e) {
+		e.preventDefault();
+		updateEmoji({
+			id: emoji.id,
+			author,
+			license,
+			source_url: source
+		});
+	}
+
+	return (
+		<form onSubmit={submitAttribution} className="form-flex">
+			<h2>Attribution</h2>
+
+			<div className="form-field text">
+				<label htmlFor="author">Author</label>
+				<input
+					type="text"
+					id="author"
+					maxLength={255}
+					onChange={onAuthorChange}
+					value={author}
+				/>
+			</div>
+
+			<div className="form-field text">
+				<label htmlFor="license">License, for example CC BY-NC-SA 4.0</label>
+				<input
+					type="text"
+					id="license"
+					maxLength={255}
+					onChange={onLicenseChange}
+					value={license}
+				/>
+			</div>
+
+			<div className="form-field text">
+				<label htmlFor="source">Source URL</label>
+				<input
+					type="url"
+					id="source"
+					placeholder="https://example.org/emojis"
+					onChange={onSourceChange}
+					value={source}
+				/>
+			</div>
+
+			<MutateButton text="Save attribution" result={result}/>
+		</form>
+	);
+}
+
 function DeleteButton({id}) {
 	// TODO: confirmation dialog?
 	const [deleteEmoji, deleteResult] = query.useDeleteEmojiMutation();
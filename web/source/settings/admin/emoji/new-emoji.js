@@ -107,7 +107,7 @@ module.exports = function NewEmojiForm({emoji}) {
 						type="file"
 						id="image"
 						name="Image"
-						accept="image/png,image/gif"
+						accept="image/png,image/gif,image/svg+xml"
 						onChange={onFileChange}
 					/>
 				</div>
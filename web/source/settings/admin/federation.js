@@ -301,9 +301,19 @@ function InstancePageWrapped() {
 		return adminActions.updateDomainBlockVal([domain, key, val]);
 	}
 
+	function alterNote([key, val]) {
+		return adminActions.updateDomainNoteVal([domain, key, val]);
+	}
+
 	const fields = formFields(alterDomain, (state) => state.admin.newInstanceBlocks[domain]);
+	const noteFields = formFields(alterNote, (state) => state.admin.domainNotes[domain]);
 
-	return <InstancePage domain={domain} Form={fields} />;
+	return (
+		<>
+			<InstancePage domain={domain} Form={fields} />
+			<DomainNote domain={domain} Form={noteFields} />
+		</>
+	);
 }
 
 function InstancePage({domain, Form}) {
@@ -372,4 +382,54 @@ function InstancePage({domain, Form}) {
 			</div>
 		</div>
 	);
-}
\ No newline at end of file
+}
+function DomainNote({domain, Form}) {
+	const dispatch = Redux.useDispatch();
+	const note = Redux.useSelector(state => state.admin.domainNotes[domain]);
+
+	React.useEffect(() => {
+		if (note == undefined) {
+			dispatch(api.admin.fetchDomainNote(domain));
+		}
+	}, [dispatch, domain, note]);
+
+	const [errorMsg, setError] = React.useState("");
+	const [statusMsg, setStatus] = React.useState("");
+
+	if (note == undefined) {
+		return null;
+	}
+
+	const updateNote = submit(
+		() => dispatch(api.admin.updateDomainNote(domain)),
+		{setStatus, setError}
+	);
+
+	return (
+		<div>
+			<h2>Admin note</h2>
+			Private note on this domain, visible to instance admins only. Save an empty note to remove it.
+			{!note.new &&
+				<div className="form-info">Last edited {new Date(note.updated_at).toLocaleString()}</div>
+			}
+
+			<Form.TextArea
+				id="text"
+				name="Note"
+				placeHolder="limited 2023-06 due to spam wave, re-evaluate"
+				inputProps={{rows: 4}}
+			/>
+
+			<div className="messagebutton">
+				<button type="submit" onClick={updateNote}>Save note</button>
+
+				{errorMsg.length > 0 &&
+					<div className="error accent">{errorMsg}</div>
+				}
+				{statusMsg.length > 0 &&
+					<div className="accent">{statusMsg}</div>
+				}
+			</div>
+		</div>
+	);
+}
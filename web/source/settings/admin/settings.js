@@ -73,12 +73,12 @@ module.exports = function AdminSettings() {
 			/>
 
 			<TextArea
-				id="short_description"
+				id="short_description_text"
 				name="Short description"
 				placeHolder="A small testing instance for the GoToSocial alpha."
 			/>
 			<TextArea
-				id="description"
+				id="description_text"
 				name="Full description"
 				placeHolder="A small testing instance for the GoToSocial alpha."
 			/>
@@ -95,7 +95,7 @@ module.exports = function AdminSettings() {
 			/>
 
 			<TextArea
-				id="terms"
+				id="terms_text"
 				name="Terms & Conditions"
 				placeHolder=""
 			/>
@@ -20,8 +20,8 @@
 
 const React = require("react");
 
-module.exports = function useTextInput({name, Name}, {validator} = {}) {
-	const [text, setText] = React.useState("");
+module.exports = function useTextInput({name, Name}, {validator, defaultValue=""} = {}) {
+	const [text, setText] = React.useState(defaultValue);
 	const textRef = React.useRef(null);
 
 	function onChange(e) {
@@ -32,8 +32,13 @@ module.exports = function ({ apiCall, getChanges }) {
 					const state = getState().instances.adminSettings;
 
 					const update = getChanges(state, {
-						formKeys: ["title", "short_description", "description", "contact_account.username", "email", "terms", "thumbnail_description"],
-						renamedKeys: {"contact_account.username": "contact_username"},
+						formKeys: ["title", "short_description_text", "description_text", "contact_account.username", "email", "terms_text", "thumbnail_description"],
+						renamedKeys: {
+							"contact_account.username": "contact_username",
+							"short_description_text": "short_description",
+							"description_text": "description",
+							"terms_text": "terms"
+						},
 						fileKeys: ["thumbnail"]
 					});
 
@@ -153,6 +158,41 @@ module.exports = function ({ apiCall, getChanges }) {
 			};
 		},
 
+		fetchDomainNote: function fetchDomainNote(domain) {
+			return function (dispatch, _getState) {
+				return Promise.try(() => {
+					return dispatch(apiCall("GET", `/api/v1/admin/domain_notes/${domain}`));
+				}).catch((e) => {
+					if (e.json != undefined && e.json.code == 404) {
+						// no note for this domain yet
+						return undefined;
+					}
+					throw e;
+				}).then((note) => {
+					return dispatch(admin.setDomainNote([domain, note]));
+				});
+			};
+		},
+
+		updateDomainNote: function updateDomainNote(domain) {
+			return function (dispatch, getState) {
+				return Promise.try(() => {
+					const state = getState().admin.domainNotes[domain];
+					if (state.text.trim().length == 0) {
+						if (state.new) {
+							return undefined;
+						}
+						return Promise.try(() => {
+							return dispatch(apiCall("DELETE", `/api/v1/admin/domain_notes/${domain}`));
+						}).then(() => undefined);
+					}
+					return dispatch(apiCall("PUT", `/api/v1/admin/domain_notes/${domain}`, {text: state.text}, "form"));
+				}).then((note) => {
+					return dispatch(admin.setDomainNote([domain, note]));
+				});
+			};
+		},
+
 		mediaCleanup: function mediaCleanup(days) {
 			return function (dispatch, _getState) {
 				return Promise.try(() => {
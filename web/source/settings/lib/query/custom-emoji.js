@@ -54,6 +54,15 @@ const endpoints = (build) => ({
 				? [{type: "Emojis", id: "LIST"}, {type: "Emojis", id: res.id}]
 				: [{type: "Emojis", id: "LIST"}]
 	}),
+	updateEmoji: build.mutation({
+		query: ({id, ...form}) => ({
+			method: "PATCH",
+			url: `/api/v1/admin/custom_emojis/${id}`,
+			asForm: true,
+			body: form
+		}),
+		invalidatesTags: (res, error, {id}) => [{type: "Emojis", id}]
+	}),
 	deleteEmoji: build.mutation({
 		query: (id) => ({
 			method: "DELETE",
@@ -44,7 +44,8 @@ module.exports = createSlice({
 			exportType: "plain",
 			...emptyBlock()
 		},
-		newInstanceBlocks: {}
+		newInstanceBlocks: {},
+		domainNotes: {}
 	},
 	reducers: {
 		setBlockedInstances: (state, { payload }) => {
@@ -78,6 +79,21 @@ module.exports = createSlice({
 			state.newInstanceBlocks[domain][key] = val;
 		},
 
+		setDomainNote: (state, { payload: [domain, data] }) => {
+			if (data == undefined) {
+				data = {
+					new: true,
+					domain,
+					text: ""
+				};
+			}
+			state.domainNotes[domain] = data;
+		},
+
+		updateDomainNoteVal: (state, { payload: [domain, key, val] }) => {
+			state.domainNotes[domain][key] = val;
+		},
+
 		updateBulkBlockVal: (state, { payload: [key, val] }) => {
 			state.bulkBlock[key] = val;
 		},
@@ -34,6 +34,14 @@ module.exports = createSlice({
 			payload.source.language = defaultValue(payload.source.language.toUpperCase(), "EN");
 			payload.source.status_format = defaultValue(payload.source.status_format, "plain");
 			payload.source.sensitive = defaultValue(payload.source.sensitive, false);
+			payload.source.followers_only_boostable = defaultValue(payload.source.followers_only_boostable, false);
+			payload.source.mention_policy = defaultValue(payload.source.mention_policy, "everyone");
+			payload.source.web_hide_boosts = defaultValue(payload.source.web_hide_boosts, true);
+			payload.source.web_hide_replies = defaultValue(payload.source.web_hide_replies, true);
+			payload.source.web_pinned_first = defaultValue(payload.source.web_pinned_first, false);
+			payload.source.web_media_tab = defaultValue(payload.source.web_media_tab, false);
+			payload.source.hide_mirrors = defaultValue(payload.source.hide_mirrors, false);
+			payload.source.notifications_retention_days = defaultValue(payload.source.notifications_retention_days, -1);
 
 			state.profile = payload;
 			// /user/settings only needs a copy of the 'source' obj
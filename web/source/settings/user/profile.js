@@ -101,6 +101,22 @@ module.exports = function UserProfile() {
 				id="enable_rss"
 				name="Enable RSS feed of Public posts"
 			/>
+			<Checkbox
+				id="source.web_hide_boosts"
+				name="Hide boosts on my profile page"
+			/>
+			<Checkbox
+				id="source.web_hide_replies"
+				name="Hide replies on my profile page"
+			/>
+			<Checkbox
+				id="source.web_pinned_first"
+				name="Show pinned posts first on my profile page"
+			/>
+			<Checkbox
+				id="source.web_media_tab"
+				name="Show a media gallery tab on my profile page"
+			/>
 			{ !allowCustomCSS ? null :  
 				<TextArea
 					id="custom_css"
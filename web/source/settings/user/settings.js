@@ -74,6 +74,34 @@ module.exports = function UserSettings() {
 					id="source.sensitive"
 					name="Mark my posts as sensitive by default"
 				/>
+				<Checkbox
+					id="source.followers_only_boostable"
+					name="Allow followers to boost my followers-only posts to their own followers"
+				/>
+				<Select id="source.mention_policy" name="Who can mention me" options={
+					<>
+						<option value="everyone">Everyone (default)</option>
+						<option value="following">Only accounts I follow</option>
+						<option value="nobody">Nobody</option>
+					</>
+				}>
+					<a href="https://docs.gotosocial.org/en/latest/user_guide/posts/#mention-controls" target="_blank" className="moreinfolink" rel="noreferrer">Learn more about mention controls (opens in a new tab)</a>
+				</Select>
+				<Checkbox
+					id="source.hide_mirrors"
+					name="Hide posts from bots and feed mirrors in the public timelines"
+				/>
+				<Select id="source.notifications_retention_days" name="Delete read notifications after" options={
+					<>
+						<option value="-1">Instance default</option>
+						<option value="7">1 week</option>
+						<option value="30">1 month</option>
+						<option value="90">3 months</option>
+						<option value="365">1 year</option>
+						<option value="0">Never</option>
+					</>
+				}>
+				</Select>
 
 				<Submit onClick={updateSettings} label="Save post settings" errorMsg={errorMsg} statusMsg={statusMsg}/>
 			</div>